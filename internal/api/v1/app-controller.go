@@ -6,6 +6,7 @@ import (
 
 	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
 	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/converter"
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
 	jwt_model "github.com/CHORUS-TRE/chorus-backend/internal/jwt/model"
 	"github.com/CHORUS-TRE/chorus-backend/internal/utils/grpc"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/app/model"
@@ -65,9 +66,14 @@ func (c AppController) UpdateApp(ctx context.Context, req *chorus.App) (*chorus.
 		return nil, status.Errorf(codes.Internal, "conversion error: %v", err.Error())
 	}
 
+	userID, err := jwt_model.ExtractUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract user id from jwt-token")
+	}
+
 	app.TenantID = tenantID
 
-	updatedApp, err := c.app.UpdateApp(ctx, app)
+	updatedApp, err := c.app.UpdateApp(ctx, app, userID)
 	if err != nil {
 		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'UpdateApp': %v", err.Error())
 	}
@@ -252,3 +258,331 @@ func (c AppController) BulkCreateApps(ctx context.Context, req *chorus.BulkCreat
 	}
 	return &chorus.BulkCreateAppsReply{Apps: newAppsProto}, nil
 }
+
+// ListWorkspaceApps returns the custom apps of a workspace.
+func (c AppController) ListWorkspaceApps(ctx context.Context, req *chorus.ListWorkspaceAppsRequest) (*chorus.ListWorkspaceAppsReply, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	res, err := c.app.ListWorkspaceApps(ctx, tenantID, req.WorkspaceId)
+	if err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'ListWorkspaceApps': %v", err.Error())
+	}
+
+	var apps []*chorus.App
+	for _, r := range res {
+		app, err := converter.AppFromBusiness(r)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "conversion error: %v", err.Error())
+		}
+		apps = append(apps, app)
+	}
+	return &chorus.ListWorkspaceAppsReply{Result: &chorus.ListWorkspaceAppsResult{Apps: apps}}, nil
+}
+
+// CreateWorkspaceApp registers a custom app of a workspace.
+func (c AppController) CreateWorkspaceApp(ctx context.Context, req *chorus.CreateWorkspaceAppRequest) (*chorus.CreateWorkspaceAppReply, error) {
+	if req == nil || req.App == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	userID, err := jwt_model.ExtractUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract user id from jwt-token")
+	}
+
+	app, err := converter.AppToBusiness(req.App)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "conversion error: %v", err.Error())
+	}
+
+	app.TenantID = tenantID
+	app.UserID = userID
+	app.WorkspaceID = &req.WorkspaceId
+
+	newApp, err := c.app.CreateWorkspaceApp(ctx, app)
+	if err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'CreateWorkspaceApp': %v", err.Error())
+	}
+
+	newAppProto, err := converter.AppFromBusiness(newApp)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "conversion error: %v", err.Error())
+	}
+	return &chorus.CreateWorkspaceAppReply{Result: &chorus.CreateWorkspaceAppResult{App: newAppProto}}, nil
+}
+
+// DeleteWorkspaceApp deletes a custom app of a workspace.
+func (c AppController) DeleteWorkspaceApp(ctx context.Context, req *chorus.DeleteWorkspaceAppRequest) (*chorus.DeleteWorkspaceAppReply, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	err = c.app.DeleteWorkspaceApp(ctx, tenantID, req.WorkspaceId, req.Id)
+	if err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'DeleteWorkspaceApp': %v", err.Error())
+	}
+	return &chorus.DeleteWorkspaceAppReply{Result: &chorus.DeleteWorkspaceAppResult{}}, nil
+}
+
+// ListAppVersions lists the versions of an app, most recent first.
+func (c AppController) ListAppVersions(ctx context.Context, req *chorus.ListAppVersionsRequest) (*chorus.ListAppVersionsReply, error) {
+	if req == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Could not extract tenant ID from token")
+	}
+
+	versions, err := c.app.ListAppVersions(ctx, tenantID, req.AppId)
+	if err != nil {
+		return nil, err
+	}
+
+	var versionsRes []*chorus.AppImageVersion
+	for _, v := range versions {
+		version, err := converter.AppImageVersionFromBusiness(v)
+		if err != nil {
+			return nil, cerr.ErrConversion.Wrap(err, "Failed to convert app version")
+		}
+		versionsRes = append(versionsRes, version)
+	}
+
+	return &chorus.ListAppVersionsReply{Result: &chorus.ListAppVersionsResult{Versions: versionsRes}}, nil
+}
+
+// CreateAppVersion adds a version to an app.
+func (c AppController) CreateAppVersion(ctx context.Context, req *chorus.CreateAppVersionRequest) (*chorus.CreateAppVersionReply, error) {
+	if req == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Could not extract tenant ID from token")
+	}
+	userID, err := jwt_model.ExtractUserID(ctx)
+	if err != nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Could not extract user ID from token")
+	}
+
+	version, err := c.app.CreateAppVersion(ctx, &model.AppVersion{
+		TenantID:     tenantID,
+		AppID:        req.AppId,
+		Tag:          req.DockerImageTag,
+		Digest:       req.DockerImageDigest,
+		ReleaseNotes: req.ReleaseNotes,
+		IsDefault:    req.IsDefault,
+		Status:       model.AppVersionActive,
+	}, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	versionRes, err := converter.AppImageVersionFromBusiness(version)
+	if err != nil {
+		return nil, cerr.ErrConversion.Wrap(err, "Failed to convert app version")
+	}
+
+	return &chorus.CreateAppVersionReply{Result: &chorus.CreateAppVersionResult{Version: versionRes}}, nil
+}
+
+// UpdateAppVersion updates the release notes of an app version, makes it the default
+// version of its app or deprecates it.
+func (c AppController) UpdateAppVersion(ctx context.Context, req *chorus.UpdateAppVersionRequest) (*chorus.UpdateAppVersionReply, error) {
+	if req == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Could not extract tenant ID from token")
+	}
+
+	status := model.AppVersionActive
+	if req.Deprecated {
+		status = model.AppVersionDeprecated
+	}
+
+	version, err := c.app.UpdateAppVersion(ctx, &model.AppVersion{
+		ID:           req.Id,
+		TenantID:     tenantID,
+		AppID:        req.AppId,
+		ReleaseNotes: req.ReleaseNotes,
+		IsDefault:    req.IsDefault,
+		Status:       status,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	versionRes, err := converter.AppImageVersionFromBusiness(version)
+	if err != nil {
+		return nil, cerr.ErrConversion.Wrap(err, "Failed to convert app version")
+	}
+
+	return &chorus.UpdateAppVersionReply{Result: &chorus.UpdateAppVersionResult{Version: versionRes}}, nil
+}
+
+// GetAppReview returns the lifecycle stage, the reviewers and the lifecycle events of an app.
+func (c AppController) GetAppReview(ctx context.Context, req *chorus.GetAppReviewRequest) (*chorus.GetAppReviewReply, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	review, err := c.app.GetAppReview(ctx, tenantID, req.AppId)
+	if err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'GetAppReview': %v", err.Error())
+	}
+
+	tgReview, err := converter.AppReviewFromBusiness(review)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "conversion error: %v", err.Error())
+	}
+	return &chorus.GetAppReviewReply{Result: &chorus.GetAppReviewResult{Review: tgReview}}, nil
+}
+
+// StartAppReview assigns the reviewers of a submitted app.
+func (c AppController) StartAppReview(ctx context.Context, req *chorus.StartAppReviewRequest) (*chorus.StartAppReviewReply, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	userID, err := jwt_model.ExtractUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract user id from jwt-token")
+	}
+
+	review, err := c.app.StartAppReview(ctx, tenantID, req.AppId, userID, req.ReviewerIds, req.Comment)
+	if err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'StartAppReview': %v", err.Error())
+	}
+
+	tgReview, err := converter.AppReviewFromBusiness(review)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "conversion error: %v", err.Error())
+	}
+	return &chorus.StartAppReviewReply{Result: &chorus.StartAppReviewResult{Review: tgReview}}, nil
+}
+
+// DecideAppReview records the decision of a reviewer of an app.
+func (c AppController) DecideAppReview(ctx context.Context, req *chorus.DecideAppReviewRequest) (*chorus.DecideAppReviewReply, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	userID, err := jwt_model.ExtractUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract user id from jwt-token")
+	}
+
+	review, err := c.app.DecideAppReview(ctx, tenantID, req.AppId, userID, req.Approve, req.Comment)
+	if err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'DecideAppReview': %v", err.Error())
+	}
+
+	tgReview, err := converter.AppReviewFromBusiness(review)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "conversion error: %v", err.Error())
+	}
+	return &chorus.DecideAppReviewReply{Result: &chorus.DecideAppReviewResult{Review: tgReview}}, nil
+}
+
+// CommentAppReview adds a comment to the review of an app.
+func (c AppController) CommentAppReview(ctx context.Context, req *chorus.CommentAppReviewRequest) (*chorus.CommentAppReviewReply, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	userID, err := jwt_model.ExtractUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract user id from jwt-token")
+	}
+
+	review, err := c.app.CommentAppReview(ctx, tenantID, req.AppId, userID, req.Comment)
+	if err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'CommentAppReview': %v", err.Error())
+	}
+
+	tgReview, err := converter.AppReviewFromBusiness(review)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "conversion error: %v", err.Error())
+	}
+	return &chorus.CommentAppReviewReply{Result: &chorus.CommentAppReviewResult{Review: tgReview}}, nil
+}
+
+// UpdateAppLifecycle deprecates, retires or reinstates an approved app.
+func (c AppController) UpdateAppLifecycle(ctx context.Context, req *chorus.UpdateAppLifecycleRequest) (*chorus.UpdateAppLifecycleReply, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	userID, err := jwt_model.ExtractUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract user id from jwt-token")
+	}
+
+	lifecycleStatus, err := model.ToAppLifecycleStatus(req.LifecycleStatus)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid lifecycle status: %v", err.Error())
+	}
+
+	var replacementAppID *uint64
+	if req.ReplacementAppId != 0 {
+		replacementAppID = &req.ReplacementAppId
+	}
+
+	review, err := c.app.UpdateAppLifecycle(ctx, tenantID, req.AppId, userID, lifecycleStatus, replacementAppID, req.Comment)
+	if err != nil {
+		return nil, status.Errorf(grpc.ErrorCode(err), "unable to call 'UpdateAppLifecycle': %v", err.Error())
+	}
+
+	tgReview, err := converter.AppReviewFromBusiness(review)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "conversion error: %v", err.Error())
+	}
+	return &chorus.UpdateAppLifecycleReply{Result: &chorus.UpdateAppLifecycleResult{Review: tgReview}}, nil
+}
@@ -99,6 +99,30 @@ func (c AppInstanceController) DeleteAppInstance(ctx context.Context, req *choru
 	return &chorus.DeleteAppInstanceReply{Result: &chorus.DeleteAppInstanceResult{AppInstance: appInstanceRes}}, nil
 }
 
+// UpgradeAppInstance moves an app instance to a newer version of its app.
+func (c AppInstanceController) UpgradeAppInstance(ctx context.Context, req *chorus.UpgradeAppInstanceRequest) (*chorus.UpgradeAppInstanceReply, error) {
+	if req == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Could not extract tenant ID from token")
+	}
+
+	appInstance, err := c.workbencher.UpgradeAppInstance(ctx, tenantID, req.Id, req.AppVersionId)
+	if err != nil {
+		return nil, err
+	}
+
+	appInstanceRes, err := converter.AppInstanceFromBusiness(appInstance)
+	if err != nil {
+		return nil, cerr.ErrConversion.Wrap(err, "Failed to convert appInstance")
+	}
+
+	return &chorus.UpgradeAppInstanceReply{Result: &chorus.UpgradeAppInstanceResult{AppInstance: appInstanceRes}}, nil
+}
+
 // ListAppInstances extracts the retrieved appInstances from the service and inserts them into a reply object.
 func (c AppInstanceController) ListAppInstances(ctx context.Context, req *chorus.ListAppInstancesRequest) (*chorus.ListAppInstancesReply, error) {
 	if req == nil {
@@ -175,3 +199,72 @@ func (c AppInstanceController) CreateAppInstance(ctx context.Context, req *choru
 
 	return &chorus.CreateAppInstanceReply{Result: &chorus.CreateAppInstanceResult{AppInstance: appInstanceProto}}, nil
 }
+
+func (c AppInstanceController) GetAppInstanceLogs(ctx context.Context, req *chorus.GetAppInstanceLogsRequest) (*chorus.GetAppInstanceLogsReply, error) {
+	if req == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Could not extract tenant ID from token")
+	}
+
+	logReq, err := converter.PodLogRequestToBusiness(req.TailLines, req.SinceTime, req.Previous)
+	if err != nil {
+		return nil, cerr.ErrConversion.Wrap(err, "Failed to convert log request")
+	}
+
+	logs, err := c.workbencher.GetAppInstanceLogs(ctx, tenantID, req.Id, logReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &chorus.GetAppInstanceLogsReply{Result: &chorus.GetAppInstanceLogsResult{Logs: converter.PodLogsFromBusiness(logs)}}, nil
+}
+
+func (c AppInstanceController) ListAppInstanceEvents(ctx context.Context, req *chorus.ListAppInstanceEventsRequest) (*chorus.ListAppInstanceEventsReply, error) {
+	if req == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Could not extract tenant ID from token")
+	}
+
+	events, err := c.workbencher.ListAppInstanceEvents(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	eventsRes, err := converter.PodEventsFromBusiness(events)
+	if err != nil {
+		return nil, cerr.ErrConversion.Wrap(err, "Failed to convert events")
+	}
+
+	return &chorus.ListAppInstanceEventsReply{Result: &chorus.ListAppInstanceEventsResult{Events: eventsRes}}, nil
+}
+
+func (c AppInstanceController) GetAppInstanceUsage(ctx context.Context, req *chorus.GetAppInstanceUsageRequest) (*chorus.GetAppInstanceUsageReply, error) {
+	if req == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Could not extract tenant ID from token")
+	}
+
+	usage, err := c.workbencher.GetAppInstanceUsage(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	usageRes, err := converter.AppInstanceUsageFromBusiness(usage)
+	if err != nil {
+		return nil, cerr.ErrConversion.Wrap(err, "Failed to convert app instance usage")
+	}
+
+	return &chorus.GetAppInstanceUsageReply{Result: &chorus.GetAppInstanceUsageResult{Usage: usageRes}}, nil
+}
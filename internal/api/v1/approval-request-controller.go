@@ -9,6 +9,7 @@ import (
 	jwt_model "github.com/CHORUS-TRE/chorus-backend/internal/jwt/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/approval-request/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/approval-request/service"
+	authz "github.com/CHORUS-TRE/chorus-backend/pkg/authorization/model"
 )
 
 var _ chorus.ApprovalRequestServiceServer = (*ApprovalRequestController)(nil)
@@ -225,6 +226,48 @@ func (c ApprovalRequestController) CreateDataTransferRequest(ctx context.Context
 	return &chorus.CreateDataTransferRequestReply{Result: &chorus.CreateDataTransferRequestResult{ApprovalRequest: protoRequest}}, nil
 }
 
+func (c ApprovalRequestController) CreateWorkspaceAccessRequest(ctx context.Context, req *chorus.CreateWorkspaceAccessRequestRequest) (*chorus.CreateWorkspaceAccessRequestReply, error) {
+	if req == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Could not extract tenant ID from token")
+	}
+
+	userID, err := jwt_model.ExtractUserID(ctx)
+	if err != nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Could not extract user ID from token")
+	}
+
+	request := &model.ApprovalRequest{
+		TenantID:    tenantID,
+		RequesterID: userID,
+		Title:       req.Title,
+		Description: req.Justification,
+		Details: model.ApprovalRequestDetails{
+			WorkspaceAccessDetails: &model.WorkspaceAccessDetails{
+				WorkspaceID:   req.WorkspaceId,
+				Role:          authz.RoleName(req.RoleName),
+				Justification: req.Justification,
+			},
+		},
+	}
+
+	createdRequest, err := c.approvalRequest.CreateWorkspaceAccessRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	protoRequest, err := converter.ApprovalRequestFromBusiness(createdRequest)
+	if err != nil {
+		return nil, cerr.ErrConversion.Wrap(err, "Failed to convert approval request")
+	}
+
+	return &chorus.CreateWorkspaceAccessRequestReply{Result: &chorus.CreateWorkspaceAccessRequestResult{ApprovalRequest: protoRequest}}, nil
+}
+
 func (c ApprovalRequestController) ApproveApprovalRequest(ctx context.Context, req *chorus.ApproveApprovalRequestRequest) (*chorus.ApproveApprovalRequestReply, error) {
 	if req == nil {
 		return nil, cerr.ErrInvalidRequest.WithMessage("Empty request")
@@ -44,6 +44,18 @@ type AppInstance struct {
 	InitialResolutionHeight uint32                 `protobuf:"varint,16,opt,name=initialResolutionHeight,proto3" json:"initialResolutionHeight,omitempty"`
 	CreatedAt               *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 	UpdatedAt               *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	// the version of the app the instance runs, the default version of the app when
+	// creating an instance without one
+	AppVersionId uint64 `protobuf:"varint,19,opt,name=appVersionId,proto3" json:"appVersionId,omitempty"`
+	// the digest the tag of the app resolved to when the instance was created or
+	// upgraded, the image the instance runs
+	AppDockerImageDigest string `protobuf:"bytes,20,opt,name=appDockerImageDigest,proto3" json:"appDockerImageDigest,omitempty"`
+	// read-only, the outcome of the vulnerability policy for the image, "pass" or
+	// "warn", set in the replies of the creation and the upgrade of the instance
+	VulnerabilityVerdict string `protobuf:"bytes,21,opt,name=vulnerabilityVerdict,proto3" json:"vulnerabilityVerdict,omitempty"`
+	// read-only, set in the replies of the creation and the upgrade of the instance when
+	// its app is deprecated, and suggesting its replacement
+	LifecycleWarning string `protobuf:"bytes,22,opt,name=lifecycleWarning,proto3" json:"lifecycleWarning,omitempty"`
 }
 
 func (x *AppInstance) Reset() {
@@ -204,13 +216,41 @@ func (x *AppInstance) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *AppInstance) GetAppVersionId() uint64 {
+	if x != nil {
+		return x.AppVersionId
+	}
+	return 0
+}
+
+func (x *AppInstance) GetAppDockerImageDigest() string {
+	if x != nil {
+		return x.AppDockerImageDigest
+	}
+	return ""
+}
+
+func (x *AppInstance) GetVulnerabilityVerdict() string {
+	if x != nil {
+		return x.VulnerabilityVerdict
+	}
+	return ""
+}
+
+func (x *AppInstance) GetLifecycleWarning() string {
+	if x != nil {
+		return x.LifecycleWarning
+	}
+	return ""
+}
+
 var File_app_instance_proto protoreflect.FileDescriptor
 
 var file_app_instance_proto_rawDesc = []byte{
 	0x0a, 0x12, 0x61, 0x70, 0x70, 0x2d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x1a, 0x1f, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb3, 0x05,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xeb, 0x06,
 	0x0a, 0x0b, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x0e, 0x0a,
 	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a,
 	0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
@@ -254,8 +294,19 @@ var file_app_instance_proto_rawDesc = []byte{
 	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
 	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x3b, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x64, 0x41, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x14, 0x61, 0x70, 0x70, 0x44, 0x6f,
+	0x63, 0x6b, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x61, 0x70, 0x70, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x14, 0x76,
+	0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x56, 0x65, 0x72, 0x64,
+	0x69, 0x63, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x76, 0x75, 0x6c, 0x6e, 0x65,
+	0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x56, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x12,
+	0x2a, 0x0a, 0x10, 0x6c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x57, 0x61, 0x72, 0x6e,
+	0x69, 0x6e, 0x67, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6c, 0x69, 0x66, 0x65, 0x63,
+	0x79, 0x63, 0x6c, 0x65, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x42, 0x0a, 0x5a, 0x08, 0x2e,
+	0x3b, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -745,6 +745,1703 @@ func (*DeleteAppResult) Descriptor() ([]byte, []int) {
 	return file_app_service_proto_rawDescGZIP(), []int{14}
 }
 
+// List the versions of an App
+type ListAppVersionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppId uint64 `protobuf:"varint,1,opt,name=appId,proto3" json:"appId,omitempty"`
+}
+
+func (x *ListAppVersionsRequest) Reset() {
+	*x = ListAppVersionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAppVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAppVersionsRequest) ProtoMessage() {}
+
+func (x *ListAppVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAppVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListAppVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListAppVersionsRequest) GetAppId() uint64 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+type ListAppVersionsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *ListAppVersionsResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *ListAppVersionsReply) Reset() {
+	*x = ListAppVersionsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAppVersionsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAppVersionsReply) ProtoMessage() {}
+
+func (x *ListAppVersionsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAppVersionsReply.ProtoReflect.Descriptor instead.
+func (*ListAppVersionsReply) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListAppVersionsReply) GetResult() *ListAppVersionsResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type ListAppVersionsResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Versions []*AppImageVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+}
+
+func (x *ListAppVersionsResult) Reset() {
+	*x = ListAppVersionsResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAppVersionsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAppVersionsResult) ProtoMessage() {}
+
+func (x *ListAppVersionsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAppVersionsResult.ProtoReflect.Descriptor instead.
+func (*ListAppVersionsResult) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListAppVersionsResult) GetVersions() []*AppImageVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+// Create App Version
+type CreateAppVersionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppId             uint64 `protobuf:"varint,1,opt,name=appId,proto3" json:"appId,omitempty"`
+	DockerImageTag    string `protobuf:"bytes,2,opt,name=dockerImageTag,proto3" json:"dockerImageTag,omitempty"`
+	DockerImageDigest string `protobuf:"bytes,3,opt,name=dockerImageDigest,proto3" json:"dockerImageDigest,omitempty"`
+	ReleaseNotes      string `protobuf:"bytes,4,opt,name=releaseNotes,proto3" json:"releaseNotes,omitempty"`
+	IsDefault         bool   `protobuf:"varint,5,opt,name=isDefault,proto3" json:"isDefault,omitempty"`
+}
+
+func (x *CreateAppVersionRequest) Reset() {
+	*x = CreateAppVersionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAppVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAppVersionRequest) ProtoMessage() {}
+
+func (x *CreateAppVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAppVersionRequest.ProtoReflect.Descriptor instead.
+func (*CreateAppVersionRequest) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateAppVersionRequest) GetAppId() uint64 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+func (x *CreateAppVersionRequest) GetDockerImageTag() string {
+	if x != nil {
+		return x.DockerImageTag
+	}
+	return ""
+}
+
+func (x *CreateAppVersionRequest) GetDockerImageDigest() string {
+	if x != nil {
+		return x.DockerImageDigest
+	}
+	return ""
+}
+
+func (x *CreateAppVersionRequest) GetReleaseNotes() string {
+	if x != nil {
+		return x.ReleaseNotes
+	}
+	return ""
+}
+
+func (x *CreateAppVersionRequest) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+type CreateAppVersionReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *CreateAppVersionResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *CreateAppVersionReply) Reset() {
+	*x = CreateAppVersionReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAppVersionReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAppVersionReply) ProtoMessage() {}
+
+func (x *CreateAppVersionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAppVersionReply.ProtoReflect.Descriptor instead.
+func (*CreateAppVersionReply) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateAppVersionReply) GetResult() *CreateAppVersionResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type CreateAppVersionResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version *AppImageVersion `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *CreateAppVersionResult) Reset() {
+	*x = CreateAppVersionResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAppVersionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAppVersionResult) ProtoMessage() {}
+
+func (x *CreateAppVersionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAppVersionResult.ProtoReflect.Descriptor instead.
+func (*CreateAppVersionResult) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateAppVersionResult) GetVersion() *AppImageVersion {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+// Update App Version
+type UpdateAppVersionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppId        uint64 `protobuf:"varint,1,opt,name=appId,proto3" json:"appId,omitempty"`
+	Id           uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	ReleaseNotes string `protobuf:"bytes,3,opt,name=releaseNotes,proto3" json:"releaseNotes,omitempty"`
+	IsDefault    bool   `protobuf:"varint,4,opt,name=isDefault,proto3" json:"isDefault,omitempty"`
+	Deprecated   bool   `protobuf:"varint,5,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+}
+
+func (x *UpdateAppVersionRequest) Reset() {
+	*x = UpdateAppVersionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateAppVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAppVersionRequest) ProtoMessage() {}
+
+func (x *UpdateAppVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAppVersionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAppVersionRequest) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UpdateAppVersionRequest) GetAppId() uint64 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+func (x *UpdateAppVersionRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateAppVersionRequest) GetReleaseNotes() string {
+	if x != nil {
+		return x.ReleaseNotes
+	}
+	return ""
+}
+
+func (x *UpdateAppVersionRequest) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *UpdateAppVersionRequest) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+type UpdateAppVersionReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *UpdateAppVersionResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *UpdateAppVersionReply) Reset() {
+	*x = UpdateAppVersionReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateAppVersionReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAppVersionReply) ProtoMessage() {}
+
+func (x *UpdateAppVersionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAppVersionReply.ProtoReflect.Descriptor instead.
+func (*UpdateAppVersionReply) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateAppVersionReply) GetResult() *UpdateAppVersionResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type UpdateAppVersionResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version *AppImageVersion `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *UpdateAppVersionResult) Reset() {
+	*x = UpdateAppVersionResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateAppVersionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAppVersionResult) ProtoMessage() {}
+
+func (x *UpdateAppVersionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAppVersionResult.ProtoReflect.Descriptor instead.
+func (*UpdateAppVersionResult) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateAppVersionResult) GetVersion() *AppImageVersion {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+// List the custom apps of a Workspace
+type ListWorkspaceAppsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId uint64 `protobuf:"varint,1,opt,name=workspaceId,proto3" json:"workspaceId,omitempty"`
+}
+
+func (x *ListWorkspaceAppsRequest) Reset() {
+	*x = ListWorkspaceAppsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWorkspaceAppsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkspaceAppsRequest) ProtoMessage() {}
+
+func (x *ListWorkspaceAppsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkspaceAppsRequest.ProtoReflect.Descriptor instead.
+func (*ListWorkspaceAppsRequest) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListWorkspaceAppsRequest) GetWorkspaceId() uint64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+type ListWorkspaceAppsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *ListWorkspaceAppsResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *ListWorkspaceAppsReply) Reset() {
+	*x = ListWorkspaceAppsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWorkspaceAppsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkspaceAppsReply) ProtoMessage() {}
+
+func (x *ListWorkspaceAppsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkspaceAppsReply.ProtoReflect.Descriptor instead.
+func (*ListWorkspaceAppsReply) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListWorkspaceAppsReply) GetResult() *ListWorkspaceAppsResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type ListWorkspaceAppsResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Apps []*App `protobuf:"bytes,1,rep,name=apps,proto3" json:"apps,omitempty"`
+}
+
+func (x *ListWorkspaceAppsResult) Reset() {
+	*x = ListWorkspaceAppsResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWorkspaceAppsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkspaceAppsResult) ProtoMessage() {}
+
+func (x *ListWorkspaceAppsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkspaceAppsResult.ProtoReflect.Descriptor instead.
+func (*ListWorkspaceAppsResult) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListWorkspaceAppsResult) GetApps() []*App {
+	if x != nil {
+		return x.Apps
+	}
+	return nil
+}
+
+// Create a custom App in a Workspace
+type CreateWorkspaceAppRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId uint64 `protobuf:"varint,1,opt,name=workspaceId,proto3" json:"workspaceId,omitempty"`
+	App         *App   `protobuf:"bytes,2,opt,name=app,proto3" json:"app,omitempty"`
+}
+
+func (x *CreateWorkspaceAppRequest) Reset() {
+	*x = CreateWorkspaceAppRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateWorkspaceAppRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWorkspaceAppRequest) ProtoMessage() {}
+
+func (x *CreateWorkspaceAppRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWorkspaceAppRequest.ProtoReflect.Descriptor instead.
+func (*CreateWorkspaceAppRequest) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreateWorkspaceAppRequest) GetWorkspaceId() uint64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *CreateWorkspaceAppRequest) GetApp() *App {
+	if x != nil {
+		return x.App
+	}
+	return nil
+}
+
+type CreateWorkspaceAppReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *CreateWorkspaceAppResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *CreateWorkspaceAppReply) Reset() {
+	*x = CreateWorkspaceAppReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateWorkspaceAppReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWorkspaceAppReply) ProtoMessage() {}
+
+func (x *CreateWorkspaceAppReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWorkspaceAppReply.ProtoReflect.Descriptor instead.
+func (*CreateWorkspaceAppReply) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CreateWorkspaceAppReply) GetResult() *CreateWorkspaceAppResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type CreateWorkspaceAppResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	App *App `protobuf:"bytes,1,opt,name=app,proto3" json:"app,omitempty"`
+}
+
+func (x *CreateWorkspaceAppResult) Reset() {
+	*x = CreateWorkspaceAppResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateWorkspaceAppResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWorkspaceAppResult) ProtoMessage() {}
+
+func (x *CreateWorkspaceAppResult) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWorkspaceAppResult.ProtoReflect.Descriptor instead.
+func (*CreateWorkspaceAppResult) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CreateWorkspaceAppResult) GetApp() *App {
+	if x != nil {
+		return x.App
+	}
+	return nil
+}
+
+// Delete a custom App of a Workspace
+type DeleteWorkspaceAppRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId uint64 `protobuf:"varint,1,opt,name=workspaceId,proto3" json:"workspaceId,omitempty"`
+	Id          uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteWorkspaceAppRequest) Reset() {
+	*x = DeleteWorkspaceAppRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteWorkspaceAppRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWorkspaceAppRequest) ProtoMessage() {}
+
+func (x *DeleteWorkspaceAppRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWorkspaceAppRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWorkspaceAppRequest) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DeleteWorkspaceAppRequest) GetWorkspaceId() uint64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *DeleteWorkspaceAppRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteWorkspaceAppReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *DeleteWorkspaceAppResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *DeleteWorkspaceAppReply) Reset() {
+	*x = DeleteWorkspaceAppReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteWorkspaceAppReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWorkspaceAppReply) ProtoMessage() {}
+
+func (x *DeleteWorkspaceAppReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWorkspaceAppReply.ProtoReflect.Descriptor instead.
+func (*DeleteWorkspaceAppReply) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DeleteWorkspaceAppReply) GetResult() *DeleteWorkspaceAppResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type DeleteWorkspaceAppResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteWorkspaceAppResult) Reset() {
+	*x = DeleteWorkspaceAppResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteWorkspaceAppResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWorkspaceAppResult) ProtoMessage() {}
+
+func (x *DeleteWorkspaceAppResult) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWorkspaceAppResult.ProtoReflect.Descriptor instead.
+func (*DeleteWorkspaceAppResult) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{32}
+}
+
+// Get the review of an App
+type GetAppReviewRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppId uint64 `protobuf:"varint,1,opt,name=appId,proto3" json:"appId,omitempty"`
+}
+
+func (x *GetAppReviewRequest) Reset() {
+	*x = GetAppReviewRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAppReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppReviewRequest) ProtoMessage() {}
+
+func (x *GetAppReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppReviewRequest.ProtoReflect.Descriptor instead.
+func (*GetAppReviewRequest) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetAppReviewRequest) GetAppId() uint64 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+type GetAppReviewReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *GetAppReviewResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *GetAppReviewReply) Reset() {
+	*x = GetAppReviewReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAppReviewReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppReviewReply) ProtoMessage() {}
+
+func (x *GetAppReviewReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppReviewReply.ProtoReflect.Descriptor instead.
+func (*GetAppReviewReply) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetAppReviewReply) GetResult() *GetAppReviewResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type GetAppReviewResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Review *AppReview `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
+}
+
+func (x *GetAppReviewResult) Reset() {
+	*x = GetAppReviewResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAppReviewResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppReviewResult) ProtoMessage() {}
+
+func (x *GetAppReviewResult) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppReviewResult.ProtoReflect.Descriptor instead.
+func (*GetAppReviewResult) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetAppReviewResult) GetReview() *AppReview {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+// Start the review of an App
+type StartAppReviewRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppId       uint64   `protobuf:"varint,1,opt,name=appId,proto3" json:"appId,omitempty"`
+	ReviewerIds []uint64 `protobuf:"varint,2,rep,packed,name=reviewerIds,proto3" json:"reviewerIds,omitempty"`
+	Comment     string   `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *StartAppReviewRequest) Reset() {
+	*x = StartAppReviewRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartAppReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartAppReviewRequest) ProtoMessage() {}
+
+func (x *StartAppReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartAppReviewRequest.ProtoReflect.Descriptor instead.
+func (*StartAppReviewRequest) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *StartAppReviewRequest) GetAppId() uint64 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+func (x *StartAppReviewRequest) GetReviewerIds() []uint64 {
+	if x != nil {
+		return x.ReviewerIds
+	}
+	return nil
+}
+
+func (x *StartAppReviewRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type StartAppReviewReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *StartAppReviewResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *StartAppReviewReply) Reset() {
+	*x = StartAppReviewReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartAppReviewReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartAppReviewReply) ProtoMessage() {}
+
+func (x *StartAppReviewReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartAppReviewReply.ProtoReflect.Descriptor instead.
+func (*StartAppReviewReply) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *StartAppReviewReply) GetResult() *StartAppReviewResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type StartAppReviewResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Review *AppReview `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
+}
+
+func (x *StartAppReviewResult) Reset() {
+	*x = StartAppReviewResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartAppReviewResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartAppReviewResult) ProtoMessage() {}
+
+func (x *StartAppReviewResult) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartAppReviewResult.ProtoReflect.Descriptor instead.
+func (*StartAppReviewResult) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *StartAppReviewResult) GetReview() *AppReview {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+// Approve an App, or request changes to it
+type DecideAppReviewRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppId   uint64 `protobuf:"varint,1,opt,name=appId,proto3" json:"appId,omitempty"`
+	Approve bool   `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"`
+	Comment string `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *DecideAppReviewRequest) Reset() {
+	*x = DecideAppReviewRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecideAppReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecideAppReviewRequest) ProtoMessage() {}
+
+func (x *DecideAppReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecideAppReviewRequest.ProtoReflect.Descriptor instead.
+func (*DecideAppReviewRequest) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DecideAppReviewRequest) GetAppId() uint64 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+func (x *DecideAppReviewRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+func (x *DecideAppReviewRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type DecideAppReviewReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *DecideAppReviewResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *DecideAppReviewReply) Reset() {
+	*x = DecideAppReviewReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecideAppReviewReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecideAppReviewReply) ProtoMessage() {}
+
+func (x *DecideAppReviewReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecideAppReviewReply.ProtoReflect.Descriptor instead.
+func (*DecideAppReviewReply) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DecideAppReviewReply) GetResult() *DecideAppReviewResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type DecideAppReviewResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Review *AppReview `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
+}
+
+func (x *DecideAppReviewResult) Reset() {
+	*x = DecideAppReviewResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecideAppReviewResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecideAppReviewResult) ProtoMessage() {}
+
+func (x *DecideAppReviewResult) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecideAppReviewResult.ProtoReflect.Descriptor instead.
+func (*DecideAppReviewResult) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DecideAppReviewResult) GetReview() *AppReview {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+// Comment the review of an App
+type CommentAppReviewRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppId   uint64 `protobuf:"varint,1,opt,name=appId,proto3" json:"appId,omitempty"`
+	Comment string `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *CommentAppReviewRequest) Reset() {
+	*x = CommentAppReviewRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommentAppReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentAppReviewRequest) ProtoMessage() {}
+
+func (x *CommentAppReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentAppReviewRequest.ProtoReflect.Descriptor instead.
+func (*CommentAppReviewRequest) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CommentAppReviewRequest) GetAppId() uint64 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+func (x *CommentAppReviewRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type CommentAppReviewReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *CommentAppReviewResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *CommentAppReviewReply) Reset() {
+	*x = CommentAppReviewReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommentAppReviewReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentAppReviewReply) ProtoMessage() {}
+
+func (x *CommentAppReviewReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentAppReviewReply.ProtoReflect.Descriptor instead.
+func (*CommentAppReviewReply) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CommentAppReviewReply) GetResult() *CommentAppReviewResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type CommentAppReviewResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Review *AppReview `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
+}
+
+func (x *CommentAppReviewResult) Reset() {
+	*x = CommentAppReviewResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommentAppReviewResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentAppReviewResult) ProtoMessage() {}
+
+func (x *CommentAppReviewResult) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentAppReviewResult.ProtoReflect.Descriptor instead.
+func (*CommentAppReviewResult) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CommentAppReviewResult) GetReview() *AppReview {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+// Deprecate, retire or reinstate an App
+type UpdateAppLifecycleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppId            uint64 `protobuf:"varint,1,opt,name=appId,proto3" json:"appId,omitempty"`
+	LifecycleStatus  string `protobuf:"bytes,2,opt,name=lifecycleStatus,proto3" json:"lifecycleStatus,omitempty"`
+	ReplacementAppId uint64 `protobuf:"varint,3,opt,name=replacementAppId,proto3" json:"replacementAppId,omitempty"`
+	Comment          string `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *UpdateAppLifecycleRequest) Reset() {
+	*x = UpdateAppLifecycleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateAppLifecycleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAppLifecycleRequest) ProtoMessage() {}
+
+func (x *UpdateAppLifecycleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAppLifecycleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAppLifecycleRequest) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UpdateAppLifecycleRequest) GetAppId() uint64 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+func (x *UpdateAppLifecycleRequest) GetLifecycleStatus() string {
+	if x != nil {
+		return x.LifecycleStatus
+	}
+	return ""
+}
+
+func (x *UpdateAppLifecycleRequest) GetReplacementAppId() uint64 {
+	if x != nil {
+		return x.ReplacementAppId
+	}
+	return 0
+}
+
+func (x *UpdateAppLifecycleRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type UpdateAppLifecycleReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *UpdateAppLifecycleResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *UpdateAppLifecycleReply) Reset() {
+	*x = UpdateAppLifecycleReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateAppLifecycleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAppLifecycleReply) ProtoMessage() {}
+
+func (x *UpdateAppLifecycleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAppLifecycleReply.ProtoReflect.Descriptor instead.
+func (*UpdateAppLifecycleReply) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *UpdateAppLifecycleReply) GetResult() *UpdateAppLifecycleResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type UpdateAppLifecycleResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Review *AppReview `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
+}
+
+func (x *UpdateAppLifecycleResult) Reset() {
+	*x = UpdateAppLifecycleResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_service_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateAppLifecycleResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAppLifecycleResult) ProtoMessage() {}
+
+func (x *UpdateAppLifecycleResult) ProtoReflect() protoreflect.Message {
+	mi := &file_app_service_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAppLifecycleResult.ProtoReflect.Descriptor instead.
+func (*UpdateAppLifecycleResult) Descriptor() ([]byte, []int) {
+	return file_app_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *UpdateAppLifecycleResult) GetReview() *AppReview {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
 var File_app_service_proto protoreflect.FileDescriptor
 
 var file_app_service_proto_rawDesc = []byte{
@@ -815,77 +2512,429 @@ var file_app_service_proto_rawDesc = []byte{
 	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73,
 	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x70, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
 	0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x11, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x41, 0x70, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x32, 0xa6, 0x07, 0x0a, 0x0a,
-	0x41, 0x70, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x8d, 0x01, 0x0a, 0x06, 0x47,
-	0x65, 0x74, 0x41, 0x70, 0x70, 0x12, 0x15, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x47,
-	0x65, 0x74, 0x41, 0x70, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x63,
-	0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x57, 0x92, 0x41, 0x36, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x0a, 0x47, 0x65, 0x74, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x70, 0x70, 0x1a, 0x1c,
-	0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x72, 0x65,
-	0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x70, 0x70, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31,
-	0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x95, 0x01, 0x0a, 0x08, 0x4c,
-	0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x73, 0x12, 0x17, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x15, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70,
-	0x70, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x59, 0x92, 0x41, 0x3d, 0x0a, 0x0a, 0x41, 0x70,
-	0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x61,
-	0x70, 0x70, 0x73, 0x1a, 0x24, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x20, 0x6c, 0x69, 0x73,
-	0x74, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x70, 0x70, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12,
-	0x11, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70,
-	0x70, 0x73, 0x12, 0x8a, 0x01, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70,
-	0x12, 0x0b, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x1a, 0x16, 0x2e,
-	0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x58, 0x92, 0x41, 0x39, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x61,
+	0x74, 0x65, 0x41, 0x70, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x2e, 0x0a, 0x16, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x22, 0x4d, 0x0a, 0x14, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x4c, 0x0a, 0x15, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x33, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x41,
+	0x70, 0x70, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xc7, 0x01, 0x0a, 0x17, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x64, 0x6f,
+	0x63, 0x6b, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x54, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x54,
+	0x61, 0x67, 0x12, 0x2c, 0x0a, 0x11, 0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x64,
+	0x6f, 0x63, 0x6b, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74,
+	0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e,
+	0x6f, 0x74, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x44, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x22, 0x4f, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x36, 0x0a, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x68,
+	0x6f, 0x72, 0x75, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x22, 0x4b, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x31, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0xa1, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x61, 0x70, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x61, 0x70, 0x70,
+	0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x6f, 0x74,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x44, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x44, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x22, 0x4f, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70,
+	0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x36, 0x0a,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x4b, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41,
+	0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x31, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x3c, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x41, 0x70, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64,
+	0x22, 0x51, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x41, 0x70, 0x70, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x37, 0x0a, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x68, 0x6f,
+	0x72, 0x75, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x41, 0x70, 0x70, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x22, 0x3a, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x41, 0x70, 0x70, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1f,
+	0x0a, 0x04, 0x61, 0x70, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x63,
+	0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x52, 0x04, 0x61, 0x70, 0x70, 0x73, 0x22,
+	0x5c, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x41, 0x70, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x03, 0x61, 0x70, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x63, 0x68,
+	0x6f, 0x72, 0x75, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x52, 0x03, 0x61, 0x70, 0x70, 0x22, 0x53, 0x0a,
+	0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x41, 0x70, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x38, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75,
+	0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x41, 0x70, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x22, 0x39, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x41, 0x70, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1d,
+	0x0a, 0x03, 0x61, 0x70, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x63, 0x68,
+	0x6f, 0x72, 0x75, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x52, 0x03, 0x61, 0x70, 0x70, 0x22, 0x4d, 0x0a,
+	0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x41, 0x70, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x77, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x53, 0x0a, 0x17,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x41,
+	0x70, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x38, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x41, 0x70, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x22, 0x1a, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x41, 0x70, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x2b, 0x0a,
+	0x13, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x22, 0x47, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x41, 0x70, 0x70, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x32, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x22, 0x3f, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x68, 0x6f, 0x72,
+	0x75, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x06, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x22, 0x69, 0x0a, 0x15, 0x53, 0x74, 0x61, 0x72, 0x74, 0x41, 0x70, 0x70,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x61, 0x70,
+	0x70, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49,
+	0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22,
+	0x4b, 0x0a, 0x13, 0x53, 0x74, 0x61, 0x72, 0x74, 0x41, 0x70, 0x70, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x34, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x41, 0x70, 0x70, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x41, 0x0a, 0x14,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x41, 0x70, 0x70, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x41, 0x70,
+	0x70, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22,
+	0x62, 0x0a, 0x16, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x41, 0x70, 0x70, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x22, 0x4d, 0x0a, 0x14, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x41, 0x70, 0x70,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x68,
+	0x6f, 0x72, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x41, 0x70, 0x70, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x22, 0x42, 0x0a, 0x15, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x41, 0x70, 0x70, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x68,
+	0x6f, 0x72, 0x75, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x06,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x49, 0x0a, 0x17, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x41, 0x70, 0x70, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x22, 0x4f, 0x0a, 0x15, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x70, 0x70, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x36, 0x0a, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x68, 0x6f,
+	0x72, 0x75, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x70, 0x70, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x22, 0x43, 0x0a, 0x16, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x70, 0x70,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x29, 0x0a, 0x06,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63,
+	0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52,
+	0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0xa1, 0x01, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x41, 0x70, 0x70, 0x4c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x6c,
+	0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x41, 0x70, 0x70, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x10, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x70, 0x70, 0x49,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x53, 0x0a, 0x17, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x4c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x38, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x4c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x22, 0x45, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x4c, 0x69, 0x66,
+	0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x29, 0x0a, 0x06,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63,
+	0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52,
+	0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x32, 0xe5, 0x1d, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x8d, 0x01, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x41, 0x70,
+	0x70, 0x12, 0x15, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x57, 0x92,
+	0x41, 0x36, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x0a,
+	0x47, 0x65, 0x74, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x70, 0x70, 0x1a, 0x1c, 0x54, 0x68, 0x69, 0x73,
+	0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x73, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x70, 0x70, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70,
+	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x95, 0x01, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x70, 0x70, 0x73, 0x12, 0x17, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x70, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x63,
+	0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x73, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x59, 0x92, 0x41, 0x3d, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x61, 0x70, 0x70, 0x73, 0x1a,
+	0x24, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x72,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66,
+	0x20, 0x61, 0x70, 0x70, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x12, 0x8a,
+	0x01, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x12, 0x0b, 0x2e, 0x63,
+	0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x1a, 0x16, 0x2e, 0x63, 0x68, 0x6f, 0x72,
+	0x75, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x58, 0x92, 0x41, 0x39, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x70,
+	0x70, 0x1a, 0x1c, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x73, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x70, 0x70, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a, 0x22, 0x11, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72,
+	0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x12, 0xb9, 0x01, 0x0a, 0x0e,
+	0x42, 0x75, 0x6c, 0x6b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x73, 0x12, 0x1d,
+	0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x41, 0x70, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x41, 0x70, 0x70, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x6b, 0x92, 0x41, 0x47, 0x0a,
+	0x0a, 0x41, 0x70, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x14, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x20, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x20, 0x61, 0x70, 0x70,
+	0x73, 0x1a, 0x23, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x73, 0x20, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c,
+	0x65, 0x20, 0x61, 0x70, 0x70, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x22,
+	0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70,
+	0x70, 0x73, 0x2f, 0x62, 0x75, 0x6c, 0x6b, 0x12, 0x8a, 0x01, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x41, 0x70, 0x70, 0x12, 0x0b, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x41,
+	0x70, 0x70, 0x1a, 0x16, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x41, 0x70, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x58, 0x92, 0x41, 0x39, 0x0a,
+	0x0a, 0x41, 0x70, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x0d, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x70, 0x70, 0x1a, 0x1c, 0x54, 0x68, 0x69, 0x73,
+	0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x73, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x70, 0x70, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01,
+	0x2a, 0x1a, 0x11, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f,
+	0x61, 0x70, 0x70, 0x73, 0x12, 0xe9, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75,
+	0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75,
+	0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x97, 0x01, 0x92, 0x41, 0x6a, 0x0a, 0x0a, 0x41, 0x70,
+	0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61,
+	0x6e, 0x20, 0x61, 0x70, 0x70, 0x1a, 0x3f, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x6e, 0x20,
+	0x61, 0x70, 0x70, 0x2c, 0x20, 0x6d, 0x6f, 0x73, 0x74, 0x20, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74,
+	0x20, 0x66, 0x69, 0x72, 0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f,
+	0x7b, 0x61, 0x70, 0x70, 0x49, 0x64, 0x7d, 0x2f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0xcf, 0x01, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x7b, 0x92, 0x41, 0x4b, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x61,
+	0x6e, 0x20, 0x61, 0x70, 0x70, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x26, 0x54,
+	0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x61, 0x64, 0x64,
+	0x73, 0x20, 0x61, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x6f, 0x20, 0x61,
+	0x6e, 0x20, 0x61, 0x70, 0x70, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x3a, 0x01, 0x2a, 0x22, 0x22,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70,
+	0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70, 0x49, 0x64, 0x7d, 0x2f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x90, 0x02, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75,
+	0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xbb, 0x01, 0x92, 0x41, 0x85, 0x01, 0x0a, 0x0a,
+	0x41, 0x70, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x15, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x70, 0x70, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x1a, 0x60, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x20, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x20, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x6e,
+	0x20, 0x61, 0x70, 0x70, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x2c, 0x20, 0x6d, 0x61,
+	0x6b, 0x65, 0x73, 0x20, 0x69, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x20, 0x6f, 0x72, 0x20, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x73,
+	0x20, 0x69, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x3a, 0x01, 0x2a, 0x1a, 0x27, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f,
+	0x7b, 0x61, 0x70, 0x70, 0x49, 0x64, 0x7d, 0x2f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x99, 0x01, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x41, 0x70, 0x70, 0x12, 0x18, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x41, 0x70, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x70, 0x70,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x5a, 0x92, 0x41, 0x39, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x61,
 	0x6e, 0x20, 0x61, 0x70, 0x70, 0x1a, 0x1c, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x73, 0x20, 0x61, 0x6e, 0x20,
-	0x61, 0x70, 0x70, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a, 0x22, 0x11, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x12,
-	0xb9, 0x01, 0x0a, 0x0e, 0x42, 0x75, 0x6c, 0x6b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70,
-	0x70, 0x73, 0x12, 0x1d, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x42, 0x75, 0x6c, 0x6b,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x6b,
-	0x92, 0x41, 0x47, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65,
-	0x20, 0x61, 0x70, 0x70, 0x73, 0x1a, 0x23, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x73, 0x20, 0x6d, 0x75, 0x6c,
-	0x74, 0x69, 0x70, 0x6c, 0x65, 0x20, 0x61, 0x70, 0x70, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b,
-	0x3a, 0x01, 0x2a, 0x22, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76,
-	0x31, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x62, 0x75, 0x6c, 0x6b, 0x12, 0x8a, 0x01, 0x0a, 0x09,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x12, 0x0b, 0x2e, 0x63, 0x68, 0x6f, 0x72,
-	0x75, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x1a, 0x16, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x58,
-	0x92, 0x41, 0x39, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x70, 0x70, 0x1a, 0x1c,
-	0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x73, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x70, 0x70, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x16, 0x3a, 0x01, 0x2a, 0x1a, 0x11, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74,
-	0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x12, 0x99, 0x01, 0x0a, 0x09, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x41, 0x70, 0x70, 0x12, 0x18, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x70, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x16, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x41, 0x70, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x5a, 0x92, 0x41, 0x39, 0x0a, 0x0a, 0x41,
-	0x70, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x70, 0x70, 0x1a, 0x1c, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65,
-	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x20,
-	0x61, 0x6e, 0x20, 0x61, 0x70, 0x70, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x2a, 0x16, 0x2f, 0x61,
+	0x6f, 0x69, 0x6e, 0x74, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x20, 0x61, 0x6e, 0x20,
+	0x61, 0x70, 0x70, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x2a, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x7b, 0x69, 0x64,
+	0x7d, 0x12, 0x97, 0x02, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x41, 0x70, 0x70, 0x73, 0x12, 0x20, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x41, 0x70,
+	0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x68, 0x6f, 0x72,
+	0x75, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x41, 0x70, 0x70, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xbf, 0x01, 0x92, 0x41, 0x89, 0x01,
+	0x0a, 0x0a, 0x41, 0x70, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x23, 0x4c, 0x69,
+	0x73, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x20, 0x61, 0x70,
+	0x70, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x1a, 0x56, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x20, 0x61, 0x70, 0x70, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x77, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2c, 0x20, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x69,
+	0x6e, 0x67, 0x20, 0x74, 0x68, 0x6f, 0x73, 0x65, 0x20, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x20, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12,
+	0x2a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x49, 0x64, 0x7d, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x12, 0xcd, 0x02, 0x0a, 0x12,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x41,
+	0x70, 0x70, 0x12, 0x21, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x41, 0x70, 0x70, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x41, 0x70,
+	0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xf2, 0x01, 0x92, 0x41, 0xb7, 0x01, 0x0a, 0x0a, 0x41,
+	0x70, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x22, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x20, 0x61, 0x20, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x20, 0x61, 0x70, 0x70, 0x20, 0x69,
+	0x6e, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x1a, 0x84, 0x01,
+	0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x73, 0x20, 0x61, 0x6e, 0x20, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x20, 0x61, 0x73, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x70, 0x70, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x20,
+	0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x6c, 0x61, 0x75, 0x6e, 0x63,
+	0x68, 0x61, 0x62, 0x6c, 0x65, 0x20, 0x69, 0x6e, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x2c, 0x20, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x73, 0x20, 0x69, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x3a, 0x03, 0x61, 0x70, 0x70, 0x22,
+	0x2a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x49, 0x64, 0x7d, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x12, 0xf8, 0x01, 0x0a, 0x12,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x41,
+	0x70, 0x70, 0x12, 0x21, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x41, 0x70, 0x70, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x41, 0x70,
+	0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x9d, 0x01, 0x92, 0x41, 0x63, 0x0a, 0x0a, 0x41, 0x70,
+	0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x22, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x20, 0x61, 0x20, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x20, 0x61, 0x70, 0x70, 0x20, 0x6f, 0x66,
+	0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x1a, 0x31, 0x54, 0x68,
+	0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x73, 0x20, 0x61, 0x20, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x20, 0x61, 0x70, 0x70,
+	0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x31, 0x2a, 0x2f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74,
+	0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x2f, 0x7b,
+	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x7d, 0x2f, 0x61, 0x70, 0x70,
+	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x85, 0x02, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41, 0x70,
+	0x70, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x1b, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x70, 0x70, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0xbc, 0x01, 0x92, 0x41, 0x90, 0x01, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x18, 0x47, 0x65, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x70, 0x70, 0x1a, 0x68, 0x54, 0x68,
+	0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x72, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c,
+	0x65, 0x20, 0x73, 0x74, 0x61, 0x67, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x70,
+	0x70, 0x2c, 0x20, 0x69, 0x74, 0x73, 0x20, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73,
+	0x20, 0x61, 0x6e, 0x64, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x20, 0x6f,
+	0x66, 0x20, 0x69, 0x74, 0x73, 0x20, 0x6c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x20,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x61,
 	0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f,
-	0x7b, 0x69, 0x64, 0x7d, 0x42, 0xa7, 0x01, 0x92, 0x41, 0x99, 0x01, 0x12, 0x70, 0x0a, 0x12, 0x63,
-	0x68, 0x6f, 0x72, 0x75, 0x73, 0x20, 0x61, 0x70, 0x70, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x22, 0x55, 0x0a, 0x12, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x20, 0x61, 0x70, 0x70, 0x20,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2c, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f,
-	0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x43, 0x48, 0x4f, 0x52,
-	0x55, 0x53, 0x2d, 0x54, 0x52, 0x45, 0x2f, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2d, 0x62, 0x61,
-	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x1a, 0x11, 0x64, 0x65, 0x76, 0x40, 0x63, 0x68, 0x6f, 0x72, 0x75,
-	0x73, 0x2d, 0x74, 0x72, 0x65, 0x2e, 0x63, 0x68, 0x32, 0x03, 0x31, 0x2e, 0x30, 0x2a, 0x01, 0x01,
-	0x32, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73,
-	0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
-	0x6a, 0x73, 0x6f, 0x6e, 0x5a, 0x08, 0x2e, 0x3b, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x7b, 0x61, 0x70, 0x70, 0x49, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0xfa,
+	0x01, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x41, 0x70, 0x70, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x12, 0x1d, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x41, 0x70, 0x70, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x41,
+	0x70, 0x70, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xab, 0x01,
+	0x92, 0x41, 0x7d, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x1a, 0x53, 0x74, 0x61, 0x72, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x70, 0x70, 0x1a, 0x53, 0x54, 0x68, 0x69,
+	0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73,
+	0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x20,
+	0x61, 0x70, 0x70, 0x2c, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x6f, 0x66, 0x20, 0x77, 0x68, 0x6f, 0x6d,
+	0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x20, 0x69, 0x74,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x3a, 0x01, 0x2a, 0x22, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x7b, 0x61, 0x70,
+	0x70, 0x49, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x96, 0x02, 0x0a, 0x0f,
+	0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x41, 0x70, 0x70, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12,
+	0x1e, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x41,
+	0x70, 0x70, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x41,
+	0x70, 0x70, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xc4, 0x01,
+	0x92, 0x41, 0x8c, 0x01, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x1e, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x20, 0x6f, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x70, 0x70,
+	0x1a, 0x5e, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x61, 0x6c, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x70, 0x70, 0x20, 0x62,
+	0x79, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x69, 0x74, 0x73, 0x20, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x2c, 0x20, 0x6f, 0x72, 0x20, 0x61, 0x20, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x3a, 0x01, 0x2a, 0x22, 0x29, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x7b, 0x61, 0x70,
+	0x70, 0x49, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2f, 0x64, 0x65, 0x63, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0xec, 0x01, 0x0a, 0x10, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x41, 0x70, 0x70, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x1f, 0x2e, 0x63, 0x68, 0x6f, 0x72,
+	0x75, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x70, 0x70, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x68, 0x6f,
+	0x72, 0x75, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x70, 0x70, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x97, 0x01, 0x92, 0x41, 0x60, 0x0a,
+	0x0a, 0x41, 0x70, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1c, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x20,
+	0x6f, 0x66, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x70, 0x70, 0x1a, 0x34, 0x54, 0x68, 0x69, 0x73, 0x20,
+	0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x61, 0x64, 0x64, 0x73, 0x20, 0x61, 0x20,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x70, 0x70, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x3a, 0x01, 0x2a, 0x22, 0x29, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72,
+	0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70,
+	0x49, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0xf9, 0x01, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70,
+	0x70, 0x4c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x12, 0x21, 0x2e, 0x63, 0x68, 0x6f,
+	0x72, 0x75, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x4c, 0x69, 0x66,
+	0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70,
+	0x4c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x9e,
+	0x01, 0x92, 0x41, 0x6d, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x1e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6c, 0x69, 0x66,
+	0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x70, 0x70,
+	0x1a, 0x3f, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20,
+	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x73, 0x2c, 0x20, 0x72, 0x65, 0x74, 0x69,
+	0x72, 0x65, 0x73, 0x20, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x73, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x20, 0x61, 0x70,
+	0x70, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x3a, 0x01, 0x2a, 0x1a, 0x23, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x7b, 0x61,
+	0x70, 0x70, 0x49, 0x64, 0x7d, 0x2f, 0x6c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x42,
+	0xa7, 0x01, 0x92, 0x41, 0x99, 0x01, 0x12, 0x70, 0x0a, 0x12, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73,
+	0x20, 0x61, 0x70, 0x70, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0x55, 0x0a, 0x12,
+	0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x20, 0x61, 0x70, 0x70, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x2c, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x43, 0x48, 0x4f, 0x52, 0x55, 0x53, 0x2d, 0x54, 0x52,
+	0x45, 0x2f, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
+	0x1a, 0x11, 0x64, 0x65, 0x76, 0x40, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2d, 0x74, 0x72, 0x65,
+	0x2e, 0x63, 0x68, 0x32, 0x03, 0x31, 0x2e, 0x30, 0x2a, 0x01, 0x01, 0x32, 0x10, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x5a,
+	0x08, 0x2e, 0x3b, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -900,58 +2949,137 @@ func file_app_service_proto_rawDescGZIP() []byte {
 	return file_app_service_proto_rawDescData
 }
 
-var file_app_service_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_app_service_proto_msgTypes = make([]protoimpl.MessageInfo, 48)
 var file_app_service_proto_goTypes = []interface{}{
-	(*ListAppsRequest)(nil),       // 0: chorus.ListAppsRequest
-	(*ListAppsReply)(nil),         // 1: chorus.ListAppsReply
-	(*ListAppsResult)(nil),        // 2: chorus.ListAppsResult
-	(*GetAppRequest)(nil),         // 3: chorus.GetAppRequest
-	(*GetAppReply)(nil),           // 4: chorus.GetAppReply
-	(*GetAppResult)(nil),          // 5: chorus.GetAppResult
-	(*CreateAppReply)(nil),        // 6: chorus.CreateAppReply
-	(*CreateAppResult)(nil),       // 7: chorus.CreateAppResult
-	(*BulkCreateAppsRequest)(nil), // 8: chorus.BulkCreateAppsRequest
-	(*BulkCreateAppsReply)(nil),   // 9: chorus.BulkCreateAppsReply
-	(*UpdateAppReply)(nil),        // 10: chorus.UpdateAppReply
-	(*UpdateAppResult)(nil),       // 11: chorus.UpdateAppResult
-	(*DeleteAppRequest)(nil),      // 12: chorus.DeleteAppRequest
-	(*DeleteAppReply)(nil),        // 13: chorus.DeleteAppReply
-	(*DeleteAppResult)(nil),       // 14: chorus.DeleteAppResult
-	(*PaginationQuery)(nil),       // 15: chorus.PaginationQuery
-	(*PaginationResult)(nil),      // 16: chorus.PaginationResult
-	(*App)(nil),                   // 17: chorus.App
+	(*ListAppsRequest)(nil),           // 0: chorus.ListAppsRequest
+	(*ListAppsReply)(nil),             // 1: chorus.ListAppsReply
+	(*ListAppsResult)(nil),            // 2: chorus.ListAppsResult
+	(*GetAppRequest)(nil),             // 3: chorus.GetAppRequest
+	(*GetAppReply)(nil),               // 4: chorus.GetAppReply
+	(*GetAppResult)(nil),              // 5: chorus.GetAppResult
+	(*CreateAppReply)(nil),            // 6: chorus.CreateAppReply
+	(*CreateAppResult)(nil),           // 7: chorus.CreateAppResult
+	(*BulkCreateAppsRequest)(nil),     // 8: chorus.BulkCreateAppsRequest
+	(*BulkCreateAppsReply)(nil),       // 9: chorus.BulkCreateAppsReply
+	(*UpdateAppReply)(nil),            // 10: chorus.UpdateAppReply
+	(*UpdateAppResult)(nil),           // 11: chorus.UpdateAppResult
+	(*DeleteAppRequest)(nil),          // 12: chorus.DeleteAppRequest
+	(*DeleteAppReply)(nil),            // 13: chorus.DeleteAppReply
+	(*DeleteAppResult)(nil),           // 14: chorus.DeleteAppResult
+	(*ListAppVersionsRequest)(nil),    // 15: chorus.ListAppVersionsRequest
+	(*ListAppVersionsReply)(nil),      // 16: chorus.ListAppVersionsReply
+	(*ListAppVersionsResult)(nil),     // 17: chorus.ListAppVersionsResult
+	(*CreateAppVersionRequest)(nil),   // 18: chorus.CreateAppVersionRequest
+	(*CreateAppVersionReply)(nil),     // 19: chorus.CreateAppVersionReply
+	(*CreateAppVersionResult)(nil),    // 20: chorus.CreateAppVersionResult
+	(*UpdateAppVersionRequest)(nil),   // 21: chorus.UpdateAppVersionRequest
+	(*UpdateAppVersionReply)(nil),     // 22: chorus.UpdateAppVersionReply
+	(*UpdateAppVersionResult)(nil),    // 23: chorus.UpdateAppVersionResult
+	(*ListWorkspaceAppsRequest)(nil),  // 24: chorus.ListWorkspaceAppsRequest
+	(*ListWorkspaceAppsReply)(nil),    // 25: chorus.ListWorkspaceAppsReply
+	(*ListWorkspaceAppsResult)(nil),   // 26: chorus.ListWorkspaceAppsResult
+	(*CreateWorkspaceAppRequest)(nil), // 27: chorus.CreateWorkspaceAppRequest
+	(*CreateWorkspaceAppReply)(nil),   // 28: chorus.CreateWorkspaceAppReply
+	(*CreateWorkspaceAppResult)(nil),  // 29: chorus.CreateWorkspaceAppResult
+	(*DeleteWorkspaceAppRequest)(nil), // 30: chorus.DeleteWorkspaceAppRequest
+	(*DeleteWorkspaceAppReply)(nil),   // 31: chorus.DeleteWorkspaceAppReply
+	(*DeleteWorkspaceAppResult)(nil),  // 32: chorus.DeleteWorkspaceAppResult
+	(*GetAppReviewRequest)(nil),       // 33: chorus.GetAppReviewRequest
+	(*GetAppReviewReply)(nil),         // 34: chorus.GetAppReviewReply
+	(*GetAppReviewResult)(nil),        // 35: chorus.GetAppReviewResult
+	(*StartAppReviewRequest)(nil),     // 36: chorus.StartAppReviewRequest
+	(*StartAppReviewReply)(nil),       // 37: chorus.StartAppReviewReply
+	(*StartAppReviewResult)(nil),      // 38: chorus.StartAppReviewResult
+	(*DecideAppReviewRequest)(nil),    // 39: chorus.DecideAppReviewRequest
+	(*DecideAppReviewReply)(nil),      // 40: chorus.DecideAppReviewReply
+	(*DecideAppReviewResult)(nil),     // 41: chorus.DecideAppReviewResult
+	(*CommentAppReviewRequest)(nil),   // 42: chorus.CommentAppReviewRequest
+	(*CommentAppReviewReply)(nil),     // 43: chorus.CommentAppReviewReply
+	(*CommentAppReviewResult)(nil),    // 44: chorus.CommentAppReviewResult
+	(*UpdateAppLifecycleRequest)(nil), // 45: chorus.UpdateAppLifecycleRequest
+	(*UpdateAppLifecycleReply)(nil),   // 46: chorus.UpdateAppLifecycleReply
+	(*UpdateAppLifecycleResult)(nil),  // 47: chorus.UpdateAppLifecycleResult
+	(*PaginationQuery)(nil),           // 48: chorus.PaginationQuery
+	(*PaginationResult)(nil),          // 49: chorus.PaginationResult
+	(*App)(nil),                       // 50: chorus.App
+	(*AppImageVersion)(nil),           // 51: chorus.AppImageVersion
+	(*AppReview)(nil),                 // 52: chorus.AppReview
 }
 var file_app_service_proto_depIdxs = []int32{
-	15, // 0: chorus.ListAppsRequest.pagination:type_name -> chorus.PaginationQuery
+	48, // 0: chorus.ListAppsRequest.pagination:type_name -> chorus.PaginationQuery
 	2,  // 1: chorus.ListAppsReply.result:type_name -> chorus.ListAppsResult
-	16, // 2: chorus.ListAppsReply.pagination:type_name -> chorus.PaginationResult
-	17, // 3: chorus.ListAppsResult.apps:type_name -> chorus.App
+	49, // 2: chorus.ListAppsReply.pagination:type_name -> chorus.PaginationResult
+	50, // 3: chorus.ListAppsResult.apps:type_name -> chorus.App
 	5,  // 4: chorus.GetAppReply.result:type_name -> chorus.GetAppResult
-	17, // 5: chorus.GetAppResult.app:type_name -> chorus.App
+	50, // 5: chorus.GetAppResult.app:type_name -> chorus.App
 	7,  // 6: chorus.CreateAppReply.result:type_name -> chorus.CreateAppResult
-	17, // 7: chorus.CreateAppResult.app:type_name -> chorus.App
-	17, // 8: chorus.BulkCreateAppsRequest.apps:type_name -> chorus.App
-	17, // 9: chorus.BulkCreateAppsReply.apps:type_name -> chorus.App
+	50, // 7: chorus.CreateAppResult.app:type_name -> chorus.App
+	50, // 8: chorus.BulkCreateAppsRequest.apps:type_name -> chorus.App
+	50, // 9: chorus.BulkCreateAppsReply.apps:type_name -> chorus.App
 	11, // 10: chorus.UpdateAppReply.result:type_name -> chorus.UpdateAppResult
-	17, // 11: chorus.UpdateAppResult.app:type_name -> chorus.App
+	50, // 11: chorus.UpdateAppResult.app:type_name -> chorus.App
 	14, // 12: chorus.DeleteAppReply.result:type_name -> chorus.DeleteAppResult
-	3,  // 13: chorus.AppService.GetApp:input_type -> chorus.GetAppRequest
-	0,  // 14: chorus.AppService.ListApps:input_type -> chorus.ListAppsRequest
-	17, // 15: chorus.AppService.CreateApp:input_type -> chorus.App
-	8,  // 16: chorus.AppService.BulkCreateApps:input_type -> chorus.BulkCreateAppsRequest
-	17, // 17: chorus.AppService.UpdateApp:input_type -> chorus.App
-	12, // 18: chorus.AppService.DeleteApp:input_type -> chorus.DeleteAppRequest
-	4,  // 19: chorus.AppService.GetApp:output_type -> chorus.GetAppReply
-	1,  // 20: chorus.AppService.ListApps:output_type -> chorus.ListAppsReply
-	6,  // 21: chorus.AppService.CreateApp:output_type -> chorus.CreateAppReply
-	9,  // 22: chorus.AppService.BulkCreateApps:output_type -> chorus.BulkCreateAppsReply
-	10, // 23: chorus.AppService.UpdateApp:output_type -> chorus.UpdateAppReply
-	13, // 24: chorus.AppService.DeleteApp:output_type -> chorus.DeleteAppReply
-	19, // [19:25] is the sub-list for method output_type
-	13, // [13:19] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	17, // 13: chorus.ListAppVersionsReply.result:type_name -> chorus.ListAppVersionsResult
+	51, // 14: chorus.ListAppVersionsResult.versions:type_name -> chorus.AppImageVersion
+	20, // 15: chorus.CreateAppVersionReply.result:type_name -> chorus.CreateAppVersionResult
+	51, // 16: chorus.CreateAppVersionResult.version:type_name -> chorus.AppImageVersion
+	23, // 17: chorus.UpdateAppVersionReply.result:type_name -> chorus.UpdateAppVersionResult
+	51, // 18: chorus.UpdateAppVersionResult.version:type_name -> chorus.AppImageVersion
+	26, // 19: chorus.ListWorkspaceAppsReply.result:type_name -> chorus.ListWorkspaceAppsResult
+	50, // 20: chorus.ListWorkspaceAppsResult.apps:type_name -> chorus.App
+	50, // 21: chorus.CreateWorkspaceAppRequest.app:type_name -> chorus.App
+	29, // 22: chorus.CreateWorkspaceAppReply.result:type_name -> chorus.CreateWorkspaceAppResult
+	50, // 23: chorus.CreateWorkspaceAppResult.app:type_name -> chorus.App
+	32, // 24: chorus.DeleteWorkspaceAppReply.result:type_name -> chorus.DeleteWorkspaceAppResult
+	35, // 25: chorus.GetAppReviewReply.result:type_name -> chorus.GetAppReviewResult
+	52, // 26: chorus.GetAppReviewResult.review:type_name -> chorus.AppReview
+	38, // 27: chorus.StartAppReviewReply.result:type_name -> chorus.StartAppReviewResult
+	52, // 28: chorus.StartAppReviewResult.review:type_name -> chorus.AppReview
+	41, // 29: chorus.DecideAppReviewReply.result:type_name -> chorus.DecideAppReviewResult
+	52, // 30: chorus.DecideAppReviewResult.review:type_name -> chorus.AppReview
+	44, // 31: chorus.CommentAppReviewReply.result:type_name -> chorus.CommentAppReviewResult
+	52, // 32: chorus.CommentAppReviewResult.review:type_name -> chorus.AppReview
+	47, // 33: chorus.UpdateAppLifecycleReply.result:type_name -> chorus.UpdateAppLifecycleResult
+	52, // 34: chorus.UpdateAppLifecycleResult.review:type_name -> chorus.AppReview
+	3,  // 35: chorus.AppService.GetApp:input_type -> chorus.GetAppRequest
+	0,  // 36: chorus.AppService.ListApps:input_type -> chorus.ListAppsRequest
+	50, // 37: chorus.AppService.CreateApp:input_type -> chorus.App
+	8,  // 38: chorus.AppService.BulkCreateApps:input_type -> chorus.BulkCreateAppsRequest
+	50, // 39: chorus.AppService.UpdateApp:input_type -> chorus.App
+	15, // 40: chorus.AppService.ListAppVersions:input_type -> chorus.ListAppVersionsRequest
+	18, // 41: chorus.AppService.CreateAppVersion:input_type -> chorus.CreateAppVersionRequest
+	21, // 42: chorus.AppService.UpdateAppVersion:input_type -> chorus.UpdateAppVersionRequest
+	12, // 43: chorus.AppService.DeleteApp:input_type -> chorus.DeleteAppRequest
+	24, // 44: chorus.AppService.ListWorkspaceApps:input_type -> chorus.ListWorkspaceAppsRequest
+	27, // 45: chorus.AppService.CreateWorkspaceApp:input_type -> chorus.CreateWorkspaceAppRequest
+	30, // 46: chorus.AppService.DeleteWorkspaceApp:input_type -> chorus.DeleteWorkspaceAppRequest
+	33, // 47: chorus.AppService.GetAppReview:input_type -> chorus.GetAppReviewRequest
+	36, // 48: chorus.AppService.StartAppReview:input_type -> chorus.StartAppReviewRequest
+	39, // 49: chorus.AppService.DecideAppReview:input_type -> chorus.DecideAppReviewRequest
+	42, // 50: chorus.AppService.CommentAppReview:input_type -> chorus.CommentAppReviewRequest
+	45, // 51: chorus.AppService.UpdateAppLifecycle:input_type -> chorus.UpdateAppLifecycleRequest
+	4,  // 52: chorus.AppService.GetApp:output_type -> chorus.GetAppReply
+	1,  // 53: chorus.AppService.ListApps:output_type -> chorus.ListAppsReply
+	6,  // 54: chorus.AppService.CreateApp:output_type -> chorus.CreateAppReply
+	9,  // 55: chorus.AppService.BulkCreateApps:output_type -> chorus.BulkCreateAppsReply
+	10, // 56: chorus.AppService.UpdateApp:output_type -> chorus.UpdateAppReply
+	16, // 57: chorus.AppService.ListAppVersions:output_type -> chorus.ListAppVersionsReply
+	19, // 58: chorus.AppService.CreateAppVersion:output_type -> chorus.CreateAppVersionReply
+	22, // 59: chorus.AppService.UpdateAppVersion:output_type -> chorus.UpdateAppVersionReply
+	13, // 60: chorus.AppService.DeleteApp:output_type -> chorus.DeleteAppReply
+	25, // 61: chorus.AppService.ListWorkspaceApps:output_type -> chorus.ListWorkspaceAppsReply
+	28, // 62: chorus.AppService.CreateWorkspaceApp:output_type -> chorus.CreateWorkspaceAppReply
+	31, // 63: chorus.AppService.DeleteWorkspaceApp:output_type -> chorus.DeleteWorkspaceAppReply
+	34, // 64: chorus.AppService.GetAppReview:output_type -> chorus.GetAppReviewReply
+	37, // 65: chorus.AppService.StartAppReview:output_type -> chorus.StartAppReviewReply
+	40, // 66: chorus.AppService.DecideAppReview:output_type -> chorus.DecideAppReviewReply
+	43, // 67: chorus.AppService.CommentAppReview:output_type -> chorus.CommentAppReviewReply
+	46, // 68: chorus.AppService.UpdateAppLifecycle:output_type -> chorus.UpdateAppLifecycleReply
+	52, // [52:69] is the sub-list for method output_type
+	35, // [35:52] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
 }
 
 func init() { file_app_service_proto_init() }
@@ -974,8 +3102,128 @@ func file_app_service_proto_init() {
 				return nil
 			}
 		}
-		file_app_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListAppsReply); i {
+		file_app_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAppsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAppsResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAppRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAppReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAppResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAppReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAppResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkCreateAppsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkCreateAppsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAppReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAppResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -986,8 +3234,8 @@ func file_app_service_proto_init() {
 				return nil
 			}
 		}
-		file_app_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListAppsResult); i {
+		file_app_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAppRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -998,8 +3246,8 @@ func file_app_service_proto_init() {
 				return nil
 			}
 		}
-		file_app_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAppRequest); i {
+		file_app_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAppReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1010,8 +3258,8 @@ func file_app_service_proto_init() {
 				return nil
 			}
 		}
-		file_app_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAppReply); i {
+		file_app_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAppResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1022,8 +3270,8 @@ func file_app_service_proto_init() {
 				return nil
 			}
 		}
-		file_app_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAppResult); i {
+		file_app_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAppVersionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1034,8 +3282,8 @@ func file_app_service_proto_init() {
 				return nil
 			}
 		}
-		file_app_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateAppReply); i {
+		file_app_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAppVersionsReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1046,8 +3294,284 @@ func file_app_service_proto_init() {
 				return nil
 			}
 		}
-		file_app_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateAppResult); i {
+		file_app_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAppVersionsResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAppVersionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAppVersionReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAppVersionResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAppVersionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAppVersionReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAppVersionResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWorkspaceAppsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWorkspaceAppsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWorkspaceAppsResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWorkspaceAppRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWorkspaceAppReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWorkspaceAppResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWorkspaceAppRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWorkspaceAppReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWorkspaceAppResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAppReviewRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAppReviewReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAppReviewResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartAppReviewRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartAppReviewReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartAppReviewResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecideAppReviewRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_service_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecideAppReviewReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1058,8 +3582,8 @@ func file_app_service_proto_init() {
 				return nil
 			}
 		}
-		file_app_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BulkCreateAppsRequest); i {
+		file_app_service_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecideAppReviewResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1070,8 +3594,8 @@ func file_app_service_proto_init() {
 				return nil
 			}
 		}
-		file_app_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BulkCreateAppsReply); i {
+		file_app_service_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommentAppReviewRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1082,8 +3606,8 @@ func file_app_service_proto_init() {
 				return nil
 			}
 		}
-		file_app_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateAppReply); i {
+		file_app_service_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommentAppReviewReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1094,8 +3618,8 @@ func file_app_service_proto_init() {
 				return nil
 			}
 		}
-		file_app_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateAppResult); i {
+		file_app_service_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommentAppReviewResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1106,8 +3630,8 @@ func file_app_service_proto_init() {
 				return nil
 			}
 		}
-		file_app_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteAppRequest); i {
+		file_app_service_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAppLifecycleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1118,8 +3642,8 @@ func file_app_service_proto_init() {
 				return nil
 			}
 		}
-		file_app_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteAppReply); i {
+		file_app_service_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAppLifecycleReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1130,8 +3654,8 @@ func file_app_service_proto_init() {
 				return nil
 			}
 		}
-		file_app_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteAppResult); i {
+		file_app_service_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAppLifecycleResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1150,7 +3674,7 @@ func file_app_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_app_service_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   48,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1181,7 +3705,18 @@ type AppServiceClient interface {
 	CreateApp(ctx context.Context, in *App, opts ...grpc.CallOption) (*CreateAppReply, error)
 	BulkCreateApps(ctx context.Context, in *BulkCreateAppsRequest, opts ...grpc.CallOption) (*BulkCreateAppsReply, error)
 	UpdateApp(ctx context.Context, in *App, opts ...grpc.CallOption) (*UpdateAppReply, error)
+	ListAppVersions(ctx context.Context, in *ListAppVersionsRequest, opts ...grpc.CallOption) (*ListAppVersionsReply, error)
+	CreateAppVersion(ctx context.Context, in *CreateAppVersionRequest, opts ...grpc.CallOption) (*CreateAppVersionReply, error)
+	UpdateAppVersion(ctx context.Context, in *UpdateAppVersionRequest, opts ...grpc.CallOption) (*UpdateAppVersionReply, error)
 	DeleteApp(ctx context.Context, in *DeleteAppRequest, opts ...grpc.CallOption) (*DeleteAppReply, error)
+	ListWorkspaceApps(ctx context.Context, in *ListWorkspaceAppsRequest, opts ...grpc.CallOption) (*ListWorkspaceAppsReply, error)
+	CreateWorkspaceApp(ctx context.Context, in *CreateWorkspaceAppRequest, opts ...grpc.CallOption) (*CreateWorkspaceAppReply, error)
+	DeleteWorkspaceApp(ctx context.Context, in *DeleteWorkspaceAppRequest, opts ...grpc.CallOption) (*DeleteWorkspaceAppReply, error)
+	GetAppReview(ctx context.Context, in *GetAppReviewRequest, opts ...grpc.CallOption) (*GetAppReviewReply, error)
+	StartAppReview(ctx context.Context, in *StartAppReviewRequest, opts ...grpc.CallOption) (*StartAppReviewReply, error)
+	DecideAppReview(ctx context.Context, in *DecideAppReviewRequest, opts ...grpc.CallOption) (*DecideAppReviewReply, error)
+	CommentAppReview(ctx context.Context, in *CommentAppReviewRequest, opts ...grpc.CallOption) (*CommentAppReviewReply, error)
+	UpdateAppLifecycle(ctx context.Context, in *UpdateAppLifecycleRequest, opts ...grpc.CallOption) (*UpdateAppLifecycleReply, error)
 }
 
 type appServiceClient struct {
@@ -1237,6 +3772,33 @@ func (c *appServiceClient) UpdateApp(ctx context.Context, in *App, opts ...grpc.
 	return out, nil
 }
 
+func (c *appServiceClient) ListAppVersions(ctx context.Context, in *ListAppVersionsRequest, opts ...grpc.CallOption) (*ListAppVersionsReply, error) {
+	out := new(ListAppVersionsReply)
+	err := c.cc.Invoke(ctx, "/chorus.AppService/ListAppVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) CreateAppVersion(ctx context.Context, in *CreateAppVersionRequest, opts ...grpc.CallOption) (*CreateAppVersionReply, error) {
+	out := new(CreateAppVersionReply)
+	err := c.cc.Invoke(ctx, "/chorus.AppService/CreateAppVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) UpdateAppVersion(ctx context.Context, in *UpdateAppVersionRequest, opts ...grpc.CallOption) (*UpdateAppVersionReply, error) {
+	out := new(UpdateAppVersionReply)
+	err := c.cc.Invoke(ctx, "/chorus.AppService/UpdateAppVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *appServiceClient) DeleteApp(ctx context.Context, in *DeleteAppRequest, opts ...grpc.CallOption) (*DeleteAppReply, error) {
 	out := new(DeleteAppReply)
 	err := c.cc.Invoke(ctx, "/chorus.AppService/DeleteApp", in, out, opts...)
@@ -1246,6 +3808,78 @@ func (c *appServiceClient) DeleteApp(ctx context.Context, in *DeleteAppRequest,
 	return out, nil
 }
 
+func (c *appServiceClient) ListWorkspaceApps(ctx context.Context, in *ListWorkspaceAppsRequest, opts ...grpc.CallOption) (*ListWorkspaceAppsReply, error) {
+	out := new(ListWorkspaceAppsReply)
+	err := c.cc.Invoke(ctx, "/chorus.AppService/ListWorkspaceApps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) CreateWorkspaceApp(ctx context.Context, in *CreateWorkspaceAppRequest, opts ...grpc.CallOption) (*CreateWorkspaceAppReply, error) {
+	out := new(CreateWorkspaceAppReply)
+	err := c.cc.Invoke(ctx, "/chorus.AppService/CreateWorkspaceApp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) DeleteWorkspaceApp(ctx context.Context, in *DeleteWorkspaceAppRequest, opts ...grpc.CallOption) (*DeleteWorkspaceAppReply, error) {
+	out := new(DeleteWorkspaceAppReply)
+	err := c.cc.Invoke(ctx, "/chorus.AppService/DeleteWorkspaceApp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) GetAppReview(ctx context.Context, in *GetAppReviewRequest, opts ...grpc.CallOption) (*GetAppReviewReply, error) {
+	out := new(GetAppReviewReply)
+	err := c.cc.Invoke(ctx, "/chorus.AppService/GetAppReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) StartAppReview(ctx context.Context, in *StartAppReviewRequest, opts ...grpc.CallOption) (*StartAppReviewReply, error) {
+	out := new(StartAppReviewReply)
+	err := c.cc.Invoke(ctx, "/chorus.AppService/StartAppReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) DecideAppReview(ctx context.Context, in *DecideAppReviewRequest, opts ...grpc.CallOption) (*DecideAppReviewReply, error) {
+	out := new(DecideAppReviewReply)
+	err := c.cc.Invoke(ctx, "/chorus.AppService/DecideAppReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) CommentAppReview(ctx context.Context, in *CommentAppReviewRequest, opts ...grpc.CallOption) (*CommentAppReviewReply, error) {
+	out := new(CommentAppReviewReply)
+	err := c.cc.Invoke(ctx, "/chorus.AppService/CommentAppReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) UpdateAppLifecycle(ctx context.Context, in *UpdateAppLifecycleRequest, opts ...grpc.CallOption) (*UpdateAppLifecycleReply, error) {
+	out := new(UpdateAppLifecycleReply)
+	err := c.cc.Invoke(ctx, "/chorus.AppService/UpdateAppLifecycle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AppServiceServer is the server API for AppService service.
 type AppServiceServer interface {
 	GetApp(context.Context, *GetAppRequest) (*GetAppReply, error)
@@ -1253,7 +3887,18 @@ type AppServiceServer interface {
 	CreateApp(context.Context, *App) (*CreateAppReply, error)
 	BulkCreateApps(context.Context, *BulkCreateAppsRequest) (*BulkCreateAppsReply, error)
 	UpdateApp(context.Context, *App) (*UpdateAppReply, error)
+	ListAppVersions(context.Context, *ListAppVersionsRequest) (*ListAppVersionsReply, error)
+	CreateAppVersion(context.Context, *CreateAppVersionRequest) (*CreateAppVersionReply, error)
+	UpdateAppVersion(context.Context, *UpdateAppVersionRequest) (*UpdateAppVersionReply, error)
 	DeleteApp(context.Context, *DeleteAppRequest) (*DeleteAppReply, error)
+	ListWorkspaceApps(context.Context, *ListWorkspaceAppsRequest) (*ListWorkspaceAppsReply, error)
+	CreateWorkspaceApp(context.Context, *CreateWorkspaceAppRequest) (*CreateWorkspaceAppReply, error)
+	DeleteWorkspaceApp(context.Context, *DeleteWorkspaceAppRequest) (*DeleteWorkspaceAppReply, error)
+	GetAppReview(context.Context, *GetAppReviewRequest) (*GetAppReviewReply, error)
+	StartAppReview(context.Context, *StartAppReviewRequest) (*StartAppReviewReply, error)
+	DecideAppReview(context.Context, *DecideAppReviewRequest) (*DecideAppReviewReply, error)
+	CommentAppReview(context.Context, *CommentAppReviewRequest) (*CommentAppReviewReply, error)
+	UpdateAppLifecycle(context.Context, *UpdateAppLifecycleRequest) (*UpdateAppLifecycleReply, error)
 }
 
 // UnimplementedAppServiceServer can be embedded to have forward compatible implementations.
@@ -1275,9 +3920,42 @@ func (*UnimplementedAppServiceServer) BulkCreateApps(context.Context, *BulkCreat
 func (*UnimplementedAppServiceServer) UpdateApp(context.Context, *App) (*UpdateAppReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateApp not implemented")
 }
+func (*UnimplementedAppServiceServer) ListAppVersions(context.Context, *ListAppVersionsRequest) (*ListAppVersionsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAppVersions not implemented")
+}
+func (*UnimplementedAppServiceServer) CreateAppVersion(context.Context, *CreateAppVersionRequest) (*CreateAppVersionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAppVersion not implemented")
+}
+func (*UnimplementedAppServiceServer) UpdateAppVersion(context.Context, *UpdateAppVersionRequest) (*UpdateAppVersionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAppVersion not implemented")
+}
 func (*UnimplementedAppServiceServer) DeleteApp(context.Context, *DeleteAppRequest) (*DeleteAppReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteApp not implemented")
 }
+func (*UnimplementedAppServiceServer) ListWorkspaceApps(context.Context, *ListWorkspaceAppsRequest) (*ListWorkspaceAppsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkspaceApps not implemented")
+}
+func (*UnimplementedAppServiceServer) CreateWorkspaceApp(context.Context, *CreateWorkspaceAppRequest) (*CreateWorkspaceAppReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWorkspaceApp not implemented")
+}
+func (*UnimplementedAppServiceServer) DeleteWorkspaceApp(context.Context, *DeleteWorkspaceAppRequest) (*DeleteWorkspaceAppReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkspaceApp not implemented")
+}
+func (*UnimplementedAppServiceServer) GetAppReview(context.Context, *GetAppReviewRequest) (*GetAppReviewReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppReview not implemented")
+}
+func (*UnimplementedAppServiceServer) StartAppReview(context.Context, *StartAppReviewRequest) (*StartAppReviewReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartAppReview not implemented")
+}
+func (*UnimplementedAppServiceServer) DecideAppReview(context.Context, *DecideAppReviewRequest) (*DecideAppReviewReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecideAppReview not implemented")
+}
+func (*UnimplementedAppServiceServer) CommentAppReview(context.Context, *CommentAppReviewRequest) (*CommentAppReviewReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommentAppReview not implemented")
+}
+func (*UnimplementedAppServiceServer) UpdateAppLifecycle(context.Context, *UpdateAppLifecycleRequest) (*UpdateAppLifecycleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAppLifecycle not implemented")
+}
 
 func RegisterAppServiceServer(s *grpc.Server, srv AppServiceServer) {
 	s.RegisterService(&_AppService_serviceDesc, srv)
@@ -1373,6 +4051,60 @@ func _AppService_UpdateApp_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AppService_ListAppVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAppVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).ListAppVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.AppService/ListAppVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).ListAppVersions(ctx, req.(*ListAppVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_CreateAppVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAppVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).CreateAppVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.AppService/CreateAppVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).CreateAppVersion(ctx, req.(*CreateAppVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_UpdateAppVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAppVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).UpdateAppVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.AppService/UpdateAppVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).UpdateAppVersion(ctx, req.(*UpdateAppVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AppService_DeleteApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteAppRequest)
 	if err := dec(in); err != nil {
@@ -1391,6 +4123,150 @@ func _AppService_DeleteApp_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AppService_ListWorkspaceApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkspaceAppsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).ListWorkspaceApps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.AppService/ListWorkspaceApps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).ListWorkspaceApps(ctx, req.(*ListWorkspaceAppsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_CreateWorkspaceApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWorkspaceAppRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).CreateWorkspaceApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.AppService/CreateWorkspaceApp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).CreateWorkspaceApp(ctx, req.(*CreateWorkspaceAppRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_DeleteWorkspaceApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWorkspaceAppRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).DeleteWorkspaceApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.AppService/DeleteWorkspaceApp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).DeleteWorkspaceApp(ctx, req.(*DeleteWorkspaceAppRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_GetAppReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).GetAppReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.AppService/GetAppReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).GetAppReview(ctx, req.(*GetAppReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_StartAppReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartAppReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).StartAppReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.AppService/StartAppReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).StartAppReview(ctx, req.(*StartAppReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_DecideAppReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecideAppReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).DecideAppReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.AppService/DecideAppReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).DecideAppReview(ctx, req.(*DecideAppReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_CommentAppReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommentAppReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).CommentAppReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.AppService/CommentAppReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).CommentAppReview(ctx, req.(*CommentAppReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_UpdateAppLifecycle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAppLifecycleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).UpdateAppLifecycle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.AppService/UpdateAppLifecycle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).UpdateAppLifecycle(ctx, req.(*UpdateAppLifecycleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AppService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "chorus.AppService",
 	HandlerType: (*AppServiceServer)(nil),
@@ -1415,10 +4291,54 @@ var _AppService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateApp",
 			Handler:    _AppService_UpdateApp_Handler,
 		},
+		{
+			MethodName: "ListAppVersions",
+			Handler:    _AppService_ListAppVersions_Handler,
+		},
+		{
+			MethodName: "CreateAppVersion",
+			Handler:    _AppService_CreateAppVersion_Handler,
+		},
+		{
+			MethodName: "UpdateAppVersion",
+			Handler:    _AppService_UpdateAppVersion_Handler,
+		},
 		{
 			MethodName: "DeleteApp",
 			Handler:    _AppService_DeleteApp_Handler,
 		},
+		{
+			MethodName: "ListWorkspaceApps",
+			Handler:    _AppService_ListWorkspaceApps_Handler,
+		},
+		{
+			MethodName: "CreateWorkspaceApp",
+			Handler:    _AppService_CreateWorkspaceApp_Handler,
+		},
+		{
+			MethodName: "DeleteWorkspaceApp",
+			Handler:    _AppService_DeleteWorkspaceApp_Handler,
+		},
+		{
+			MethodName: "GetAppReview",
+			Handler:    _AppService_GetAppReview_Handler,
+		},
+		{
+			MethodName: "StartAppReview",
+			Handler:    _AppService_StartAppReview_Handler,
+		},
+		{
+			MethodName: "DecideAppReview",
+			Handler:    _AppService_DecideAppReview_Handler,
+		},
+		{
+			MethodName: "CommentAppReview",
+			Handler:    _AppService_CommentAppReview_Handler,
+		},
+		{
+			MethodName: "UpdateAppLifecycle",
+			Handler:    _AppService_UpdateAppLifecycle_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "app-service.proto",
@@ -913,6 +913,171 @@ func (x *CreateDataTransferRequestResult) GetApprovalRequest() *ApprovalRequest
 	return nil
 }
 
+type CreateWorkspaceAccessRequestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId   uint64 `protobuf:"varint,1,opt,name=workspaceId,proto3" json:"workspaceId,omitempty"`
+	RoleName      string `protobuf:"bytes,2,opt,name=roleName,proto3" json:"roleName,omitempty"`
+	Justification string `protobuf:"bytes,3,opt,name=justification,proto3" json:"justification,omitempty"`
+	Title         string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+}
+
+func (x *CreateWorkspaceAccessRequestRequest) Reset() {
+	*x = CreateWorkspaceAccessRequestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_approval_request_service_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateWorkspaceAccessRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWorkspaceAccessRequestRequest) ProtoMessage() {}
+
+func (x *CreateWorkspaceAccessRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_approval_request_service_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWorkspaceAccessRequestRequest.ProtoReflect.Descriptor instead.
+func (*CreateWorkspaceAccessRequestRequest) Descriptor() ([]byte, []int) {
+	return file_approval_request_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateWorkspaceAccessRequestRequest) GetWorkspaceId() uint64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *CreateWorkspaceAccessRequestRequest) GetRoleName() string {
+	if x != nil {
+		return x.RoleName
+	}
+	return ""
+}
+
+func (x *CreateWorkspaceAccessRequestRequest) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *CreateWorkspaceAccessRequestRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type CreateWorkspaceAccessRequestReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *CreateWorkspaceAccessRequestResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *CreateWorkspaceAccessRequestReply) Reset() {
+	*x = CreateWorkspaceAccessRequestReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_approval_request_service_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateWorkspaceAccessRequestReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWorkspaceAccessRequestReply) ProtoMessage() {}
+
+func (x *CreateWorkspaceAccessRequestReply) ProtoReflect() protoreflect.Message {
+	mi := &file_approval_request_service_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWorkspaceAccessRequestReply.ProtoReflect.Descriptor instead.
+func (*CreateWorkspaceAccessRequestReply) Descriptor() ([]byte, []int) {
+	return file_approval_request_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateWorkspaceAccessRequestReply) GetResult() *CreateWorkspaceAccessRequestResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type CreateWorkspaceAccessRequestResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApprovalRequest *ApprovalRequest `protobuf:"bytes,1,opt,name=approvalRequest,proto3" json:"approvalRequest,omitempty"`
+}
+
+func (x *CreateWorkspaceAccessRequestResult) Reset() {
+	*x = CreateWorkspaceAccessRequestResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_approval_request_service_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateWorkspaceAccessRequestResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWorkspaceAccessRequestResult) ProtoMessage() {}
+
+func (x *CreateWorkspaceAccessRequestResult) ProtoReflect() protoreflect.Message {
+	mi := &file_approval_request_service_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWorkspaceAccessRequestResult.ProtoReflect.Descriptor instead.
+func (*CreateWorkspaceAccessRequestResult) Descriptor() ([]byte, []int) {
+	return file_approval_request_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateWorkspaceAccessRequestResult) GetApprovalRequest() *ApprovalRequest {
+	if x != nil {
+		return x.ApprovalRequest
+	}
+	return nil
+}
+
 type ApproveApprovalRequestRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -926,7 +1091,7 @@ type ApproveApprovalRequestRequest struct {
 func (x *ApproveApprovalRequestRequest) Reset() {
 	*x = ApproveApprovalRequestRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_approval_request_service_proto_msgTypes[16]
+		mi := &file_approval_request_service_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -939,7 +1104,7 @@ func (x *ApproveApprovalRequestRequest) String() string {
 func (*ApproveApprovalRequestRequest) ProtoMessage() {}
 
 func (x *ApproveApprovalRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_approval_request_service_proto_msgTypes[16]
+	mi := &file_approval_request_service_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -952,7 +1117,7 @@ func (x *ApproveApprovalRequestRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApproveApprovalRequestRequest.ProtoReflect.Descriptor instead.
 func (*ApproveApprovalRequestRequest) Descriptor() ([]byte, []int) {
-	return file_approval_request_service_proto_rawDescGZIP(), []int{16}
+	return file_approval_request_service_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ApproveApprovalRequestRequest) GetId() uint64 {
@@ -987,7 +1152,7 @@ type ApproveApprovalRequestReply struct {
 func (x *ApproveApprovalRequestReply) Reset() {
 	*x = ApproveApprovalRequestReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_approval_request_service_proto_msgTypes[17]
+		mi := &file_approval_request_service_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1000,7 +1165,7 @@ func (x *ApproveApprovalRequestReply) String() string {
 func (*ApproveApprovalRequestReply) ProtoMessage() {}
 
 func (x *ApproveApprovalRequestReply) ProtoReflect() protoreflect.Message {
-	mi := &file_approval_request_service_proto_msgTypes[17]
+	mi := &file_approval_request_service_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1013,7 +1178,7 @@ func (x *ApproveApprovalRequestReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApproveApprovalRequestReply.ProtoReflect.Descriptor instead.
 func (*ApproveApprovalRequestReply) Descriptor() ([]byte, []int) {
-	return file_approval_request_service_proto_rawDescGZIP(), []int{17}
+	return file_approval_request_service_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ApproveApprovalRequestReply) GetResult() *ApproveApprovalRequestResult {
@@ -1034,7 +1199,7 @@ type ApproveApprovalRequestResult struct {
 func (x *ApproveApprovalRequestResult) Reset() {
 	*x = ApproveApprovalRequestResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_approval_request_service_proto_msgTypes[18]
+		mi := &file_approval_request_service_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1047,7 +1212,7 @@ func (x *ApproveApprovalRequestResult) String() string {
 func (*ApproveApprovalRequestResult) ProtoMessage() {}
 
 func (x *ApproveApprovalRequestResult) ProtoReflect() protoreflect.Message {
-	mi := &file_approval_request_service_proto_msgTypes[18]
+	mi := &file_approval_request_service_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1060,7 +1225,7 @@ func (x *ApproveApprovalRequestResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApproveApprovalRequestResult.ProtoReflect.Descriptor instead.
 func (*ApproveApprovalRequestResult) Descriptor() ([]byte, []int) {
-	return file_approval_request_service_proto_rawDescGZIP(), []int{18}
+	return file_approval_request_service_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ApproveApprovalRequestResult) GetApprovalRequest() *ApprovalRequest {
@@ -1081,7 +1246,7 @@ type DeleteApprovalRequestRequest struct {
 func (x *DeleteApprovalRequestRequest) Reset() {
 	*x = DeleteApprovalRequestRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_approval_request_service_proto_msgTypes[19]
+		mi := &file_approval_request_service_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1094,7 +1259,7 @@ func (x *DeleteApprovalRequestRequest) String() string {
 func (*DeleteApprovalRequestRequest) ProtoMessage() {}
 
 func (x *DeleteApprovalRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_approval_request_service_proto_msgTypes[19]
+	mi := &file_approval_request_service_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1107,7 +1272,7 @@ func (x *DeleteApprovalRequestRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteApprovalRequestRequest.ProtoReflect.Descriptor instead.
 func (*DeleteApprovalRequestRequest) Descriptor() ([]byte, []int) {
-	return file_approval_request_service_proto_rawDescGZIP(), []int{19}
+	return file_approval_request_service_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *DeleteApprovalRequestRequest) GetId() uint64 {
@@ -1128,7 +1293,7 @@ type DeleteApprovalRequestReply struct {
 func (x *DeleteApprovalRequestReply) Reset() {
 	*x = DeleteApprovalRequestReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_approval_request_service_proto_msgTypes[20]
+		mi := &file_approval_request_service_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1141,7 +1306,7 @@ func (x *DeleteApprovalRequestReply) String() string {
 func (*DeleteApprovalRequestReply) ProtoMessage() {}
 
 func (x *DeleteApprovalRequestReply) ProtoReflect() protoreflect.Message {
-	mi := &file_approval_request_service_proto_msgTypes[20]
+	mi := &file_approval_request_service_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1154,7 +1319,7 @@ func (x *DeleteApprovalRequestReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteApprovalRequestReply.ProtoReflect.Descriptor instead.
 func (*DeleteApprovalRequestReply) Descriptor() ([]byte, []int) {
-	return file_approval_request_service_proto_rawDescGZIP(), []int{20}
+	return file_approval_request_service_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *DeleteApprovalRequestReply) GetResult() *DeleteApprovalRequestResult {
@@ -1173,7 +1338,7 @@ type DeleteApprovalRequestResult struct {
 func (x *DeleteApprovalRequestResult) Reset() {
 	*x = DeleteApprovalRequestResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_approval_request_service_proto_msgTypes[21]
+		mi := &file_approval_request_service_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1186,7 +1351,7 @@ func (x *DeleteApprovalRequestResult) String() string {
 func (*DeleteApprovalRequestResult) ProtoMessage() {}
 
 func (x *DeleteApprovalRequestResult) ProtoReflect() protoreflect.Message {
-	mi := &file_approval_request_service_proto_msgTypes[21]
+	mi := &file_approval_request_service_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1199,7 +1364,7 @@ func (x *DeleteApprovalRequestResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteApprovalRequestResult.ProtoReflect.Descriptor instead.
 func (*DeleteApprovalRequestResult) Descriptor() ([]byte, []int) {
-	return file_approval_request_service_proto_rawDescGZIP(), []int{21}
+	return file_approval_request_service_proto_rawDescGZIP(), []int{24}
 }
 
 type DownloadApprovalRequestFileRequest struct {
@@ -1214,7 +1379,7 @@ type DownloadApprovalRequestFileRequest struct {
 func (x *DownloadApprovalRequestFileRequest) Reset() {
 	*x = DownloadApprovalRequestFileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_approval_request_service_proto_msgTypes[22]
+		mi := &file_approval_request_service_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1227,7 +1392,7 @@ func (x *DownloadApprovalRequestFileRequest) String() string {
 func (*DownloadApprovalRequestFileRequest) ProtoMessage() {}
 
 func (x *DownloadApprovalRequestFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_approval_request_service_proto_msgTypes[22]
+	mi := &file_approval_request_service_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1240,7 +1405,7 @@ func (x *DownloadApprovalRequestFileRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use DownloadApprovalRequestFileRequest.ProtoReflect.Descriptor instead.
 func (*DownloadApprovalRequestFileRequest) Descriptor() ([]byte, []int) {
-	return file_approval_request_service_proto_rawDescGZIP(), []int{22}
+	return file_approval_request_service_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *DownloadApprovalRequestFileRequest) GetId() uint64 {
@@ -1268,7 +1433,7 @@ type DownloadApprovalRequestFileReply struct {
 func (x *DownloadApprovalRequestFileReply) Reset() {
 	*x = DownloadApprovalRequestFileReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_approval_request_service_proto_msgTypes[23]
+		mi := &file_approval_request_service_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1281,7 +1446,7 @@ func (x *DownloadApprovalRequestFileReply) String() string {
 func (*DownloadApprovalRequestFileReply) ProtoMessage() {}
 
 func (x *DownloadApprovalRequestFileReply) ProtoReflect() protoreflect.Message {
-	mi := &file_approval_request_service_proto_msgTypes[23]
+	mi := &file_approval_request_service_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1294,7 +1459,7 @@ func (x *DownloadApprovalRequestFileReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DownloadApprovalRequestFileReply.ProtoReflect.Descriptor instead.
 func (*DownloadApprovalRequestFileReply) Descriptor() ([]byte, []int) {
-	return file_approval_request_service_proto_rawDescGZIP(), []int{23}
+	return file_approval_request_service_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *DownloadApprovalRequestFileReply) GetResult() *DownloadApprovalRequestFileResult {
@@ -1316,7 +1481,7 @@ type DownloadApprovalRequestFileResult struct {
 func (x *DownloadApprovalRequestFileResult) Reset() {
 	*x = DownloadApprovalRequestFileResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_approval_request_service_proto_msgTypes[24]
+		mi := &file_approval_request_service_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1329,7 +1494,7 @@ func (x *DownloadApprovalRequestFileResult) String() string {
 func (*DownloadApprovalRequestFileResult) ProtoMessage() {}
 
 func (x *DownloadApprovalRequestFileResult) ProtoReflect() protoreflect.Message {
-	mi := &file_approval_request_service_proto_msgTypes[24]
+	mi := &file_approval_request_service_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1342,7 +1507,7 @@ func (x *DownloadApprovalRequestFileResult) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use DownloadApprovalRequestFileResult.ProtoReflect.Descriptor instead.
 func (*DownloadApprovalRequestFileResult) Descriptor() ([]byte, []int) {
-	return file_approval_request_service_proto_rawDescGZIP(), []int{24}
+	return file_approval_request_service_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *DownloadApprovalRequestFileResult) GetFile() *ApprovalRequestFile {
@@ -1522,151 +1687,202 @@ var file_approval_request_service_proto_rawDesc = []byte{
 	0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x6f,
 	0x72, 0x75, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x52, 0x0f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x74, 0x0a, 0x1d, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x41,
-	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x12,
-	0x1d, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0a,
-	0x0a, 0x08, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x5b, 0x0a, 0x1b, 0x41, 0x70,
-	0x70, 0x72, 0x6f, 0x76, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3c, 0x0a, 0x06, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x68, 0x6f, 0x72,
-	0x75, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
-	0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
-	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x61, 0x0a, 0x1c, 0x41, 0x70, 0x70, 0x72, 0x6f,
-	0x76, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x41, 0x0a, 0x0f, 0x61, 0x70, 0x70, 0x72, 0x6f,
-	0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x17, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
-	0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0f, 0x61, 0x70, 0x70, 0x72, 0x6f,
-	0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2e, 0x0a, 0x1c, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x59, 0x0a, 0x1a, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3b, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75,
-	0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x1d, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41,
-	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x22, 0x48, 0x0a, 0x22, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
-	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46,
-	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
-	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x65,
-	0x0a, 0x20, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
-	0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x12, 0x41, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x44, 0x6f, 0x77, 0x6e,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x9f, 0x01, 0x0a, 0x23, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x75,
+	0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x67, 0x0a, 0x21, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x42, 0x0a, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x68,
+	0x6f, 0x72, 0x75, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+	0x67, 0x0a, 0x22, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x41, 0x0a, 0x0f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x74, 0x0a, 0x1d, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x88,
+	0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x5b,
+	0x0a, 0x1b, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3c, 0x0a,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x41, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x61, 0x0a, 0x1c, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x41, 0x0a, 0x0f, 0x61,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x41, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0f, 0x61,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2e,
+	0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x59,
+	0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3b, 0x0a, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63,
+	0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x1d, 0x0a, 0x1b, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x48, 0x0a, 0x22, 0x44, 0x6f, 0x77, 0x6e,
 	0x6c, 0x6f, 0x61, 0x64, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x6e, 0x0a, 0x21, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
-	0x64, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x66, 0x69,
-	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75,
-	0x73, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x32, 0xbf, 0x13, 0x0a, 0x16, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
-	0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0xe5, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73,
-	0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x68, 0x6f,
-	0x72, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x8a, 0x01, 0x92, 0x41,
-	0x5c, 0x0a, 0x16, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x17, 0x47, 0x65, 0x74, 0x20, 0x61,
-	0x6e, 0x20, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x29, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x70, 0x70,
-	0x72, 0x6f, 0x76, 0x61, 0x6c, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76,
-	0x31, 0x2f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x2d, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xed, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73,
-	0x74, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x73, 0x12, 0x23, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41,
-	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x8c, 0x01, 0x92, 0x41, 0x63, 0x0a,
-	0x16, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x61, 0x70,
-	0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x1a,
-	0x31, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x72,
-	0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66,
-	0x20, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72,
-	0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x2d,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x9d, 0x02, 0x0a, 0x17, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x4d, 0x79, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x73, 0x12, 0x26, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x4d, 0x79, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63,
-	0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x79, 0x41, 0x70, 0x70,
-	0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0xb3, 0x01, 0x92, 0x41, 0x7f, 0x0a, 0x16, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
-	0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x1a, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x20, 0x6d, 0x79, 0x20, 0x61, 0x70, 0x70, 0x72, 0x6f,
-	0x76, 0x61, 0x6c, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x1a, 0x49, 0x54, 0x68,
-	0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x72, 0x65, 0x74, 0x75,
-	0x72, 0x6e, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x20, 0x6f, 0x66,
+	0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x22, 0x65, 0x0a, 0x20, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x41, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x6e, 0x0a, 0x21, 0x44, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2f,
+	0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63,
+	0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x32, 0xfb, 0x16, 0x0a, 0x16, 0x41, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0xe5, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x2e, 0x63, 0x68,
+	0x6f, 0x72, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x8a, 0x01, 0x92, 0x41, 0x5c, 0x0a, 0x16, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x17, 0x47,
+	0x65, 0x74, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x20, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x6e,
 	0x20, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x74, 0x20, 0x75, 0x73, 0x65, 0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x72,
-	0x6f, 0x76, 0x61, 0x6c, 0x2d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x6d, 0x69,
-	0x6e, 0x65, 0x2f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0xf5, 0x02, 0x0a, 0x1b, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75,
+	0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65,
+	0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x2d, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xed, 0x01, 0x0a,
+	0x14, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x68, 0x6f,
+	0x72, 0x75, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x8c, 0x01,
+	0x92, 0x41, 0x63, 0x0a, 0x16, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x16, 0x4c, 0x69, 0x73,
+	0x74, 0x20, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x1a, 0x31, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x20, 0x6c, 0x69, 0x73,
+	0x74, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x20, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x61, 0x6c, 0x2d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x9d, 0x02, 0x0a,
+	0x17, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x79, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x26, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75,
+	0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x79, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4d,
+	0x79, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xb3, 0x01, 0x92, 0x41, 0x7f, 0x0a, 0x16, 0x41, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x20, 0x6d, 0x79, 0x20, 0x61,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x1a, 0x49, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20,
+	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x20, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x75, 0x73, 0x65, 0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x2b, 0x12, 0x29, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x2d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x2f, 0x6d, 0x69, 0x6e, 0x65, 0x2f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0xf5, 0x02, 0x0a,
+	0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x2e, 0x63,
+	0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75,
 	0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x74, 0x72,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xff,
-	0x01, 0x92, 0x41, 0xc2, 0x01, 0x0a, 0x16, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x20, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x61, 0x20, 0x64, 0x61, 0x74, 0x61, 0x20, 0x65, 0x78, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x85, 0x01, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x73, 0x20, 0x61, 0x20, 0x64, 0x61, 0x74, 0x61, 0x20, 0x65,
-	0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
-	0x61, 0x6c, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x20, 0x46, 0x69, 0x6c, 0x65,
-	0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x63, 0x6f, 0x70, 0x69, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20,
-	0x61, 0x20, 0x73, 0x74, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x72, 0x65, 0x61, 0x20, 0x66,
-	0x6f, 0x72, 0x20, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x20, 0x62, 0x65, 0x66, 0x6f,
-	0x72, 0x65, 0x20, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x64, 0x6f,
-	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x3a, 0x01, 0x2a,
-	0x22, 0x2e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x61,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0xff, 0x01, 0x92, 0x41, 0xc2, 0x01, 0x0a, 0x16, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x20, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x61, 0x20, 0x64, 0x61, 0x74, 0x61,
+	0x20, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x85, 0x01, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x73, 0x20, 0x61, 0x20, 0x64, 0x61,
+	0x74, 0x61, 0x20, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x61, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x20,
+	0x46, 0x69, 0x6c, 0x65, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x63, 0x6f, 0x70, 0x69, 0x65, 0x64,
+	0x20, 0x74, 0x6f, 0x20, 0x61, 0x20, 0x73, 0x74, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x72,
+	0x65, 0x61, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x20,
+	0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x20, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x33, 0x3a, 0x01, 0x2a, 0x22, 0x2e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f,
+	0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x2d, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x2d, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0xf7, 0x02, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x28, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63,
+	0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x87, 0x02, 0x92, 0x41, 0xcc, 0x01, 0x0a, 0x16, 0x41, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x61, 0x20, 0x64, 0x61,
+	0x74, 0x61, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x20, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x91, 0x01, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x73, 0x20, 0x61, 0x20, 0x64, 0x61,
+	0x74, 0x61, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x20, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x61, 0x6c, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x20, 0x46, 0x69,
+	0x6c, 0x65, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x63, 0x6f, 0x70, 0x69, 0x65, 0x64, 0x20, 0x74,
+	0x6f, 0x20, 0x61, 0x20, 0x73, 0x74, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x72, 0x65, 0x61,
+	0x20, 0x66, 0x6f, 0x72, 0x20, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x20, 0x62, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x20, 0x74, 0x6f,
+	0x20, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x77, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x3a, 0x01, 0x2a,
+	0x22, 0x2c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x61,
 	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x2d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
-	0x2f, 0x64, 0x61, 0x74, 0x61, 0x2d, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0xf7, 0x02, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28,
-	0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61,
-	0x74, 0x61, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75,
-	0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x87, 0x02, 0x92, 0x41, 0xcc, 0x01, 0x0a, 0x16, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
-	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x61, 0x20, 0x64, 0x61, 0x74, 0x61, 0x20, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x91, 0x01, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x73, 0x20, 0x61, 0x20, 0x64, 0x61, 0x74, 0x61, 0x20, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x20, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
-	0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x20, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x20,
-	0x61, 0x72, 0x65, 0x20, 0x63, 0x6f, 0x70, 0x69, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x61, 0x20,
-	0x73, 0x74, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x72, 0x65, 0x61, 0x20, 0x66, 0x6f, 0x72,
-	0x20, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x20, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65,
-	0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x20, 0x74, 0x6f, 0x20, 0x64, 0x65, 0x73,
-	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x3a, 0x01, 0x2a, 0x22, 0x2c, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x72, 0x6f,
-	0x76, 0x61, 0x6c, 0x2d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x64, 0x61, 0x74,
-	0x61, 0x2d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x96, 0x02, 0x0a, 0x16, 0x41,
+	0x2f, 0x64, 0x61, 0x74, 0x61, 0x2d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0xb9,
+	0x03, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2b, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x63,
+	0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xc0, 0x02, 0x92, 0x41, 0x82, 0x02, 0x0a, 0x16,
+	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x24, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x61, 0x20, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x1a, 0xc1, 0x01, 0x54,
+	0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x73, 0x20, 0x61, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74,
+	0x6f, 0x20, 0x6a, 0x6f, 0x69, 0x6e, 0x20, 0x61, 0x20, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x20,
+	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x61,
+	0x20, 0x72, 0x6f, 0x6c, 0x65, 0x2e, 0x20, 0x54, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x20, 0x75, 0x73, 0x65, 0x72, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x77, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x20, 0x61, 0x72, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x73, 0x2c, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x72, 0x6f, 0x6c, 0x65, 0x20, 0x69, 0x73, 0x20, 0x67, 0x72, 0x61, 0x6e, 0x74,
+	0x65, 0x64, 0x20, 0x6f, 0x6e, 0x63, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x20, 0x69, 0x73, 0x20, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x2e,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x3a, 0x01, 0x2a, 0x22, 0x2f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x2d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x2d, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x96, 0x02, 0x0a, 0x16, 0x41,
 	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x41,
 	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65,
@@ -1754,85 +1970,92 @@ func file_approval_request_service_proto_rawDescGZIP() []byte {
 	return file_approval_request_service_proto_rawDescData
 }
 
-var file_approval_request_service_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_approval_request_service_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_approval_request_service_proto_goTypes = []interface{}{
-	(*ListApprovalRequestsRequest)(nil),        // 0: chorus.ListApprovalRequestsRequest
-	(*ListApprovalRequestsReply)(nil),          // 1: chorus.ListApprovalRequestsReply
-	(*ListApprovalRequestsResult)(nil),         // 2: chorus.ListApprovalRequestsResult
-	(*ApprovalRequestFilter)(nil),              // 3: chorus.ApprovalRequestFilter
-	(*CountMyApprovalRequestsRequest)(nil),     // 4: chorus.CountMyApprovalRequestsRequest
-	(*CountMyApprovalRequestsReply)(nil),       // 5: chorus.CountMyApprovalRequestsReply
-	(*CountMyApprovalRequestsResult)(nil),      // 6: chorus.CountMyApprovalRequestsResult
-	(*GetApprovalRequestRequest)(nil),          // 7: chorus.GetApprovalRequestRequest
-	(*GetApprovalRequestReply)(nil),            // 8: chorus.GetApprovalRequestReply
-	(*GetApprovalRequestResult)(nil),           // 9: chorus.GetApprovalRequestResult
-	(*CreateDataExtractionRequestRequest)(nil), // 10: chorus.CreateDataExtractionRequestRequest
-	(*CreateDataExtractionRequestReply)(nil),   // 11: chorus.CreateDataExtractionRequestReply
-	(*CreateDataExtractionRequestResult)(nil),  // 12: chorus.CreateDataExtractionRequestResult
-	(*CreateDataTransferRequestRequest)(nil),   // 13: chorus.CreateDataTransferRequestRequest
-	(*CreateDataTransferRequestReply)(nil),     // 14: chorus.CreateDataTransferRequestReply
-	(*CreateDataTransferRequestResult)(nil),    // 15: chorus.CreateDataTransferRequestResult
-	(*ApproveApprovalRequestRequest)(nil),      // 16: chorus.ApproveApprovalRequestRequest
-	(*ApproveApprovalRequestReply)(nil),        // 17: chorus.ApproveApprovalRequestReply
-	(*ApproveApprovalRequestResult)(nil),       // 18: chorus.ApproveApprovalRequestResult
-	(*DeleteApprovalRequestRequest)(nil),       // 19: chorus.DeleteApprovalRequestRequest
-	(*DeleteApprovalRequestReply)(nil),         // 20: chorus.DeleteApprovalRequestReply
-	(*DeleteApprovalRequestResult)(nil),        // 21: chorus.DeleteApprovalRequestResult
-	(*DownloadApprovalRequestFileRequest)(nil), // 22: chorus.DownloadApprovalRequestFileRequest
-	(*DownloadApprovalRequestFileReply)(nil),   // 23: chorus.DownloadApprovalRequestFileReply
-	(*DownloadApprovalRequestFileResult)(nil),  // 24: chorus.DownloadApprovalRequestFileResult
-	nil,                         // 25: chorus.CountMyApprovalRequestsResult.CountByStatusEntry
-	nil,                         // 26: chorus.CountMyApprovalRequestsResult.CountByTypeEntry
-	(*PaginationQuery)(nil),     // 27: chorus.PaginationQuery
-	(*PaginationResult)(nil),    // 28: chorus.PaginationResult
-	(*ApprovalRequest)(nil),     // 29: chorus.ApprovalRequest
-	(ApprovalRequestStatus)(0),  // 30: chorus.ApprovalRequestStatus
-	(ApprovalRequestType)(0),    // 31: chorus.ApprovalRequestType
-	(*ApprovalRequestFile)(nil), // 32: chorus.ApprovalRequestFile
+	(*ListApprovalRequestsRequest)(nil),         // 0: chorus.ListApprovalRequestsRequest
+	(*ListApprovalRequestsReply)(nil),           // 1: chorus.ListApprovalRequestsReply
+	(*ListApprovalRequestsResult)(nil),          // 2: chorus.ListApprovalRequestsResult
+	(*ApprovalRequestFilter)(nil),               // 3: chorus.ApprovalRequestFilter
+	(*CountMyApprovalRequestsRequest)(nil),      // 4: chorus.CountMyApprovalRequestsRequest
+	(*CountMyApprovalRequestsReply)(nil),        // 5: chorus.CountMyApprovalRequestsReply
+	(*CountMyApprovalRequestsResult)(nil),       // 6: chorus.CountMyApprovalRequestsResult
+	(*GetApprovalRequestRequest)(nil),           // 7: chorus.GetApprovalRequestRequest
+	(*GetApprovalRequestReply)(nil),             // 8: chorus.GetApprovalRequestReply
+	(*GetApprovalRequestResult)(nil),            // 9: chorus.GetApprovalRequestResult
+	(*CreateDataExtractionRequestRequest)(nil),  // 10: chorus.CreateDataExtractionRequestRequest
+	(*CreateDataExtractionRequestReply)(nil),    // 11: chorus.CreateDataExtractionRequestReply
+	(*CreateDataExtractionRequestResult)(nil),   // 12: chorus.CreateDataExtractionRequestResult
+	(*CreateDataTransferRequestRequest)(nil),    // 13: chorus.CreateDataTransferRequestRequest
+	(*CreateDataTransferRequestReply)(nil),      // 14: chorus.CreateDataTransferRequestReply
+	(*CreateDataTransferRequestResult)(nil),     // 15: chorus.CreateDataTransferRequestResult
+	(*CreateWorkspaceAccessRequestRequest)(nil), // 16: chorus.CreateWorkspaceAccessRequestRequest
+	(*CreateWorkspaceAccessRequestReply)(nil),   // 17: chorus.CreateWorkspaceAccessRequestReply
+	(*CreateWorkspaceAccessRequestResult)(nil),  // 18: chorus.CreateWorkspaceAccessRequestResult
+	(*ApproveApprovalRequestRequest)(nil),       // 19: chorus.ApproveApprovalRequestRequest
+	(*ApproveApprovalRequestReply)(nil),         // 20: chorus.ApproveApprovalRequestReply
+	(*ApproveApprovalRequestResult)(nil),        // 21: chorus.ApproveApprovalRequestResult
+	(*DeleteApprovalRequestRequest)(nil),        // 22: chorus.DeleteApprovalRequestRequest
+	(*DeleteApprovalRequestReply)(nil),          // 23: chorus.DeleteApprovalRequestReply
+	(*DeleteApprovalRequestResult)(nil),         // 24: chorus.DeleteApprovalRequestResult
+	(*DownloadApprovalRequestFileRequest)(nil),  // 25: chorus.DownloadApprovalRequestFileRequest
+	(*DownloadApprovalRequestFileReply)(nil),    // 26: chorus.DownloadApprovalRequestFileReply
+	(*DownloadApprovalRequestFileResult)(nil),   // 27: chorus.DownloadApprovalRequestFileResult
+	nil,                         // 28: chorus.CountMyApprovalRequestsResult.CountByStatusEntry
+	nil,                         // 29: chorus.CountMyApprovalRequestsResult.CountByTypeEntry
+	(*PaginationQuery)(nil),     // 30: chorus.PaginationQuery
+	(*PaginationResult)(nil),    // 31: chorus.PaginationResult
+	(*ApprovalRequest)(nil),     // 32: chorus.ApprovalRequest
+	(ApprovalRequestStatus)(0),  // 33: chorus.ApprovalRequestStatus
+	(ApprovalRequestType)(0),    // 34: chorus.ApprovalRequestType
+	(*ApprovalRequestFile)(nil), // 35: chorus.ApprovalRequestFile
 }
 var file_approval_request_service_proto_depIdxs = []int32{
-	27, // 0: chorus.ListApprovalRequestsRequest.pagination:type_name -> chorus.PaginationQuery
+	30, // 0: chorus.ListApprovalRequestsRequest.pagination:type_name -> chorus.PaginationQuery
 	3,  // 1: chorus.ListApprovalRequestsRequest.filter:type_name -> chorus.ApprovalRequestFilter
 	2,  // 2: chorus.ListApprovalRequestsReply.result:type_name -> chorus.ListApprovalRequestsResult
-	28, // 3: chorus.ListApprovalRequestsReply.pagination:type_name -> chorus.PaginationResult
-	29, // 4: chorus.ListApprovalRequestsResult.approvalRequests:type_name -> chorus.ApprovalRequest
-	30, // 5: chorus.ApprovalRequestFilter.statusesIn:type_name -> chorus.ApprovalRequestStatus
-	31, // 6: chorus.ApprovalRequestFilter.typesIn:type_name -> chorus.ApprovalRequestType
+	31, // 3: chorus.ListApprovalRequestsReply.pagination:type_name -> chorus.PaginationResult
+	32, // 4: chorus.ListApprovalRequestsResult.approvalRequests:type_name -> chorus.ApprovalRequest
+	33, // 5: chorus.ApprovalRequestFilter.statusesIn:type_name -> chorus.ApprovalRequestStatus
+	34, // 6: chorus.ApprovalRequestFilter.typesIn:type_name -> chorus.ApprovalRequestType
 	6,  // 7: chorus.CountMyApprovalRequestsReply.result:type_name -> chorus.CountMyApprovalRequestsResult
-	25, // 8: chorus.CountMyApprovalRequestsResult.countByStatus:type_name -> chorus.CountMyApprovalRequestsResult.CountByStatusEntry
-	26, // 9: chorus.CountMyApprovalRequestsResult.countByType:type_name -> chorus.CountMyApprovalRequestsResult.CountByTypeEntry
+	28, // 8: chorus.CountMyApprovalRequestsResult.countByStatus:type_name -> chorus.CountMyApprovalRequestsResult.CountByStatusEntry
+	29, // 9: chorus.CountMyApprovalRequestsResult.countByType:type_name -> chorus.CountMyApprovalRequestsResult.CountByTypeEntry
 	9,  // 10: chorus.GetApprovalRequestReply.result:type_name -> chorus.GetApprovalRequestResult
-	29, // 11: chorus.GetApprovalRequestResult.approvalRequest:type_name -> chorus.ApprovalRequest
+	32, // 11: chorus.GetApprovalRequestResult.approvalRequest:type_name -> chorus.ApprovalRequest
 	12, // 12: chorus.CreateDataExtractionRequestReply.result:type_name -> chorus.CreateDataExtractionRequestResult
-	29, // 13: chorus.CreateDataExtractionRequestResult.approvalRequest:type_name -> chorus.ApprovalRequest
+	32, // 13: chorus.CreateDataExtractionRequestResult.approvalRequest:type_name -> chorus.ApprovalRequest
 	15, // 14: chorus.CreateDataTransferRequestReply.result:type_name -> chorus.CreateDataTransferRequestResult
-	29, // 15: chorus.CreateDataTransferRequestResult.approvalRequest:type_name -> chorus.ApprovalRequest
-	18, // 16: chorus.ApproveApprovalRequestReply.result:type_name -> chorus.ApproveApprovalRequestResult
-	29, // 17: chorus.ApproveApprovalRequestResult.approvalRequest:type_name -> chorus.ApprovalRequest
-	21, // 18: chorus.DeleteApprovalRequestReply.result:type_name -> chorus.DeleteApprovalRequestResult
-	24, // 19: chorus.DownloadApprovalRequestFileReply.result:type_name -> chorus.DownloadApprovalRequestFileResult
-	32, // 20: chorus.DownloadApprovalRequestFileResult.file:type_name -> chorus.ApprovalRequestFile
-	7,  // 21: chorus.ApprovalRequestService.GetApprovalRequest:input_type -> chorus.GetApprovalRequestRequest
-	0,  // 22: chorus.ApprovalRequestService.ListApprovalRequests:input_type -> chorus.ListApprovalRequestsRequest
-	4,  // 23: chorus.ApprovalRequestService.CountMyApprovalRequests:input_type -> chorus.CountMyApprovalRequestsRequest
-	10, // 24: chorus.ApprovalRequestService.CreateDataExtractionRequest:input_type -> chorus.CreateDataExtractionRequestRequest
-	13, // 25: chorus.ApprovalRequestService.CreateDataTransferRequest:input_type -> chorus.CreateDataTransferRequestRequest
-	16, // 26: chorus.ApprovalRequestService.ApproveApprovalRequest:input_type -> chorus.ApproveApprovalRequestRequest
-	19, // 27: chorus.ApprovalRequestService.DeleteApprovalRequest:input_type -> chorus.DeleteApprovalRequestRequest
-	22, // 28: chorus.ApprovalRequestService.DownloadApprovalRequestFile:input_type -> chorus.DownloadApprovalRequestFileRequest
-	8,  // 29: chorus.ApprovalRequestService.GetApprovalRequest:output_type -> chorus.GetApprovalRequestReply
-	1,  // 30: chorus.ApprovalRequestService.ListApprovalRequests:output_type -> chorus.ListApprovalRequestsReply
-	5,  // 31: chorus.ApprovalRequestService.CountMyApprovalRequests:output_type -> chorus.CountMyApprovalRequestsReply
-	11, // 32: chorus.ApprovalRequestService.CreateDataExtractionRequest:output_type -> chorus.CreateDataExtractionRequestReply
-	14, // 33: chorus.ApprovalRequestService.CreateDataTransferRequest:output_type -> chorus.CreateDataTransferRequestReply
-	17, // 34: chorus.ApprovalRequestService.ApproveApprovalRequest:output_type -> chorus.ApproveApprovalRequestReply
-	20, // 35: chorus.ApprovalRequestService.DeleteApprovalRequest:output_type -> chorus.DeleteApprovalRequestReply
-	23, // 36: chorus.ApprovalRequestService.DownloadApprovalRequestFile:output_type -> chorus.DownloadApprovalRequestFileReply
-	29, // [29:37] is the sub-list for method output_type
-	21, // [21:29] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	32, // 15: chorus.CreateDataTransferRequestResult.approvalRequest:type_name -> chorus.ApprovalRequest
+	18, // 16: chorus.CreateWorkspaceAccessRequestReply.result:type_name -> chorus.CreateWorkspaceAccessRequestResult
+	32, // 17: chorus.CreateWorkspaceAccessRequestResult.approvalRequest:type_name -> chorus.ApprovalRequest
+	21, // 18: chorus.ApproveApprovalRequestReply.result:type_name -> chorus.ApproveApprovalRequestResult
+	32, // 19: chorus.ApproveApprovalRequestResult.approvalRequest:type_name -> chorus.ApprovalRequest
+	24, // 20: chorus.DeleteApprovalRequestReply.result:type_name -> chorus.DeleteApprovalRequestResult
+	27, // 21: chorus.DownloadApprovalRequestFileReply.result:type_name -> chorus.DownloadApprovalRequestFileResult
+	35, // 22: chorus.DownloadApprovalRequestFileResult.file:type_name -> chorus.ApprovalRequestFile
+	7,  // 23: chorus.ApprovalRequestService.GetApprovalRequest:input_type -> chorus.GetApprovalRequestRequest
+	0,  // 24: chorus.ApprovalRequestService.ListApprovalRequests:input_type -> chorus.ListApprovalRequestsRequest
+	4,  // 25: chorus.ApprovalRequestService.CountMyApprovalRequests:input_type -> chorus.CountMyApprovalRequestsRequest
+	10, // 26: chorus.ApprovalRequestService.CreateDataExtractionRequest:input_type -> chorus.CreateDataExtractionRequestRequest
+	13, // 27: chorus.ApprovalRequestService.CreateDataTransferRequest:input_type -> chorus.CreateDataTransferRequestRequest
+	16, // 28: chorus.ApprovalRequestService.CreateWorkspaceAccessRequest:input_type -> chorus.CreateWorkspaceAccessRequestRequest
+	19, // 29: chorus.ApprovalRequestService.ApproveApprovalRequest:input_type -> chorus.ApproveApprovalRequestRequest
+	22, // 30: chorus.ApprovalRequestService.DeleteApprovalRequest:input_type -> chorus.DeleteApprovalRequestRequest
+	25, // 31: chorus.ApprovalRequestService.DownloadApprovalRequestFile:input_type -> chorus.DownloadApprovalRequestFileRequest
+	8,  // 32: chorus.ApprovalRequestService.GetApprovalRequest:output_type -> chorus.GetApprovalRequestReply
+	1,  // 33: chorus.ApprovalRequestService.ListApprovalRequests:output_type -> chorus.ListApprovalRequestsReply
+	5,  // 34: chorus.ApprovalRequestService.CountMyApprovalRequests:output_type -> chorus.CountMyApprovalRequestsReply
+	11, // 35: chorus.ApprovalRequestService.CreateDataExtractionRequest:output_type -> chorus.CreateDataExtractionRequestReply
+	14, // 36: chorus.ApprovalRequestService.CreateDataTransferRequest:output_type -> chorus.CreateDataTransferRequestReply
+	17, // 37: chorus.ApprovalRequestService.CreateWorkspaceAccessRequest:output_type -> chorus.CreateWorkspaceAccessRequestReply
+	20, // 38: chorus.ApprovalRequestService.ApproveApprovalRequest:output_type -> chorus.ApproveApprovalRequestReply
+	23, // 39: chorus.ApprovalRequestService.DeleteApprovalRequest:output_type -> chorus.DeleteApprovalRequestReply
+	26, // 40: chorus.ApprovalRequestService.DownloadApprovalRequestFile:output_type -> chorus.DownloadApprovalRequestFileReply
+	32, // [32:41] is the sub-list for method output_type
+	23, // [23:32] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_approval_request_service_proto_init() }
@@ -2036,7 +2259,7 @@ func file_approval_request_service_proto_init() {
 			}
 		}
 		file_approval_request_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApproveApprovalRequestRequest); i {
+			switch v := v.(*CreateWorkspaceAccessRequestRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2048,7 +2271,7 @@ func file_approval_request_service_proto_init() {
 			}
 		}
 		file_approval_request_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApproveApprovalRequestReply); i {
+			switch v := v.(*CreateWorkspaceAccessRequestReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2060,7 +2283,7 @@ func file_approval_request_service_proto_init() {
 			}
 		}
 		file_approval_request_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApproveApprovalRequestResult); i {
+			switch v := v.(*CreateWorkspaceAccessRequestResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2072,7 +2295,7 @@ func file_approval_request_service_proto_init() {
 			}
 		}
 		file_approval_request_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteApprovalRequestRequest); i {
+			switch v := v.(*ApproveApprovalRequestRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2084,7 +2307,7 @@ func file_approval_request_service_proto_init() {
 			}
 		}
 		file_approval_request_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteApprovalRequestReply); i {
+			switch v := v.(*ApproveApprovalRequestReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2096,7 +2319,7 @@ func file_approval_request_service_proto_init() {
 			}
 		}
 		file_approval_request_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteApprovalRequestResult); i {
+			switch v := v.(*ApproveApprovalRequestResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2108,7 +2331,7 @@ func file_approval_request_service_proto_init() {
 			}
 		}
 		file_approval_request_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DownloadApprovalRequestFileRequest); i {
+			switch v := v.(*DeleteApprovalRequestRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2120,7 +2343,7 @@ func file_approval_request_service_proto_init() {
 			}
 		}
 		file_approval_request_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DownloadApprovalRequestFileReply); i {
+			switch v := v.(*DeleteApprovalRequestReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2132,6 +2355,42 @@ func file_approval_request_service_proto_init() {
 			}
 		}
 		file_approval_request_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteApprovalRequestResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_approval_request_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadApprovalRequestFileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_approval_request_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadApprovalRequestFileReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_approval_request_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DownloadApprovalRequestFileResult); i {
 			case 0:
 				return &v.state
@@ -2147,14 +2406,14 @@ func file_approval_request_service_proto_init() {
 	file_approval_request_service_proto_msgTypes[0].OneofWrappers = []interface{}{}
 	file_approval_request_service_proto_msgTypes[1].OneofWrappers = []interface{}{}
 	file_approval_request_service_proto_msgTypes[3].OneofWrappers = []interface{}{}
-	file_approval_request_service_proto_msgTypes[16].OneofWrappers = []interface{}{}
+	file_approval_request_service_proto_msgTypes[19].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_approval_request_service_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   27,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -2185,6 +2444,7 @@ type ApprovalRequestServiceClient interface {
 	CountMyApprovalRequests(ctx context.Context, in *CountMyApprovalRequestsRequest, opts ...grpc.CallOption) (*CountMyApprovalRequestsReply, error)
 	CreateDataExtractionRequest(ctx context.Context, in *CreateDataExtractionRequestRequest, opts ...grpc.CallOption) (*CreateDataExtractionRequestReply, error)
 	CreateDataTransferRequest(ctx context.Context, in *CreateDataTransferRequestRequest, opts ...grpc.CallOption) (*CreateDataTransferRequestReply, error)
+	CreateWorkspaceAccessRequest(ctx context.Context, in *CreateWorkspaceAccessRequestRequest, opts ...grpc.CallOption) (*CreateWorkspaceAccessRequestReply, error)
 	ApproveApprovalRequest(ctx context.Context, in *ApproveApprovalRequestRequest, opts ...grpc.CallOption) (*ApproveApprovalRequestReply, error)
 	DeleteApprovalRequest(ctx context.Context, in *DeleteApprovalRequestRequest, opts ...grpc.CallOption) (*DeleteApprovalRequestReply, error)
 	DownloadApprovalRequestFile(ctx context.Context, in *DownloadApprovalRequestFileRequest, opts ...grpc.CallOption) (*DownloadApprovalRequestFileReply, error)
@@ -2243,6 +2503,15 @@ func (c *approvalRequestServiceClient) CreateDataTransferRequest(ctx context.Con
 	return out, nil
 }
 
+func (c *approvalRequestServiceClient) CreateWorkspaceAccessRequest(ctx context.Context, in *CreateWorkspaceAccessRequestRequest, opts ...grpc.CallOption) (*CreateWorkspaceAccessRequestReply, error) {
+	out := new(CreateWorkspaceAccessRequestReply)
+	err := c.cc.Invoke(ctx, "/chorus.ApprovalRequestService/CreateWorkspaceAccessRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *approvalRequestServiceClient) ApproveApprovalRequest(ctx context.Context, in *ApproveApprovalRequestRequest, opts ...grpc.CallOption) (*ApproveApprovalRequestReply, error) {
 	out := new(ApproveApprovalRequestReply)
 	err := c.cc.Invoke(ctx, "/chorus.ApprovalRequestService/ApproveApprovalRequest", in, out, opts...)
@@ -2277,6 +2546,7 @@ type ApprovalRequestServiceServer interface {
 	CountMyApprovalRequests(context.Context, *CountMyApprovalRequestsRequest) (*CountMyApprovalRequestsReply, error)
 	CreateDataExtractionRequest(context.Context, *CreateDataExtractionRequestRequest) (*CreateDataExtractionRequestReply, error)
 	CreateDataTransferRequest(context.Context, *CreateDataTransferRequestRequest) (*CreateDataTransferRequestReply, error)
+	CreateWorkspaceAccessRequest(context.Context, *CreateWorkspaceAccessRequestRequest) (*CreateWorkspaceAccessRequestReply, error)
 	ApproveApprovalRequest(context.Context, *ApproveApprovalRequestRequest) (*ApproveApprovalRequestReply, error)
 	DeleteApprovalRequest(context.Context, *DeleteApprovalRequestRequest) (*DeleteApprovalRequestReply, error)
 	DownloadApprovalRequestFile(context.Context, *DownloadApprovalRequestFileRequest) (*DownloadApprovalRequestFileReply, error)
@@ -2301,6 +2571,9 @@ func (*UnimplementedApprovalRequestServiceServer) CreateDataExtractionRequest(co
 func (*UnimplementedApprovalRequestServiceServer) CreateDataTransferRequest(context.Context, *CreateDataTransferRequestRequest) (*CreateDataTransferRequestReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateDataTransferRequest not implemented")
 }
+func (*UnimplementedApprovalRequestServiceServer) CreateWorkspaceAccessRequest(context.Context, *CreateWorkspaceAccessRequestRequest) (*CreateWorkspaceAccessRequestReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWorkspaceAccessRequest not implemented")
+}
 func (*UnimplementedApprovalRequestServiceServer) ApproveApprovalRequest(context.Context, *ApproveApprovalRequestRequest) (*ApproveApprovalRequestReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ApproveApprovalRequest not implemented")
 }
@@ -2405,6 +2678,24 @@ func _ApprovalRequestService_CreateDataTransferRequest_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApprovalRequestService_CreateWorkspaceAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWorkspaceAccessRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApprovalRequestServiceServer).CreateWorkspaceAccessRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.ApprovalRequestService/CreateWorkspaceAccessRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApprovalRequestServiceServer).CreateWorkspaceAccessRequest(ctx, req.(*CreateWorkspaceAccessRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApprovalRequestService_ApproveApprovalRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ApproveApprovalRequestRequest)
 	if err := dec(in); err != nil {
@@ -2483,6 +2774,10 @@ var _ApprovalRequestService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateDataTransferRequest",
 			Handler:    _ApprovalRequestService_CreateDataTransferRequest_Handler,
 		},
+		{
+			MethodName: "CreateWorkspaceAccessRequest",
+			Handler:    _ApprovalRequestService_CreateWorkspaceAccessRequest_Handler,
+		},
 		{
 			MethodName: "ApproveApprovalRequest",
 			Handler:    _ApprovalRequestService_ApproveApprovalRequest_Handler,
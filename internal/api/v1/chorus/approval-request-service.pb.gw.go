@@ -172,6 +172,30 @@ func local_request_ApprovalRequestService_CreateDataTransferRequest_0(ctx contex
 	return msg, metadata, err
 }
 
+func request_ApprovalRequestService_CreateWorkspaceAccessRequest_0(ctx context.Context, marshaler runtime.Marshaler, client ApprovalRequestServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq CreateWorkspaceAccessRequestRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := client.CreateWorkspaceAccessRequest(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_ApprovalRequestService_CreateWorkspaceAccessRequest_0(ctx context.Context, marshaler runtime.Marshaler, server ApprovalRequestServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq CreateWorkspaceAccessRequestRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.CreateWorkspaceAccessRequest(ctx, &protoReq)
+	return msg, metadata, err
+}
+
 func request_ApprovalRequestService_ApproveApprovalRequest_0(ctx context.Context, marshaler runtime.Marshaler, client ApprovalRequestServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
 		protoReq ApproveApprovalRequestRequest
@@ -410,6 +434,26 @@ func RegisterApprovalRequestServiceHandlerServer(ctx context.Context, mux *runti
 		}
 		forward_ApprovalRequestService_CreateDataTransferRequest_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodPost, pattern_ApprovalRequestService_CreateWorkspaceAccessRequest_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/chorus.ApprovalRequestService/CreateWorkspaceAccessRequest", runtime.WithHTTPPathPattern("/api/rest/v1/approval-requests/workspace-access"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_ApprovalRequestService_CreateWorkspaceAccessRequest_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_ApprovalRequestService_CreateWorkspaceAccessRequest_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 	mux.Handle(http.MethodPost, pattern_ApprovalRequestService_ApproveApprovalRequest_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -595,6 +639,23 @@ func RegisterApprovalRequestServiceHandlerClient(ctx context.Context, mux *runti
 		}
 		forward_ApprovalRequestService_CreateDataTransferRequest_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodPost, pattern_ApprovalRequestService_CreateWorkspaceAccessRequest_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/chorus.ApprovalRequestService/CreateWorkspaceAccessRequest", runtime.WithHTTPPathPattern("/api/rest/v1/approval-requests/workspace-access"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_ApprovalRequestService_CreateWorkspaceAccessRequest_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_ApprovalRequestService_CreateWorkspaceAccessRequest_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 	mux.Handle(http.MethodPost, pattern_ApprovalRequestService_ApproveApprovalRequest_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -650,23 +711,25 @@ func RegisterApprovalRequestServiceHandlerClient(ctx context.Context, mux *runti
 }
 
 var (
-	pattern_ApprovalRequestService_GetApprovalRequest_0          = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "rest", "v1", "approval-requests", "id"}, ""))
-	pattern_ApprovalRequestService_ListApprovalRequests_0        = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "rest", "v1", "approval-requests"}, ""))
-	pattern_ApprovalRequestService_CountMyApprovalRequests_0     = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4, 2, 5}, []string{"api", "rest", "v1", "approval-requests", "mine", "count"}, ""))
-	pattern_ApprovalRequestService_CreateDataExtractionRequest_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4}, []string{"api", "rest", "v1", "approval-requests", "data-extraction"}, ""))
-	pattern_ApprovalRequestService_CreateDataTransferRequest_0   = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4}, []string{"api", "rest", "v1", "approval-requests", "data-transfer"}, ""))
-	pattern_ApprovalRequestService_ApproveApprovalRequest_0      = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5}, []string{"api", "rest", "v1", "approval-requests", "id", "approve"}, ""))
-	pattern_ApprovalRequestService_DeleteApprovalRequest_0       = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "rest", "v1", "approval-requests", "id"}, ""))
-	pattern_ApprovalRequestService_DownloadApprovalRequestFile_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5, 3, 0, 4, 1, 5, 6}, []string{"api", "rest", "v1", "approval-requests", "id", "files", "path"}, ""))
+	pattern_ApprovalRequestService_GetApprovalRequest_0           = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "rest", "v1", "approval-requests", "id"}, ""))
+	pattern_ApprovalRequestService_ListApprovalRequests_0         = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "rest", "v1", "approval-requests"}, ""))
+	pattern_ApprovalRequestService_CountMyApprovalRequests_0      = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4, 2, 5}, []string{"api", "rest", "v1", "approval-requests", "mine", "count"}, ""))
+	pattern_ApprovalRequestService_CreateDataExtractionRequest_0  = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4}, []string{"api", "rest", "v1", "approval-requests", "data-extraction"}, ""))
+	pattern_ApprovalRequestService_CreateDataTransferRequest_0    = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4}, []string{"api", "rest", "v1", "approval-requests", "data-transfer"}, ""))
+	pattern_ApprovalRequestService_CreateWorkspaceAccessRequest_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4}, []string{"api", "rest", "v1", "approval-requests", "workspace-access"}, ""))
+	pattern_ApprovalRequestService_ApproveApprovalRequest_0       = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5}, []string{"api", "rest", "v1", "approval-requests", "id", "approve"}, ""))
+	pattern_ApprovalRequestService_DeleteApprovalRequest_0        = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "rest", "v1", "approval-requests", "id"}, ""))
+	pattern_ApprovalRequestService_DownloadApprovalRequestFile_0  = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5, 3, 0, 4, 1, 5, 6}, []string{"api", "rest", "v1", "approval-requests", "id", "files", "path"}, ""))
 )
 
 var (
-	forward_ApprovalRequestService_GetApprovalRequest_0          = runtime.ForwardResponseMessage
-	forward_ApprovalRequestService_ListApprovalRequests_0        = runtime.ForwardResponseMessage
-	forward_ApprovalRequestService_CountMyApprovalRequests_0     = runtime.ForwardResponseMessage
-	forward_ApprovalRequestService_CreateDataExtractionRequest_0 = runtime.ForwardResponseMessage
-	forward_ApprovalRequestService_CreateDataTransferRequest_0   = runtime.ForwardResponseMessage
-	forward_ApprovalRequestService_ApproveApprovalRequest_0      = runtime.ForwardResponseMessage
-	forward_ApprovalRequestService_DeleteApprovalRequest_0       = runtime.ForwardResponseMessage
-	forward_ApprovalRequestService_DownloadApprovalRequestFile_0 = runtime.ForwardResponseMessage
+	forward_ApprovalRequestService_GetApprovalRequest_0           = runtime.ForwardResponseMessage
+	forward_ApprovalRequestService_ListApprovalRequests_0         = runtime.ForwardResponseMessage
+	forward_ApprovalRequestService_CountMyApprovalRequests_0      = runtime.ForwardResponseMessage
+	forward_ApprovalRequestService_CreateDataExtractionRequest_0  = runtime.ForwardResponseMessage
+	forward_ApprovalRequestService_CreateDataTransferRequest_0    = runtime.ForwardResponseMessage
+	forward_ApprovalRequestService_CreateWorkspaceAccessRequest_0 = runtime.ForwardResponseMessage
+	forward_ApprovalRequestService_ApproveApprovalRequest_0       = runtime.ForwardResponseMessage
+	forward_ApprovalRequestService_DeleteApprovalRequest_0        = runtime.ForwardResponseMessage
+	forward_ApprovalRequestService_DownloadApprovalRequestFile_0  = runtime.ForwardResponseMessage
 )
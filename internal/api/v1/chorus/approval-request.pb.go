@@ -24,9 +24,12 @@ const (
 type ApprovalRequestType int32
 
 const (
-	ApprovalRequestType_APPROVAL_REQUEST_TYPE_UNSPECIFIED     ApprovalRequestType = 0
-	ApprovalRequestType_APPROVAL_REQUEST_TYPE_DATA_EXTRACTION ApprovalRequestType = 1
-	ApprovalRequestType_APPROVAL_REQUEST_TYPE_DATA_TRANSFER   ApprovalRequestType = 2
+	ApprovalRequestType_APPROVAL_REQUEST_TYPE_UNSPECIFIED           ApprovalRequestType = 0
+	ApprovalRequestType_APPROVAL_REQUEST_TYPE_DATA_EXTRACTION       ApprovalRequestType = 1
+	ApprovalRequestType_APPROVAL_REQUEST_TYPE_DATA_TRANSFER         ApprovalRequestType = 2
+	ApprovalRequestType_APPROVAL_REQUEST_TYPE_WORKSPACE_ACCESS      ApprovalRequestType = 3
+	ApprovalRequestType_APPROVAL_REQUEST_TYPE_NETWORK_POLICY_CHANGE ApprovalRequestType = 4
+	ApprovalRequestType_APPROVAL_REQUEST_TYPE_CUSTOM_APP            ApprovalRequestType = 5
 )
 
 // Enum value maps for ApprovalRequestType.
@@ -35,11 +38,17 @@ var (
 		0: "APPROVAL_REQUEST_TYPE_UNSPECIFIED",
 		1: "APPROVAL_REQUEST_TYPE_DATA_EXTRACTION",
 		2: "APPROVAL_REQUEST_TYPE_DATA_TRANSFER",
+		3: "APPROVAL_REQUEST_TYPE_WORKSPACE_ACCESS",
+		4: "APPROVAL_REQUEST_TYPE_NETWORK_POLICY_CHANGE",
+		5: "APPROVAL_REQUEST_TYPE_CUSTOM_APP",
 	}
 	ApprovalRequestType_value = map[string]int32{
-		"APPROVAL_REQUEST_TYPE_UNSPECIFIED":     0,
-		"APPROVAL_REQUEST_TYPE_DATA_EXTRACTION": 1,
-		"APPROVAL_REQUEST_TYPE_DATA_TRANSFER":   2,
+		"APPROVAL_REQUEST_TYPE_UNSPECIFIED":           0,
+		"APPROVAL_REQUEST_TYPE_DATA_EXTRACTION":       1,
+		"APPROVAL_REQUEST_TYPE_DATA_TRANSFER":         2,
+		"APPROVAL_REQUEST_TYPE_WORKSPACE_ACCESS":      3,
+		"APPROVAL_REQUEST_TYPE_NETWORK_POLICY_CHANGE": 4,
+		"APPROVAL_REQUEST_TYPE_CUSTOM_APP":            5,
 	}
 )
 
@@ -306,6 +315,222 @@ func (x *DataTransferDetails) GetFiles() []*ApprovalRequestFile {
 	return nil
 }
 
+type WorkspaceAccessDetails struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId   uint64 `protobuf:"varint,1,opt,name=workspaceId,proto3" json:"workspaceId,omitempty"`
+	RoleName      string `protobuf:"bytes,2,opt,name=roleName,proto3" json:"roleName,omitempty"`
+	Justification string `protobuf:"bytes,3,opt,name=justification,proto3" json:"justification,omitempty"`
+}
+
+func (x *WorkspaceAccessDetails) Reset() {
+	*x = WorkspaceAccessDetails{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_approval_request_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkspaceAccessDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceAccessDetails) ProtoMessage() {}
+
+func (x *WorkspaceAccessDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_approval_request_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceAccessDetails.ProtoReflect.Descriptor instead.
+func (*WorkspaceAccessDetails) Descriptor() ([]byte, []int) {
+	return file_approval_request_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WorkspaceAccessDetails) GetWorkspaceId() uint64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *WorkspaceAccessDetails) GetRoleName() string {
+	if x != nil {
+		return x.RoleName
+	}
+	return ""
+}
+
+func (x *WorkspaceAccessDetails) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+// NetworkPolicyChangeDetails describes the relaxation of a workspace network policy,
+// along with the policy in place when it was requested.
+type NetworkPolicyChangeDetails struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId          uint64      `protobuf:"varint,1,opt,name=workspaceId,proto3" json:"workspaceId,omitempty"`
+	CurrentNetworkPolicy string      `protobuf:"bytes,2,opt,name=currentNetworkPolicy,proto3" json:"currentNetworkPolicy,omitempty"`
+	CurrentAllowedFQDNs  []*FQDNRule `protobuf:"bytes,3,rep,name=currentAllowedFQDNs,proto3" json:"currentAllowedFQDNs,omitempty"`
+	NetworkPolicy        string      `protobuf:"bytes,4,opt,name=networkPolicy,proto3" json:"networkPolicy,omitempty"`
+	AllowedFQDNs         []*FQDNRule `protobuf:"bytes,5,rep,name=allowedFQDNs,proto3" json:"allowedFQDNs,omitempty"`
+}
+
+func (x *NetworkPolicyChangeDetails) Reset() {
+	*x = NetworkPolicyChangeDetails{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_approval_request_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetworkPolicyChangeDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkPolicyChangeDetails) ProtoMessage() {}
+
+func (x *NetworkPolicyChangeDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_approval_request_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkPolicyChangeDetails.ProtoReflect.Descriptor instead.
+func (*NetworkPolicyChangeDetails) Descriptor() ([]byte, []int) {
+	return file_approval_request_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *NetworkPolicyChangeDetails) GetWorkspaceId() uint64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *NetworkPolicyChangeDetails) GetCurrentNetworkPolicy() string {
+	if x != nil {
+		return x.CurrentNetworkPolicy
+	}
+	return ""
+}
+
+func (x *NetworkPolicyChangeDetails) GetCurrentAllowedFQDNs() []*FQDNRule {
+	if x != nil {
+		return x.CurrentAllowedFQDNs
+	}
+	return nil
+}
+
+func (x *NetworkPolicyChangeDetails) GetNetworkPolicy() string {
+	if x != nil {
+		return x.NetworkPolicy
+	}
+	return ""
+}
+
+func (x *NetworkPolicyChangeDetails) GetAllowedFQDNs() []*FQDNRule {
+	if x != nil {
+		return x.AllowedFQDNs
+	}
+	return nil
+}
+
+// CustomAppDetails describes a custom app registered by the maintainers of a workspace.
+type CustomAppDetails struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId uint64 `protobuf:"varint,1,opt,name=workspaceId,proto3" json:"workspaceId,omitempty"`
+	AppId       uint64 `protobuf:"varint,2,opt,name=appId,proto3" json:"appId,omitempty"`
+	AppName     string `protobuf:"bytes,3,opt,name=appName,proto3" json:"appName,omitempty"`
+	Image       string `protobuf:"bytes,4,opt,name=image,proto3" json:"image,omitempty"`
+}
+
+func (x *CustomAppDetails) Reset() {
+	*x = CustomAppDetails{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_approval_request_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomAppDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomAppDetails) ProtoMessage() {}
+
+func (x *CustomAppDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_approval_request_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomAppDetails.ProtoReflect.Descriptor instead.
+func (*CustomAppDetails) Descriptor() ([]byte, []int) {
+	return file_approval_request_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CustomAppDetails) GetWorkspaceId() uint64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *CustomAppDetails) GetAppId() uint64 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+func (x *CustomAppDetails) GetAppName() string {
+	if x != nil {
+		return x.AppName
+	}
+	return ""
+}
+
+func (x *CustomAppDetails) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
 // ApproverIds is a list of user ids.
 type ApproverIds struct {
 	state         protoimpl.MessageState
@@ -318,7 +543,7 @@ type ApproverIds struct {
 func (x *ApproverIds) Reset() {
 	*x = ApproverIds{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_approval_request_proto_msgTypes[3]
+		mi := &file_approval_request_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -331,7 +556,7 @@ func (x *ApproverIds) String() string {
 func (*ApproverIds) ProtoMessage() {}
 
 func (x *ApproverIds) ProtoReflect() protoreflect.Message {
-	mi := &file_approval_request_proto_msgTypes[3]
+	mi := &file_approval_request_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -344,7 +569,7 @@ func (x *ApproverIds) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApproverIds.ProtoReflect.Descriptor instead.
 func (*ApproverIds) Descriptor() ([]byte, []int) {
-	return file_approval_request_proto_rawDescGZIP(), []int{3}
+	return file_approval_request_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ApproverIds) GetIds() []uint64 {
@@ -368,7 +593,7 @@ type ApprovalStepDecision struct {
 func (x *ApprovalStepDecision) Reset() {
 	*x = ApprovalStepDecision{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_approval_request_proto_msgTypes[4]
+		mi := &file_approval_request_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -381,7 +606,7 @@ func (x *ApprovalStepDecision) String() string {
 func (*ApprovalStepDecision) ProtoMessage() {}
 
 func (x *ApprovalStepDecision) ProtoReflect() protoreflect.Message {
-	mi := &file_approval_request_proto_msgTypes[4]
+	mi := &file_approval_request_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -394,7 +619,7 @@ func (x *ApprovalStepDecision) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApprovalStepDecision.ProtoReflect.Descriptor instead.
 func (*ApprovalStepDecision) Descriptor() ([]byte, []int) {
-	return file_approval_request_proto_rawDescGZIP(), []int{4}
+	return file_approval_request_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ApprovalStepDecision) GetApproverId() uint64 {
@@ -422,7 +647,9 @@ func (x *ApprovalStepDecision) GetApprove() bool {
 // extraction has one step, "download" (data leaving the source workspace);
 // a data transfer adds "upload" (data entering the destination). The request
 // is approved once every required step is approved, and rejected the moment
-// any step is rejected.
+// any step is rejected. A workspace access request has a single "access" step,
+// a network policy change a single "network_policy" step and a custom app a
+// single "custom_app" step.
 type ApprovalRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -439,8 +666,11 @@ type ApprovalRequest struct {
 	//
 	//	*ApprovalRequest_DataExtraction
 	//	*ApprovalRequest_DataTransfer
+	//	*ApprovalRequest_WorkspaceAccess
+	//	*ApprovalRequest_NetworkPolicyChange
+	//	*ApprovalRequest_CustomApp
 	Details isApprovalRequest_Details `protobuf_oneof:"details"`
-	// Users allowed to approve each step, keyed by step name ("download", "upload").
+	// Users allowed to approve each step, keyed by step name ("download", "upload", "access", "network_policy", "custom_app").
 	ApproverIdsByStep map[string]*ApproverIds `protobuf:"bytes,10,rep,name=approverIdsByStep,proto3" json:"approverIdsByStep,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// Decisions recorded for each step so far, keyed by step name.
 	StepDecisions   map[string]*ApprovalStepDecision `protobuf:"bytes,11,rep,name=stepDecisions,proto3" json:"stepDecisions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
@@ -454,7 +684,7 @@ type ApprovalRequest struct {
 func (x *ApprovalRequest) Reset() {
 	*x = ApprovalRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_approval_request_proto_msgTypes[5]
+		mi := &file_approval_request_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -467,7 +697,7 @@ func (x *ApprovalRequest) String() string {
 func (*ApprovalRequest) ProtoMessage() {}
 
 func (x *ApprovalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_approval_request_proto_msgTypes[5]
+	mi := &file_approval_request_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -480,7 +710,7 @@ func (x *ApprovalRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApprovalRequest.ProtoReflect.Descriptor instead.
 func (*ApprovalRequest) Descriptor() ([]byte, []int) {
-	return file_approval_request_proto_rawDescGZIP(), []int{5}
+	return file_approval_request_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ApprovalRequest) GetId() uint64 {
@@ -553,6 +783,27 @@ func (x *ApprovalRequest) GetDataTransfer() *DataTransferDetails {
 	return nil
 }
 
+func (x *ApprovalRequest) GetWorkspaceAccess() *WorkspaceAccessDetails {
+	if x, ok := x.GetDetails().(*ApprovalRequest_WorkspaceAccess); ok {
+		return x.WorkspaceAccess
+	}
+	return nil
+}
+
+func (x *ApprovalRequest) GetNetworkPolicyChange() *NetworkPolicyChangeDetails {
+	if x, ok := x.GetDetails().(*ApprovalRequest_NetworkPolicyChange); ok {
+		return x.NetworkPolicyChange
+	}
+	return nil
+}
+
+func (x *ApprovalRequest) GetCustomApp() *CustomAppDetails {
+	if x, ok := x.GetDetails().(*ApprovalRequest_CustomApp); ok {
+		return x.CustomApp
+	}
+	return nil
+}
+
 func (x *ApprovalRequest) GetApproverIdsByStep() map[string]*ApproverIds {
 	if x != nil {
 		return x.ApproverIdsByStep
@@ -614,10 +865,28 @@ type ApprovalRequest_DataTransfer struct {
 	DataTransfer *DataTransferDetails `protobuf:"bytes,9,opt,name=dataTransfer,proto3,oneof"`
 }
 
+type ApprovalRequest_WorkspaceAccess struct {
+	WorkspaceAccess *WorkspaceAccessDetails `protobuf:"bytes,17,opt,name=workspaceAccess,proto3,oneof"`
+}
+
+type ApprovalRequest_NetworkPolicyChange struct {
+	NetworkPolicyChange *NetworkPolicyChangeDetails `protobuf:"bytes,18,opt,name=networkPolicyChange,proto3,oneof"`
+}
+
+type ApprovalRequest_CustomApp struct {
+	CustomApp *CustomAppDetails `protobuf:"bytes,19,opt,name=customApp,proto3,oneof"`
+}
+
 func (*ApprovalRequest_DataExtraction) isApprovalRequest_Details() {}
 
 func (*ApprovalRequest_DataTransfer) isApprovalRequest_Details() {}
 
+func (*ApprovalRequest_WorkspaceAccess) isApprovalRequest_Details() {}
+
+func (*ApprovalRequest_NetworkPolicyChange) isApprovalRequest_Details() {}
+
+func (*ApprovalRequest_CustomApp) isApprovalRequest_Details() {}
+
 var File_approval_request_proto protoreflect.FileDescriptor
 
 var file_approval_request_proto_rawDesc = []byte{
@@ -625,33 +894,67 @@ var file_approval_request_proto_rawDesc = []byte{
 	0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73,
 	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
 	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x73, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x65, 0x73, 0x74,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61,
-	0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x78, 0x0a, 0x15, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78,
-	0x74, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12,
-	0x2c, 0x0a, 0x11, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x31, 0x0a,
-	0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63,
-	0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73,
-	0x22, 0xae, 0x01, 0x0a, 0x13, 0x44, 0x61, 0x74, 0x61, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
-	0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x11, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x16, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x31,
-	0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x6f, 0x1a, 0x0f, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x73, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x78, 0x0a, 0x15, 0x44, 0x61, 0x74, 0x61, 0x45,
+	0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x12, 0x2c, 0x0a, 0x11, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x31,
+	0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
 	0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65,
-	0x73, 0x22, 0x1f, 0x0a, 0x0b, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x49, 0x64, 0x73,
+	0x73, 0x22, 0xae, 0x01, 0x0a, 0x13, 0x44, 0x61, 0x74, 0x61, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x57, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x16, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12,
+	0x31, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x66, 0x69, 0x6c,
+	0x65, 0x73, 0x22, 0x7c, 0x0a, 0x16, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x75,
+	0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x92, 0x02, 0x0a, 0x1a, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12,
+	0x20, 0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49,
+	0x64, 0x12, 0x32, 0x0a, 0x14, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x14, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x42, 0x0a, 0x13, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x46, 0x51, 0x44, 0x4e, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x46, 0x51, 0x44, 0x4e,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x13, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x46, 0x51, 0x44, 0x4e, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x34, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x46, 0x51, 0x44, 0x4e, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x46,
+	0x51, 0x44, 0x4e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x46, 0x51, 0x44, 0x4e, 0x73, 0x22, 0x7a, 0x0a, 0x10, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x41,
+	0x70, 0x70, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x77, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x70, 0x70, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x22, 0x1f, 0x0a, 0x0b, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x49, 0x64, 0x73,
 	0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x03, 0x69,
 	0x64, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x14, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53,
 	0x74, 0x65, 0x70, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x61,
@@ -662,7 +965,7 @@ var file_approval_request_proto_rawDesc = []byte{
 	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x61, 0x70, 0x70,
 	0x72, 0x6f, 0x76, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x72, 0x6f,
 	0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
-	0x65, 0x22, 0x93, 0x08, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65,
+	0x65, 0x22, 0xf1, 0x09, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49,
 	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49,
@@ -687,71 +990,93 @@ var file_approval_request_proto_rawDesc = []byte{
 	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x61,
 	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x48,
 	0x00, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12,
-	0x5c, 0x0a, 0x11, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x49, 0x64, 0x73, 0x42, 0x79,
-	0x53, 0x74, 0x65, 0x70, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x63, 0x68, 0x6f,
-	0x72, 0x75, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x49, 0x64, 0x73, 0x42,
-	0x79, 0x53, 0x74, 0x65, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x61, 0x70, 0x70, 0x72,
-	0x6f, 0x76, 0x65, 0x72, 0x49, 0x64, 0x73, 0x42, 0x79, 0x53, 0x74, 0x65, 0x70, 0x12, 0x50, 0x0a,
-	0x0d, 0x73, 0x74, 0x65, 0x70, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x41, 0x70,
-	0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74,
-	0x65, 0x70, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x0d, 0x73, 0x74, 0x65, 0x70, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x22, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x6f, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x61, 0x75, 0x74, 0x6f, 0x41, 0x70, 0x70, 0x72, 0x6f,
-	0x76, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x70,
-	0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3f, 0x0a,
-	0x0a, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52,
-	0x0a, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x38,
-	0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x1a, 0x59, 0x0a, 0x16, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x49, 0x64,
-	0x73, 0x42, 0x79, 0x53, 0x74, 0x65, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x49,
-	0x64, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5e, 0x0a,
-	0x12, 0x53, 0x74, 0x65, 0x70, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x41, 0x70,
-	0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x74, 0x65, 0x70, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x09, 0x0a,
-	0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x61, 0x70, 0x70,
-	0x72, 0x6f, 0x76, 0x65, 0x64, 0x41, 0x74, 0x2a, 0x90, 0x01, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x72,
-	0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x4a, 0x0a, 0x0f, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75,
+	0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x48, 0x00, 0x52, 0x0f, 0x77, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x56, 0x0a, 0x13, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75,
+	0x73, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x48, 0x00, 0x52, 0x13,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x41, 0x70, 0x70,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x41, 0x70, 0x70, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x48, 0x00, 0x52, 0x09, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x41, 0x70, 0x70, 0x12, 0x5c, 0x0a,
+	0x11, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x49, 0x64, 0x73, 0x42, 0x79, 0x53, 0x74,
+	0x65, 0x70, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75,
+	0x73, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x49, 0x64, 0x73, 0x42, 0x79, 0x53,
+	0x74, 0x65, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x72, 0x49, 0x64, 0x73, 0x42, 0x79, 0x53, 0x74, 0x65, 0x70, 0x12, 0x50, 0x0a, 0x0d, 0x73,
+	0x74, 0x65, 0x70, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x65, 0x70,
+	0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d,
+	0x73, 0x74, 0x65, 0x70, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x22, 0x0a,
+	0x0c, 0x61, 0x75, 0x74, 0x6f, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x61, 0x75, 0x74, 0x6f, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x64, 0x12, 0x28, 0x0a, 0x0f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x61, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3f, 0x0a, 0x0a, 0x61,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0a, 0x61,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x1a, 0x59, 0x0a, 0x16, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x49, 0x64, 0x73, 0x42,
+	0x79, 0x53, 0x74, 0x65, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x68,
+	0x6f, 0x72, 0x75, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x49, 0x64, 0x73,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5e, 0x0a, 0x12, 0x53,
+	0x74, 0x65, 0x70, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x61, 0x6c, 0x53, 0x74, 0x65, 0x70, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x64,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x64, 0x41, 0x74, 0x2a, 0x93, 0x02, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a,
+	0x21, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x29, 0x0a, 0x25, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x41, 0x4c,
+	0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x41,
+	0x54, 0x41, 0x5f, 0x45, 0x58, 0x54, 0x52, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12,
+	0x27, 0x0a, 0x23, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x51, 0x55,
+	0x45, 0x53, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x54, 0x52,
+	0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x10, 0x02, 0x12, 0x2a, 0x0a, 0x26, 0x41, 0x50, 0x50, 0x52,
+	0x4f, 0x56, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x41, 0x43, 0x43, 0x45,
+	0x53, 0x53, 0x10, 0x03, 0x12, 0x2f, 0x0a, 0x2b, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x41, 0x4c,
+	0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x45,
+	0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x43, 0x48, 0x41,
+	0x4e, 0x47, 0x45, 0x10, 0x04, 0x12, 0x24, 0x0a, 0x20, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x41,
+	0x4c, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43,
+	0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x41, 0x50, 0x50, 0x10, 0x05, 0x2a, 0xd8, 0x01, 0x0a, 0x15,
+	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x23, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x41,
+	0x4c, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x23,
+	0x0a, 0x1f, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45,
+	0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e,
+	0x47, 0x10, 0x01, 0x12, 0x24, 0x0a, 0x20, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x41, 0x4c, 0x5f,
+	0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41,
+	0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x02, 0x12, 0x24, 0x0a, 0x20, 0x41, 0x50, 0x50,
+	0x52, 0x4f, 0x56, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12,
 	0x25, 0x0a, 0x21, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x51, 0x55,
-	0x45, 0x53, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
-	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x29, 0x0a, 0x25, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56,
-	0x41, 0x4c, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x44, 0x41, 0x54, 0x41, 0x5f, 0x45, 0x58, 0x54, 0x52, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10,
-	0x01, 0x12, 0x27, 0x0a, 0x23, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x41, 0x4c, 0x5f, 0x52, 0x45,
-	0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f,
-	0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x10, 0x02, 0x2a, 0xd8, 0x01, 0x0a, 0x15, 0x41,
-	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x23, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x41, 0x4c,
-	0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
-	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x23, 0x0a,
-	0x1f, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53,
-	0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47,
-	0x10, 0x01, 0x12, 0x24, 0x0a, 0x20, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x41, 0x4c, 0x5f, 0x52,
-	0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x50,
-	0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x02, 0x12, 0x24, 0x0a, 0x20, 0x41, 0x50, 0x50, 0x52,
-	0x4f, 0x56, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x25,
-	0x0a, 0x21, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45,
-	0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c,
-	0x4c, 0x45, 0x44, 0x10, 0x04, 0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x3b, 0x63, 0x68, 0x6f, 0x72, 0x75,
-	0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45,
+	0x4c, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x3b, 0x63, 0x68, 0x6f, 0x72,
+	0x75, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -767,40 +1092,49 @@ func file_approval_request_proto_rawDescGZIP() []byte {
 }
 
 var file_approval_request_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_approval_request_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_approval_request_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_approval_request_proto_goTypes = []interface{}{
-	(ApprovalRequestType)(0),      // 0: chorus.ApprovalRequestType
-	(ApprovalRequestStatus)(0),    // 1: chorus.ApprovalRequestStatus
-	(*ApprovalRequestFile)(nil),   // 2: chorus.ApprovalRequestFile
-	(*DataExtractionDetails)(nil), // 3: chorus.DataExtractionDetails
-	(*DataTransferDetails)(nil),   // 4: chorus.DataTransferDetails
-	(*ApproverIds)(nil),           // 5: chorus.ApproverIds
-	(*ApprovalStepDecision)(nil),  // 6: chorus.ApprovalStepDecision
-	(*ApprovalRequest)(nil),       // 7: chorus.ApprovalRequest
-	nil,                           // 8: chorus.ApprovalRequest.ApproverIdsByStepEntry
-	nil,                           // 9: chorus.ApprovalRequest.StepDecisionsEntry
-	(*timestamppb.Timestamp)(nil), // 10: google.protobuf.Timestamp
+	(ApprovalRequestType)(0),           // 0: chorus.ApprovalRequestType
+	(ApprovalRequestStatus)(0),         // 1: chorus.ApprovalRequestStatus
+	(*ApprovalRequestFile)(nil),        // 2: chorus.ApprovalRequestFile
+	(*DataExtractionDetails)(nil),      // 3: chorus.DataExtractionDetails
+	(*DataTransferDetails)(nil),        // 4: chorus.DataTransferDetails
+	(*WorkspaceAccessDetails)(nil),     // 5: chorus.WorkspaceAccessDetails
+	(*NetworkPolicyChangeDetails)(nil), // 6: chorus.NetworkPolicyChangeDetails
+	(*CustomAppDetails)(nil),           // 7: chorus.CustomAppDetails
+	(*ApproverIds)(nil),                // 8: chorus.ApproverIds
+	(*ApprovalStepDecision)(nil),       // 9: chorus.ApprovalStepDecision
+	(*ApprovalRequest)(nil),            // 10: chorus.ApprovalRequest
+	nil,                                // 11: chorus.ApprovalRequest.ApproverIdsByStepEntry
+	nil,                                // 12: chorus.ApprovalRequest.StepDecisionsEntry
+	(*FQDNRule)(nil),                   // 13: chorus.FQDNRule
+	(*timestamppb.Timestamp)(nil),      // 14: google.protobuf.Timestamp
 }
 var file_approval_request_proto_depIdxs = []int32{
 	2,  // 0: chorus.DataExtractionDetails.files:type_name -> chorus.ApprovalRequestFile
 	2,  // 1: chorus.DataTransferDetails.files:type_name -> chorus.ApprovalRequestFile
-	10, // 2: chorus.ApprovalStepDecision.approvedAt:type_name -> google.protobuf.Timestamp
-	0,  // 3: chorus.ApprovalRequest.type:type_name -> chorus.ApprovalRequestType
-	1,  // 4: chorus.ApprovalRequest.status:type_name -> chorus.ApprovalRequestStatus
-	3,  // 5: chorus.ApprovalRequest.dataExtraction:type_name -> chorus.DataExtractionDetails
-	4,  // 6: chorus.ApprovalRequest.dataTransfer:type_name -> chorus.DataTransferDetails
-	8,  // 7: chorus.ApprovalRequest.approverIdsByStep:type_name -> chorus.ApprovalRequest.ApproverIdsByStepEntry
-	9,  // 8: chorus.ApprovalRequest.stepDecisions:type_name -> chorus.ApprovalRequest.StepDecisionsEntry
-	10, // 9: chorus.ApprovalRequest.approvedAt:type_name -> google.protobuf.Timestamp
-	10, // 10: chorus.ApprovalRequest.createdAt:type_name -> google.protobuf.Timestamp
-	10, // 11: chorus.ApprovalRequest.updatedAt:type_name -> google.protobuf.Timestamp
-	5,  // 12: chorus.ApprovalRequest.ApproverIdsByStepEntry.value:type_name -> chorus.ApproverIds
-	6,  // 13: chorus.ApprovalRequest.StepDecisionsEntry.value:type_name -> chorus.ApprovalStepDecision
-	14, // [14:14] is the sub-list for method output_type
-	14, // [14:14] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	13, // 2: chorus.NetworkPolicyChangeDetails.currentAllowedFQDNs:type_name -> chorus.FQDNRule
+	13, // 3: chorus.NetworkPolicyChangeDetails.allowedFQDNs:type_name -> chorus.FQDNRule
+	14, // 4: chorus.ApprovalStepDecision.approvedAt:type_name -> google.protobuf.Timestamp
+	0,  // 5: chorus.ApprovalRequest.type:type_name -> chorus.ApprovalRequestType
+	1,  // 6: chorus.ApprovalRequest.status:type_name -> chorus.ApprovalRequestStatus
+	3,  // 7: chorus.ApprovalRequest.dataExtraction:type_name -> chorus.DataExtractionDetails
+	4,  // 8: chorus.ApprovalRequest.dataTransfer:type_name -> chorus.DataTransferDetails
+	5,  // 9: chorus.ApprovalRequest.workspaceAccess:type_name -> chorus.WorkspaceAccessDetails
+	6,  // 10: chorus.ApprovalRequest.networkPolicyChange:type_name -> chorus.NetworkPolicyChangeDetails
+	7,  // 11: chorus.ApprovalRequest.customApp:type_name -> chorus.CustomAppDetails
+	11, // 12: chorus.ApprovalRequest.approverIdsByStep:type_name -> chorus.ApprovalRequest.ApproverIdsByStepEntry
+	12, // 13: chorus.ApprovalRequest.stepDecisions:type_name -> chorus.ApprovalRequest.StepDecisionsEntry
+	14, // 14: chorus.ApprovalRequest.approvedAt:type_name -> google.protobuf.Timestamp
+	14, // 15: chorus.ApprovalRequest.createdAt:type_name -> google.protobuf.Timestamp
+	14, // 16: chorus.ApprovalRequest.updatedAt:type_name -> google.protobuf.Timestamp
+	8,  // 17: chorus.ApprovalRequest.ApproverIdsByStepEntry.value:type_name -> chorus.ApproverIds
+	9,  // 18: chorus.ApprovalRequest.StepDecisionsEntry.value:type_name -> chorus.ApprovalStepDecision
+	19, // [19:19] is the sub-list for method output_type
+	19, // [19:19] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_approval_request_proto_init() }
@@ -808,6 +1142,7 @@ func file_approval_request_proto_init() {
 	if File_approval_request_proto != nil {
 		return
 	}
+	file_workspace_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_approval_request_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ApprovalRequestFile); i {
@@ -846,7 +1181,7 @@ func file_approval_request_proto_init() {
 			}
 		}
 		file_approval_request_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApproverIds); i {
+			switch v := v.(*WorkspaceAccessDetails); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -858,7 +1193,7 @@ func file_approval_request_proto_init() {
 			}
 		}
 		file_approval_request_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApprovalStepDecision); i {
+			switch v := v.(*NetworkPolicyChangeDetails); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -870,6 +1205,42 @@ func file_approval_request_proto_init() {
 			}
 		}
 		file_approval_request_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomAppDetails); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_approval_request_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproverIds); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_approval_request_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApprovalStepDecision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_approval_request_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ApprovalRequest); i {
 			case 0:
 				return &v.state
@@ -882,9 +1253,12 @@ func file_approval_request_proto_init() {
 			}
 		}
 	}
-	file_approval_request_proto_msgTypes[5].OneofWrappers = []interface{}{
+	file_approval_request_proto_msgTypes[8].OneofWrappers = []interface{}{
 		(*ApprovalRequest_DataExtraction)(nil),
 		(*ApprovalRequest_DataTransfer)(nil),
+		(*ApprovalRequest_WorkspaceAccess)(nil),
+		(*ApprovalRequest_NetworkPolicyChange)(nil),
+		(*ApprovalRequest_CustomApp)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -892,7 +1266,7 @@ func file_approval_request_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_approval_request_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   8,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -45,6 +45,10 @@ const (
 	ChorusErrorCode_PERMISSION_DENIED ChorusErrorCode = 9
 	// Unrecoverable server-side error. HTTP 500.
 	ChorusErrorCode_INTERNAL_ERROR ChorusErrorCode = 10
+	// A platform limit (e.g. maximum workspaces per user) has been reached. HTTP 429.
+	ChorusErrorCode_QUOTA_EXCEEDED ChorusErrorCode = 11
+	// The resource is read-only in its current state (e.g. an archived workspace). HTTP 400.
+	ChorusErrorCode_READ_ONLY ChorusErrorCode = 12
 )
 
 // Enum value maps for ChorusErrorCode.
@@ -61,6 +65,8 @@ var (
 		8:  "TWO_FACTOR_REQUIRED",
 		9:  "PERMISSION_DENIED",
 		10: "INTERNAL_ERROR",
+		11: "QUOTA_EXCEEDED",
+		12: "READ_ONLY",
 	}
 	ChorusErrorCode_value = map[string]int32{
 		"CHORUS_ERROR_CODE_UNSPECIFIED": 0,
@@ -74,6 +80,8 @@ var (
 		"TWO_FACTOR_REQUIRED":           8,
 		"PERMISSION_DENIED":             9,
 		"INTERNAL_ERROR":                10,
+		"QUOTA_EXCEEDED":                11,
+		"READ_ONLY":                     12,
 	}
 )
 
@@ -354,7 +362,7 @@ var file_errors_proto_rawDesc = []byte{
 	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61,
 	0x69, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x68, 0x6f, 0x72,
 	0x75, 0x73, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x07,
-	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x2a, 0x8a, 0x02, 0x0a, 0x0f, 0x43, 0x68, 0x6f, 0x72,
+	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x2a, 0xad, 0x02, 0x0a, 0x0f, 0x43, 0x68, 0x6f, 0x72,
 	0x75, 0x73, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x43,
 	0x48, 0x4f, 0x52, 0x55, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45,
 	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13,
@@ -371,8 +379,10 @@ var file_errors_proto_rawDesc = []byte{
 	0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x08, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x45, 0x52,
 	0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44, 0x10, 0x09,
 	0x12, 0x12, 0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52,
-	0x4f, 0x52, 0x10, 0x0a, 0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x3b, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x4f, 0x52, 0x10, 0x0a, 0x12, 0x12, 0x0a, 0x0e, 0x51, 0x55, 0x4f, 0x54, 0x41, 0x5f, 0x45, 0x58,
+	0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x0b, 0x12, 0x0d, 0x0a, 0x09, 0x52, 0x45, 0x41, 0x44,
+	0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x0c, 0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x3b, 0x63, 0x68, 0x6f,
+	0x72, 0x75, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -299,6 +299,147 @@ func (x *UpdatePlatformSettingsResult) GetPlatformSettings() *PlatformSettings {
 	return nil
 }
 
+type GetUserUsageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId uint64 `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+}
+
+func (x *GetUserUsageRequest) Reset() {
+	*x = GetUserUsageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_platform_settings_service_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserUsageRequest) ProtoMessage() {}
+
+func (x *GetUserUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_settings_service_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetUserUsageRequest) Descriptor() ([]byte, []int) {
+	return file_platform_settings_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetUserUsageRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetUserUsageReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *GetUserUsageResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *GetUserUsageReply) Reset() {
+	*x = GetUserUsageReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_platform_settings_service_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserUsageReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserUsageReply) ProtoMessage() {}
+
+func (x *GetUserUsageReply) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_settings_service_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserUsageReply.ProtoReflect.Descriptor instead.
+func (*GetUserUsageReply) Descriptor() ([]byte, []int) {
+	return file_platform_settings_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetUserUsageReply) GetResult() *GetUserUsageResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type GetUserUsageResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Usage *UserUsage `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage,omitempty"`
+}
+
+func (x *GetUserUsageResult) Reset() {
+	*x = GetUserUsageResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_platform_settings_service_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserUsageResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserUsageResult) ProtoMessage() {}
+
+func (x *GetUserUsageResult) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_settings_service_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserUsageResult.ProtoReflect.Descriptor instead.
+func (*GetUserUsageResult) Descriptor() ([]byte, []int) {
+	return file_platform_settings_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetUserUsageResult) GetUsage() *UserUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
 var File_platform_settings_service_proto protoreflect.FileDescriptor
 
 var file_platform_settings_service_proto_rawDesc = []byte{
@@ -344,60 +485,91 @@ var file_platform_settings_service_proto_rawDesc = []byte{
 	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
 	0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x53,
 	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x10, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
-	0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x32, 0xf7, 0x04, 0x0a, 0x17, 0x50, 0x6c,
-	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xc6, 0x02, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61,
-	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x22, 0x2e,
-	0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f,
-	0x72, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x20, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c,
-	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0xe8, 0x01, 0x92, 0x41, 0xba, 0x01, 0x0a, 0x17, 0x50, 0x6c, 0x61, 0x74,
-	0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x15, 0x47, 0x65, 0x74, 0x20, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
-	0x6d, 0x20, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x85, 0x01, 0x52, 0x65, 0x74,
-	0x75, 0x72, 0x6e, 0x73, 0x20, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2d, 0x77, 0x69,
-	0x64, 0x65, 0x20, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x20, 0x69, 0x6e, 0x63, 0x6c,
-	0x75, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x20, 0x74,
-	0x69, 0x74, 0x6c, 0x65, 0x2c, 0x20, 0x74, 0x61, 0x67, 0x6c, 0x69, 0x6e, 0x65, 0x2c, 0x20, 0x72,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x2c, 0x20,
-	0x61, 0x6e, 0x64, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x74, 0x65, 0x72, 0x6d,
-	0x73, 0x20, 0x6f, 0x66, 0x20, 0x75, 0x73, 0x65, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x2e, 0x20, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x2e, 0x62, 0x00, 0x88, 0xb5, 0x18, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6c, 0x61,
-	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2d, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x92,
-	0x02, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
-	0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x25, 0x2e, 0x63, 0x68, 0x6f, 0x72,
-	0x75, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
-	0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x23, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x2d, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x47, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x32, 0x0a,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x22, 0x3d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65,
+	0x32, 0xb2, 0x07, 0x0a, 0x17, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xc6, 0x02, 0x0a,
+	0x13, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x12, 0x22, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xe8, 0x01, 0x92, 0x41, 0xba,
+	0x01, 0x0a, 0x17, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x15, 0x47, 0x65, 0x74, 0x20,
+	0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x20, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x1a, 0x85, 0x01, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x70, 0x6c, 0x61, 0x74,
+	0x66, 0x6f, 0x72, 0x6d, 0x2d, 0x77, 0x69, 0x64, 0x65, 0x20, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x20, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x20, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x2c, 0x20, 0x74, 0x61, 0x67,
+	0x6c, 0x69, 0x6e, 0x65, 0x2c, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x73, 0x2c, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x20, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x75, 0x73, 0x65, 0x20,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x20, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x20,
+	0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x62, 0x00, 0x88, 0xb5, 0x18, 0x01, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74,
+	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2d, 0x73, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x92, 0x02, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x12, 0x25, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
 	0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xab, 0x01, 0x92, 0x41, 0x7f, 0x0a, 0x17, 0x50, 0x6c, 0x61,
-	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x70, 0x6c, 0x61,
-	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x20, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x4a,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x20, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
-	0x2d, 0x77, 0x69, 0x64, 0x65, 0x20, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x20,
-	0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x50, 0x6c, 0x61,
-	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x4d, 0x61, 0x6e,
-	0x61, 0x67, 0x65, 0x72, 0x20, 0x72, 0x6f, 0x6c, 0x65, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23,
-	0x3a, 0x01, 0x2a, 0x1a, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76,
-	0x31, 0x2f, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2d, 0x73, 0x65, 0x74, 0x74, 0x69,
-	0x6e, 0x67, 0x73, 0x42, 0xc4, 0x01, 0x92, 0x41, 0xb6, 0x01, 0x12, 0x8c, 0x01, 0x0a, 0x20, 0x63,
-	0x68, 0x6f, 0x72, 0x75, 0x73, 0x20, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x20, 0x73,
-	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22,
-	0x63, 0x0a, 0x20, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x20, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f,
-	0x72, 0x6d, 0x20, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x20, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x2c, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x43, 0x48, 0x4f, 0x52, 0x55, 0x53, 0x2d, 0x54,
-	0x52, 0x45, 0x2f, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e,
-	0x64, 0x1a, 0x11, 0x64, 0x65, 0x76, 0x40, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2d, 0x74, 0x72,
-	0x65, 0x2e, 0x63, 0x68, 0x32, 0x03, 0x31, 0x2e, 0x30, 0x2a, 0x01, 0x01, 0x32, 0x10, 0x61, 0x70,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xab, 0x01, 0x92,
+	0x41, 0x7f, 0x0a, 0x17, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x18, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x20, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x20, 0x73, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x4a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x20, 0x70,
+	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2d, 0x77, 0x69, 0x64, 0x65, 0x20, 0x73, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x20, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x20, 0x72, 0x6f, 0x6c, 0x65,
+	0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x3a, 0x01, 0x2a, 0x1a, 0x1e, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
+	0x6d, 0x2d, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0xb8, 0x02, 0x0a, 0x0c, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x2e, 0x63, 0x68,
+	0x6f, 0x72, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0xef, 0x01, 0x92, 0x41, 0xb6, 0x01, 0x0a, 0x17, 0x50, 0x6c, 0x61, 0x74,
+	0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x0e, 0x47, 0x65, 0x74, 0x20, 0x75, 0x73, 0x65, 0x72, 0x20, 0x75, 0x73,
+	0x61, 0x67, 0x65, 0x1a, 0x8a, 0x01, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x77, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x2c, 0x20, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x20, 0x61, 0x6e, 0x64, 0x20, 0x61, 0x70, 0x70, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x20, 0x6f, 0x77, 0x6e, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x61, 0x20, 0x75, 0x73,
+	0x65, 0x72, 0x20, 0x74, 0x6f, 0x67, 0x65, 0x74, 0x68, 0x65, 0x72, 0x20, 0x77, 0x69, 0x74, 0x68,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x65, 0x72, 0x2d, 0x75, 0x73, 0x65, 0x72, 0x20, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x73, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x6c,
+	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x20, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73,
+	0x74, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2d, 0x73, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x2f, 0x7b, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x7d, 0x42, 0xc4, 0x01, 0x92, 0x41, 0xb6, 0x01, 0x12, 0x8c, 0x01, 0x0a,
+	0x20, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x20, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
+	0x20, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x22, 0x63, 0x0a, 0x20, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x20, 0x70, 0x6c, 0x61, 0x74,
+	0x66, 0x6f, 0x72, 0x6d, 0x20, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x20, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2c, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x43, 0x48, 0x4f, 0x52, 0x55, 0x53,
+	0x2d, 0x54, 0x52, 0x45, 0x2f, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2d, 0x62, 0x61, 0x63, 0x6b,
+	0x65, 0x6e, 0x64, 0x1a, 0x11, 0x64, 0x65, 0x76, 0x40, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2d,
+	0x74, 0x72, 0x65, 0x2e, 0x63, 0x68, 0x32, 0x03, 0x31, 0x2e, 0x30, 0x2a, 0x01, 0x01, 0x32, 0x10,
 	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e,
-	0x5a, 0x08, 0x2e, 0x3b, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73,
+	0x6f, 0x6e, 0x5a, 0x08, 0x2e, 0x3b, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -412,7 +584,7 @@ func file_platform_settings_service_proto_rawDescGZIP() []byte {
 	return file_platform_settings_service_proto_rawDescData
 }
 
-var file_platform_settings_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_platform_settings_service_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_platform_settings_service_proto_goTypes = []interface{}{
 	(*GetPlatformSettingsRequest)(nil),    // 0: chorus.GetPlatformSettingsRequest
 	(*GetPlatformSettingsReply)(nil),      // 1: chorus.GetPlatformSettingsReply
@@ -420,23 +592,31 @@ var file_platform_settings_service_proto_goTypes = []interface{}{
 	(*UpdatePlatformSettingsRequest)(nil), // 3: chorus.UpdatePlatformSettingsRequest
 	(*UpdatePlatformSettingsReply)(nil),   // 4: chorus.UpdatePlatformSettingsReply
 	(*UpdatePlatformSettingsResult)(nil),  // 5: chorus.UpdatePlatformSettingsResult
-	(*PlatformSettings)(nil),              // 6: chorus.PlatformSettings
+	(*GetUserUsageRequest)(nil),           // 6: chorus.GetUserUsageRequest
+	(*GetUserUsageReply)(nil),             // 7: chorus.GetUserUsageReply
+	(*GetUserUsageResult)(nil),            // 8: chorus.GetUserUsageResult
+	(*PlatformSettings)(nil),              // 9: chorus.PlatformSettings
+	(*UserUsage)(nil),                     // 10: chorus.UserUsage
 }
 var file_platform_settings_service_proto_depIdxs = []int32{
-	2, // 0: chorus.GetPlatformSettingsReply.result:type_name -> chorus.GetPlatformSettingsResult
-	6, // 1: chorus.GetPlatformSettingsResult.platformSettings:type_name -> chorus.PlatformSettings
-	6, // 2: chorus.UpdatePlatformSettingsRequest.platformSettings:type_name -> chorus.PlatformSettings
-	5, // 3: chorus.UpdatePlatformSettingsReply.result:type_name -> chorus.UpdatePlatformSettingsResult
-	6, // 4: chorus.UpdatePlatformSettingsResult.platformSettings:type_name -> chorus.PlatformSettings
-	0, // 5: chorus.PlatformSettingsService.GetPlatformSettings:input_type -> chorus.GetPlatformSettingsRequest
-	3, // 6: chorus.PlatformSettingsService.UpdatePlatformSettings:input_type -> chorus.UpdatePlatformSettingsRequest
-	1, // 7: chorus.PlatformSettingsService.GetPlatformSettings:output_type -> chorus.GetPlatformSettingsReply
-	4, // 8: chorus.PlatformSettingsService.UpdatePlatformSettings:output_type -> chorus.UpdatePlatformSettingsReply
-	7, // [7:9] is the sub-list for method output_type
-	5, // [5:7] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	2,  // 0: chorus.GetPlatformSettingsReply.result:type_name -> chorus.GetPlatformSettingsResult
+	9,  // 1: chorus.GetPlatformSettingsResult.platformSettings:type_name -> chorus.PlatformSettings
+	9,  // 2: chorus.UpdatePlatformSettingsRequest.platformSettings:type_name -> chorus.PlatformSettings
+	5,  // 3: chorus.UpdatePlatformSettingsReply.result:type_name -> chorus.UpdatePlatformSettingsResult
+	9,  // 4: chorus.UpdatePlatformSettingsResult.platformSettings:type_name -> chorus.PlatformSettings
+	8,  // 5: chorus.GetUserUsageReply.result:type_name -> chorus.GetUserUsageResult
+	10, // 6: chorus.GetUserUsageResult.usage:type_name -> chorus.UserUsage
+	0,  // 7: chorus.PlatformSettingsService.GetPlatformSettings:input_type -> chorus.GetPlatformSettingsRequest
+	3,  // 8: chorus.PlatformSettingsService.UpdatePlatformSettings:input_type -> chorus.UpdatePlatformSettingsRequest
+	6,  // 9: chorus.PlatformSettingsService.GetUserUsage:input_type -> chorus.GetUserUsageRequest
+	1,  // 10: chorus.PlatformSettingsService.GetPlatformSettings:output_type -> chorus.GetPlatformSettingsReply
+	4,  // 11: chorus.PlatformSettingsService.UpdatePlatformSettings:output_type -> chorus.UpdatePlatformSettingsReply
+	7,  // 12: chorus.PlatformSettingsService.GetUserUsage:output_type -> chorus.GetUserUsageReply
+	10, // [10:13] is the sub-list for method output_type
+	7,  // [7:10] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_platform_settings_service_proto_init() }
@@ -519,6 +699,42 @@ func file_platform_settings_service_proto_init() {
 				return nil
 			}
 		}
+		file_platform_settings_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserUsageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_platform_settings_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserUsageReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_platform_settings_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserUsageResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -526,7 +742,7 @@ func file_platform_settings_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_platform_settings_service_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -554,6 +770,7 @@ const _ = grpc.SupportPackageIsVersion6
 type PlatformSettingsServiceClient interface {
 	GetPlatformSettings(ctx context.Context, in *GetPlatformSettingsRequest, opts ...grpc.CallOption) (*GetPlatformSettingsReply, error)
 	UpdatePlatformSettings(ctx context.Context, in *UpdatePlatformSettingsRequest, opts ...grpc.CallOption) (*UpdatePlatformSettingsReply, error)
+	GetUserUsage(ctx context.Context, in *GetUserUsageRequest, opts ...grpc.CallOption) (*GetUserUsageReply, error)
 }
 
 type platformSettingsServiceClient struct {
@@ -582,10 +799,20 @@ func (c *platformSettingsServiceClient) UpdatePlatformSettings(ctx context.Conte
 	return out, nil
 }
 
+func (c *platformSettingsServiceClient) GetUserUsage(ctx context.Context, in *GetUserUsageRequest, opts ...grpc.CallOption) (*GetUserUsageReply, error) {
+	out := new(GetUserUsageReply)
+	err := c.cc.Invoke(ctx, "/chorus.PlatformSettingsService/GetUserUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PlatformSettingsServiceServer is the server API for PlatformSettingsService service.
 type PlatformSettingsServiceServer interface {
 	GetPlatformSettings(context.Context, *GetPlatformSettingsRequest) (*GetPlatformSettingsReply, error)
 	UpdatePlatformSettings(context.Context, *UpdatePlatformSettingsRequest) (*UpdatePlatformSettingsReply, error)
+	GetUserUsage(context.Context, *GetUserUsageRequest) (*GetUserUsageReply, error)
 }
 
 // UnimplementedPlatformSettingsServiceServer can be embedded to have forward compatible implementations.
@@ -598,6 +825,9 @@ func (*UnimplementedPlatformSettingsServiceServer) GetPlatformSettings(context.C
 func (*UnimplementedPlatformSettingsServiceServer) UpdatePlatformSettings(context.Context, *UpdatePlatformSettingsRequest) (*UpdatePlatformSettingsReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdatePlatformSettings not implemented")
 }
+func (*UnimplementedPlatformSettingsServiceServer) GetUserUsage(context.Context, *GetUserUsageRequest) (*GetUserUsageReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserUsage not implemented")
+}
 
 func RegisterPlatformSettingsServiceServer(s *grpc.Server, srv PlatformSettingsServiceServer) {
 	s.RegisterService(&_PlatformSettingsService_serviceDesc, srv)
@@ -639,6 +869,24 @@ func _PlatformSettingsService_UpdatePlatformSettings_Handler(srv interface{}, ct
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PlatformSettingsService_GetUserUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformSettingsServiceServer).GetUserUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.PlatformSettingsService/GetUserUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformSettingsServiceServer).GetUserUsage(ctx, req.(*GetUserUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _PlatformSettingsService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "chorus.PlatformSettingsService",
 	HandlerType: (*PlatformSettingsServiceServer)(nil),
@@ -651,6 +899,10 @@ var _PlatformSettingsService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdatePlatformSettings",
 			Handler:    _PlatformSettingsService_UpdatePlatformSettings_Handler,
 		},
+		{
+			MethodName: "GetUserUsage",
+			Handler:    _PlatformSettingsService_GetUserUsage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "platform-settings-service.proto",
@@ -26,17 +26,33 @@ type PlatformSettings struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                     uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	TenantId               uint64                 `protobuf:"varint,2,opt,name=tenantId,proto3" json:"tenantId,omitempty"`
-	Title                  string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Headline               string                 `protobuf:"bytes,4,opt,name=headline,proto3" json:"headline,omitempty"`
-	Tagline                string                 `protobuf:"bytes,5,opt,name=tagline,proto3" json:"tagline,omitempty"`
-	WebsiteURL             string                 `protobuf:"bytes,6,opt,name=websiteURL,proto3" json:"websiteURL,omitempty"`
-	MaxWorkspacesPerUser   uint32                 `protobuf:"varint,8,opt,name=maxWorkspacesPerUser,proto3" json:"maxWorkspacesPerUser,omitempty"`
-	MaxSessionsPerUser     uint32                 `protobuf:"varint,9,opt,name=maxSessionsPerUser,proto3" json:"maxSessionsPerUser,omitempty"`
-	MaxAppInstancesPerUser uint32                 `protobuf:"varint,10,opt,name=maxAppInstancesPerUser,proto3" json:"maxAppInstancesPerUser,omitempty"`
-	CreatedAt              *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedAt              *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	Id                     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId               uint64 `protobuf:"varint,2,opt,name=tenantId,proto3" json:"tenantId,omitempty"`
+	Title                  string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Headline               string `protobuf:"bytes,4,opt,name=headline,proto3" json:"headline,omitempty"`
+	Tagline                string `protobuf:"bytes,5,opt,name=tagline,proto3" json:"tagline,omitempty"`
+	WebsiteURL             string `protobuf:"bytes,6,opt,name=websiteURL,proto3" json:"websiteURL,omitempty"`
+	MaxWorkspacesPerUser   uint32 `protobuf:"varint,8,opt,name=maxWorkspacesPerUser,proto3" json:"maxWorkspacesPerUser,omitempty"`
+	MaxSessionsPerUser     uint32 `protobuf:"varint,9,opt,name=maxSessionsPerUser,proto3" json:"maxSessionsPerUser,omitempty"`
+	MaxAppInstancesPerUser uint32 `protobuf:"varint,10,opt,name=maxAppInstancesPerUser,proto3" json:"maxAppInstancesPerUser,omitempty"`
+	// Vulnerability policy applied to the images of app instances: instances of images
+	// with vulnerabilities of the block severity or higher cannot be started, and those
+	// of the warn severity or higher are flagged. One of "critical", "high", "medium",
+	// "low", or empty to disable the rule.
+	VulnerabilityBlockSeverity string `protobuf:"bytes,13,opt,name=vulnerabilityBlockSeverity,proto3" json:"vulnerabilityBlockSeverity,omitempty"`
+	VulnerabilityWarnSeverity  string `protobuf:"bytes,14,opt,name=vulnerabilityWarnSeverity,proto3" json:"vulnerabilityWarnSeverity,omitempty"`
+	// PEM bundle of the public keys app images are signed with, e.g. the cosign.pub
+	// files of the publishers, and whether images not signed by one of them are refused.
+	ImageSigningKeys       string `protobuf:"bytes,15,opt,name=imageSigningKeys,proto3" json:"imageSigningKeys,omitempty"`
+	RequireImageSignatures bool   `protobuf:"varint,16,opt,name=requireImageSignatures,proto3" json:"requireImageSignatures,omitempty"`
+	// Whether the custom apps registered by workspace maintainers await the approval of an
+	// app store admin before they can be launched.
+	RequireCustomAppApproval bool `protobuf:"varint,17,opt,name=requireCustomAppApproval,proto3" json:"requireCustomAppApproval,omitempty"`
+	// Whether new apps are submitted for review, and can only be launched once approved
+	// by their reviewers.
+	RequireAppReview bool                   `protobuf:"varint,18,opt,name=requireAppReview,proto3" json:"requireAppReview,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
 }
 
 func (x *PlatformSettings) Reset() {
@@ -134,6 +150,48 @@ func (x *PlatformSettings) GetMaxAppInstancesPerUser() uint32 {
 	return 0
 }
 
+func (x *PlatformSettings) GetVulnerabilityBlockSeverity() string {
+	if x != nil {
+		return x.VulnerabilityBlockSeverity
+	}
+	return ""
+}
+
+func (x *PlatformSettings) GetVulnerabilityWarnSeverity() string {
+	if x != nil {
+		return x.VulnerabilityWarnSeverity
+	}
+	return ""
+}
+
+func (x *PlatformSettings) GetImageSigningKeys() string {
+	if x != nil {
+		return x.ImageSigningKeys
+	}
+	return ""
+}
+
+func (x *PlatformSettings) GetRequireImageSignatures() bool {
+	if x != nil {
+		return x.RequireImageSignatures
+	}
+	return false
+}
+
+func (x *PlatformSettings) GetRequireCustomAppApproval() bool {
+	if x != nil {
+		return x.RequireCustomAppApproval
+	}
+	return false
+}
+
+func (x *PlatformSettings) GetRequireAppReview() bool {
+	if x != nil {
+		return x.RequireAppReview
+	}
+	return false
+}
+
 func (x *PlatformSettings) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
@@ -148,6 +206,136 @@ func (x *PlatformSettings) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+// Usage of a single quota-limited resource.
+type ResourceUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of resources currently owned by the user.
+	Used uint32 `protobuf:"varint,1,opt,name=used,proto3" json:"used,omitempty"`
+	// Maximum number allowed by the platform settings; 0 means unlimited.
+	Max uint32 `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (x *ResourceUsage) Reset() {
+	*x = ResourceUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_platform_settings_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResourceUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceUsage) ProtoMessage() {}
+
+func (x *ResourceUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_settings_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceUsage.ProtoReflect.Descriptor instead.
+func (*ResourceUsage) Descriptor() ([]byte, []int) {
+	return file_platform_settings_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ResourceUsage) GetUsed() uint32 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetMax() uint32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+// Usage of a user against the per-user platform limits.
+type UserUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId       uint64         `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Workspaces   *ResourceUsage `protobuf:"bytes,2,opt,name=workspaces,proto3" json:"workspaces,omitempty"`
+	Sessions     *ResourceUsage `protobuf:"bytes,3,opt,name=sessions,proto3" json:"sessions,omitempty"`
+	AppInstances *ResourceUsage `protobuf:"bytes,4,opt,name=appInstances,proto3" json:"appInstances,omitempty"`
+}
+
+func (x *UserUsage) Reset() {
+	*x = UserUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_platform_settings_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserUsage) ProtoMessage() {}
+
+func (x *UserUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_settings_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserUsage.ProtoReflect.Descriptor instead.
+func (*UserUsage) Descriptor() ([]byte, []int) {
+	return file_platform_settings_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UserUsage) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserUsage) GetWorkspaces() *ResourceUsage {
+	if x != nil {
+		return x.Workspaces
+	}
+	return nil
+}
+
+func (x *UserUsage) GetSessions() *ResourceUsage {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+func (x *UserUsage) GetAppInstances() *ResourceUsage {
+	if x != nil {
+		return x.AppInstances
+	}
+	return nil
+}
+
 var File_platform_settings_proto protoreflect.FileDescriptor
 
 var file_platform_settings_proto_rawDesc = []byte{
@@ -155,7 +343,7 @@ var file_platform_settings_proto_rawDesc = []byte{
 	0x6e, 0x67, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x63, 0x68, 0x6f, 0x72, 0x75,
 	0x73, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
 	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0xba, 0x03, 0x0a, 0x10, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x53,
+	0x74, 0x6f, 0x22, 0x84, 0x06, 0x0a, 0x10, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x53,
 	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e,
 	0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e,
@@ -175,16 +363,52 @@ var file_platform_settings_proto_rawDesc = []byte{
 	0x73, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x16, 0x6d, 0x61, 0x78, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73,
 	0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x50, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x18, 0x0a, 0x20,
 	0x01, 0x28, 0x0d, 0x52, 0x16, 0x6d, 0x61, 0x78, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x73, 0x50, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x6e, 0x63, 0x65, 0x73, 0x50, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x1a, 0x76,
+	0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x1a, 0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x3c, 0x0a, 0x19, 0x76,
+	0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x57, 0x61, 0x72, 0x6e,
+	0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x19,
+	0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x57, 0x61, 0x72,
+	0x6e, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2a, 0x0a, 0x10, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x73, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e,
+	0x67, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x36, 0x0a, 0x16, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x3a, 0x0a,
+	0x18, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x41, 0x70,
+	0x70, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x18, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x41, 0x70,
+	0x70, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x41, 0x70, 0x70, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x10, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x41, 0x70, 0x70, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42,
-	0x0a, 0x5a, 0x08, 0x2e, 0x3b, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x35, 0x0a, 0x0d, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x75, 0x73, 0x65, 0x64, 0x12, 0x10,
+	0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6d, 0x61, 0x78,
+	0x22, 0xc8, 0x01, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x68, 0x6f,
+	0x72, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x31, 0x0a,
+	0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x39, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0c, 0x61,
+	0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x0a, 0x5a, 0x08, 0x2e,
+	0x3b, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -199,19 +423,24 @@ func file_platform_settings_proto_rawDescGZIP() []byte {
 	return file_platform_settings_proto_rawDescData
 }
 
-var file_platform_settings_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_platform_settings_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_platform_settings_proto_goTypes = []interface{}{
 	(*PlatformSettings)(nil),      // 0: chorus.PlatformSettings
-	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
+	(*ResourceUsage)(nil),         // 1: chorus.ResourceUsage
+	(*UserUsage)(nil),             // 2: chorus.UserUsage
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
 }
 var file_platform_settings_proto_depIdxs = []int32{
-	1, // 0: chorus.PlatformSettings.createdAt:type_name -> google.protobuf.Timestamp
-	1, // 1: chorus.PlatformSettings.updatedAt:type_name -> google.protobuf.Timestamp
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 0: chorus.PlatformSettings.createdAt:type_name -> google.protobuf.Timestamp
+	3, // 1: chorus.PlatformSettings.updatedAt:type_name -> google.protobuf.Timestamp
+	1, // 2: chorus.UserUsage.workspaces:type_name -> chorus.ResourceUsage
+	1, // 3: chorus.UserUsage.sessions:type_name -> chorus.ResourceUsage
+	1, // 4: chorus.UserUsage.appInstances:type_name -> chorus.ResourceUsage
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_platform_settings_proto_init() }
@@ -232,6 +461,30 @@ func file_platform_settings_proto_init() {
 				return nil
 			}
 		}
+		file_platform_settings_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_platform_settings_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -239,7 +492,7 @@ func file_platform_settings_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_platform_settings_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
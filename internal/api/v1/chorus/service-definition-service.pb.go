@@ -0,0 +1,1291 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        v6.30.2
+// source: service-definition-service.proto
+
+package chorus
+
+import (
+	context "context"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListServiceDefinitionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *PaginationQuery `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *ListServiceDefinitionsRequest) Reset() {
+	*x = ListServiceDefinitionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_definition_service_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListServiceDefinitionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServiceDefinitionsRequest) ProtoMessage() {}
+
+func (x *ListServiceDefinitionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_definition_service_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServiceDefinitionsRequest.ProtoReflect.Descriptor instead.
+func (*ListServiceDefinitionsRequest) Descriptor() ([]byte, []int) {
+	return file_service_definition_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListServiceDefinitionsRequest) GetPagination() *PaginationQuery {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListServiceDefinitionsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result     *ListServiceDefinitionsResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Pagination *PaginationResult             `protobuf:"bytes,2,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
+}
+
+func (x *ListServiceDefinitionsReply) Reset() {
+	*x = ListServiceDefinitionsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_definition_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListServiceDefinitionsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServiceDefinitionsReply) ProtoMessage() {}
+
+func (x *ListServiceDefinitionsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_service_definition_service_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServiceDefinitionsReply.ProtoReflect.Descriptor instead.
+func (*ListServiceDefinitionsReply) Descriptor() ([]byte, []int) {
+	return file_service_definition_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListServiceDefinitionsReply) GetResult() *ListServiceDefinitionsResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *ListServiceDefinitionsReply) GetPagination() *PaginationResult {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListServiceDefinitionsResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceDefinitions []*ServiceDefinition `protobuf:"bytes,1,rep,name=serviceDefinitions,proto3" json:"serviceDefinitions,omitempty"`
+}
+
+func (x *ListServiceDefinitionsResult) Reset() {
+	*x = ListServiceDefinitionsResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_definition_service_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListServiceDefinitionsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServiceDefinitionsResult) ProtoMessage() {}
+
+func (x *ListServiceDefinitionsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_service_definition_service_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServiceDefinitionsResult.ProtoReflect.Descriptor instead.
+func (*ListServiceDefinitionsResult) Descriptor() ([]byte, []int) {
+	return file_service_definition_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListServiceDefinitionsResult) GetServiceDefinitions() []*ServiceDefinition {
+	if x != nil {
+		return x.ServiceDefinitions
+	}
+	return nil
+}
+
+type GetServiceDefinitionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetServiceDefinitionRequest) Reset() {
+	*x = GetServiceDefinitionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_definition_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetServiceDefinitionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceDefinitionRequest) ProtoMessage() {}
+
+func (x *GetServiceDefinitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_definition_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceDefinitionRequest.ProtoReflect.Descriptor instead.
+func (*GetServiceDefinitionRequest) Descriptor() ([]byte, []int) {
+	return file_service_definition_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetServiceDefinitionRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetServiceDefinitionReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *GetServiceDefinitionResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *GetServiceDefinitionReply) Reset() {
+	*x = GetServiceDefinitionReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_definition_service_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetServiceDefinitionReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceDefinitionReply) ProtoMessage() {}
+
+func (x *GetServiceDefinitionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_service_definition_service_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceDefinitionReply.ProtoReflect.Descriptor instead.
+func (*GetServiceDefinitionReply) Descriptor() ([]byte, []int) {
+	return file_service_definition_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetServiceDefinitionReply) GetResult() *GetServiceDefinitionResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type GetServiceDefinitionResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceDefinition *ServiceDefinition `protobuf:"bytes,1,opt,name=serviceDefinition,proto3" json:"serviceDefinition,omitempty"`
+}
+
+func (x *GetServiceDefinitionResult) Reset() {
+	*x = GetServiceDefinitionResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_definition_service_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetServiceDefinitionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceDefinitionResult) ProtoMessage() {}
+
+func (x *GetServiceDefinitionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_service_definition_service_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceDefinitionResult.ProtoReflect.Descriptor instead.
+func (*GetServiceDefinitionResult) Descriptor() ([]byte, []int) {
+	return file_service_definition_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetServiceDefinitionResult) GetServiceDefinition() *ServiceDefinition {
+	if x != nil {
+		return x.ServiceDefinition
+	}
+	return nil
+}
+
+type CreateServiceDefinitionReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *CreateServiceDefinitionResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *CreateServiceDefinitionReply) Reset() {
+	*x = CreateServiceDefinitionReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_definition_service_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateServiceDefinitionReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateServiceDefinitionReply) ProtoMessage() {}
+
+func (x *CreateServiceDefinitionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_service_definition_service_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateServiceDefinitionReply.ProtoReflect.Descriptor instead.
+func (*CreateServiceDefinitionReply) Descriptor() ([]byte, []int) {
+	return file_service_definition_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateServiceDefinitionReply) GetResult() *CreateServiceDefinitionResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type CreateServiceDefinitionResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceDefinition *ServiceDefinition `protobuf:"bytes,1,opt,name=serviceDefinition,proto3" json:"serviceDefinition,omitempty"`
+}
+
+func (x *CreateServiceDefinitionResult) Reset() {
+	*x = CreateServiceDefinitionResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_definition_service_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateServiceDefinitionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateServiceDefinitionResult) ProtoMessage() {}
+
+func (x *CreateServiceDefinitionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_service_definition_service_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateServiceDefinitionResult.ProtoReflect.Descriptor instead.
+func (*CreateServiceDefinitionResult) Descriptor() ([]byte, []int) {
+	return file_service_definition_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateServiceDefinitionResult) GetServiceDefinition() *ServiceDefinition {
+	if x != nil {
+		return x.ServiceDefinition
+	}
+	return nil
+}
+
+type UpdateServiceDefinitionReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *UpdateServiceDefinitionResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *UpdateServiceDefinitionReply) Reset() {
+	*x = UpdateServiceDefinitionReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_definition_service_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateServiceDefinitionReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateServiceDefinitionReply) ProtoMessage() {}
+
+func (x *UpdateServiceDefinitionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_service_definition_service_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateServiceDefinitionReply.ProtoReflect.Descriptor instead.
+func (*UpdateServiceDefinitionReply) Descriptor() ([]byte, []int) {
+	return file_service_definition_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateServiceDefinitionReply) GetResult() *UpdateServiceDefinitionResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type UpdateServiceDefinitionResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceDefinition *ServiceDefinition `protobuf:"bytes,1,opt,name=serviceDefinition,proto3" json:"serviceDefinition,omitempty"`
+}
+
+func (x *UpdateServiceDefinitionResult) Reset() {
+	*x = UpdateServiceDefinitionResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_definition_service_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateServiceDefinitionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateServiceDefinitionResult) ProtoMessage() {}
+
+func (x *UpdateServiceDefinitionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_service_definition_service_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateServiceDefinitionResult.ProtoReflect.Descriptor instead.
+func (*UpdateServiceDefinitionResult) Descriptor() ([]byte, []int) {
+	return file_service_definition_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateServiceDefinitionResult) GetServiceDefinition() *ServiceDefinition {
+	if x != nil {
+		return x.ServiceDefinition
+	}
+	return nil
+}
+
+type DeleteServiceDefinitionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteServiceDefinitionRequest) Reset() {
+	*x = DeleteServiceDefinitionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_definition_service_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteServiceDefinitionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteServiceDefinitionRequest) ProtoMessage() {}
+
+func (x *DeleteServiceDefinitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_definition_service_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteServiceDefinitionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteServiceDefinitionRequest) Descriptor() ([]byte, []int) {
+	return file_service_definition_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteServiceDefinitionRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteServiceDefinitionReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *DeleteServiceDefinitionResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *DeleteServiceDefinitionReply) Reset() {
+	*x = DeleteServiceDefinitionReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_definition_service_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteServiceDefinitionReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteServiceDefinitionReply) ProtoMessage() {}
+
+func (x *DeleteServiceDefinitionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_service_definition_service_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteServiceDefinitionReply.ProtoReflect.Descriptor instead.
+func (*DeleteServiceDefinitionReply) Descriptor() ([]byte, []int) {
+	return file_service_definition_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteServiceDefinitionReply) GetResult() *DeleteServiceDefinitionResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type DeleteServiceDefinitionResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteServiceDefinitionResult) Reset() {
+	*x = DeleteServiceDefinitionResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_definition_service_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteServiceDefinitionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteServiceDefinitionResult) ProtoMessage() {}
+
+func (x *DeleteServiceDefinitionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_service_definition_service_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteServiceDefinitionResult.ProtoReflect.Descriptor instead.
+func (*DeleteServiceDefinitionResult) Descriptor() ([]byte, []int) {
+	return file_service_definition_service_proto_rawDescGZIP(), []int{12}
+}
+
+var File_service_definition_service_proto protoreflect.FileDescriptor
+
+var file_service_definition_service_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2d, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x06, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2d,
+	0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x58, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44,
+	0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x37, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x50,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa9, 0x01, 0x0a, 0x1b, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3c, 0x0a, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x68, 0x6f,
+	0x72, 0x75, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44,
+	0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63,
+	0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x69, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x49, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x2d, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44,
+	0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x57, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65,
+	0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3a, 0x0a,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x65, 0x0a, 0x1a, 0x47, 0x65, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x47, 0x0a, 0x11, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x5d, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x3d, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+	0x68, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x47, 0x0a, 0x11, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x68,
+	0x6f, 0x72, 0x75, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x66, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44,
+	0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5d, 0x0a, 0x1c, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3d, 0x0a, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x68, 0x6f, 0x72,
+	0x75, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x68, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x47, 0x0a, 0x11, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x11, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x30, 0x0a, 0x1e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x5d, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x3d, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x22, 0x1f, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x32, 0x90, 0x0a, 0x0a, 0x18, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0xf1, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x63, 0x68, 0x6f,
+	0x72, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65,
+	0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x90, 0x01, 0x92, 0x41, 0x60, 0x0a, 0x18, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x18, 0x47, 0x65, 0x74, 0x20, 0x61, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x20, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x2a, 0x54, 0x68,
+	0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x72, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x73, 0x20, 0x61, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x64, 0x65,
+	0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2d, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x80, 0x02, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x25, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x66, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x99, 0x01, 0x92,
+	0x41, 0x6e, 0x0a, 0x18, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x18, 0x4c, 0x69,
+	0x73, 0x74, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x64, 0x65, 0x66, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x38, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x20, 0x6f, 0x66, 0x20, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x20, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73,
+	0x74, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2d, 0x64, 0x65, 0x66,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xfa, 0x01, 0x0a, 0x17, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x1a,
+	0x24, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x9d, 0x01, 0x92, 0x41, 0x6f, 0x0a, 0x18, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x61, 0x20,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x1a, 0x36, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x20, 0x61, 0x64, 0x64, 0x73, 0x20, 0x61, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x20, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x6f, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25,
+	0x3a, 0x01, 0x2a, 0x22, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76,
+	0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2d, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xee, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x19, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x24, 0x2e, 0x63,
+	0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x91, 0x01, 0x92, 0x41, 0x63, 0x0a, 0x18, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x61, 0x20, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x20, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x1a,
+	0x2a, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x20, 0x61, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x20, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x25, 0x3a, 0x01, 0x2a, 0x1a, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f,
+	0x76, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2d, 0x64, 0x65, 0x66, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x8e, 0x02, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x26, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63, 0x68, 0x6f,
+	0x72, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0xa4, 0x01, 0x92, 0x41, 0x74, 0x0a, 0x18, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44,
+	0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x61, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x20, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x3b, 0x54,
+	0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x73, 0x20, 0x61, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x64,
+	0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27,
+	0x2a, 0x25, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2d, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x42, 0xc6, 0x01, 0x92, 0x41, 0xb8, 0x01, 0x12, 0x8e,
+	0x01, 0x0a, 0x21, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x20, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x22, 0x64, 0x0a, 0x21, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x20, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2c, 0x68, 0x74, 0x74, 0x70, 0x73,
+	0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x43, 0x48,
+	0x4f, 0x52, 0x55, 0x53, 0x2d, 0x54, 0x52, 0x45, 0x2f, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2d,
+	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x1a, 0x11, 0x64, 0x65, 0x76, 0x40, 0x63, 0x68, 0x6f,
+	0x72, 0x75, 0x73, 0x2d, 0x74, 0x72, 0x65, 0x2e, 0x63, 0x68, 0x32, 0x03, 0x31, 0x2e, 0x30, 0x2a,
+	0x01, 0x01, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
+	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x5a, 0x08, 0x2e, 0x3b, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_service_definition_service_proto_rawDescOnce sync.Once
+	file_service_definition_service_proto_rawDescData = file_service_definition_service_proto_rawDesc
+)
+
+func file_service_definition_service_proto_rawDescGZIP() []byte {
+	file_service_definition_service_proto_rawDescOnce.Do(func() {
+		file_service_definition_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_service_definition_service_proto_rawDescData)
+	})
+	return file_service_definition_service_proto_rawDescData
+}
+
+var file_service_definition_service_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_service_definition_service_proto_goTypes = []interface{}{
+	(*ListServiceDefinitionsRequest)(nil),  // 0: chorus.ListServiceDefinitionsRequest
+	(*ListServiceDefinitionsReply)(nil),    // 1: chorus.ListServiceDefinitionsReply
+	(*ListServiceDefinitionsResult)(nil),   // 2: chorus.ListServiceDefinitionsResult
+	(*GetServiceDefinitionRequest)(nil),    // 3: chorus.GetServiceDefinitionRequest
+	(*GetServiceDefinitionReply)(nil),      // 4: chorus.GetServiceDefinitionReply
+	(*GetServiceDefinitionResult)(nil),     // 5: chorus.GetServiceDefinitionResult
+	(*CreateServiceDefinitionReply)(nil),   // 6: chorus.CreateServiceDefinitionReply
+	(*CreateServiceDefinitionResult)(nil),  // 7: chorus.CreateServiceDefinitionResult
+	(*UpdateServiceDefinitionReply)(nil),   // 8: chorus.UpdateServiceDefinitionReply
+	(*UpdateServiceDefinitionResult)(nil),  // 9: chorus.UpdateServiceDefinitionResult
+	(*DeleteServiceDefinitionRequest)(nil), // 10: chorus.DeleteServiceDefinitionRequest
+	(*DeleteServiceDefinitionReply)(nil),   // 11: chorus.DeleteServiceDefinitionReply
+	(*DeleteServiceDefinitionResult)(nil),  // 12: chorus.DeleteServiceDefinitionResult
+	(*PaginationQuery)(nil),                // 13: chorus.PaginationQuery
+	(*PaginationResult)(nil),               // 14: chorus.PaginationResult
+	(*ServiceDefinition)(nil),              // 15: chorus.ServiceDefinition
+}
+var file_service_definition_service_proto_depIdxs = []int32{
+	13, // 0: chorus.ListServiceDefinitionsRequest.pagination:type_name -> chorus.PaginationQuery
+	2,  // 1: chorus.ListServiceDefinitionsReply.result:type_name -> chorus.ListServiceDefinitionsResult
+	14, // 2: chorus.ListServiceDefinitionsReply.pagination:type_name -> chorus.PaginationResult
+	15, // 3: chorus.ListServiceDefinitionsResult.serviceDefinitions:type_name -> chorus.ServiceDefinition
+	5,  // 4: chorus.GetServiceDefinitionReply.result:type_name -> chorus.GetServiceDefinitionResult
+	15, // 5: chorus.GetServiceDefinitionResult.serviceDefinition:type_name -> chorus.ServiceDefinition
+	7,  // 6: chorus.CreateServiceDefinitionReply.result:type_name -> chorus.CreateServiceDefinitionResult
+	15, // 7: chorus.CreateServiceDefinitionResult.serviceDefinition:type_name -> chorus.ServiceDefinition
+	9,  // 8: chorus.UpdateServiceDefinitionReply.result:type_name -> chorus.UpdateServiceDefinitionResult
+	15, // 9: chorus.UpdateServiceDefinitionResult.serviceDefinition:type_name -> chorus.ServiceDefinition
+	12, // 10: chorus.DeleteServiceDefinitionReply.result:type_name -> chorus.DeleteServiceDefinitionResult
+	3,  // 11: chorus.ServiceDefinitionService.GetServiceDefinition:input_type -> chorus.GetServiceDefinitionRequest
+	0,  // 12: chorus.ServiceDefinitionService.ListServiceDefinitions:input_type -> chorus.ListServiceDefinitionsRequest
+	15, // 13: chorus.ServiceDefinitionService.CreateServiceDefinition:input_type -> chorus.ServiceDefinition
+	15, // 14: chorus.ServiceDefinitionService.UpdateServiceDefinition:input_type -> chorus.ServiceDefinition
+	10, // 15: chorus.ServiceDefinitionService.DeleteServiceDefinition:input_type -> chorus.DeleteServiceDefinitionRequest
+	4,  // 16: chorus.ServiceDefinitionService.GetServiceDefinition:output_type -> chorus.GetServiceDefinitionReply
+	1,  // 17: chorus.ServiceDefinitionService.ListServiceDefinitions:output_type -> chorus.ListServiceDefinitionsReply
+	6,  // 18: chorus.ServiceDefinitionService.CreateServiceDefinition:output_type -> chorus.CreateServiceDefinitionReply
+	8,  // 19: chorus.ServiceDefinitionService.UpdateServiceDefinition:output_type -> chorus.UpdateServiceDefinitionReply
+	11, // 20: chorus.ServiceDefinitionService.DeleteServiceDefinition:output_type -> chorus.DeleteServiceDefinitionReply
+	16, // [16:21] is the sub-list for method output_type
+	11, // [11:16] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_service_definition_service_proto_init() }
+func file_service_definition_service_proto_init() {
+	if File_service_definition_service_proto != nil {
+		return
+	}
+	file_common_proto_init()
+	file_service_definition_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_service_definition_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListServiceDefinitionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_definition_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListServiceDefinitionsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_definition_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListServiceDefinitionsResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_definition_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServiceDefinitionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_definition_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServiceDefinitionReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_definition_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServiceDefinitionResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_definition_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateServiceDefinitionReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_definition_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateServiceDefinitionResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_definition_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateServiceDefinitionReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_definition_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateServiceDefinitionResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_definition_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteServiceDefinitionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_definition_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteServiceDefinitionReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_definition_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteServiceDefinitionResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_service_definition_service_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_service_definition_service_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_service_definition_service_proto_goTypes,
+		DependencyIndexes: file_service_definition_service_proto_depIdxs,
+		MessageInfos:      file_service_definition_service_proto_msgTypes,
+	}.Build()
+	File_service_definition_service_proto = out.File
+	file_service_definition_service_proto_rawDesc = nil
+	file_service_definition_service_proto_goTypes = nil
+	file_service_definition_service_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// ServiceDefinitionServiceClient is the client API for ServiceDefinitionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ServiceDefinitionServiceClient interface {
+	GetServiceDefinition(ctx context.Context, in *GetServiceDefinitionRequest, opts ...grpc.CallOption) (*GetServiceDefinitionReply, error)
+	ListServiceDefinitions(ctx context.Context, in *ListServiceDefinitionsRequest, opts ...grpc.CallOption) (*ListServiceDefinitionsReply, error)
+	CreateServiceDefinition(ctx context.Context, in *ServiceDefinition, opts ...grpc.CallOption) (*CreateServiceDefinitionReply, error)
+	UpdateServiceDefinition(ctx context.Context, in *ServiceDefinition, opts ...grpc.CallOption) (*UpdateServiceDefinitionReply, error)
+	DeleteServiceDefinition(ctx context.Context, in *DeleteServiceDefinitionRequest, opts ...grpc.CallOption) (*DeleteServiceDefinitionReply, error)
+}
+
+type serviceDefinitionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewServiceDefinitionServiceClient(cc grpc.ClientConnInterface) ServiceDefinitionServiceClient {
+	return &serviceDefinitionServiceClient{cc}
+}
+
+func (c *serviceDefinitionServiceClient) GetServiceDefinition(ctx context.Context, in *GetServiceDefinitionRequest, opts ...grpc.CallOption) (*GetServiceDefinitionReply, error) {
+	out := new(GetServiceDefinitionReply)
+	err := c.cc.Invoke(ctx, "/chorus.ServiceDefinitionService/GetServiceDefinition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceDefinitionServiceClient) ListServiceDefinitions(ctx context.Context, in *ListServiceDefinitionsRequest, opts ...grpc.CallOption) (*ListServiceDefinitionsReply, error) {
+	out := new(ListServiceDefinitionsReply)
+	err := c.cc.Invoke(ctx, "/chorus.ServiceDefinitionService/ListServiceDefinitions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceDefinitionServiceClient) CreateServiceDefinition(ctx context.Context, in *ServiceDefinition, opts ...grpc.CallOption) (*CreateServiceDefinitionReply, error) {
+	out := new(CreateServiceDefinitionReply)
+	err := c.cc.Invoke(ctx, "/chorus.ServiceDefinitionService/CreateServiceDefinition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceDefinitionServiceClient) UpdateServiceDefinition(ctx context.Context, in *ServiceDefinition, opts ...grpc.CallOption) (*UpdateServiceDefinitionReply, error) {
+	out := new(UpdateServiceDefinitionReply)
+	err := c.cc.Invoke(ctx, "/chorus.ServiceDefinitionService/UpdateServiceDefinition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceDefinitionServiceClient) DeleteServiceDefinition(ctx context.Context, in *DeleteServiceDefinitionRequest, opts ...grpc.CallOption) (*DeleteServiceDefinitionReply, error) {
+	out := new(DeleteServiceDefinitionReply)
+	err := c.cc.Invoke(ctx, "/chorus.ServiceDefinitionService/DeleteServiceDefinition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServiceDefinitionServiceServer is the server API for ServiceDefinitionService service.
+type ServiceDefinitionServiceServer interface {
+	GetServiceDefinition(context.Context, *GetServiceDefinitionRequest) (*GetServiceDefinitionReply, error)
+	ListServiceDefinitions(context.Context, *ListServiceDefinitionsRequest) (*ListServiceDefinitionsReply, error)
+	CreateServiceDefinition(context.Context, *ServiceDefinition) (*CreateServiceDefinitionReply, error)
+	UpdateServiceDefinition(context.Context, *ServiceDefinition) (*UpdateServiceDefinitionReply, error)
+	DeleteServiceDefinition(context.Context, *DeleteServiceDefinitionRequest) (*DeleteServiceDefinitionReply, error)
+}
+
+// UnimplementedServiceDefinitionServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedServiceDefinitionServiceServer struct {
+}
+
+func (*UnimplementedServiceDefinitionServiceServer) GetServiceDefinition(context.Context, *GetServiceDefinitionRequest) (*GetServiceDefinitionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceDefinition not implemented")
+}
+func (*UnimplementedServiceDefinitionServiceServer) ListServiceDefinitions(context.Context, *ListServiceDefinitionsRequest) (*ListServiceDefinitionsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServiceDefinitions not implemented")
+}
+func (*UnimplementedServiceDefinitionServiceServer) CreateServiceDefinition(context.Context, *ServiceDefinition) (*CreateServiceDefinitionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServiceDefinition not implemented")
+}
+func (*UnimplementedServiceDefinitionServiceServer) UpdateServiceDefinition(context.Context, *ServiceDefinition) (*UpdateServiceDefinitionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateServiceDefinition not implemented")
+}
+func (*UnimplementedServiceDefinitionServiceServer) DeleteServiceDefinition(context.Context, *DeleteServiceDefinitionRequest) (*DeleteServiceDefinitionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteServiceDefinition not implemented")
+}
+
+func RegisterServiceDefinitionServiceServer(s *grpc.Server, srv ServiceDefinitionServiceServer) {
+	s.RegisterService(&_ServiceDefinitionService_serviceDesc, srv)
+}
+
+func _ServiceDefinitionService_GetServiceDefinition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServiceDefinitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceDefinitionServiceServer).GetServiceDefinition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.ServiceDefinitionService/GetServiceDefinition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceDefinitionServiceServer).GetServiceDefinition(ctx, req.(*GetServiceDefinitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceDefinitionService_ListServiceDefinitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServiceDefinitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceDefinitionServiceServer).ListServiceDefinitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.ServiceDefinitionService/ListServiceDefinitions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceDefinitionServiceServer).ListServiceDefinitions(ctx, req.(*ListServiceDefinitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceDefinitionService_CreateServiceDefinition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceDefinition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceDefinitionServiceServer).CreateServiceDefinition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.ServiceDefinitionService/CreateServiceDefinition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceDefinitionServiceServer).CreateServiceDefinition(ctx, req.(*ServiceDefinition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceDefinitionService_UpdateServiceDefinition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceDefinition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceDefinitionServiceServer).UpdateServiceDefinition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.ServiceDefinitionService/UpdateServiceDefinition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceDefinitionServiceServer).UpdateServiceDefinition(ctx, req.(*ServiceDefinition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceDefinitionService_DeleteServiceDefinition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteServiceDefinitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceDefinitionServiceServer).DeleteServiceDefinition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.ServiceDefinitionService/DeleteServiceDefinition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceDefinitionServiceServer).DeleteServiceDefinition(ctx, req.(*DeleteServiceDefinitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ServiceDefinitionService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "chorus.ServiceDefinitionService",
+	HandlerType: (*ServiceDefinitionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetServiceDefinition",
+			Handler:    _ServiceDefinitionService_GetServiceDefinition_Handler,
+		},
+		{
+			MethodName: "ListServiceDefinitions",
+			Handler:    _ServiceDefinitionService_ListServiceDefinitions_Handler,
+		},
+		{
+			MethodName: "CreateServiceDefinition",
+			Handler:    _ServiceDefinitionService_CreateServiceDefinition_Handler,
+		},
+		{
+			MethodName: "UpdateServiceDefinition",
+			Handler:    _ServiceDefinitionService_UpdateServiceDefinition_Handler,
+		},
+		{
+			MethodName: "DeleteServiceDefinition",
+			Handler:    _ServiceDefinitionService_DeleteServiceDefinition_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service-definition-service.proto",
+}
@@ -16,6 +16,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	_ "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -1007,610 +1008,4035 @@ func (x *DeleteWorkbenchResult) GetWorkbench() *Workbench {
 	return nil
 }
 
-var File_workbench_service_proto protoreflect.FileDescriptor
+type GetWorkbenchScheduleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_workbench_service_proto_rawDesc = []byte{
-	0x0a, 0x17, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x2d, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x63, 0x68, 0x6f, 0x72, 0x75,
-	0x73, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e,
-	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e,
-	0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e,
-	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0f, 0x77, 0x6f, 0x72, 0x6b,
-	0x62, 0x65, 0x6e, 0x63, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0a, 0x75, 0x73, 0x65,
-	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x92, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74,
-	0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x37, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e,
-	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x06, 0x66,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x68,
-	0x6f, 0x72, 0x75, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x46, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x88, 0x01,
-	0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x9b, 0x01, 0x0a,
-	0x14, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x65, 0x73,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3d, 0x0a, 0x0a,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x18, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4c, 0x0a, 0x15, 0x4c, 0x69,
-	0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x12, 0x33, 0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75,
-	0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x0b, 0x77, 0x6f, 0x72,
-	0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x22, 0x3b, 0x0a, 0x0f, 0x57, 0x6f, 0x72, 0x6b,
-	0x62, 0x65, 0x6e, 0x63, 0x68, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x77,
-	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x5f, 0x69, 0x6e, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0e, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x49, 0x64, 0x73, 0x49, 0x6e, 0x22, 0x25, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b,
-	0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x47, 0x0a, 0x11,
-	0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x12, 0x32, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f,
-	0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x45, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b,
-	0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2f, 0x0a, 0x09, 0x77,
-	0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63,
-	0x68, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x22, 0x4d, 0x0a, 0x14,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x48, 0x0a, 0x15, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x12, 0x2f, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63,
-	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73,
-	0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b,
-	0x62, 0x65, 0x6e, 0x63, 0x68, 0x22, 0x4d, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57,
-	0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a,
-	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
-	0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72,
-	0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x22, 0x48, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f,
-	0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2f, 0x0a,
-	0x09, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65,
-	0x6e, 0x63, 0x68, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x22, 0x69,
-	0x0a, 0x1d, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x57,
-	0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x52,
-	0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x5b, 0x0a, 0x1b, 0x41, 0x64, 0x64,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65,
-	0x6e, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3c, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75,
-	0x73, 0x2e, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x57,
-	0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06,
-	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x4f, 0x0a, 0x1c, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2f, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65,
-	0x6e, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x68, 0x6f, 0x72,
-	0x75, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x09, 0x77, 0x6f,
-	0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x22, 0x48, 0x0a, 0x1e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x46, 0x72, 0x6f, 0x6d, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
-	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x22, 0x5d, 0x0a, 0x1c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x46,
-	0x72, 0x6f, 0x6d, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x12, 0x3d, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x25, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x46, 0x72, 0x6f, 0x6d, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
-	0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x22, 0x50, 0x0a, 0x1d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x46, 0x72,
-	0x6f, 0x6d, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x12, 0x2f, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x57, 0x6f,
-	0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
-	0x63, 0x68, 0x22, 0x28, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b,
-	0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4d, 0x0a, 0x14,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x48, 0x0a, 0x15, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x12, 0x2f, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63,
-	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73,
-	0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b,
-	0x62, 0x65, 0x6e, 0x63, 0x68, 0x32, 0xd5, 0x0d, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65,
-	0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xd7, 0x01, 0x0a, 0x0c, 0x47,
-	0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x12, 0x1b, 0x2e, 0x63, 0x68,
-	0x6f, 0x72, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63,
-	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75,
-	0x73, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x8e, 0x01, 0x92, 0x41, 0x46, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x62,
-	0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x0f, 0x47, 0x65, 0x74,
-	0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x1a, 0x21, 0x54, 0x68,
-	0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x72, 0x65, 0x74, 0x75,
-	0x72, 0x6e, 0x73, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x3f, 0x5a, 0x1e, 0x12, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65,
-	0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x73,
-	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74,
-	0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x2f,
-	0x7b, 0x69, 0x64, 0x7d, 0x12, 0xe1, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72,
-	0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75,
-	0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75,
-	0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x65,
-	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x8f, 0x01, 0x92, 0x41, 0x51, 0x0a, 0x10, 0x57, 0x6f,
-	0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x10,
-	0x4c, 0x69, 0x73, 0x74, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x65, 0x73,
-	0x1a, 0x2b, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20,
-	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f,
-	0x66, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x35, 0x5a, 0x19, 0x12, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74,
-	0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x73, 0x12, 0x18,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72,
-	0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x12, 0xd2, 0x01, 0x0a, 0x0f, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x12, 0x11, 0x2e, 0x63,
-	0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x1a,
-	0x1c, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57,
-	0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x8d, 0x01,
-	0x92, 0x41, 0x49, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x61, 0x20,
-	0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x1a, 0x21, 0x54, 0x68, 0x69, 0x73, 0x20,
-	0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x73,
-	0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x3b, 0x3a, 0x01, 0x2a, 0x5a, 0x1c, 0x3a, 0x01, 0x2a, 0x22, 0x17, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
-	0x63, 0x68, 0x73, 0x22, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76,
-	0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x12, 0xd2, 0x01,
-	0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63,
-	0x68, 0x12, 0x11, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x62,
-	0x65, 0x6e, 0x63, 0x68, 0x1a, 0x1c, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x8d, 0x01, 0x92, 0x41, 0x49, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65,
-	0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x1a, 0x21,
-	0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x73, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63,
-	0x68, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3b, 0x3a, 0x01, 0x2a, 0x5a, 0x1c, 0x3a, 0x01, 0x2a, 0x1a,
-	0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f,
-	0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x73, 0x1a, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72,
-	0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68,
-	0x65, 0x73, 0x12, 0xc0, 0x02, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f,
-	0x6c, 0x65, 0x49, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x12, 0x25, 0x2e,
-	0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f,
-	0x6c, 0x65, 0x49, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x41, 0x64,
-	0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x62,
-	0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xd9, 0x01, 0x92, 0x41, 0x65, 0x0a,
-	0x10, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x20, 0x41, 0x64, 0x64, 0x20, 0x61, 0x20, 0x75, 0x73, 0x65, 0x72, 0x27, 0x73, 0x20,
-	0x72, 0x6f, 0x6c, 0x65, 0x20, 0x69, 0x6e, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65,
-	0x6e, 0x63, 0x68, 0x1a, 0x2f, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x20, 0x61, 0x64, 0x64, 0x73, 0x20, 0x61, 0x20, 0x75, 0x73, 0x65, 0x72, 0x27, 0x73,
-	0x20, 0x72, 0x6f, 0x6c, 0x65, 0x20, 0x69, 0x6e, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62,
-	0x65, 0x6e, 0x63, 0x68, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x6b, 0x3a, 0x01, 0x2a, 0x5a, 0x34, 0x3a,
-	0x01, 0x2a, 0x22, 0x2f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31,
-	0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
-	0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x72,
-	0x6f, 0x6c, 0x65, 0x22, 0x30, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76,
-	0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x2f, 0x7b, 0x69,
-	0x64, 0x7d, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x7d,
-	0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0xaf, 0x02, 0x0a, 0x17, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x55, 0x73, 0x65, 0x72, 0x46, 0x72, 0x6f, 0x6d, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63,
-	0x68, 0x12, 0x26, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x46, 0x72, 0x6f, 0x6d, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
-	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63, 0x68, 0x6f, 0x72,
-	0x75, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x46, 0x72, 0x6f,
-	0x6d, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0xc5, 0x01, 0x92, 0x41, 0x61, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x20,
-	0x61, 0x20, 0x75, 0x73, 0x65, 0x72, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x61, 0x20, 0x77, 0x6f,
-	0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x1a, 0x2d, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e,
-	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x20, 0x61,
-	0x20, 0x75, 0x73, 0x65, 0x72, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72,
-	0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x5b, 0x5a, 0x2c, 0x2a, 0x2a,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72,
-	0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x75, 0x73, 0x65,
-	0x72, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2a, 0x2b, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
-	0x63, 0x68, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x7b,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x12, 0xe3, 0x01, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x12, 0x1e, 0x2e, 0x63, 0x68,
-	0x6f, 0x72, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62,
-	0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x68,
-	0x6f, 0x72, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62,
-	0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x91, 0x01, 0x92, 0x41, 0x49, 0x0a,
-	0x10, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b,
-	0x62, 0x65, 0x6e, 0x63, 0x68, 0x1a, 0x21, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x20, 0x61, 0x20, 0x77,
-	0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3f, 0x5a, 0x1e,
-	0x2a, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77,
-	0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2a, 0x1d,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72,
-	0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x42, 0xb3, 0x01,
-	0x92, 0x41, 0xa5, 0x01, 0x12, 0x7c, 0x0a, 0x18, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x20, 0x77,
-	0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x22, 0x5b, 0x0a, 0x18, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62,
-	0x65, 0x6e, 0x63, 0x68, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2c, 0x68, 0x74,
-	0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x43, 0x48, 0x4f, 0x52, 0x55, 0x53, 0x2d, 0x54, 0x52, 0x45, 0x2f, 0x63, 0x68, 0x6f, 0x72,
-	0x75, 0x73, 0x2d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x1a, 0x11, 0x64, 0x65, 0x76, 0x40,
-	0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2d, 0x74, 0x72, 0x65, 0x2e, 0x63, 0x68, 0x32, 0x03, 0x31,
-	0x2e, 0x30, 0x2a, 0x01, 0x01, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x5a, 0x08, 0x2e, 0x3b, 0x63, 0x68, 0x6f,
-	0x72, 0x75, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-var (
-	file_workbench_service_proto_rawDescOnce sync.Once
-	file_workbench_service_proto_rawDescData = file_workbench_service_proto_rawDesc
-)
+func (x *GetWorkbenchScheduleRequest) Reset() {
+	*x = GetWorkbenchScheduleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_workbench_service_proto_rawDescGZIP() []byte {
-	file_workbench_service_proto_rawDescOnce.Do(func() {
-		file_workbench_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_workbench_service_proto_rawDescData)
-	})
-	return file_workbench_service_proto_rawDescData
+func (x *GetWorkbenchScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_workbench_service_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
-var file_workbench_service_proto_goTypes = []interface{}{
-	(*ListWorkbenchesRequest)(nil),         // 0: chorus.ListWorkbenchesRequest
-	(*ListWorkbenchesReply)(nil),           // 1: chorus.ListWorkbenchesReply
-	(*ListWorkbenchesResult)(nil),          // 2: chorus.ListWorkbenchesResult
-	(*WorkbenchFilter)(nil),                // 3: chorus.WorkbenchFilter
-	(*GetWorkbenchRequest)(nil),            // 4: chorus.GetWorkbenchRequest
-	(*GetWorkbenchReply)(nil),              // 5: chorus.GetWorkbenchReply
-	(*GetWorkbenchResult)(nil),             // 6: chorus.GetWorkbenchResult
-	(*CreateWorkbenchReply)(nil),           // 7: chorus.CreateWorkbenchReply
-	(*CreateWorkbenchResult)(nil),          // 8: chorus.CreateWorkbenchResult
-	(*UpdateWorkbenchReply)(nil),           // 9: chorus.UpdateWorkbenchReply
-	(*UpdateWorkbenchResult)(nil),          // 10: chorus.UpdateWorkbenchResult
-	(*AddUserRoleInWorkbenchRequest)(nil),  // 11: chorus.AddUserRoleInWorkbenchRequest
-	(*AddUserRoleInWorkbenchReply)(nil),    // 12: chorus.AddUserRoleInWorkbenchReply
-	(*AddUserRoleInWorkbenchResult)(nil),   // 13: chorus.AddUserRoleInWorkbenchResult
-	(*RemoveUserFromWorkbenchRequest)(nil), // 14: chorus.RemoveUserFromWorkbenchRequest
-	(*RemoveUserFromWorkbenchReply)(nil),   // 15: chorus.RemoveUserFromWorkbenchReply
-	(*RemoveUserFromWorkbenchResult)(nil),  // 16: chorus.RemoveUserFromWorkbenchResult
-	(*DeleteWorkbenchRequest)(nil),         // 17: chorus.DeleteWorkbenchRequest
-	(*DeleteWorkbenchReply)(nil),           // 18: chorus.DeleteWorkbenchReply
-	(*DeleteWorkbenchResult)(nil),          // 19: chorus.DeleteWorkbenchResult
-	(*PaginationQuery)(nil),                // 20: chorus.PaginationQuery
-	(*PaginationResult)(nil),               // 21: chorus.PaginationResult
-	(*Workbench)(nil),                      // 22: chorus.Workbench
-	(*Role)(nil),                           // 23: chorus.Role
+func (*GetWorkbenchScheduleRequest) ProtoMessage() {}
+
+func (x *GetWorkbenchScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_workbench_service_proto_depIdxs = []int32{
-	20, // 0: chorus.ListWorkbenchesRequest.pagination:type_name -> chorus.PaginationQuery
-	3,  // 1: chorus.ListWorkbenchesRequest.filter:type_name -> chorus.WorkbenchFilter
-	2,  // 2: chorus.ListWorkbenchesReply.result:type_name -> chorus.ListWorkbenchesResult
-	21, // 3: chorus.ListWorkbenchesReply.pagination:type_name -> chorus.PaginationResult
-	22, // 4: chorus.ListWorkbenchesResult.workbenches:type_name -> chorus.Workbench
-	6,  // 5: chorus.GetWorkbenchReply.result:type_name -> chorus.GetWorkbenchResult
-	22, // 6: chorus.GetWorkbenchResult.workbench:type_name -> chorus.Workbench
-	8,  // 7: chorus.CreateWorkbenchReply.result:type_name -> chorus.CreateWorkbenchResult
-	22, // 8: chorus.CreateWorkbenchResult.workbench:type_name -> chorus.Workbench
-	10, // 9: chorus.UpdateWorkbenchReply.result:type_name -> chorus.UpdateWorkbenchResult
-	22, // 10: chorus.UpdateWorkbenchResult.workbench:type_name -> chorus.Workbench
-	23, // 11: chorus.AddUserRoleInWorkbenchRequest.role:type_name -> chorus.Role
-	13, // 12: chorus.AddUserRoleInWorkbenchReply.result:type_name -> chorus.AddUserRoleInWorkbenchResult
-	22, // 13: chorus.AddUserRoleInWorkbenchResult.workbench:type_name -> chorus.Workbench
-	16, // 14: chorus.RemoveUserFromWorkbenchReply.result:type_name -> chorus.RemoveUserFromWorkbenchResult
-	22, // 15: chorus.RemoveUserFromWorkbenchResult.workbench:type_name -> chorus.Workbench
-	19, // 16: chorus.DeleteWorkbenchReply.result:type_name -> chorus.DeleteWorkbenchResult
-	22, // 17: chorus.DeleteWorkbenchResult.workbench:type_name -> chorus.Workbench
-	4,  // 18: chorus.WorkbenchService.GetWorkbench:input_type -> chorus.GetWorkbenchRequest
-	0,  // 19: chorus.WorkbenchService.ListWorkbenches:input_type -> chorus.ListWorkbenchesRequest
-	22, // 20: chorus.WorkbenchService.CreateWorkbench:input_type -> chorus.Workbench
-	22, // 21: chorus.WorkbenchService.UpdateWorkbench:input_type -> chorus.Workbench
-	11, // 22: chorus.WorkbenchService.AddUserRoleInWorkbench:input_type -> chorus.AddUserRoleInWorkbenchRequest
-	14, // 23: chorus.WorkbenchService.RemoveUserFromWorkbench:input_type -> chorus.RemoveUserFromWorkbenchRequest
-	17, // 24: chorus.WorkbenchService.DeleteWorkbench:input_type -> chorus.DeleteWorkbenchRequest
-	5,  // 25: chorus.WorkbenchService.GetWorkbench:output_type -> chorus.GetWorkbenchReply
-	1,  // 26: chorus.WorkbenchService.ListWorkbenches:output_type -> chorus.ListWorkbenchesReply
-	7,  // 27: chorus.WorkbenchService.CreateWorkbench:output_type -> chorus.CreateWorkbenchReply
-	9,  // 28: chorus.WorkbenchService.UpdateWorkbench:output_type -> chorus.UpdateWorkbenchReply
-	12, // 29: chorus.WorkbenchService.AddUserRoleInWorkbench:output_type -> chorus.AddUserRoleInWorkbenchReply
-	15, // 30: chorus.WorkbenchService.RemoveUserFromWorkbench:output_type -> chorus.RemoveUserFromWorkbenchReply
-	18, // 31: chorus.WorkbenchService.DeleteWorkbench:output_type -> chorus.DeleteWorkbenchReply
-	25, // [25:32] is the sub-list for method output_type
-	18, // [18:25] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+
+// Deprecated: Use GetWorkbenchScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkbenchScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{20}
 }
 
-func init() { file_workbench_service_proto_init() }
-func file_workbench_service_proto_init() {
-	if File_workbench_service_proto != nil {
-		return
+func (x *GetWorkbenchScheduleRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
 	}
-	file_common_proto_init()
-	file_workbench_proto_init()
-	file_user_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_workbench_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListWorkbenchesRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return 0
+}
+
+type GetWorkbenchScheduleReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *GetWorkbenchScheduleResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *GetWorkbenchScheduleReply) Reset() {
+	*x = GetWorkbenchScheduleReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWorkbenchScheduleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkbenchScheduleReply) ProtoMessage() {}
+
+func (x *GetWorkbenchScheduleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_workbench_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListWorkbenchesReply); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkbenchScheduleReply.ProtoReflect.Descriptor instead.
+func (*GetWorkbenchScheduleReply) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetWorkbenchScheduleReply) GetResult() *GetWorkbenchScheduleResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type GetWorkbenchScheduleResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Schedule *WorkbenchSchedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+}
+
+func (x *GetWorkbenchScheduleResult) Reset() {
+	*x = GetWorkbenchScheduleResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWorkbenchScheduleResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkbenchScheduleResult) ProtoMessage() {}
+
+func (x *GetWorkbenchScheduleResult) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_workbench_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListWorkbenchesResult); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkbenchScheduleResult.ProtoReflect.Descriptor instead.
+func (*GetWorkbenchScheduleResult) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetWorkbenchScheduleResult) GetSchedule() *WorkbenchSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type SetWorkbenchScheduleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       uint64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Schedule *WorkbenchSchedule `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule,omitempty"`
+}
+
+func (x *SetWorkbenchScheduleRequest) Reset() {
+	*x = SetWorkbenchScheduleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetWorkbenchScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetWorkbenchScheduleRequest) ProtoMessage() {}
+
+func (x *SetWorkbenchScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_workbench_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WorkbenchFilter); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetWorkbenchScheduleRequest.ProtoReflect.Descriptor instead.
+func (*SetWorkbenchScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SetWorkbenchScheduleRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SetWorkbenchScheduleRequest) GetSchedule() *WorkbenchSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type SetWorkbenchScheduleReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *SetWorkbenchScheduleResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *SetWorkbenchScheduleReply) Reset() {
+	*x = SetWorkbenchScheduleReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetWorkbenchScheduleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetWorkbenchScheduleReply) ProtoMessage() {}
+
+func (x *SetWorkbenchScheduleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_workbench_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetWorkbenchRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_workbench_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetWorkbenchReply); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_workbench_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetWorkbenchResult); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_workbench_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateWorkbenchReply); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_workbench_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateWorkbenchResult); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_workbench_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateWorkbenchReply); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_workbench_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateWorkbenchResult); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_workbench_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddUserRoleInWorkbenchRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_workbench_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddUserRoleInWorkbenchReply); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_workbench_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddUserRoleInWorkbenchResult); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_workbench_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveUserFromWorkbenchRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_workbench_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveUserFromWorkbenchReply); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_workbench_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveUserFromWorkbenchResult); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_workbench_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteWorkbenchRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_workbench_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteWorkbenchReply); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_workbench_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteWorkbenchResult); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetWorkbenchScheduleReply.ProtoReflect.Descriptor instead.
+func (*SetWorkbenchScheduleReply) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SetWorkbenchScheduleReply) GetResult() *SetWorkbenchScheduleResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type SetWorkbenchScheduleResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Schedule *WorkbenchSchedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+}
+
+func (x *SetWorkbenchScheduleResult) Reset() {
+	*x = SetWorkbenchScheduleResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetWorkbenchScheduleResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetWorkbenchScheduleResult) ProtoMessage() {}
+
+func (x *SetWorkbenchScheduleResult) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	file_workbench_service_proto_msgTypes[0].OneofWrappers = []interface{}{}
-	file_workbench_service_proto_msgTypes[1].OneofWrappers = []interface{}{}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_workbench_service_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   20,
-			NumExtensions: 0,
-			NumServices:   1,
-		},
-		GoTypes:           file_workbench_service_proto_goTypes,
-		DependencyIndexes: file_workbench_service_proto_depIdxs,
-		MessageInfos:      file_workbench_service_proto_msgTypes,
-	}.Build()
-	File_workbench_service_proto = out.File
-	file_workbench_service_proto_rawDesc = nil
-	file_workbench_service_proto_goTypes = nil
-	file_workbench_service_proto_depIdxs = nil
+	return mi.MessageOf(x)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
+// Deprecated: Use SetWorkbenchScheduleResult.ProtoReflect.Descriptor instead.
+func (*SetWorkbenchScheduleResult) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{25}
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
+func (x *SetWorkbenchScheduleResult) GetSchedule() *WorkbenchSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
 
-// WorkbenchServiceClient is the client API for WorkbenchService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DeleteWorkbenchScheduleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteWorkbenchScheduleRequest) Reset() {
+	*x = DeleteWorkbenchScheduleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteWorkbenchScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWorkbenchScheduleRequest) ProtoMessage() {}
+
+func (x *DeleteWorkbenchScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWorkbenchScheduleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWorkbenchScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DeleteWorkbenchScheduleRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteWorkbenchScheduleReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *DeleteWorkbenchScheduleResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *DeleteWorkbenchScheduleReply) Reset() {
+	*x = DeleteWorkbenchScheduleReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteWorkbenchScheduleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWorkbenchScheduleReply) ProtoMessage() {}
+
+func (x *DeleteWorkbenchScheduleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWorkbenchScheduleReply.ProtoReflect.Descriptor instead.
+func (*DeleteWorkbenchScheduleReply) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeleteWorkbenchScheduleReply) GetResult() *DeleteWorkbenchScheduleResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type DeleteWorkbenchScheduleResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteWorkbenchScheduleResult) Reset() {
+	*x = DeleteWorkbenchScheduleResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteWorkbenchScheduleResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWorkbenchScheduleResult) ProtoMessage() {}
+
+func (x *DeleteWorkbenchScheduleResult) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWorkbenchScheduleResult.ProtoReflect.Descriptor instead.
+func (*DeleteWorkbenchScheduleResult) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{28}
+}
+
+type KeepWorkbenchAliveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *KeepWorkbenchAliveRequest) Reset() {
+	*x = KeepWorkbenchAliveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeepWorkbenchAliveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeepWorkbenchAliveRequest) ProtoMessage() {}
+
+func (x *KeepWorkbenchAliveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeepWorkbenchAliveRequest.ProtoReflect.Descriptor instead.
+func (*KeepWorkbenchAliveRequest) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *KeepWorkbenchAliveRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type KeepWorkbenchAliveReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *KeepWorkbenchAliveResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *KeepWorkbenchAliveReply) Reset() {
+	*x = KeepWorkbenchAliveReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeepWorkbenchAliveReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeepWorkbenchAliveReply) ProtoMessage() {}
+
+func (x *KeepWorkbenchAliveReply) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeepWorkbenchAliveReply.ProtoReflect.Descriptor instead.
+func (*KeepWorkbenchAliveReply) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *KeepWorkbenchAliveReply) GetResult() *KeepWorkbenchAliveResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type KeepWorkbenchAliveResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// When the workbench is reclaimed if it stays idle. Unset when it is never reclaimed.
+	IdleReclaimAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=idle_reclaim_at,json=idleReclaimAt,proto3" json:"idle_reclaim_at,omitempty"`
+}
+
+func (x *KeepWorkbenchAliveResult) Reset() {
+	*x = KeepWorkbenchAliveResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeepWorkbenchAliveResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeepWorkbenchAliveResult) ProtoMessage() {}
+
+func (x *KeepWorkbenchAliveResult) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeepWorkbenchAliveResult.ProtoReflect.Descriptor instead.
+func (*KeepWorkbenchAliveResult) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *KeepWorkbenchAliveResult) GetIdleReclaimAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IdleReclaimAt
+	}
+	return nil
+}
+
+type HibernateWorkbenchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *HibernateWorkbenchRequest) Reset() {
+	*x = HibernateWorkbenchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HibernateWorkbenchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HibernateWorkbenchRequest) ProtoMessage() {}
+
+func (x *HibernateWorkbenchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HibernateWorkbenchRequest.ProtoReflect.Descriptor instead.
+func (*HibernateWorkbenchRequest) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *HibernateWorkbenchRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type HibernateWorkbenchReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *HibernateWorkbenchResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *HibernateWorkbenchReply) Reset() {
+	*x = HibernateWorkbenchReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HibernateWorkbenchReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HibernateWorkbenchReply) ProtoMessage() {}
+
+func (x *HibernateWorkbenchReply) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HibernateWorkbenchReply.ProtoReflect.Descriptor instead.
+func (*HibernateWorkbenchReply) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *HibernateWorkbenchReply) GetResult() *HibernateWorkbenchResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type HibernateWorkbenchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Workbench *Workbench `protobuf:"bytes,1,opt,name=workbench,proto3" json:"workbench,omitempty"`
+}
+
+func (x *HibernateWorkbenchResult) Reset() {
+	*x = HibernateWorkbenchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HibernateWorkbenchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HibernateWorkbenchResult) ProtoMessage() {}
+
+func (x *HibernateWorkbenchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HibernateWorkbenchResult.ProtoReflect.Descriptor instead.
+func (*HibernateWorkbenchResult) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *HibernateWorkbenchResult) GetWorkbench() *Workbench {
+	if x != nil {
+		return x.Workbench
+	}
+	return nil
+}
+
+type ResumeWorkbenchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ResumeWorkbenchRequest) Reset() {
+	*x = ResumeWorkbenchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumeWorkbenchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeWorkbenchRequest) ProtoMessage() {}
+
+func (x *ResumeWorkbenchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeWorkbenchRequest.ProtoReflect.Descriptor instead.
+func (*ResumeWorkbenchRequest) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ResumeWorkbenchRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ResumeWorkbenchReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *ResumeWorkbenchResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *ResumeWorkbenchReply) Reset() {
+	*x = ResumeWorkbenchReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumeWorkbenchReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeWorkbenchReply) ProtoMessage() {}
+
+func (x *ResumeWorkbenchReply) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeWorkbenchReply.ProtoReflect.Descriptor instead.
+func (*ResumeWorkbenchReply) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ResumeWorkbenchReply) GetResult() *ResumeWorkbenchResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type ResumeWorkbenchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Workbench *Workbench `protobuf:"bytes,1,opt,name=workbench,proto3" json:"workbench,omitempty"`
+}
+
+func (x *ResumeWorkbenchResult) Reset() {
+	*x = ResumeWorkbenchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumeWorkbenchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeWorkbenchResult) ProtoMessage() {}
+
+func (x *ResumeWorkbenchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeWorkbenchResult.ProtoReflect.Descriptor instead.
+func (*ResumeWorkbenchResult) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ResumeWorkbenchResult) GetWorkbench() *Workbench {
+	if x != nil {
+		return x.Workbench
+	}
+	return nil
+}
+
+type CreateWorkbenchShareGrantRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// share with a single user, or with the current members of a workspace
+	UserId      uint64 `protobuf:"varint,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	WorkspaceId uint64 `protobuf:"varint,3,opt,name=workspaceId,proto3" json:"workspaceId,omitempty"`
+	// view or interactive
+	Mode      string                 `protobuf:"bytes,4,opt,name=mode,proto3" json:"mode,omitempty"`
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+}
+
+func (x *CreateWorkbenchShareGrantRequest) Reset() {
+	*x = CreateWorkbenchShareGrantRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateWorkbenchShareGrantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWorkbenchShareGrantRequest) ProtoMessage() {}
+
+func (x *CreateWorkbenchShareGrantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWorkbenchShareGrantRequest.ProtoReflect.Descriptor instead.
+func (*CreateWorkbenchShareGrantRequest) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CreateWorkbenchShareGrantRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CreateWorkbenchShareGrantRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateWorkbenchShareGrantRequest) GetWorkspaceId() uint64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *CreateWorkbenchShareGrantRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *CreateWorkbenchShareGrantRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type CreateWorkbenchShareGrantReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *CreateWorkbenchShareGrantResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *CreateWorkbenchShareGrantReply) Reset() {
+	*x = CreateWorkbenchShareGrantReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateWorkbenchShareGrantReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWorkbenchShareGrantReply) ProtoMessage() {}
+
+func (x *CreateWorkbenchShareGrantReply) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWorkbenchShareGrantReply.ProtoReflect.Descriptor instead.
+func (*CreateWorkbenchShareGrantReply) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CreateWorkbenchShareGrantReply) GetResult() *CreateWorkbenchShareGrantResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type CreateWorkbenchShareGrantResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Grant *WorkbenchShareGrant `protobuf:"bytes,1,opt,name=grant,proto3" json:"grant,omitempty"`
+}
+
+func (x *CreateWorkbenchShareGrantResult) Reset() {
+	*x = CreateWorkbenchShareGrantResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateWorkbenchShareGrantResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWorkbenchShareGrantResult) ProtoMessage() {}
+
+func (x *CreateWorkbenchShareGrantResult) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWorkbenchShareGrantResult.ProtoReflect.Descriptor instead.
+func (*CreateWorkbenchShareGrantResult) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CreateWorkbenchShareGrantResult) GetGrant() *WorkbenchShareGrant {
+	if x != nil {
+		return x.Grant
+	}
+	return nil
+}
+
+type ListWorkbenchShareGrantsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ListWorkbenchShareGrantsRequest) Reset() {
+	*x = ListWorkbenchShareGrantsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWorkbenchShareGrantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkbenchShareGrantsRequest) ProtoMessage() {}
+
+func (x *ListWorkbenchShareGrantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkbenchShareGrantsRequest.ProtoReflect.Descriptor instead.
+func (*ListWorkbenchShareGrantsRequest) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListWorkbenchShareGrantsRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListWorkbenchShareGrantsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *ListWorkbenchShareGrantsResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *ListWorkbenchShareGrantsReply) Reset() {
+	*x = ListWorkbenchShareGrantsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWorkbenchShareGrantsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkbenchShareGrantsReply) ProtoMessage() {}
+
+func (x *ListWorkbenchShareGrantsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkbenchShareGrantsReply.ProtoReflect.Descriptor instead.
+func (*ListWorkbenchShareGrantsReply) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListWorkbenchShareGrantsReply) GetResult() *ListWorkbenchShareGrantsResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type ListWorkbenchShareGrantsResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Grants []*WorkbenchShareGrant `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants,omitempty"`
+}
+
+func (x *ListWorkbenchShareGrantsResult) Reset() {
+	*x = ListWorkbenchShareGrantsResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWorkbenchShareGrantsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkbenchShareGrantsResult) ProtoMessage() {}
+
+func (x *ListWorkbenchShareGrantsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkbenchShareGrantsResult.ProtoReflect.Descriptor instead.
+func (*ListWorkbenchShareGrantsResult) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListWorkbenchShareGrantsResult) GetGrants() []*WorkbenchShareGrant {
+	if x != nil {
+		return x.Grants
+	}
+	return nil
+}
+
+type RevokeWorkbenchShareGrantRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	GrantId uint64 `protobuf:"varint,2,opt,name=grantId,proto3" json:"grantId,omitempty"`
+}
+
+func (x *RevokeWorkbenchShareGrantRequest) Reset() {
+	*x = RevokeWorkbenchShareGrantRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeWorkbenchShareGrantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeWorkbenchShareGrantRequest) ProtoMessage() {}
+
+func (x *RevokeWorkbenchShareGrantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeWorkbenchShareGrantRequest.ProtoReflect.Descriptor instead.
+func (*RevokeWorkbenchShareGrantRequest) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RevokeWorkbenchShareGrantRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RevokeWorkbenchShareGrantRequest) GetGrantId() uint64 {
+	if x != nil {
+		return x.GrantId
+	}
+	return 0
+}
+
+type RevokeWorkbenchShareGrantReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *RevokeWorkbenchShareGrantResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *RevokeWorkbenchShareGrantReply) Reset() {
+	*x = RevokeWorkbenchShareGrantReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeWorkbenchShareGrantReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeWorkbenchShareGrantReply) ProtoMessage() {}
+
+func (x *RevokeWorkbenchShareGrantReply) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeWorkbenchShareGrantReply.ProtoReflect.Descriptor instead.
+func (*RevokeWorkbenchShareGrantReply) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RevokeWorkbenchShareGrantReply) GetResult() *RevokeWorkbenchShareGrantResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type RevokeWorkbenchShareGrantResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Grant *WorkbenchShareGrant `protobuf:"bytes,1,opt,name=grant,proto3" json:"grant,omitempty"`
+}
+
+func (x *RevokeWorkbenchShareGrantResult) Reset() {
+	*x = RevokeWorkbenchShareGrantResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeWorkbenchShareGrantResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeWorkbenchShareGrantResult) ProtoMessage() {}
+
+func (x *RevokeWorkbenchShareGrantResult) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeWorkbenchShareGrantResult.ProtoReflect.Descriptor instead.
+func (*RevokeWorkbenchShareGrantResult) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *RevokeWorkbenchShareGrantResult) GetGrant() *WorkbenchShareGrant {
+	if x != nil {
+		return x.Grant
+	}
+	return nil
+}
+
+type WatchWorkbenchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *WatchWorkbenchRequest) Reset() {
+	*x = WatchWorkbenchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchWorkbenchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchWorkbenchRequest) ProtoMessage() {}
+
+func (x *WatchWorkbenchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchWorkbenchRequest.ProtoReflect.Descriptor instead.
+func (*WatchWorkbenchRequest) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *WatchWorkbenchRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type WatchWorkspaceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *WatchWorkspaceRequest) Reset() {
+	*x = WatchWorkspaceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchWorkspaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchWorkspaceRequest) ProtoMessage() {}
+
+func (x *WatchWorkspaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*WatchWorkspaceRequest) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *WatchWorkspaceRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetWorkbenchServerLogsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// number of lines from the end of the logs, 200 by default and at most 5000
+	TailLines uint32 `protobuf:"varint,2,opt,name=tailLines,proto3" json:"tailLines,omitempty"`
+	// only the lines written after this time
+	SinceTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=sinceTime,proto3" json:"sinceTime,omitempty"`
+	// the logs of the container that ran before the last restart
+	Previous bool `protobuf:"varint,4,opt,name=previous,proto3" json:"previous,omitempty"`
+}
+
+func (x *GetWorkbenchServerLogsRequest) Reset() {
+	*x = GetWorkbenchServerLogsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWorkbenchServerLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkbenchServerLogsRequest) ProtoMessage() {}
+
+func (x *GetWorkbenchServerLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkbenchServerLogsRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkbenchServerLogsRequest) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetWorkbenchServerLogsRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetWorkbenchServerLogsRequest) GetTailLines() uint32 {
+	if x != nil {
+		return x.TailLines
+	}
+	return 0
+}
+
+func (x *GetWorkbenchServerLogsRequest) GetSinceTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SinceTime
+	}
+	return nil
+}
+
+func (x *GetWorkbenchServerLogsRequest) GetPrevious() bool {
+	if x != nil {
+		return x.Previous
+	}
+	return false
+}
+
+type GetWorkbenchServerLogsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *GetWorkbenchServerLogsResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *GetWorkbenchServerLogsReply) Reset() {
+	*x = GetWorkbenchServerLogsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWorkbenchServerLogsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkbenchServerLogsReply) ProtoMessage() {}
+
+func (x *GetWorkbenchServerLogsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkbenchServerLogsReply.ProtoReflect.Descriptor instead.
+func (*GetWorkbenchServerLogsReply) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetWorkbenchServerLogsReply) GetResult() *GetWorkbenchServerLogsResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type GetWorkbenchServerLogsResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Logs *PodLogs `protobuf:"bytes,1,opt,name=logs,proto3" json:"logs,omitempty"`
+}
+
+func (x *GetWorkbenchServerLogsResult) Reset() {
+	*x = GetWorkbenchServerLogsResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWorkbenchServerLogsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkbenchServerLogsResult) ProtoMessage() {}
+
+func (x *GetWorkbenchServerLogsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkbenchServerLogsResult.ProtoReflect.Descriptor instead.
+func (*GetWorkbenchServerLogsResult) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetWorkbenchServerLogsResult) GetLogs() *PodLogs {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+type ListWorkbenchServerEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ListWorkbenchServerEventsRequest) Reset() {
+	*x = ListWorkbenchServerEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWorkbenchServerEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkbenchServerEventsRequest) ProtoMessage() {}
+
+func (x *ListWorkbenchServerEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkbenchServerEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListWorkbenchServerEventsRequest) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListWorkbenchServerEventsRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListWorkbenchServerEventsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *ListWorkbenchServerEventsResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *ListWorkbenchServerEventsReply) Reset() {
+	*x = ListWorkbenchServerEventsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWorkbenchServerEventsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkbenchServerEventsReply) ProtoMessage() {}
+
+func (x *ListWorkbenchServerEventsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkbenchServerEventsReply.ProtoReflect.Descriptor instead.
+func (*ListWorkbenchServerEventsReply) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListWorkbenchServerEventsReply) GetResult() *ListWorkbenchServerEventsResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type ListWorkbenchServerEventsResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*PodEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *ListWorkbenchServerEventsResult) Reset() {
+	*x = ListWorkbenchServerEventsResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWorkbenchServerEventsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkbenchServerEventsResult) ProtoMessage() {}
+
+func (x *ListWorkbenchServerEventsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkbenchServerEventsResult.ProtoReflect.Descriptor instead.
+func (*ListWorkbenchServerEventsResult) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListWorkbenchServerEventsResult) GetEvents() []*PodEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type GetWorkbenchUsageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetWorkbenchUsageRequest) Reset() {
+	*x = GetWorkbenchUsageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWorkbenchUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkbenchUsageRequest) ProtoMessage() {}
+
+func (x *GetWorkbenchUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkbenchUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkbenchUsageRequest) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetWorkbenchUsageRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetWorkbenchUsageReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *GetWorkbenchUsageResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *GetWorkbenchUsageReply) Reset() {
+	*x = GetWorkbenchUsageReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWorkbenchUsageReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkbenchUsageReply) ProtoMessage() {}
+
+func (x *GetWorkbenchUsageReply) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkbenchUsageReply.ProtoReflect.Descriptor instead.
+func (*GetWorkbenchUsageReply) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetWorkbenchUsageReply) GetResult() *GetWorkbenchUsageResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type GetWorkbenchUsageResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Usage *WorkbenchUsage `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage,omitempty"`
+}
+
+func (x *GetWorkbenchUsageResult) Reset() {
+	*x = GetWorkbenchUsageResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWorkbenchUsageResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkbenchUsageResult) ProtoMessage() {}
+
+func (x *GetWorkbenchUsageResult) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkbenchUsageResult.ProtoReflect.Descriptor instead.
+func (*GetWorkbenchUsageResult) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetWorkbenchUsageResult) GetUsage() *WorkbenchUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+type GetWorkspaceUsageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetWorkspaceUsageRequest) Reset() {
+	*x = GetWorkspaceUsageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWorkspaceUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkspaceUsageRequest) ProtoMessage() {}
+
+func (x *GetWorkspaceUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkspaceUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkspaceUsageRequest) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetWorkspaceUsageRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetWorkspaceUsageReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *GetWorkspaceUsageResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *GetWorkspaceUsageReply) Reset() {
+	*x = GetWorkspaceUsageReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWorkspaceUsageReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkspaceUsageReply) ProtoMessage() {}
+
+func (x *GetWorkspaceUsageReply) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkspaceUsageReply.ProtoReflect.Descriptor instead.
+func (*GetWorkspaceUsageReply) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetWorkspaceUsageReply) GetResult() *GetWorkspaceUsageResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type GetWorkspaceUsageResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Usage *WorkspaceUsage `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage,omitempty"`
+}
+
+func (x *GetWorkspaceUsageResult) Reset() {
+	*x = GetWorkspaceUsageResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWorkspaceUsageResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkspaceUsageResult) ProtoMessage() {}
+
+func (x *GetWorkspaceUsageResult) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkspaceUsageResult.ProtoReflect.Descriptor instead.
+func (*GetWorkspaceUsageResult) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetWorkspaceUsageResult) GetUsage() *WorkspaceUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+// Connections listing of a workbench, a workspace or a user
+type ListWorkbenchConnectionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint64                     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pagination *PaginationQuery           `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Filter     *WorkbenchConnectionFilter `protobuf:"bytes,3,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
+}
+
+func (x *ListWorkbenchConnectionsRequest) Reset() {
+	*x = ListWorkbenchConnectionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWorkbenchConnectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkbenchConnectionsRequest) ProtoMessage() {}
+
+func (x *ListWorkbenchConnectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkbenchConnectionsRequest.ProtoReflect.Descriptor instead.
+func (*ListWorkbenchConnectionsRequest) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListWorkbenchConnectionsRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ListWorkbenchConnectionsRequest) GetPagination() *PaginationQuery {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListWorkbenchConnectionsRequest) GetFilter() *WorkbenchConnectionFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type ListWorkbenchConnectionsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result     *ListWorkbenchConnectionsResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Pagination *PaginationResult               `protobuf:"bytes,2,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
+}
+
+func (x *ListWorkbenchConnectionsReply) Reset() {
+	*x = ListWorkbenchConnectionsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWorkbenchConnectionsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkbenchConnectionsReply) ProtoMessage() {}
+
+func (x *ListWorkbenchConnectionsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkbenchConnectionsReply.ProtoReflect.Descriptor instead.
+func (*ListWorkbenchConnectionsReply) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListWorkbenchConnectionsReply) GetResult() *ListWorkbenchConnectionsResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *ListWorkbenchConnectionsReply) GetPagination() *PaginationResult {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListWorkbenchConnectionsResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Connections []*WorkbenchConnection `protobuf:"bytes,1,rep,name=connections,proto3" json:"connections,omitempty"`
+}
+
+func (x *ListWorkbenchConnectionsResult) Reset() {
+	*x = ListWorkbenchConnectionsResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workbench_service_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWorkbenchConnectionsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkbenchConnectionsResult) ProtoMessage() {}
+
+func (x *ListWorkbenchConnectionsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_workbench_service_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkbenchConnectionsResult.ProtoReflect.Descriptor instead.
+func (*ListWorkbenchConnectionsResult) Descriptor() ([]byte, []int) {
+	return file_workbench_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListWorkbenchConnectionsResult) GetConnections() []*WorkbenchConnection {
+	if x != nil {
+		return x.Connections
+	}
+	return nil
+}
+
+var File_workbench_service_proto protoreflect.FileDescriptor
+
+var file_workbench_service_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x2d, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x63, 0x68, 0x6f, 0x72, 0x75,
+	0x73, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e,
+	0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0f, 0x77, 0x6f, 0x72,
+	0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0a, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x92, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73,
+	0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73,
+	0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63,
+	0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x88,
+	0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x9b, 0x01,
+	0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x65,
+	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3d, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4c, 0x0a, 0x15, 0x4c,
+	0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x33, 0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63,
+	0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x68, 0x6f, 0x72,
+	0x75, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x0b, 0x77, 0x6f,
+	0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x22, 0x3b, 0x0a, 0x0f, 0x57, 0x6f, 0x72,
+	0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x10,
+	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x5f, 0x69, 0x6e,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0e, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x49, 0x64, 0x73, 0x49, 0x6e, 0x22, 0x25, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72,
+	0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x47, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x32, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x57,
+	0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x45, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72,
+	0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2f, 0x0a, 0x09,
+	0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x22, 0x4d, 0x0a,
+	0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x48, 0x0a, 0x15,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2f, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75,
+	0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x09, 0x77, 0x6f, 0x72,
+	0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x22, 0x4d, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x35,
+	0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f,
+	0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x48, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57,
+	0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2f,
+	0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x22,
+	0x69, 0x0a, 0x1d, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e,
+	0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x5b, 0x0a, 0x1b, 0x41, 0x64,
+	0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3c, 0x0a, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x68, 0x6f, 0x72,
+	0x75, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e,
+	0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x4f, 0x0a, 0x1c, 0x41, 0x64, 0x64, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63,
+	0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2f, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x68, 0x6f,
+	0x72, 0x75, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x09, 0x77,
+	0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x22, 0x48, 0x0a, 0x1e, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x46, 0x72, 0x6f, 0x6d, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0x5d, 0x0a, 0x1c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x46, 0x72, 0x6f, 0x6d, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x3d, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x46, 0x72, 0x6f, 0x6d, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x22, 0x50, 0x0a, 0x1d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x46,
+	0x72, 0x6f, 0x6d, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x2f, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x57,
+	0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x22, 0x28, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72,
+	0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4d, 0x0a,
+	0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x48, 0x0a, 0x15,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2f, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75,
+	0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x09, 0x77, 0x6f, 0x72,
+	0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x22, 0x2d, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72,
+	0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x57, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x3a, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x57,
+	0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x53,
+	0x0a, 0x1a, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x35, 0x0a, 0x08,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63,
+	0x68, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x22, 0x64, 0x0a, 0x1b, 0x53, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x57, 0x6f,
+	0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52,
+	0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x57, 0x0a, 0x19, 0x53, 0x65, 0x74,
+	0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3a, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e,
+	0x53, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x22, 0x53, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x35, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x08, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x30, 0x0a, 0x1e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x5d, 0x0a, 0x1c, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3d, 0x0a, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x68, 0x6f, 0x72,
+	0x75, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x1f, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x2b, 0x0a, 0x19, 0x4b, 0x65, 0x65,
+	0x70, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x53, 0x0a, 0x17, 0x4b, 0x65, 0x65, 0x70, 0x57, 0x6f,
+	0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x38, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x4b, 0x65, 0x65, 0x70, 0x57,
+	0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x5e, 0x0a, 0x18, 0x4b,
+	0x65, 0x65, 0x70, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x41, 0x6c, 0x69, 0x76,
+	0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x42, 0x0a, 0x0f, 0x69, 0x64, 0x6c, 0x65, 0x5f,
+	0x72, 0x65, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x69, 0x64,
+	0x6c, 0x65, 0x52, 0x65, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x41, 0x74, 0x22, 0x2b, 0x0a, 0x19, 0x48,
+	0x69, 0x62, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x53, 0x0a, 0x17, 0x48, 0x69, 0x62, 0x65,
+	0x72, 0x6e, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x38, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x48, 0x69, 0x62,
+	0x65, 0x72, 0x6e, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x4b, 0x0a,
+	0x18, 0x48, 0x69, 0x62, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2f, 0x0a, 0x09, 0x77, 0x6f, 0x72,
+	0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63,
+	0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52,
+	0x09, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x22, 0x28, 0x0a, 0x16, 0x52, 0x65,
+	0x73, 0x75, 0x6d, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x4d, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x57, 0x6f,
+	0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63,
+	0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x57, 0x6f, 0x72, 0x6b,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x22, 0x48, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x57, 0x6f, 0x72,
+	0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2f, 0x0a, 0x09,
+	0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x22, 0xba, 0x01,
+	0x0a, 0x20, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63,
+	0x68, 0x53, 0x68, 0x61, 0x72, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x77, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x12, 0x38, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x61, 0x0a, 0x1e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3f, 0x0a, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63,
+	0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x68, 0x61, 0x72, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x54, 0x0a,
+	0x1f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x31, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x53, 0x68, 0x61, 0x72, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x05, 0x67, 0x72,
+	0x61, 0x6e, 0x74, 0x22, 0x31, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x53, 0x68, 0x61, 0x72, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x5f, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f,
+	0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x68, 0x61, 0x72, 0x65, 0x47, 0x72, 0x61, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3e, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x55, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x57,
+	0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x68, 0x61, 0x72, 0x65, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x33, 0x0a, 0x06, 0x67, 0x72, 0x61,
+	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x68, 0x6f, 0x72,
+	0x75, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x06, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x4c,
+	0x0a, 0x20, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63,
+	0x68, 0x53, 0x68, 0x61, 0x72, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x61, 0x0a, 0x1e,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3f,
+	0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x57, 0x6f,
+	0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x68, 0x61, 0x72, 0x65, 0x47, 0x72, 0x61, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+	0x54, 0x0a, 0x1f, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x53, 0x68, 0x61, 0x72, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x31, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x53, 0x68, 0x61, 0x72, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x05,
+	0x67, 0x72, 0x61, 0x6e, 0x74, 0x22, 0x27, 0x0a, 0x15, 0x57, 0x61, 0x74, 0x63, 0x68, 0x57, 0x6f,
+	0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x27,
+	0x0a, 0x15, 0x57, 0x61, 0x74, 0x63, 0x68, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0xa3, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x57,
+	0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4c, 0x6f,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x69,
+	0x6c, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x61,
+	0x69, 0x6c, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x69, 0x6e, 0x63, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x22, 0x5b, 0x0a,
+	0x1b, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3c, 0x0a, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63,
+	0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x43, 0x0a, 0x1c, 0x47, 0x65,
+	0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x23, 0x0a, 0x04, 0x6c, 0x6f,
+	0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75,
+	0x73, 0x2e, 0x50, 0x6f, 0x64, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x22,
+	0x32, 0x0a, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x61, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3f, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x4b, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f,
+	0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x28, 0x0a, 0x06, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x68, 0x6f, 0x72,
+	0x75, 0x73, 0x2e, 0x50, 0x6f, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x22, 0x2a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x51, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x37, 0x0a, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x68, 0x6f, 0x72,
+	0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x22, 0x47, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2c, 0x0a,
+	0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63,
+	0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x22, 0x2a, 0x0a, 0x18, 0x47,
+	0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x51, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x57, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x37, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x47, 0x0a, 0x17, 0x47, 0x65,
+	0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2c, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x57, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x75, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0xb5, 0x01, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x37, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x68,
+	0x6f, 0x72, 0x75, 0x73, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x3e, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01,
+	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xad, 0x01, 0x0a, 0x1d,
+	0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3e, 0x0a,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3d, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5f, 0x0a, 0x1e, 0x4c,
+	0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3d, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x32, 0xf9, 0x3c, 0x0a,
+	0x10, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0xd7, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x12, 0x1b, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x57,
+	0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x19, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x8e, 0x01, 0x92, 0x41, 0x46,
+	0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x0f, 0x47, 0x65, 0x74, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x1a, 0x21, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72,
+	0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3f, 0x5a, 0x1e, 0x12, 0x1c,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72,
+	0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x1d, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xe1, 0x01, 0x0a, 0x0f,
+	0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x12,
+	0x1e, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72,
+	0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72,
+	0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x8f, 0x01,
+	0x92, 0x41, 0x51, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x77, 0x6f, 0x72, 0x6b,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x1a, 0x2b, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61,
+	0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x65, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x5a, 0x19, 0x12, 0x17, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x73, 0x12, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74,
+	0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x12,
+	0xd2, 0x01, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x12, 0x11, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x57, 0x6f, 0x72,
+	0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x1a, 0x1c, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x8d, 0x01, 0x92, 0x41, 0x49, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68,
+	0x1a, 0x21, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x73, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3b, 0x3a, 0x01, 0x2a, 0x5a, 0x1c, 0x3a, 0x01,
+	0x2a, 0x22, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f,
+	0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x73, 0x22, 0x18, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x65, 0x73, 0x12, 0xd2, 0x01, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57,
+	0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x12, 0x11, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75,
+	0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x1a, 0x1c, 0x2e, 0x63, 0x68,
+	0x6f, 0x72, 0x75, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x8d, 0x01, 0x92, 0x41, 0x49, 0x0a,
+	0x10, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x1a, 0x21, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x20, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x20, 0x61, 0x20, 0x77,
+	0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3b, 0x3a, 0x01,
+	0x2a, 0x5a, 0x1c, 0x3a, 0x01, 0x2a, 0x1a, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73,
+	0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x73, 0x1a,
+	0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f,
+	0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x12, 0xc0, 0x02, 0x0a, 0x16, 0x41, 0x64,
+	0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x12, 0x25, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x68,
+	0x6f, 0x72, 0x75, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65,
+	0x49, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0xd9, 0x01, 0x92, 0x41, 0x65, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63,
+	0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x20, 0x41, 0x64, 0x64, 0x20, 0x61, 0x20,
+	0x75, 0x73, 0x65, 0x72, 0x27, 0x73, 0x20, 0x72, 0x6f, 0x6c, 0x65, 0x20, 0x69, 0x6e, 0x20, 0x61,
+	0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x1a, 0x2f, 0x54, 0x68, 0x69, 0x73,
+	0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x61, 0x64, 0x64, 0x73, 0x20, 0x61,
+	0x20, 0x75, 0x73, 0x65, 0x72, 0x27, 0x73, 0x20, 0x72, 0x6f, 0x6c, 0x65, 0x20, 0x69, 0x6e, 0x20,
+	0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x6b, 0x3a, 0x01, 0x2a, 0x5a, 0x34, 0x3a, 0x01, 0x2a, 0x22, 0x2f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63,
+	0x68, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x7b, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x30, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x7b,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0xaf, 0x02, 0x0a,
+	0x17, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x46, 0x72, 0x6f, 0x6d, 0x57,
+	0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x12, 0x26, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75,
+	0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x46, 0x72, 0x6f, 0x6d,
+	0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x46, 0x72, 0x6f, 0x6d, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63,
+	0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xc5, 0x01, 0x92, 0x41, 0x61, 0x0a, 0x10, 0x57, 0x6f,
+	0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1e,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x20, 0x61, 0x20, 0x75, 0x73, 0x65, 0x72, 0x20, 0x66, 0x72,
+	0x6f, 0x6d, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x1a, 0x2d,
+	0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x72, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x73, 0x20, 0x61, 0x20, 0x75, 0x73, 0x65, 0x72, 0x20, 0x66, 0x72, 0x6f,
+	0x6d, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x5b, 0x5a, 0x2c, 0x2a, 0x2a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74,
+	0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x73, 0x2f, 0x7b,
+	0x69, 0x64, 0x7d, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x7d, 0x2a, 0x2b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f,
+	0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
+	0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x12, 0xe3,
+	0x01, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x12, 0x1e, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x91, 0x01, 0x92, 0x41, 0x49, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63,
+	0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x1a, 0x21, 0x54, 0x68,
+	0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x73, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x3f, 0x5a, 0x1e, 0x2a, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65,
+	0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x73,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2a, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74,
+	0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x12, 0x8c, 0x02, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x23, 0x2e,
+	0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x57,
+	0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xab, 0x01, 0x92, 0x41, 0x7a, 0x0a, 0x10, 0x57, 0x6f, 0x72,
+	0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1f, 0x47,
+	0x65, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x20,
+	0x6f, 0x66, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x1a, 0x45,
+	0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x72, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x77, 0x65, 0x65, 0x6b, 0x6c, 0x79,
+	0x20, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x20, 0x64, 0x75, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x77,
+	0x68, 0x69, 0x63, 0x68, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68,
+	0x20, 0x72, 0x75, 0x6e, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x12, 0xfa, 0x02, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x23, 0x2e, 0x63,
+	0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x57, 0x6f,
+	0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x99, 0x02, 0x92, 0x41, 0xe4, 0x01, 0x0a, 0x10, 0x57, 0x6f, 0x72,
+	0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1f, 0x53,
+	0x65, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x20,
+	0x6f, 0x66, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x1a, 0xae,
+	0x01, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x61,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x73, 0x20, 0x61, 0x20, 0x77, 0x65, 0x65, 0x6b, 0x6c, 0x79,
+	0x20, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x20, 0x74, 0x6f, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72,
+	0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x3b, 0x20, 0x6f, 0x75, 0x74, 0x73, 0x69, 0x64, 0x65, 0x20,
+	0x6f, 0x66, 0x20, 0x69, 0x74, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x61, 0x6e, 0x64, 0x20,
+	0x69, 0x74, 0x73, 0x20, 0x61, 0x70, 0x70, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x2c, 0x20, 0x61,
+	0x6e, 0x64, 0x20, 0x74, 0x68, 0x65, 0x79, 0x20, 0x61, 0x72, 0x65, 0x20, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x20, 0x61, 0x67, 0x61, 0x69, 0x6e, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x20, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x3a, 0x01, 0x2a, 0x1a, 0x26, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72,
+	0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68,
+	0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x12, 0xb7, 0x02, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x26, 0x2e, 0x63,
+	0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xcd, 0x01, 0x92, 0x41, 0x9b,
+	0x01, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x22, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x77, 0x6f,
+	0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x1a, 0x63, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x61,
+	0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x2c, 0x20, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x69, 0x6e, 0x67, 0x20, 0x69, 0x74, 0x20, 0x61, 0x67, 0x61, 0x69, 0x6e, 0x20, 0x69, 0x66,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x20, 0x68, 0x61,
+	0x64, 0x20, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x20, 0x69, 0x74, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x28, 0x2a, 0x26, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31,
+	0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64,
+	0x7d, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0xa7, 0x02, 0x0a, 0x12, 0x4b,
+	0x65, 0x65, 0x70, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x41, 0x6c, 0x69, 0x76,
+	0x65, 0x12, 0x21, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x4b, 0x65, 0x65, 0x70, 0x57,
+	0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x4b, 0x65,
+	0x65, 0x70, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x41, 0x6c, 0x69, 0x76, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xcc, 0x01, 0x92, 0x41, 0x98, 0x01, 0x0a, 0x10, 0x57, 0x6f,
+	0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x16,
+	0x4b, 0x65, 0x65, 0x70, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68,
+	0x20, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x1a, 0x6c, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x69, 0x64, 0x6c, 0x65, 0x20, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x20, 0x6f, 0x66,
+	0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x2c, 0x20, 0x70, 0x6f,
+	0x73, 0x74, 0x70, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x20, 0x69, 0x74, 0x73, 0x20, 0x72, 0x65, 0x63,
+	0x6c, 0x61, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x61, 0x73, 0x20, 0x69, 0x66, 0x20, 0x69,
+	0x74, 0x20, 0x68, 0x61, 0x64, 0x20, 0x6a, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x65, 0x6e, 0x20,
+	0x75, 0x73, 0x65, 0x64, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x22, 0x28, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x6b, 0x65, 0x65, 0x70, 0x2d, 0x61,
+	0x6c, 0x69, 0x76, 0x65, 0x12, 0xe4, 0x02, 0x0a, 0x12, 0x48, 0x69, 0x62, 0x65, 0x72, 0x6e, 0x61,
+	0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x12, 0x21, 0x2e, 0x63, 0x68,
+	0x6f, 0x72, 0x75, 0x73, 0x2e, 0x48, 0x69, 0x62, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x65, 0x57, 0x6f,
+	0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x48, 0x69, 0x62, 0x65, 0x72, 0x6e, 0x61, 0x74,
+	0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x89, 0x02, 0x92, 0x41, 0xd6, 0x01, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63,
+	0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x15, 0x48, 0x69, 0x62, 0x65, 0x72, 0x6e,
+	0x61, 0x74, 0x65, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x1a,
+	0xaa, 0x01, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20,
+	0x66, 0x72, 0x65, 0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x20, 0x77, 0x68, 0x69, 0x6c, 0x65, 0x20, 0x6b, 0x65, 0x65, 0x70, 0x69, 0x6e, 0x67,
+	0x20, 0x69, 0x74, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x69, 0x74, 0x73, 0x20, 0x61, 0x70, 0x70, 0x20,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2c, 0x20, 0x61, 0x6c, 0x6f, 0x6e, 0x67,
+	0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x74, 0x68, 0x65, 0x69, 0x72, 0x20, 0x61, 0x70, 0x70, 0x20,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x72, 0x65, 0x73,
+	0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2c, 0x20, 0x73, 0x6f, 0x20, 0x74, 0x68, 0x61, 0x74,
+	0x20, 0x69, 0x74, 0x20, 0x63, 0x61, 0x6e, 0x20, 0x62, 0x65, 0x20, 0x72, 0x65, 0x73, 0x75, 0x6d,
+	0x65, 0x64, 0x20, 0x61, 0x73, 0x20, 0x69, 0x74, 0x20, 0x77, 0x61, 0x73, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x29, 0x22, 0x27, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31,
+	0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64,
+	0x7d, 0x2f, 0x68, 0x69, 0x62, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x65, 0x12, 0x8f, 0x02, 0x0a, 0x0f,
+	0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x12,
+	0x1e, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x57,
+	0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x57,
+	0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xbd, 0x01,
+	0x92, 0x41, 0x8d, 0x01, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x20, 0x61,
+	0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x1a, 0x65, 0x54, 0x68, 0x69, 0x73,
+	0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x62, 0x72, 0x69, 0x6e, 0x67, 0x73,
+	0x20, 0x62, 0x61, 0x63, 0x6b, 0x20, 0x61, 0x20, 0x68, 0x69, 0x62, 0x65, 0x72, 0x6e, 0x61, 0x74,
+	0x65, 0x64, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x20, 0x77, 0x69, 0x74,
+	0x68, 0x20, 0x74, 0x68, 0x65, 0x20, 0x61, 0x70, 0x70, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x20, 0x69, 0x74, 0x20, 0x68, 0x61, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20,
+	0x69, 0x74, 0x20, 0x77, 0x61, 0x73, 0x20, 0x68, 0x69, 0x62, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x65,
+	0x64, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x22, 0x24, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65,
+	0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x65,
+	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x12, 0xd6, 0x02,
+	0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63,
+	0x68, 0x53, 0x68, 0x61, 0x72, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x28, 0x2e, 0x63, 0x68,
+	0x6f, 0x72, 0x75, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x53, 0x68, 0x61, 0x72, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xe6, 0x01,
+	0x92, 0x41, 0xad, 0x01, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x11, 0x53, 0x68, 0x61, 0x72, 0x65, 0x20, 0x61, 0x20,
+	0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x1a, 0x85, 0x01, 0x54, 0x68, 0x69, 0x73,
+	0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x67, 0x69, 0x76, 0x65, 0x73, 0x20,
+	0x61, 0x20, 0x75, 0x73, 0x65, 0x72, 0x2c, 0x20, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x2c, 0x20, 0x61, 0x20, 0x76, 0x69, 0x65, 0x77, 0x2d, 0x6f, 0x6e,
+	0x6c, 0x79, 0x20, 0x6f, 0x72, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x20, 0x72, 0x6f, 0x6c, 0x65, 0x20, 0x69, 0x6e, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x20, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x67, 0x69, 0x76, 0x65, 0x6e, 0x20, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x20, 0x64, 0x61, 0x74,
+	0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x3a, 0x01, 0x2a, 0x22, 0x2a, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x2d,
+	0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x12, 0xba, 0x02, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x57,
+	0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x68, 0x61, 0x72, 0x65, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x73, 0x12, 0x27, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x68, 0x61, 0x72, 0x65, 0x47,
+	0x72, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63,
+	0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x53, 0x68, 0x61, 0x72, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0xcd, 0x01, 0x92, 0x41, 0x97, 0x01, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x24, 0x4c, 0x69,
+	0x73, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x68, 0x61, 0x72, 0x65, 0x20, 0x67, 0x72, 0x61,
+	0x6e, 0x74, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x1a, 0x5d, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x20, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20,
+	0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x2c, 0x20, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64,
+	0x20, 0x61, 0x6e, 0x64, 0x20, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x20, 0x6f, 0x6e, 0x65,
+	0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65,
+	0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x65,
+	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x2d, 0x67, 0x72, 0x61,
+	0x6e, 0x74, 0x73, 0x12, 0x9a, 0x02, 0x0a, 0x19, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x57, 0x6f,
+	0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x68, 0x61, 0x72, 0x65, 0x47, 0x72, 0x61, 0x6e,
+	0x74, 0x12, 0x28, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x68, 0x61, 0x72, 0x65, 0x47,
+	0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x68,
+	0x6f, 0x72, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x53, 0x68, 0x61, 0x72, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0xaa, 0x01, 0x92, 0x41, 0x6b, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x14, 0x52, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x20, 0x61, 0x20, 0x73, 0x68, 0x61, 0x72, 0x65, 0x20, 0x67, 0x72, 0x61, 0x6e,
+	0x74, 0x1a, 0x41, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x20, 0x65, 0x6e, 0x64, 0x73, 0x20, 0x61, 0x20, 0x73, 0x68, 0x61, 0x72, 0x65, 0x20, 0x67, 0x72,
+	0x61, 0x6e, 0x74, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x74, 0x61, 0x6b, 0x65, 0x73, 0x20, 0x62, 0x61,
+	0x63, 0x6b, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x20, 0x69, 0x74, 0x20,
+	0x67, 0x61, 0x76, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x2a, 0x34, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x2d,
+	0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x7d,
+	0x12, 0xdf, 0x02, 0x0a, 0x0e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x12, 0x1d, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x22, 0x8d, 0x02, 0x92, 0x41, 0xde, 0x01, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x11, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x1a, 0xb6, 0x01, 0x54,
+	0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x20,
+	0x6f, 0x66, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x20, 0x61,
+	0x6e, 0x64, 0x20, 0x6f, 0x66, 0x20, 0x69, 0x74, 0x73, 0x20, 0x61, 0x70, 0x70, 0x20, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2c, 0x20, 0x66, 0x69, 0x72, 0x73, 0x74, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x74,
+	0x68, 0x65, 0x6e, 0x20, 0x65, 0x61, 0x63, 0x68, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e,
+	0x20, 0x53, 0x65, 0x6e, 0x64, 0x20, 0x27, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x3a, 0x20, 0x74,
+	0x65, 0x78, 0x74, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2d, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x27, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x20, 0x69, 0x74, 0x20,
+	0x61, 0x73, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2d, 0x73, 0x65, 0x6e, 0x74, 0x20, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x77, 0x61, 0x74, 0x63, 0x68,
+	0x30, 0x01, 0x12, 0x92, 0x03, 0x0a, 0x0e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x57, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1d, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x57, 0x6f,
+	0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x22, 0xc0, 0x02, 0x92, 0x41, 0x86, 0x02, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x24, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x20, 0x74, 0x68, 0x65, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68,
+	0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x1a, 0xcb, 0x01, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x20, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x77, 0x6f, 0x72, 0x6b,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68,
+	0x65, 0x69, 0x72, 0x20, 0x61, 0x70, 0x70, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x2c, 0x20, 0x66, 0x69, 0x72, 0x73, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x74, 0x68, 0x65, 0x6e, 0x20, 0x65, 0x61,
+	0x63, 0x68, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x20, 0x53, 0x65, 0x6e, 0x64, 0x20,
+	0x27, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x3a, 0x20, 0x74, 0x65, 0x78, 0x74, 0x2f, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x2d, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x27, 0x20, 0x74, 0x6f, 0x20, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x20, 0x69, 0x74, 0x20, 0x61, 0x73, 0x20, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2d, 0x73, 0x65, 0x6e, 0x74, 0x20, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x30, 0x12, 0x2e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74,
+	0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x2f, 0x7b,
+	0x69, 0x64, 0x7d, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x2f,
+	0x77, 0x61, 0x74, 0x63, 0x68, 0x30, 0x01, 0x12, 0xab, 0x02, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x57,
+	0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4c, 0x6f,
+	0x67, 0x73, 0x12, 0x25, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x57,
+	0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4c, 0x6f,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x68, 0x6f, 0x72,
+	0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xc4,
+	0x01, 0x92, 0x41, 0x96, 0x01, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x22, 0x47, 0x65, 0x74, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x6c, 0x6f, 0x67, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x1a, 0x5e, 0x54, 0x68, 0x69,
+	0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x20, 0x6c, 0x6f,
+	0x67, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x2c, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x73, 0x20, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74, 0x65, 0x64, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x24, 0x12, 0x22, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f,
+	0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
+	0x2f, 0x6c, 0x6f, 0x67, 0x73, 0x12, 0xb9, 0x02, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f,
+	0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x28, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xc9, 0x01, 0x92, 0x41, 0x99, 0x01, 0x0a, 0x10, 0x57, 0x6f,
+	0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x25,
+	0x4c, 0x69, 0x73, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x20,
+	0x6f, 0x66, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x20, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x1a, 0x5e, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x20, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x70, 0x6f, 0x64, 0x2c, 0x20,
+	0x77, 0x69, 0x74, 0x68, 0x20, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x20, 0x72, 0x65, 0x64,
+	0x61, 0x63, 0x74, 0x65, 0x64, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0xeb, 0x02, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x20, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73,
+	0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x68, 0x6f, 0x72,
+	0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x93, 0x02, 0x92, 0x41, 0xe4, 0x01,
+	0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x25, 0x47, 0x65, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x20, 0x75, 0x73, 0x61, 0x67, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20,
+	0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x1a, 0xa8, 0x01, 0x54, 0x68, 0x69, 0x73,
+	0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x61, 0x6e,
+	0x64, 0x20, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x20, 0x43, 0x50, 0x55, 0x20, 0x61, 0x6e, 0x64,
+	0x20, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x20, 0x75, 0x73, 0x61, 0x67, 0x65, 0x20, 0x6f, 0x66,
+	0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x20, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x6f, 0x66, 0x20, 0x69, 0x74, 0x73, 0x20, 0x61,
+	0x70, 0x70, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2c, 0x20, 0x61, 0x67,
+	0x61, 0x69, 0x6e, 0x73, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75,
+	0x6d, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x20, 0x64, 0x65,
+	0x63, 0x6c, 0x61, 0x72, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x74, 0x68, 0x65, 0x69, 0x72, 0x20,
+	0x61, 0x70, 0x70, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0xc8, 0x02, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x20, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73,
+	0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xf0, 0x01, 0x92, 0x41, 0xb6, 0x01, 0x0a, 0x10,
+	0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x38, 0x47, 0x65, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x20, 0x75, 0x73, 0x61, 0x67, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61,
+	0x20, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x1a, 0x68, 0x54, 0x68, 0x69, 0x73,
+	0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x43, 0x50,
+	0x55, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x20, 0x75, 0x73, 0x61,
+	0x67, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x74, 0x68, 0x65, 0x69, 0x72, 0x20, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x12, 0x2e, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x65, 0x73, 0x2f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0xd9, 0x02, 0x0a, 0x18, 0x4c,
+	0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x25, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f,
+	0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xec, 0x01, 0x92, 0x41, 0xb7, 0x01, 0x0a, 0x10,
+	0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x23, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x1a, 0x7e, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x77, 0x68, 0x6f,
+	0x20, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x61, 0x20,
+	0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x2c, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20,
+	0x77, 0x68, 0x69, 0x63, 0x68, 0x20, 0x49, 0x50, 0x2c, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x61,
+	0x6e, 0x64, 0x20, 0x68, 0x6f, 0x77, 0x20, 0x6d, 0x61, 0x6e, 0x79, 0x20, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x20, 0x77, 0x65, 0x72, 0x65, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72,
+	0x65, 0x64, 0x2c, 0x20, 0x6d, 0x6f, 0x73, 0x74, 0x20, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x20,
+	0x66, 0x69, 0x72, 0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x94, 0x03, 0x0a, 0x21, 0x4c, 0x69, 0x73, 0x74, 0x57,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63,
+	0x68, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x2e, 0x63,
+	0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x9e, 0x02, 0x92,
+	0x41, 0xde, 0x01, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x36, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x20, 0x6f,
+	0x66, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x1a, 0x91, 0x01,
+	0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x72, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x77, 0x68, 0x6f, 0x20, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x2c, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x77, 0x68, 0x69, 0x63,
+	0x68, 0x20, 0x49, 0x50, 0x2c, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x68,
+	0x6f, 0x77, 0x20, 0x6d, 0x61, 0x6e, 0x79, 0x20, 0x62, 0x79, 0x74, 0x65, 0x73, 0x20, 0x77, 0x65,
+	0x72, 0x65, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x2c, 0x20,
+	0x6d, 0x6f, 0x73, 0x74, 0x20, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x20, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x12, 0x34, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65,
+	0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x65,
+	0x73, 0x2f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xf8, 0x02,
+	0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27,
+	0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x87,
+	0x02, 0x92, 0x41, 0xce, 0x01, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2d, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x6f, 0x66,
+	0x20, 0x61, 0x20, 0x75, 0x73, 0x65, 0x72, 0x20, 0x74, 0x6f, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x1a, 0x8a, 0x01, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x6f,
+	0x66, 0x20, 0x61, 0x20, 0x75, 0x73, 0x65, 0x72, 0x20, 0x74, 0x6f, 0x20, 0x77, 0x6f, 0x72, 0x6b,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x2c, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x77, 0x68,
+	0x69, 0x63, 0x68, 0x20, 0x49, 0x50, 0x2c, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x61, 0x6e, 0x64,
+	0x20, 0x68, 0x6f, 0x77, 0x20, 0x6d, 0x61, 0x6e, 0x79, 0x20, 0x62, 0x79, 0x74, 0x65, 0x73, 0x20,
+	0x77, 0x65, 0x72, 0x65, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64,
+	0x2c, 0x20, 0x6d, 0x6f, 0x73, 0x74, 0x20, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x20, 0x66, 0x69,
+	0x72, 0x73, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x69,
+	0x64, 0x7d, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x2d, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0xb3, 0x01, 0x92, 0x41, 0xa5, 0x01, 0x12,
+	0x7c, 0x0a, 0x18, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0x5b, 0x0a, 0x18, 0x63,
+	0x68, 0x6f, 0x72, 0x75, 0x73, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x20,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2c, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f,
+	0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x43, 0x48, 0x4f, 0x52,
+	0x55, 0x53, 0x2d, 0x54, 0x52, 0x45, 0x2f, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2d, 0x62, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x1a, 0x11, 0x64, 0x65, 0x76, 0x40, 0x63, 0x68, 0x6f, 0x72, 0x75,
+	0x73, 0x2d, 0x74, 0x72, 0x65, 0x2e, 0x63, 0x68, 0x32, 0x03, 0x31, 0x2e, 0x30, 0x2a, 0x01, 0x01,
+	0x32, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
+	0x6a, 0x73, 0x6f, 0x6e, 0x5a, 0x08, 0x2e, 0x3b, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_workbench_service_proto_rawDescOnce sync.Once
+	file_workbench_service_proto_rawDescData = file_workbench_service_proto_rawDesc
+)
+
+func file_workbench_service_proto_rawDescGZIP() []byte {
+	file_workbench_service_proto_rawDescOnce.Do(func() {
+		file_workbench_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_workbench_service_proto_rawDescData)
+	})
+	return file_workbench_service_proto_rawDescData
+}
+
+var file_workbench_service_proto_msgTypes = make([]protoimpl.MessageInfo, 64)
+var file_workbench_service_proto_goTypes = []interface{}{
+	(*ListWorkbenchesRequest)(nil),           // 0: chorus.ListWorkbenchesRequest
+	(*ListWorkbenchesReply)(nil),             // 1: chorus.ListWorkbenchesReply
+	(*ListWorkbenchesResult)(nil),            // 2: chorus.ListWorkbenchesResult
+	(*WorkbenchFilter)(nil),                  // 3: chorus.WorkbenchFilter
+	(*GetWorkbenchRequest)(nil),              // 4: chorus.GetWorkbenchRequest
+	(*GetWorkbenchReply)(nil),                // 5: chorus.GetWorkbenchReply
+	(*GetWorkbenchResult)(nil),               // 6: chorus.GetWorkbenchResult
+	(*CreateWorkbenchReply)(nil),             // 7: chorus.CreateWorkbenchReply
+	(*CreateWorkbenchResult)(nil),            // 8: chorus.CreateWorkbenchResult
+	(*UpdateWorkbenchReply)(nil),             // 9: chorus.UpdateWorkbenchReply
+	(*UpdateWorkbenchResult)(nil),            // 10: chorus.UpdateWorkbenchResult
+	(*AddUserRoleInWorkbenchRequest)(nil),    // 11: chorus.AddUserRoleInWorkbenchRequest
+	(*AddUserRoleInWorkbenchReply)(nil),      // 12: chorus.AddUserRoleInWorkbenchReply
+	(*AddUserRoleInWorkbenchResult)(nil),     // 13: chorus.AddUserRoleInWorkbenchResult
+	(*RemoveUserFromWorkbenchRequest)(nil),   // 14: chorus.RemoveUserFromWorkbenchRequest
+	(*RemoveUserFromWorkbenchReply)(nil),     // 15: chorus.RemoveUserFromWorkbenchReply
+	(*RemoveUserFromWorkbenchResult)(nil),    // 16: chorus.RemoveUserFromWorkbenchResult
+	(*DeleteWorkbenchRequest)(nil),           // 17: chorus.DeleteWorkbenchRequest
+	(*DeleteWorkbenchReply)(nil),             // 18: chorus.DeleteWorkbenchReply
+	(*DeleteWorkbenchResult)(nil),            // 19: chorus.DeleteWorkbenchResult
+	(*GetWorkbenchScheduleRequest)(nil),      // 20: chorus.GetWorkbenchScheduleRequest
+	(*GetWorkbenchScheduleReply)(nil),        // 21: chorus.GetWorkbenchScheduleReply
+	(*GetWorkbenchScheduleResult)(nil),       // 22: chorus.GetWorkbenchScheduleResult
+	(*SetWorkbenchScheduleRequest)(nil),      // 23: chorus.SetWorkbenchScheduleRequest
+	(*SetWorkbenchScheduleReply)(nil),        // 24: chorus.SetWorkbenchScheduleReply
+	(*SetWorkbenchScheduleResult)(nil),       // 25: chorus.SetWorkbenchScheduleResult
+	(*DeleteWorkbenchScheduleRequest)(nil),   // 26: chorus.DeleteWorkbenchScheduleRequest
+	(*DeleteWorkbenchScheduleReply)(nil),     // 27: chorus.DeleteWorkbenchScheduleReply
+	(*DeleteWorkbenchScheduleResult)(nil),    // 28: chorus.DeleteWorkbenchScheduleResult
+	(*KeepWorkbenchAliveRequest)(nil),        // 29: chorus.KeepWorkbenchAliveRequest
+	(*KeepWorkbenchAliveReply)(nil),          // 30: chorus.KeepWorkbenchAliveReply
+	(*KeepWorkbenchAliveResult)(nil),         // 31: chorus.KeepWorkbenchAliveResult
+	(*HibernateWorkbenchRequest)(nil),        // 32: chorus.HibernateWorkbenchRequest
+	(*HibernateWorkbenchReply)(nil),          // 33: chorus.HibernateWorkbenchReply
+	(*HibernateWorkbenchResult)(nil),         // 34: chorus.HibernateWorkbenchResult
+	(*ResumeWorkbenchRequest)(nil),           // 35: chorus.ResumeWorkbenchRequest
+	(*ResumeWorkbenchReply)(nil),             // 36: chorus.ResumeWorkbenchReply
+	(*ResumeWorkbenchResult)(nil),            // 37: chorus.ResumeWorkbenchResult
+	(*CreateWorkbenchShareGrantRequest)(nil), // 38: chorus.CreateWorkbenchShareGrantRequest
+	(*CreateWorkbenchShareGrantReply)(nil),   // 39: chorus.CreateWorkbenchShareGrantReply
+	(*CreateWorkbenchShareGrantResult)(nil),  // 40: chorus.CreateWorkbenchShareGrantResult
+	(*ListWorkbenchShareGrantsRequest)(nil),  // 41: chorus.ListWorkbenchShareGrantsRequest
+	(*ListWorkbenchShareGrantsReply)(nil),    // 42: chorus.ListWorkbenchShareGrantsReply
+	(*ListWorkbenchShareGrantsResult)(nil),   // 43: chorus.ListWorkbenchShareGrantsResult
+	(*RevokeWorkbenchShareGrantRequest)(nil), // 44: chorus.RevokeWorkbenchShareGrantRequest
+	(*RevokeWorkbenchShareGrantReply)(nil),   // 45: chorus.RevokeWorkbenchShareGrantReply
+	(*RevokeWorkbenchShareGrantResult)(nil),  // 46: chorus.RevokeWorkbenchShareGrantResult
+	(*WatchWorkbenchRequest)(nil),            // 47: chorus.WatchWorkbenchRequest
+	(*WatchWorkspaceRequest)(nil),            // 48: chorus.WatchWorkspaceRequest
+	(*GetWorkbenchServerLogsRequest)(nil),    // 49: chorus.GetWorkbenchServerLogsRequest
+	(*GetWorkbenchServerLogsReply)(nil),      // 50: chorus.GetWorkbenchServerLogsReply
+	(*GetWorkbenchServerLogsResult)(nil),     // 51: chorus.GetWorkbenchServerLogsResult
+	(*ListWorkbenchServerEventsRequest)(nil), // 52: chorus.ListWorkbenchServerEventsRequest
+	(*ListWorkbenchServerEventsReply)(nil),   // 53: chorus.ListWorkbenchServerEventsReply
+	(*ListWorkbenchServerEventsResult)(nil),  // 54: chorus.ListWorkbenchServerEventsResult
+	(*GetWorkbenchUsageRequest)(nil),         // 55: chorus.GetWorkbenchUsageRequest
+	(*GetWorkbenchUsageReply)(nil),           // 56: chorus.GetWorkbenchUsageReply
+	(*GetWorkbenchUsageResult)(nil),          // 57: chorus.GetWorkbenchUsageResult
+	(*GetWorkspaceUsageRequest)(nil),         // 58: chorus.GetWorkspaceUsageRequest
+	(*GetWorkspaceUsageReply)(nil),           // 59: chorus.GetWorkspaceUsageReply
+	(*GetWorkspaceUsageResult)(nil),          // 60: chorus.GetWorkspaceUsageResult
+	(*ListWorkbenchConnectionsRequest)(nil),  // 61: chorus.ListWorkbenchConnectionsRequest
+	(*ListWorkbenchConnectionsReply)(nil),    // 62: chorus.ListWorkbenchConnectionsReply
+	(*ListWorkbenchConnectionsResult)(nil),   // 63: chorus.ListWorkbenchConnectionsResult
+	(*PaginationQuery)(nil),                  // 64: chorus.PaginationQuery
+	(*PaginationResult)(nil),                 // 65: chorus.PaginationResult
+	(*Workbench)(nil),                        // 66: chorus.Workbench
+	(*Role)(nil),                             // 67: chorus.Role
+	(*WorkbenchSchedule)(nil),                // 68: chorus.WorkbenchSchedule
+	(*timestamppb.Timestamp)(nil),            // 69: google.protobuf.Timestamp
+	(*WorkbenchShareGrant)(nil),              // 70: chorus.WorkbenchShareGrant
+	(*PodLogs)(nil),                          // 71: chorus.PodLogs
+	(*PodEvent)(nil),                         // 72: chorus.PodEvent
+	(*WorkbenchUsage)(nil),                   // 73: chorus.WorkbenchUsage
+	(*WorkspaceUsage)(nil),                   // 74: chorus.WorkspaceUsage
+	(*WorkbenchConnectionFilter)(nil),        // 75: chorus.WorkbenchConnectionFilter
+	(*WorkbenchConnection)(nil),              // 76: chorus.WorkbenchConnection
+	(*WorkbenchStatusEvent)(nil),             // 77: chorus.WorkbenchStatusEvent
+}
+var file_workbench_service_proto_depIdxs = []int32{
+	64, // 0: chorus.ListWorkbenchesRequest.pagination:type_name -> chorus.PaginationQuery
+	3,  // 1: chorus.ListWorkbenchesRequest.filter:type_name -> chorus.WorkbenchFilter
+	2,  // 2: chorus.ListWorkbenchesReply.result:type_name -> chorus.ListWorkbenchesResult
+	65, // 3: chorus.ListWorkbenchesReply.pagination:type_name -> chorus.PaginationResult
+	66, // 4: chorus.ListWorkbenchesResult.workbenches:type_name -> chorus.Workbench
+	6,  // 5: chorus.GetWorkbenchReply.result:type_name -> chorus.GetWorkbenchResult
+	66, // 6: chorus.GetWorkbenchResult.workbench:type_name -> chorus.Workbench
+	8,  // 7: chorus.CreateWorkbenchReply.result:type_name -> chorus.CreateWorkbenchResult
+	66, // 8: chorus.CreateWorkbenchResult.workbench:type_name -> chorus.Workbench
+	10, // 9: chorus.UpdateWorkbenchReply.result:type_name -> chorus.UpdateWorkbenchResult
+	66, // 10: chorus.UpdateWorkbenchResult.workbench:type_name -> chorus.Workbench
+	67, // 11: chorus.AddUserRoleInWorkbenchRequest.role:type_name -> chorus.Role
+	13, // 12: chorus.AddUserRoleInWorkbenchReply.result:type_name -> chorus.AddUserRoleInWorkbenchResult
+	66, // 13: chorus.AddUserRoleInWorkbenchResult.workbench:type_name -> chorus.Workbench
+	16, // 14: chorus.RemoveUserFromWorkbenchReply.result:type_name -> chorus.RemoveUserFromWorkbenchResult
+	66, // 15: chorus.RemoveUserFromWorkbenchResult.workbench:type_name -> chorus.Workbench
+	19, // 16: chorus.DeleteWorkbenchReply.result:type_name -> chorus.DeleteWorkbenchResult
+	66, // 17: chorus.DeleteWorkbenchResult.workbench:type_name -> chorus.Workbench
+	22, // 18: chorus.GetWorkbenchScheduleReply.result:type_name -> chorus.GetWorkbenchScheduleResult
+	68, // 19: chorus.GetWorkbenchScheduleResult.schedule:type_name -> chorus.WorkbenchSchedule
+	68, // 20: chorus.SetWorkbenchScheduleRequest.schedule:type_name -> chorus.WorkbenchSchedule
+	25, // 21: chorus.SetWorkbenchScheduleReply.result:type_name -> chorus.SetWorkbenchScheduleResult
+	68, // 22: chorus.SetWorkbenchScheduleResult.schedule:type_name -> chorus.WorkbenchSchedule
+	28, // 23: chorus.DeleteWorkbenchScheduleReply.result:type_name -> chorus.DeleteWorkbenchScheduleResult
+	31, // 24: chorus.KeepWorkbenchAliveReply.result:type_name -> chorus.KeepWorkbenchAliveResult
+	69, // 25: chorus.KeepWorkbenchAliveResult.idle_reclaim_at:type_name -> google.protobuf.Timestamp
+	34, // 26: chorus.HibernateWorkbenchReply.result:type_name -> chorus.HibernateWorkbenchResult
+	66, // 27: chorus.HibernateWorkbenchResult.workbench:type_name -> chorus.Workbench
+	37, // 28: chorus.ResumeWorkbenchReply.result:type_name -> chorus.ResumeWorkbenchResult
+	66, // 29: chorus.ResumeWorkbenchResult.workbench:type_name -> chorus.Workbench
+	69, // 30: chorus.CreateWorkbenchShareGrantRequest.expiresAt:type_name -> google.protobuf.Timestamp
+	40, // 31: chorus.CreateWorkbenchShareGrantReply.result:type_name -> chorus.CreateWorkbenchShareGrantResult
+	70, // 32: chorus.CreateWorkbenchShareGrantResult.grant:type_name -> chorus.WorkbenchShareGrant
+	43, // 33: chorus.ListWorkbenchShareGrantsReply.result:type_name -> chorus.ListWorkbenchShareGrantsResult
+	70, // 34: chorus.ListWorkbenchShareGrantsResult.grants:type_name -> chorus.WorkbenchShareGrant
+	46, // 35: chorus.RevokeWorkbenchShareGrantReply.result:type_name -> chorus.RevokeWorkbenchShareGrantResult
+	70, // 36: chorus.RevokeWorkbenchShareGrantResult.grant:type_name -> chorus.WorkbenchShareGrant
+	69, // 37: chorus.GetWorkbenchServerLogsRequest.sinceTime:type_name -> google.protobuf.Timestamp
+	51, // 38: chorus.GetWorkbenchServerLogsReply.result:type_name -> chorus.GetWorkbenchServerLogsResult
+	71, // 39: chorus.GetWorkbenchServerLogsResult.logs:type_name -> chorus.PodLogs
+	54, // 40: chorus.ListWorkbenchServerEventsReply.result:type_name -> chorus.ListWorkbenchServerEventsResult
+	72, // 41: chorus.ListWorkbenchServerEventsResult.events:type_name -> chorus.PodEvent
+	57, // 42: chorus.GetWorkbenchUsageReply.result:type_name -> chorus.GetWorkbenchUsageResult
+	73, // 43: chorus.GetWorkbenchUsageResult.usage:type_name -> chorus.WorkbenchUsage
+	60, // 44: chorus.GetWorkspaceUsageReply.result:type_name -> chorus.GetWorkspaceUsageResult
+	74, // 45: chorus.GetWorkspaceUsageResult.usage:type_name -> chorus.WorkspaceUsage
+	64, // 46: chorus.ListWorkbenchConnectionsRequest.pagination:type_name -> chorus.PaginationQuery
+	75, // 47: chorus.ListWorkbenchConnectionsRequest.filter:type_name -> chorus.WorkbenchConnectionFilter
+	63, // 48: chorus.ListWorkbenchConnectionsReply.result:type_name -> chorus.ListWorkbenchConnectionsResult
+	65, // 49: chorus.ListWorkbenchConnectionsReply.pagination:type_name -> chorus.PaginationResult
+	76, // 50: chorus.ListWorkbenchConnectionsResult.connections:type_name -> chorus.WorkbenchConnection
+	4,  // 51: chorus.WorkbenchService.GetWorkbench:input_type -> chorus.GetWorkbenchRequest
+	0,  // 52: chorus.WorkbenchService.ListWorkbenches:input_type -> chorus.ListWorkbenchesRequest
+	66, // 53: chorus.WorkbenchService.CreateWorkbench:input_type -> chorus.Workbench
+	66, // 54: chorus.WorkbenchService.UpdateWorkbench:input_type -> chorus.Workbench
+	11, // 55: chorus.WorkbenchService.AddUserRoleInWorkbench:input_type -> chorus.AddUserRoleInWorkbenchRequest
+	14, // 56: chorus.WorkbenchService.RemoveUserFromWorkbench:input_type -> chorus.RemoveUserFromWorkbenchRequest
+	17, // 57: chorus.WorkbenchService.DeleteWorkbench:input_type -> chorus.DeleteWorkbenchRequest
+	20, // 58: chorus.WorkbenchService.GetWorkbenchSchedule:input_type -> chorus.GetWorkbenchScheduleRequest
+	23, // 59: chorus.WorkbenchService.SetWorkbenchSchedule:input_type -> chorus.SetWorkbenchScheduleRequest
+	26, // 60: chorus.WorkbenchService.DeleteWorkbenchSchedule:input_type -> chorus.DeleteWorkbenchScheduleRequest
+	29, // 61: chorus.WorkbenchService.KeepWorkbenchAlive:input_type -> chorus.KeepWorkbenchAliveRequest
+	32, // 62: chorus.WorkbenchService.HibernateWorkbench:input_type -> chorus.HibernateWorkbenchRequest
+	35, // 63: chorus.WorkbenchService.ResumeWorkbench:input_type -> chorus.ResumeWorkbenchRequest
+	38, // 64: chorus.WorkbenchService.CreateWorkbenchShareGrant:input_type -> chorus.CreateWorkbenchShareGrantRequest
+	41, // 65: chorus.WorkbenchService.ListWorkbenchShareGrants:input_type -> chorus.ListWorkbenchShareGrantsRequest
+	44, // 66: chorus.WorkbenchService.RevokeWorkbenchShareGrant:input_type -> chorus.RevokeWorkbenchShareGrantRequest
+	47, // 67: chorus.WorkbenchService.WatchWorkbench:input_type -> chorus.WatchWorkbenchRequest
+	48, // 68: chorus.WorkbenchService.WatchWorkspace:input_type -> chorus.WatchWorkspaceRequest
+	49, // 69: chorus.WorkbenchService.GetWorkbenchServerLogs:input_type -> chorus.GetWorkbenchServerLogsRequest
+	52, // 70: chorus.WorkbenchService.ListWorkbenchServerEvents:input_type -> chorus.ListWorkbenchServerEventsRequest
+	55, // 71: chorus.WorkbenchService.GetWorkbenchUsage:input_type -> chorus.GetWorkbenchUsageRequest
+	58, // 72: chorus.WorkbenchService.GetWorkspaceUsage:input_type -> chorus.GetWorkspaceUsageRequest
+	61, // 73: chorus.WorkbenchService.ListWorkbenchConnections:input_type -> chorus.ListWorkbenchConnectionsRequest
+	61, // 74: chorus.WorkbenchService.ListWorkspaceWorkbenchConnections:input_type -> chorus.ListWorkbenchConnectionsRequest
+	61, // 75: chorus.WorkbenchService.ListUserWorkbenchConnections:input_type -> chorus.ListWorkbenchConnectionsRequest
+	5,  // 76: chorus.WorkbenchService.GetWorkbench:output_type -> chorus.GetWorkbenchReply
+	1,  // 77: chorus.WorkbenchService.ListWorkbenches:output_type -> chorus.ListWorkbenchesReply
+	7,  // 78: chorus.WorkbenchService.CreateWorkbench:output_type -> chorus.CreateWorkbenchReply
+	9,  // 79: chorus.WorkbenchService.UpdateWorkbench:output_type -> chorus.UpdateWorkbenchReply
+	12, // 80: chorus.WorkbenchService.AddUserRoleInWorkbench:output_type -> chorus.AddUserRoleInWorkbenchReply
+	15, // 81: chorus.WorkbenchService.RemoveUserFromWorkbench:output_type -> chorus.RemoveUserFromWorkbenchReply
+	18, // 82: chorus.WorkbenchService.DeleteWorkbench:output_type -> chorus.DeleteWorkbenchReply
+	21, // 83: chorus.WorkbenchService.GetWorkbenchSchedule:output_type -> chorus.GetWorkbenchScheduleReply
+	24, // 84: chorus.WorkbenchService.SetWorkbenchSchedule:output_type -> chorus.SetWorkbenchScheduleReply
+	27, // 85: chorus.WorkbenchService.DeleteWorkbenchSchedule:output_type -> chorus.DeleteWorkbenchScheduleReply
+	30, // 86: chorus.WorkbenchService.KeepWorkbenchAlive:output_type -> chorus.KeepWorkbenchAliveReply
+	33, // 87: chorus.WorkbenchService.HibernateWorkbench:output_type -> chorus.HibernateWorkbenchReply
+	36, // 88: chorus.WorkbenchService.ResumeWorkbench:output_type -> chorus.ResumeWorkbenchReply
+	39, // 89: chorus.WorkbenchService.CreateWorkbenchShareGrant:output_type -> chorus.CreateWorkbenchShareGrantReply
+	42, // 90: chorus.WorkbenchService.ListWorkbenchShareGrants:output_type -> chorus.ListWorkbenchShareGrantsReply
+	45, // 91: chorus.WorkbenchService.RevokeWorkbenchShareGrant:output_type -> chorus.RevokeWorkbenchShareGrantReply
+	77, // 92: chorus.WorkbenchService.WatchWorkbench:output_type -> chorus.WorkbenchStatusEvent
+	77, // 93: chorus.WorkbenchService.WatchWorkspace:output_type -> chorus.WorkbenchStatusEvent
+	50, // 94: chorus.WorkbenchService.GetWorkbenchServerLogs:output_type -> chorus.GetWorkbenchServerLogsReply
+	53, // 95: chorus.WorkbenchService.ListWorkbenchServerEvents:output_type -> chorus.ListWorkbenchServerEventsReply
+	56, // 96: chorus.WorkbenchService.GetWorkbenchUsage:output_type -> chorus.GetWorkbenchUsageReply
+	59, // 97: chorus.WorkbenchService.GetWorkspaceUsage:output_type -> chorus.GetWorkspaceUsageReply
+	62, // 98: chorus.WorkbenchService.ListWorkbenchConnections:output_type -> chorus.ListWorkbenchConnectionsReply
+	62, // 99: chorus.WorkbenchService.ListWorkspaceWorkbenchConnections:output_type -> chorus.ListWorkbenchConnectionsReply
+	62, // 100: chorus.WorkbenchService.ListUserWorkbenchConnections:output_type -> chorus.ListWorkbenchConnectionsReply
+	76, // [76:101] is the sub-list for method output_type
+	51, // [51:76] is the sub-list for method input_type
+	51, // [51:51] is the sub-list for extension type_name
+	51, // [51:51] is the sub-list for extension extendee
+	0,  // [0:51] is the sub-list for field type_name
+}
+
+func init() { file_workbench_service_proto_init() }
+func file_workbench_service_proto_init() {
+	if File_workbench_service_proto != nil {
+		return
+	}
+	file_common_proto_init()
+	file_workbench_proto_init()
+	file_user_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_workbench_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWorkbenchesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWorkbenchesReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWorkbenchesResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkbenchFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWorkbenchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWorkbenchReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWorkbenchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWorkbenchReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWorkbenchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateWorkbenchReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateWorkbenchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddUserRoleInWorkbenchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddUserRoleInWorkbenchReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddUserRoleInWorkbenchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveUserFromWorkbenchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveUserFromWorkbenchReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveUserFromWorkbenchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWorkbenchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWorkbenchReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWorkbenchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWorkbenchScheduleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWorkbenchScheduleReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWorkbenchScheduleResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetWorkbenchScheduleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetWorkbenchScheduleReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetWorkbenchScheduleResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWorkbenchScheduleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWorkbenchScheduleReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWorkbenchScheduleResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeepWorkbenchAliveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeepWorkbenchAliveReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeepWorkbenchAliveResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HibernateWorkbenchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HibernateWorkbenchReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HibernateWorkbenchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeWorkbenchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeWorkbenchReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeWorkbenchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWorkbenchShareGrantRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWorkbenchShareGrantReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWorkbenchShareGrantResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWorkbenchShareGrantsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWorkbenchShareGrantsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWorkbenchShareGrantsResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeWorkbenchShareGrantRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeWorkbenchShareGrantReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeWorkbenchShareGrantResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchWorkbenchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchWorkspaceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWorkbenchServerLogsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWorkbenchServerLogsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWorkbenchServerLogsResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWorkbenchServerEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWorkbenchServerEventsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWorkbenchServerEventsResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWorkbenchUsageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWorkbenchUsageReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWorkbenchUsageResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWorkspaceUsageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWorkspaceUsageReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWorkspaceUsageResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWorkbenchConnectionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWorkbenchConnectionsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workbench_service_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWorkbenchConnectionsResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_workbench_service_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_workbench_service_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	file_workbench_service_proto_msgTypes[61].OneofWrappers = []interface{}{}
+	file_workbench_service_proto_msgTypes[62].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_workbench_service_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   64,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_workbench_service_proto_goTypes,
+		DependencyIndexes: file_workbench_service_proto_depIdxs,
+		MessageInfos:      file_workbench_service_proto_msgTypes,
+	}.Build()
+	File_workbench_service_proto = out.File
+	file_workbench_service_proto_rawDesc = nil
+	file_workbench_service_proto_goTypes = nil
+	file_workbench_service_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// WorkbenchServiceClient is the client API for WorkbenchService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type WorkbenchServiceClient interface {
 	GetWorkbench(ctx context.Context, in *GetWorkbenchRequest, opts ...grpc.CallOption) (*GetWorkbenchReply, error)
 	ListWorkbenches(ctx context.Context, in *ListWorkbenchesRequest, opts ...grpc.CallOption) (*ListWorkbenchesReply, error)
@@ -1619,242 +5045,870 @@ type WorkbenchServiceClient interface {
 	AddUserRoleInWorkbench(ctx context.Context, in *AddUserRoleInWorkbenchRequest, opts ...grpc.CallOption) (*AddUserRoleInWorkbenchReply, error)
 	RemoveUserFromWorkbench(ctx context.Context, in *RemoveUserFromWorkbenchRequest, opts ...grpc.CallOption) (*RemoveUserFromWorkbenchReply, error)
 	DeleteWorkbench(ctx context.Context, in *DeleteWorkbenchRequest, opts ...grpc.CallOption) (*DeleteWorkbenchReply, error)
+	GetWorkbenchSchedule(ctx context.Context, in *GetWorkbenchScheduleRequest, opts ...grpc.CallOption) (*GetWorkbenchScheduleReply, error)
+	SetWorkbenchSchedule(ctx context.Context, in *SetWorkbenchScheduleRequest, opts ...grpc.CallOption) (*SetWorkbenchScheduleReply, error)
+	DeleteWorkbenchSchedule(ctx context.Context, in *DeleteWorkbenchScheduleRequest, opts ...grpc.CallOption) (*DeleteWorkbenchScheduleReply, error)
+	KeepWorkbenchAlive(ctx context.Context, in *KeepWorkbenchAliveRequest, opts ...grpc.CallOption) (*KeepWorkbenchAliveReply, error)
+	HibernateWorkbench(ctx context.Context, in *HibernateWorkbenchRequest, opts ...grpc.CallOption) (*HibernateWorkbenchReply, error)
+	ResumeWorkbench(ctx context.Context, in *ResumeWorkbenchRequest, opts ...grpc.CallOption) (*ResumeWorkbenchReply, error)
+	CreateWorkbenchShareGrant(ctx context.Context, in *CreateWorkbenchShareGrantRequest, opts ...grpc.CallOption) (*CreateWorkbenchShareGrantReply, error)
+	ListWorkbenchShareGrants(ctx context.Context, in *ListWorkbenchShareGrantsRequest, opts ...grpc.CallOption) (*ListWorkbenchShareGrantsReply, error)
+	RevokeWorkbenchShareGrant(ctx context.Context, in *RevokeWorkbenchShareGrantRequest, opts ...grpc.CallOption) (*RevokeWorkbenchShareGrantReply, error)
+	WatchWorkbench(ctx context.Context, in *WatchWorkbenchRequest, opts ...grpc.CallOption) (WorkbenchService_WatchWorkbenchClient, error)
+	WatchWorkspace(ctx context.Context, in *WatchWorkspaceRequest, opts ...grpc.CallOption) (WorkbenchService_WatchWorkspaceClient, error)
+	GetWorkbenchServerLogs(ctx context.Context, in *GetWorkbenchServerLogsRequest, opts ...grpc.CallOption) (*GetWorkbenchServerLogsReply, error)
+	ListWorkbenchServerEvents(ctx context.Context, in *ListWorkbenchServerEventsRequest, opts ...grpc.CallOption) (*ListWorkbenchServerEventsReply, error)
+	GetWorkbenchUsage(ctx context.Context, in *GetWorkbenchUsageRequest, opts ...grpc.CallOption) (*GetWorkbenchUsageReply, error)
+	GetWorkspaceUsage(ctx context.Context, in *GetWorkspaceUsageRequest, opts ...grpc.CallOption) (*GetWorkspaceUsageReply, error)
+	ListWorkbenchConnections(ctx context.Context, in *ListWorkbenchConnectionsRequest, opts ...grpc.CallOption) (*ListWorkbenchConnectionsReply, error)
+	ListWorkspaceWorkbenchConnections(ctx context.Context, in *ListWorkbenchConnectionsRequest, opts ...grpc.CallOption) (*ListWorkbenchConnectionsReply, error)
+	ListUserWorkbenchConnections(ctx context.Context, in *ListWorkbenchConnectionsRequest, opts ...grpc.CallOption) (*ListWorkbenchConnectionsReply, error)
+}
+
+type workbenchServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWorkbenchServiceClient(cc grpc.ClientConnInterface) WorkbenchServiceClient {
+	return &workbenchServiceClient{cc}
+}
+
+func (c *workbenchServiceClient) GetWorkbench(ctx context.Context, in *GetWorkbenchRequest, opts ...grpc.CallOption) (*GetWorkbenchReply, error) {
+	out := new(GetWorkbenchReply)
+	err := c.cc.Invoke(ctx, "/chorus.WorkbenchService/GetWorkbench", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workbenchServiceClient) ListWorkbenches(ctx context.Context, in *ListWorkbenchesRequest, opts ...grpc.CallOption) (*ListWorkbenchesReply, error) {
+	out := new(ListWorkbenchesReply)
+	err := c.cc.Invoke(ctx, "/chorus.WorkbenchService/ListWorkbenches", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workbenchServiceClient) CreateWorkbench(ctx context.Context, in *Workbench, opts ...grpc.CallOption) (*CreateWorkbenchReply, error) {
+	out := new(CreateWorkbenchReply)
+	err := c.cc.Invoke(ctx, "/chorus.WorkbenchService/CreateWorkbench", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workbenchServiceClient) UpdateWorkbench(ctx context.Context, in *Workbench, opts ...grpc.CallOption) (*UpdateWorkbenchReply, error) {
+	out := new(UpdateWorkbenchReply)
+	err := c.cc.Invoke(ctx, "/chorus.WorkbenchService/UpdateWorkbench", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workbenchServiceClient) AddUserRoleInWorkbench(ctx context.Context, in *AddUserRoleInWorkbenchRequest, opts ...grpc.CallOption) (*AddUserRoleInWorkbenchReply, error) {
+	out := new(AddUserRoleInWorkbenchReply)
+	err := c.cc.Invoke(ctx, "/chorus.WorkbenchService/AddUserRoleInWorkbench", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workbenchServiceClient) RemoveUserFromWorkbench(ctx context.Context, in *RemoveUserFromWorkbenchRequest, opts ...grpc.CallOption) (*RemoveUserFromWorkbenchReply, error) {
+	out := new(RemoveUserFromWorkbenchReply)
+	err := c.cc.Invoke(ctx, "/chorus.WorkbenchService/RemoveUserFromWorkbench", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workbenchServiceClient) DeleteWorkbench(ctx context.Context, in *DeleteWorkbenchRequest, opts ...grpc.CallOption) (*DeleteWorkbenchReply, error) {
+	out := new(DeleteWorkbenchReply)
+	err := c.cc.Invoke(ctx, "/chorus.WorkbenchService/DeleteWorkbench", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workbenchServiceClient) GetWorkbenchSchedule(ctx context.Context, in *GetWorkbenchScheduleRequest, opts ...grpc.CallOption) (*GetWorkbenchScheduleReply, error) {
+	out := new(GetWorkbenchScheduleReply)
+	err := c.cc.Invoke(ctx, "/chorus.WorkbenchService/GetWorkbenchSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workbenchServiceClient) SetWorkbenchSchedule(ctx context.Context, in *SetWorkbenchScheduleRequest, opts ...grpc.CallOption) (*SetWorkbenchScheduleReply, error) {
+	out := new(SetWorkbenchScheduleReply)
+	err := c.cc.Invoke(ctx, "/chorus.WorkbenchService/SetWorkbenchSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workbenchServiceClient) DeleteWorkbenchSchedule(ctx context.Context, in *DeleteWorkbenchScheduleRequest, opts ...grpc.CallOption) (*DeleteWorkbenchScheduleReply, error) {
+	out := new(DeleteWorkbenchScheduleReply)
+	err := c.cc.Invoke(ctx, "/chorus.WorkbenchService/DeleteWorkbenchSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workbenchServiceClient) KeepWorkbenchAlive(ctx context.Context, in *KeepWorkbenchAliveRequest, opts ...grpc.CallOption) (*KeepWorkbenchAliveReply, error) {
+	out := new(KeepWorkbenchAliveReply)
+	err := c.cc.Invoke(ctx, "/chorus.WorkbenchService/KeepWorkbenchAlive", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workbenchServiceClient) HibernateWorkbench(ctx context.Context, in *HibernateWorkbenchRequest, opts ...grpc.CallOption) (*HibernateWorkbenchReply, error) {
+	out := new(HibernateWorkbenchReply)
+	err := c.cc.Invoke(ctx, "/chorus.WorkbenchService/HibernateWorkbench", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workbenchServiceClient) ResumeWorkbench(ctx context.Context, in *ResumeWorkbenchRequest, opts ...grpc.CallOption) (*ResumeWorkbenchReply, error) {
+	out := new(ResumeWorkbenchReply)
+	err := c.cc.Invoke(ctx, "/chorus.WorkbenchService/ResumeWorkbench", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workbenchServiceClient) CreateWorkbenchShareGrant(ctx context.Context, in *CreateWorkbenchShareGrantRequest, opts ...grpc.CallOption) (*CreateWorkbenchShareGrantReply, error) {
+	out := new(CreateWorkbenchShareGrantReply)
+	err := c.cc.Invoke(ctx, "/chorus.WorkbenchService/CreateWorkbenchShareGrant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workbenchServiceClient) ListWorkbenchShareGrants(ctx context.Context, in *ListWorkbenchShareGrantsRequest, opts ...grpc.CallOption) (*ListWorkbenchShareGrantsReply, error) {
+	out := new(ListWorkbenchShareGrantsReply)
+	err := c.cc.Invoke(ctx, "/chorus.WorkbenchService/ListWorkbenchShareGrants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workbenchServiceClient) RevokeWorkbenchShareGrant(ctx context.Context, in *RevokeWorkbenchShareGrantRequest, opts ...grpc.CallOption) (*RevokeWorkbenchShareGrantReply, error) {
+	out := new(RevokeWorkbenchShareGrantReply)
+	err := c.cc.Invoke(ctx, "/chorus.WorkbenchService/RevokeWorkbenchShareGrant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workbenchServiceClient) WatchWorkbench(ctx context.Context, in *WatchWorkbenchRequest, opts ...grpc.CallOption) (WorkbenchService_WatchWorkbenchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WorkbenchService_serviceDesc.Streams[0], "/chorus.WorkbenchService/WatchWorkbench", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &workbenchServiceWatchWorkbenchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WorkbenchService_WatchWorkbenchClient interface {
+	Recv() (*WorkbenchStatusEvent, error)
+	grpc.ClientStream
+}
+
+type workbenchServiceWatchWorkbenchClient struct {
+	grpc.ClientStream
+}
+
+func (x *workbenchServiceWatchWorkbenchClient) Recv() (*WorkbenchStatusEvent, error) {
+	m := new(WorkbenchStatusEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *workbenchServiceClient) WatchWorkspace(ctx context.Context, in *WatchWorkspaceRequest, opts ...grpc.CallOption) (WorkbenchService_WatchWorkspaceClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WorkbenchService_serviceDesc.Streams[1], "/chorus.WorkbenchService/WatchWorkspace", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &workbenchServiceWatchWorkspaceClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WorkbenchService_WatchWorkspaceClient interface {
+	Recv() (*WorkbenchStatusEvent, error)
+	grpc.ClientStream
+}
+
+type workbenchServiceWatchWorkspaceClient struct {
+	grpc.ClientStream
+}
+
+func (x *workbenchServiceWatchWorkspaceClient) Recv() (*WorkbenchStatusEvent, error) {
+	m := new(WorkbenchStatusEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *workbenchServiceClient) GetWorkbenchServerLogs(ctx context.Context, in *GetWorkbenchServerLogsRequest, opts ...grpc.CallOption) (*GetWorkbenchServerLogsReply, error) {
+	out := new(GetWorkbenchServerLogsReply)
+	err := c.cc.Invoke(ctx, "/chorus.WorkbenchService/GetWorkbenchServerLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workbenchServiceClient) ListWorkbenchServerEvents(ctx context.Context, in *ListWorkbenchServerEventsRequest, opts ...grpc.CallOption) (*ListWorkbenchServerEventsReply, error) {
+	out := new(ListWorkbenchServerEventsReply)
+	err := c.cc.Invoke(ctx, "/chorus.WorkbenchService/ListWorkbenchServerEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workbenchServiceClient) GetWorkbenchUsage(ctx context.Context, in *GetWorkbenchUsageRequest, opts ...grpc.CallOption) (*GetWorkbenchUsageReply, error) {
+	out := new(GetWorkbenchUsageReply)
+	err := c.cc.Invoke(ctx, "/chorus.WorkbenchService/GetWorkbenchUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workbenchServiceClient) GetWorkspaceUsage(ctx context.Context, in *GetWorkspaceUsageRequest, opts ...grpc.CallOption) (*GetWorkspaceUsageReply, error) {
+	out := new(GetWorkspaceUsageReply)
+	err := c.cc.Invoke(ctx, "/chorus.WorkbenchService/GetWorkspaceUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workbenchServiceClient) ListWorkbenchConnections(ctx context.Context, in *ListWorkbenchConnectionsRequest, opts ...grpc.CallOption) (*ListWorkbenchConnectionsReply, error) {
+	out := new(ListWorkbenchConnectionsReply)
+	err := c.cc.Invoke(ctx, "/chorus.WorkbenchService/ListWorkbenchConnections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workbenchServiceClient) ListWorkspaceWorkbenchConnections(ctx context.Context, in *ListWorkbenchConnectionsRequest, opts ...grpc.CallOption) (*ListWorkbenchConnectionsReply, error) {
+	out := new(ListWorkbenchConnectionsReply)
+	err := c.cc.Invoke(ctx, "/chorus.WorkbenchService/ListWorkspaceWorkbenchConnections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workbenchServiceClient) ListUserWorkbenchConnections(ctx context.Context, in *ListWorkbenchConnectionsRequest, opts ...grpc.CallOption) (*ListWorkbenchConnectionsReply, error) {
+	out := new(ListWorkbenchConnectionsReply)
+	err := c.cc.Invoke(ctx, "/chorus.WorkbenchService/ListUserWorkbenchConnections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WorkbenchServiceServer is the server API for WorkbenchService service.
+type WorkbenchServiceServer interface {
+	GetWorkbench(context.Context, *GetWorkbenchRequest) (*GetWorkbenchReply, error)
+	ListWorkbenches(context.Context, *ListWorkbenchesRequest) (*ListWorkbenchesReply, error)
+	CreateWorkbench(context.Context, *Workbench) (*CreateWorkbenchReply, error)
+	UpdateWorkbench(context.Context, *Workbench) (*UpdateWorkbenchReply, error)
+	AddUserRoleInWorkbench(context.Context, *AddUserRoleInWorkbenchRequest) (*AddUserRoleInWorkbenchReply, error)
+	RemoveUserFromWorkbench(context.Context, *RemoveUserFromWorkbenchRequest) (*RemoveUserFromWorkbenchReply, error)
+	DeleteWorkbench(context.Context, *DeleteWorkbenchRequest) (*DeleteWorkbenchReply, error)
+	GetWorkbenchSchedule(context.Context, *GetWorkbenchScheduleRequest) (*GetWorkbenchScheduleReply, error)
+	SetWorkbenchSchedule(context.Context, *SetWorkbenchScheduleRequest) (*SetWorkbenchScheduleReply, error)
+	DeleteWorkbenchSchedule(context.Context, *DeleteWorkbenchScheduleRequest) (*DeleteWorkbenchScheduleReply, error)
+	KeepWorkbenchAlive(context.Context, *KeepWorkbenchAliveRequest) (*KeepWorkbenchAliveReply, error)
+	HibernateWorkbench(context.Context, *HibernateWorkbenchRequest) (*HibernateWorkbenchReply, error)
+	ResumeWorkbench(context.Context, *ResumeWorkbenchRequest) (*ResumeWorkbenchReply, error)
+	CreateWorkbenchShareGrant(context.Context, *CreateWorkbenchShareGrantRequest) (*CreateWorkbenchShareGrantReply, error)
+	ListWorkbenchShareGrants(context.Context, *ListWorkbenchShareGrantsRequest) (*ListWorkbenchShareGrantsReply, error)
+	RevokeWorkbenchShareGrant(context.Context, *RevokeWorkbenchShareGrantRequest) (*RevokeWorkbenchShareGrantReply, error)
+	WatchWorkbench(*WatchWorkbenchRequest, WorkbenchService_WatchWorkbenchServer) error
+	WatchWorkspace(*WatchWorkspaceRequest, WorkbenchService_WatchWorkspaceServer) error
+	GetWorkbenchServerLogs(context.Context, *GetWorkbenchServerLogsRequest) (*GetWorkbenchServerLogsReply, error)
+	ListWorkbenchServerEvents(context.Context, *ListWorkbenchServerEventsRequest) (*ListWorkbenchServerEventsReply, error)
+	GetWorkbenchUsage(context.Context, *GetWorkbenchUsageRequest) (*GetWorkbenchUsageReply, error)
+	GetWorkspaceUsage(context.Context, *GetWorkspaceUsageRequest) (*GetWorkspaceUsageReply, error)
+	ListWorkbenchConnections(context.Context, *ListWorkbenchConnectionsRequest) (*ListWorkbenchConnectionsReply, error)
+	ListWorkspaceWorkbenchConnections(context.Context, *ListWorkbenchConnectionsRequest) (*ListWorkbenchConnectionsReply, error)
+	ListUserWorkbenchConnections(context.Context, *ListWorkbenchConnectionsRequest) (*ListWorkbenchConnectionsReply, error)
+}
+
+// UnimplementedWorkbenchServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedWorkbenchServiceServer struct {
+}
+
+func (*UnimplementedWorkbenchServiceServer) GetWorkbench(context.Context, *GetWorkbenchRequest) (*GetWorkbenchReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkbench not implemented")
+}
+func (*UnimplementedWorkbenchServiceServer) ListWorkbenches(context.Context, *ListWorkbenchesRequest) (*ListWorkbenchesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkbenches not implemented")
+}
+func (*UnimplementedWorkbenchServiceServer) CreateWorkbench(context.Context, *Workbench) (*CreateWorkbenchReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWorkbench not implemented")
+}
+func (*UnimplementedWorkbenchServiceServer) UpdateWorkbench(context.Context, *Workbench) (*UpdateWorkbenchReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkbench not implemented")
+}
+func (*UnimplementedWorkbenchServiceServer) AddUserRoleInWorkbench(context.Context, *AddUserRoleInWorkbenchRequest) (*AddUserRoleInWorkbenchReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddUserRoleInWorkbench not implemented")
+}
+func (*UnimplementedWorkbenchServiceServer) RemoveUserFromWorkbench(context.Context, *RemoveUserFromWorkbenchRequest) (*RemoveUserFromWorkbenchReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveUserFromWorkbench not implemented")
+}
+func (*UnimplementedWorkbenchServiceServer) DeleteWorkbench(context.Context, *DeleteWorkbenchRequest) (*DeleteWorkbenchReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkbench not implemented")
+}
+func (*UnimplementedWorkbenchServiceServer) GetWorkbenchSchedule(context.Context, *GetWorkbenchScheduleRequest) (*GetWorkbenchScheduleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkbenchSchedule not implemented")
+}
+func (*UnimplementedWorkbenchServiceServer) SetWorkbenchSchedule(context.Context, *SetWorkbenchScheduleRequest) (*SetWorkbenchScheduleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWorkbenchSchedule not implemented")
+}
+func (*UnimplementedWorkbenchServiceServer) DeleteWorkbenchSchedule(context.Context, *DeleteWorkbenchScheduleRequest) (*DeleteWorkbenchScheduleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkbenchSchedule not implemented")
+}
+func (*UnimplementedWorkbenchServiceServer) KeepWorkbenchAlive(context.Context, *KeepWorkbenchAliveRequest) (*KeepWorkbenchAliveReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeepWorkbenchAlive not implemented")
+}
+func (*UnimplementedWorkbenchServiceServer) HibernateWorkbench(context.Context, *HibernateWorkbenchRequest) (*HibernateWorkbenchReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HibernateWorkbench not implemented")
+}
+func (*UnimplementedWorkbenchServiceServer) ResumeWorkbench(context.Context, *ResumeWorkbenchRequest) (*ResumeWorkbenchReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeWorkbench not implemented")
+}
+func (*UnimplementedWorkbenchServiceServer) CreateWorkbenchShareGrant(context.Context, *CreateWorkbenchShareGrantRequest) (*CreateWorkbenchShareGrantReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWorkbenchShareGrant not implemented")
+}
+func (*UnimplementedWorkbenchServiceServer) ListWorkbenchShareGrants(context.Context, *ListWorkbenchShareGrantsRequest) (*ListWorkbenchShareGrantsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkbenchShareGrants not implemented")
+}
+func (*UnimplementedWorkbenchServiceServer) RevokeWorkbenchShareGrant(context.Context, *RevokeWorkbenchShareGrantRequest) (*RevokeWorkbenchShareGrantReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeWorkbenchShareGrant not implemented")
+}
+func (*UnimplementedWorkbenchServiceServer) WatchWorkbench(*WatchWorkbenchRequest, WorkbenchService_WatchWorkbenchServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchWorkbench not implemented")
+}
+func (*UnimplementedWorkbenchServiceServer) WatchWorkspace(*WatchWorkspaceRequest, WorkbenchService_WatchWorkspaceServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchWorkspace not implemented")
+}
+func (*UnimplementedWorkbenchServiceServer) GetWorkbenchServerLogs(context.Context, *GetWorkbenchServerLogsRequest) (*GetWorkbenchServerLogsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkbenchServerLogs not implemented")
+}
+func (*UnimplementedWorkbenchServiceServer) ListWorkbenchServerEvents(context.Context, *ListWorkbenchServerEventsRequest) (*ListWorkbenchServerEventsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkbenchServerEvents not implemented")
+}
+func (*UnimplementedWorkbenchServiceServer) GetWorkbenchUsage(context.Context, *GetWorkbenchUsageRequest) (*GetWorkbenchUsageReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkbenchUsage not implemented")
+}
+func (*UnimplementedWorkbenchServiceServer) GetWorkspaceUsage(context.Context, *GetWorkspaceUsageRequest) (*GetWorkspaceUsageReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkspaceUsage not implemented")
+}
+func (*UnimplementedWorkbenchServiceServer) ListWorkbenchConnections(context.Context, *ListWorkbenchConnectionsRequest) (*ListWorkbenchConnectionsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkbenchConnections not implemented")
+}
+func (*UnimplementedWorkbenchServiceServer) ListWorkspaceWorkbenchConnections(context.Context, *ListWorkbenchConnectionsRequest) (*ListWorkbenchConnectionsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkspaceWorkbenchConnections not implemented")
+}
+func (*UnimplementedWorkbenchServiceServer) ListUserWorkbenchConnections(context.Context, *ListWorkbenchConnectionsRequest) (*ListWorkbenchConnectionsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserWorkbenchConnections not implemented")
+}
+
+func RegisterWorkbenchServiceServer(s *grpc.Server, srv WorkbenchServiceServer) {
+	s.RegisterService(&_WorkbenchService_serviceDesc, srv)
+}
+
+func _WorkbenchService_GetWorkbench_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkbenchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkbenchServiceServer).GetWorkbench(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.WorkbenchService/GetWorkbench",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkbenchServiceServer).GetWorkbench(ctx, req.(*GetWorkbenchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkbenchService_ListWorkbenches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkbenchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkbenchServiceServer).ListWorkbenches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.WorkbenchService/ListWorkbenches",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkbenchServiceServer).ListWorkbenches(ctx, req.(*ListWorkbenchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkbenchService_CreateWorkbench_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Workbench)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkbenchServiceServer).CreateWorkbench(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.WorkbenchService/CreateWorkbench",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkbenchServiceServer).CreateWorkbench(ctx, req.(*Workbench))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkbenchService_UpdateWorkbench_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Workbench)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkbenchServiceServer).UpdateWorkbench(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.WorkbenchService/UpdateWorkbench",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkbenchServiceServer).UpdateWorkbench(ctx, req.(*Workbench))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkbenchService_AddUserRoleInWorkbench_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddUserRoleInWorkbenchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkbenchServiceServer).AddUserRoleInWorkbench(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.WorkbenchService/AddUserRoleInWorkbench",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkbenchServiceServer).AddUserRoleInWorkbench(ctx, req.(*AddUserRoleInWorkbenchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkbenchService_RemoveUserFromWorkbench_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveUserFromWorkbenchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkbenchServiceServer).RemoveUserFromWorkbench(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.WorkbenchService/RemoveUserFromWorkbench",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkbenchServiceServer).RemoveUserFromWorkbench(ctx, req.(*RemoveUserFromWorkbenchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type workbenchServiceClient struct {
-	cc grpc.ClientConnInterface
+func _WorkbenchService_DeleteWorkbench_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWorkbenchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkbenchServiceServer).DeleteWorkbench(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.WorkbenchService/DeleteWorkbench",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkbenchServiceServer).DeleteWorkbench(ctx, req.(*DeleteWorkbenchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func NewWorkbenchServiceClient(cc grpc.ClientConnInterface) WorkbenchServiceClient {
-	return &workbenchServiceClient{cc}
+func _WorkbenchService_GetWorkbenchSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkbenchScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkbenchServiceServer).GetWorkbenchSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.WorkbenchService/GetWorkbenchSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkbenchServiceServer).GetWorkbenchSchedule(ctx, req.(*GetWorkbenchScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *workbenchServiceClient) GetWorkbench(ctx context.Context, in *GetWorkbenchRequest, opts ...grpc.CallOption) (*GetWorkbenchReply, error) {
-	out := new(GetWorkbenchReply)
-	err := c.cc.Invoke(ctx, "/chorus.WorkbenchService/GetWorkbench", in, out, opts...)
-	if err != nil {
+func _WorkbenchService_SetWorkbenchSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetWorkbenchScheduleRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(WorkbenchServiceServer).SetWorkbenchSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.WorkbenchService/SetWorkbenchSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkbenchServiceServer).SetWorkbenchSchedule(ctx, req.(*SetWorkbenchScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *workbenchServiceClient) ListWorkbenches(ctx context.Context, in *ListWorkbenchesRequest, opts ...grpc.CallOption) (*ListWorkbenchesReply, error) {
-	out := new(ListWorkbenchesReply)
-	err := c.cc.Invoke(ctx, "/chorus.WorkbenchService/ListWorkbenches", in, out, opts...)
-	if err != nil {
+func _WorkbenchService_DeleteWorkbenchSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWorkbenchScheduleRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(WorkbenchServiceServer).DeleteWorkbenchSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.WorkbenchService/DeleteWorkbenchSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkbenchServiceServer).DeleteWorkbenchSchedule(ctx, req.(*DeleteWorkbenchScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *workbenchServiceClient) CreateWorkbench(ctx context.Context, in *Workbench, opts ...grpc.CallOption) (*CreateWorkbenchReply, error) {
-	out := new(CreateWorkbenchReply)
-	err := c.cc.Invoke(ctx, "/chorus.WorkbenchService/CreateWorkbench", in, out, opts...)
-	if err != nil {
+func _WorkbenchService_KeepWorkbenchAlive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeepWorkbenchAliveRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(WorkbenchServiceServer).KeepWorkbenchAlive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.WorkbenchService/KeepWorkbenchAlive",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkbenchServiceServer).KeepWorkbenchAlive(ctx, req.(*KeepWorkbenchAliveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *workbenchServiceClient) UpdateWorkbench(ctx context.Context, in *Workbench, opts ...grpc.CallOption) (*UpdateWorkbenchReply, error) {
-	out := new(UpdateWorkbenchReply)
-	err := c.cc.Invoke(ctx, "/chorus.WorkbenchService/UpdateWorkbench", in, out, opts...)
-	if err != nil {
+func _WorkbenchService_HibernateWorkbench_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HibernateWorkbenchRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(WorkbenchServiceServer).HibernateWorkbench(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.WorkbenchService/HibernateWorkbench",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkbenchServiceServer).HibernateWorkbench(ctx, req.(*HibernateWorkbenchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *workbenchServiceClient) AddUserRoleInWorkbench(ctx context.Context, in *AddUserRoleInWorkbenchRequest, opts ...grpc.CallOption) (*AddUserRoleInWorkbenchReply, error) {
-	out := new(AddUserRoleInWorkbenchReply)
-	err := c.cc.Invoke(ctx, "/chorus.WorkbenchService/AddUserRoleInWorkbench", in, out, opts...)
-	if err != nil {
+func _WorkbenchService_ResumeWorkbench_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeWorkbenchRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(WorkbenchServiceServer).ResumeWorkbench(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.WorkbenchService/ResumeWorkbench",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkbenchServiceServer).ResumeWorkbench(ctx, req.(*ResumeWorkbenchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *workbenchServiceClient) RemoveUserFromWorkbench(ctx context.Context, in *RemoveUserFromWorkbenchRequest, opts ...grpc.CallOption) (*RemoveUserFromWorkbenchReply, error) {
-	out := new(RemoveUserFromWorkbenchReply)
-	err := c.cc.Invoke(ctx, "/chorus.WorkbenchService/RemoveUserFromWorkbench", in, out, opts...)
-	if err != nil {
+func _WorkbenchService_CreateWorkbenchShareGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWorkbenchShareGrantRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(WorkbenchServiceServer).CreateWorkbenchShareGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.WorkbenchService/CreateWorkbenchShareGrant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkbenchServiceServer).CreateWorkbenchShareGrant(ctx, req.(*CreateWorkbenchShareGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *workbenchServiceClient) DeleteWorkbench(ctx context.Context, in *DeleteWorkbenchRequest, opts ...grpc.CallOption) (*DeleteWorkbenchReply, error) {
-	out := new(DeleteWorkbenchReply)
-	err := c.cc.Invoke(ctx, "/chorus.WorkbenchService/DeleteWorkbench", in, out, opts...)
-	if err != nil {
+func _WorkbenchService_ListWorkbenchShareGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkbenchShareGrantsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(WorkbenchServiceServer).ListWorkbenchShareGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.WorkbenchService/ListWorkbenchShareGrants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkbenchServiceServer).ListWorkbenchShareGrants(ctx, req.(*ListWorkbenchShareGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// WorkbenchServiceServer is the server API for WorkbenchService service.
-type WorkbenchServiceServer interface {
-	GetWorkbench(context.Context, *GetWorkbenchRequest) (*GetWorkbenchReply, error)
-	ListWorkbenches(context.Context, *ListWorkbenchesRequest) (*ListWorkbenchesReply, error)
-	CreateWorkbench(context.Context, *Workbench) (*CreateWorkbenchReply, error)
-	UpdateWorkbench(context.Context, *Workbench) (*UpdateWorkbenchReply, error)
-	AddUserRoleInWorkbench(context.Context, *AddUserRoleInWorkbenchRequest) (*AddUserRoleInWorkbenchReply, error)
-	RemoveUserFromWorkbench(context.Context, *RemoveUserFromWorkbenchRequest) (*RemoveUserFromWorkbenchReply, error)
-	DeleteWorkbench(context.Context, *DeleteWorkbenchRequest) (*DeleteWorkbenchReply, error)
+func _WorkbenchService_RevokeWorkbenchShareGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeWorkbenchShareGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkbenchServiceServer).RevokeWorkbenchShareGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.WorkbenchService/RevokeWorkbenchShareGrant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkbenchServiceServer).RevokeWorkbenchShareGrant(ctx, req.(*RevokeWorkbenchShareGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedWorkbenchServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedWorkbenchServiceServer struct {
+func _WorkbenchService_WatchWorkbench_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchWorkbenchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WorkbenchServiceServer).WatchWorkbench(m, &workbenchServiceWatchWorkbenchServer{stream})
 }
 
-func (*UnimplementedWorkbenchServiceServer) GetWorkbench(context.Context, *GetWorkbenchRequest) (*GetWorkbenchReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetWorkbench not implemented")
-}
-func (*UnimplementedWorkbenchServiceServer) ListWorkbenches(context.Context, *ListWorkbenchesRequest) (*ListWorkbenchesReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListWorkbenches not implemented")
+type WorkbenchService_WatchWorkbenchServer interface {
+	Send(*WorkbenchStatusEvent) error
+	grpc.ServerStream
 }
-func (*UnimplementedWorkbenchServiceServer) CreateWorkbench(context.Context, *Workbench) (*CreateWorkbenchReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateWorkbench not implemented")
+
+type workbenchServiceWatchWorkbenchServer struct {
+	grpc.ServerStream
 }
-func (*UnimplementedWorkbenchServiceServer) UpdateWorkbench(context.Context, *Workbench) (*UpdateWorkbenchReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkbench not implemented")
+
+func (x *workbenchServiceWatchWorkbenchServer) Send(m *WorkbenchStatusEvent) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (*UnimplementedWorkbenchServiceServer) AddUserRoleInWorkbench(context.Context, *AddUserRoleInWorkbenchRequest) (*AddUserRoleInWorkbenchReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddUserRoleInWorkbench not implemented")
+
+func _WorkbenchService_WatchWorkspace_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchWorkspaceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WorkbenchServiceServer).WatchWorkspace(m, &workbenchServiceWatchWorkspaceServer{stream})
 }
-func (*UnimplementedWorkbenchServiceServer) RemoveUserFromWorkbench(context.Context, *RemoveUserFromWorkbenchRequest) (*RemoveUserFromWorkbenchReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveUserFromWorkbench not implemented")
+
+type WorkbenchService_WatchWorkspaceServer interface {
+	Send(*WorkbenchStatusEvent) error
+	grpc.ServerStream
 }
-func (*UnimplementedWorkbenchServiceServer) DeleteWorkbench(context.Context, *DeleteWorkbenchRequest) (*DeleteWorkbenchReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkbench not implemented")
+
+type workbenchServiceWatchWorkspaceServer struct {
+	grpc.ServerStream
 }
 
-func RegisterWorkbenchServiceServer(s *grpc.Server, srv WorkbenchServiceServer) {
-	s.RegisterService(&_WorkbenchService_serviceDesc, srv)
+func (x *workbenchServiceWatchWorkspaceServer) Send(m *WorkbenchStatusEvent) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func _WorkbenchService_GetWorkbench_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetWorkbenchRequest)
+func _WorkbenchService_GetWorkbenchServerLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkbenchServerLogsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkbenchServiceServer).GetWorkbench(ctx, in)
+		return srv.(WorkbenchServiceServer).GetWorkbenchServerLogs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/chorus.WorkbenchService/GetWorkbench",
+		FullMethod: "/chorus.WorkbenchService/GetWorkbenchServerLogs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkbenchServiceServer).GetWorkbench(ctx, req.(*GetWorkbenchRequest))
+		return srv.(WorkbenchServiceServer).GetWorkbenchServerLogs(ctx, req.(*GetWorkbenchServerLogsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WorkbenchService_ListWorkbenches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListWorkbenchesRequest)
+func _WorkbenchService_ListWorkbenchServerEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkbenchServerEventsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkbenchServiceServer).ListWorkbenches(ctx, in)
+		return srv.(WorkbenchServiceServer).ListWorkbenchServerEvents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/chorus.WorkbenchService/ListWorkbenches",
+		FullMethod: "/chorus.WorkbenchService/ListWorkbenchServerEvents",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkbenchServiceServer).ListWorkbenches(ctx, req.(*ListWorkbenchesRequest))
+		return srv.(WorkbenchServiceServer).ListWorkbenchServerEvents(ctx, req.(*ListWorkbenchServerEventsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WorkbenchService_CreateWorkbench_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Workbench)
+func _WorkbenchService_GetWorkbenchUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkbenchUsageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkbenchServiceServer).CreateWorkbench(ctx, in)
+		return srv.(WorkbenchServiceServer).GetWorkbenchUsage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/chorus.WorkbenchService/CreateWorkbench",
+		FullMethod: "/chorus.WorkbenchService/GetWorkbenchUsage",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkbenchServiceServer).CreateWorkbench(ctx, req.(*Workbench))
+		return srv.(WorkbenchServiceServer).GetWorkbenchUsage(ctx, req.(*GetWorkbenchUsageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WorkbenchService_UpdateWorkbench_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Workbench)
+func _WorkbenchService_GetWorkspaceUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkspaceUsageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkbenchServiceServer).UpdateWorkbench(ctx, in)
+		return srv.(WorkbenchServiceServer).GetWorkspaceUsage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/chorus.WorkbenchService/UpdateWorkbench",
+		FullMethod: "/chorus.WorkbenchService/GetWorkspaceUsage",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkbenchServiceServer).UpdateWorkbench(ctx, req.(*Workbench))
+		return srv.(WorkbenchServiceServer).GetWorkspaceUsage(ctx, req.(*GetWorkspaceUsageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WorkbenchService_AddUserRoleInWorkbench_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddUserRoleInWorkbenchRequest)
+func _WorkbenchService_ListWorkbenchConnections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkbenchConnectionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkbenchServiceServer).AddUserRoleInWorkbench(ctx, in)
+		return srv.(WorkbenchServiceServer).ListWorkbenchConnections(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/chorus.WorkbenchService/AddUserRoleInWorkbench",
+		FullMethod: "/chorus.WorkbenchService/ListWorkbenchConnections",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkbenchServiceServer).AddUserRoleInWorkbench(ctx, req.(*AddUserRoleInWorkbenchRequest))
+		return srv.(WorkbenchServiceServer).ListWorkbenchConnections(ctx, req.(*ListWorkbenchConnectionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WorkbenchService_RemoveUserFromWorkbench_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveUserFromWorkbenchRequest)
+func _WorkbenchService_ListWorkspaceWorkbenchConnections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkbenchConnectionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkbenchServiceServer).RemoveUserFromWorkbench(ctx, in)
+		return srv.(WorkbenchServiceServer).ListWorkspaceWorkbenchConnections(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/chorus.WorkbenchService/RemoveUserFromWorkbench",
+		FullMethod: "/chorus.WorkbenchService/ListWorkspaceWorkbenchConnections",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkbenchServiceServer).RemoveUserFromWorkbench(ctx, req.(*RemoveUserFromWorkbenchRequest))
+		return srv.(WorkbenchServiceServer).ListWorkspaceWorkbenchConnections(ctx, req.(*ListWorkbenchConnectionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WorkbenchService_DeleteWorkbench_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteWorkbenchRequest)
+func _WorkbenchService_ListUserWorkbenchConnections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkbenchConnectionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkbenchServiceServer).DeleteWorkbench(ctx, in)
+		return srv.(WorkbenchServiceServer).ListUserWorkbenchConnections(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/chorus.WorkbenchService/DeleteWorkbench",
+		FullMethod: "/chorus.WorkbenchService/ListUserWorkbenchConnections",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkbenchServiceServer).DeleteWorkbench(ctx, req.(*DeleteWorkbenchRequest))
+		return srv.(WorkbenchServiceServer).ListUserWorkbenchConnections(ctx, req.(*ListWorkbenchConnectionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1891,7 +5945,82 @@ var _WorkbenchService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteWorkbench",
 			Handler:    _WorkbenchService_DeleteWorkbench_Handler,
 		},
+		{
+			MethodName: "GetWorkbenchSchedule",
+			Handler:    _WorkbenchService_GetWorkbenchSchedule_Handler,
+		},
+		{
+			MethodName: "SetWorkbenchSchedule",
+			Handler:    _WorkbenchService_SetWorkbenchSchedule_Handler,
+		},
+		{
+			MethodName: "DeleteWorkbenchSchedule",
+			Handler:    _WorkbenchService_DeleteWorkbenchSchedule_Handler,
+		},
+		{
+			MethodName: "KeepWorkbenchAlive",
+			Handler:    _WorkbenchService_KeepWorkbenchAlive_Handler,
+		},
+		{
+			MethodName: "HibernateWorkbench",
+			Handler:    _WorkbenchService_HibernateWorkbench_Handler,
+		},
+		{
+			MethodName: "ResumeWorkbench",
+			Handler:    _WorkbenchService_ResumeWorkbench_Handler,
+		},
+		{
+			MethodName: "CreateWorkbenchShareGrant",
+			Handler:    _WorkbenchService_CreateWorkbenchShareGrant_Handler,
+		},
+		{
+			MethodName: "ListWorkbenchShareGrants",
+			Handler:    _WorkbenchService_ListWorkbenchShareGrants_Handler,
+		},
+		{
+			MethodName: "RevokeWorkbenchShareGrant",
+			Handler:    _WorkbenchService_RevokeWorkbenchShareGrant_Handler,
+		},
+		{
+			MethodName: "GetWorkbenchServerLogs",
+			Handler:    _WorkbenchService_GetWorkbenchServerLogs_Handler,
+		},
+		{
+			MethodName: "ListWorkbenchServerEvents",
+			Handler:    _WorkbenchService_ListWorkbenchServerEvents_Handler,
+		},
+		{
+			MethodName: "GetWorkbenchUsage",
+			Handler:    _WorkbenchService_GetWorkbenchUsage_Handler,
+		},
+		{
+			MethodName: "GetWorkspaceUsage",
+			Handler:    _WorkbenchService_GetWorkspaceUsage_Handler,
+		},
+		{
+			MethodName: "ListWorkbenchConnections",
+			Handler:    _WorkbenchService_ListWorkbenchConnections_Handler,
+		},
+		{
+			MethodName: "ListWorkspaceWorkbenchConnections",
+			Handler:    _WorkbenchService_ListWorkspaceWorkbenchConnections_Handler,
+		},
+		{
+			MethodName: "ListUserWorkbenchConnections",
+			Handler:    _WorkbenchService_ListUserWorkbenchConnections_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchWorkbench",
+			Handler:       _WorkbenchService_WatchWorkbench_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchWorkspace",
+			Handler:       _WorkbenchService_WatchWorkspace_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "workbench-service.proto",
 }
@@ -0,0 +1,1761 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        v6.30.2
+// source: workspace-invitation-service.proto
+
+package chorus
+
+import (
+	context "context"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateWorkspaceInvitationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId uint64                 `protobuf:"varint,1,opt,name=workspaceId,proto3" json:"workspaceId,omitempty"`
+	UserId      uint64                 `protobuf:"varint,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	Email       string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	RoleName    string                 `protobuf:"bytes,4,opt,name=roleName,proto3" json:"roleName,omitempty"`
+	ExpiresAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+}
+
+func (x *CreateWorkspaceInvitationRequest) Reset() {
+	*x = CreateWorkspaceInvitationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workspace_invitation_service_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateWorkspaceInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWorkspaceInvitationRequest) ProtoMessage() {}
+
+func (x *CreateWorkspaceInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workspace_invitation_service_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWorkspaceInvitationRequest.ProtoReflect.Descriptor instead.
+func (*CreateWorkspaceInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_workspace_invitation_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateWorkspaceInvitationRequest) GetWorkspaceId() uint64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *CreateWorkspaceInvitationRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateWorkspaceInvitationRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateWorkspaceInvitationRequest) GetRoleName() string {
+	if x != nil {
+		return x.RoleName
+	}
+	return ""
+}
+
+func (x *CreateWorkspaceInvitationRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type CreateWorkspaceInvitationReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *CreateWorkspaceInvitationResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *CreateWorkspaceInvitationReply) Reset() {
+	*x = CreateWorkspaceInvitationReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workspace_invitation_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateWorkspaceInvitationReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWorkspaceInvitationReply) ProtoMessage() {}
+
+func (x *CreateWorkspaceInvitationReply) ProtoReflect() protoreflect.Message {
+	mi := &file_workspace_invitation_service_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWorkspaceInvitationReply.ProtoReflect.Descriptor instead.
+func (*CreateWorkspaceInvitationReply) Descriptor() ([]byte, []int) {
+	return file_workspace_invitation_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateWorkspaceInvitationReply) GetResult() *CreateWorkspaceInvitationResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type CreateWorkspaceInvitationResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Invitation *WorkspaceInvitation `protobuf:"bytes,1,opt,name=invitation,proto3" json:"invitation,omitempty"`
+}
+
+func (x *CreateWorkspaceInvitationResult) Reset() {
+	*x = CreateWorkspaceInvitationResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workspace_invitation_service_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateWorkspaceInvitationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWorkspaceInvitationResult) ProtoMessage() {}
+
+func (x *CreateWorkspaceInvitationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_workspace_invitation_service_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWorkspaceInvitationResult.ProtoReflect.Descriptor instead.
+func (*CreateWorkspaceInvitationResult) Descriptor() ([]byte, []int) {
+	return file_workspace_invitation_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateWorkspaceInvitationResult) GetInvitation() *WorkspaceInvitation {
+	if x != nil {
+		return x.Invitation
+	}
+	return nil
+}
+
+type ListWorkspaceInvitationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId uint64           `protobuf:"varint,1,opt,name=workspaceId,proto3" json:"workspaceId,omitempty"`
+	Pagination  *PaginationQuery `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	StatusesIn  []string         `protobuf:"bytes,3,rep,name=statusesIn,proto3" json:"statusesIn,omitempty"`
+}
+
+func (x *ListWorkspaceInvitationsRequest) Reset() {
+	*x = ListWorkspaceInvitationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workspace_invitation_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWorkspaceInvitationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkspaceInvitationsRequest) ProtoMessage() {}
+
+func (x *ListWorkspaceInvitationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workspace_invitation_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkspaceInvitationsRequest.ProtoReflect.Descriptor instead.
+func (*ListWorkspaceInvitationsRequest) Descriptor() ([]byte, []int) {
+	return file_workspace_invitation_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListWorkspaceInvitationsRequest) GetWorkspaceId() uint64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *ListWorkspaceInvitationsRequest) GetPagination() *PaginationQuery {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListWorkspaceInvitationsRequest) GetStatusesIn() []string {
+	if x != nil {
+		return x.StatusesIn
+	}
+	return nil
+}
+
+type ListWorkspaceInvitationsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result     *ListWorkspaceInvitationsResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Pagination *PaginationResult               `protobuf:"bytes,2,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
+}
+
+func (x *ListWorkspaceInvitationsReply) Reset() {
+	*x = ListWorkspaceInvitationsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workspace_invitation_service_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWorkspaceInvitationsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkspaceInvitationsReply) ProtoMessage() {}
+
+func (x *ListWorkspaceInvitationsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_workspace_invitation_service_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkspaceInvitationsReply.ProtoReflect.Descriptor instead.
+func (*ListWorkspaceInvitationsReply) Descriptor() ([]byte, []int) {
+	return file_workspace_invitation_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListWorkspaceInvitationsReply) GetResult() *ListWorkspaceInvitationsResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *ListWorkspaceInvitationsReply) GetPagination() *PaginationResult {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListWorkspaceInvitationsResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Invitations []*WorkspaceInvitation `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
+}
+
+func (x *ListWorkspaceInvitationsResult) Reset() {
+	*x = ListWorkspaceInvitationsResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workspace_invitation_service_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWorkspaceInvitationsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkspaceInvitationsResult) ProtoMessage() {}
+
+func (x *ListWorkspaceInvitationsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_workspace_invitation_service_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkspaceInvitationsResult.ProtoReflect.Descriptor instead.
+func (*ListWorkspaceInvitationsResult) Descriptor() ([]byte, []int) {
+	return file_workspace_invitation_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListWorkspaceInvitationsResult) GetInvitations() []*WorkspaceInvitation {
+	if x != nil {
+		return x.Invitations
+	}
+	return nil
+}
+
+type ListMyWorkspaceInvitationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *PaginationQuery `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *ListMyWorkspaceInvitationsRequest) Reset() {
+	*x = ListMyWorkspaceInvitationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workspace_invitation_service_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMyWorkspaceInvitationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyWorkspaceInvitationsRequest) ProtoMessage() {}
+
+func (x *ListMyWorkspaceInvitationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workspace_invitation_service_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyWorkspaceInvitationsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyWorkspaceInvitationsRequest) Descriptor() ([]byte, []int) {
+	return file_workspace_invitation_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListMyWorkspaceInvitationsRequest) GetPagination() *PaginationQuery {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListMyWorkspaceInvitationsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result     *ListMyWorkspaceInvitationsResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Pagination *PaginationResult                 `protobuf:"bytes,2,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
+}
+
+func (x *ListMyWorkspaceInvitationsReply) Reset() {
+	*x = ListMyWorkspaceInvitationsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workspace_invitation_service_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMyWorkspaceInvitationsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyWorkspaceInvitationsReply) ProtoMessage() {}
+
+func (x *ListMyWorkspaceInvitationsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_workspace_invitation_service_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyWorkspaceInvitationsReply.ProtoReflect.Descriptor instead.
+func (*ListMyWorkspaceInvitationsReply) Descriptor() ([]byte, []int) {
+	return file_workspace_invitation_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListMyWorkspaceInvitationsReply) GetResult() *ListMyWorkspaceInvitationsResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *ListMyWorkspaceInvitationsReply) GetPagination() *PaginationResult {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListMyWorkspaceInvitationsResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Invitations []*WorkspaceInvitation `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
+}
+
+func (x *ListMyWorkspaceInvitationsResult) Reset() {
+	*x = ListMyWorkspaceInvitationsResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workspace_invitation_service_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMyWorkspaceInvitationsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyWorkspaceInvitationsResult) ProtoMessage() {}
+
+func (x *ListMyWorkspaceInvitationsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_workspace_invitation_service_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyWorkspaceInvitationsResult.ProtoReflect.Descriptor instead.
+func (*ListMyWorkspaceInvitationsResult) Descriptor() ([]byte, []int) {
+	return file_workspace_invitation_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListMyWorkspaceInvitationsResult) GetInvitations() []*WorkspaceInvitation {
+	if x != nil {
+		return x.Invitations
+	}
+	return nil
+}
+
+type RespondWorkspaceInvitationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *RespondWorkspaceInvitationRequest) Reset() {
+	*x = RespondWorkspaceInvitationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workspace_invitation_service_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RespondWorkspaceInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondWorkspaceInvitationRequest) ProtoMessage() {}
+
+func (x *RespondWorkspaceInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workspace_invitation_service_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondWorkspaceInvitationRequest.ProtoReflect.Descriptor instead.
+func (*RespondWorkspaceInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_workspace_invitation_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RespondWorkspaceInvitationRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RespondWorkspaceInvitationRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type AcceptWorkspaceInvitationReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *AcceptWorkspaceInvitationResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *AcceptWorkspaceInvitationReply) Reset() {
+	*x = AcceptWorkspaceInvitationReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workspace_invitation_service_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptWorkspaceInvitationReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptWorkspaceInvitationReply) ProtoMessage() {}
+
+func (x *AcceptWorkspaceInvitationReply) ProtoReflect() protoreflect.Message {
+	mi := &file_workspace_invitation_service_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptWorkspaceInvitationReply.ProtoReflect.Descriptor instead.
+func (*AcceptWorkspaceInvitationReply) Descriptor() ([]byte, []int) {
+	return file_workspace_invitation_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AcceptWorkspaceInvitationReply) GetResult() *AcceptWorkspaceInvitationResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type AcceptWorkspaceInvitationResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Invitation *WorkspaceInvitation `protobuf:"bytes,1,opt,name=invitation,proto3" json:"invitation,omitempty"`
+}
+
+func (x *AcceptWorkspaceInvitationResult) Reset() {
+	*x = AcceptWorkspaceInvitationResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workspace_invitation_service_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptWorkspaceInvitationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptWorkspaceInvitationResult) ProtoMessage() {}
+
+func (x *AcceptWorkspaceInvitationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_workspace_invitation_service_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptWorkspaceInvitationResult.ProtoReflect.Descriptor instead.
+func (*AcceptWorkspaceInvitationResult) Descriptor() ([]byte, []int) {
+	return file_workspace_invitation_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AcceptWorkspaceInvitationResult) GetInvitation() *WorkspaceInvitation {
+	if x != nil {
+		return x.Invitation
+	}
+	return nil
+}
+
+type DeclineWorkspaceInvitationReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *DeclineWorkspaceInvitationResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *DeclineWorkspaceInvitationReply) Reset() {
+	*x = DeclineWorkspaceInvitationReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workspace_invitation_service_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeclineWorkspaceInvitationReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineWorkspaceInvitationReply) ProtoMessage() {}
+
+func (x *DeclineWorkspaceInvitationReply) ProtoReflect() protoreflect.Message {
+	mi := &file_workspace_invitation_service_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineWorkspaceInvitationReply.ProtoReflect.Descriptor instead.
+func (*DeclineWorkspaceInvitationReply) Descriptor() ([]byte, []int) {
+	return file_workspace_invitation_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeclineWorkspaceInvitationReply) GetResult() *DeclineWorkspaceInvitationResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type DeclineWorkspaceInvitationResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Invitation *WorkspaceInvitation `protobuf:"bytes,1,opt,name=invitation,proto3" json:"invitation,omitempty"`
+}
+
+func (x *DeclineWorkspaceInvitationResult) Reset() {
+	*x = DeclineWorkspaceInvitationResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workspace_invitation_service_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeclineWorkspaceInvitationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineWorkspaceInvitationResult) ProtoMessage() {}
+
+func (x *DeclineWorkspaceInvitationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_workspace_invitation_service_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineWorkspaceInvitationResult.ProtoReflect.Descriptor instead.
+func (*DeclineWorkspaceInvitationResult) Descriptor() ([]byte, []int) {
+	return file_workspace_invitation_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeclineWorkspaceInvitationResult) GetInvitation() *WorkspaceInvitation {
+	if x != nil {
+		return x.Invitation
+	}
+	return nil
+}
+
+type RevokeWorkspaceInvitationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RevokeWorkspaceInvitationRequest) Reset() {
+	*x = RevokeWorkspaceInvitationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workspace_invitation_service_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeWorkspaceInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeWorkspaceInvitationRequest) ProtoMessage() {}
+
+func (x *RevokeWorkspaceInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workspace_invitation_service_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeWorkspaceInvitationRequest.ProtoReflect.Descriptor instead.
+func (*RevokeWorkspaceInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_workspace_invitation_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RevokeWorkspaceInvitationRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RevokeWorkspaceInvitationReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *RevokeWorkspaceInvitationResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *RevokeWorkspaceInvitationReply) Reset() {
+	*x = RevokeWorkspaceInvitationReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workspace_invitation_service_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeWorkspaceInvitationReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeWorkspaceInvitationReply) ProtoMessage() {}
+
+func (x *RevokeWorkspaceInvitationReply) ProtoReflect() protoreflect.Message {
+	mi := &file_workspace_invitation_service_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeWorkspaceInvitationReply.ProtoReflect.Descriptor instead.
+func (*RevokeWorkspaceInvitationReply) Descriptor() ([]byte, []int) {
+	return file_workspace_invitation_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RevokeWorkspaceInvitationReply) GetResult() *RevokeWorkspaceInvitationResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type RevokeWorkspaceInvitationResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Invitation *WorkspaceInvitation `protobuf:"bytes,1,opt,name=invitation,proto3" json:"invitation,omitempty"`
+}
+
+func (x *RevokeWorkspaceInvitationResult) Reset() {
+	*x = RevokeWorkspaceInvitationResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workspace_invitation_service_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeWorkspaceInvitationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeWorkspaceInvitationResult) ProtoMessage() {}
+
+func (x *RevokeWorkspaceInvitationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_workspace_invitation_service_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeWorkspaceInvitationResult.ProtoReflect.Descriptor instead.
+func (*RevokeWorkspaceInvitationResult) Descriptor() ([]byte, []int) {
+	return file_workspace_invitation_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RevokeWorkspaceInvitationResult) GetInvitation() *WorkspaceInvitation {
+	if x != nil {
+		return x.Invitation
+	}
+	return nil
+}
+
+var File_workspace_invitation_service_proto protoreflect.FileDescriptor
+
+var file_workspace_invitation_service_proto_rawDesc = []byte{
+	0x0a, 0x22, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2d, 0x69, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x1a, 0x1c, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32,
+	0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x77, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x2d, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe6, 0x03, 0x0a, 0x20, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x77, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x62, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x4a, 0x92, 0x41,
+	0x47, 0x32, 0x45, 0x49, 0x44, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x6e, 0x20, 0x65, 0x78, 0x69, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x20, 0x75, 0x73, 0x65, 0x72, 0x20, 0x74, 0x6f, 0x20, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x2e, 0x20, 0x45, 0x69, 0x74, 0x68, 0x65, 0x72, 0x20, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x20, 0x6f, 0x72, 0x20, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x20, 0x6d, 0x75, 0x73, 0x74,
+	0x20, 0x62, 0x65, 0x20, 0x73, 0x65, 0x74, 0x2e, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x57, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x41, 0x92, 0x41, 0x3e, 0x32, 0x3c, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x20, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x2e, 0x20, 0x45,
+	0x69, 0x74, 0x68, 0x65, 0x72, 0x20, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x20, 0x6f, 0x72, 0x20,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x73, 0x65,
+	0x74, 0x2e, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x58, 0x0a, 0x08, 0x72, 0x6f, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0x92, 0x41, 0x39,
+	0x32, 0x37, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x20, 0x72, 0x6f, 0x6c, 0x65,
+	0x20, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x69, 0x73, 0x20,
+	0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x2e, 0x52, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x88, 0x01, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x4e, 0x92, 0x41, 0x4b, 0x32, 0x49, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79,
+	0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x20, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x20, 0x74, 0x6f, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x20, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69,
+	0x6d, 0x65, 0x2e, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x61,
+	0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x3f, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x22, 0x5e, 0x0a, 0x1f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x3b, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75,
+	0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x9c, 0x01, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x68,
+	0x6f, 0x72, 0x75, 0x73, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x49, 0x6e, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x49, 0x6e,
+	0x22, 0xad, 0x01, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x3e, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x3d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e,
+	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x48, 0x00, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01,
+	0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x5f, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73,
+	0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x5c, 0x0a, 0x21, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x6f,
+	0x72, 0x75, 0x73, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0xb1, 0x01, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x40, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x68, 0x6f, 0x72,
+	0x75, 0x73, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x61, 0x0a, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x79, 0x57, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63,
+	0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xcd, 0x01, 0x0a, 0x21, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x97, 0x01, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x80, 0x01, 0x92,
+	0x41, 0x7d, 0x32, 0x7b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6c, 0x69,
+	0x6e, 0x6b, 0x2e, 0x20, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65,
+	0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x20, 0x77, 0x61, 0x73, 0x20, 0x73, 0x65, 0x6e, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x61, 0x6e, 0x20,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x20, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x20, 0x6f, 0x74,
+	0x68, 0x65, 0x72, 0x20, 0x74, 0x68, 0x61, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x6e, 0x65,
+	0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x52,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x61, 0x0a, 0x1e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3f, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75,
+	0x73, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x5e, 0x0a, 0x1f, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3b, 0x0a, 0x0a,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x63, 0x0a, 0x1f, 0x44, 0x65, 0x63,
+	0x6c, 0x69, 0x6e, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x40, 0x0a, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63,
+	0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x57, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x5f,
+	0x0a, 0x20, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x3b, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e,
+	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x32, 0x0a, 0x20, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x61, 0x0a, 0x1e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x57, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3f, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x5e, 0x0a, 0x1f, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3b, 0x0a, 0x0a, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0xc6, 0x0e, 0x0a, 0x1a, 0x57, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xcc, 0x02, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xdc, 0x01, 0x92, 0x41, 0x9c, 0x01, 0x0a, 0x1a, 0x57, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1c, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x20, 0x61, 0x20, 0x75, 0x73, 0x65, 0x72, 0x20, 0x74, 0x6f, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x1a, 0x60, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x20, 0x61, 0x6e,
+	0x20, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x75, 0x73, 0x65, 0x72, 0x20, 0x6f,
+	0x72, 0x20, 0x61, 0x6e, 0x20, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x20, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x6a, 0x6f, 0x69, 0x6e, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x61, 0x20, 0x67, 0x69,
+	0x76, 0x65, 0x6e, 0x20, 0x72, 0x6f, 0x6c, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x3a, 0x01,
+	0x2a, 0x22, 0x31, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f,
+	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x77, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x7d, 0x2f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0xa6, 0x02, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x27, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x68, 0x6f,
+	0x72, 0x75, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0xb9, 0x01, 0x92, 0x41, 0x7d, 0x0a, 0x1a, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x23, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x69, 0x6e,
+	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x77,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x1a, 0x3a, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65,
+	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20,
+	0x73, 0x65, 0x6e, 0x74, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x12, 0x31, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x73, 0x2f, 0x7b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64,
+	0x7d, 0x2f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xc4, 0x02,
+	0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x2e, 0x63,
+	0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x79, 0x57, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0xd1, 0x01, 0x92, 0x41, 0xa0, 0x01, 0x0a, 0x1a, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x6d, 0x79, 0x20, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x20, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x1a, 0x65, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20,
+	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x20, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x75, 0x73, 0x65, 0x72, 0x20, 0x6f, 0x72,
+	0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x69, 0x72, 0x20, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x20,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x2d, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2f, 0x6d, 0x65, 0x12, 0xc7, 0x02, 0x0a, 0x19, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x57,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x29, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x57, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xd6, 0x01, 0x92, 0x41, 0x99, 0x01, 0x0a, 0x1a, 0x57, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1d, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x20, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x5c, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x73, 0x20, 0x61,
+	0x6e, 0x20, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x61, 0x6e, 0x64,
+	0x20, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x20, 0x69, 0x74, 0x73, 0x20, 0x72, 0x6f, 0x6c, 0x65,
+	0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x20, 0x75, 0x73, 0x65, 0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x3a, 0x01, 0x2a, 0x22, 0x2e,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2d, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x12, 0x92,
+	0x02, 0x0a, 0x1a, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x2e,
+	0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x57, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75,
+	0x73, 0x2e, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x9f, 0x01, 0x92, 0x41, 0x62, 0x0a, 0x1a, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x1e, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x20, 0x61, 0x20, 0x77,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x20, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x1a, 0x24, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x20, 0x64, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x20, 0x61, 0x6e, 0x20, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x3a,
+	0x01, 0x2a, 0x22, 0x2f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31,
+	0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2d, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x63, 0x6c,
+	0x69, 0x6e, 0x65, 0x12, 0x89, 0x02, 0x0a, 0x19, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x57, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x28, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x68,
+	0x6f, 0x72, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x99, 0x01, 0x92, 0x41, 0x67, 0x0a, 0x1a, 0x57, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1d, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x20, 0x61, 0x20,
+	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x20, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x2a, 0x54, 0x68, 0x69, 0x73, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x20, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x73, 0x20, 0x61, 0x20, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x2a, 0x27, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73,
+	0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2d, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x42,
+	0xca, 0x01, 0x92, 0x41, 0xbc, 0x01, 0x12, 0x92, 0x01, 0x0a, 0x23, 0x63, 0x68, 0x6f, 0x72, 0x75,
+	0x73, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x20, 0x69, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0x66,
+	0x0a, 0x23, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x20, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2c, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x43, 0x48, 0x4f, 0x52, 0x55, 0x53,
+	0x2d, 0x54, 0x52, 0x45, 0x2f, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2d, 0x62, 0x61, 0x63, 0x6b,
+	0x65, 0x6e, 0x64, 0x1a, 0x11, 0x64, 0x65, 0x76, 0x40, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2d,
+	0x74, 0x72, 0x65, 0x2e, 0x63, 0x68, 0x32, 0x03, 0x31, 0x2e, 0x30, 0x2a, 0x01, 0x01, 0x32, 0x10,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73,
+	0x6f, 0x6e, 0x5a, 0x08, 0x2e, 0x3b, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_workspace_invitation_service_proto_rawDescOnce sync.Once
+	file_workspace_invitation_service_proto_rawDescData = file_workspace_invitation_service_proto_rawDesc
+)
+
+func file_workspace_invitation_service_proto_rawDescGZIP() []byte {
+	file_workspace_invitation_service_proto_rawDescOnce.Do(func() {
+		file_workspace_invitation_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_workspace_invitation_service_proto_rawDescData)
+	})
+	return file_workspace_invitation_service_proto_rawDescData
+}
+
+var file_workspace_invitation_service_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_workspace_invitation_service_proto_goTypes = []interface{}{
+	(*CreateWorkspaceInvitationRequest)(nil),  // 0: chorus.CreateWorkspaceInvitationRequest
+	(*CreateWorkspaceInvitationReply)(nil),    // 1: chorus.CreateWorkspaceInvitationReply
+	(*CreateWorkspaceInvitationResult)(nil),   // 2: chorus.CreateWorkspaceInvitationResult
+	(*ListWorkspaceInvitationsRequest)(nil),   // 3: chorus.ListWorkspaceInvitationsRequest
+	(*ListWorkspaceInvitationsReply)(nil),     // 4: chorus.ListWorkspaceInvitationsReply
+	(*ListWorkspaceInvitationsResult)(nil),    // 5: chorus.ListWorkspaceInvitationsResult
+	(*ListMyWorkspaceInvitationsRequest)(nil), // 6: chorus.ListMyWorkspaceInvitationsRequest
+	(*ListMyWorkspaceInvitationsReply)(nil),   // 7: chorus.ListMyWorkspaceInvitationsReply
+	(*ListMyWorkspaceInvitationsResult)(nil),  // 8: chorus.ListMyWorkspaceInvitationsResult
+	(*RespondWorkspaceInvitationRequest)(nil), // 9: chorus.RespondWorkspaceInvitationRequest
+	(*AcceptWorkspaceInvitationReply)(nil),    // 10: chorus.AcceptWorkspaceInvitationReply
+	(*AcceptWorkspaceInvitationResult)(nil),   // 11: chorus.AcceptWorkspaceInvitationResult
+	(*DeclineWorkspaceInvitationReply)(nil),   // 12: chorus.DeclineWorkspaceInvitationReply
+	(*DeclineWorkspaceInvitationResult)(nil),  // 13: chorus.DeclineWorkspaceInvitationResult
+	(*RevokeWorkspaceInvitationRequest)(nil),  // 14: chorus.RevokeWorkspaceInvitationRequest
+	(*RevokeWorkspaceInvitationReply)(nil),    // 15: chorus.RevokeWorkspaceInvitationReply
+	(*RevokeWorkspaceInvitationResult)(nil),   // 16: chorus.RevokeWorkspaceInvitationResult
+	(*timestamppb.Timestamp)(nil),             // 17: google.protobuf.Timestamp
+	(*WorkspaceInvitation)(nil),               // 18: chorus.WorkspaceInvitation
+	(*PaginationQuery)(nil),                   // 19: chorus.PaginationQuery
+	(*PaginationResult)(nil),                  // 20: chorus.PaginationResult
+}
+var file_workspace_invitation_service_proto_depIdxs = []int32{
+	17, // 0: chorus.CreateWorkspaceInvitationRequest.expiresAt:type_name -> google.protobuf.Timestamp
+	2,  // 1: chorus.CreateWorkspaceInvitationReply.result:type_name -> chorus.CreateWorkspaceInvitationResult
+	18, // 2: chorus.CreateWorkspaceInvitationResult.invitation:type_name -> chorus.WorkspaceInvitation
+	19, // 3: chorus.ListWorkspaceInvitationsRequest.pagination:type_name -> chorus.PaginationQuery
+	5,  // 4: chorus.ListWorkspaceInvitationsReply.result:type_name -> chorus.ListWorkspaceInvitationsResult
+	20, // 5: chorus.ListWorkspaceInvitationsReply.pagination:type_name -> chorus.PaginationResult
+	18, // 6: chorus.ListWorkspaceInvitationsResult.invitations:type_name -> chorus.WorkspaceInvitation
+	19, // 7: chorus.ListMyWorkspaceInvitationsRequest.pagination:type_name -> chorus.PaginationQuery
+	8,  // 8: chorus.ListMyWorkspaceInvitationsReply.result:type_name -> chorus.ListMyWorkspaceInvitationsResult
+	20, // 9: chorus.ListMyWorkspaceInvitationsReply.pagination:type_name -> chorus.PaginationResult
+	18, // 10: chorus.ListMyWorkspaceInvitationsResult.invitations:type_name -> chorus.WorkspaceInvitation
+	11, // 11: chorus.AcceptWorkspaceInvitationReply.result:type_name -> chorus.AcceptWorkspaceInvitationResult
+	18, // 12: chorus.AcceptWorkspaceInvitationResult.invitation:type_name -> chorus.WorkspaceInvitation
+	13, // 13: chorus.DeclineWorkspaceInvitationReply.result:type_name -> chorus.DeclineWorkspaceInvitationResult
+	18, // 14: chorus.DeclineWorkspaceInvitationResult.invitation:type_name -> chorus.WorkspaceInvitation
+	16, // 15: chorus.RevokeWorkspaceInvitationReply.result:type_name -> chorus.RevokeWorkspaceInvitationResult
+	18, // 16: chorus.RevokeWorkspaceInvitationResult.invitation:type_name -> chorus.WorkspaceInvitation
+	0,  // 17: chorus.WorkspaceInvitationService.CreateWorkspaceInvitation:input_type -> chorus.CreateWorkspaceInvitationRequest
+	3,  // 18: chorus.WorkspaceInvitationService.ListWorkspaceInvitations:input_type -> chorus.ListWorkspaceInvitationsRequest
+	6,  // 19: chorus.WorkspaceInvitationService.ListMyWorkspaceInvitations:input_type -> chorus.ListMyWorkspaceInvitationsRequest
+	9,  // 20: chorus.WorkspaceInvitationService.AcceptWorkspaceInvitation:input_type -> chorus.RespondWorkspaceInvitationRequest
+	9,  // 21: chorus.WorkspaceInvitationService.DeclineWorkspaceInvitation:input_type -> chorus.RespondWorkspaceInvitationRequest
+	14, // 22: chorus.WorkspaceInvitationService.RevokeWorkspaceInvitation:input_type -> chorus.RevokeWorkspaceInvitationRequest
+	1,  // 23: chorus.WorkspaceInvitationService.CreateWorkspaceInvitation:output_type -> chorus.CreateWorkspaceInvitationReply
+	4,  // 24: chorus.WorkspaceInvitationService.ListWorkspaceInvitations:output_type -> chorus.ListWorkspaceInvitationsReply
+	7,  // 25: chorus.WorkspaceInvitationService.ListMyWorkspaceInvitations:output_type -> chorus.ListMyWorkspaceInvitationsReply
+	10, // 26: chorus.WorkspaceInvitationService.AcceptWorkspaceInvitation:output_type -> chorus.AcceptWorkspaceInvitationReply
+	12, // 27: chorus.WorkspaceInvitationService.DeclineWorkspaceInvitation:output_type -> chorus.DeclineWorkspaceInvitationReply
+	15, // 28: chorus.WorkspaceInvitationService.RevokeWorkspaceInvitation:output_type -> chorus.RevokeWorkspaceInvitationReply
+	23, // [23:29] is the sub-list for method output_type
+	17, // [17:23] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
+}
+
+func init() { file_workspace_invitation_service_proto_init() }
+func file_workspace_invitation_service_proto_init() {
+	if File_workspace_invitation_service_proto != nil {
+		return
+	}
+	file_common_proto_init()
+	file_workspace_invitation_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_workspace_invitation_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWorkspaceInvitationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workspace_invitation_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWorkspaceInvitationReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workspace_invitation_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWorkspaceInvitationResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workspace_invitation_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWorkspaceInvitationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workspace_invitation_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWorkspaceInvitationsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workspace_invitation_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWorkspaceInvitationsResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workspace_invitation_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMyWorkspaceInvitationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workspace_invitation_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMyWorkspaceInvitationsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workspace_invitation_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMyWorkspaceInvitationsResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workspace_invitation_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondWorkspaceInvitationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workspace_invitation_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptWorkspaceInvitationReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workspace_invitation_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptWorkspaceInvitationResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workspace_invitation_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeclineWorkspaceInvitationReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workspace_invitation_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeclineWorkspaceInvitationResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workspace_invitation_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeWorkspaceInvitationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workspace_invitation_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeWorkspaceInvitationReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workspace_invitation_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeWorkspaceInvitationResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_workspace_invitation_service_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	file_workspace_invitation_service_proto_msgTypes[7].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_workspace_invitation_service_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   17,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_workspace_invitation_service_proto_goTypes,
+		DependencyIndexes: file_workspace_invitation_service_proto_depIdxs,
+		MessageInfos:      file_workspace_invitation_service_proto_msgTypes,
+	}.Build()
+	File_workspace_invitation_service_proto = out.File
+	file_workspace_invitation_service_proto_rawDesc = nil
+	file_workspace_invitation_service_proto_goTypes = nil
+	file_workspace_invitation_service_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// WorkspaceInvitationServiceClient is the client API for WorkspaceInvitationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type WorkspaceInvitationServiceClient interface {
+	CreateWorkspaceInvitation(ctx context.Context, in *CreateWorkspaceInvitationRequest, opts ...grpc.CallOption) (*CreateWorkspaceInvitationReply, error)
+	ListWorkspaceInvitations(ctx context.Context, in *ListWorkspaceInvitationsRequest, opts ...grpc.CallOption) (*ListWorkspaceInvitationsReply, error)
+	ListMyWorkspaceInvitations(ctx context.Context, in *ListMyWorkspaceInvitationsRequest, opts ...grpc.CallOption) (*ListMyWorkspaceInvitationsReply, error)
+	AcceptWorkspaceInvitation(ctx context.Context, in *RespondWorkspaceInvitationRequest, opts ...grpc.CallOption) (*AcceptWorkspaceInvitationReply, error)
+	DeclineWorkspaceInvitation(ctx context.Context, in *RespondWorkspaceInvitationRequest, opts ...grpc.CallOption) (*DeclineWorkspaceInvitationReply, error)
+	RevokeWorkspaceInvitation(ctx context.Context, in *RevokeWorkspaceInvitationRequest, opts ...grpc.CallOption) (*RevokeWorkspaceInvitationReply, error)
+}
+
+type workspaceInvitationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWorkspaceInvitationServiceClient(cc grpc.ClientConnInterface) WorkspaceInvitationServiceClient {
+	return &workspaceInvitationServiceClient{cc}
+}
+
+func (c *workspaceInvitationServiceClient) CreateWorkspaceInvitation(ctx context.Context, in *CreateWorkspaceInvitationRequest, opts ...grpc.CallOption) (*CreateWorkspaceInvitationReply, error) {
+	out := new(CreateWorkspaceInvitationReply)
+	err := c.cc.Invoke(ctx, "/chorus.WorkspaceInvitationService/CreateWorkspaceInvitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceInvitationServiceClient) ListWorkspaceInvitations(ctx context.Context, in *ListWorkspaceInvitationsRequest, opts ...grpc.CallOption) (*ListWorkspaceInvitationsReply, error) {
+	out := new(ListWorkspaceInvitationsReply)
+	err := c.cc.Invoke(ctx, "/chorus.WorkspaceInvitationService/ListWorkspaceInvitations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceInvitationServiceClient) ListMyWorkspaceInvitations(ctx context.Context, in *ListMyWorkspaceInvitationsRequest, opts ...grpc.CallOption) (*ListMyWorkspaceInvitationsReply, error) {
+	out := new(ListMyWorkspaceInvitationsReply)
+	err := c.cc.Invoke(ctx, "/chorus.WorkspaceInvitationService/ListMyWorkspaceInvitations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceInvitationServiceClient) AcceptWorkspaceInvitation(ctx context.Context, in *RespondWorkspaceInvitationRequest, opts ...grpc.CallOption) (*AcceptWorkspaceInvitationReply, error) {
+	out := new(AcceptWorkspaceInvitationReply)
+	err := c.cc.Invoke(ctx, "/chorus.WorkspaceInvitationService/AcceptWorkspaceInvitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceInvitationServiceClient) DeclineWorkspaceInvitation(ctx context.Context, in *RespondWorkspaceInvitationRequest, opts ...grpc.CallOption) (*DeclineWorkspaceInvitationReply, error) {
+	out := new(DeclineWorkspaceInvitationReply)
+	err := c.cc.Invoke(ctx, "/chorus.WorkspaceInvitationService/DeclineWorkspaceInvitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceInvitationServiceClient) RevokeWorkspaceInvitation(ctx context.Context, in *RevokeWorkspaceInvitationRequest, opts ...grpc.CallOption) (*RevokeWorkspaceInvitationReply, error) {
+	out := new(RevokeWorkspaceInvitationReply)
+	err := c.cc.Invoke(ctx, "/chorus.WorkspaceInvitationService/RevokeWorkspaceInvitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WorkspaceInvitationServiceServer is the server API for WorkspaceInvitationService service.
+type WorkspaceInvitationServiceServer interface {
+	CreateWorkspaceInvitation(context.Context, *CreateWorkspaceInvitationRequest) (*CreateWorkspaceInvitationReply, error)
+	ListWorkspaceInvitations(context.Context, *ListWorkspaceInvitationsRequest) (*ListWorkspaceInvitationsReply, error)
+	ListMyWorkspaceInvitations(context.Context, *ListMyWorkspaceInvitationsRequest) (*ListMyWorkspaceInvitationsReply, error)
+	AcceptWorkspaceInvitation(context.Context, *RespondWorkspaceInvitationRequest) (*AcceptWorkspaceInvitationReply, error)
+	DeclineWorkspaceInvitation(context.Context, *RespondWorkspaceInvitationRequest) (*DeclineWorkspaceInvitationReply, error)
+	RevokeWorkspaceInvitation(context.Context, *RevokeWorkspaceInvitationRequest) (*RevokeWorkspaceInvitationReply, error)
+}
+
+// UnimplementedWorkspaceInvitationServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedWorkspaceInvitationServiceServer struct {
+}
+
+func (*UnimplementedWorkspaceInvitationServiceServer) CreateWorkspaceInvitation(context.Context, *CreateWorkspaceInvitationRequest) (*CreateWorkspaceInvitationReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWorkspaceInvitation not implemented")
+}
+func (*UnimplementedWorkspaceInvitationServiceServer) ListWorkspaceInvitations(context.Context, *ListWorkspaceInvitationsRequest) (*ListWorkspaceInvitationsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkspaceInvitations not implemented")
+}
+func (*UnimplementedWorkspaceInvitationServiceServer) ListMyWorkspaceInvitations(context.Context, *ListMyWorkspaceInvitationsRequest) (*ListMyWorkspaceInvitationsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyWorkspaceInvitations not implemented")
+}
+func (*UnimplementedWorkspaceInvitationServiceServer) AcceptWorkspaceInvitation(context.Context, *RespondWorkspaceInvitationRequest) (*AcceptWorkspaceInvitationReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptWorkspaceInvitation not implemented")
+}
+func (*UnimplementedWorkspaceInvitationServiceServer) DeclineWorkspaceInvitation(context.Context, *RespondWorkspaceInvitationRequest) (*DeclineWorkspaceInvitationReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineWorkspaceInvitation not implemented")
+}
+func (*UnimplementedWorkspaceInvitationServiceServer) RevokeWorkspaceInvitation(context.Context, *RevokeWorkspaceInvitationRequest) (*RevokeWorkspaceInvitationReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeWorkspaceInvitation not implemented")
+}
+
+func RegisterWorkspaceInvitationServiceServer(s *grpc.Server, srv WorkspaceInvitationServiceServer) {
+	s.RegisterService(&_WorkspaceInvitationService_serviceDesc, srv)
+}
+
+func _WorkspaceInvitationService_CreateWorkspaceInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWorkspaceInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceInvitationServiceServer).CreateWorkspaceInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.WorkspaceInvitationService/CreateWorkspaceInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceInvitationServiceServer).CreateWorkspaceInvitation(ctx, req.(*CreateWorkspaceInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceInvitationService_ListWorkspaceInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkspaceInvitationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceInvitationServiceServer).ListWorkspaceInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.WorkspaceInvitationService/ListWorkspaceInvitations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceInvitationServiceServer).ListWorkspaceInvitations(ctx, req.(*ListWorkspaceInvitationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceInvitationService_ListMyWorkspaceInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyWorkspaceInvitationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceInvitationServiceServer).ListMyWorkspaceInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.WorkspaceInvitationService/ListMyWorkspaceInvitations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceInvitationServiceServer).ListMyWorkspaceInvitations(ctx, req.(*ListMyWorkspaceInvitationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceInvitationService_AcceptWorkspaceInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondWorkspaceInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceInvitationServiceServer).AcceptWorkspaceInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.WorkspaceInvitationService/AcceptWorkspaceInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceInvitationServiceServer).AcceptWorkspaceInvitation(ctx, req.(*RespondWorkspaceInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceInvitationService_DeclineWorkspaceInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondWorkspaceInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceInvitationServiceServer).DeclineWorkspaceInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.WorkspaceInvitationService/DeclineWorkspaceInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceInvitationServiceServer).DeclineWorkspaceInvitation(ctx, req.(*RespondWorkspaceInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceInvitationService_RevokeWorkspaceInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeWorkspaceInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceInvitationServiceServer).RevokeWorkspaceInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chorus.WorkspaceInvitationService/RevokeWorkspaceInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceInvitationServiceServer).RevokeWorkspaceInvitation(ctx, req.(*RevokeWorkspaceInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _WorkspaceInvitationService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "chorus.WorkspaceInvitationService",
+	HandlerType: (*WorkspaceInvitationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateWorkspaceInvitation",
+			Handler:    _WorkspaceInvitationService_CreateWorkspaceInvitation_Handler,
+		},
+		{
+			MethodName: "ListWorkspaceInvitations",
+			Handler:    _WorkspaceInvitationService_ListWorkspaceInvitations_Handler,
+		},
+		{
+			MethodName: "ListMyWorkspaceInvitations",
+			Handler:    _WorkspaceInvitationService_ListMyWorkspaceInvitations_Handler,
+		},
+		{
+			MethodName: "AcceptWorkspaceInvitation",
+			Handler:    _WorkspaceInvitationService_AcceptWorkspaceInvitation_Handler,
+		},
+		{
+			MethodName: "DeclineWorkspaceInvitation",
+			Handler:    _WorkspaceInvitationService_DeclineWorkspaceInvitation_Handler,
+		},
+		{
+			MethodName: "RevokeWorkspaceInvitation",
+			Handler:    _WorkspaceInvitationService_RevokeWorkspaceInvitation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "workspace-invitation-service.proto",
+}
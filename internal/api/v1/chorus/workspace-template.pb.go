@@ -0,0 +1,638 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        v6.30.2
+// source: workspace-template.proto
+
+package chorus
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type WorkspaceTemplate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               uint64                              `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId         uint64                              `protobuf:"varint,2,opt,name=tenantId,proto3" json:"tenantId,omitempty"`
+	UserId           uint64                              `protobuf:"varint,3,opt,name=userId,proto3" json:"userId,omitempty"`
+	Name             string                              `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Description      string                              `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	NetworkPolicy    string                              `protobuf:"bytes,6,opt,name=networkPolicy,proto3" json:"networkPolicy,omitempty"`
+	AllowedFqdns     []string                            `protobuf:"bytes,7,rep,name=allowedFqdns,proto3" json:"allowedFqdns,omitempty"`
+	FqdnRules        []*FQDNRule                         `protobuf:"bytes,14,rep,name=fqdnRules,proto3" json:"fqdnRules,omitempty"`
+	Clipboard        string                              `protobuf:"bytes,8,opt,name=clipboard,proto3" json:"clipboard,omitempty"`
+	Members          []*WorkspaceTemplateMember          `protobuf:"bytes,9,rep,name=members,proto3" json:"members,omitempty"`
+	ServiceInstances []*WorkspaceTemplateServiceInstance `protobuf:"bytes,10,rep,name=serviceInstances,proto3" json:"serviceInstances,omitempty"`
+	AppIds           []uint64                            `protobuf:"varint,11,rep,packed,name=appIds,proto3" json:"appIds,omitempty"`
+	CreatedAt        *timestamppb.Timestamp              `protobuf:"bytes,12,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp              `protobuf:"bytes,13,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+}
+
+func (x *WorkspaceTemplate) Reset() {
+	*x = WorkspaceTemplate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workspace_template_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkspaceTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceTemplate) ProtoMessage() {}
+
+func (x *WorkspaceTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_workspace_template_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceTemplate.ProtoReflect.Descriptor instead.
+func (*WorkspaceTemplate) Descriptor() ([]byte, []int) {
+	return file_workspace_template_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WorkspaceTemplate) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WorkspaceTemplate) GetTenantId() uint64 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *WorkspaceTemplate) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *WorkspaceTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WorkspaceTemplate) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *WorkspaceTemplate) GetNetworkPolicy() string {
+	if x != nil {
+		return x.NetworkPolicy
+	}
+	return ""
+}
+
+func (x *WorkspaceTemplate) GetAllowedFqdns() []string {
+	if x != nil {
+		return x.AllowedFqdns
+	}
+	return nil
+}
+
+func (x *WorkspaceTemplate) GetFqdnRules() []*FQDNRule {
+	if x != nil {
+		return x.FqdnRules
+	}
+	return nil
+}
+
+func (x *WorkspaceTemplate) GetClipboard() string {
+	if x != nil {
+		return x.Clipboard
+	}
+	return ""
+}
+
+func (x *WorkspaceTemplate) GetMembers() []*WorkspaceTemplateMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *WorkspaceTemplate) GetServiceInstances() []*WorkspaceTemplateServiceInstance {
+	if x != nil {
+		return x.ServiceInstances
+	}
+	return nil
+}
+
+func (x *WorkspaceTemplate) GetAppIds() []uint64 {
+	if x != nil {
+		return x.AppIds
+	}
+	return nil
+}
+
+func (x *WorkspaceTemplate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *WorkspaceTemplate) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type WorkspaceTemplateMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId uint64 `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Role   string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+}
+
+func (x *WorkspaceTemplateMember) Reset() {
+	*x = WorkspaceTemplateMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workspace_template_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkspaceTemplateMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceTemplateMember) ProtoMessage() {}
+
+func (x *WorkspaceTemplateMember) ProtoReflect() protoreflect.Message {
+	mi := &file_workspace_template_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceTemplateMember.ProtoReflect.Descriptor instead.
+func (*WorkspaceTemplateMember) Descriptor() ([]byte, []int) {
+	return file_workspace_template_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WorkspaceTemplateMember) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *WorkspaceTemplateMember) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type WorkspaceTemplateServiceInstance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name                   string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ChartRegistry          string   `protobuf:"bytes,2,opt,name=chartRegistry,proto3" json:"chartRegistry,omitempty"`
+	ChartRepository        string   `protobuf:"bytes,3,opt,name=chartRepository,proto3" json:"chartRepository,omitempty"`
+	ChartTag               string   `protobuf:"bytes,4,opt,name=chartTag,proto3" json:"chartTag,omitempty"`
+	ValuesOverrideJson     string   `protobuf:"bytes,5,opt,name=valuesOverrideJson,proto3" json:"valuesOverrideJson,omitempty"`
+	CredentialsSecretName  string   `protobuf:"bytes,6,opt,name=credentialsSecretName,proto3" json:"credentialsSecretName,omitempty"`
+	CredentialsPaths       []string `protobuf:"bytes,7,rep,name=credentialsPaths,proto3" json:"credentialsPaths,omitempty"`
+	ConnectionInfoTemplate string   `protobuf:"bytes,8,opt,name=connectionInfoTemplate,proto3" json:"connectionInfoTemplate,omitempty"`
+	ServiceDefinitionId    uint64   `protobuf:"varint,9,opt,name=serviceDefinitionId,proto3" json:"serviceDefinitionId,omitempty"`
+}
+
+func (x *WorkspaceTemplateServiceInstance) Reset() {
+	*x = WorkspaceTemplateServiceInstance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_workspace_template_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkspaceTemplateServiceInstance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceTemplateServiceInstance) ProtoMessage() {}
+
+func (x *WorkspaceTemplateServiceInstance) ProtoReflect() protoreflect.Message {
+	mi := &file_workspace_template_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceTemplateServiceInstance.ProtoReflect.Descriptor instead.
+func (*WorkspaceTemplateServiceInstance) Descriptor() ([]byte, []int) {
+	return file_workspace_template_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WorkspaceTemplateServiceInstance) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WorkspaceTemplateServiceInstance) GetChartRegistry() string {
+	if x != nil {
+		return x.ChartRegistry
+	}
+	return ""
+}
+
+func (x *WorkspaceTemplateServiceInstance) GetChartRepository() string {
+	if x != nil {
+		return x.ChartRepository
+	}
+	return ""
+}
+
+func (x *WorkspaceTemplateServiceInstance) GetChartTag() string {
+	if x != nil {
+		return x.ChartTag
+	}
+	return ""
+}
+
+func (x *WorkspaceTemplateServiceInstance) GetValuesOverrideJson() string {
+	if x != nil {
+		return x.ValuesOverrideJson
+	}
+	return ""
+}
+
+func (x *WorkspaceTemplateServiceInstance) GetCredentialsSecretName() string {
+	if x != nil {
+		return x.CredentialsSecretName
+	}
+	return ""
+}
+
+func (x *WorkspaceTemplateServiceInstance) GetCredentialsPaths() []string {
+	if x != nil {
+		return x.CredentialsPaths
+	}
+	return nil
+}
+
+func (x *WorkspaceTemplateServiceInstance) GetConnectionInfoTemplate() string {
+	if x != nil {
+		return x.ConnectionInfoTemplate
+	}
+	return ""
+}
+
+func (x *WorkspaceTemplateServiceInstance) GetServiceDefinitionId() uint64 {
+	if x != nil {
+		return x.ServiceDefinitionId
+	}
+	return 0
+}
+
+var File_workspace_template_proto protoreflect.FileDescriptor
+
+var file_workspace_template_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2d, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x63, 0x68, 0x6f, 0x72,
+	0x75, 0x73, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d,
+	0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x0f, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x82, 0x0c, 0x0a, 0x11, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x41, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x31, 0x92, 0x41, 0x2e, 0x32, 0x2c, 0x55, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x20, 0x6f,
+	0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x20,
+	0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2e, 0x52, 0x02, 0x69, 0x64, 0x12, 0x47, 0x0a,
+	0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x2b, 0x92, 0x41, 0x28, 0x32, 0x26, 0x49, 0x44, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x20, 0x6f, 0x77, 0x6e, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68,
+	0x69, 0x73, 0x20, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2e, 0x52, 0x08, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2e, 0x92, 0x41, 0x2b, 0x32, 0x29, 0x49, 0x44, 0x20,
+	0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x75, 0x73, 0x65, 0x72, 0x20, 0x77, 0x68, 0x6f, 0x20,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x74, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x2e, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x47,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0x92, 0x41,
+	0x30, 0x32, 0x2e, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x6f,
+	0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x20, 0x77,
+	0x69, 0x74, 0x68, 0x69, 0x6e, 0x20, 0x69, 0x74, 0x73, 0x20, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x2e, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0x92, 0x41,
+	0x1e, 0x32, 0x1c, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f,
+	0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2e, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x8b, 0x01, 0x0a,
+	0x0d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x65, 0x92, 0x41, 0x62, 0x32, 0x60, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x20, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x20, 0x6f, 0x66, 0x20, 0x77, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x20,
+	0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x2e, 0x20, 0x4f, 0x6e, 0x65, 0x20, 0x6f, 0x66, 0x3a, 0x20, 0x4f, 0x70, 0x65, 0x6e,
+	0x2c, 0x20, 0x41, 0x69, 0x72, 0x67, 0x61, 0x70, 0x70, 0x65, 0x64, 0x2c, 0x20, 0x46, 0x51, 0x44,
+	0x4e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x52, 0x0d, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x8b, 0x01, 0x0a, 0x0c, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x46, 0x71, 0x64, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x09, 0x42, 0x67, 0x92, 0x41, 0x64, 0x32, 0x62, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
+	0x20, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x66, 0x71, 0x64,
+	0x6e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x2c, 0x20, 0x6b, 0x65, 0x70, 0x74, 0x20, 0x66, 0x6f, 0x72,
+	0x20, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x20,
+	0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x66, 0x71, 0x64, 0x6e, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x20, 0x69, 0x73, 0x20, 0x73, 0x65, 0x74, 0x2e, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x64, 0x46, 0x71, 0x64, 0x6e, 0x73, 0x12, 0x73, 0x0a, 0x09, 0x66, 0x71, 0x64, 0x6e,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x68,
+	0x6f, 0x72, 0x75, 0x73, 0x2e, 0x46, 0x51, 0x44, 0x4e, 0x52, 0x75, 0x6c, 0x65, 0x42, 0x43, 0x92,
+	0x41, 0x40, 0x32, 0x3e, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x20, 0x72, 0x75, 0x6c, 0x65, 0x73,
+	0x20, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x20, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x20, 0x69, 0x73, 0x20, 0x46, 0x51, 0x44, 0x4e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73,
+	0x74, 0x2e, 0x52, 0x09, 0x66, 0x71, 0x64, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x89, 0x01,
+	0x0a, 0x09, 0x63, 0x6c, 0x69, 0x70, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x6b, 0x92, 0x41, 0x68, 0x32, 0x66, 0x43, 0x6c, 0x69, 0x70, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x20, 0x6d, 0x6f, 0x64, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x73, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x20, 0x66, 0x72, 0x6f,
+	0x6d, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2e,
+	0x20, 0x4f, 0x6e, 0x65, 0x20, 0x6f, 0x66, 0x3a, 0x20, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x2c, 0x20, 0x74, 0x6f, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2c, 0x20, 0x74, 0x6f,
+	0x2d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2c, 0x20, 0x62, 0x6f, 0x74, 0x68, 0x2e, 0x52, 0x09,
+	0x63, 0x6c, 0x69, 0x70, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x8c, 0x01, 0x0a, 0x07, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x68,
+	0x6f, 0x72, 0x75, 0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x51, 0x92, 0x41,
+	0x4e, 0x32, 0x4c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x20, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x2d, 0x72, 0x6f, 0x6c, 0x65, 0x20, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x20, 0x67, 0x72,
+	0x61, 0x6e, 0x74, 0x65, 0x64, 0x20, 0x6f, 0x6e, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x73, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x20, 0x66, 0x72, 0x6f, 0x6d,
+	0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2e, 0x52,
+	0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x9f, 0x01, 0x0a, 0x10, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x0a, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x2e, 0x57, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x49, 0x92,
+	0x41, 0x46, 0x32, 0x44, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x73, 0x20, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x20, 0x69,
+	0x6e, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x20, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x74,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2e, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x64, 0x0a, 0x06, 0x61, 0x70,
+	0x70, 0x49, 0x64, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x04, 0x42, 0x4c, 0x92, 0x41, 0x49, 0x32,
+	0x47, 0x49, 0x44, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x61, 0x70, 0x70, 0x73,
+	0x20, 0x70, 0x72, 0x65, 0x2d, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x20, 0x66, 0x6f,
+	0x72, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x20, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x74,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2e, 0x52, 0x06, 0x61, 0x70, 0x70, 0x49, 0x64, 0x73,
+	0x12, 0x68, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x2e, 0x92, 0x41, 0x2b, 0x32, 0x29, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x20,
+	0x77, 0x68, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x20, 0x77, 0x61, 0x73, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x2e, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x6d, 0x0a, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x33, 0x92, 0x41, 0x30, 0x32, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x74,
+	0x68, 0x69, 0x73, 0x20, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x20, 0x77, 0x61, 0x73,
+	0x20, 0x6c, 0x61, 0x73, 0x74, 0x20, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x2e, 0x52, 0x09,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xb4, 0x01, 0x0a, 0x17, 0x57, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2c, 0x92, 0x41, 0x29, 0x32, 0x27, 0x49, 0x44, 0x20, 0x6f,
+	0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x75, 0x73, 0x65, 0x72, 0x20, 0x74, 0x6f, 0x20, 0x61, 0x64,
+	0x64, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x2e, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x53, 0x0a, 0x04, 0x72,
+	0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3f, 0x92, 0x41, 0x3c, 0x32, 0x3a,
+	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x20, 0x72, 0x6f, 0x6c, 0x65, 0x20, 0x67,
+	0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x75, 0x73,
+	0x65, 0x72, 0x20, 0x28, 0x65, 0x2e, 0x67, 0x2e, 0x20, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x29, 0x2e, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65,
+	0x22, 0xe7, 0x09, 0x0a, 0x20, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x3f, 0x92, 0x41, 0x3c, 0x32, 0x3a, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x6f,
+	0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x20, 0x4d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20,
+	0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x20, 0x70, 0x65, 0x72, 0x20, 0x74, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x2e, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x71, 0x0a, 0x0d, 0x63, 0x68,
+	0x61, 0x72, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x4b, 0x92, 0x41, 0x48, 0x32, 0x46, 0x4f, 0x43, 0x49, 0x20, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x79, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x48, 0x65, 0x6c, 0x6d, 0x20, 0x63, 0x68, 0x61, 0x72, 0x74, 0x20, 0x28, 0x65, 0x2e, 0x67,
+	0x2e, 0x20, 0x6f, 0x63, 0x69, 0x3a, 0x2f, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79,
+	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x29, 0x2e, 0x52, 0x0d,
+	0x63, 0x68, 0x61, 0x72, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x12, 0x65, 0x0a,
+	0x0f, 0x63, 0x68, 0x61, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3b, 0x92, 0x41, 0x38, 0x32, 0x36, 0x52, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x20, 0x70, 0x61, 0x74, 0x68, 0x20, 0x6f, 0x66, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x48, 0x65, 0x6c, 0x6d, 0x20, 0x63, 0x68, 0x61, 0x72, 0x74, 0x20, 0x77,
+	0x69, 0x74, 0x68, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x79, 0x2e, 0x52, 0x0f, 0x63, 0x68, 0x61, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x6f, 0x72, 0x79, 0x12, 0x3f, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x72, 0x74, 0x54, 0x61, 0x67,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0x92, 0x41, 0x20, 0x32, 0x1e, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x61, 0x67, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x48, 0x65, 0x6c, 0x6d, 0x20, 0x63, 0x68, 0x61, 0x72, 0x74, 0x2e, 0x52, 0x08, 0x63, 0x68, 0x61,
+	0x72, 0x74, 0x54, 0x61, 0x67, 0x12, 0x71, 0x0a, 0x12, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x4f,
+	0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x4a, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x41, 0x92, 0x41, 0x3e, 0x32, 0x3c, 0x4a, 0x53, 0x4f, 0x4e, 0x2d, 0x65, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x64, 0x20, 0x48, 0x65, 0x6c, 0x6d, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x20, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x64, 0x20, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x20, 0x61, 0x74, 0x20, 0x72,
+	0x65, 0x73, 0x74, 0x2e, 0x52, 0x12, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x4f, 0x76, 0x65, 0x72,
+	0x72, 0x69, 0x64, 0x65, 0x4a, 0x73, 0x6f, 0x6e, 0x12, 0x93, 0x01, 0x0a, 0x15, 0x63, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5d, 0x92, 0x41, 0x5a, 0x32, 0x58, 0x4e,
+	0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x4b, 0x38, 0x73, 0x20, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67,
+	0x20, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x20, 0x66, 0x6f, 0x72,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x20, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x64, 0x20, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x20, 0x61,
+	0x74, 0x20, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x15, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x73, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x78,
+	0x0a, 0x10, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x50, 0x61, 0x74,
+	0x68, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x42, 0x4c, 0x92, 0x41, 0x49, 0x32, 0x47, 0x50,
+	0x61, 0x74, 0x68, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x20, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x20, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x64, 0x20, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x20, 0x61, 0x74,
+	0x20, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x10, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x73, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x84, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4c, 0x92, 0x41, 0x49, 0x32, 0x47,
+	0x47, 0x6f, 0x20, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x20, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x20, 0x75, 0x73, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x20, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x69, 0x6e, 0x66,
+	0x6f, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x20,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x2e, 0x52, 0x16, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12,
+	0xc8, 0x02, 0x0a, 0x13, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x42, 0x95, 0x02,
+	0x92, 0x41, 0x91, 0x02, 0x32, 0x8e, 0x02, 0x49, 0x44, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x68, 0x61, 0x72, 0x74, 0x20, 0x73, 0x70,
+	0x65, 0x63, 0x20, 0x63, 0x6f, 0x6d, 0x65, 0x73, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x2e, 0x20, 0x57,
+	0x68, 0x65, 0x6e, 0x20, 0x73, 0x65, 0x74, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x68, 0x61,
+	0x72, 0x74, 0x20, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x20, 0x61,
+	0x6e, 0x64, 0x20, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x69, 0x6e,
+	0x66, 0x6f, 0x20, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x20, 0x61, 0x72, 0x65, 0x20,
+	0x74, 0x61, 0x6b, 0x65, 0x6e, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74, 0x68, 0x65, 0x20, 0x64,
+	0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x4a, 0x73, 0x6f, 0x6e,
+	0x20, 0x69, 0x73, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x20, 0x61, 0x67,
+	0x61, 0x69, 0x6e, 0x73, 0x74, 0x20, 0x69, 0x74, 0x73, 0x20, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x2c, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x20, 0x69, 0x73, 0x20, 0x73, 0x61, 0x76, 0x65, 0x64, 0x20, 0x61, 0x6e, 0x64,
+	0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x61, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x20, 0x69, 0x73, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x20, 0x66, 0x72, 0x6f,
+	0x6d, 0x20, 0x69, 0x74, 0x2e, 0x52, 0x13, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65,
+	0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x3b,
+	0x63, 0x68, 0x6f, 0x72, 0x75, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_workspace_template_proto_rawDescOnce sync.Once
+	file_workspace_template_proto_rawDescData = file_workspace_template_proto_rawDesc
+)
+
+func file_workspace_template_proto_rawDescGZIP() []byte {
+	file_workspace_template_proto_rawDescOnce.Do(func() {
+		file_workspace_template_proto_rawDescData = protoimpl.X.CompressGZIP(file_workspace_template_proto_rawDescData)
+	})
+	return file_workspace_template_proto_rawDescData
+}
+
+var file_workspace_template_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_workspace_template_proto_goTypes = []interface{}{
+	(*WorkspaceTemplate)(nil),                // 0: chorus.WorkspaceTemplate
+	(*WorkspaceTemplateMember)(nil),          // 1: chorus.WorkspaceTemplateMember
+	(*WorkspaceTemplateServiceInstance)(nil), // 2: chorus.WorkspaceTemplateServiceInstance
+	(*FQDNRule)(nil),                         // 3: chorus.FQDNRule
+	(*timestamppb.Timestamp)(nil),            // 4: google.protobuf.Timestamp
+}
+var file_workspace_template_proto_depIdxs = []int32{
+	3, // 0: chorus.WorkspaceTemplate.fqdnRules:type_name -> chorus.FQDNRule
+	1, // 1: chorus.WorkspaceTemplate.members:type_name -> chorus.WorkspaceTemplateMember
+	2, // 2: chorus.WorkspaceTemplate.serviceInstances:type_name -> chorus.WorkspaceTemplateServiceInstance
+	4, // 3: chorus.WorkspaceTemplate.createdAt:type_name -> google.protobuf.Timestamp
+	4, // 4: chorus.WorkspaceTemplate.updatedAt:type_name -> google.protobuf.Timestamp
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_workspace_template_proto_init() }
+func file_workspace_template_proto_init() {
+	if File_workspace_template_proto != nil {
+		return
+	}
+	file_workspace_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_workspace_template_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkspaceTemplate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workspace_template_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkspaceTemplateMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_workspace_template_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkspaceTemplateServiceInstance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_workspace_template_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_workspace_template_proto_goTypes,
+		DependencyIndexes: file_workspace_template_proto_depIdxs,
+		MessageInfos:      file_workspace_template_proto_msgTypes,
+	}.Build()
+	File_workspace_template_proto = out.File
+	file_workspace_template_proto_rawDesc = nil
+	file_workspace_template_proto_goTypes = nil
+	file_workspace_template_proto_depIdxs = nil
+}
@@ -25,11 +25,12 @@ func AppInstanceToBusiness(appInstance *chorus.AppInstance) (*model.AppInstance,
 	return &model.AppInstance{
 		ID: appInstance.Id,
 
-		TenantID:    appInstance.TenantId,
-		UserID:      appInstance.UserId,
-		AppID:       appInstance.AppId,
-		WorkspaceID: appInstance.WorkspaceId,
-		WorkbenchID: appInstance.WorkbenchId,
+		TenantID:     appInstance.TenantId,
+		UserID:       appInstance.UserId,
+		AppID:        appInstance.AppId,
+		AppVersionID: appInstance.AppVersionId,
+		WorkspaceID:  appInstance.WorkspaceId,
+		WorkbenchID:  appInstance.WorkbenchId,
 
 		Status: status,
 
@@ -64,6 +65,10 @@ func AppInstanceFromBusiness(appInstance *model.AppInstance) (*chorus.AppInstanc
 		AppDockerImageRegistry: utils.ToString(appInstance.AppDockerImageRegistry),
 		AppDockerImageName:     utils.ToString(appInstance.AppDockerImageName),
 		AppDockerImageTag:      utils.ToString(appInstance.AppDockerImageTag),
+		AppDockerImageDigest:   appInstance.AppDockerImageDigest,
+		VulnerabilityVerdict:   appInstance.VulnerabilityVerdict,
+		LifecycleWarning:       appInstance.LifecycleWarning,
+		AppVersionId:           appInstance.AppVersionID,
 
 		Status:     appInstance.Status.String(),
 		K8SState:   appInstance.K8sState.String(),
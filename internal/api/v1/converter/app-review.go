@@ -0,0 +1,48 @@
+package converter
+
+import (
+	"fmt"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/app/model"
+)
+
+func AppReviewFromBusiness(review *model.AppReview) (*chorus.AppReview, error) {
+	reviewers := make([]*chorus.AppReviewer, 0, len(review.Reviewers))
+	for _, r := range review.Reviewers {
+		da, err := PointerToProtoTimestamp(r.DecidedAt)
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert decidedAt timestamp: %w", err)
+		}
+		reviewers = append(reviewers, &chorus.AppReviewer{
+			UserId:    r.UserID,
+			Decision:  r.Decision.String(),
+			DecidedAt: da,
+		})
+	}
+
+	events := make([]*chorus.AppLifecycleEvent, 0, len(review.Events))
+	for _, e := range review.Events {
+		ca, err := ToProtoTimestamp(e.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert createdAt timestamp: %w", err)
+		}
+		events = append(events, &chorus.AppLifecycleEvent{
+			Id:         e.ID,
+			UserId:     e.UserID,
+			Action:     e.Action.String(),
+			FromStatus: e.FromStatus.String(),
+			ToStatus:   e.ToStatus.String(),
+			Comment:    e.Comment,
+			CreatedAt:  ca,
+		})
+	}
+
+	return &chorus.AppReview{
+		AppId:            review.AppID,
+		LifecycleStatus:  review.LifecycleStatus.String(),
+		ReplacementAppId: derefOrZero(review.ReplacementAppID),
+		Reviewers:        reviewers,
+		Events:           events,
+	}, nil
+}
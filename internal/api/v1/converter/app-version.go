@@ -0,0 +1,75 @@
+package converter
+
+import (
+	"fmt"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/app/model"
+)
+
+func AppImageVersionToBusiness(version *chorus.AppImageVersion) (*model.AppVersion, error) {
+	status := model.AppVersionActive
+	if version.Status != "" {
+		s, err := model.ToAppVersionStatus(version.Status)
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert app version status: %w", err)
+		}
+		status = s
+	}
+
+	return &model.AppVersion{
+		ID:    version.Id,
+		AppID: version.AppId,
+
+		Tag:          version.DockerImageTag,
+		Digest:       version.DockerImageDigest,
+		ReleaseNotes: version.ReleaseNotes,
+		IsDefault:    version.IsDefault,
+		Status:       status,
+	}, nil
+}
+
+func AppImageVersionFromBusiness(version *model.AppVersion) (*chorus.AppImageVersion, error) {
+	ca, err := ToProtoTimestamp(version.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert createdAt timestamp: %w", err)
+	}
+	ua, err := ToProtoTimestamp(version.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert updatedAt timestamp: %w", err)
+	}
+	da, err := PointerToProtoTimestamp(version.DeprecatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert deprecatedAt timestamp: %w", err)
+	}
+	sa, err := PointerToProtoTimestamp(version.ScannedAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert scannedAt timestamp: %w", err)
+	}
+
+	return &chorus.AppImageVersion{
+		Id:    version.ID,
+		AppId: version.AppID,
+
+		DockerImageTag:    version.Tag,
+		DockerImageDigest: version.Digest,
+		ReleaseNotes:      version.ReleaseNotes,
+		IsDefault:         version.IsDefault,
+		Status:            version.Status.String(),
+
+		CreatedAt:    ca,
+		UpdatedAt:    ua,
+		DeprecatedAt: da,
+
+		VulnerabilityScan: &chorus.AppImageScan{
+			Status:    version.ScanStatus,
+			Critical:  uint32(version.ScanCritical),
+			High:      uint32(version.ScanHigh),
+			Medium:    uint32(version.ScanMedium),
+			Low:       uint32(version.ScanLow),
+			Unknown:   uint32(version.ScanUnknown),
+			ScannedAt: sa,
+		},
+		VulnerabilityVerdict: version.VulnerabilityVerdict.String(),
+	}, nil
+}
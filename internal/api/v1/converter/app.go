@@ -20,6 +20,14 @@ func AppToBusiness(app *chorus.App) (*model.App, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to convert app status: %w", err)
 	}
+	versions := make([]*model.AppVersion, 0, len(app.Versions))
+	for _, v := range app.Versions {
+		version, err := AppImageVersionToBusiness(v)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, version)
+	}
 
 	return &model.App{
 		ID: app.Id,
@@ -34,6 +42,7 @@ func AppToBusiness(app *chorus.App) (*model.App, error) {
 		DockerImageRegistry: app.DockerImageRegistry,
 		DockerImageName:     app.DockerImageName,
 		DockerImageTag:      app.DockerImageTag,
+		Versions:            versions,
 
 		ShmSize:                      app.ShmSize,
 		MaxCPU:                       app.MaxCPU,
@@ -64,12 +73,25 @@ func AppFromBusiness(app *model.App) (*chorus.App, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to convert updatedAt timestamp: %w", err)
 	}
+	sa, err := PointerToProtoTimestamp(app.SignatureCheckedAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert signatureCheckedAt timestamp: %w", err)
+	}
+	versions := make([]*chorus.AppImageVersion, 0, len(app.Versions))
+	for _, v := range app.Versions {
+		version, err := AppImageVersionFromBusiness(v)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, version)
+	}
 
 	return &chorus.App{
 		Id: app.ID,
 
-		TenantId: app.TenantID,
-		UserId:   app.UserID,
+		TenantId:    app.TenantID,
+		UserId:      app.UserID,
+		WorkspaceId: derefOrZero(app.WorkspaceID),
 
 		Name:        app.Name,
 		Description: app.Description,
@@ -79,6 +101,7 @@ func AppFromBusiness(app *model.App) (*chorus.App, error) {
 		DockerImageName:     app.DockerImageName,
 		DockerImageTag:      app.DockerImageTag,
 		DockerImageRegistry: app.DockerImageRegistry,
+		Versions:            versions,
 
 		ShmSize:                      app.ShmSize,
 		BrowserConfigURL:             app.BrowserConfigURL,
@@ -95,6 +118,13 @@ func AppFromBusiness(app *model.App) (*chorus.App, error) {
 		StabilityStatus:              app.StabilityStatus.String(),
 		Category:                     app.Category,
 
+		SignatureStatus:    app.SignatureStatus.String(),
+		SignatureKeyId:     app.SignatureKeyID,
+		SignatureCheckedAt: sa,
+
+		LifecycleStatus:  app.LifecycleStatus.String(),
+		ReplacementAppId: derefOrZero(app.ReplacementAppID),
+
 		CreatedAt: ca,
 		UpdatedAt: ua,
 	}, nil
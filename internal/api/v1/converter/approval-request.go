@@ -6,6 +6,7 @@ import (
 
 	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/approval-request/model"
+	authz "github.com/CHORUS-TRE/chorus-backend/pkg/authorization/model"
 )
 
 func ApprovalRequestFromBusiness(request *model.ApprovalRequest) (*chorus.ApprovalRequest, error) {
@@ -60,6 +61,33 @@ func ApprovalRequestFromBusiness(request *model.ApprovalRequest) (*chorus.Approv
 				Files:                  FilesFromBusiness(request.Details.DataTransferDetails.Files),
 			},
 		}
+	case model.ApprovalRequestTypeWorkspaceAccess:
+		protoRequest.Details = &chorus.ApprovalRequest_WorkspaceAccess{
+			WorkspaceAccess: &chorus.WorkspaceAccessDetails{
+				WorkspaceId:   request.Details.WorkspaceAccessDetails.WorkspaceID,
+				RoleName:      request.Details.WorkspaceAccessDetails.Role.String(),
+				Justification: request.Details.WorkspaceAccessDetails.Justification,
+			},
+		}
+	case model.ApprovalRequestTypeNetworkPolicyChange:
+		protoRequest.Details = &chorus.ApprovalRequest_NetworkPolicyChange{
+			NetworkPolicyChange: &chorus.NetworkPolicyChangeDetails{
+				WorkspaceId:          request.Details.NetworkPolicyChangeDetails.WorkspaceID,
+				CurrentNetworkPolicy: request.Details.NetworkPolicyChangeDetails.CurrentNetworkPolicy,
+				CurrentAllowedFQDNs:  FQDNRulesFromBusiness(request.Details.NetworkPolicyChangeDetails.CurrentAllowedFQDNs, nil),
+				NetworkPolicy:        request.Details.NetworkPolicyChangeDetails.NetworkPolicy,
+				AllowedFQDNs:         FQDNRulesFromBusiness(request.Details.NetworkPolicyChangeDetails.AllowedFQDNs, nil),
+			},
+		}
+	case model.ApprovalRequestTypeCustomApp:
+		protoRequest.Details = &chorus.ApprovalRequest_CustomApp{
+			CustomApp: &chorus.CustomAppDetails{
+				WorkspaceId: request.Details.CustomAppDetails.WorkspaceID,
+				AppId:       request.Details.CustomAppDetails.AppID,
+				AppName:     request.Details.CustomAppDetails.AppName,
+				Image:       request.Details.CustomAppDetails.Image,
+			},
+		}
 	}
 
 	return protoRequest, nil
@@ -156,6 +184,39 @@ func ApprovalRequestToBusiness(request *chorus.ApprovalRequest) (*model.Approval
 				},
 			}
 		}
+	case *chorus.ApprovalRequest_WorkspaceAccess:
+		if d.WorkspaceAccess != nil {
+			result.Details = model.ApprovalRequestDetails{
+				WorkspaceAccessDetails: &model.WorkspaceAccessDetails{
+					WorkspaceID:   d.WorkspaceAccess.WorkspaceId,
+					Role:          authz.RoleName(d.WorkspaceAccess.RoleName),
+					Justification: d.WorkspaceAccess.Justification,
+				},
+			}
+		}
+	case *chorus.ApprovalRequest_NetworkPolicyChange:
+		if d.NetworkPolicyChange != nil {
+			result.Details = model.ApprovalRequestDetails{
+				NetworkPolicyChangeDetails: &model.NetworkPolicyChangeDetails{
+					WorkspaceID:          d.NetworkPolicyChange.WorkspaceId,
+					CurrentNetworkPolicy: d.NetworkPolicyChange.CurrentNetworkPolicy,
+					CurrentAllowedFQDNs:  FQDNRulesToBusiness(d.NetworkPolicyChange.CurrentAllowedFQDNs, nil),
+					NetworkPolicy:        d.NetworkPolicyChange.NetworkPolicy,
+					AllowedFQDNs:         FQDNRulesToBusiness(d.NetworkPolicyChange.AllowedFQDNs, nil),
+				},
+			}
+		}
+	case *chorus.ApprovalRequest_CustomApp:
+		if d.CustomApp != nil {
+			result.Details = model.ApprovalRequestDetails{
+				CustomAppDetails: &model.CustomAppDetails{
+					WorkspaceID: d.CustomApp.WorkspaceId,
+					AppID:       d.CustomApp.AppId,
+					AppName:     d.CustomApp.AppName,
+					Image:       d.CustomApp.Image,
+				},
+			}
+		}
 	}
 
 	return result, nil
@@ -167,6 +228,12 @@ func ApprovalRequestTypeFromBusiness(t model.ApprovalRequestType) chorus.Approva
 		return chorus.ApprovalRequestType_APPROVAL_REQUEST_TYPE_DATA_EXTRACTION
 	case model.ApprovalRequestTypeDataTransfer:
 		return chorus.ApprovalRequestType_APPROVAL_REQUEST_TYPE_DATA_TRANSFER
+	case model.ApprovalRequestTypeWorkspaceAccess:
+		return chorus.ApprovalRequestType_APPROVAL_REQUEST_TYPE_WORKSPACE_ACCESS
+	case model.ApprovalRequestTypeNetworkPolicyChange:
+		return chorus.ApprovalRequestType_APPROVAL_REQUEST_TYPE_NETWORK_POLICY_CHANGE
+	case model.ApprovalRequestTypeCustomApp:
+		return chorus.ApprovalRequestType_APPROVAL_REQUEST_TYPE_CUSTOM_APP
 	default:
 		return chorus.ApprovalRequestType_APPROVAL_REQUEST_TYPE_UNSPECIFIED
 	}
@@ -178,6 +245,12 @@ func ApprovalRequestTypeToBusiness(t chorus.ApprovalRequestType) model.ApprovalR
 		return model.ApprovalRequestTypeDataExtraction
 	case chorus.ApprovalRequestType_APPROVAL_REQUEST_TYPE_DATA_TRANSFER:
 		return model.ApprovalRequestTypeDataTransfer
+	case chorus.ApprovalRequestType_APPROVAL_REQUEST_TYPE_WORKSPACE_ACCESS:
+		return model.ApprovalRequestTypeWorkspaceAccess
+	case chorus.ApprovalRequestType_APPROVAL_REQUEST_TYPE_NETWORK_POLICY_CHANGE:
+		return model.ApprovalRequestTypeNetworkPolicyChange
+	case chorus.ApprovalRequestType_APPROVAL_REQUEST_TYPE_CUSTOM_APP:
+		return model.ApprovalRequestTypeCustomApp
 	default:
 		return model.ApprovalRequestTypeUnspecified
 	}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
+	app_model "github.com/CHORUS-TRE/chorus-backend/pkg/app/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/platform-settings/model"
 )
 
@@ -27,8 +28,16 @@ func PlatformSettingsFromBusiness(s *model.PlatformSettings) (*chorus.PlatformSe
 		MaxWorkspacesPerUser:   s.MaxWorkspacesPerUser,
 		MaxSessionsPerUser:     s.MaxSessionsPerUser,
 		MaxAppInstancesPerUser: s.MaxAppInstancesPerUser,
-		CreatedAt:              ca,
-		UpdatedAt:              ua,
+
+		VulnerabilityBlockSeverity: s.VulnerabilityBlockSeverity.String(),
+		VulnerabilityWarnSeverity:  s.VulnerabilityWarnSeverity.String(),
+		ImageSigningKeys:           s.ImageSigningKeys,
+		RequireImageSignatures:     s.RequireImageSignatures,
+		RequireCustomAppApproval:   s.RequireCustomAppApproval,
+		RequireAppReview:           s.RequireAppReview,
+
+		CreatedAt: ca,
+		UpdatedAt: ua,
 	}, nil
 }
 
@@ -41,5 +50,28 @@ func PlatformSettingsToBusiness(p *chorus.PlatformSettings) *model.PlatformSetti
 		MaxWorkspacesPerUser:   p.MaxWorkspacesPerUser,
 		MaxSessionsPerUser:     p.MaxSessionsPerUser,
 		MaxAppInstancesPerUser: p.MaxAppInstancesPerUser,
+
+		VulnerabilityBlockSeverity: app_model.Severity(p.VulnerabilityBlockSeverity),
+		VulnerabilityWarnSeverity:  app_model.Severity(p.VulnerabilityWarnSeverity),
+		ImageSigningKeys:           p.ImageSigningKeys,
+		RequireImageSignatures:     p.RequireImageSignatures,
+		RequireCustomAppApproval:   p.RequireCustomAppApproval,
+		RequireAppReview:           p.RequireAppReview,
+	}
+}
+
+func UserUsageFromBusiness(u *model.UserUsage) *chorus.UserUsage {
+	return &chorus.UserUsage{
+		UserId:       u.UserID,
+		Workspaces:   ResourceUsageFromBusiness(u.Workspaces),
+		Sessions:     ResourceUsageFromBusiness(u.Sessions),
+		AppInstances: ResourceUsageFromBusiness(u.AppInstances),
+	}
+}
+
+func ResourceUsageFromBusiness(r model.ResourceUsage) *chorus.ResourceUsage {
+	return &chorus.ResourceUsage{
+		Used: r.Used,
+		Max:  r.Max,
 	}
 }
@@ -0,0 +1,66 @@
+package converter
+
+import (
+	"fmt"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func PodLogRequestToBusiness(tailLines uint32, sinceTime *timestamppb.Timestamp, previous bool) (model.PodLogRequest, error) {
+	req := model.PodLogRequest{
+		TailLines: tailLines,
+		Previous:  previous,
+	}
+
+	if sinceTime != nil {
+		if err := sinceTime.CheckValid(); err != nil {
+			return model.PodLogRequest{}, fmt.Errorf("unable to convert sinceTime timestamp: %w", err)
+		}
+		since := sinceTime.AsTime()
+		req.Since = &since
+	}
+
+	return req, nil
+}
+
+func PodLogsFromBusiness(logs *model.PodLogs) *chorus.PodLogs {
+	return &chorus.PodLogs{
+		PodName:   logs.PodName,
+		Container: logs.Container,
+		Logs:      logs.Logs,
+	}
+}
+
+func PodEventFromBusiness(event *model.PodEvent) (*chorus.PodEvent, error) {
+	fs, err := ToProtoTimestamp(event.FirstSeenAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert firstSeenAt timestamp: %w", err)
+	}
+	ls, err := ToProtoTimestamp(event.LastSeenAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert lastSeenAt timestamp: %w", err)
+	}
+
+	return &chorus.PodEvent{
+		Type:        event.Type,
+		Reason:      event.Reason,
+		Message:     event.Message,
+		Count:       event.Count,
+		FirstSeenAt: fs,
+		LastSeenAt:  ls,
+	}, nil
+}
+
+func PodEventsFromBusiness(events []*model.PodEvent) ([]*chorus.PodEvent, error) {
+	res := make([]*chorus.PodEvent, 0, len(events))
+	for _, event := range events {
+		e, err := PodEventFromBusiness(event)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, e)
+	}
+	return res, nil
+}
@@ -0,0 +1,125 @@
+package converter
+
+import (
+	"fmt"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
+)
+
+func ComputeUsageFromBusiness(usage *model.ResourceUsage) (*chorus.ComputeUsage, error) {
+	if usage == nil {
+		return nil, nil
+	}
+
+	observedAt, err := ToProtoTimestamp(usage.ObservedAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert observedAt timestamp: %w", err)
+	}
+
+	return &chorus.ComputeUsage{
+		ObservedAt:    observedAt,
+		CpuMillicores: usage.CPUMillicores,
+		MemoryBytes:   usage.MemoryBytes,
+	}, nil
+}
+
+func ComputeUsagesFromBusiness(usages []model.ResourceUsage) ([]*chorus.ComputeUsage, error) {
+	res := make([]*chorus.ComputeUsage, 0, len(usages))
+	for i := range usages {
+		u, err := ComputeUsageFromBusiness(&usages[i])
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, u)
+	}
+	return res, nil
+}
+
+func ComputeBoundsFromBusiness(bounds model.ResourceBounds) *chorus.ComputeBounds {
+	return &chorus.ComputeBounds{
+		MinCpuMillicores: bounds.MinCPUMillicores,
+		MaxCpuMillicores: bounds.MaxCPUMillicores,
+		MinMemoryBytes:   bounds.MinMemoryBytes,
+		MaxMemoryBytes:   bounds.MaxMemoryBytes,
+	}
+}
+
+func AppInstanceUsageFromBusiness(usage *model.AppInstanceUsage) (*chorus.AppInstanceUsage, error) {
+	current, err := ComputeUsageFromBusiness(usage.Current)
+	if err != nil {
+		return nil, err
+	}
+	history, err := ComputeUsagesFromBusiness(usage.History)
+	if err != nil {
+		return nil, err
+	}
+
+	return &chorus.AppInstanceUsage{
+		AppInstanceId: usage.AppInstanceID,
+		WorkbenchId:   usage.WorkbenchID,
+		WorkspaceId:   usage.WorkspaceID,
+		AppName:       usage.AppName,
+		Bounds:        ComputeBoundsFromBusiness(usage.Bounds),
+		Current:       current,
+		History:       history,
+		CpuThrottled:  usage.CPUThrottled(),
+	}, nil
+}
+
+func WorkbenchUsageFromBusiness(usage *model.WorkbenchUsage) (*chorus.WorkbenchUsage, error) {
+	server, err := ComputeUsageFromBusiness(usage.Server)
+	if err != nil {
+		return nil, err
+	}
+	serverHistory, err := ComputeUsagesFromBusiness(usage.ServerHistory)
+	if err != nil {
+		return nil, err
+	}
+	total, err := ComputeUsageFromBusiness(&usage.Total)
+	if err != nil {
+		return nil, err
+	}
+
+	appInstances := make([]*chorus.AppInstanceUsage, 0, len(usage.AppInstances))
+	for _, appInstance := range usage.AppInstances {
+		a, err := AppInstanceUsageFromBusiness(appInstance)
+		if err != nil {
+			return nil, err
+		}
+		appInstances = append(appInstances, a)
+	}
+
+	return &chorus.WorkbenchUsage{
+		WorkbenchId:   usage.WorkbenchID,
+		WorkspaceId:   usage.WorkspaceID,
+		Server:        server,
+		ServerHistory: serverHistory,
+		AppInstances:  appInstances,
+		Total:         total,
+		Bounds:        ComputeBoundsFromBusiness(usage.Bounds),
+	}, nil
+}
+
+func WorkspaceUsageFromBusiness(usage *model.WorkspaceUsage) (*chorus.WorkspaceUsage, error) {
+	total, err := ComputeUsageFromBusiness(&usage.Total)
+	if err != nil {
+		return nil, err
+	}
+
+	workbenches := make([]*chorus.WorkbenchUsage, 0, len(usage.Workbenches))
+	for _, workbench := range usage.Workbenches {
+		w, err := WorkbenchUsageFromBusiness(workbench)
+		if err != nil {
+			return nil, err
+		}
+		workbenches = append(workbenches, w)
+	}
+
+	return &chorus.WorkspaceUsage{
+		WorkspaceId: usage.WorkspaceID,
+		Workbenches: workbenches,
+		Total:       total,
+		Bounds:      ComputeBoundsFromBusiness(usage.Bounds),
+	}, nil
+}
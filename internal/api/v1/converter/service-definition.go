@@ -0,0 +1,91 @@
+package converter
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
+)
+
+func ServiceDefinitionToBusiness(pb *chorus.ServiceDefinition) (*model.ServiceDefinition, error) {
+	ca, err := FromProtoTimestamp(pb.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert createdAt timestamp: %w", err)
+	}
+	ua, err := FromProtoTimestamp(pb.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert updatedAt timestamp: %w", err)
+	}
+
+	var valuesSchema model.JSONMap[any]
+	if pb.ValuesSchemaJson != "" {
+		if err := json.Unmarshal([]byte(pb.ValuesSchemaJson), &valuesSchema); err != nil {
+			return nil, fmt.Errorf("unable to unmarshal valuesSchemaJson: %w", err)
+		}
+	}
+
+	return &model.ServiceDefinition{
+		ID:       pb.Id,
+		TenantID: pb.TenantId,
+		UserID:   pb.UserId,
+
+		Name:        pb.Name,
+		Description: pb.Description,
+		Status:      model.ServiceDefinitionStatus(pb.Status),
+
+		ChartRegistry:   pb.ChartRegistry,
+		ChartRepository: pb.ChartRepository,
+		ChartTag:        pb.ChartTag,
+
+		ValuesSchema:            valuesSchema,
+		DefaultCredentialsPaths: model.StringSlice(pb.DefaultCredentialsPaths),
+		ConnectionInfoTemplate:  pb.ConnectionInfoTemplate,
+		IconURL:                 pb.IconUrl,
+
+		CreatedAt: ca,
+		UpdatedAt: ua,
+	}, nil
+}
+
+func ServiceDefinitionFromBusiness(def *model.ServiceDefinition) (*chorus.ServiceDefinition, error) {
+	ca, err := ToProtoTimestamp(def.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert createdAt timestamp: %w", err)
+	}
+	ua, err := ToProtoTimestamp(def.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert updatedAt timestamp: %w", err)
+	}
+
+	var valuesSchemaJson string
+	if len(def.ValuesSchema) > 0 {
+		b, err := json.Marshal(def.ValuesSchema)
+		if err != nil {
+			return nil, fmt.Errorf("unable to marshal values schema: %w", err)
+		}
+		valuesSchemaJson = string(b)
+	}
+
+	return &chorus.ServiceDefinition{
+		Id:       def.ID,
+		TenantId: def.TenantID,
+		UserId:   def.UserID,
+
+		Name:        def.Name,
+		Description: def.Description,
+		Status:      def.Status.String(),
+
+		ChartRegistry:   def.ChartRegistry,
+		ChartRepository: def.ChartRepository,
+		ChartTag:        def.ChartTag,
+
+		ValuesSchemaJson:        valuesSchemaJson,
+		DefaultCredentialsPaths: []string(def.DefaultCredentialsPaths),
+		ConnectionInfoTemplate:  def.ConnectionInfoTemplate,
+		IconUrl:                 def.IconURL,
+
+		CreatedAt: ca,
+		UpdatedAt: ua,
+	}, nil
+}
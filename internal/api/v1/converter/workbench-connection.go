@@ -0,0 +1,64 @@
+package converter
+
+import (
+	"fmt"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
+)
+
+func WorkbenchConnectionFilterToBusiness(filter *chorus.WorkbenchConnectionFilter) (model.WorkbenchConnectionFilter, error) {
+	if filter == nil {
+		return model.WorkbenchConnectionFilter{}, nil
+	}
+
+	from, err := FromProtoTimestamp(filter.FromTime)
+	if err != nil {
+		return model.WorkbenchConnectionFilter{}, fmt.Errorf("unable to convert fromTime timestamp: %w", err)
+	}
+	to, err := FromProtoTimestamp(filter.ToTime)
+	if err != nil {
+		return model.WorkbenchConnectionFilter{}, fmt.Errorf("unable to convert toTime timestamp: %w", err)
+	}
+
+	return model.WorkbenchConnectionFilter{
+		WorkbenchID: filter.WorkbenchId,
+		WorkspaceID: filter.WorkspaceId,
+		UserID:      filter.UserId,
+		From:        from,
+		To:          to,
+	}, nil
+}
+
+func WorkbenchConnectionFromBusiness(connection *model.WorkbenchConnection) (*chorus.WorkbenchConnection, error) {
+	startedAt, err := ToProtoTimestamp(connection.StartedAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert startedAt timestamp: %w", err)
+	}
+	lastSeenAt, err := ToProtoTimestamp(connection.LastSeenAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert lastSeenAt timestamp: %w", err)
+	}
+
+	res := &chorus.WorkbenchConnection{
+		Id:          connection.ID,
+		WorkbenchId: connection.WorkbenchID,
+		WorkspaceId: connection.WorkspaceID,
+		UserId:      connection.UserID,
+		ClientIp:    connection.ClientIP,
+		UserAgent:   connection.UserAgent,
+		BytesIn:     connection.BytesIn,
+		BytesOut:    connection.BytesOut,
+		StartedAt:   startedAt,
+		LastSeenAt:  lastSeenAt,
+	}
+
+	if connection.EndedAt != nil {
+		res.EndedAt, err = ToProtoTimestamp(*connection.EndedAt)
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert endedAt timestamp: %w", err)
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,62 @@
+package converter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
+)
+
+func WorkbenchScheduleToBusiness(schedule *chorus.WorkbenchSchedule) (*model.WorkbenchSchedule, error) {
+	weekdays := make([]time.Weekday, 0, len(schedule.Weekdays))
+	for _, name := range schedule.Weekdays {
+		day, err := model.ToWeekday(name)
+		if err != nil {
+			return nil, err
+		}
+		weekdays = append(weekdays, day)
+	}
+
+	return &model.WorkbenchSchedule{
+		WorkbenchID: schedule.WorkbenchId,
+
+		Timezone:  schedule.Timezone,
+		Weekdays:  weekdays,
+		StartTime: schedule.StartTime,
+		StopTime:  schedule.StopTime,
+	}, nil
+}
+
+func WorkbenchScheduleFromBusiness(schedule *model.WorkbenchSchedule) (*chorus.WorkbenchSchedule, error) {
+	sa, err := PointerToProtoTimestamp(schedule.StoppedAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert stoppedAt timestamp: %w", err)
+	}
+	ca, err := ToProtoTimestamp(schedule.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert createdAt timestamp: %w", err)
+	}
+	ua, err := ToProtoTimestamp(schedule.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert updatedAt timestamp: %w", err)
+	}
+
+	weekdays := make([]string, 0, len(schedule.Weekdays))
+	for _, day := range schedule.Weekdays {
+		weekdays = append(weekdays, model.WeekdayName(day))
+	}
+
+	return &chorus.WorkbenchSchedule{
+		WorkbenchId: schedule.WorkbenchID,
+
+		Timezone:  schedule.Timezone,
+		Weekdays:  weekdays,
+		StartTime: schedule.StartTime,
+		StopTime:  schedule.StopTime,
+
+		StoppedAt: sa,
+		CreatedAt: ca,
+		UpdatedAt: ua,
+	}, nil
+}
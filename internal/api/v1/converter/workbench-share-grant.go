@@ -0,0 +1,63 @@
+package converter
+
+import (
+	"fmt"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
+)
+
+func WorkbenchShareGrantFromBusiness(grant *model.WorkbenchShareGrant) (*chorus.WorkbenchShareGrant, error) {
+	ea, err := ToProtoTimestamp(grant.ExpiresAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert expiresAt timestamp: %w", err)
+	}
+	ena, err := PointerToProtoTimestamp(grant.EndedAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert endedAt timestamp: %w", err)
+	}
+	ca, err := ToProtoTimestamp(grant.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert createdAt timestamp: %w", err)
+	}
+	ua, err := ToProtoTimestamp(grant.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert updatedAt timestamp: %w", err)
+	}
+
+	members := make([]*chorus.WorkbenchShareGrantMember, 0, len(grant.Members))
+	for _, m := range grant.Members {
+		ra, err := PointerToProtoTimestamp(m.RemovedAt)
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert removedAt timestamp: %w", err)
+		}
+		members = append(members, &chorus.WorkbenchShareGrantMember{UserId: m.UserID, RemovedAt: ra})
+	}
+
+	res := &chorus.WorkbenchShareGrant{
+		Id: grant.ID,
+
+		TenantId:    grant.TenantID,
+		WorkbenchId: grant.WorkbenchID,
+		GranterId:   grant.GranterID,
+
+		Mode:   grant.Mode.String(),
+		Status: grant.Status.String(),
+
+		ExpiresAt: ea,
+		EndedAt:   ena,
+
+		Members: members,
+
+		CreatedAt: ca,
+		UpdatedAt: ua,
+	}
+	if grant.GranteeUserID != nil {
+		res.GranteeUserId = *grant.GranteeUserID
+	}
+	if grant.GranteeWorkspaceID != nil {
+		res.GranteeWorkspaceId = *grant.GranteeWorkspaceID
+	}
+
+	return res, nil
+}
@@ -68,3 +68,30 @@ func WorkbenchFromBusiness(workbench *model.Workbench) (*chorus.Workbench, error
 		UpdatedAt: ua,
 	}, nil
 }
+
+func WorkbenchStatusEventFromBusiness(event *model.WorkbenchStatusEvent) (*chorus.WorkbenchStatusEvent, error) {
+	workbench, err := WorkbenchFromBusiness(event.Workbench)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert workbench: %w", err)
+	}
+
+	appInstances := make([]*chorus.AppInstance, 0, len(event.AppInstances))
+	for _, appInstance := range event.AppInstances {
+		ai, err := AppInstanceFromBusiness(appInstance)
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert app instance %v: %w", appInstance.ID, err)
+		}
+		appInstances = append(appInstances, ai)
+	}
+
+	oa, err := ToProtoTimestamp(event.ObservedAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert observedAt timestamp: %w", err)
+	}
+
+	return &chorus.WorkbenchStatusEvent{
+		Workbench:    workbench,
+		AppInstances: appInstances,
+		ObservedAt:   oa,
+	}, nil
+}
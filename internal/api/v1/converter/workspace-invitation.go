@@ -0,0 +1,48 @@
+package converter
+
+import (
+	"fmt"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
+)
+
+func WorkspaceInvitationFromBusiness(i *model.WorkspaceInvitation) (*chorus.WorkspaceInvitation, error) {
+	ea, err := ToProtoTimestamp(i.ExpiresAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert expiresAt timestamp: %w", err)
+	}
+	ra, err := PointerToProtoTimestamp(i.RespondedAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert respondedAt timestamp: %w", err)
+	}
+	ca, err := ToProtoTimestamp(i.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert createdAt timestamp: %w", err)
+	}
+	ua, err := ToProtoTimestamp(i.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert updatedAt timestamp: %w", err)
+	}
+
+	var inviteeUserID uint64
+	if i.InviteeUserID != nil {
+		inviteeUserID = *i.InviteeUserID
+	}
+
+	return &chorus.WorkspaceInvitation{
+		Id:            i.ID,
+		TenantId:      i.TenantID,
+		WorkspaceId:   i.WorkspaceID,
+		WorkspaceName: i.WorkspaceName,
+		InviterId:     i.InviterID,
+		InviteeUserId: inviteeUserID,
+		InviteeEmail:  i.InviteeEmail,
+		RoleName:      i.Role.String(),
+		Status:        i.Status.String(),
+		ExpiresAt:     ea,
+		RespondedAt:   ra,
+		CreatedAt:     ca,
+		UpdatedAt:     ua,
+	}, nil
+}
@@ -33,6 +33,7 @@ func WorkspaceServiceInstanceToBusiness(pb *chorus.WorkspaceServiceInstance) (*m
 		WorkspaceID: pb.WorkspaceId,
 		Name:        pb.Name,
 
+		ServiceDefinitionID:    nonZeroUint64(pb.ServiceDefinitionId),
 		State:                  model.ServiceInstanceState(pb.State),
 		ChartRegistry:          pb.ChartRegistry,
 		ChartRepository:        pb.ChartRepository,
@@ -78,6 +79,7 @@ func WorkspaceServiceInstanceFromBusiness(svc *model.WorkspaceServiceInstance) (
 		WorkspaceId: svc.WorkspaceID,
 		Name:        svc.Name,
 
+		ServiceDefinitionId:    svc.GetServiceDefinitionID(),
 		State:                  svc.State.String(),
 		ChartRegistry:          svc.ChartRegistry,
 		ChartRepository:        svc.ChartRepository,
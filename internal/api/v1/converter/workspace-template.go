@@ -0,0 +1,151 @@
+package converter
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
+	authz "github.com/CHORUS-TRE/chorus-backend/pkg/authorization/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
+)
+
+func WorkspaceTemplateToBusiness(pb *chorus.WorkspaceTemplate) (*model.WorkspaceTemplate, error) {
+	ca, err := FromProtoTimestamp(pb.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert createdAt timestamp: %w", err)
+	}
+	ua, err := FromProtoTimestamp(pb.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert updatedAt timestamp: %w", err)
+	}
+
+	members := make([]model.WorkspaceTemplateMember, 0, len(pb.Members))
+	for _, m := range pb.Members {
+		members = append(members, model.WorkspaceTemplateMember{
+			UserID: m.UserId,
+			Role:   authz.RoleName(m.Role),
+		})
+	}
+
+	svcs := make([]*model.WorkspaceTemplateServiceInstance, 0, len(pb.ServiceInstances))
+	for _, s := range pb.ServiceInstances {
+		svc, err := WorkspaceTemplateServiceInstanceToBusiness(s)
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert service instance %q: %w", s.Name, err)
+		}
+		svcs = append(svcs, svc)
+	}
+
+	return &model.WorkspaceTemplate{
+		ID:       pb.Id,
+		TenantID: pb.TenantId,
+		UserID:   pb.UserId,
+
+		Name:        pb.Name,
+		Description: pb.Description,
+
+		NetworkPolicy: model.NetworkPolicyMode(pb.NetworkPolicy),
+		AllowedFQDNs:  FQDNRulesToBusiness(pb.FqdnRules, pb.AllowedFqdns),
+		Clipboard:     model.ClipboardMode(pb.Clipboard),
+
+		Members:          members,
+		ServiceInstances: svcs,
+		AppIDs:           pb.AppIds,
+
+		CreatedAt: ca,
+		UpdatedAt: ua,
+	}, nil
+}
+
+func WorkspaceTemplateFromBusiness(t *model.WorkspaceTemplate) (*chorus.WorkspaceTemplate, error) {
+	ca, err := ToProtoTimestamp(t.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert createdAt timestamp: %w", err)
+	}
+	ua, err := ToProtoTimestamp(t.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert updatedAt timestamp: %w", err)
+	}
+
+	members := make([]*chorus.WorkspaceTemplateMember, 0, len(t.Members))
+	for _, m := range t.Members {
+		members = append(members, &chorus.WorkspaceTemplateMember{
+			UserId: m.UserID,
+			Role:   m.Role.String(),
+		})
+	}
+
+	svcs := make([]*chorus.WorkspaceTemplateServiceInstance, 0, len(t.ServiceInstances))
+	for _, s := range t.ServiceInstances {
+		svc, err := WorkspaceTemplateServiceInstanceFromBusiness(s)
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert service instance %q: %w", s.Name, err)
+		}
+		svcs = append(svcs, svc)
+	}
+
+	return &chorus.WorkspaceTemplate{
+		Id:       t.ID,
+		TenantId: t.TenantID,
+		UserId:   t.UserID,
+
+		Name:        t.Name,
+		Description: t.Description,
+
+		NetworkPolicy: string(t.NetworkPolicy),
+		AllowedFqdns:  t.AllowedFQDNs.Patterns(),
+		FqdnRules:     FQDNRulesFromBusiness(t.AllowedFQDNs, nil),
+		Clipboard:     string(t.Clipboard),
+
+		Members:          members,
+		ServiceInstances: svcs,
+		AppIds:           t.AppIDs,
+
+		CreatedAt: ca,
+		UpdatedAt: ua,
+	}, nil
+}
+
+func WorkspaceTemplateServiceInstanceToBusiness(pb *chorus.WorkspaceTemplateServiceInstance) (*model.WorkspaceTemplateServiceInstance, error) {
+	var values model.JSONMap[any]
+	if pb.ValuesOverrideJson != "" {
+		if err := json.Unmarshal([]byte(pb.ValuesOverrideJson), &values); err != nil {
+			return nil, fmt.Errorf("unable to unmarshal valuesOverrideJson: %w", err)
+		}
+	}
+
+	return &model.WorkspaceTemplateServiceInstance{
+		Name:                   pb.Name,
+		ServiceDefinitionID:    nonZeroUint64(pb.ServiceDefinitionId),
+		ChartRegistry:          pb.ChartRegistry,
+		ChartRepository:        pb.ChartRepository,
+		ChartTag:               pb.ChartTag,
+		Values:                 values,
+		CredentialsSecretName:  pb.CredentialsSecretName,
+		CredentialsPaths:       model.StringSlice(pb.CredentialsPaths),
+		ConnectionInfoTemplate: pb.ConnectionInfoTemplate,
+	}, nil
+}
+
+func WorkspaceTemplateServiceInstanceFromBusiness(svc *model.WorkspaceTemplateServiceInstance) (*chorus.WorkspaceTemplateServiceInstance, error) {
+	var valuesOverrideJson string
+	if svc.Values != nil {
+		b, err := json.Marshal(svc.Values)
+		if err != nil {
+			return nil, fmt.Errorf("unable to marshal values: %w", err)
+		}
+		valuesOverrideJson = string(b)
+	}
+
+	return &chorus.WorkspaceTemplateServiceInstance{
+		Name:                   svc.Name,
+		ServiceDefinitionId:    svc.GetServiceDefinitionID(),
+		ChartRegistry:          svc.ChartRegistry,
+		ChartRepository:        svc.ChartRepository,
+		ChartTag:               svc.ChartTag,
+		ValuesOverrideJson:     valuesOverrideJson,
+		CredentialsSecretName:  svc.CredentialsSecretName,
+		CredentialsPaths:       []string(svc.CredentialsPaths),
+		ConnectionInfoTemplate: svc.ConnectionInfoTemplate,
+	}, nil
+}
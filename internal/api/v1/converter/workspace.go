@@ -2,6 +2,7 @@ package converter
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
@@ -17,6 +18,21 @@ func WorkspaceToBusiness(workspace *chorus.Workspace) (*model.Workspace, error)
 		return nil, fmt.Errorf("unable to convert updatedAt timestamp: %w", err)
 	}
 
+	var expiresAt *time.Time
+	if workspace.ExpiresAt != nil {
+		ea, err := FromProtoTimestamp(workspace.ExpiresAt)
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert expiresAt timestamp: %w", err)
+		}
+		expiresAt = &ea
+	}
+
+	var idleTimeout *int
+	if workspace.WorkbenchIdleTimeoutMinutes > 0 {
+		minutes := int(workspace.WorkbenchIdleTimeoutMinutes)
+		idleTimeout = &minutes
+	}
+
 	return &model.Workspace{
 		ID: workspace.Id,
 
@@ -33,12 +49,16 @@ func WorkspaceToBusiness(workspace *chorus.Workspace) (*model.Workspace, error)
 		NetworkPolicy:        model.NetworkPolicyMode(workspace.NetworkPolicy),
 		NetworkPolicyStatus:  workspace.NetworkPolicyStatus,
 		NetworkPolicyMessage: workspace.NetworkPolicyMessage,
-		AllowedFQDNs:         model.StringSlice(workspace.AllowedFqdns),
+		AllowedFQDNs:         FQDNRulesToBusiness(workspace.FqdnRules, workspace.AllowedFqdns),
 		Clipboard:            model.ClipboardMode(workspace.Clipboard),
 
 		Visibility:    WorkspaceVisibilityToBusiness(workspace.Visibility),
 		ContactUserID: nonZeroUint64(workspace.ContactUserId),
 
+		ExpiresAt: expiresAt,
+
+		WorkbenchIdleTimeoutMinutes: idleTimeout,
+
 		CreatedAt: ca,
 		UpdatedAt: ua,
 	}, nil
@@ -53,6 +73,10 @@ func WorkspaceFromBusiness(workspace *model.Workspace, gidOffset uint64) (*choru
 	if err != nil {
 		return nil, fmt.Errorf("unable to convert updatedAt timestamp: %w", err)
 	}
+	ea, err := PointerToProtoTimestamp(workspace.ExpiresAt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert expiresAt timestamp: %w", err)
+	}
 
 	return &chorus.Workspace{
 		Id: workspace.ID,
@@ -71,7 +95,8 @@ func WorkspaceFromBusiness(workspace *model.Workspace, gidOffset uint64) (*choru
 		Namespace: workspace.GetClusterName(),
 
 		NetworkPolicy:        string(workspace.NetworkPolicy),
-		AllowedFqdns:         []string(workspace.AllowedFQDNs),
+		AllowedFqdns:         workspace.AllowedFQDNs.Patterns(),
+		FqdnRules:            FQDNRulesFromBusiness(workspace.AllowedFQDNs, workspace.RejectedFQDNs),
 		NetworkPolicyStatus:  workspace.NetworkPolicyStatus,
 		NetworkPolicyMessage: workspace.NetworkPolicyMessage,
 		Clipboard:            string(workspace.Clipboard),
@@ -82,9 +107,55 @@ func WorkspaceFromBusiness(workspace *model.Workspace, gidOffset uint64) (*choru
 		Gid:           workspace.ID + gidOffset,
 		Visibility:    WorkspaceVisibilityFromBusiness(workspace.Visibility),
 		ContactUserId: workspace.GetContactUserID(),
+		TemplateId:    workspace.GetTemplateID(),
+		AppIds:        workspace.AppIDs,
+		ExpiresAt:     ea,
+
+		WorkbenchIdleTimeoutMinutes: uint32(workspace.GetWorkbenchIdleTimeoutMinutes()),
+
+		NetworkPolicyApprovalRequestId: workspace.NetworkPolicyApprovalRequestID,
 	}, nil
 }
 
+// FQDNRulesToBusiness converts the FQDN rules of a request, falling back to the
+// plain patterns sent by older clients when no rule is set.
+func FQDNRulesToBusiness(rules []*chorus.FQDNRule, patterns []string) model.FQDNRules {
+	if len(rules) == 0 {
+		return model.FQDNRulesFromPatterns(patterns)
+	}
+
+	res := make(model.FQDNRules, 0, len(rules))
+	for _, rule := range rules {
+		res = append(res, model.FQDNRule{
+			Pattern:  rule.Pattern,
+			Ports:    rule.Ports,
+			Protocol: model.FQDNProtocol(rule.Protocol),
+			Comment:  rule.Comment,
+		})
+	}
+	return res
+}
+
+// FQDNRulesFromBusiness converts FQDN rules, flagging those whose pattern was
+// rejected by the network policy controller.
+func FQDNRulesFromBusiness(rules model.FQDNRules, rejected map[string]string) []*chorus.FQDNRule {
+	res := make([]*chorus.FQDNRule, 0, len(rules))
+	for _, rule := range rules {
+		pbRule := &chorus.FQDNRule{
+			Pattern:  rule.Pattern,
+			Ports:    rule.Ports,
+			Protocol: rule.Protocol.String(),
+			Comment:  rule.Comment,
+		}
+		if reason, ok := rejected[rule.Pattern]; ok {
+			pbRule.Status = model.FQDNRuleStatusRejected
+			pbRule.Message = reason
+		}
+		res = append(res, pbRule)
+	}
+	return res
+}
+
 func PublicWorkspaceFromBusiness(workspace *model.PublicWorkspace, gidOffset uint64) (*chorus.PublicWorkspace, error) {
 	ca, err := ToProtoTimestamp(workspace.CreatedAt)
 	if err != nil {
@@ -122,6 +193,10 @@ func WorkspaceStatusToBusiness(s chorus.WorkspaceStatus) model.WorkspaceStatus {
 		return model.WorkspaceStatusInactive
 	case chorus.WorkspaceStatus_WORKSPACE_STATUS_DELETED:
 		return model.WorkspaceStatusDeleted
+	case chorus.WorkspaceStatus_WORKSPACE_STATUS_ARCHIVED:
+		return model.WorkspaceStatusArchived
+	case chorus.WorkspaceStatus_WORKSPACE_STATUS_FROZEN:
+		return model.WorkspaceStatusFrozen
 	default:
 		return model.WorkspaceStatusActive
 	}
@@ -135,6 +210,10 @@ func WorkspaceStatusFromBusiness(s model.WorkspaceStatus) chorus.WorkspaceStatus
 		return chorus.WorkspaceStatus_WORKSPACE_STATUS_INACTIVE
 	case model.WorkspaceStatusDeleted:
 		return chorus.WorkspaceStatus_WORKSPACE_STATUS_DELETED
+	case model.WorkspaceStatusArchived:
+		return chorus.WorkspaceStatus_WORKSPACE_STATUS_ARCHIVED
+	case model.WorkspaceStatusFrozen:
+		return chorus.WorkspaceStatus_WORKSPACE_STATUS_FROZEN
 	default:
 		return chorus.WorkspaceStatus_WORKSPACE_STATUS_ACTIVE
 	}
@@ -183,3 +183,262 @@ func (c appControllerAudit) BulkCreateApps(ctx context.Context, req *chorus.Bulk
 
 	return res, err
 }
+
+func (c appControllerAudit) ListWorkspaceApps(ctx context.Context, req *chorus.ListWorkspaceAppsRequest) (*chorus.ListWorkspaceAppsReply, error) {
+	res, err := c.next.ListWorkspaceApps(ctx, req)
+
+	if err != nil {
+		audit.Record(ctx, c.auditWriter, model.AuditActionWorkspaceAppList,
+			audit.WithDescription(fmt.Sprintf("Failed to list apps of workspace with ID %d.", req.WorkspaceId)),
+			audit.WithWorkspaceID(req.WorkspaceId),
+			audit.WithError(err),
+		)
+	}
+
+	return res, err
+}
+
+func (c appControllerAudit) CreateWorkspaceApp(ctx context.Context, req *chorus.CreateWorkspaceAppRequest) (*chorus.CreateWorkspaceAppReply, error) {
+	res, err := c.next.CreateWorkspaceApp(ctx, req)
+
+	opts := []audit.Option{
+		audit.WithWorkspaceID(req.WorkspaceId),
+		audit.WithDetail("app_name", req.GetApp().GetName()),
+	}
+
+	if err != nil {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Failed to create app %q in workspace with ID %d.", req.GetApp().GetName(), req.WorkspaceId)),
+			audit.WithError(err),
+		)
+	} else {
+		app := res.Result.App
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Created app %q (ID %d) in workspace with ID %d.", app.Name, app.Id, req.WorkspaceId)),
+			audit.WithDetail("app_id", app.Id),
+			audit.WithDetail("app_registry", app.DockerImageRegistry),
+			audit.WithDetail("app_image_name", app.DockerImageName),
+			audit.WithDetail("app_image_tag", app.DockerImageTag),
+			audit.WithDetail("status", app.Status),
+		)
+	}
+
+	audit.Record(ctx, c.auditWriter, model.AuditActionWorkspaceAppCreate, opts...)
+
+	return res, err
+}
+
+func (c appControllerAudit) DeleteWorkspaceApp(ctx context.Context, req *chorus.DeleteWorkspaceAppRequest) (*chorus.DeleteWorkspaceAppReply, error) {
+	res, err := c.next.DeleteWorkspaceApp(ctx, req)
+
+	opts := []audit.Option{
+		audit.WithWorkspaceID(req.WorkspaceId),
+		audit.WithDetail("app_id", req.Id),
+	}
+
+	if err != nil {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Failed to delete app with ID %d of workspace with ID %d.", req.Id, req.WorkspaceId)),
+			audit.WithError(err),
+		)
+	} else {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Deleted app with ID %d of workspace with ID %d.", req.Id, req.WorkspaceId)),
+		)
+	}
+
+	audit.Record(ctx, c.auditWriter, model.AuditActionWorkspaceAppDelete, opts...)
+
+	return res, err
+}
+
+func (c appControllerAudit) ListAppVersions(ctx context.Context, req *chorus.ListAppVersionsRequest) (*chorus.ListAppVersionsReply, error) {
+	res, err := c.next.ListAppVersions(ctx, req)
+
+	if err != nil {
+		audit.Record(ctx, c.auditWriter, model.AuditActionAppVersionList,
+			audit.WithDescription(fmt.Sprintf("Failed to list versions of app with ID %d.", req.AppId)),
+			audit.WithDetail("app_id", req.AppId),
+			audit.WithError(err),
+		)
+	}
+
+	return res, err
+}
+
+func (c appControllerAudit) CreateAppVersion(ctx context.Context, req *chorus.CreateAppVersionRequest) (*chorus.CreateAppVersionReply, error) {
+	res, err := c.next.CreateAppVersion(ctx, req)
+
+	opts := []audit.Option{
+		audit.WithDetail("app_id", req.AppId),
+		audit.WithDetail("app_image_tag", req.DockerImageTag),
+	}
+
+	if err != nil {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Failed to add version %s to app with ID %d.", req.DockerImageTag, req.AppId)),
+			audit.WithError(err),
+		)
+	} else {
+		v := res.Result.Version
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Added version %s (ID %d) to app with ID %d.", v.DockerImageTag, v.Id, v.AppId)),
+			audit.WithDetail("app_version_id", v.Id),
+			audit.WithDetail("app_image_digest", v.DockerImageDigest),
+			audit.WithDetail("is_default", v.IsDefault),
+		)
+	}
+
+	audit.Record(ctx, c.auditWriter, model.AuditActionAppVersionCreate, opts...)
+
+	return res, err
+}
+
+func (c appControllerAudit) UpdateAppVersion(ctx context.Context, req *chorus.UpdateAppVersionRequest) (*chorus.UpdateAppVersionReply, error) {
+	res, err := c.next.UpdateAppVersion(ctx, req)
+
+	opts := []audit.Option{
+		audit.WithDetail("app_id", req.AppId),
+		audit.WithDetail("app_version_id", req.Id),
+	}
+
+	if err != nil {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Failed to update version with ID %d of app with ID %d.", req.Id, req.AppId)),
+			audit.WithError(err),
+		)
+	} else {
+		v := res.Result.Version
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Updated version %s (ID %d) of app with ID %d.", v.DockerImageTag, v.Id, v.AppId)),
+			audit.WithDetail("app_image_tag", v.DockerImageTag),
+			audit.WithDetail("is_default", v.IsDefault),
+			audit.WithDetail("status", v.Status),
+		)
+	}
+
+	audit.Record(ctx, c.auditWriter, model.AuditActionAppVersionUpdate, opts...)
+
+	return res, err
+}
+
+func (c appControllerAudit) GetAppReview(ctx context.Context, req *chorus.GetAppReviewRequest) (*chorus.GetAppReviewReply, error) {
+	res, err := c.next.GetAppReview(ctx, req)
+
+	if err != nil {
+		audit.Record(ctx, c.auditWriter, model.AuditActionAppReviewRead,
+			audit.WithDescription(fmt.Sprintf("Failed to get review of app with ID %d.", req.AppId)),
+			audit.WithDetail("app_id", req.AppId),
+			audit.WithError(err),
+		)
+	}
+
+	return res, err
+}
+
+func (c appControllerAudit) StartAppReview(ctx context.Context, req *chorus.StartAppReviewRequest) (*chorus.StartAppReviewReply, error) {
+	res, err := c.next.StartAppReview(ctx, req)
+
+	opts := []audit.Option{
+		audit.WithDetail("app_id", req.AppId),
+		audit.WithDetail("reviewer_ids", req.ReviewerIds),
+		audit.WithDetail("comment", req.Comment),
+	}
+
+	if err != nil {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Failed to start review of app with ID %d.", req.AppId)),
+			audit.WithError(err),
+		)
+	} else {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Started review of app with ID %d by %d reviewers.", req.AppId, len(res.Result.Review.Reviewers))),
+			audit.WithDetail("lifecycle_status", res.Result.Review.LifecycleStatus),
+		)
+	}
+
+	audit.Record(ctx, c.auditWriter, model.AuditActionAppReviewStart, opts...)
+
+	return res, err
+}
+
+func (c appControllerAudit) DecideAppReview(ctx context.Context, req *chorus.DecideAppReviewRequest) (*chorus.DecideAppReviewReply, error) {
+	res, err := c.next.DecideAppReview(ctx, req)
+
+	decision := "requested changes to"
+	if req.Approve {
+		decision = "approved"
+	}
+
+	opts := []audit.Option{
+		audit.WithDetail("app_id", req.AppId),
+		audit.WithDetail("approve", req.Approve),
+		audit.WithDetail("comment", req.Comment),
+	}
+
+	if err != nil {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Failed to record review of app with ID %d.", req.AppId)),
+			audit.WithError(err),
+		)
+	} else {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Reviewer %s app with ID %d.", decision, req.AppId)),
+			audit.WithDetail("lifecycle_status", res.Result.Review.LifecycleStatus),
+		)
+	}
+
+	audit.Record(ctx, c.auditWriter, model.AuditActionAppReviewDecide, opts...)
+
+	return res, err
+}
+
+func (c appControllerAudit) CommentAppReview(ctx context.Context, req *chorus.CommentAppReviewRequest) (*chorus.CommentAppReviewReply, error) {
+	res, err := c.next.CommentAppReview(ctx, req)
+
+	opts := []audit.Option{
+		audit.WithDetail("app_id", req.AppId),
+		audit.WithDetail("comment", req.Comment),
+	}
+
+	if err != nil {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Failed to comment review of app with ID %d.", req.AppId)),
+			audit.WithError(err),
+		)
+	} else {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Commented review of app with ID %d.", req.AppId)),
+		)
+	}
+
+	audit.Record(ctx, c.auditWriter, model.AuditActionAppReviewComment, opts...)
+
+	return res, err
+}
+
+func (c appControllerAudit) UpdateAppLifecycle(ctx context.Context, req *chorus.UpdateAppLifecycleRequest) (*chorus.UpdateAppLifecycleReply, error) {
+	res, err := c.next.UpdateAppLifecycle(ctx, req)
+
+	opts := []audit.Option{
+		audit.WithDetail("app_id", req.AppId),
+		audit.WithDetail("lifecycle_status", req.LifecycleStatus),
+		audit.WithDetail("replacement_app_id", req.ReplacementAppId),
+		audit.WithDetail("comment", req.Comment),
+	}
+
+	if err != nil {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Failed to move app with ID %d to %s.", req.AppId, req.LifecycleStatus)),
+			audit.WithError(err),
+		)
+	} else {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Moved app with ID %d to %s.", req.AppId, res.Result.Review.LifecycleStatus)),
+		)
+	}
+
+	audit.Record(ctx, c.auditWriter, model.AuditActionAppLifecycleUpdate, opts...)
+
+	return res, err
+}
@@ -4,30 +4,63 @@ import (
 	"context"
 
 	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	jwt_model "github.com/CHORUS-TRE/chorus-backend/internal/jwt/model"
 	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	app_model "github.com/CHORUS-TRE/chorus-backend/pkg/app/model"
 	authz "github.com/CHORUS-TRE/chorus-backend/pkg/authorization/model"
 	authorization_service "github.com/CHORUS-TRE/chorus-backend/pkg/authorization/service"
 )
 
 var _ chorus.AppServiceServer = (*appControllerAuthorization)(nil)
 
+type AppResolver interface {
+	GetApp(ctx context.Context, tenantID uint64, appID uint64) (*app_model.App, error)
+}
+
 type appControllerAuthorization struct {
 	Authorization
-	next chorus.AppServiceServer
+	resolver AppResolver
+	next     chorus.AppServiceServer
 }
 
-func AppAuthorizing(logger *logger.ContextLogger, authorizer authorization_service.Authorizer) func(chorus.AppServiceServer) chorus.AppServiceServer {
+func AppAuthorizing(logger *logger.ContextLogger, authorizer authorization_service.Authorizer, resolver AppResolver) func(chorus.AppServiceServer) chorus.AppServiceServer {
 	return func(next chorus.AppServiceServer) chorus.AppServiceServer {
 		return &appControllerAuthorization{
 			Authorization: Authorization{
 				logger:     logger,
 				authorizer: authorizer,
 			},
-			next: next,
+			resolver: resolver,
+			next:     next,
 		}
 	}
 }
 
+// isAuthorizedToGetApp checks the caller can read an app, and for an app of a workspace,
+// list the apps of that workspace.
+func (c appControllerAuthorization) isAuthorizedToGetApp(ctx context.Context, appID uint64) error {
+	err := c.IsAuthorized(ctx, authz.PermGetApp.For())
+	if err != nil {
+		return err
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return cerr.ErrUnauthenticated.WithMessage("Unable to extract tenant ID")
+	}
+
+	app, err := c.resolver.GetApp(ctx, tenantID, appID)
+	if err != nil {
+		return cerr.ErrNotFound.WithMessage("App not found")
+	}
+	if app.WorkspaceID != nil {
+		return c.IsAuthorized(ctx, authz.PermListWorkspaceApps.For(authz.WorkspaceID(*app.WorkspaceID)))
+	}
+
+	return nil
+}
+
 func (c appControllerAuthorization) ListApps(ctx context.Context, req *chorus.ListAppsRequest) (*chorus.ListAppsReply, error) {
 	err := c.IsAuthorized(ctx, authz.PermListApps.For())
 	if err != nil {
@@ -38,7 +71,7 @@ func (c appControllerAuthorization) ListApps(ctx context.Context, req *chorus.Li
 }
 
 func (c appControllerAuthorization) GetApp(ctx context.Context, req *chorus.GetAppRequest) (*chorus.GetAppReply, error) {
-	err := c.IsAuthorized(ctx, authz.PermGetApp.For())
+	err := c.isAuthorizedToGetApp(ctx, req.Id)
 	if err != nil {
 		return nil, err
 	}
@@ -81,3 +114,102 @@ func (c appControllerAuthorization) DeleteApp(ctx context.Context, req *chorus.D
 
 	return c.next.DeleteApp(ctx, req)
 }
+
+func (c appControllerAuthorization) ListWorkspaceApps(ctx context.Context, req *chorus.ListWorkspaceAppsRequest) (*chorus.ListWorkspaceAppsReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermListWorkspaceApps.For(authz.WorkspaceID(req.WorkspaceId)))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.ListWorkspaceApps(ctx, req)
+}
+
+func (c appControllerAuthorization) CreateWorkspaceApp(ctx context.Context, req *chorus.CreateWorkspaceAppRequest) (*chorus.CreateWorkspaceAppReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermManageWorkspaceApps.For(authz.WorkspaceID(req.WorkspaceId)))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.CreateWorkspaceApp(ctx, req)
+}
+
+func (c appControllerAuthorization) DeleteWorkspaceApp(ctx context.Context, req *chorus.DeleteWorkspaceAppRequest) (*chorus.DeleteWorkspaceAppReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermManageWorkspaceApps.For(authz.WorkspaceID(req.WorkspaceId)))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.DeleteWorkspaceApp(ctx, req)
+}
+
+func (c appControllerAuthorization) ListAppVersions(ctx context.Context, req *chorus.ListAppVersionsRequest) (*chorus.ListAppVersionsReply, error) {
+	err := c.isAuthorizedToGetApp(ctx, req.AppId)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.ListAppVersions(ctx, req)
+}
+
+func (c appControllerAuthorization) CreateAppVersion(ctx context.Context, req *chorus.CreateAppVersionRequest) (*chorus.CreateAppVersionReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermUpdateApp.For())
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.CreateAppVersion(ctx, req)
+}
+
+func (c appControllerAuthorization) UpdateAppVersion(ctx context.Context, req *chorus.UpdateAppVersionRequest) (*chorus.UpdateAppVersionReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermUpdateApp.For())
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.UpdateAppVersion(ctx, req)
+}
+
+func (c appControllerAuthorization) GetAppReview(ctx context.Context, req *chorus.GetAppReviewRequest) (*chorus.GetAppReviewReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermGetApp.For())
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.GetAppReview(ctx, req)
+}
+
+func (c appControllerAuthorization) StartAppReview(ctx context.Context, req *chorus.StartAppReviewRequest) (*chorus.StartAppReviewReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermManageAppLifecycle.For())
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.StartAppReview(ctx, req)
+}
+
+func (c appControllerAuthorization) DecideAppReview(ctx context.Context, req *chorus.DecideAppReviewRequest) (*chorus.DecideAppReviewReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermReviewApp.For())
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.DecideAppReview(ctx, req)
+}
+
+func (c appControllerAuthorization) CommentAppReview(ctx context.Context, req *chorus.CommentAppReviewRequest) (*chorus.CommentAppReviewReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermReviewApp.For())
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.CommentAppReview(ctx, req)
+}
+
+func (c appControllerAuthorization) UpdateAppLifecycle(ctx context.Context, req *chorus.UpdateAppLifecycleRequest) (*chorus.UpdateAppLifecycleReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermManageAppLifecycle.For())
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.UpdateAppLifecycle(ctx, req)
+}
@@ -94,6 +94,9 @@ func (c appInstanceControllerAudit) CreateAppInstance(ctx context.Context, req *
 			audit.WithDetail("app_image_registry", ai.AppDockerImageRegistry),
 			audit.WithDetail("app_image_name", ai.AppDockerImageName),
 			audit.WithDetail("app_image_tag", ai.AppDockerImageTag),
+			audit.WithDetail("app_image_digest", ai.AppDockerImageDigest),
+			audit.WithDetail("vulnerability_verdict", ai.VulnerabilityVerdict),
+			audit.WithDetail("lifecycle_warning", ai.LifecycleWarning),
 		)
 	}
 
@@ -126,6 +129,7 @@ func (c appInstanceControllerAudit) UpdateAppInstance(ctx context.Context, req *
 			audit.WithDetail("app_image_registry", ai.AppDockerImageRegistry),
 			audit.WithDetail("app_image_name", ai.AppDockerImageName),
 			audit.WithDetail("app_image_tag", ai.AppDockerImageTag),
+			audit.WithDetail("app_image_digest", ai.AppDockerImageDigest),
 		)
 	}
 
@@ -157,6 +161,7 @@ func (c appInstanceControllerAudit) DeleteAppInstance(ctx context.Context, req *
 			audit.WithDetail("app_image_registry", ai.AppDockerImageRegistry),
 			audit.WithDetail("app_image_name", ai.AppDockerImageName),
 			audit.WithDetail("app_image_tag", ai.AppDockerImageTag),
+			audit.WithDetail("app_image_digest", ai.AppDockerImageDigest),
 		)
 	}
 
@@ -164,3 +169,71 @@ func (c appInstanceControllerAudit) DeleteAppInstance(ctx context.Context, req *
 
 	return res, err
 }
+
+func (c appInstanceControllerAudit) UpgradeAppInstance(ctx context.Context, req *chorus.UpgradeAppInstanceRequest) (*chorus.UpgradeAppInstanceReply, error) {
+	res, err := c.next.UpgradeAppInstance(ctx, req)
+
+	opts := []audit.Option{
+		audit.WithDetail("app_instance_id", req.Id),
+		audit.WithDetail("app_version_id", req.AppVersionId),
+	}
+
+	if err != nil {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Failed to upgrade app instance (ID %d) to version with ID %d.", req.Id, req.AppVersionId)),
+			audit.WithError(err),
+		)
+	} else {
+		ai := res.Result.AppInstance
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Upgraded instance of %q to version %s.", ai.AppName, ai.AppDockerImageTag)),
+			audit.WithWorkspaceID(ai.WorkspaceId),
+			audit.WithWorkbenchID(ai.WorkbenchId),
+			audit.WithDetail("app_id", ai.AppId),
+			audit.WithDetail("app_name", ai.AppName),
+			audit.WithDetail("app_image_registry", ai.AppDockerImageRegistry),
+			audit.WithDetail("app_image_name", ai.AppDockerImageName),
+			audit.WithDetail("app_image_tag", ai.AppDockerImageTag),
+			audit.WithDetail("app_image_digest", ai.AppDockerImageDigest),
+			audit.WithDetail("vulnerability_verdict", ai.VulnerabilityVerdict),
+			audit.WithDetail("lifecycle_warning", ai.LifecycleWarning),
+		)
+	}
+
+	audit.Record(ctx, c.auditWriter, model.AuditActionAppInstanceUpgrade, opts...)
+
+	return res, err
+}
+
+func (c appInstanceControllerAudit) GetAppInstanceLogs(ctx context.Context, req *chorus.GetAppInstanceLogsRequest) (*chorus.GetAppInstanceLogsReply, error) {
+	res, err := c.next.GetAppInstanceLogs(ctx, req)
+
+	opts := []audit.Option{
+		audit.WithDetail("app_instance_id", req.Id),
+		audit.WithDetail("previous", req.Previous),
+	}
+
+	if err != nil {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Failed to get logs of app instance with ID %d.", req.Id)),
+			audit.WithError(err),
+		)
+	} else {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Retrieved logs of app instance with ID %d.", req.Id)),
+			audit.WithDetail("pod_name", res.Result.Logs.PodName),
+		)
+	}
+
+	audit.Record(ctx, c.auditWriter, model.AuditActionAppInstanceLogsRead, opts...)
+
+	return res, err
+}
+
+func (c appInstanceControllerAudit) ListAppInstanceEvents(ctx context.Context, req *chorus.ListAppInstanceEventsRequest) (*chorus.ListAppInstanceEventsReply, error) {
+	return c.next.ListAppInstanceEvents(ctx, req)
+}
+
+func (c appInstanceControllerAudit) GetAppInstanceUsage(ctx context.Context, req *chorus.GetAppInstanceUsageRequest) (*chorus.GetAppInstanceUsageReply, error) {
+	return c.next.GetAppInstanceUsage(ctx, req)
+}
@@ -145,3 +145,61 @@ func (c appInstanceControllerAuthorization) DeleteAppInstance(ctx context.Contex
 
 	return c.next.DeleteAppInstance(ctx, req)
 }
+
+func (c appInstanceControllerAuthorization) UpgradeAppInstance(ctx context.Context, req *chorus.UpgradeAppInstanceRequest) (*chorus.UpgradeAppInstanceReply, error) {
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	appInstance, err := c.resolver.GetAppInstance(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "unable to resolve app instance %v: %v", req.Id, err)
+	}
+
+	err = c.IsAuthorized(ctx, authz.PermUpdateAppInstance.For(authz.WorkbenchID(appInstance.WorkbenchID)))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.UpgradeAppInstance(ctx, req)
+}
+
+func (c appInstanceControllerAuthorization) GetAppInstanceLogs(ctx context.Context, req *chorus.GetAppInstanceLogsRequest) (*chorus.GetAppInstanceLogsReply, error) {
+	if err := c.authorizeAppInstanceRead(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	return c.next.GetAppInstanceLogs(ctx, req)
+}
+
+func (c appInstanceControllerAuthorization) ListAppInstanceEvents(ctx context.Context, req *chorus.ListAppInstanceEventsRequest) (*chorus.ListAppInstanceEventsReply, error) {
+	if err := c.authorizeAppInstanceRead(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	return c.next.ListAppInstanceEvents(ctx, req)
+}
+
+func (c appInstanceControllerAuthorization) GetAppInstanceUsage(ctx context.Context, req *chorus.GetAppInstanceUsageRequest) (*chorus.GetAppInstanceUsageReply, error) {
+	if err := c.authorizeAppInstanceRead(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	return c.next.GetAppInstanceUsage(ctx, req)
+}
+
+// authorizeAppInstanceRead checks that the caller can read the workbench of an app instance.
+func (c appInstanceControllerAuthorization) authorizeAppInstanceRead(ctx context.Context, appInstanceID uint64) error {
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	appInstance, err := c.resolver.GetAppInstance(ctx, tenantID, appInstanceID)
+	if err != nil {
+		return status.Errorf(codes.NotFound, "unable to resolve app instance %v: %v", appInstanceID, err)
+	}
+
+	return c.IsAuthorized(ctx, authz.PermGetAppInstance.For(authz.WorkbenchID(appInstance.WorkbenchID)))
+}
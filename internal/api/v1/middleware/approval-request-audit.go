@@ -141,6 +141,33 @@ func (c approvalRequestControllerAudit) CreateDataTransferRequest(ctx context.Co
 	return res, err
 }
 
+func (c approvalRequestControllerAudit) CreateWorkspaceAccessRequest(ctx context.Context, req *chorus.CreateWorkspaceAccessRequestRequest) (*chorus.CreateWorkspaceAccessRequestReply, error) {
+	res, err := c.next.CreateWorkspaceAccessRequest(ctx, req)
+
+	opts := []audit.Option{
+		audit.WithWorkspaceID(req.WorkspaceId),
+		audit.WithDetail("workspace_id", req.WorkspaceId),
+		audit.WithDetail("role", req.RoleName),
+		audit.WithDetail("justification", req.Justification),
+	}
+
+	if err != nil {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Failed to request access to workspace %d with role %s.", req.WorkspaceId, req.RoleName)),
+			audit.WithError(err),
+		)
+	} else {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Requested access to workspace %d with role %s (request ID %d).", req.WorkspaceId, req.RoleName, res.Result.ApprovalRequest.Id)),
+			audit.WithDetail("approval_request_id", res.Result.ApprovalRequest.Id),
+		)
+	}
+
+	audit.Record(ctx, c.auditWriter, model.AuditActionWorkspaceAccessRequestCreate, opts...)
+
+	return res, err
+}
+
 func (c approvalRequestControllerAudit) ApproveApprovalRequest(ctx context.Context, req *chorus.ApproveApprovalRequestRequest) (*chorus.ApproveApprovalRequestReply, error) {
 	res, err := c.next.ApproveApprovalRequest(ctx, req)
 
@@ -160,6 +187,30 @@ func (c approvalRequestControllerAudit) ApproveApprovalRequest(ctx context.Conte
 			audit.WithDetail("requester_id", res.Result.ApprovalRequest.RequesterId),
 			audit.WithDetail("approval_request_type", res.Result.ApprovalRequest.Type),
 		)
+		if access := res.Result.ApprovalRequest.GetWorkspaceAccess(); access != nil {
+			opts = append(opts,
+				audit.WithWorkspaceID(access.WorkspaceId),
+				audit.WithUserID(res.Result.ApprovalRequest.RequesterId),
+				audit.WithDetail("workspace_id", access.WorkspaceId),
+				audit.WithDetail("role", access.RoleName),
+			)
+		}
+		if change := res.Result.ApprovalRequest.GetNetworkPolicyChange(); change != nil {
+			opts = append(opts,
+				audit.WithWorkspaceID(change.WorkspaceId),
+				audit.WithDetail("workspace_id", change.WorkspaceId),
+				audit.WithDetail("network_policy", change.NetworkPolicy),
+				audit.WithDetail("allowed_fqdns", fqdnRulePatterns(change.AllowedFQDNs, nil)),
+			)
+		}
+		if customApp := res.Result.ApprovalRequest.GetCustomApp(); customApp != nil {
+			opts = append(opts,
+				audit.WithWorkspaceID(customApp.WorkspaceId),
+				audit.WithDetail("workspace_id", customApp.WorkspaceId),
+				audit.WithDetail("app_id", customApp.AppId),
+				audit.WithDetail("image", customApp.Image),
+			)
+		}
 	}
 
 	audit.Record(ctx, c.auditWriter, model.AuditActionApprovalRequestApprove, opts...)
@@ -64,13 +64,8 @@ func (c approvalRequestControllerAuthorization) GetApprovalRequest(ctx context.C
 
 	// Workspace admins with PermissionGetRequest on any involved workspace can also view it.
 	hasWorkspaceAccess := func() bool {
-		sourceWorkspaceID := approvalRequest.GetSourceWorkspaceID()
-		if c.IsAuthorized(ctx, authz.PermGetRequest.For(authz.WorkspaceID(sourceWorkspaceID))) == nil {
-			return true
-		}
-		if approvalRequest.Type == approval_request_model.ApprovalRequestTypeDataTransfer {
-			destinationWorkspaceID := approvalRequest.Details.DataTransferDetails.DestinationWorkspaceID
-			if c.IsAuthorized(ctx, authz.PermGetRequest.For(authz.WorkspaceID(destinationWorkspaceID))) == nil {
+		for _, workspaceID := range approvalRequest.GetWorkspaceIDs() {
+			if c.IsAuthorized(ctx, authz.PermGetRequest.For(authz.WorkspaceID(workspaceID))) == nil {
 				return true
 			}
 		}
@@ -147,6 +142,19 @@ func (c approvalRequestControllerAuthorization) CreateDataTransferRequest(ctx co
 	return c.next.CreateDataTransferRequest(ctx, req)
 }
 
+func (c approvalRequestControllerAuthorization) CreateWorkspaceAccessRequest(ctx context.Context, req *chorus.CreateWorkspaceAccessRequestRequest) (*chorus.CreateWorkspaceAccessRequestReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermRequestWorkspaceAccess.For())
+	if err != nil {
+		return nil, err
+	}
+
+	if !c.IsRoleInScope(authz.RoleName(req.RoleName), authz.RoleScopeWorkspace) {
+		return nil, cerr.ErrInvalidRequest.WithMessage(fmt.Sprintf("Role %q is not a valid workspace role", req.RoleName))
+	}
+
+	return c.next.CreateWorkspaceAccessRequest(ctx, req)
+}
+
 func (c approvalRequestControllerAuthorization) ApproveApprovalRequest(ctx context.Context, req *chorus.ApproveApprovalRequestRequest) (*chorus.ApproveApprovalRequestReply, error) {
 	tenantID, err := jwt_model.ExtractTenantID(ctx)
 	if err != nil {
@@ -182,6 +190,24 @@ func (c approvalRequestControllerAuthorization) ApproveApprovalRequest(ctx conte
 				canApprove = true
 			}
 		}
+	case approval_request_model.ApprovalRequestTypeWorkspaceAccess:
+		// The contact user of the workspace may not hold any permission in it, so
+		// access requests are approved by their designated approvers. The roles
+		// they grant are limited to the configured workspace_access_roles.
+		userID, err := jwt_model.ExtractUserID(ctx)
+		if err != nil {
+			return nil, cerr.ErrUnauthenticated.WithMessage("Unable to extract user ID")
+		}
+		canApprove = approvalRequest.CanBeApprovedBy(userID)
+	case approval_request_model.ApprovalRequestTypeNetworkPolicyChange:
+		workspaceID := approvalRequest.Details.NetworkPolicyChangeDetails.WorkspaceID
+		if err := c.IsAuthorized(ctx, authz.PermApproveNetworkPolicyChange.For(authz.WorkspaceID(workspaceID))); err == nil {
+			canApprove = true
+		}
+	case approval_request_model.ApprovalRequestTypeCustomApp:
+		if err := c.IsAuthorized(ctx, authz.PermApproveCustomApp.For()); err == nil {
+			canApprove = true
+		}
 	default:
 		return nil, cerr.ErrInternal.WithMessage("Unable to determine source workspace for approval request")
 	}
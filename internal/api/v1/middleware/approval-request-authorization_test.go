@@ -0,0 +1,75 @@
+//go:build unit
+
+package middleware
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
+	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	jwt_model "github.com/CHORUS-TRE/chorus-backend/internal/jwt/model"
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	approval_request_model "github.com/CHORUS-TRE/chorus-backend/pkg/approval-request/model"
+	authz "github.com/CHORUS-TRE/chorus-backend/pkg/authorization/model"
+)
+
+type approvalRequestResolver struct {
+	request *approval_request_model.ApprovalRequest
+}
+
+func (r *approvalRequestResolver) GetApprovalRequest(context.Context, uint64, uint64) (*approval_request_model.ApprovalRequest, error) {
+	return r.request, nil
+}
+
+type approveServer struct {
+	chorus.UnimplementedApprovalRequestServiceServer
+	called bool
+}
+
+func (s *approveServer) ApproveApprovalRequest(context.Context, *chorus.ApproveApprovalRequestRequest) (*chorus.ApproveApprovalRequestReply, error) {
+	s.called = true
+	return &chorus.ApproveApprovalRequestReply{}, nil
+}
+
+func TestApprovalRequestAuthorizingLetsContactUserApproveWorkspaceAccess(t *testing.T) {
+	const contactUserID = 42
+	resolver := &approvalRequestResolver{request: &approval_request_model.ApprovalRequest{
+		ID:          3,
+		TenantID:    1,
+		RequesterID: 7,
+		Type:        approval_request_model.ApprovalRequestTypeWorkspaceAccess,
+		Status:      approval_request_model.ApprovalRequestStatusPending,
+		Details: approval_request_model.ApprovalRequestDetails{
+			WorkspaceAccessDetails: &approval_request_model.WorkspaceAccessDetails{WorkspaceID: 5, Role: authz.RoleWorkspaceMember.Name},
+		},
+		ApproverIDsByStep: map[approval_request_model.ApprovalStep][]uint64{
+			approval_request_model.StepAccess: {contactUserID},
+		},
+	}}
+
+	// The contact user holds no role in the workspace.
+	next := &approveServer{}
+	server := ApprovalRequestAuthorizing(logger.NewNop(), nil, config.Config{}, nil, resolver)(next)
+
+	ctx := context.WithValue(context.Background(), jwt_model.JWTClaimsContextKey, &jwt_model.JWTClaims{ID: contactUserID, TenantID: 1})
+	if _, err := server.ApproveApprovalRequest(ctx, &chorus.ApproveApprovalRequestRequest{Id: 3, Approve: true}); err != nil {
+		t.Fatalf("ApproveApprovalRequest() returned error: %v", err)
+	}
+	if !next.called {
+		t.Fatal("ApproveApprovalRequest() did not call next server")
+	}
+
+	next.called = false
+	ctx = context.WithValue(context.Background(), jwt_model.JWTClaimsContextKey, &jwt_model.JWTClaims{ID: 43, TenantID: 1})
+	_, err := server.ApproveApprovalRequest(ctx, &chorus.ApproveApprovalRequestRequest{Id: 3, Approve: true})
+	var cErr *cerr.ChorusError
+	if !errors.As(err, &cErr) || cErr.ChorusCode != cerr.ErrPermissionDenied.ChorusCode {
+		t.Fatalf("expected permission denied for a user who is not an approver, got %v", err)
+	}
+	if next.called {
+		t.Fatal("ApproveApprovalRequest() called next server for a user who is not an approver")
+	}
+}
@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
 	"github.com/CHORUS-TRE/chorus-backend/internal/audit"
@@ -50,3 +51,17 @@ func (c platformSettingsControllerAudit) UpdatePlatformSettings(ctx context.Cont
 
 	return res, err
 }
+
+func (c platformSettingsControllerAudit) GetUserUsage(ctx context.Context, req *chorus.GetUserUsageRequest) (*chorus.GetUserUsageReply, error) {
+	res, err := c.next.GetUserUsage(ctx, req)
+
+	if err != nil {
+		audit.Record(ctx, c.auditWriter, model.AuditActionUserUsageRead,
+			audit.WithUserID(req.UserId),
+			audit.WithDescription(fmt.Sprintf("Failed to get usage of user with ID %d.", req.UserId)),
+			audit.WithError(err),
+		)
+	}
+
+	return res, err
+}
@@ -40,3 +40,12 @@ func (c platformSettingsControllerAuthorization) UpdatePlatformSettings(ctx cont
 
 	return c.next.UpdatePlatformSettings(ctx, req)
 }
+
+func (c platformSettingsControllerAuthorization) GetUserUsage(ctx context.Context, req *chorus.GetUserUsageRequest) (*chorus.GetUserUsageReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermGetUserUsage.For(authz.UserID(req.UserId)))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.GetUserUsage(ctx, req)
+}
@@ -0,0 +1,125 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
+	"github.com/CHORUS-TRE/chorus-backend/internal/audit"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/audit/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/audit/service"
+)
+
+var _ chorus.ServiceDefinitionServiceServer = (*serviceDefinitionControllerAudit)(nil)
+
+type serviceDefinitionControllerAudit struct {
+	next        chorus.ServiceDefinitionServiceServer
+	auditWriter service.AuditWriter
+}
+
+func NewServiceDefinitionAuditMiddleware(auditWriter service.AuditWriter) func(chorus.ServiceDefinitionServiceServer) chorus.ServiceDefinitionServiceServer {
+	return func(next chorus.ServiceDefinitionServiceServer) chorus.ServiceDefinitionServiceServer {
+		return &serviceDefinitionControllerAudit{
+			next:        next,
+			auditWriter: auditWriter,
+		}
+	}
+}
+
+func (c serviceDefinitionControllerAudit) GetServiceDefinition(ctx context.Context, req *chorus.GetServiceDefinitionRequest) (*chorus.GetServiceDefinitionReply, error) {
+	res, err := c.next.GetServiceDefinition(ctx, req)
+
+	if err != nil {
+		audit.Record(ctx, c.auditWriter, model.AuditActionServiceDefinitionRead,
+			audit.WithDetail("service_definition_id", req.Id),
+			audit.WithDescription(fmt.Sprintf("Failed to get service definition %d.", req.Id)),
+			audit.WithError(err),
+		)
+	}
+
+	return res, err
+}
+
+func (c serviceDefinitionControllerAudit) ListServiceDefinitions(ctx context.Context, req *chorus.ListServiceDefinitionsRequest) (*chorus.ListServiceDefinitionsReply, error) {
+	res, err := c.next.ListServiceDefinitions(ctx, req)
+
+	if err != nil {
+		audit.Record(ctx, c.auditWriter, model.AuditActionServiceDefinitionList,
+			audit.WithDescription("Failed to list service definitions."),
+			audit.WithError(err),
+		)
+	}
+
+	return res, err
+}
+
+func (c serviceDefinitionControllerAudit) CreateServiceDefinition(ctx context.Context, req *chorus.ServiceDefinition) (*chorus.CreateServiceDefinitionReply, error) {
+	res, err := c.next.CreateServiceDefinition(ctx, req)
+
+	opts := []audit.Option{
+		audit.WithDetail("service_definition_name", req.Name),
+	}
+
+	if err != nil {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Failed to create service definition %q.", req.Name)),
+			audit.WithError(err),
+		)
+	} else {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Created service definition %q (ID %d).", req.Name, res.Result.ServiceDefinition.Id)),
+			audit.WithDetail("service_definition_id", res.Result.ServiceDefinition.Id),
+		)
+	}
+
+	audit.Record(ctx, c.auditWriter, model.AuditActionServiceDefinitionCreate, opts...)
+
+	return res, err
+}
+
+func (c serviceDefinitionControllerAudit) UpdateServiceDefinition(ctx context.Context, req *chorus.ServiceDefinition) (*chorus.UpdateServiceDefinitionReply, error) {
+	res, err := c.next.UpdateServiceDefinition(ctx, req)
+
+	opts := []audit.Option{
+		audit.WithDetail("service_definition_id", req.Id),
+		audit.WithDetail("service_definition_name", req.Name),
+	}
+
+	if err != nil {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Failed to update service definition %q (ID %d).", req.Name, req.Id)),
+			audit.WithError(err),
+		)
+	} else {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Updated service definition %q (ID %d).", req.Name, req.Id)),
+		)
+	}
+
+	audit.Record(ctx, c.auditWriter, model.AuditActionServiceDefinitionUpdate, opts...)
+
+	return res, err
+}
+
+func (c serviceDefinitionControllerAudit) DeleteServiceDefinition(ctx context.Context, req *chorus.DeleteServiceDefinitionRequest) (*chorus.DeleteServiceDefinitionReply, error) {
+	res, err := c.next.DeleteServiceDefinition(ctx, req)
+
+	opts := []audit.Option{
+		audit.WithDetail("service_definition_id", req.Id),
+	}
+
+	if err != nil {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Failed to delete service definition %d.", req.Id)),
+			audit.WithError(err),
+		)
+	} else {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Deleted service definition %d.", req.Id)),
+		)
+	}
+
+	audit.Record(ctx, c.auditWriter, model.AuditActionServiceDefinitionDelete, opts...)
+
+	return res, err
+}
@@ -0,0 +1,77 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
+	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	authz "github.com/CHORUS-TRE/chorus-backend/pkg/authorization/model"
+	authorization_service "github.com/CHORUS-TRE/chorus-backend/pkg/authorization/service"
+)
+
+var _ chorus.ServiceDefinitionServiceServer = (*serviceDefinitionControllerAuthorization)(nil)
+
+type serviceDefinitionControllerAuthorization struct {
+	Authorization
+	next chorus.ServiceDefinitionServiceServer
+}
+
+func ServiceDefinitionAuthorizing(logger *logger.ContextLogger, authorizer authorization_service.Authorizer, cfg config.Config, refresher Refresher) func(chorus.ServiceDefinitionServiceServer) chorus.ServiceDefinitionServiceServer {
+	return func(next chorus.ServiceDefinitionServiceServer) chorus.ServiceDefinitionServiceServer {
+		return &serviceDefinitionControllerAuthorization{
+			Authorization: Authorization{
+				logger:     logger,
+				authorizer: authorizer,
+				cfg:        cfg,
+				refresher:  refresher,
+			},
+			next: next,
+		}
+	}
+}
+
+func (c serviceDefinitionControllerAuthorization) ListServiceDefinitions(ctx context.Context, req *chorus.ListServiceDefinitionsRequest) (*chorus.ListServiceDefinitionsReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermListServiceDefinitions.For())
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.ListServiceDefinitions(ctx, req)
+}
+
+func (c serviceDefinitionControllerAuthorization) GetServiceDefinition(ctx context.Context, req *chorus.GetServiceDefinitionRequest) (*chorus.GetServiceDefinitionReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermGetServiceDefinition.For())
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.GetServiceDefinition(ctx, req)
+}
+
+func (c serviceDefinitionControllerAuthorization) CreateServiceDefinition(ctx context.Context, req *chorus.ServiceDefinition) (*chorus.CreateServiceDefinitionReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermCreateServiceDefinition.For())
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.CreateServiceDefinition(ctx, req)
+}
+
+func (c serviceDefinitionControllerAuthorization) UpdateServiceDefinition(ctx context.Context, req *chorus.ServiceDefinition) (*chorus.UpdateServiceDefinitionReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermUpdateServiceDefinition.For())
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.UpdateServiceDefinition(ctx, req)
+}
+
+func (c serviceDefinitionControllerAuthorization) DeleteServiceDefinition(ctx context.Context, req *chorus.DeleteServiceDefinitionRequest) (*chorus.DeleteServiceDefinitionReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermDeleteServiceDefinition.For())
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.DeleteServiceDefinition(ctx, req)
+}
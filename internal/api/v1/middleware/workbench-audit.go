@@ -3,6 +3,7 @@ package middleware
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
 	"github.com/CHORUS-TRE/chorus-backend/internal/audit"
@@ -199,3 +200,293 @@ func (c workbenchControllerAudit) RemoveUserFromWorkbench(ctx context.Context, r
 
 	return res, err
 }
+
+func (c workbenchControllerAudit) GetWorkbenchSchedule(ctx context.Context, req *chorus.GetWorkbenchScheduleRequest) (*chorus.GetWorkbenchScheduleReply, error) {
+	return c.next.GetWorkbenchSchedule(ctx, req)
+}
+
+func (c workbenchControllerAudit) SetWorkbenchSchedule(ctx context.Context, req *chorus.SetWorkbenchScheduleRequest) (*chorus.SetWorkbenchScheduleReply, error) {
+	res, err := c.next.SetWorkbenchSchedule(ctx, req)
+
+	opts := []audit.Option{
+		audit.WithWorkbenchID(req.Id),
+		audit.WithDetail("workbench_id", req.Id),
+	}
+	if req.Schedule != nil {
+		opts = append(opts,
+			audit.WithDetail("timezone", req.Schedule.Timezone),
+			audit.WithDetail("weekdays", req.Schedule.Weekdays),
+			audit.WithDetail("start_time", req.Schedule.StartTime),
+			audit.WithDetail("stop_time", req.Schedule.StopTime),
+		)
+	}
+
+	if err != nil {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Failed to set schedule of session %d.", req.Id)),
+			audit.WithError(err),
+		)
+	} else {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Set schedule of session %d to %s-%s on %v (%s).", req.Id, req.Schedule.StartTime, req.Schedule.StopTime, req.Schedule.Weekdays, req.Schedule.Timezone)),
+		)
+	}
+
+	audit.Record(ctx, c.auditWriter, model.AuditActionWorkbenchScheduleSet, opts...)
+
+	return res, err
+}
+
+func (c workbenchControllerAudit) DeleteWorkbenchSchedule(ctx context.Context, req *chorus.DeleteWorkbenchScheduleRequest) (*chorus.DeleteWorkbenchScheduleReply, error) {
+	res, err := c.next.DeleteWorkbenchSchedule(ctx, req)
+
+	opts := []audit.Option{
+		audit.WithWorkbenchID(req.Id),
+		audit.WithDetail("workbench_id", req.Id),
+	}
+
+	if err != nil {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Failed to delete schedule of session %d.", req.Id)),
+			audit.WithError(err),
+		)
+	} else {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Deleted schedule of session %d.", req.Id)),
+		)
+	}
+
+	audit.Record(ctx, c.auditWriter, model.AuditActionWorkbenchScheduleDelete, opts...)
+
+	return res, err
+}
+
+func (c workbenchControllerAudit) KeepWorkbenchAlive(ctx context.Context, req *chorus.KeepWorkbenchAliveRequest) (*chorus.KeepWorkbenchAliveReply, error) {
+	res, err := c.next.KeepWorkbenchAlive(ctx, req)
+
+	opts := []audit.Option{
+		audit.WithWorkbenchID(req.Id),
+		audit.WithDetail("workbench_id", req.Id),
+	}
+
+	if err != nil {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Failed to keep session %d alive.", req.Id)),
+			audit.WithError(err),
+		)
+	} else {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Kept session %d alive.", req.Id)),
+		)
+	}
+
+	audit.Record(ctx, c.auditWriter, model.AuditActionWorkbenchKeepAlive, opts...)
+
+	return res, err
+}
+
+func (c workbenchControllerAudit) HibernateWorkbench(ctx context.Context, req *chorus.HibernateWorkbenchRequest) (*chorus.HibernateWorkbenchReply, error) {
+	res, err := c.next.HibernateWorkbench(ctx, req)
+
+	opts := []audit.Option{
+		audit.WithWorkbenchID(req.Id),
+		audit.WithDetail("workbench_id", req.Id),
+	}
+
+	if err != nil {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Failed to hibernate session %d.", req.Id)),
+			audit.WithError(err),
+		)
+	} else {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Hibernated session %q (ID %d) in workspace %d.", res.Result.Workbench.Name, res.Result.Workbench.Id, res.Result.Workbench.WorkspaceId)),
+			audit.WithWorkspaceID(res.Result.Workbench.WorkspaceId),
+			audit.WithDetail("workspace_id", res.Result.Workbench.WorkspaceId),
+		)
+	}
+
+	audit.Record(ctx, c.auditWriter, model.AuditActionWorkbenchHibernate, opts...)
+
+	return res, err
+}
+
+func (c workbenchControllerAudit) ResumeWorkbench(ctx context.Context, req *chorus.ResumeWorkbenchRequest) (*chorus.ResumeWorkbenchReply, error) {
+	res, err := c.next.ResumeWorkbench(ctx, req)
+
+	opts := []audit.Option{
+		audit.WithWorkbenchID(req.Id),
+		audit.WithDetail("workbench_id", req.Id),
+	}
+
+	if err != nil {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Failed to resume session %d.", req.Id)),
+			audit.WithError(err),
+		)
+	} else {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Resumed session %q (ID %d) in workspace %d.", res.Result.Workbench.Name, res.Result.Workbench.Id, res.Result.Workbench.WorkspaceId)),
+			audit.WithWorkspaceID(res.Result.Workbench.WorkspaceId),
+			audit.WithDetail("workspace_id", res.Result.Workbench.WorkspaceId),
+		)
+	}
+
+	audit.Record(ctx, c.auditWriter, model.AuditActionWorkbenchResume, opts...)
+
+	return res, err
+}
+
+func (c workbenchControllerAudit) CreateWorkbenchShareGrant(ctx context.Context, req *chorus.CreateWorkbenchShareGrantRequest) (*chorus.CreateWorkbenchShareGrantReply, error) {
+	res, err := c.next.CreateWorkbenchShareGrant(ctx, req)
+
+	opts := []audit.Option{
+		audit.WithWorkbenchID(req.Id),
+		audit.WithDetail("workbench_id", req.Id),
+		audit.WithDetail("mode", req.Mode),
+		audit.WithDetail("expires_at", req.ExpiresAt.AsTime()),
+	}
+	grantee := fmt.Sprintf("user %d", req.UserId)
+	if req.UserId != 0 {
+		opts = append(opts, audit.WithUserID(req.UserId), audit.WithDetail("user_id", req.UserId))
+	} else {
+		grantee = fmt.Sprintf("the members of workspace %d", req.WorkspaceId)
+		opts = append(opts, audit.WithDetail("grantee_workspace_id", req.WorkspaceId))
+	}
+
+	if err != nil {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Failed to share session %d with %s.", req.Id, grantee)),
+			audit.WithError(err),
+		)
+	} else {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Shared session %d with %s in %s mode until %s.", req.Id, grantee, req.Mode, req.ExpiresAt.AsTime().Format(time.RFC3339))),
+			audit.WithDetail("grant_id", res.Result.Grant.Id),
+		)
+	}
+
+	audit.Record(ctx, c.auditWriter, model.AuditActionWorkbenchShareGrantCreate, opts...)
+
+	return res, err
+}
+
+func (c workbenchControllerAudit) ListWorkbenchShareGrants(ctx context.Context, req *chorus.ListWorkbenchShareGrantsRequest) (*chorus.ListWorkbenchShareGrantsReply, error) {
+	return c.next.ListWorkbenchShareGrants(ctx, req)
+}
+
+func (c workbenchControllerAudit) RevokeWorkbenchShareGrant(ctx context.Context, req *chorus.RevokeWorkbenchShareGrantRequest) (*chorus.RevokeWorkbenchShareGrantReply, error) {
+	res, err := c.next.RevokeWorkbenchShareGrant(ctx, req)
+
+	opts := []audit.Option{
+		audit.WithWorkbenchID(req.Id),
+		audit.WithDetail("workbench_id", req.Id),
+		audit.WithDetail("grant_id", req.GrantId),
+	}
+
+	if err != nil {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Failed to revoke share grant %d of session %d.", req.GrantId, req.Id)),
+			audit.WithError(err),
+		)
+	} else {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Revoked share grant %d of session %d.", req.GrantId, req.Id)),
+		)
+	}
+
+	audit.Record(ctx, c.auditWriter, model.AuditActionWorkbenchShareGrantRevoke, opts...)
+
+	return res, err
+}
+
+func (c workbenchControllerAudit) WatchWorkbench(req *chorus.WatchWorkbenchRequest, stream chorus.WorkbenchService_WatchWorkbenchServer) error {
+	return c.next.WatchWorkbench(req, stream)
+}
+
+func (c workbenchControllerAudit) WatchWorkspace(req *chorus.WatchWorkspaceRequest, stream chorus.WorkbenchService_WatchWorkspaceServer) error {
+	return c.next.WatchWorkspace(req, stream)
+}
+
+func (c workbenchControllerAudit) GetWorkbenchServerLogs(ctx context.Context, req *chorus.GetWorkbenchServerLogsRequest) (*chorus.GetWorkbenchServerLogsReply, error) {
+	res, err := c.next.GetWorkbenchServerLogs(ctx, req)
+
+	opts := []audit.Option{
+		audit.WithWorkbenchID(req.Id),
+		audit.WithDetail("workbench_id", req.Id),
+		audit.WithDetail("previous", req.Previous),
+	}
+
+	if err != nil {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Failed to get server logs of session %d.", req.Id)),
+			audit.WithError(err),
+		)
+	} else {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Retrieved server logs of session %d.", req.Id)),
+			audit.WithDetail("pod_name", res.Result.Logs.PodName),
+		)
+	}
+
+	audit.Record(ctx, c.auditWriter, model.AuditActionWorkbenchLogsRead, opts...)
+
+	return res, err
+}
+
+func (c workbenchControllerAudit) ListWorkbenchServerEvents(ctx context.Context, req *chorus.ListWorkbenchServerEventsRequest) (*chorus.ListWorkbenchServerEventsReply, error) {
+	return c.next.ListWorkbenchServerEvents(ctx, req)
+}
+
+func (c workbenchControllerAudit) GetWorkbenchUsage(ctx context.Context, req *chorus.GetWorkbenchUsageRequest) (*chorus.GetWorkbenchUsageReply, error) {
+	return c.next.GetWorkbenchUsage(ctx, req)
+}
+
+func (c workbenchControllerAudit) GetWorkspaceUsage(ctx context.Context, req *chorus.GetWorkspaceUsageRequest) (*chorus.GetWorkspaceUsageReply, error) {
+	return c.next.GetWorkspaceUsage(ctx, req)
+}
+
+func (c workbenchControllerAudit) ListWorkbenchConnections(ctx context.Context, req *chorus.ListWorkbenchConnectionsRequest) (*chorus.ListWorkbenchConnectionsReply, error) {
+	res, err := c.next.ListWorkbenchConnections(ctx, req)
+
+	if err != nil {
+		audit.Record(ctx, c.auditWriter, model.AuditActionWorkbenchConnectionList,
+			audit.WithWorkbenchID(req.Id),
+			audit.WithDetail("workbench_id", req.Id),
+			audit.WithDescription(fmt.Sprintf("Failed to list connections to session %d.", req.Id)),
+			audit.WithError(err),
+		)
+	}
+
+	return res, err
+}
+
+func (c workbenchControllerAudit) ListWorkspaceWorkbenchConnections(ctx context.Context, req *chorus.ListWorkbenchConnectionsRequest) (*chorus.ListWorkbenchConnectionsReply, error) {
+	res, err := c.next.ListWorkspaceWorkbenchConnections(ctx, req)
+
+	if err != nil {
+		audit.Record(ctx, c.auditWriter, model.AuditActionWorkbenchConnectionList,
+			audit.WithWorkspaceID(req.Id),
+			audit.WithDetail("workspace_id", req.Id),
+			audit.WithDescription(fmt.Sprintf("Failed to list session connections in workspace %d.", req.Id)),
+			audit.WithError(err),
+		)
+	}
+
+	return res, err
+}
+
+func (c workbenchControllerAudit) ListUserWorkbenchConnections(ctx context.Context, req *chorus.ListWorkbenchConnectionsRequest) (*chorus.ListWorkbenchConnectionsReply, error) {
+	res, err := c.next.ListUserWorkbenchConnections(ctx, req)
+
+	if err != nil {
+		audit.Record(ctx, c.auditWriter, model.AuditActionWorkbenchConnectionList,
+			audit.WithUserID(req.Id),
+			audit.WithDetail("user_id", req.Id),
+			audit.WithDescription(fmt.Sprintf("Failed to list session connections of user %d.", req.Id)),
+			audit.WithError(err),
+		)
+	}
+
+	return res, err
+}
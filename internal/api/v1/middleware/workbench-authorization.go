@@ -7,19 +7,27 @@ import (
 
 	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
 	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	jwt_model "github.com/CHORUS-TRE/chorus-backend/internal/jwt/model"
 	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
 	authz "github.com/CHORUS-TRE/chorus-backend/pkg/authorization/model"
 	authorization_service "github.com/CHORUS-TRE/chorus-backend/pkg/authorization/service"
+	workbench_model "github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
 )
 
 var _ chorus.WorkbenchServiceServer = (*workbenchControllerAuthorization)(nil)
 
+type WorkbenchShareResolver interface {
+	ListWorkbenchShareGrantees(ctx context.Context, grant *workbench_model.WorkbenchShareGrant) ([]uint64, error)
+}
+
 type workbenchControllerAuthorization struct {
 	Authorization
-	next chorus.WorkbenchServiceServer
+	resolver WorkbenchShareResolver
+	next     chorus.WorkbenchServiceServer
 }
 
-func WorkbenchAuthorizing(logger *logger.ContextLogger, authorizer authorization_service.Authorizer, cfg config.Config, refresher Refresher) func(chorus.WorkbenchServiceServer) chorus.WorkbenchServiceServer {
+func WorkbenchAuthorizing(logger *logger.ContextLogger, authorizer authorization_service.Authorizer, cfg config.Config, refresher Refresher, resolver WorkbenchShareResolver) func(chorus.WorkbenchServiceServer) chorus.WorkbenchServiceServer {
 	return func(next chorus.WorkbenchServiceServer) chorus.WorkbenchServiceServer {
 		return &workbenchControllerAuthorization{
 			Authorization: Authorization{
@@ -28,7 +36,8 @@ func WorkbenchAuthorizing(logger *logger.ContextLogger, authorizer authorization
 				cfg:        cfg,
 				refresher:  refresher,
 			},
-			next: next,
+			resolver: resolver,
+			next:     next,
 		}
 	}
 }
@@ -155,3 +164,206 @@ func (c workbenchControllerAuthorization) RemoveUserFromWorkbench(ctx context.Co
 
 	return c.next.RemoveUserFromWorkbench(ctx, req)
 }
+
+func (c workbenchControllerAuthorization) GetWorkbenchSchedule(ctx context.Context, req *chorus.GetWorkbenchScheduleRequest) (*chorus.GetWorkbenchScheduleReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermGetWorkbench.For(authz.WorkbenchID(req.Id)))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.GetWorkbenchSchedule(ctx, req)
+}
+
+func (c workbenchControllerAuthorization) SetWorkbenchSchedule(ctx context.Context, req *chorus.SetWorkbenchScheduleRequest) (*chorus.SetWorkbenchScheduleReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermManageWorkbenchSchedule.For(authz.WorkbenchID(req.Id)))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.SetWorkbenchSchedule(ctx, req)
+}
+
+func (c workbenchControllerAuthorization) DeleteWorkbenchSchedule(ctx context.Context, req *chorus.DeleteWorkbenchScheduleRequest) (*chorus.DeleteWorkbenchScheduleReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermManageWorkbenchSchedule.For(authz.WorkbenchID(req.Id)))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.DeleteWorkbenchSchedule(ctx, req)
+}
+
+func (c workbenchControllerAuthorization) KeepWorkbenchAlive(ctx context.Context, req *chorus.KeepWorkbenchAliveRequest) (*chorus.KeepWorkbenchAliveReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermStreamWorkbench.For(authz.WorkbenchID(req.Id)))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.KeepWorkbenchAlive(ctx, req)
+}
+
+func (c workbenchControllerAuthorization) HibernateWorkbench(ctx context.Context, req *chorus.HibernateWorkbenchRequest) (*chorus.HibernateWorkbenchReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermUpdateWorkbench.For(authz.WorkbenchID(req.Id)))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.HibernateWorkbench(ctx, req)
+}
+
+func (c workbenchControllerAuthorization) ResumeWorkbench(ctx context.Context, req *chorus.ResumeWorkbenchRequest) (*chorus.ResumeWorkbenchReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermUpdateWorkbench.For(authz.WorkbenchID(req.Id)))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.ResumeWorkbench(ctx, req)
+}
+
+func (c workbenchControllerAuthorization) CreateWorkbenchShareGrant(ctx context.Context, req *chorus.CreateWorkbenchShareGrantRequest) (*chorus.CreateWorkbenchShareGrantReply, error) {
+	mode, err := workbench_model.ToWorkbenchShareMode(req.Mode)
+	if err != nil {
+		return nil, cerr.ErrValidation.WithMessage(fmt.Sprintf("Invalid share mode %q", req.Mode))
+	}
+
+	err = c.IsAuthorized(ctx, authz.PermManageUsersInWorkbench.For(authz.WorkbenchID(req.Id)))
+	if err != nil {
+		return nil, err
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Could not extract tenant ID from token")
+	}
+	userID, err := jwt_model.ExtractUserID(ctx)
+	if err != nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Could not extract user ID from token")
+	}
+
+	// The role is given to every grantee, each of them is checked like a single user grant.
+	grant := &workbench_model.WorkbenchShareGrant{TenantID: tenantID, WorkbenchID: req.Id}
+	if req.UserId != 0 {
+		grant.GranteeUserID = &req.UserId
+	}
+	if req.WorkspaceId != 0 {
+		grant.GranteeWorkspaceID = &req.WorkspaceId
+	}
+	granteeIDs, err := c.resolver.ListWorkbenchShareGrantees(ctx, grant)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, granteeID := range granteeIDs {
+		if granteeID == userID {
+			continue
+		}
+
+		assignmentContext := authz.Context{
+			authz.ContextWorkbench: fmt.Sprintf("%d", req.Id),
+			authz.ContextUser:      fmt.Sprintf("%d", granteeID),
+		}
+		if err := c.CanAssignRole(ctx, mode.RoleName(), assignmentContext); err != nil {
+			return nil, err
+		}
+	}
+
+	return c.next.CreateWorkbenchShareGrant(ctx, req)
+}
+
+func (c workbenchControllerAuthorization) ListWorkbenchShareGrants(ctx context.Context, req *chorus.ListWorkbenchShareGrantsRequest) (*chorus.ListWorkbenchShareGrantsReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermManageUsersInWorkbench.For(authz.WorkbenchID(req.Id)))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.ListWorkbenchShareGrants(ctx, req)
+}
+
+func (c workbenchControllerAuthorization) RevokeWorkbenchShareGrant(ctx context.Context, req *chorus.RevokeWorkbenchShareGrantRequest) (*chorus.RevokeWorkbenchShareGrantReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermManageUsersInWorkbench.For(authz.WorkbenchID(req.Id)))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.RevokeWorkbenchShareGrant(ctx, req)
+}
+
+func (c workbenchControllerAuthorization) WatchWorkbench(req *chorus.WatchWorkbenchRequest, stream chorus.WorkbenchService_WatchWorkbenchServer) error {
+	err := c.IsAuthorized(stream.Context(), authz.PermGetWorkbench.For(authz.WorkbenchID(req.Id)))
+	if err != nil {
+		return err
+	}
+
+	return c.next.WatchWorkbench(req, stream)
+}
+
+func (c workbenchControllerAuthorization) WatchWorkspace(req *chorus.WatchWorkspaceRequest, stream chorus.WorkbenchService_WatchWorkspaceServer) error {
+	err := c.IsAuthorized(stream.Context(), authz.PermGetWorkspace.For(authz.WorkspaceID(req.Id)))
+	if err != nil {
+		return err
+	}
+
+	return c.next.WatchWorkspace(req, stream)
+}
+
+func (c workbenchControllerAuthorization) GetWorkbenchServerLogs(ctx context.Context, req *chorus.GetWorkbenchServerLogsRequest) (*chorus.GetWorkbenchServerLogsReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermGetWorkbench.For(authz.WorkbenchID(req.Id)))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.GetWorkbenchServerLogs(ctx, req)
+}
+
+func (c workbenchControllerAuthorization) ListWorkbenchServerEvents(ctx context.Context, req *chorus.ListWorkbenchServerEventsRequest) (*chorus.ListWorkbenchServerEventsReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermGetWorkbench.For(authz.WorkbenchID(req.Id)))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.ListWorkbenchServerEvents(ctx, req)
+}
+
+func (c workbenchControllerAuthorization) GetWorkbenchUsage(ctx context.Context, req *chorus.GetWorkbenchUsageRequest) (*chorus.GetWorkbenchUsageReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermGetWorkbench.For(authz.WorkbenchID(req.Id)))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.GetWorkbenchUsage(ctx, req)
+}
+
+func (c workbenchControllerAuthorization) GetWorkspaceUsage(ctx context.Context, req *chorus.GetWorkspaceUsageRequest) (*chorus.GetWorkspaceUsageReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermGetWorkspace.For(authz.WorkspaceID(req.Id)))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.GetWorkspaceUsage(ctx, req)
+}
+
+func (c workbenchControllerAuthorization) ListWorkbenchConnections(ctx context.Context, req *chorus.ListWorkbenchConnectionsRequest) (*chorus.ListWorkbenchConnectionsReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermAuditWorkbench.For(authz.WorkbenchID(req.Id)))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.ListWorkbenchConnections(ctx, req)
+}
+
+func (c workbenchControllerAuthorization) ListWorkspaceWorkbenchConnections(ctx context.Context, req *chorus.ListWorkbenchConnectionsRequest) (*chorus.ListWorkbenchConnectionsReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermAuditWorkspace.For(authz.WorkspaceID(req.Id)))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.ListWorkspaceWorkbenchConnections(ctx, req)
+}
+
+func (c workbenchControllerAuthorization) ListUserWorkbenchConnections(ctx context.Context, req *chorus.ListWorkbenchConnectionsRequest) (*chorus.ListWorkbenchConnectionsReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermAuditUser.For(authz.UserID(req.Id)))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.ListUserWorkbenchConnections(ctx, req)
+}
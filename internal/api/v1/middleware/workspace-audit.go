@@ -3,6 +3,7 @@ package middleware
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
 	"github.com/CHORUS-TRE/chorus-backend/internal/audit"
@@ -122,6 +123,13 @@ func (c workspaceControllerAudit) UpdateWorkspace(ctx context.Context, req *chor
 			audit.WithDescription(fmt.Sprintf("Failed to update workspace %q (ID %d).", req.Name, req.Id)),
 			audit.WithError(err),
 		)
+	} else if requestID := res.Result.Workspace.NetworkPolicyApprovalRequestId; requestID != 0 {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Updated workspace %q (ID %d), network policy change pending in approval request %d.", req.Name, req.Id, requestID)),
+			audit.WithDetail("network_policy_approval_request_id", requestID),
+			audit.WithDetail("requested_network_policy", req.NetworkPolicy),
+			audit.WithDetail("requested_allowed_fqdns", fqdnRulePatterns(req.FqdnRules, req.AllowedFqdns)),
+		)
 	} else {
 		opts = append(opts,
 			audit.WithDescription(fmt.Sprintf("Updated workspace %q (ID %d).", req.Name, req.Id)),
@@ -157,6 +165,82 @@ func (c workspaceControllerAudit) DeleteWorkspace(ctx context.Context, req *chor
 	return res, err
 }
 
+func (c workspaceControllerAudit) ExtendWorkspace(ctx context.Context, req *chorus.ExtendWorkspaceRequest) (*chorus.ExtendWorkspaceReply, error) {
+	res, err := c.next.ExtendWorkspace(ctx, req)
+
+	expiresAt := req.GetExpiresAt().AsTime().Format(time.RFC3339)
+	opts := []audit.Option{
+		audit.WithWorkspaceID(req.Id),
+		audit.WithDetail("workspace_id", req.Id),
+		audit.WithDetail("expires_at", expiresAt),
+	}
+
+	if err != nil {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Failed to extend workspace with ID %d until %s.", req.Id, expiresAt)),
+			audit.WithError(err),
+		)
+	} else {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Extended workspace with ID %d until %s.", req.Id, expiresAt)),
+		)
+	}
+
+	audit.Record(ctx, c.auditWriter, model.AuditActionWorkspaceExtend, opts...)
+
+	return res, err
+}
+
+func (c workspaceControllerAudit) FreezeWorkspace(ctx context.Context, req *chorus.FreezeWorkspaceRequest) (*chorus.FreezeWorkspaceReply, error) {
+	res, err := c.next.FreezeWorkspace(ctx, req)
+
+	opts := []audit.Option{
+		audit.WithWorkspaceID(req.Id),
+		audit.WithDetail("workspace_id", req.Id),
+		audit.WithDetail("reason", req.Reason),
+	}
+
+	if err != nil {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Failed to freeze workspace with ID %d.", req.Id)),
+			audit.WithError(err),
+		)
+	} else {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Froze workspace with ID %d.", req.Id)),
+		)
+	}
+
+	audit.Record(ctx, c.auditWriter, model.AuditActionWorkspaceFreeze, opts...)
+
+	return res, err
+}
+
+func (c workspaceControllerAudit) UnfreezeWorkspace(ctx context.Context, req *chorus.UnfreezeWorkspaceRequest) (*chorus.UnfreezeWorkspaceReply, error) {
+	res, err := c.next.UnfreezeWorkspace(ctx, req)
+
+	opts := []audit.Option{
+		audit.WithWorkspaceID(req.Id),
+		audit.WithDetail("workspace_id", req.Id),
+		audit.WithDetail("reason", req.Reason),
+	}
+
+	if err != nil {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Failed to unfreeze workspace with ID %d.", req.Id)),
+			audit.WithError(err),
+		)
+	} else {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Unfroze workspace with ID %d.", req.Id)),
+		)
+	}
+
+	audit.Record(ctx, c.auditWriter, model.AuditActionWorkspaceUnfreeze, opts...)
+
+	return res, err
+}
+
 func (c workspaceControllerAudit) AddUserRoleInWorkspace(ctx context.Context, req *chorus.AddUserRoleInWorkspaceRequest) (*chorus.AddUserRoleInWorkspaceReply, error) {
 	res, err := c.next.AddUserRoleInWorkspace(ctx, req)
 
@@ -236,3 +320,16 @@ func (c workspaceControllerAudit) RemoveUserFromWorkspace(ctx context.Context, r
 
 	return res, err
 }
+
+// fqdnRulePatterns returns the hostname patterns of FQDN rules, or the plain patterns
+// sent by older clients when no rule is set.
+func fqdnRulePatterns(rules []*chorus.FQDNRule, patterns []string) []string {
+	if len(rules) == 0 {
+		return patterns
+	}
+	res := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		res = append(res, rule.Pattern)
+	}
+	return res
+}
@@ -135,6 +135,33 @@ func (c workspaceControllerAuthorization) DeleteWorkspace(ctx context.Context, r
 	return c.next.DeleteWorkspace(ctx, req)
 }
 
+func (c workspaceControllerAuthorization) ExtendWorkspace(ctx context.Context, req *chorus.ExtendWorkspaceRequest) (*chorus.ExtendWorkspaceReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermExtendWorkspace.For(authz.WorkspaceID(req.Id)))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.ExtendWorkspace(ctx, req)
+}
+
+func (c workspaceControllerAuthorization) FreezeWorkspace(ctx context.Context, req *chorus.FreezeWorkspaceRequest) (*chorus.FreezeWorkspaceReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermFreezeWorkspace.For(authz.WorkspaceID(req.Id)))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.FreezeWorkspace(ctx, req)
+}
+
+func (c workspaceControllerAuthorization) UnfreezeWorkspace(ctx context.Context, req *chorus.UnfreezeWorkspaceRequest) (*chorus.UnfreezeWorkspaceReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermFreezeWorkspace.For(authz.WorkspaceID(req.Id)))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.UnfreezeWorkspace(ctx, req)
+}
+
 func (c workspaceControllerAuthorization) AddUserRoleInWorkspace(ctx context.Context, req *chorus.AddUserRoleInWorkspaceRequest) (*chorus.AddUserRoleInWorkspaceReply, error) {
 	roleName, err := authz.ToRoleName(req.Role.Name)
 	if err != nil {
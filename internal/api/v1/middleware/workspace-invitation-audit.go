@@ -0,0 +1,175 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
+	"github.com/CHORUS-TRE/chorus-backend/internal/audit"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/audit/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/audit/service"
+)
+
+var _ chorus.WorkspaceInvitationServiceServer = (*workspaceInvitationControllerAudit)(nil)
+
+type workspaceInvitationControllerAudit struct {
+	next        chorus.WorkspaceInvitationServiceServer
+	auditWriter service.AuditWriter
+}
+
+func NewWorkspaceInvitationAuditMiddleware(auditWriter service.AuditWriter) func(chorus.WorkspaceInvitationServiceServer) chorus.WorkspaceInvitationServiceServer {
+	return func(next chorus.WorkspaceInvitationServiceServer) chorus.WorkspaceInvitationServiceServer {
+		return &workspaceInvitationControllerAudit{
+			next:        next,
+			auditWriter: auditWriter,
+		}
+	}
+}
+
+func (c workspaceInvitationControllerAudit) CreateWorkspaceInvitation(ctx context.Context, req *chorus.CreateWorkspaceInvitationRequest) (*chorus.CreateWorkspaceInvitationReply, error) {
+	res, err := c.next.CreateWorkspaceInvitation(ctx, req)
+
+	invitee := req.Email
+	if req.UserId != 0 {
+		invitee = fmt.Sprintf("user %d", req.UserId)
+	}
+
+	opts := []audit.Option{
+		audit.WithWorkspaceID(req.WorkspaceId),
+		audit.WithDetail("workspace_id", req.WorkspaceId),
+		audit.WithDetail("role", req.RoleName),
+	}
+	if req.UserId != 0 {
+		opts = append(opts, audit.WithUserID(req.UserId), audit.WithDetail("user_id", req.UserId))
+	}
+	if req.Email != "" {
+		opts = append(opts, audit.WithDetail("email", req.Email))
+	}
+
+	if err != nil {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Failed to invite %s to workspace %d with role %s.", invitee, req.WorkspaceId, req.RoleName)),
+			audit.WithError(err),
+		)
+	} else {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Invited %s to workspace %d with role %s (invitation ID %d).", invitee, req.WorkspaceId, req.RoleName, res.Result.Invitation.Id)),
+			audit.WithDetail("workspace_invitation_id", res.Result.Invitation.Id),
+			audit.WithDetail("expires_at", res.Result.Invitation.ExpiresAt.AsTime()),
+		)
+	}
+
+	audit.Record(ctx, c.auditWriter, model.AuditActionWorkspaceInvitationCreate, opts...)
+
+	return res, err
+}
+
+func (c workspaceInvitationControllerAudit) ListWorkspaceInvitations(ctx context.Context, req *chorus.ListWorkspaceInvitationsRequest) (*chorus.ListWorkspaceInvitationsReply, error) {
+	res, err := c.next.ListWorkspaceInvitations(ctx, req)
+
+	if err != nil {
+		audit.Record(ctx, c.auditWriter, model.AuditActionWorkspaceInvitationList,
+			audit.WithWorkspaceID(req.WorkspaceId),
+			audit.WithDetail("workspace_id", req.WorkspaceId),
+			audit.WithDescription(fmt.Sprintf("Failed to list invitations of workspace %d.", req.WorkspaceId)),
+			audit.WithError(err),
+		)
+	}
+
+	return res, err
+}
+
+func (c workspaceInvitationControllerAudit) ListMyWorkspaceInvitations(ctx context.Context, req *chorus.ListMyWorkspaceInvitationsRequest) (*chorus.ListMyWorkspaceInvitationsReply, error) {
+	res, err := c.next.ListMyWorkspaceInvitations(ctx, req)
+
+	if err != nil {
+		audit.Record(ctx, c.auditWriter, model.AuditActionWorkspaceInvitationList,
+			audit.WithDescription("Failed to list my workspace invitations."),
+			audit.WithError(err),
+		)
+	}
+
+	return res, err
+}
+
+func (c workspaceInvitationControllerAudit) AcceptWorkspaceInvitation(ctx context.Context, req *chorus.RespondWorkspaceInvitationRequest) (*chorus.AcceptWorkspaceInvitationReply, error) {
+	res, err := c.next.AcceptWorkspaceInvitation(ctx, req)
+
+	opts := []audit.Option{
+		audit.WithDetail("workspace_invitation_id", req.Id),
+	}
+
+	if err != nil {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Failed to accept workspace invitation %d.", req.Id)),
+			audit.WithError(err),
+		)
+	} else {
+		invitation := res.Result.Invitation
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Accepted invitation %d to workspace %d with role %s.", req.Id, invitation.WorkspaceId, invitation.RoleName)),
+			audit.WithWorkspaceID(invitation.WorkspaceId),
+			audit.WithUserID(invitation.InviteeUserId),
+			audit.WithDetail("workspace_id", invitation.WorkspaceId),
+			audit.WithDetail("role", invitation.RoleName),
+			audit.WithDetail("with_token", req.Token != ""),
+		)
+	}
+
+	audit.Record(ctx, c.auditWriter, model.AuditActionWorkspaceInvitationAccept, opts...)
+
+	return res, err
+}
+
+func (c workspaceInvitationControllerAudit) DeclineWorkspaceInvitation(ctx context.Context, req *chorus.RespondWorkspaceInvitationRequest) (*chorus.DeclineWorkspaceInvitationReply, error) {
+	res, err := c.next.DeclineWorkspaceInvitation(ctx, req)
+
+	opts := []audit.Option{
+		audit.WithDetail("workspace_invitation_id", req.Id),
+	}
+
+	if err != nil {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Failed to decline workspace invitation %d.", req.Id)),
+			audit.WithError(err),
+		)
+	} else {
+		invitation := res.Result.Invitation
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Declined invitation %d to workspace %d.", req.Id, invitation.WorkspaceId)),
+			audit.WithWorkspaceID(invitation.WorkspaceId),
+			audit.WithUserID(invitation.InviteeUserId),
+			audit.WithDetail("workspace_id", invitation.WorkspaceId),
+		)
+	}
+
+	audit.Record(ctx, c.auditWriter, model.AuditActionWorkspaceInvitationDecline, opts...)
+
+	return res, err
+}
+
+func (c workspaceInvitationControllerAudit) RevokeWorkspaceInvitation(ctx context.Context, req *chorus.RevokeWorkspaceInvitationRequest) (*chorus.RevokeWorkspaceInvitationReply, error) {
+	res, err := c.next.RevokeWorkspaceInvitation(ctx, req)
+
+	opts := []audit.Option{
+		audit.WithDetail("workspace_invitation_id", req.Id),
+	}
+
+	if err != nil {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Failed to revoke workspace invitation %d.", req.Id)),
+			audit.WithError(err),
+		)
+	} else {
+		invitation := res.Result.Invitation
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Revoked invitation %d to workspace %d.", req.Id, invitation.WorkspaceId)),
+			audit.WithWorkspaceID(invitation.WorkspaceId),
+			audit.WithDetail("workspace_id", invitation.WorkspaceId),
+		)
+	}
+
+	audit.Record(ctx, c.auditWriter, model.AuditActionWorkspaceInvitationRevoke, opts...)
+
+	return res, err
+}
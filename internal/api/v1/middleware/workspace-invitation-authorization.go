@@ -0,0 +1,151 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
+	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+	jwt_model "github.com/CHORUS-TRE/chorus-backend/internal/jwt/model"
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	authz "github.com/CHORUS-TRE/chorus-backend/pkg/authorization/model"
+	authorization_service "github.com/CHORUS-TRE/chorus-backend/pkg/authorization/service"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ chorus.WorkspaceInvitationServiceServer = (*workspaceInvitationControllerAuthorization)(nil)
+
+type WorkspaceInvitationResolver interface {
+	GetWorkspaceInvitation(ctx context.Context, tenantID, invitationID uint64) (*model.WorkspaceInvitation, error)
+}
+
+type workspaceInvitationControllerAuthorization struct {
+	Authorization
+	resolver WorkspaceInvitationResolver
+	next     chorus.WorkspaceInvitationServiceServer
+}
+
+func WorkspaceInvitationAuthorizing(logger *logger.ContextLogger, authorizer authorization_service.Authorizer, cfg config.Config, refresher Refresher, resolver WorkspaceInvitationResolver) func(chorus.WorkspaceInvitationServiceServer) chorus.WorkspaceInvitationServiceServer {
+	return func(next chorus.WorkspaceInvitationServiceServer) chorus.WorkspaceInvitationServiceServer {
+		return &workspaceInvitationControllerAuthorization{
+			Authorization: Authorization{
+				logger:     logger,
+				authorizer: authorizer,
+				cfg:        cfg,
+				refresher:  refresher,
+			},
+			resolver: resolver,
+			next:     next,
+		}
+	}
+}
+
+// canManageInvitation checks that the caller may grant the role of an invitation in its
+// workspace, with the same rules as for adding a user to the workspace directly.
+func (c workspaceInvitationControllerAuthorization) canManageInvitation(ctx context.Context, workspaceID, userID uint64, roleName authz.RoleName) error {
+	if !c.IsRoleInScope(roleName, authz.RoleScopeWorkspace) {
+		return fmt.Errorf("role %q is not a valid workspace role", roleName)
+	}
+
+	if roleName == authz.RoleWorkspaceDataManager.Name {
+		if err := c.IsAuthorized(ctx, authz.PermManageUsersDataRoleInWorkspace.For(authz.WorkspaceID(workspaceID))); err != nil {
+			return err
+		}
+	} else {
+		if err := c.IsAuthorized(ctx, authz.PermManageUsersInWorkspace.For(authz.WorkspaceID(workspaceID))); err != nil {
+			return err
+		}
+	}
+
+	assignmentContext := authz.Context{
+		authz.ContextWorkspace: fmt.Sprintf("%d", workspaceID),
+	}
+	if userID != 0 {
+		assignmentContext[authz.ContextUser] = fmt.Sprintf("%d", userID)
+	}
+	return c.CanAssignRole(ctx, roleName, assignmentContext)
+}
+
+func (c workspaceInvitationControllerAuthorization) CreateWorkspaceInvitation(ctx context.Context, req *chorus.CreateWorkspaceInvitationRequest) (*chorus.CreateWorkspaceInvitationReply, error) {
+	roleName, err := authz.ToRoleName(req.RoleName)
+	if err != nil {
+		return nil, fmt.Errorf("invalid role name: %w", err)
+	}
+
+	if err := c.canManageInvitation(ctx, req.WorkspaceId, req.UserId, roleName); err != nil {
+		return nil, err
+	}
+
+	return c.next.CreateWorkspaceInvitation(ctx, req)
+}
+
+func (c workspaceInvitationControllerAuthorization) ListWorkspaceInvitations(ctx context.Context, req *chorus.ListWorkspaceInvitationsRequest) (*chorus.ListWorkspaceInvitationsReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermManageUsersInWorkspace.For(authz.WorkspaceID(req.WorkspaceId)))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.ListWorkspaceInvitations(ctx, req)
+}
+
+func (c workspaceInvitationControllerAuthorization) ListMyWorkspaceInvitations(ctx context.Context, req *chorus.ListMyWorkspaceInvitationsRequest) (*chorus.ListMyWorkspaceInvitationsReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermRespondToWorkspaceInvitations.For())
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.ListMyWorkspaceInvitations(ctx, req)
+}
+
+func (c workspaceInvitationControllerAuthorization) AcceptWorkspaceInvitation(ctx context.Context, req *chorus.RespondWorkspaceInvitationRequest) (*chorus.AcceptWorkspaceInvitationReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermRespondToWorkspaceInvitations.For())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.next.AcceptWorkspaceInvitation(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.TriggerRefreshToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c workspaceInvitationControllerAuthorization) DeclineWorkspaceInvitation(ctx context.Context, req *chorus.RespondWorkspaceInvitationRequest) (*chorus.DeclineWorkspaceInvitationReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermRespondToWorkspaceInvitations.For())
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.DeclineWorkspaceInvitation(ctx, req)
+}
+
+func (c workspaceInvitationControllerAuthorization) RevokeWorkspaceInvitation(ctx context.Context, req *chorus.RevokeWorkspaceInvitationRequest) (*chorus.RevokeWorkspaceInvitationReply, error) {
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "could not extract tenant id from jwt-token")
+	}
+
+	invitation, err := c.resolver.GetWorkspaceInvitation(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "unable to resolve workspace invitation %v: %v", req.Id, err)
+	}
+
+	var inviteeUserID uint64
+	if invitation.InviteeUserID != nil {
+		inviteeUserID = *invitation.InviteeUserID
+	}
+	if err := c.canManageInvitation(ctx, invitation.WorkspaceID, inviteeUserID, invitation.Role); err != nil {
+		return nil, err
+	}
+
+	return c.next.RevokeWorkspaceInvitation(ctx, req)
+}
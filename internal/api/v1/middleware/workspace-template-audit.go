@@ -0,0 +1,156 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
+	"github.com/CHORUS-TRE/chorus-backend/internal/audit"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/audit/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/audit/service"
+)
+
+var _ chorus.WorkspaceTemplateServiceServer = (*workspaceTemplateControllerAudit)(nil)
+
+type workspaceTemplateControllerAudit struct {
+	next        chorus.WorkspaceTemplateServiceServer
+	auditWriter service.AuditWriter
+}
+
+func NewWorkspaceTemplateAuditMiddleware(auditWriter service.AuditWriter) func(chorus.WorkspaceTemplateServiceServer) chorus.WorkspaceTemplateServiceServer {
+	return func(next chorus.WorkspaceTemplateServiceServer) chorus.WorkspaceTemplateServiceServer {
+		return &workspaceTemplateControllerAudit{
+			next:        next,
+			auditWriter: auditWriter,
+		}
+	}
+}
+
+func (c workspaceTemplateControllerAudit) GetWorkspaceTemplate(ctx context.Context, req *chorus.GetWorkspaceTemplateRequest) (*chorus.GetWorkspaceTemplateReply, error) {
+	res, err := c.next.GetWorkspaceTemplate(ctx, req)
+
+	if err != nil {
+		audit.Record(ctx, c.auditWriter, model.AuditActionWorkspaceTemplateRead,
+			audit.WithDetail("workspace_template_id", req.Id),
+			audit.WithDescription(fmt.Sprintf("Failed to get workspace template %d.", req.Id)),
+			audit.WithError(err),
+		)
+	}
+
+	return res, err
+}
+
+func (c workspaceTemplateControllerAudit) ListWorkspaceTemplates(ctx context.Context, req *chorus.ListWorkspaceTemplatesRequest) (*chorus.ListWorkspaceTemplatesReply, error) {
+	res, err := c.next.ListWorkspaceTemplates(ctx, req)
+
+	if err != nil {
+		audit.Record(ctx, c.auditWriter, model.AuditActionWorkspaceTemplateList,
+			audit.WithDescription("Failed to list workspace templates."),
+			audit.WithError(err),
+		)
+	}
+
+	return res, err
+}
+
+func (c workspaceTemplateControllerAudit) CreateWorkspaceTemplate(ctx context.Context, req *chorus.WorkspaceTemplate) (*chorus.CreateWorkspaceTemplateReply, error) {
+	res, err := c.next.CreateWorkspaceTemplate(ctx, req)
+
+	opts := []audit.Option{
+		audit.WithDetail("workspace_template_name", req.Name),
+	}
+
+	if err != nil {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Failed to create workspace template %q.", req.Name)),
+			audit.WithError(err),
+		)
+	} else {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Created workspace template %q (ID %d).", req.Name, res.Result.WorkspaceTemplate.Id)),
+			audit.WithDetail("workspace_template_id", res.Result.WorkspaceTemplate.Id),
+		)
+	}
+
+	audit.Record(ctx, c.auditWriter, model.AuditActionWorkspaceTemplateCreate, opts...)
+
+	return res, err
+}
+
+func (c workspaceTemplateControllerAudit) UpdateWorkspaceTemplate(ctx context.Context, req *chorus.WorkspaceTemplate) (*chorus.UpdateWorkspaceTemplateReply, error) {
+	res, err := c.next.UpdateWorkspaceTemplate(ctx, req)
+
+	opts := []audit.Option{
+		audit.WithDetail("workspace_template_id", req.Id),
+		audit.WithDetail("workspace_template_name", req.Name),
+	}
+
+	if err != nil {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Failed to update workspace template %q (ID %d).", req.Name, req.Id)),
+			audit.WithError(err),
+		)
+	} else {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Updated workspace template %q (ID %d).", req.Name, req.Id)),
+		)
+	}
+
+	audit.Record(ctx, c.auditWriter, model.AuditActionWorkspaceTemplateUpdate, opts...)
+
+	return res, err
+}
+
+func (c workspaceTemplateControllerAudit) DeleteWorkspaceTemplate(ctx context.Context, req *chorus.DeleteWorkspaceTemplateRequest) (*chorus.DeleteWorkspaceTemplateReply, error) {
+	res, err := c.next.DeleteWorkspaceTemplate(ctx, req)
+
+	opts := []audit.Option{
+		audit.WithDetail("workspace_template_id", req.Id),
+	}
+
+	if err != nil {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Failed to delete workspace template %d.", req.Id)),
+			audit.WithError(err),
+		)
+	} else {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Deleted workspace template %d.", req.Id)),
+		)
+	}
+
+	audit.Record(ctx, c.auditWriter, model.AuditActionWorkspaceTemplateDelete, opts...)
+
+	return res, err
+}
+
+func (c workspaceTemplateControllerAudit) CreateWorkspaceFromTemplate(ctx context.Context, req *chorus.CreateWorkspaceFromTemplateRequest) (*chorus.CreateWorkspaceFromTemplateReply, error) {
+	res, err := c.next.CreateWorkspaceFromTemplate(ctx, req)
+
+	opts := []audit.Option{
+		audit.WithDetail("workspace_template_id", req.TemplateId),
+		audit.WithDetail("workspace_name", req.Name),
+	}
+
+	if err != nil {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Failed to create workspace %q from template %d.", req.Name, req.TemplateId)),
+			audit.WithError(err),
+		)
+	} else {
+		serviceIDs := make([]uint64, 0, len(res.Result.WorkspaceServiceInstances))
+		for _, svc := range res.Result.WorkspaceServiceInstances {
+			serviceIDs = append(serviceIDs, svc.Id)
+		}
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Created workspace %q (ID %d) from template %d.", req.Name, res.Result.Workspace.Id, req.TemplateId)),
+			audit.WithWorkspaceID(res.Result.Workspace.Id),
+			audit.WithDetail("workspace_id", res.Result.Workspace.Id),
+			audit.WithDetail("service_instance_ids", serviceIDs),
+		)
+	}
+
+	audit.Record(ctx, c.auditWriter, model.AuditActionWorkspaceCreateFromTemplate, opts...)
+
+	return res, err
+}
@@ -0,0 +1,101 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
+	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	authz "github.com/CHORUS-TRE/chorus-backend/pkg/authorization/model"
+	authorization_service "github.com/CHORUS-TRE/chorus-backend/pkg/authorization/service"
+)
+
+var _ chorus.WorkspaceTemplateServiceServer = (*workspaceTemplateControllerAuthorization)(nil)
+
+type workspaceTemplateControllerAuthorization struct {
+	Authorization
+	next chorus.WorkspaceTemplateServiceServer
+}
+
+func WorkspaceTemplateAuthorizing(logger *logger.ContextLogger, authorizer authorization_service.Authorizer, cfg config.Config, refresher Refresher) func(chorus.WorkspaceTemplateServiceServer) chorus.WorkspaceTemplateServiceServer {
+	return func(next chorus.WorkspaceTemplateServiceServer) chorus.WorkspaceTemplateServiceServer {
+		return &workspaceTemplateControllerAuthorization{
+			Authorization: Authorization{
+				logger:     logger,
+				authorizer: authorizer,
+				cfg:        cfg,
+				refresher:  refresher,
+			},
+			next: next,
+		}
+	}
+}
+
+func (c workspaceTemplateControllerAuthorization) ListWorkspaceTemplates(ctx context.Context, req *chorus.ListWorkspaceTemplatesRequest) (*chorus.ListWorkspaceTemplatesReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermListWorkspaceTemplates.For())
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.ListWorkspaceTemplates(ctx, req)
+}
+
+func (c workspaceTemplateControllerAuthorization) GetWorkspaceTemplate(ctx context.Context, req *chorus.GetWorkspaceTemplateRequest) (*chorus.GetWorkspaceTemplateReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermGetWorkspaceTemplate.For())
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.GetWorkspaceTemplate(ctx, req)
+}
+
+func (c workspaceTemplateControllerAuthorization) CreateWorkspaceTemplate(ctx context.Context, req *chorus.WorkspaceTemplate) (*chorus.CreateWorkspaceTemplateReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermCreateWorkspaceTemplate.For())
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.CreateWorkspaceTemplate(ctx, req)
+}
+
+func (c workspaceTemplateControllerAuthorization) UpdateWorkspaceTemplate(ctx context.Context, req *chorus.WorkspaceTemplate) (*chorus.UpdateWorkspaceTemplateReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermUpdateWorkspaceTemplate.For())
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.UpdateWorkspaceTemplate(ctx, req)
+}
+
+func (c workspaceTemplateControllerAuthorization) DeleteWorkspaceTemplate(ctx context.Context, req *chorus.DeleteWorkspaceTemplateRequest) (*chorus.DeleteWorkspaceTemplateReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermDeleteWorkspaceTemplate.For())
+	if err != nil {
+		return nil, err
+	}
+
+	return c.next.DeleteWorkspaceTemplate(ctx, req)
+}
+
+func (c workspaceTemplateControllerAuthorization) CreateWorkspaceFromTemplate(ctx context.Context, req *chorus.CreateWorkspaceFromTemplateRequest) (*chorus.CreateWorkspaceFromTemplateReply, error) {
+	err := c.IsAuthorized(ctx, authz.PermCreateWorkspace.For())
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.IsAuthorized(ctx, authz.PermGetWorkspaceTemplate.For())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.next.CreateWorkspaceFromTemplate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.TriggerRefreshToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -53,3 +53,16 @@ func (c PlatformSettingsController) UpdatePlatformSettings(ctx context.Context,
 
 	return &chorus.UpdatePlatformSettingsReply{Result: &chorus.UpdatePlatformSettingsResult{PlatformSettings: proto}}, nil
 }
+
+func (c PlatformSettingsController) GetUserUsage(ctx context.Context, req *chorus.GetUserUsageRequest) (*chorus.GetUserUsageReply, error) {
+	if req == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("empty request")
+	}
+
+	usage, err := c.platformSettings.GetUserUsage(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &chorus.GetUserUsageReply{Result: &chorus.GetUserUsageResult{Usage: converter.UserUsageFromBusiness(usage)}}, nil
+}
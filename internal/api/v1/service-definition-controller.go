@@ -0,0 +1,162 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
+	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/converter"
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	jwt_model "github.com/CHORUS-TRE/chorus-backend/internal/jwt/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workspace/service"
+)
+
+var _ chorus.ServiceDefinitionServiceServer = (*ServiceDefinitionController)(nil)
+
+func NewServiceDefinitionController(workspaceer service.Workspaceer) ServiceDefinitionController {
+	return ServiceDefinitionController{workspaceer: workspaceer}
+}
+
+type ServiceDefinitionController struct {
+	workspaceer service.Workspaceer
+}
+
+func (c ServiceDefinitionController) GetServiceDefinition(ctx context.Context, req *chorus.GetServiceDefinitionRequest) (*chorus.GetServiceDefinitionReply, error) {
+	if req == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, cerr.ErrUnauthenticated.Wrap(err, "Could not extract tenant id from jwt-token")
+	}
+
+	def, err := c.workspaceer.GetServiceDefinition(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	pbDef, err := converter.ServiceDefinitionFromBusiness(def)
+	if err != nil {
+		return nil, cerr.ErrConversion.Wrap(err, "Failed to convert service definition")
+	}
+
+	return &chorus.GetServiceDefinitionReply{Result: &chorus.GetServiceDefinitionResult{ServiceDefinition: pbDef}}, nil
+}
+
+func (c ServiceDefinitionController) ListServiceDefinitions(ctx context.Context, req *chorus.ListServiceDefinitionsRequest) (*chorus.ListServiceDefinitionsReply, error) {
+	if req == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, cerr.ErrUnauthenticated.Wrap(err, "Could not extract tenant id from jwt-token")
+	}
+
+	pagination := converter.PaginationToBusiness(req.Pagination)
+
+	res, paginationRes, err := c.workspaceer.ListServiceDefinitions(ctx, tenantID, &pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	var defs []*chorus.ServiceDefinition
+	for _, r := range res {
+		pbDef, err := converter.ServiceDefinitionFromBusiness(r)
+		if err != nil {
+			return nil, cerr.ErrConversion.Wrap(err, "Failed to convert service definition")
+		}
+		defs = append(defs, pbDef)
+	}
+
+	var paginationResult *chorus.PaginationResult
+	if paginationRes != nil {
+		paginationResult = converter.PaginationResultFromBusiness(paginationRes)
+	}
+
+	return &chorus.ListServiceDefinitionsReply{Result: &chorus.ListServiceDefinitionsResult{ServiceDefinitions: defs}, Pagination: paginationResult}, nil
+}
+
+func (c ServiceDefinitionController) CreateServiceDefinition(ctx context.Context, req *chorus.ServiceDefinition) (*chorus.CreateServiceDefinitionReply, error) {
+	if req == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, cerr.ErrUnauthenticated.Wrap(err, "Could not extract tenant id from jwt-token")
+	}
+
+	userID, err := jwt_model.ExtractUserID(ctx)
+	if err != nil {
+		return nil, cerr.ErrUnauthenticated.Wrap(err, "Could not extract user id from jwt-token")
+	}
+
+	def, err := converter.ServiceDefinitionToBusiness(req)
+	if err != nil {
+		return nil, cerr.ErrConversion.Wrap(err, "Failed to convert service definition")
+	}
+
+	def.TenantID = tenantID
+	def.UserID = userID
+
+	created, err := c.workspaceer.CreateServiceDefinition(ctx, def)
+	if err != nil {
+		return nil, err
+	}
+
+	pbDef, err := converter.ServiceDefinitionFromBusiness(created)
+	if err != nil {
+		return nil, cerr.ErrConversion.Wrap(err, "Failed to convert service definition")
+	}
+
+	return &chorus.CreateServiceDefinitionReply{Result: &chorus.CreateServiceDefinitionResult{ServiceDefinition: pbDef}}, nil
+}
+
+func (c ServiceDefinitionController) UpdateServiceDefinition(ctx context.Context, req *chorus.ServiceDefinition) (*chorus.UpdateServiceDefinitionReply, error) {
+	if req == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, cerr.ErrUnauthenticated.Wrap(err, "Could not extract tenant id from jwt-token")
+	}
+
+	def, err := converter.ServiceDefinitionToBusiness(req)
+	if err != nil {
+		return nil, cerr.ErrConversion.Wrap(err, "Failed to convert service definition")
+	}
+
+	def.TenantID = tenantID
+
+	updated, err := c.workspaceer.UpdateServiceDefinition(ctx, def)
+	if err != nil {
+		return nil, err
+	}
+
+	pbDef, err := converter.ServiceDefinitionFromBusiness(updated)
+	if err != nil {
+		return nil, cerr.ErrConversion.Wrap(err, "Failed to convert service definition")
+	}
+
+	return &chorus.UpdateServiceDefinitionReply{Result: &chorus.UpdateServiceDefinitionResult{ServiceDefinition: pbDef}}, nil
+}
+
+func (c ServiceDefinitionController) DeleteServiceDefinition(ctx context.Context, req *chorus.DeleteServiceDefinitionRequest) (*chorus.DeleteServiceDefinitionReply, error) {
+	if req == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, cerr.ErrUnauthenticated.Wrap(err, "Could not extract tenant id from jwt-token")
+	}
+
+	err = c.workspaceer.DeleteServiceDefinition(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &chorus.DeleteServiceDefinitionReply{Result: &chorus.DeleteServiceDefinitionResult{}}, nil
+}
@@ -239,3 +239,447 @@ func (c WorkbenchController) RemoveUserFromWorkbench(ctx context.Context, req *c
 
 	return &chorus.RemoveUserFromWorkbenchReply{Result: &chorus.RemoveUserFromWorkbenchResult{Workbench: workbenchRes}}, nil
 }
+
+func (c WorkbenchController) GetWorkbenchSchedule(ctx context.Context, req *chorus.GetWorkbenchScheduleRequest) (*chorus.GetWorkbenchScheduleReply, error) {
+	if req == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Could not extract tenant ID from token")
+	}
+
+	schedule, err := c.workbench.GetWorkbenchSchedule(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduleRes, err := converter.WorkbenchScheduleFromBusiness(schedule)
+	if err != nil {
+		return nil, cerr.ErrConversion.Wrap(err, "Failed to convert workbench schedule")
+	}
+
+	return &chorus.GetWorkbenchScheduleReply{Result: &chorus.GetWorkbenchScheduleResult{Schedule: scheduleRes}}, nil
+}
+
+func (c WorkbenchController) SetWorkbenchSchedule(ctx context.Context, req *chorus.SetWorkbenchScheduleRequest) (*chorus.SetWorkbenchScheduleReply, error) {
+	if req == nil || req.Schedule == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Could not extract tenant ID from token")
+	}
+
+	schedule, err := converter.WorkbenchScheduleToBusiness(req.Schedule)
+	if err != nil {
+		return nil, cerr.ErrInvalidRequest.Wrap(err, "Invalid workbench schedule")
+	}
+	schedule.TenantID = tenantID
+	schedule.WorkbenchID = req.Id
+
+	updated, err := c.workbench.SetWorkbenchSchedule(ctx, schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduleRes, err := converter.WorkbenchScheduleFromBusiness(updated)
+	if err != nil {
+		return nil, cerr.ErrConversion.Wrap(err, "Failed to convert workbench schedule")
+	}
+
+	return &chorus.SetWorkbenchScheduleReply{Result: &chorus.SetWorkbenchScheduleResult{Schedule: scheduleRes}}, nil
+}
+
+func (c WorkbenchController) DeleteWorkbenchSchedule(ctx context.Context, req *chorus.DeleteWorkbenchScheduleRequest) (*chorus.DeleteWorkbenchScheduleReply, error) {
+	if req == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Could not extract tenant ID from token")
+	}
+
+	if err := c.workbench.DeleteWorkbenchSchedule(ctx, tenantID, req.Id); err != nil {
+		return nil, err
+	}
+
+	return &chorus.DeleteWorkbenchScheduleReply{Result: &chorus.DeleteWorkbenchScheduleResult{}}, nil
+}
+
+func (c WorkbenchController) KeepWorkbenchAlive(ctx context.Context, req *chorus.KeepWorkbenchAliveRequest) (*chorus.KeepWorkbenchAliveReply, error) {
+	if req == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Could not extract tenant ID from token")
+	}
+
+	reclaimAt, err := c.workbench.KeepWorkbenchAlive(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	reclaimAtRes, err := converter.PointerToProtoTimestamp(reclaimAt)
+	if err != nil {
+		return nil, cerr.ErrConversion.Wrap(err, "Failed to convert idle reclaim date")
+	}
+
+	return &chorus.KeepWorkbenchAliveReply{Result: &chorus.KeepWorkbenchAliveResult{IdleReclaimAt: reclaimAtRes}}, nil
+}
+
+func (c WorkbenchController) HibernateWorkbench(ctx context.Context, req *chorus.HibernateWorkbenchRequest) (*chorus.HibernateWorkbenchReply, error) {
+	if req == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Could not extract tenant ID from token")
+	}
+
+	workbench, err := c.workbench.HibernateWorkbench(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	workbenchRes, err := converter.WorkbenchFromBusiness(workbench)
+	if err != nil {
+		return nil, cerr.ErrConversion.Wrap(err, "Failed to convert workbench")
+	}
+
+	return &chorus.HibernateWorkbenchReply{Result: &chorus.HibernateWorkbenchResult{Workbench: workbenchRes}}, nil
+}
+
+func (c WorkbenchController) ResumeWorkbench(ctx context.Context, req *chorus.ResumeWorkbenchRequest) (*chorus.ResumeWorkbenchReply, error) {
+	if req == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Could not extract tenant ID from token")
+	}
+
+	workbench, err := c.workbench.ResumeWorkbench(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	workbenchRes, err := converter.WorkbenchFromBusiness(workbench)
+	if err != nil {
+		return nil, cerr.ErrConversion.Wrap(err, "Failed to convert workbench")
+	}
+
+	return &chorus.ResumeWorkbenchReply{Result: &chorus.ResumeWorkbenchResult{Workbench: workbenchRes}}, nil
+}
+
+func (c WorkbenchController) CreateWorkbenchShareGrant(ctx context.Context, req *chorus.CreateWorkbenchShareGrantRequest) (*chorus.CreateWorkbenchShareGrantReply, error) {
+	if req == nil || req.ExpiresAt == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Could not extract tenant ID from token")
+	}
+
+	userID, err := jwt_model.ExtractUserID(ctx)
+	if err != nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Could not extract user ID from token")
+	}
+
+	expiresAt, err := converter.FromProtoTimestamp(req.ExpiresAt)
+	if err != nil {
+		return nil, cerr.ErrInvalidRequest.Wrap(err, "Invalid expiry date")
+	}
+
+	grant := &model.WorkbenchShareGrant{
+		TenantID:    tenantID,
+		WorkbenchID: req.Id,
+		GranterID:   userID,
+		Mode:        model.WorkbenchShareMode(req.Mode),
+		ExpiresAt:   expiresAt,
+	}
+	if req.UserId != 0 {
+		grant.GranteeUserID = &req.UserId
+	}
+	if req.WorkspaceId != 0 {
+		grant.GranteeWorkspaceID = &req.WorkspaceId
+	}
+
+	created, err := c.workbench.CreateWorkbenchShareGrant(ctx, grant)
+	if err != nil {
+		return nil, err
+	}
+
+	grantRes, err := converter.WorkbenchShareGrantFromBusiness(created)
+	if err != nil {
+		return nil, cerr.ErrConversion.Wrap(err, "Failed to convert share grant")
+	}
+
+	return &chorus.CreateWorkbenchShareGrantReply{Result: &chorus.CreateWorkbenchShareGrantResult{Grant: grantRes}}, nil
+}
+
+func (c WorkbenchController) ListWorkbenchShareGrants(ctx context.Context, req *chorus.ListWorkbenchShareGrantsRequest) (*chorus.ListWorkbenchShareGrantsReply, error) {
+	if req == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Could not extract tenant ID from token")
+	}
+
+	grants, err := c.workbench.ListWorkbenchShareGrants(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var grantsRes []*chorus.WorkbenchShareGrant
+	for _, grant := range grants {
+		grantRes, err := converter.WorkbenchShareGrantFromBusiness(grant)
+		if err != nil {
+			return nil, cerr.ErrConversion.Wrap(err, "Failed to convert share grant")
+		}
+		grantsRes = append(grantsRes, grantRes)
+	}
+
+	return &chorus.ListWorkbenchShareGrantsReply{Result: &chorus.ListWorkbenchShareGrantsResult{Grants: grantsRes}}, nil
+}
+
+func (c WorkbenchController) RevokeWorkbenchShareGrant(ctx context.Context, req *chorus.RevokeWorkbenchShareGrantRequest) (*chorus.RevokeWorkbenchShareGrantReply, error) {
+	if req == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Could not extract tenant ID from token")
+	}
+
+	grant, err := c.workbench.RevokeWorkbenchShareGrant(ctx, tenantID, req.Id, req.GrantId)
+	if err != nil {
+		return nil, err
+	}
+
+	grantRes, err := converter.WorkbenchShareGrantFromBusiness(grant)
+	if err != nil {
+		return nil, cerr.ErrConversion.Wrap(err, "Failed to convert share grant")
+	}
+
+	return &chorus.RevokeWorkbenchShareGrantReply{Result: &chorus.RevokeWorkbenchShareGrantResult{Grant: grantRes}}, nil
+}
+
+func (c WorkbenchController) WatchWorkbench(req *chorus.WatchWorkbenchRequest, stream chorus.WorkbenchService_WatchWorkbenchServer) error {
+	if req == nil {
+		return cerr.ErrInvalidRequest.WithMessage("Empty request")
+	}
+
+	ctx := stream.Context()
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return cerr.ErrInvalidRequest.WithMessage("Could not extract tenant ID from token")
+	}
+
+	events, err := c.workbench.WatchWorkbench(ctx, tenantID, req.Id)
+	if err != nil {
+		return err
+	}
+
+	return sendWorkbenchStatusEvents(stream, events)
+}
+
+func (c WorkbenchController) WatchWorkspace(req *chorus.WatchWorkspaceRequest, stream chorus.WorkbenchService_WatchWorkspaceServer) error {
+	if req == nil {
+		return cerr.ErrInvalidRequest.WithMessage("Empty request")
+	}
+
+	ctx := stream.Context()
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return cerr.ErrInvalidRequest.WithMessage("Could not extract tenant ID from token")
+	}
+
+	events, err := c.workbench.WatchWorkspace(ctx, tenantID, req.Id)
+	if err != nil {
+		return err
+	}
+
+	return sendWorkbenchStatusEvents(stream, events)
+}
+
+// sendWorkbenchStatusEvents forwards status events to a client until the service closes
+// the channel, which it does once the client is gone.
+func sendWorkbenchStatusEvents(stream interface {
+	Send(*chorus.WorkbenchStatusEvent) error
+}, events <-chan *model.WorkbenchStatusEvent) error {
+	for event := range events {
+		res, err := converter.WorkbenchStatusEventFromBusiness(event)
+		if err != nil {
+			return cerr.ErrConversion.Wrap(err, "Failed to convert workbench status event")
+		}
+		if err := stream.Send(res); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c WorkbenchController) GetWorkbenchServerLogs(ctx context.Context, req *chorus.GetWorkbenchServerLogsRequest) (*chorus.GetWorkbenchServerLogsReply, error) {
+	if req == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Could not extract tenant ID from token")
+	}
+
+	logReq, err := converter.PodLogRequestToBusiness(req.TailLines, req.SinceTime, req.Previous)
+	if err != nil {
+		return nil, cerr.ErrConversion.Wrap(err, "Failed to convert log request")
+	}
+
+	logs, err := c.workbench.GetWorkbenchServerLogs(ctx, tenantID, req.Id, logReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &chorus.GetWorkbenchServerLogsReply{Result: &chorus.GetWorkbenchServerLogsResult{Logs: converter.PodLogsFromBusiness(logs)}}, nil
+}
+
+func (c WorkbenchController) ListWorkbenchServerEvents(ctx context.Context, req *chorus.ListWorkbenchServerEventsRequest) (*chorus.ListWorkbenchServerEventsReply, error) {
+	if req == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Could not extract tenant ID from token")
+	}
+
+	events, err := c.workbench.ListWorkbenchServerEvents(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	eventsRes, err := converter.PodEventsFromBusiness(events)
+	if err != nil {
+		return nil, cerr.ErrConversion.Wrap(err, "Failed to convert events")
+	}
+
+	return &chorus.ListWorkbenchServerEventsReply{Result: &chorus.ListWorkbenchServerEventsResult{Events: eventsRes}}, nil
+}
+
+func (c WorkbenchController) GetWorkbenchUsage(ctx context.Context, req *chorus.GetWorkbenchUsageRequest) (*chorus.GetWorkbenchUsageReply, error) {
+	if req == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Could not extract tenant ID from token")
+	}
+
+	usage, err := c.workbench.GetWorkbenchUsage(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	usageRes, err := converter.WorkbenchUsageFromBusiness(usage)
+	if err != nil {
+		return nil, cerr.ErrConversion.Wrap(err, "Failed to convert workbench usage")
+	}
+
+	return &chorus.GetWorkbenchUsageReply{Result: &chorus.GetWorkbenchUsageResult{Usage: usageRes}}, nil
+}
+
+func (c WorkbenchController) GetWorkspaceUsage(ctx context.Context, req *chorus.GetWorkspaceUsageRequest) (*chorus.GetWorkspaceUsageReply, error) {
+	if req == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Could not extract tenant ID from token")
+	}
+
+	usage, err := c.workbench.GetWorkspaceUsage(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	usageRes, err := converter.WorkspaceUsageFromBusiness(usage)
+	if err != nil {
+		return nil, cerr.ErrConversion.Wrap(err, "Failed to convert workspace usage")
+	}
+
+	return &chorus.GetWorkspaceUsageReply{Result: &chorus.GetWorkspaceUsageResult{Usage: usageRes}}, nil
+}
+
+func (c WorkbenchController) ListWorkbenchConnections(ctx context.Context, req *chorus.ListWorkbenchConnectionsRequest) (*chorus.ListWorkbenchConnectionsReply, error) {
+	return c.listWorkbenchConnections(ctx, req, func(filter *model.WorkbenchConnectionFilter) {
+		filter.WorkbenchID = req.Id
+	})
+}
+
+func (c WorkbenchController) ListWorkspaceWorkbenchConnections(ctx context.Context, req *chorus.ListWorkbenchConnectionsRequest) (*chorus.ListWorkbenchConnectionsReply, error) {
+	return c.listWorkbenchConnections(ctx, req, func(filter *model.WorkbenchConnectionFilter) {
+		filter.WorkspaceID = req.Id
+	})
+}
+
+func (c WorkbenchController) ListUserWorkbenchConnections(ctx context.Context, req *chorus.ListWorkbenchConnectionsRequest) (*chorus.ListWorkbenchConnectionsReply, error) {
+	return c.listWorkbenchConnections(ctx, req, func(filter *model.WorkbenchConnectionFilter) {
+		filter.UserID = req.Id
+	})
+}
+
+// listWorkbenchConnections lists the connections of the filter in the request, scoped to
+// the entity of the route the caller is authorized for.
+func (c WorkbenchController) listWorkbenchConnections(ctx context.Context, req *chorus.ListWorkbenchConnectionsRequest, scope func(*model.WorkbenchConnectionFilter)) (*chorus.ListWorkbenchConnectionsReply, error) {
+	if req == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Could not extract tenant ID from token")
+	}
+
+	pagination := converter.PaginationToBusiness(req.Pagination)
+
+	filter, err := converter.WorkbenchConnectionFilterToBusiness(req.Filter)
+	if err != nil {
+		return nil, cerr.ErrConversion.Wrap(err, "Failed to convert workbench connection filter")
+	}
+	scope(&filter)
+
+	res, paginationRes, err := c.workbench.ListWorkbenchConnections(ctx, tenantID, &pagination, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var connections []*chorus.WorkbenchConnection
+	for _, r := range res {
+		connection, err := converter.WorkbenchConnectionFromBusiness(r)
+		if err != nil {
+			return nil, cerr.ErrConversion.Wrap(err, "Failed to convert workbench connection")
+		}
+		connections = append(connections, connection)
+	}
+
+	var paginationResult *chorus.PaginationResult
+	if paginationRes != nil {
+		paginationResult = converter.PaginationResultFromBusiness(paginationRes)
+	}
+
+	return &chorus.ListWorkbenchConnectionsReply{Result: &chorus.ListWorkbenchConnectionsResult{Connections: connections}, Pagination: paginationResult}, nil
+}
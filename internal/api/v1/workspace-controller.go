@@ -92,6 +92,81 @@ func (c WorkspaceController) UpdateWorkspace(ctx context.Context, req *chorus.Wo
 	return &chorus.UpdateWorkspaceReply{Result: &chorus.UpdateWorkspaceResult{Workspace: tgWorkspace}}, nil
 }
 
+func (c WorkspaceController) ExtendWorkspace(ctx context.Context, req *chorus.ExtendWorkspaceRequest) (*chorus.ExtendWorkspaceReply, error) {
+	if req == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Empty request")
+	}
+	if req.ExpiresAt == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Missing end date")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Could not extract tenant ID from token")
+	}
+
+	workspace, err := c.workspace.ExtendWorkspace(ctx, tenantID, req.Id, req.ExpiresAt.AsTime())
+	if err != nil {
+		return nil, err
+	}
+
+	tgWorkspace, err := converter.WorkspaceFromBusiness(workspace, c.cfg.Services.WorkspaceService.GIDOffset)
+	if err != nil {
+		return nil, cerr.ErrConversion.Wrap(err, "Failed to convert workspace")
+	}
+
+	return &chorus.ExtendWorkspaceReply{Result: &chorus.ExtendWorkspaceResult{Workspace: tgWorkspace}}, nil
+}
+
+func (c WorkspaceController) FreezeWorkspace(ctx context.Context, req *chorus.FreezeWorkspaceRequest) (*chorus.FreezeWorkspaceReply, error) {
+	if req == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Empty request")
+	}
+	if req.Reason == "" {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Missing reason")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Could not extract tenant ID from token")
+	}
+
+	workspace, err := c.workspace.FreezeWorkspace(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	tgWorkspace, err := converter.WorkspaceFromBusiness(workspace, c.cfg.Services.WorkspaceService.GIDOffset)
+	if err != nil {
+		return nil, cerr.ErrConversion.Wrap(err, "Failed to convert workspace")
+	}
+
+	return &chorus.FreezeWorkspaceReply{Result: &chorus.FreezeWorkspaceResult{Workspace: tgWorkspace}}, nil
+}
+
+func (c WorkspaceController) UnfreezeWorkspace(ctx context.Context, req *chorus.UnfreezeWorkspaceRequest) (*chorus.UnfreezeWorkspaceReply, error) {
+	if req == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Could not extract tenant ID from token")
+	}
+
+	workspace, err := c.workspace.UnfreezeWorkspace(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	tgWorkspace, err := converter.WorkspaceFromBusiness(workspace, c.cfg.Services.WorkspaceService.GIDOffset)
+	if err != nil {
+		return nil, cerr.ErrConversion.Wrap(err, "Failed to convert workspace")
+	}
+
+	return &chorus.UnfreezeWorkspaceReply{Result: &chorus.UnfreezeWorkspaceResult{Workspace: tgWorkspace}}, nil
+}
+
 func (c WorkspaceController) DeleteWorkspace(ctx context.Context, req *chorus.DeleteWorkspaceRequest) (*chorus.DeleteWorkspaceReply, error) {
 	if req == nil {
 		return nil, cerr.ErrInvalidRequest.WithMessage("Empty request")
@@ -0,0 +1,226 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
+	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/converter"
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	jwt_model "github.com/CHORUS-TRE/chorus-backend/internal/jwt/model"
+	authz "github.com/CHORUS-TRE/chorus-backend/pkg/authorization/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workspace/service"
+)
+
+var _ chorus.WorkspaceInvitationServiceServer = (*WorkspaceInvitationController)(nil)
+
+func NewWorkspaceInvitationController(workspaceer service.Workspaceer) WorkspaceInvitationController {
+	return WorkspaceInvitationController{workspaceer: workspaceer}
+}
+
+type WorkspaceInvitationController struct {
+	workspaceer service.Workspaceer
+}
+
+func (c WorkspaceInvitationController) CreateWorkspaceInvitation(ctx context.Context, req *chorus.CreateWorkspaceInvitationRequest) (*chorus.CreateWorkspaceInvitationReply, error) {
+	if req == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, cerr.ErrUnauthenticated.Wrap(err, "Could not extract tenant id from jwt-token")
+	}
+
+	userID, err := jwt_model.ExtractUserID(ctx)
+	if err != nil {
+		return nil, cerr.ErrUnauthenticated.Wrap(err, "Could not extract user id from jwt-token")
+	}
+
+	invitation := &model.WorkspaceInvitation{
+		TenantID:     tenantID,
+		WorkspaceID:  req.WorkspaceId,
+		InviterID:    userID,
+		InviteeEmail: req.Email,
+		Role:         authz.RoleName(req.RoleName),
+	}
+	if req.UserId != 0 {
+		invitation.InviteeUserID = &req.UserId
+	}
+	if req.ExpiresAt != nil {
+		invitation.ExpiresAt = req.ExpiresAt.AsTime()
+	}
+
+	created, err := c.workspaceer.CreateWorkspaceInvitation(ctx, invitation)
+	if err != nil {
+		return nil, err
+	}
+
+	pbInvitation, err := converter.WorkspaceInvitationFromBusiness(created)
+	if err != nil {
+		return nil, cerr.ErrConversion.Wrap(err, "Failed to convert workspace invitation")
+	}
+
+	return &chorus.CreateWorkspaceInvitationReply{Result: &chorus.CreateWorkspaceInvitationResult{Invitation: pbInvitation}}, nil
+}
+
+func (c WorkspaceInvitationController) ListWorkspaceInvitations(ctx context.Context, req *chorus.ListWorkspaceInvitationsRequest) (*chorus.ListWorkspaceInvitationsReply, error) {
+	if req == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, cerr.ErrUnauthenticated.Wrap(err, "Could not extract tenant id from jwt-token")
+	}
+
+	pagination := converter.PaginationToBusiness(req.Pagination)
+
+	filter := model.WorkspaceInvitationFilter{}
+	for _, s := range req.StatusesIn {
+		filter.StatusesIn = append(filter.StatusesIn, model.WorkspaceInvitationStatus(s))
+	}
+
+	res, paginationRes, err := c.workspaceer.ListWorkspaceInvitations(ctx, tenantID, req.WorkspaceId, &pagination, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	invitations, err := workspaceInvitationsFromBusiness(res)
+	if err != nil {
+		return nil, err
+	}
+
+	var paginationResult *chorus.PaginationResult
+	if paginationRes != nil {
+		paginationResult = converter.PaginationResultFromBusiness(paginationRes)
+	}
+
+	return &chorus.ListWorkspaceInvitationsReply{Result: &chorus.ListWorkspaceInvitationsResult{Invitations: invitations}, Pagination: paginationResult}, nil
+}
+
+func (c WorkspaceInvitationController) ListMyWorkspaceInvitations(ctx context.Context, req *chorus.ListMyWorkspaceInvitationsRequest) (*chorus.ListMyWorkspaceInvitationsReply, error) {
+	if req == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, cerr.ErrUnauthenticated.Wrap(err, "Could not extract tenant id from jwt-token")
+	}
+
+	userID, err := jwt_model.ExtractUserID(ctx)
+	if err != nil {
+		return nil, cerr.ErrUnauthenticated.Wrap(err, "Could not extract user id from jwt-token")
+	}
+
+	pagination := converter.PaginationToBusiness(req.Pagination)
+
+	res, paginationRes, err := c.workspaceer.ListInviteeWorkspaceInvitations(ctx, tenantID, userID, &pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	invitations, err := workspaceInvitationsFromBusiness(res)
+	if err != nil {
+		return nil, err
+	}
+
+	var paginationResult *chorus.PaginationResult
+	if paginationRes != nil {
+		paginationResult = converter.PaginationResultFromBusiness(paginationRes)
+	}
+
+	return &chorus.ListMyWorkspaceInvitationsReply{Result: &chorus.ListMyWorkspaceInvitationsResult{Invitations: invitations}, Pagination: paginationResult}, nil
+}
+
+func (c WorkspaceInvitationController) AcceptWorkspaceInvitation(ctx context.Context, req *chorus.RespondWorkspaceInvitationRequest) (*chorus.AcceptWorkspaceInvitationReply, error) {
+	if req == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, cerr.ErrUnauthenticated.Wrap(err, "Could not extract tenant id from jwt-token")
+	}
+
+	userID, err := jwt_model.ExtractUserID(ctx)
+	if err != nil {
+		return nil, cerr.ErrUnauthenticated.Wrap(err, "Could not extract user id from jwt-token")
+	}
+
+	invitation, err := c.workspaceer.AcceptWorkspaceInvitation(ctx, tenantID, userID, req.Id, req.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	pbInvitation, err := converter.WorkspaceInvitationFromBusiness(invitation)
+	if err != nil {
+		return nil, cerr.ErrConversion.Wrap(err, "Failed to convert workspace invitation")
+	}
+
+	return &chorus.AcceptWorkspaceInvitationReply{Result: &chorus.AcceptWorkspaceInvitationResult{Invitation: pbInvitation}}, nil
+}
+
+func (c WorkspaceInvitationController) DeclineWorkspaceInvitation(ctx context.Context, req *chorus.RespondWorkspaceInvitationRequest) (*chorus.DeclineWorkspaceInvitationReply, error) {
+	if req == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, cerr.ErrUnauthenticated.Wrap(err, "Could not extract tenant id from jwt-token")
+	}
+
+	userID, err := jwt_model.ExtractUserID(ctx)
+	if err != nil {
+		return nil, cerr.ErrUnauthenticated.Wrap(err, "Could not extract user id from jwt-token")
+	}
+
+	invitation, err := c.workspaceer.DeclineWorkspaceInvitation(ctx, tenantID, userID, req.Id, req.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	pbInvitation, err := converter.WorkspaceInvitationFromBusiness(invitation)
+	if err != nil {
+		return nil, cerr.ErrConversion.Wrap(err, "Failed to convert workspace invitation")
+	}
+
+	return &chorus.DeclineWorkspaceInvitationReply{Result: &chorus.DeclineWorkspaceInvitationResult{Invitation: pbInvitation}}, nil
+}
+
+func (c WorkspaceInvitationController) RevokeWorkspaceInvitation(ctx context.Context, req *chorus.RevokeWorkspaceInvitationRequest) (*chorus.RevokeWorkspaceInvitationReply, error) {
+	if req == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, cerr.ErrUnauthenticated.Wrap(err, "Could not extract tenant id from jwt-token")
+	}
+
+	invitation, err := c.workspaceer.RevokeWorkspaceInvitation(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	pbInvitation, err := converter.WorkspaceInvitationFromBusiness(invitation)
+	if err != nil {
+		return nil, cerr.ErrConversion.Wrap(err, "Failed to convert workspace invitation")
+	}
+
+	return &chorus.RevokeWorkspaceInvitationReply{Result: &chorus.RevokeWorkspaceInvitationResult{Invitation: pbInvitation}}, nil
+}
+
+func workspaceInvitationsFromBusiness(invitations []*model.WorkspaceInvitation) ([]*chorus.WorkspaceInvitation, error) {
+	res := make([]*chorus.WorkspaceInvitation, 0, len(invitations))
+	for _, i := range invitations {
+		pbInvitation, err := converter.WorkspaceInvitationFromBusiness(i)
+		if err != nil {
+			return nil, cerr.ErrConversion.Wrap(err, "Failed to convert workspace invitation")
+		}
+		res = append(res, pbInvitation)
+	}
+	return res, nil
+}
@@ -0,0 +1,215 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/chorus"
+	"github.com/CHORUS-TRE/chorus-backend/internal/api/v1/converter"
+	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	jwt_model "github.com/CHORUS-TRE/chorus-backend/internal/jwt/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workspace/service"
+)
+
+var _ chorus.WorkspaceTemplateServiceServer = (*WorkspaceTemplateController)(nil)
+
+func NewWorkspaceTemplateController(workspaceer service.Workspaceer, cfg config.Config) WorkspaceTemplateController {
+	return WorkspaceTemplateController{workspaceer: workspaceer, cfg: cfg}
+}
+
+type WorkspaceTemplateController struct {
+	workspaceer service.Workspaceer
+	cfg         config.Config
+}
+
+func (c WorkspaceTemplateController) GetWorkspaceTemplate(ctx context.Context, req *chorus.GetWorkspaceTemplateRequest) (*chorus.GetWorkspaceTemplateReply, error) {
+	if req == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, cerr.ErrUnauthenticated.Wrap(err, "Could not extract tenant id from jwt-token")
+	}
+
+	template, err := c.workspaceer.GetWorkspaceTemplate(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	pbTemplate, err := converter.WorkspaceTemplateFromBusiness(template)
+	if err != nil {
+		return nil, cerr.ErrConversion.Wrap(err, "Failed to convert workspace template")
+	}
+
+	return &chorus.GetWorkspaceTemplateReply{Result: &chorus.GetWorkspaceTemplateResult{WorkspaceTemplate: pbTemplate}}, nil
+}
+
+func (c WorkspaceTemplateController) ListWorkspaceTemplates(ctx context.Context, req *chorus.ListWorkspaceTemplatesRequest) (*chorus.ListWorkspaceTemplatesReply, error) {
+	if req == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, cerr.ErrUnauthenticated.Wrap(err, "Could not extract tenant id from jwt-token")
+	}
+
+	pagination := converter.PaginationToBusiness(req.Pagination)
+
+	res, paginationRes, err := c.workspaceer.ListWorkspaceTemplates(ctx, tenantID, &pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	var templates []*chorus.WorkspaceTemplate
+	for _, r := range res {
+		pbTemplate, err := converter.WorkspaceTemplateFromBusiness(r)
+		if err != nil {
+			return nil, cerr.ErrConversion.Wrap(err, "Failed to convert workspace template")
+		}
+		templates = append(templates, pbTemplate)
+	}
+
+	var paginationResult *chorus.PaginationResult
+	if paginationRes != nil {
+		paginationResult = converter.PaginationResultFromBusiness(paginationRes)
+	}
+
+	return &chorus.ListWorkspaceTemplatesReply{Result: &chorus.ListWorkspaceTemplatesResult{WorkspaceTemplates: templates}, Pagination: paginationResult}, nil
+}
+
+func (c WorkspaceTemplateController) CreateWorkspaceTemplate(ctx context.Context, req *chorus.WorkspaceTemplate) (*chorus.CreateWorkspaceTemplateReply, error) {
+	if req == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, cerr.ErrUnauthenticated.Wrap(err, "Could not extract tenant id from jwt-token")
+	}
+
+	userID, err := jwt_model.ExtractUserID(ctx)
+	if err != nil {
+		return nil, cerr.ErrUnauthenticated.Wrap(err, "Could not extract user id from jwt-token")
+	}
+
+	template, err := converter.WorkspaceTemplateToBusiness(req)
+	if err != nil {
+		return nil, cerr.ErrConversion.Wrap(err, "Failed to convert workspace template")
+	}
+
+	template.TenantID = tenantID
+	template.UserID = userID
+
+	created, err := c.workspaceer.CreateWorkspaceTemplate(ctx, template)
+	if err != nil {
+		return nil, err
+	}
+
+	pbTemplate, err := converter.WorkspaceTemplateFromBusiness(created)
+	if err != nil {
+		return nil, cerr.ErrConversion.Wrap(err, "Failed to convert workspace template")
+	}
+
+	return &chorus.CreateWorkspaceTemplateReply{Result: &chorus.CreateWorkspaceTemplateResult{WorkspaceTemplate: pbTemplate}}, nil
+}
+
+func (c WorkspaceTemplateController) UpdateWorkspaceTemplate(ctx context.Context, req *chorus.WorkspaceTemplate) (*chorus.UpdateWorkspaceTemplateReply, error) {
+	if req == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, cerr.ErrUnauthenticated.Wrap(err, "Could not extract tenant id from jwt-token")
+	}
+
+	template, err := converter.WorkspaceTemplateToBusiness(req)
+	if err != nil {
+		return nil, cerr.ErrConversion.Wrap(err, "Failed to convert workspace template")
+	}
+
+	template.TenantID = tenantID
+
+	updated, err := c.workspaceer.UpdateWorkspaceTemplate(ctx, template)
+	if err != nil {
+		return nil, err
+	}
+
+	pbTemplate, err := converter.WorkspaceTemplateFromBusiness(updated)
+	if err != nil {
+		return nil, cerr.ErrConversion.Wrap(err, "Failed to convert workspace template")
+	}
+
+	return &chorus.UpdateWorkspaceTemplateReply{Result: &chorus.UpdateWorkspaceTemplateResult{WorkspaceTemplate: pbTemplate}}, nil
+}
+
+func (c WorkspaceTemplateController) DeleteWorkspaceTemplate(ctx context.Context, req *chorus.DeleteWorkspaceTemplateRequest) (*chorus.DeleteWorkspaceTemplateReply, error) {
+	if req == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, cerr.ErrUnauthenticated.Wrap(err, "Could not extract tenant id from jwt-token")
+	}
+
+	err = c.workspaceer.DeleteWorkspaceTemplate(ctx, tenantID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &chorus.DeleteWorkspaceTemplateReply{Result: &chorus.DeleteWorkspaceTemplateResult{}}, nil
+}
+
+func (c WorkspaceTemplateController) CreateWorkspaceFromTemplate(ctx context.Context, req *chorus.CreateWorkspaceFromTemplateRequest) (*chorus.CreateWorkspaceFromTemplateReply, error) {
+	if req == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Empty request")
+	}
+
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, cerr.ErrUnauthenticated.Wrap(err, "Could not extract tenant id from jwt-token")
+	}
+
+	userID, err := jwt_model.ExtractUserID(ctx)
+	if err != nil {
+		return nil, cerr.ErrUnauthenticated.Wrap(err, "Could not extract user id from jwt-token")
+	}
+
+	workspace := &model.Workspace{
+		TenantID:    tenantID,
+		UserID:      userID,
+		Name:        req.Name,
+		ShortName:   req.ShortName,
+		Description: req.Description,
+		Status:      model.WorkspaceStatusActive,
+	}
+	if req.ExpiresAt != nil {
+		expiresAt := req.ExpiresAt.AsTime()
+		workspace.ExpiresAt = &expiresAt
+	}
+
+	created, svcs, err := c.workspaceer.CreateWorkspaceFromTemplate(ctx, req.TemplateId, workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	pbWorkspace, err := converter.WorkspaceFromBusiness(created, c.cfg.Services.WorkspaceService.GIDOffset)
+	if err != nil {
+		return nil, cerr.ErrConversion.Wrap(err, "Failed to convert workspace")
+	}
+
+	var pbSvcs []*chorus.WorkspaceServiceInstance
+	for _, svc := range svcs {
+		pbSvc, err := converter.WorkspaceServiceInstanceFromBusiness(svc)
+		if err != nil {
+			return nil, cerr.ErrConversion.Wrap(err, "Failed to convert workspace service instance")
+		}
+		pbSvcs = append(pbSvcs, pbSvc)
+	}
+
+	return &chorus.CreateWorkspaceFromTemplateReply{Result: &chorus.CreateWorkspaceFromTemplateResult{Workspace: pbWorkspace, WorkspaceServiceInstances: pbSvcs}}, nil
+}
@@ -0,0 +1,83 @@
+package catalog
+
+import (
+	"strings"
+	"time"
+)
+
+// Image is a tagged image of a registry, with the labels of its config.
+type Image struct {
+	// Repository is the name of the image in its registry, e.g. "apps/vscode".
+	Repository string
+	Tag        string
+	Digest     string
+	Labels     map[string]string
+	// PushedAt is when the tag was pushed to the registry, zero when the registry does
+	// not tell.
+	PushedAt time.Time
+}
+
+// Source is a registry the app catalog is synced from.
+type Source interface {
+	// Name identifies the source in logs.
+	Name() string
+	// Registry is the host the images of the source are pulled from.
+	Registry() string
+	// ListImages returns the tagged images of the source, with their labels mapped to
+	// the chorus labels.
+	ListImages() ([]Image, error)
+}
+
+// mapLabels adds to the labels of an image the chorus labels the mapping points to,
+// e.g. "ch.chorus-tre.app.name" from the "org.example.app.name" label. Labels already
+// set on the image are kept when their mapped label is missing.
+func mapLabels(labels map[string]string, mapping map[string]string) map[string]string {
+	if len(mapping) == 0 {
+		return labels
+	}
+
+	mapped := make(map[string]string, len(labels)+len(mapping))
+	for k, v := range labels {
+		mapped[k] = v
+	}
+	for chorusLabel, label := range mapping {
+		if v, ok := labels[label]; ok {
+			mapped[chorusLabel] = v
+		}
+	}
+	return mapped
+}
+
+// filterLabels keeps the labels starting with one of the prefixes, or all of them when
+// there is no prefix.
+func filterLabels(labels map[string]string, prefixes []string) map[string]string {
+	if len(prefixes) == 0 {
+		return labels
+	}
+
+	filtered := make(map[string]string, len(labels))
+	for k, v := range labels {
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(k, prefix) {
+				filtered[k] = v
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+// inProjects tells whether a repository is under one of the projects, or whether there
+// is no project to filter on.
+func inProjects(repository string, projects []string) bool {
+	if len(projects) == 0 {
+		return true
+	}
+
+	for _, project := range projects {
+		if strings.HasPrefix(repository, strings.TrimSuffix(project, "/")+"/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,158 @@
+//go:build unit
+
+package catalog
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/harbor"
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/ociregistry"
+	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+)
+
+func TestMain(m *testing.M) {
+	logger.TechLog = logger.NewNop()
+	os.Exit(m.Run())
+}
+
+type fakeRegistry struct {
+	ociregistry.OCIClienter
+	tags   map[string][]string
+	labels map[string]map[string]string
+	// broken lists the repositories and image references that cannot be read.
+	broken map[string]bool
+}
+
+func (r *fakeRegistry) ListRepositories(_ string) ([]string, error) {
+	repos := make([]string, 0, len(r.tags))
+	for repo := range r.tags {
+		repos = append(repos, repo)
+	}
+	return repos, nil
+}
+
+func (r *fakeRegistry) ListTags(repository string) ([]string, error) {
+	_, repo, _ := strings.Cut(repository, "/")
+	if r.broken[repo] {
+		return nil, errors.New("unauthorized")
+	}
+	return r.tags[repo], nil
+}
+
+func (r *fakeRegistry) GetDigest(imageRef string) (string, error) {
+	if r.broken[imageRef] {
+		return "", errors.New("manifest unknown")
+	}
+	return "sha256:" + imageRef[strings.LastIndex(imageRef, ":")+1:], nil
+}
+
+func (r *fakeRegistry) GetLabels(imageRef string) (map[string]string, error) {
+	if r.broken[imageRef] {
+		return nil, errors.New("blob unknown")
+	}
+	_, repo, _ := strings.Cut(imageRef, "/")
+	repo, _, _ = strings.Cut(repo, "@")
+	return r.labels[repo], nil
+}
+
+func TestOCISource_ListImages(t *testing.T) {
+	registry := &fakeRegistry{
+		tags: map[string][]string{
+			"apps/vscode":  {"1.0", "1.1"},
+			"tools/ubuntu": {"24.04"},
+		},
+		labels: map[string]map[string]string{
+			"apps/vscode": {
+				"org.example.app.name":           "vscode",
+				"org.opencontainers.image.title": "VS Code",
+				"com.vendor.build":               "42",
+			},
+		},
+	}
+	source := NewOCISource("partner", config.OCICatalog{
+		Host:               "registry.example.com",
+		Projects:           []string{"apps"},
+		LabelPrefixes:      []string{"ch.chorus-tre.", "org.opencontainers."},
+		LabelMapping:       map[string]string{"ch.chorus-tre.app.name": "org.example.app.name"},
+		MaxParallelFetches: 2,
+	}, registry)
+
+	assert.Equal(t, "partner", source.Name())
+	assert.Equal(t, "registry.example.com", source.Registry())
+
+	images, err := source.ListImages()
+	require.NoError(t, err)
+
+	// Only the repositories of the project are synced.
+	require.Len(t, images, 2)
+	for i, tag := range []string{"1.0", "1.1"} {
+		assert.Equal(t, "apps/vscode", images[i].Repository)
+		assert.Equal(t, tag, images[i].Tag)
+		assert.Equal(t, "sha256:"+tag, images[i].Digest)
+		// The mapped label is added, and the labels outside the prefixes are dropped.
+		assert.Equal(t, map[string]string{
+			"ch.chorus-tre.app.name":         "vscode",
+			"org.opencontainers.image.title": "VS Code",
+		}, images[i].Labels)
+	}
+}
+
+func TestOCISource_ListImagesSkipsUnreadableImages(t *testing.T) {
+	registry := &fakeRegistry{
+		tags: map[string][]string{
+			"apps/vscode":  {"1.0", "1.1", "1.2"},
+			"apps/private": {"1.0"},
+		},
+		broken: map[string]bool{
+			"apps/private":                                true,
+			"registry.example.com/apps/vscode:1.0":        true,
+			"registry.example.com/apps/vscode@sha256:1.1": true,
+		},
+	}
+	source := NewOCISource("partner", config.OCICatalog{Host: "registry.example.com", Projects: []string{"apps"}}, registry)
+
+	// The unreadable repository and tags are skipped, and the rest of the registry syncs.
+	images, err := source.ListImages()
+	require.NoError(t, err)
+	require.Len(t, images, 1)
+	assert.Equal(t, "apps/vscode", images[0].Repository)
+	assert.Equal(t, "1.2", images[0].Tag)
+}
+
+type fakeHarbor struct {
+	harbor.HarborNoopClient
+	apps []harbor.App
+}
+
+func (h *fakeHarbor) ListApps() ([]harbor.App, error) {
+	return h.apps, nil
+}
+
+func TestHarborSource_ListImages(t *testing.T) {
+	source := NewHarborSource(&fakeHarbor{apps: []harbor.App{
+		{Repository: "chorus/vscode", Tag: "1.0", Digest: "sha256:a", Labels: map[string]string{"org.example.app.name": "vscode"}},
+	}}, "harbor.example.com", map[string]string{"ch.chorus-tre.app.name": "org.example.app.name"})
+
+	images, err := source.ListImages()
+	require.NoError(t, err)
+	require.Len(t, images, 1)
+	assert.Equal(t, "chorus/vscode", images[0].Repository)
+	assert.Equal(t, "sha256:a", images[0].Digest)
+	assert.Equal(t, "vscode", images[0].Labels["ch.chorus-tre.app.name"])
+	assert.Equal(t, "vscode", images[0].Labels["org.example.app.name"])
+}
+
+func TestInProjects(t *testing.T) {
+	assert.True(t, inProjects("apps/vscode", nil))
+	assert.True(t, inProjects("apps/vscode", []string{"apps"}))
+	assert.True(t, inProjects("apps/vscode", []string{"tools", "apps/"}))
+	assert.False(t, inProjects("apps-old/vscode", []string{"apps"}))
+	assert.False(t, inProjects("vscode", []string{"apps"}))
+}
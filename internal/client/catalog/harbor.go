@@ -0,0 +1,51 @@
+package catalog
+
+import (
+	"fmt"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/harbor"
+)
+
+var _ Source = &harborSource{}
+
+// harborSource lists the images of a Harbor project through the Harbor API.
+type harborSource struct {
+	client       harbor.HarborClient
+	registry     string
+	labelMapping map[string]string
+}
+
+func NewHarborSource(client harbor.HarborClient, registry string, labelMapping map[string]string) *harborSource {
+	return &harborSource{
+		client:       client,
+		registry:     registry,
+		labelMapping: labelMapping,
+	}
+}
+
+func (s *harborSource) Name() string {
+	return "harbor"
+}
+
+func (s *harborSource) Registry() string {
+	return s.registry
+}
+
+func (s *harborSource) ListImages() ([]Image, error) {
+	apps, err := s.client.ListApps()
+	if err != nil {
+		return nil, fmt.Errorf("listing apps from harbor: %w", err)
+	}
+
+	images := make([]Image, 0, len(apps))
+	for _, app := range apps {
+		images = append(images, Image{
+			Repository: app.Repository,
+			Tag:        app.Tag,
+			Digest:     app.Digest,
+			Labels:     mapLabels(app.Labels, s.labelMapping),
+			PushedAt:   app.PushedAt,
+		})
+	}
+	return images, nil
+}
@@ -0,0 +1,125 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/ociregistry"
+	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+var _ Source = &ociSource{}
+
+// ociSource lists the images of any registry implementing the OCI distribution API,
+// e.g. registry:2 or Zot, through its _catalog and tags endpoints.
+type ociSource struct {
+	name      string
+	cfg       config.OCICatalog
+	ociClient ociregistry.OCIClienter
+}
+
+func NewOCISource(name string, cfg config.OCICatalog, ociClient ociregistry.OCIClienter) *ociSource {
+	return &ociSource{
+		name:      name,
+		cfg:       cfg,
+		ociClient: ociClient,
+	}
+}
+
+func (s *ociSource) Name() string {
+	return s.name
+}
+
+func (s *ociSource) Registry() string {
+	return s.cfg.Host
+}
+
+// labelOCICreated is the standard label holding the date an image was built. The
+// distribution API has no push time, the build date stands in for it.
+const labelOCICreated = "org.opencontainers.image.created"
+
+// ociTag is a tag of a repository, and the digest and labels of its image once fetched.
+type ociTag struct {
+	repository string
+	tag        string
+	digest     string
+	labels     map[string]string
+	createdAt  time.Time
+}
+
+// ListImages lists the tags of the repositories of the configured projects. A repository
+// or tag that cannot be read is logged and skipped, so that it does not keep the rest of
+// the registry from syncing.
+func (s *ociSource) ListImages() ([]Image, error) {
+	repos, err := s.ociClient.ListRepositories(s.cfg.Host)
+	if err != nil {
+		return nil, fmt.Errorf("listing repositories of %s: %w", s.cfg.Host, err)
+	}
+
+	var tags []*ociTag
+	for _, repo := range repos {
+		if !inProjects(repo, s.cfg.Projects) {
+			continue
+		}
+
+		repoTags, err := s.ociClient.ListTags(s.cfg.Host + "/" + repo)
+		if err != nil {
+			logger.TechLog.Warn(context.Background(), "skipping catalog repository whose tags cannot be listed",
+				zap.String("source", s.name), zap.String("repository", repo), zap.Error(err))
+			continue
+		}
+		for _, tag := range repoTags {
+			tags = append(tags, &ociTag{repository: repo, tag: tag})
+		}
+	}
+
+	// The registry has no endpoint listing the labels of many images, so they are
+	// fetched per tag, in parallel.
+	g := new(errgroup.Group)
+	g.SetLimit(int(max(s.cfg.MaxParallelFetches, 1)))
+	for _, t := range tags {
+		g.Go(func() error {
+			imageRef := s.cfg.Host + "/" + t.repository + ":" + t.tag
+
+			digest, err := s.ociClient.GetDigest(imageRef)
+			if err != nil {
+				logger.TechLog.Warn(context.Background(), "skipping catalog image whose digest cannot be read",
+					zap.String("source", s.name), zap.String("image", imageRef), zap.Error(err))
+				return nil
+			}
+			labels, err := s.ociClient.GetLabels(s.cfg.Host + "/" + t.repository + "@" + digest)
+			if err != nil {
+				logger.TechLog.Warn(context.Background(), "skipping catalog image whose labels cannot be read",
+					zap.String("source", s.name), zap.String("image", imageRef), zap.Error(err))
+				return nil
+			}
+
+			t.digest = digest
+			t.createdAt, _ = time.Parse(time.RFC3339, labels[labelOCICreated])
+			t.labels = filterLabels(mapLabels(labels, s.cfg.LabelMapping), s.cfg.LabelPrefixes)
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	images := make([]Image, 0, len(tags))
+	for _, t := range tags {
+		// The tags whose image could not be read have no digest.
+		if t.digest == "" {
+			continue
+		}
+		images = append(images, Image{
+			Repository: t.repository,
+			Tag:        t.tag,
+			Digest:     t.digest,
+			Labels:     t.labels,
+			PushedAt:   t.createdAt,
+		})
+	}
+	return images, nil
+}
@@ -17,11 +17,25 @@ import (
 type App struct {
 	Repository string            `json:"repository"`
 	Tag        string            `json:"tag"`
+	Digest     string            `json:"digest"`
 	Labels     map[string]string `json:"labels"`
+	PushedAt   time.Time         `json:"pushedAt"`
+}
+
+// ScanOverview is the summary of the last vulnerability scan of an artifact. Status is
+// the status reported by the scanner, "Success" once the report is complete.
+type ScanOverview struct {
+	Status    string
+	Severity  string
+	Summary   map[string]int // number of vulnerabilities per severity, e.g. "Critical"
+	ScannedAt time.Time
 }
 
 type HarborClient interface {
 	ListApps() ([]App, error)
+	// GetScanOverview returns the scan overview of an artifact of the project, addressed
+	// by a tag or a digest. It returns nil when the artifact was never scanned.
+	GetScanOverview(repository, reference string) (*ScanOverview, error)
 }
 
 type HarborNoopClient struct{}
@@ -30,6 +44,10 @@ func (c *HarborNoopClient) ListApps() ([]App, error) {
 	return nil, nil
 }
 
+func (c *HarborNoopClient) GetScanOverview(repository, reference string) (*ScanOverview, error) {
+	return nil, nil
+}
+
 type harborClient struct {
 	cfg       config.HarborClient
 	client    *http.Client
@@ -68,6 +86,25 @@ type harborTag struct {
 	PushTime time.Time `json:"push_time"`
 }
 
+// harborScanArtifact is the Harbor API response for an artifact fetched with its scan
+// overview, which is keyed by the mime type of the report.
+type harborScanArtifact struct {
+	ScanOverview map[string]harborScanReport `json:"scan_overview"`
+}
+
+type harborScanReport struct {
+	ScanStatus string    `json:"scan_status"`
+	Severity   string    `json:"severity"`
+	EndTime    time.Time `json:"end_time"`
+	Summary    struct {
+		Summary map[string]int `json:"summary"`
+	} `json:"summary"`
+}
+
+// acceptVulnerabilities lists the report formats accepted for scan overviews, the
+// current one first.
+const acceptVulnerabilities = "application/vnd.security.vulnerability.report; version=1.1, application/vnd.scanner.adapter.vuln.report.harbor+json; version=1.0"
+
 // imageRef identifies one image whose labels must be retrieved.
 type imageRef struct {
 	repository   string // full repository name, used for the resulting App
@@ -133,7 +170,9 @@ func (c *harborClient) ListApps() ([]App, error) {
 			apps = append(apps, App{
 				Repository: ref.repository,
 				Tag:        tag.Name,
+				Digest:     ref.digest,
 				Labels:     labelsByRef[i],
+				PushedAt:   tag.PushTime,
 			})
 		}
 	}
@@ -149,7 +188,7 @@ func (c *harborClient) listRepositories() ([]harborRepository, error) {
 		url := fmt.Sprintf("%s/api/v2.0/projects/%s/repositories?page_size=%d&page=%d",
 			c.baseURL(), c.cfg.Project, pageSize, page)
 
-		body, err := c.doGet(url)
+		body, err := c.doGet(url, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -177,7 +216,7 @@ func (c *harborClient) listArtifacts(repoName string) ([]harborArtifact, error)
 		url := fmt.Sprintf("%s/api/v2.0/projects/%s/repositories/%s/artifacts?page_size=%d&page=%d",
 			c.baseURL(), c.cfg.Project, repoName, pageSize, page)
 
-		body, err := c.doGet(url)
+		body, err := c.doGet(url, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -227,11 +266,40 @@ func (c *harborClient) filterLabels(all map[string]string) map[string]string {
 	return filtered
 }
 
-func (c *harborClient) doGet(url string) ([]byte, error) {
+func (c *harborClient) GetScanOverview(repository, reference string) (*ScanOverview, error) {
+	url := fmt.Sprintf("%s/api/v2.0/projects/%s/repositories/%s/artifacts/%s?with_scan_overview=true",
+		c.baseURL(), c.cfg.Project, c.stripProjectPrefix(repository), reference)
+
+	body, err := c.doGet(url, http.Header{"X-Accept-Vulnerabilities": {acceptVulnerabilities}})
+	if err != nil {
+		return nil, err
+	}
+
+	var artifact harborScanArtifact
+	if err := json.Unmarshal(body, &artifact); err != nil {
+		return nil, fmt.Errorf("decoding scan overview: %w", err)
+	}
+
+	// Harbor returns a single report, in the first accepted format it has.
+	for _, report := range artifact.ScanOverview {
+		return &ScanOverview{
+			Status:    report.ScanStatus,
+			Severity:  report.Severity,
+			Summary:   report.Summary.Summary,
+			ScannedAt: report.EndTime,
+		}, nil
+	}
+	return nil, nil
+}
+
+func (c *harborClient) doGet(url string, header http.Header) ([]byte, error) {
 	req, err := http.NewRequest(http.MethodGet, url, nil)
 	if err != nil {
 		return nil, err
 	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
 	c.setAuth(req)
 
 	resp, err := c.client.Do(req)
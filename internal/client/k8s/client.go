@@ -53,6 +53,16 @@ type K8sClienter interface {
 	PrePullImageOnAllNodes(image string)
 	GetSecret(namespace, name string) (map[string][]byte, error)
 
+	// Pod diagnostics operations
+	GetWorkbenchServerLogs(namespace, workbenchName string, opts PodLogOptions) (*PodLogs, error)
+	GetAppInstanceLogs(namespace, workbenchName string, appInstance AppInstance, opts PodLogOptions) (*PodLogs, error)
+	ListWorkbenchServerEvents(namespace, workbenchName string) ([]PodEvent, error)
+	ListAppInstanceEvents(namespace, workbenchName string, appInstance AppInstance) ([]PodEvent, error)
+
+	// Resource usage operations
+	GetWorkbenchServerUsage(namespace, workbenchName string) (*PodUsage, error)
+	GetAppInstanceUsage(namespace, workbenchName string, appInstance AppInstance) (*PodUsage, error)
+
 	// Watcher operations
 	RegisterOnNewWorkbenchHandler(func(workbench Workbench) error) error
 	RegisterOnUpdateWorkbenchHandler(func(workbench Workbench) error) error
@@ -72,6 +82,8 @@ type client struct {
 	onUpdateWorkbench func(workbench Workbench) error
 	onDeleteWorkbench func(workbench Workbench) error
 	onUpdateWorkspace func(workspace WorkspaceOutput) error
+
+	metrics *metricsCollector
 }
 
 func NewClient(cfg config.Config) (*client, error) {
@@ -104,6 +116,13 @@ func NewClient(cfg config.Config) (*client, error) {
 		go c.watchWorkbenchEvents()
 	}
 
+	if cfg.Clients.K8sClient.MetricsEnabled {
+		c.metrics = newMetricsCollector(func(ctx context.Context) ([]byte, error) {
+			return k8sClient.CoreV1().RESTClient().Get().AbsPath(podMetricsPath).DoRaw(ctx)
+		}, cfg.Clients.K8sClient.MetricsHistorySize)
+		go c.metrics.run(cfg.Clients.K8sClient.MetricsInterval)
+	}
+
 	return c, nil
 }
 
@@ -103,6 +103,7 @@ func (c *client) appInstanceToK8sWorkbenchApp(app AppInstance) WorkbenchApp {
 			w.Image.Tag = app.AppTag
 		}
 	}
+	w.Image.Digest = app.AppDigest
 
 	if app.ShmSize != "" {
 		shmSize := resource.MustParse(app.ShmSize)
@@ -260,6 +261,7 @@ func (c *client) k8sWorkbenchAppToAppInstance(w WorkbenchApp) (AppInstance, erro
 		app.AppRegistry = w.Image.Registry
 		app.AppImage = w.Image.Repository
 		app.AppTag = w.Image.Tag
+		app.AppDigest = w.Image.Digest
 	}
 
 	app.K8sState = string(w.State)
@@ -371,6 +373,11 @@ func (c *client) workspaceInputToK8sWorkspace(workspace WorkspaceInput) K8sWorks
 		}
 	}
 
+	allowedFQDNs := make([]string, 0, len(workspace.AllowedFQDNs))
+	for _, rule := range workspace.AllowedFQDNs {
+		allowedFQDNs = append(allowedFQDNs, rule.Pattern)
+	}
+
 	k8sWorkspace := K8sWorkspace{
 		TypeMeta: v1.TypeMeta{
 			Kind:       "Workspace",
@@ -386,7 +393,8 @@ func (c *client) workspaceInputToK8sWorkspace(workspace WorkspaceInput) K8sWorks
 		},
 		Spec: WorkspaceSpec{
 			NetworkPolicy: workspace.NetworkPolicy,
-			AllowedFQDNs:  workspace.AllowedFQDNs,
+			AllowedFQDNs:  allowedFQDNs,
+			FQDNRules:     workspace.AllowedFQDNs,
 			Services:      services,
 		},
 	}
@@ -415,6 +423,13 @@ func (c *client) k8sWorkspaceToWorkspaceOutput(ws K8sWorkspace) (WorkspaceOutput
 		ServiceStatuses:      make(map[string]WorkspaceServiceStatusOutput),
 	}
 
+	if len(ws.Status.NetworkPolicy.RejectedFQDNs) > 0 {
+		output.RejectedFQDNs = make(map[string]string, len(ws.Status.NetworkPolicy.RejectedFQDNs))
+		for _, rejected := range ws.Status.NetworkPolicy.RejectedFQDNs {
+			output.RejectedFQDNs[rejected.Pattern] = rejected.Reason
+		}
+	}
+
 	for name, svc := range ws.Status.Services {
 		output.ServiceStatuses[name] = WorkspaceServiceStatusOutput{
 			Status:         string(svc.Status),
@@ -26,6 +26,8 @@ type Image struct {
 	Registry   string `json:"registry,omitempty"`
 	Repository string `json:"repository,omitempty"`
 	Tag        string `json:"tag,omitempty"`
+	// Digest pins the image, the tag is then only informative.
+	Digest string `json:"digest,omitempty"`
 }
 type BrowserConfig struct {
 	URL      string `json:"url"`
@@ -42,17 +42,34 @@ type WorkspaceK8sService struct {
 	ComputedValues         map[string]string            `json:"computedValues,omitempty"`
 }
 
+// WorkspaceFQDNRule allows egress to the hosts matching a pattern, optionally
+// restricted to some ports and to a protocol
+type WorkspaceFQDNRule struct {
+	Pattern  string   `json:"pattern"`
+	Ports    []uint32 `json:"ports,omitempty"`
+	Protocol string   `json:"protocol,omitempty"`
+}
+
 // WorkspaceSpec defines the desired state of the Workspace CRD
 type WorkspaceSpec struct {
-	NetworkPolicy string                         `json:"networkPolicy"`
-	AllowedFQDNs  []string                       `json:"allowedFQDNs,omitempty"`
-	Services      map[string]WorkspaceK8sService `json:"services,omitempty"`
+	NetworkPolicy string `json:"networkPolicy"`
+	// AllowedFQDNs lists the patterns of FQDNRules, for operators predating FQDN rules
+	AllowedFQDNs []string                       `json:"allowedFQDNs,omitempty"`
+	FQDNRules    []WorkspaceFQDNRule            `json:"fqdnRules,omitempty"`
+	Services     map[string]WorkspaceK8sService `json:"services,omitempty"`
+}
+
+// RejectedFQDN is an FQDN rule pattern the operator could not apply
+type RejectedFQDN struct {
+	Pattern string `json:"pattern"`
+	Reason  string `json:"reason,omitempty"`
 }
 
 // NetworkPolicyStatus is the observed state of the workspace network policy
 type NetworkPolicyStatus struct {
-	Status  string `json:"status,omitempty"`
-	Message string `json:"message,omitempty"`
+	Status        string         `json:"status,omitempty"`
+	Message       string         `json:"message,omitempty"`
+	RejectedFQDNs []RejectedFQDN `json:"rejectedFQDNs,omitempty"`
 }
 
 // WorkspaceStatusServiceStatus is the observed state of a workspace service
@@ -0,0 +1,214 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	podMetricsPath = "/apis/metrics.k8s.io/v1beta1/pods"
+	// workspaceNamespacePrefix is the prefix of the namespaces of the workspaces, the only
+	// ones whose usage is kept.
+	workspaceNamespacePrefix = "workspace"
+)
+
+// ErrMetricsDisabled is returned when the usage of pods is not collected.
+var ErrMetricsDisabled = errors.New("pod metrics collection is disabled")
+
+// PodUsageSample is the CPU and memory used by all the containers of a pod.
+type PodUsageSample struct {
+	Timestamp     time.Time
+	CPUMillicores int64
+	MemoryBytes   int64
+}
+
+// PodUsage is the recent usage of a pod, oldest sample first.
+type PodUsage struct {
+	PodName string
+	Samples []PodUsageSample
+}
+
+// ParseCPUMillicores converts a CPU quantity such as "500m" or "2" to millicores.
+func ParseCPUMillicores(s string) (int64, error) {
+	q, err := resource.ParseQuantity(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid CPU quantity %q: %w", s, err)
+	}
+	return q.MilliValue(), nil
+}
+
+// ParseMemoryBytes converts a memory quantity such as "512Mi" or "2G" to bytes.
+func ParseMemoryBytes(s string) (int64, error) {
+	q, err := resource.ParseQuantity(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid memory quantity %q: %w", s, err)
+	}
+	return q.Value(), nil
+}
+
+// podMetricsList is the subset of the metrics.k8s.io PodMetricsList used by the collector.
+type podMetricsList struct {
+	Items []podMetrics `json:"items"`
+}
+
+type podMetrics struct {
+	Metadata struct {
+		Name      string            `json:"name"`
+		Namespace string            `json:"namespace"`
+		Labels    map[string]string `json:"labels"`
+	} `json:"metadata"`
+	Timestamp  time.Time `json:"timestamp"`
+	Containers []struct {
+		Name  string            `json:"name"`
+		Usage map[string]string `json:"usage"`
+	} `json:"containers"`
+}
+
+type podUsageHistory struct {
+	name    string
+	labels  map[string]string
+	samples []PodUsageSample
+}
+
+// metricsCollector polls the metrics API for the usage of the pods of the workspaces and
+// keeps a short history per pod, so that usage can be shown without a monitoring stack.
+type metricsCollector struct {
+	fetch       func(ctx context.Context) ([]byte, error)
+	historySize int
+
+	mu   sync.RWMutex
+	pods map[string]map[string]*podUsageHistory // namespace -> pod name -> history
+}
+
+func newMetricsCollector(fetch func(ctx context.Context) ([]byte, error), historySize int) *metricsCollector {
+	return &metricsCollector{
+		fetch:       fetch,
+		historySize: historySize,
+		pods:        make(map[string]map[string]*podUsageHistory),
+	}
+}
+
+func (m *metricsCollector) run(interval time.Duration) {
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), interval)
+		if err := m.collect(ctx); err != nil {
+			logger.TechLog.Error(ctx, "unable to collect pod metrics", zap.Error(err))
+		}
+		cancel()
+
+		time.Sleep(interval)
+	}
+}
+
+// collect records a sample for each pod of the workspaces and forgets the pods that are gone.
+func (m *metricsCollector) collect(ctx context.Context) error {
+	raw, err := m.fetch(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to get pod metrics: %w", err)
+	}
+
+	var list podMetricsList
+	if err := json.Unmarshal(raw, &list); err != nil {
+		return fmt.Errorf("unable to decode pod metrics: %w", err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	pods := make(map[string]map[string]*podUsageHistory)
+	for _, item := range list.Items {
+		namespace, name := item.Metadata.Namespace, item.Metadata.Name
+		if !strings.HasPrefix(namespace, workspaceNamespacePrefix) {
+			continue
+		}
+
+		sample := PodUsageSample{Timestamp: item.Timestamp}
+		for _, container := range item.Containers {
+			if cpu, err := ParseCPUMillicores(container.Usage["cpu"]); err == nil {
+				sample.CPUMillicores += cpu
+			}
+			if memory, err := ParseMemoryBytes(container.Usage["memory"]); err == nil {
+				sample.MemoryBytes += memory
+			}
+		}
+
+		history := m.pods[namespace][name]
+		if history == nil {
+			history = &podUsageHistory{name: name}
+		}
+		history.labels = item.Metadata.Labels
+		if n := len(history.samples); n == 0 || history.samples[n-1].Timestamp.Before(sample.Timestamp) {
+			history.samples = append(history.samples, sample)
+		}
+		if len(history.samples) > m.historySize {
+			history.samples = history.samples[len(history.samples)-m.historySize:]
+		}
+
+		if pods[namespace] == nil {
+			pods[namespace] = make(map[string]*podUsageHistory)
+		}
+		pods[namespace][name] = history
+	}
+	m.pods = pods
+
+	return nil
+}
+
+// usage returns the usage of the pod of a namespace that matches, preferring the one
+// with the most recent sample; nil when none does.
+func (m *metricsCollector) usage(namespace string, match func(name string, labels map[string]string) bool) *PodUsage {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var found *podUsageHistory
+	for _, history := range m.pods[namespace] {
+		if !match(history.name, history.labels) || len(history.samples) == 0 {
+			continue
+		}
+		if found == nil || found.samples[len(found.samples)-1].Timestamp.Before(history.samples[len(history.samples)-1].Timestamp) {
+			found = history
+		}
+	}
+	if found == nil {
+		return nil
+	}
+
+	return &PodUsage{
+		PodName: found.name,
+		Samples: append([]PodUsageSample(nil), found.samples...),
+	}
+}
+
+// GetWorkbenchServerUsage returns the recent usage of the workbench server pod, or nil
+// when no sample has been collected for it.
+func (c *client) GetWorkbenchServerUsage(namespace, workbenchName string) (*PodUsage, error) {
+	if c.metrics == nil {
+		return nil, ErrMetricsDisabled
+	}
+
+	return c.metrics.usage(namespace, func(_ string, labels map[string]string) bool {
+		return labels["workbench"] == workbenchName
+	}), nil
+}
+
+// GetAppInstanceUsage returns the recent usage of the pod of an app instance, or nil when
+// no sample has been collected for it.
+func (c *client) GetAppInstanceUsage(namespace, workbenchName string, appInstance AppInstance) (*PodUsage, error) {
+	if c.metrics == nil {
+		return nil, ErrMetricsDisabled
+	}
+
+	// The app instance UID is unique across the namespace, the workbench is not needed.
+	return c.metrics.usage(namespace, func(name string, labels map[string]string) bool {
+		return isAppInstancePod(appInstance, name, labels)
+	}), nil
+}
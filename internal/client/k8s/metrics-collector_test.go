@@ -0,0 +1,76 @@
+//go:build unit
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func podMetricsJSON(ts time.Time, pods ...string) []byte {
+	items := make([]string, 0, len(pods))
+	for _, pod := range pods {
+		items = append(items, fmt.Sprintf(pod, ts.Format(time.RFC3339)))
+	}
+	return []byte(fmt.Sprintf(`{"kind":"PodMetricsList","items":[%s]}`, strings.Join(items, ",")))
+}
+
+const (
+	serverPodMetrics = `{"metadata":{"name":"workbench3-server","namespace":"workspace7","labels":{"workbench":"workbench3"}},"timestamp":"%[1]s","containers":[{"name":"server","usage":{"cpu":"150m","memory":"100Mi"}}]}`
+	appPodMetrics    = `{"metadata":{"name":"workbench3-app-instance-10-x2k","namespace":"workspace7","labels":{"job-name":"workbench3-app-instance-10"}},"timestamp":"%[1]s","containers":[{"name":"app","usage":{"cpu":"1500000000n","memory":"1Gi"}},{"name":"sidecar","usage":{"cpu":"10m","memory":"0"}}]}`
+	systemPodMetrics = `{"metadata":{"name":"coredns","namespace":"kube-system"},"timestamp":"%[1]s","containers":[{"name":"coredns","usage":{"cpu":"5m","memory":"20Mi"}}]}`
+)
+
+func TestMetricsCollector_KeepsShortHistoryOfWorkspacePods(t *testing.T) {
+	start := time.Now().Truncate(time.Second)
+	var payload []byte
+	m := newMetricsCollector(func(context.Context) ([]byte, error) { return payload, nil }, 2)
+
+	for i := 0; i < 3; i++ {
+		payload = podMetricsJSON(start.Add(time.Duration(i)*time.Minute), serverPodMetrics, appPodMetrics, systemPodMetrics)
+		require.NoError(t, m.collect(context.Background()))
+	}
+	// The same sample polled again is not recorded twice.
+	require.NoError(t, m.collect(context.Background()))
+
+	c := &client{metrics: m}
+	server, err := c.GetWorkbenchServerUsage("workspace7", "workbench3")
+	require.NoError(t, err)
+	require.NotNil(t, server)
+	require.Len(t, server.Samples, 2)
+	assert.Equal(t, start.Add(2*time.Minute), server.Samples[1].Timestamp.Local())
+	assert.Equal(t, int64(150), server.Samples[1].CPUMillicores)
+	assert.Equal(t, int64(100<<20), server.Samples[1].MemoryBytes)
+
+	app, err := c.GetAppInstanceUsage("workspace7", "workbench3", AppInstance{ID: 10})
+	require.NoError(t, err)
+	require.NotNil(t, app)
+	assert.Equal(t, int64(1510), app.Samples[1].CPUMillicores)
+	assert.Equal(t, int64(1<<30), app.Samples[1].MemoryBytes)
+
+	// app-instance-1 must not match the pod of app-instance-10.
+	other, err := c.GetAppInstanceUsage("workspace7", "workbench3", AppInstance{ID: 1})
+	require.NoError(t, err)
+	assert.Nil(t, other)
+
+	assert.Nil(t, m.usage("kube-system", func(string, map[string]string) bool { return true }))
+
+	// Pods that are gone are forgotten.
+	payload = podMetricsJSON(start.Add(3*time.Minute), serverPodMetrics)
+	require.NoError(t, m.collect(context.Background()))
+	app, err = c.GetAppInstanceUsage("workspace7", "workbench3", AppInstance{ID: 10})
+	require.NoError(t, err)
+	assert.Nil(t, app)
+}
+
+func TestMetricsCollector_Disabled(t *testing.T) {
+	c := &client{}
+	_, err := c.GetWorkbenchServerUsage("workspace7", "workbench3")
+	assert.ErrorIs(t, err, ErrMetricsDisabled)
+}
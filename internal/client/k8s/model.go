@@ -62,6 +62,8 @@ type AppInstance struct {
 	AppRegistry string
 	AppImage    string
 	AppTag      string
+	// AppDigest pins the image of the app instance when set.
+	AppDigest string
 
 	K8sState   string
 	K8sStatus  string
@@ -157,7 +159,7 @@ type WorkspaceInput struct {
 	TenantID      uint64
 	Namespace     string
 	NetworkPolicy string
-	AllowedFQDNs  []string
+	AllowedFQDNs  []WorkspaceFQDNRule
 	Clipboard     string
 	Services      map[string]WorkspaceInputService
 }
@@ -170,6 +172,8 @@ type WorkspaceOutput struct {
 
 	NetworkPolicyStatus  string
 	NetworkPolicyMessage string
+	// RejectedFQDNs maps the FQDN rule patterns the operator could not apply to the reason
+	RejectedFQDNs map[string]string
 
 	ServiceStatuses map[string]WorkspaceServiceStatusOutput
 }
@@ -0,0 +1,233 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+)
+
+const defaultContainerAnnotation = "kubectl.kubernetes.io/default-container"
+
+var (
+	// ErrPodNotFound is returned when no pod currently runs the requested workbench
+	// server or app instance.
+	ErrPodNotFound = errors.New("pod not found")
+	// ErrPodLogsUnavailable is returned when the requested logs do not exist, for
+	// instance the previous container of a pod that never restarted.
+	ErrPodLogsUnavailable = errors.New("pod logs unavailable")
+)
+
+type PodLogOptions struct {
+	TailLines  int64
+	Since      *time.Time
+	Previous   bool
+	LimitBytes int64
+}
+
+type PodLogs struct {
+	PodName   string
+	Container string
+	Logs      string
+}
+
+type PodEvent struct {
+	Type    string
+	Reason  string
+	Message string
+	Count   int32
+
+	FirstSeenAt time.Time
+	LastSeenAt  time.Time
+}
+
+func (c *client) GetWorkbenchServerLogs(namespace, workbenchName string, opts PodLogOptions) (*PodLogs, error) {
+	pod, err := c.getWorkbenchServerPod(namespace, workbenchName)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.getPodLogs(pod, opts)
+}
+
+func (c *client) GetAppInstanceLogs(namespace, workbenchName string, appInstance AppInstance, opts PodLogOptions) (*PodLogs, error) {
+	pod, err := c.getAppInstancePod(namespace, workbenchName, appInstance)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.getPodLogs(pod, opts)
+}
+
+func (c *client) ListWorkbenchServerEvents(namespace, workbenchName string) ([]PodEvent, error) {
+	pod, err := c.getWorkbenchServerPod(namespace, workbenchName)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.listPodEvents(pod)
+}
+
+func (c *client) ListAppInstanceEvents(namespace, workbenchName string, appInstance AppInstance) ([]PodEvent, error) {
+	pod, err := c.getAppInstancePod(namespace, workbenchName, appInstance)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.listPodEvents(pod)
+}
+
+// getWorkbenchServerPod returns the most recent pod of the workbench server, which the
+// operator labels with the workbench name.
+func (c *client) getWorkbenchServerPod(namespace, workbenchName string) (*corev1.Pod, error) {
+	pods, err := c.k8sClient.CoreV1().Pods(namespace).List(context.Background(), v1.ListOptions{
+		LabelSelector: fmt.Sprintf("workbench=%s", workbenchName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get pods: %w", err)
+	}
+
+	pod := newestPod(pods.Items, func(corev1.Pod) bool { return true })
+	if pod == nil {
+		return nil, fmt.Errorf("no server pod for workbench %q in namespace %q: %w", workbenchName, namespace, ErrPodNotFound)
+	}
+
+	return pod, nil
+}
+
+// getAppInstancePod returns the most recent pod of an app instance. The operator runs it
+// in a job named after the workbench and the app instance UID, whose pods carry the job
+// name as label.
+func (c *client) getAppInstancePod(namespace, workbenchName string, appInstance AppInstance) (*corev1.Pod, error) {
+	pods, err := c.k8sClient.CoreV1().Pods(namespace).List(context.Background(), v1.ListOptions{
+		LabelSelector: fmt.Sprintf("job-name=%s-%s", workbenchName, appInstance.UID()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get pods: %w", err)
+	}
+
+	pod := newestPod(pods.Items, func(corev1.Pod) bool { return true })
+	if pod == nil {
+		return nil, fmt.Errorf("no pod for app instance %v of workbench %q in namespace %q: %w", appInstance.ID, workbenchName, namespace, ErrPodNotFound)
+	}
+
+	return pod, nil
+}
+
+// isAppInstancePod tells whether a pod, or the job that created it, is named after the
+// app instance UID.
+func isAppInstancePod(appInstance AppInstance, podName string, labels map[string]string) bool {
+	uid := appInstance.UID()
+	hasUID := func(name string) bool {
+		return strings.Contains(name, uid+"-") || strings.HasSuffix(name, uid)
+	}
+	return hasUID(podName) || hasUID(labels["job-name"])
+}
+
+func newestPod(pods []corev1.Pod, match func(corev1.Pod) bool) *corev1.Pod {
+	var newest *corev1.Pod
+	for i := range pods {
+		if !match(pods[i]) {
+			continue
+		}
+		if newest == nil || pods[i].CreationTimestamp.After(newest.CreationTimestamp.Time) {
+			newest = &pods[i]
+		}
+	}
+	return newest
+}
+
+func podContainerName(pod *corev1.Pod) string {
+	if name := pod.Annotations[defaultContainerAnnotation]; name != "" {
+		return name
+	}
+	if len(pod.Spec.Containers) > 0 {
+		return pod.Spec.Containers[0].Name
+	}
+	return ""
+}
+
+func (c *client) getPodLogs(pod *corev1.Pod, opts PodLogOptions) (*PodLogs, error) {
+	container := podContainerName(pod)
+
+	logOpts := &corev1.PodLogOptions{
+		Container: container,
+		Previous:  opts.Previous,
+	}
+	if opts.TailLines > 0 {
+		logOpts.TailLines = &opts.TailLines
+	}
+	if opts.LimitBytes > 0 {
+		logOpts.LimitBytes = &opts.LimitBytes
+	}
+	if opts.Since != nil {
+		since := v1.NewTime(*opts.Since)
+		logOpts.SinceTime = &since
+	}
+
+	raw, err := c.k8sClient.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, logOpts).DoRaw(context.Background())
+	if err != nil {
+		if k8serrors.IsBadRequest(err) || k8serrors.IsNotFound(err) {
+			return nil, fmt.Errorf("unable to get logs of pod %q: %v: %w", pod.Name, err, ErrPodLogsUnavailable)
+		}
+		return nil, fmt.Errorf("unable to get logs of pod %q: %w", pod.Name, err)
+	}
+
+	return &PodLogs{
+		PodName:   pod.Name,
+		Container: container,
+		Logs:      string(raw),
+	}, nil
+}
+
+// listPodEvents returns the events of a pod, oldest first.
+func (c *client) listPodEvents(pod *corev1.Pod) ([]PodEvent, error) {
+	selector := fields.AndSelectors(
+		fields.OneTermEqualSelector("involvedObject.kind", "Pod"),
+		fields.OneTermEqualSelector("involvedObject.name", pod.Name),
+	)
+
+	list, err := c.k8sClient.CoreV1().Events(pod.Namespace).List(context.Background(), v1.ListOptions{
+		FieldSelector: selector.String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list events of pod %q: %w", pod.Name, err)
+	}
+
+	events := make([]PodEvent, 0, len(list.Items))
+	for _, e := range list.Items {
+		firstSeen, lastSeen := e.FirstTimestamp.Time, e.LastTimestamp.Time
+		if firstSeen.IsZero() {
+			firstSeen = e.EventTime.Time
+		}
+		if lastSeen.IsZero() {
+			lastSeen = firstSeen
+		}
+		count := e.Count
+		if count == 0 {
+			count = 1
+		}
+
+		events = append(events, PodEvent{
+			Type:        e.Type,
+			Reason:      e.Reason,
+			Message:     e.Message,
+			Count:       count,
+			FirstSeenAt: firstSeen,
+			LastSeenAt:  lastSeen,
+		})
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].LastSeenAt.Before(events[j].LastSeenAt)
+	})
+
+	return events, nil
+}
@@ -59,6 +59,32 @@ func (c *testClient) GetSecret(namespace, name string) (map[string][]byte, error
 	return nil, nil
 }
 
+// Pod diagnostics operations
+func (c *testClient) GetWorkbenchServerLogs(namespace, workbenchName string, opts PodLogOptions) (*PodLogs, error) {
+	return &PodLogs{}, nil
+}
+
+func (c *testClient) GetAppInstanceLogs(namespace, workbenchName string, appInstance AppInstance, opts PodLogOptions) (*PodLogs, error) {
+	return &PodLogs{}, nil
+}
+
+func (c *testClient) ListWorkbenchServerEvents(namespace, workbenchName string) ([]PodEvent, error) {
+	return nil, nil
+}
+
+func (c *testClient) ListAppInstanceEvents(namespace, workbenchName string, appInstance AppInstance) ([]PodEvent, error) {
+	return nil, nil
+}
+
+// Resource usage operations
+func (c *testClient) GetWorkbenchServerUsage(namespace, workbenchName string) (*PodUsage, error) {
+	return nil, nil
+}
+
+func (c *testClient) GetAppInstanceUsage(namespace, workbenchName string, appInstance AppInstance) (*PodUsage, error) {
+	return nil, nil
+}
+
 // Watchers registration methods
 func (c *testClient) RegisterOnNewWorkbenchHandler(func(workbench Workbench) error) error {
 	return nil
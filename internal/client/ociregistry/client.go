@@ -18,8 +18,13 @@ var _ OCIClienter = &client{}
 type OCIClienter interface {
 	ImageExists(imageRef string) (bool, error)
 	GetLabels(imageRef string) (map[string]string, error)
+	GetDigest(imageRef string) (string, error)
+	ListRepositories(registry string) ([]string, error)
+	ListTags(repository string) ([]string, error)
 	Credentials() (username, password string)
 	Host() string
+	HasRegistry(host string) bool
+	VerifySignature(imageRef string, keys []TrustedKey) (*SignatureVerification, error)
 }
 
 type registryConfig struct {
@@ -31,24 +36,47 @@ type registryConfig struct {
 type client struct {
 	cfg config.Config
 	reg registryConfig
+	// catalogs are the other registries apps are synced from, by host.
+	catalogs map[string]registryConfig
 }
 
 func NewClient(cfg config.Config) (*client, error) {
 	ociCfg := cfg.Clients.OCIClient
-	return &client{
+	c := &client{
 		cfg: cfg,
 		reg: registryConfig{
 			host:     ociCfg.Host,
 			username: ociCfg.Username,
 			password: ociCfg.Password.PlainText(),
 		},
-	}, nil
+		catalogs: make(map[string]registryConfig, len(cfg.Clients.OCICatalogs)),
+	}
+
+	for _, catalog := range cfg.Clients.OCICatalogs {
+		c.catalogs[catalog.Host] = registryConfig{
+			host:     catalog.Host,
+			username: catalog.Username,
+			password: catalog.Password.PlainText(),
+		}
+	}
+
+	return c, nil
 }
 
 func (c *client) Host() string {
 	return c.reg.host
 }
 
+// HasRegistry tells whether apps can be pulled from a registry, either the main one or
+// one of the catalogs.
+func (c *client) HasRegistry(host string) bool {
+	if host == c.reg.host {
+		return true
+	}
+	_, ok := c.catalogs[host]
+	return ok
+}
+
 func (c *client) Credentials() (string, string) {
 	return c.reg.username, c.reg.password
 }
@@ -59,7 +87,7 @@ func (c *client) ImageExists(imageRef string) (bool, error) {
 		return false, fmt.Errorf("invalid image reference: %w", err)
 	}
 
-	_, err = remote.Get(ref, remote.WithAuth(c.getRegistryAuth()))
+	_, err = remote.Get(ref, remote.WithAuth(c.getRegistryAuth(ref.Context().RegistryStr())))
 	if err != nil {
 		if terr, ok := err.(*transport.Error); ok && terr.StatusCode == 404 {
 			return false, nil
@@ -76,7 +104,7 @@ func (c *client) GetLabels(imageRef string) (map[string]string, error) {
 		return nil, fmt.Errorf("invalid image reference: %w", err)
 	}
 
-	desc, err := remote.Get(ref, remote.WithAuth(c.getRegistryAuth()))
+	desc, err := remote.Get(ref, remote.WithAuth(c.getRegistryAuth(ref.Context().RegistryStr())))
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch image descriptor: %w", err)
 	}
@@ -96,9 +124,61 @@ func (c *client) GetLabels(imageRef string) (map[string]string, error) {
 	return cfgFile.Config.Labels, nil
 }
 
-func (c *client) getRegistryAuth() authn.Authenticator {
-	if c.reg.username == "" || c.reg.password == "" {
+// GetDigest returns the digest of the manifest an image reference points to, e.g.
+// "sha256:..." for "harbor.example.com/apps/myapp:1.0".
+func (c *client) GetDigest(imageRef string) (string, error) {
+	ref, err := name.ParseReference(imageRef, name.WeakValidation)
+	if err != nil {
+		return "", fmt.Errorf("invalid image reference: %w", err)
+	}
+
+	desc, err := remote.Head(ref, remote.WithAuth(c.getRegistryAuth(ref.Context().RegistryStr())))
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch image descriptor: %w", err)
+	}
+
+	return desc.Digest.String(), nil
+}
+
+// ListRepositories returns the repositories of a registry through its _catalog endpoint.
+func (c *client) ListRepositories(registry string) ([]string, error) {
+	reg, err := name.NewRegistry(registry, name.WeakValidation)
+	if err != nil {
+		return nil, fmt.Errorf("invalid registry: %w", err)
+	}
+
+	repos, err := remote.Catalog(context.Background(), reg, remote.WithAuth(c.getRegistryAuth(reg.RegistryStr())))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list repositories: %w", err)
+	}
+
+	return repos, nil
+}
+
+// ListTags returns the tags of a repository, e.g. "registry.example.com/apps/vscode".
+func (c *client) ListTags(repository string) ([]string, error) {
+	repo, err := name.NewRepository(repository, name.WeakValidation)
+	if err != nil {
+		return nil, fmt.Errorf("invalid repository: %w", err)
+	}
+
+	tags, err := remote.List(repo, remote.WithAuth(c.getRegistryAuth(repo.RegistryStr())))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	return tags, nil
+}
+
+// getRegistryAuth returns the credentials of the catalog a host belongs to, or those of
+// the main registry.
+func (c *client) getRegistryAuth(host string) authn.Authenticator {
+	reg := c.reg
+	if catalog, ok := c.catalogs[host]; ok {
+		reg = catalog
+	}
+	if reg.username == "" || reg.password == "" {
 		return authn.Anonymous
 	}
-	return authn.FromConfig(authn.AuthConfig{Username: c.reg.username, Password: c.reg.password})
+	return authn.FromConfig(authn.AuthConfig{Username: reg.username, Password: reg.password})
 }
@@ -0,0 +1,238 @@
+package ociregistry
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+)
+
+const (
+	// cosignSignatureAnnotation holds the base64 signature of the payload of a layer of a
+	// cosign signature manifest.
+	cosignSignatureAnnotation = "dev.cosignproject.cosign/signature"
+	// cosignSignatureArtifactType is the artifact type of the signatures cosign attaches
+	// to an image as OCI referrers.
+	cosignSignatureArtifactType = "application/vnd.dev.cosign.artifact.sig.v1+json"
+	// cosignSignatureTagSuffix is the suffix of the tag under which cosign stores the
+	// signatures of an image, e.g. "sha256-<hex>.sig".
+	cosignSignatureTagSuffix = ".sig"
+)
+
+// Signature is a cosign signature of an image: a simple signing payload naming the digest
+// of the image, and the signature of that payload.
+type Signature struct {
+	Payload   []byte
+	Signature []byte
+}
+
+// TrustedKey is a public key images may be signed with. ID identifies the key in the
+// verification results, as the SHA-256 fingerprint of its DER encoding.
+type TrustedKey struct {
+	ID  string
+	Key crypto.PublicKey
+}
+
+// SignatureVerification is the result of the verification of the signatures of an image.
+type SignatureVerification struct {
+	Digest string
+	// Signed tells whether at least one signature was found for the image.
+	Signed bool
+	// KeyID is the ID of the trusted key a valid signature was made with, empty when none.
+	KeyID string
+}
+
+func (v *SignatureVerification) IsVerified() bool {
+	return v.KeyID != ""
+}
+
+// ParseTrustedKeys parses a bundle of PEM encoded public keys, as exported by
+// "cosign generate-key-pair". ECDSA, RSA and Ed25519 keys are supported.
+func ParseTrustedKeys(bundle string) ([]TrustedKey, error) {
+	var keys []TrustedKey
+	rest := []byte(bundle)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "PUBLIC KEY" {
+			return nil, fmt.Errorf("unexpected PEM block %q, expected a public key", block.Type)
+		}
+
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key: %w", err)
+		}
+		switch key.(type) {
+		case *ecdsa.PublicKey, *rsa.PublicKey, ed25519.PublicKey:
+		default:
+			return nil, fmt.Errorf("unsupported public key type %T", key)
+		}
+
+		fingerprint := sha256.Sum256(block.Bytes)
+		keys = append(keys, TrustedKey{ID: "sha256:" + hex.EncodeToString(fingerprint[:]), Key: key})
+	}
+
+	if strings.TrimSpace(string(rest)) != "" {
+		return nil, errors.New("trailing data after the public keys")
+	}
+	return keys, nil
+}
+
+// VerifySignature fetches the signatures of an image, stored by cosign under the signature
+// tag of its digest or attached as OCI referrers, and checks them against the trusted keys.
+func (c *client) VerifySignature(imageRef string, keys []TrustedKey) (*SignatureVerification, error) {
+	ref, err := name.ParseReference(imageRef, name.WeakValidation)
+	if err != nil {
+		return nil, fmt.Errorf("invalid image reference: %w", err)
+	}
+	opts := []remote.Option{remote.WithAuth(c.getRegistryAuth(ref.Context().RegistryStr()))}
+
+	desc, err := remote.Head(ref, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch image descriptor: %w", err)
+	}
+	digest := ref.Context().Digest(desc.Digest.String())
+
+	var signatures []Signature
+
+	tag := ref.Context().Tag(strings.Replace(desc.Digest.String(), ":", "-", 1) + cosignSignatureTagSuffix)
+	tagged, err := fetchSignatures(tag, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch signatures of %s: %w", digest, err)
+	}
+	signatures = append(signatures, tagged...)
+
+	index, err := remote.Referrers(digest, append(opts, remote.WithFilter("artifactType", cosignSignatureArtifactType))...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch referrers of %s: %w", digest, err)
+	}
+	indexManifest, err := index.IndexManifest()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read referrers of %s: %w", digest, err)
+	}
+	for _, referrer := range indexManifest.Manifests {
+		if referrer.ArtifactType != cosignSignatureArtifactType {
+			continue
+		}
+		referred, err := fetchSignatures(ref.Context().Digest(referrer.Digest.String()), opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch signature %s of %s: %w", referrer.Digest, digest, err)
+		}
+		signatures = append(signatures, referred...)
+	}
+
+	return VerifySignatures(desc.Digest.String(), signatures, keys), nil
+}
+
+// fetchSignatures returns the signatures held by the layers of a cosign signature
+// manifest, and none when the manifest does not exist.
+func fetchSignatures(ref name.Reference, opts []remote.Option) ([]Signature, error) {
+	img, err := remote.Image(ref, opts...)
+	if err != nil {
+		var terr *transport.Error
+		if errors.As(err, &terr) && terr.StatusCode == 404 {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	manifest, err := img.Manifest()
+	if err != nil {
+		return nil, err
+	}
+
+	var signatures []Signature
+	for _, layer := range manifest.Layers {
+		encoded, ok := layer.Annotations[cosignSignatureAnnotation]
+		if !ok {
+			continue
+		}
+		signature, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signature encoding: %w", err)
+		}
+		payload, err := layerPayload(img, layer.Digest)
+		if err != nil {
+			return nil, err
+		}
+		signatures = append(signatures, Signature{Payload: payload, Signature: signature})
+	}
+	return signatures, nil
+}
+
+func layerPayload(img v1.Image, digest v1.Hash) ([]byte, error) {
+	layer, err := img.LayerByDigest(digest)
+	if err != nil {
+		return nil, err
+	}
+	rc, err := layer.Compressed()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return io.ReadAll(rc)
+}
+
+// simpleSigningPayload is the part of a cosign simple signing payload naming the image.
+type simpleSigningPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+// VerifySignatures checks whether one of the signatures of the image with the given digest
+// was made with one of the trusted keys. A signature only counts for the image its payload
+// names.
+func VerifySignatures(digest string, signatures []Signature, keys []TrustedKey) *SignatureVerification {
+	verification := &SignatureVerification{Digest: digest, Signed: len(signatures) > 0}
+
+	for _, signature := range signatures {
+		var payload simpleSigningPayload
+		if err := json.Unmarshal(signature.Payload, &payload); err != nil || payload.Critical.Image.DockerManifestDigest != digest {
+			continue
+		}
+		for _, key := range keys {
+			if verifyPayload(key.Key, signature.Payload, signature.Signature) {
+				verification.KeyID = key.ID
+				return verification
+			}
+		}
+	}
+
+	return verification
+}
+
+func verifyPayload(key crypto.PublicKey, payload, signature []byte) bool {
+	digest := sha256.Sum256(payload)
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		return ecdsa.VerifyASN1(k, digest[:], signature)
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(k, crypto.SHA256, digest[:], signature) == nil ||
+			rsa.VerifyPSS(k, crypto.SHA256, digest[:], signature, nil) == nil
+	case ed25519.PublicKey:
+		return ed25519.Verify(k, payload, signature)
+	default:
+		return false
+	}
+}
@@ -0,0 +1,86 @@
+//go:build unit
+
+package ociregistry
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testDigest = "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+func publicKeyPEM(t *testing.T, key crypto.PublicKey) string {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func signedPayload(t *testing.T, key *ecdsa.PrivateKey, digest string) Signature {
+	t.Helper()
+	payload := []byte(fmt.Sprintf(`{"critical":{"identity":{"docker-reference":"harbor.local/apps/jupyter"},"image":{"docker-manifest-digest":%q},"type":"cosign container image signature"},"optional":null}`, digest))
+	hash := sha256.Sum256(payload)
+	signature, err := ecdsa.SignASN1(rand.Reader, key, hash[:])
+	require.NoError(t, err)
+	return Signature{Payload: payload, Signature: signature}
+}
+
+func TestParseTrustedKeys(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	edKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	keys, err := ParseTrustedKeys(publicKeyPEM(t, &ecKey.PublicKey) + publicKeyPEM(t, edKey))
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	assert.Regexp(t, "^sha256:[0-9a-f]{64}$", keys[0].ID)
+	assert.NotEqual(t, keys[0].ID, keys[1].ID)
+
+	keys, err = ParseTrustedKeys("")
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+
+	_, err = ParseTrustedKeys("not a key")
+	assert.Error(t, err)
+
+	_, err = ParseTrustedKeys(string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte("x")})))
+	assert.Error(t, err)
+}
+
+func TestVerifySignatures(t *testing.T) {
+	trusted, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	other, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	keys, err := ParseTrustedKeys(publicKeyPEM(t, &trusted.PublicKey))
+	require.NoError(t, err)
+
+	verification := VerifySignatures(testDigest, nil, keys)
+	assert.False(t, verification.Signed)
+	assert.False(t, verification.IsVerified())
+
+	verification = VerifySignatures(testDigest, []Signature{signedPayload(t, other, testDigest)}, keys)
+	assert.True(t, verification.Signed)
+	assert.False(t, verification.IsVerified(), "signatures by other keys are not trusted")
+
+	otherDigest := "sha256:" + testDigest[len(testDigest)-64:len(testDigest)-1] + "0"
+	verification = VerifySignatures(testDigest, []Signature{signedPayload(t, trusted, otherDigest)}, keys)
+	assert.False(t, verification.IsVerified(), "signatures of other images do not count")
+
+	verification = VerifySignatures(testDigest, []Signature{signedPayload(t, other, testDigest), signedPayload(t, trusted, testDigest)}, keys)
+	assert.True(t, verification.IsVerified())
+	assert.Equal(t, keys[0].ID, verification.KeyID)
+	assert.Equal(t, testDigest, verification.Digest)
+}
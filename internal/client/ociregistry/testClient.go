@@ -18,6 +18,19 @@ func (c *testClient) GetLabels(imageRef string) (map[string]string, error) {
 	return nil, nil
 }
 
+// GetDigest returns no digest, so that images are referenced by their tag in test mode
+func (c *testClient) GetDigest(imageRef string) (string, error) {
+	return "", nil
+}
+
+func (c *testClient) ListRepositories(registry string) ([]string, error) {
+	return nil, nil
+}
+
+func (c *testClient) ListTags(repository string) ([]string, error) {
+	return nil, nil
+}
+
 func (c *testClient) Credentials() (string, string) {
 	return "", ""
 }
@@ -25,3 +38,13 @@ func (c *testClient) Credentials() (string, string) {
 func (c *testClient) Host() string {
 	return ""
 }
+
+// HasRegistry only accepts apps without a registry, as the test client has no host
+func (c *testClient) HasRegistry(host string) bool {
+	return host == ""
+}
+
+// VerifySignature reports every image as unsigned, as the test client has no registry
+func (c *testClient) VerifySignature(imageRef string, keys []TrustedKey) (*SignatureVerification, error) {
+	return &SignatureVerification{}, nil
+}
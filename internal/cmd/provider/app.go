@@ -21,7 +21,8 @@ var app service.Apper
 func ProvideAppService() service.Apper {
 	appOnce.Do(func() {
 		app = service.NewAppService(
-			ProvideAppStore(), ProvideK8sClient(), ProvideOCIClient(), ProvideHarborClient(),
+			ProvideConfig(), ProvideAppStore(), ProvideK8sClient(), ProvideOCIClient(), ProvideHarborClient(), ProvidePlatformSettingsStore(),
+			ProvideApprovalRequestStore(), ProvideAuthorizer(), ProvideNotificationStore(),
 		)
 		app = service_mw.Logging(logger.BizLog)(app)
 		app = service_mw.Validation(ProvideValidator())(app)
@@ -36,7 +37,7 @@ var appController chorus.AppServiceServer
 func ProvideAppController() chorus.AppServiceServer {
 	appControllerOnce.Do(func() {
 		appController = v1.NewAppController(ProvideAppService())
-		appController = ctrl_mw.AppAuthorizing(logger.SecLog, ProvideAuthorizer())(appController)
+		appController = ctrl_mw.AppAuthorizing(logger.SecLog, ProvideAuthorizer(), ProvideAppStore())(appController)
 		if ProvideConfig().Services.AuditService.Enabled {
 			appController = ctrl_mw.NewAppAuditMiddleware(ProvideAuditWriter())(appController)
 		}
@@ -28,6 +28,9 @@ func ProvideApprovalRequestService() service.ApprovalRequester {
 			ProvideApprovalRequestStagingFileStore(cfg.Services.ApprovalRequestService.StagingFileStoreName),
 			ProvideNotificationStore(),
 			ProvideAuthorizer(),
+			ProvideWorkspaceService(),
+			ProvideWorkspaceService(),
+			ProvideAppService(),
 			ProvideConfig(),
 		)
 		approvalRequestService = service_mw.Logging(logger.BizLog)(approvalRequestService)
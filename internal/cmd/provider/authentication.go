@@ -26,7 +26,7 @@ func ProvideAuthenticator() service.Authenticator {
 	cfg := ProvideConfig()
 
 	authenticatorOnce.Do(func() {
-		authService, err := service.NewAuthenticationService(cfg, ProvideUser(), ProvideAuthenticationStore(), ProvideDaemonEncryptionKey())
+		authService, err := service.NewAuthenticationService(cfg, ProvideUser(), ProvideAuthenticationStore(), ProvideWorkspaceStore(), ProvideDaemonEncryptionKey())
 		if err != nil {
 			logger.TechLog.Fatal(context.Background(), fmt.Sprintf("unable to provide authentication service: %v", err))
 		}
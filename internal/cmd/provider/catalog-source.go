@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/catalog"
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+var catalogSourcesOnce sync.Once
+var catalogSources []catalog.Source
+
+// ProvideCatalogSources returns the registries the app catalog is synced from: Harbor
+// when it is enabled, and the OCI catalogs in the order of their names. The OCI catalogs
+// are read with the OCI client, they are left out when it is disabled.
+func ProvideCatalogSources() []catalog.Source {
+	catalogSourcesOnce.Do(func() {
+		cfg := ProvideConfig()
+
+		if cfg.Clients.HarborClient.Enabled {
+			catalogSources = append(catalogSources, catalog.NewHarborSource(ProvideHarborClient(), ProvideOCIClient().Host(), cfg.Clients.HarborClient.LabelMapping))
+		}
+
+		if !cfg.Clients.OCIClient.Enabled {
+			if len(cfg.Clients.OCICatalogs) > 0 {
+				logger.TechLog.Warn(context.Background(), "OCI client is disabled, the OCI catalogs are not synced", zap.Int("oci_catalogs", len(cfg.Clients.OCICatalogs)))
+			}
+			return
+		}
+
+		names := make([]string, 0, len(cfg.Clients.OCICatalogs))
+		for name := range cfg.Clients.OCICatalogs {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			catalogSources = append(catalogSources, catalog.NewOCISource(name, cfg.Clients.OCICatalogs[name], ProvideOCIClient()))
+		}
+	})
+	return catalogSources
+}
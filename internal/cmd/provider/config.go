@@ -318,6 +318,16 @@ func SetDefaultConfig(v *viper.Viper) {
 	v.SetDefault("daemon.jobs.app_sync.interval", 30*time.Minute)
 	v.SetDefault("daemon.jobs.app_sync.timeout", 10*time.Minute)
 	v.SetDefault("daemon.jobs.app_sync.options", map[string]interface{}{"tenant_id": 1, "user_id": 1})
+	v.SetDefault("daemon.jobs.app_vulnerability_scan.enabled", true)
+	v.SetDefault("daemon.jobs.app_vulnerability_scan.interval", time.Hour)
+	v.SetDefault("daemon.jobs.app_vulnerability_scan.timeout", 10*time.Minute)
+	v.SetDefault("daemon.jobs.app_vulnerability_scan.options", map[string]interface{}{"tenant_id": 1})
+	v.SetDefault("daemon.jobs.workbench_schedule.enabled", true)
+	v.SetDefault("daemon.jobs.workbench_schedule.interval", 5*time.Minute)
+	v.SetDefault("daemon.jobs.workbench_schedule.timeout", 5*time.Minute)
+	v.SetDefault("daemon.jobs.workbench_share_expiry.enabled", true)
+	v.SetDefault("daemon.jobs.workbench_share_expiry.interval", time.Minute)
+	v.SetDefault("daemon.jobs.workbench_share_expiry.timeout", time.Minute)
 
 	// Daemon - Jobber
 	v.SetDefault("daemon.jobber.enabled", true)
@@ -369,6 +379,9 @@ func SetDefaultConfig(v *viper.Viper) {
 	v.SetDefault("clients.kubernetes.default_repository", "apps")
 	v.SetDefault("clients.kubernetes.prepull_namespace", "backend")
 	v.SetDefault("clients.kubernetes.prepull_job_ttl_seconds", 60)
+	v.SetDefault("clients.kubernetes.metrics_enabled", false)
+	v.SetDefault("clients.kubernetes.metrics_interval", 30*time.Second)
+	v.SetDefault("clients.kubernetes.metrics_history_size", 20)
 	// Default to the conventional kubeconfig path,
 	// leave unset if file does not exist
 	if home, err := os.UserHomeDir(); err == nil {
@@ -486,6 +499,11 @@ func SetDefaultConfig(v *viper.Viper) {
 	v.SetDefault("services.workbench_service.proxy_hit_save_batch_interval", 30*time.Second)
 	v.SetDefault("services.workbench_service.workbench_idle_timeout", 24*time.Hour)
 	v.SetDefault("services.workbench_service.workbench_idle_check_interval", 10*time.Second)
+	v.SetDefault("services.workbench_service.max_workbench_idle_timeout", 7*24*time.Hour)
+	v.SetDefault("services.workbench_service.workbench_idle_warning", 30*time.Minute)
+	v.SetDefault("services.workbench_service.workbench_idle_warning_email", false)
+	v.SetDefault("services.workbench_service.workbench_idle_cpu_millicores", 100)
+	v.SetDefault("services.workbench_service.max_workbench_share_duration", 7*24*time.Hour)
 	v.SetDefault("services.workbench_service.round_tripper.dial_timeout", 5*time.Second)
 	// If zero, keep-alive probes are sent with a default value (currently 15 seconds)
 	// If negative, keep-alive probes are disabled.
@@ -505,6 +523,7 @@ func SetDefaultConfig(v *viper.Viper) {
 	v.SetDefault("services.workspace_service.creator_is_admin", true)
 	v.SetDefault("services.workspace_service.creator_is_data_manager", true)
 	v.SetDefault("services.workspace_service.gid_offset", 0)
+	v.SetDefault("services.workspace_service.invitation_ttl", 14*24*time.Hour)
 
 	// Services - Workspace File
 	v.SetDefault("services.workspace_file_service.stores.archive.workspace_prefix", "workspaces/%s")
@@ -518,9 +537,16 @@ func SetDefaultConfig(v *viper.Viper) {
 	// Services - Authorization
 	v.SetDefault("services.authorization_service.workspace_admin_can_assign_data_manager", true)
 
+	// Services - App
+	v.SetDefault("services.app_service.workspace_app_limits.max_cpu", "4")
+	v.SetDefault("services.app_service.workspace_app_limits.max_memory", "16Gi")
+	v.SetDefault("services.app_service.workspace_app_limits.max_ephemeral_storage", "20Gi")
+	v.SetDefault("services.app_service.workspace_app_limits.max_shm_size", "2Gi")
+
 	// Services - Approval Request
 	v.SetDefault("services.approval_request_service.staging_file_store_name", "disk")
 	v.SetDefault("services.approval_request_service.require_data_manager_approval", true)
+	v.SetDefault("services.approval_request_service.workspace_access_roles", []string{"WorkspaceMember", "WorkspaceGuest"})
 
 	// Services - User
 	v.SetDefault("services.user_service.require_email", false)
@@ -10,6 +10,8 @@ import (
 	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
 	"github.com/CHORUS-TRE/chorus-backend/internal/migration"
 	appservice "github.com/CHORUS-TRE/chorus-backend/pkg/app/service"
+	workbenchservice "github.com/CHORUS-TRE/chorus-backend/pkg/workbench/service"
+	workspaceservice "github.com/CHORUS-TRE/chorus-backend/pkg/workspace/service"
 
 	"go.uber.org/zap"
 )
@@ -53,10 +55,38 @@ func InitDaemonJobs() {
 			j = appservice.NewAppSyncJob(
 				ProvideAppStore(),
 				ProvideAppService(),
+				ProvideCatalogSources(),
+				cfg.Clients.K8sClient.DefaultRegistry+"/"+cfg.Clients.K8sClient.DefaultRepository,
+				logger.TechLog,
+			)
+		case "app_vulnerability_scan":
+			j = appservice.NewAppVulnerabilityScanJob(
+				ProvideAppStore(),
 				ProvideHarborClient(),
 				ProvideOCIClient().Host(),
 				logger.TechLog,
 			)
+		case "workspace_expiry":
+			j = workspaceservice.NewWorkspaceExpiryJob(
+				ProvideWorkspaceStore(),
+				ProvideWorkbench(),
+				ProvideUser(),
+				ProvideNotificationStore(),
+				ProvideMailer(),
+				ProvideAuditWriter(),
+				logger.TechLog,
+			)
+		case "workbench_schedule":
+			j = workbenchservice.NewWorkbenchScheduleJob(
+				ProvideWorkbenchStore(),
+				ProvideWorkbench(),
+				logger.TechLog,
+			)
+		case "workbench_share_expiry":
+			j = workbenchservice.NewWorkbenchShareExpiryJob(
+				ProvideWorkbench(),
+				logger.TechLog,
+			)
 		default:
 			logger.TechLog.Warn(context.Background(), "unknown job in config, skipping", zap.String("job", name))
 			continue
@@ -24,11 +24,14 @@ func ProvideWorkbench() service.Workbencher {
 			ProvideConfig(),
 			ProvideWorkbenchStore(),
 			ProvideK8sClient(),
+			ProvideOCIClient(),
 			ProvideAppService(),
 			ProvideUser(),
 			ProvideAuthenticator(),
 			ProvideNotificationStore(),
+			ProvideMailer(),
 			ProvideWorkspaceStore(),
+			ProvidePlatformSettingsStore(),
 			ProvideAuditWriter(),
 		)
 		workbench = service_mw.Logging(logger.BizLog)(workbench)
@@ -44,7 +47,7 @@ var workbenchController chorus.WorkbenchServiceServer
 func ProvideWorkbenchController() chorus.WorkbenchServiceServer {
 	workbenchControllerOnce.Do(func() {
 		workbenchController = v1.NewWorkbenchController(ProvideWorkbench())
-		workbenchController = ctrl_mw.WorkbenchAuthorizing(logger.SecLog, ProvideAuthorizer(), ProvideConfig(), ProvideAuthenticator())(workbenchController)
+		workbenchController = ctrl_mw.WorkbenchAuthorizing(logger.SecLog, ProvideAuthorizer(), ProvideConfig(), ProvideAuthenticator(), ProvideWorkbench())(workbenchController)
 		if ProvideConfig().Services.AuditService.Enabled {
 			workbenchController = ctrl_mw.NewWorkbenchAuditMiddleware(ProvideAuditWriter())(workbenchController)
 		}
@@ -35,6 +35,7 @@ func ProvideWorkspaceFileService() service.WorkspaceFiler {
 		workspaceFileService, err = service.NewWorkspaceFileService(
 			ProvideConfig(),
 			ProvideFileStores(),
+			ProvideWorkspaceService(),
 		)
 		if err != nil {
 			logger.TechLog.Fatal(context.Background(), "failed to create workspace file service: "+err.Error())
@@ -41,7 +41,11 @@ func ProvideWorkspaceService() service.Workspaceer {
 			ProvideWorkbench(),
 			ProvideUser(),
 			ProvideNotificationStore(),
+			ProvideMailer(),
+			ProvidePlatformSettingsStore(),
 			ProvideAuditWriter(),
+			ProvideApprovalRequestStore(),
+			ProvideAuthorizer(),
 		)
 		workspaceService = service_mw.Logging(logger.BizLog)(workspaceService)
 		workspaceService = service_mw.Validation(ProvideValidator())(workspaceService)
@@ -80,3 +84,45 @@ func ProvideWorkspaceServiceInstanceController() chorus.WorkspaceServiceInstance
 	})
 	return workspaceServiceInstanceController
 }
+
+var workspaceTemplateControllerOnce sync.Once
+var workspaceTemplateController chorus.WorkspaceTemplateServiceServer
+
+func ProvideWorkspaceTemplateController() chorus.WorkspaceTemplateServiceServer {
+	workspaceTemplateControllerOnce.Do(func() {
+		workspaceTemplateController = v1.NewWorkspaceTemplateController(ProvideWorkspaceService(), ProvideConfig())
+		workspaceTemplateController = ctrl_mw.WorkspaceTemplateAuthorizing(logger.SecLog, ProvideAuthorizer(), ProvideConfig(), ProvideAuthenticator())(workspaceTemplateController)
+		if ProvideConfig().Services.AuditService.Enabled {
+			workspaceTemplateController = ctrl_mw.NewWorkspaceTemplateAuditMiddleware(ProvideAuditWriter())(workspaceTemplateController)
+		}
+	})
+	return workspaceTemplateController
+}
+
+var serviceDefinitionControllerOnce sync.Once
+var serviceDefinitionController chorus.ServiceDefinitionServiceServer
+
+func ProvideServiceDefinitionController() chorus.ServiceDefinitionServiceServer {
+	serviceDefinitionControllerOnce.Do(func() {
+		serviceDefinitionController = v1.NewServiceDefinitionController(ProvideWorkspaceService())
+		serviceDefinitionController = ctrl_mw.ServiceDefinitionAuthorizing(logger.SecLog, ProvideAuthorizer(), ProvideConfig(), ProvideAuthenticator())(serviceDefinitionController)
+		if ProvideConfig().Services.AuditService.Enabled {
+			serviceDefinitionController = ctrl_mw.NewServiceDefinitionAuditMiddleware(ProvideAuditWriter())(serviceDefinitionController)
+		}
+	})
+	return serviceDefinitionController
+}
+
+var workspaceInvitationControllerOnce sync.Once
+var workspaceInvitationController chorus.WorkspaceInvitationServiceServer
+
+func ProvideWorkspaceInvitationController() chorus.WorkspaceInvitationServiceServer {
+	workspaceInvitationControllerOnce.Do(func() {
+		workspaceInvitationController = v1.NewWorkspaceInvitationController(ProvideWorkspaceService())
+		workspaceInvitationController = ctrl_mw.WorkspaceInvitationAuthorizing(logger.SecLog, ProvideAuthorizer(), ProvideConfig(), ProvideAuthenticator(), ProvideWorkspaceService())(workspaceInvitationController)
+		if ProvideConfig().Services.AuditService.Enabled {
+			workspaceInvitationController = ctrl_mw.NewWorkspaceInvitationAuditMiddleware(ProvideAuditWriter())(workspaceInvitationController)
+		}
+	})
+	return workspaceInvitationController
+}
@@ -74,7 +74,7 @@ func runServer() error {
 
 	// 1. Init and serve the HTTP server, but it will return 503 errors until
 	// the gRPC server has started.
-	handler, mux, opts := rest.InitServer(ctx, cfg, getVersion(), started, provider.ProvideWorkbench().ProxyWorkbench, provider.ProvideAuthorizer(), provider.ProvideKeyFunc(cfg.Daemon.JWT.Secret.PlainText()), provider.ProvideClaimsFactory(), provider.ProvideOIDCIDPService())
+	handler, mux, opts := rest.InitServer(ctx, cfg, getVersion(), started, provider.ProvideWorkbench().ProxyWorkbench, provider.ProvideWorkbench().GetWorkbenchShareRoles, provider.ProvideAuthorizer(), provider.ProvideKeyFunc(cfg.Daemon.JWT.Secret.PlainText()), provider.ProvideClaimsFactory(), provider.ProvideOIDCIDPService())
 
 	httpSrv := &http.Server{
 		Addr:    httpHostPort,
@@ -164,6 +164,9 @@ func registerGRPCServices(server *google_grpc.Server) {
 	chorus.RegisterWorkspaceServiceServer(server, provider.ProvideWorkspaceController())
 	chorus.RegisterWorkspaceFileServiceServer(server, provider.ProvideWorkspaceFileController())
 	chorus.RegisterWorkspaceServiceInstanceServiceServer(server, provider.ProvideWorkspaceServiceInstanceController())
+	chorus.RegisterWorkspaceTemplateServiceServer(server, provider.ProvideWorkspaceTemplateController())
+	chorus.RegisterServiceDefinitionServiceServer(server, provider.ProvideServiceDefinitionController())
+	chorus.RegisterWorkspaceInvitationServiceServer(server, provider.ProvideWorkspaceInvitationController())
 	chorus.RegisterWorkbenchServiceServer(server, provider.ProvideWorkbenchController())
 	chorus.RegisterDevstoreServiceServer(server, provider.ProvideDevstoreController())
 	chorus.RegisterApprovalRequestServiceServer(server, provider.ProvideApprovalRequestController())
@@ -216,6 +219,15 @@ func registerHTTPEndpoints(ctx context.Context, mux *runtime.ServeMux, grpcHostP
 	if err := chorus.RegisterWorkspaceServiceInstanceServiceHandlerFromEndpoint(ctx, mux, grpcHostPort, opts); err != nil {
 		logger.TechLog.Fatal(ctx, "failed to register http workspace service instance service handler", logger.WithErrorField(err))
 	}
+	if err := chorus.RegisterWorkspaceTemplateServiceHandlerFromEndpoint(ctx, mux, grpcHostPort, opts); err != nil {
+		logger.TechLog.Fatal(ctx, "failed to register http workspace template service handler", logger.WithErrorField(err))
+	}
+	if err := chorus.RegisterServiceDefinitionServiceHandlerFromEndpoint(ctx, mux, grpcHostPort, opts); err != nil {
+		logger.TechLog.Fatal(ctx, "failed to register http service definition service handler", logger.WithErrorField(err))
+	}
+	if err := chorus.RegisterWorkspaceInvitationServiceHandlerFromEndpoint(ctx, mux, grpcHostPort, opts); err != nil {
+		logger.TechLog.Fatal(ctx, "failed to register http workspace invitation service handler", logger.WithErrorField(err))
+	}
 	if err := chorus.RegisterWorkbenchServiceHandlerFromEndpoint(ctx, mux, grpcHostPort, opts); err != nil {
 		logger.TechLog.Fatal(ctx, "failed to register http workbench service handler", logger.WithErrorField(err))
 	}
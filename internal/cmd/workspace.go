@@ -0,0 +1,153 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/cmd/provider"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workspace/service"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+)
+
+var (
+	workspaceTenantID     uint64
+	workspaceManifestFile string
+	workspaceApplyDryRun  bool
+)
+
+// workspaceCmd groups the commands managing workspaces as declarative manifests, so
+// that workspace definitions can be versioned in git and reviewed like code. They talk
+// to the database and Kubernetes directly, through the same service layer as the API.
+var workspaceCmd = &cobra.Command{
+	Use:   "workspace",
+	Short: "manage workspaces through declarative manifests",
+}
+
+var workspaceExportCmd = &cobra.Command{
+	Use:     "export <workspace-id>",
+	Short:   "export a workspace as a manifest",
+	Long:    `prints the settings, members and roles, service instances and network policy of a workspace as a YAML manifest that workspace apply accepts`,
+	Args:    cobra.ExactArgs(1),
+	PreRunE: func(cmd *cobra.Command, args []string) error { return initConfig() },
+	RunE: func(cmd *cobra.Command, args []string) error {
+		workspaceID, err := strconv.ParseUint(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid workspace id %q: %w", args[0], err)
+		}
+		return runWorkspaceExport(workspaceID)
+	},
+}
+
+var workspaceApplyCmd = &cobra.Command{
+	Use:     "apply -f <manifest.yaml>",
+	Short:   "reconcile a workspace with a manifest",
+	Long:    `shows the drift between a workspace manifest and the current state of the workspace, then reconciles the workspace with the manifest (creating it when the manifest has no id). With --dry-run, only the drift is shown.`,
+	Args:    cobra.NoArgs,
+	PreRunE: func(cmd *cobra.Command, args []string) error { return initConfig() },
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runWorkspaceApply(workspaceManifestFile, workspaceApplyDryRun)
+	},
+}
+
+func init() {
+	workspaceCmd.PersistentFlags().Uint64Var(&workspaceTenantID, "tenant-id", 0, "tenant of the workspace; defaults to services.authentication_service.self_service.tenant_id")
+	workspaceApplyCmd.Flags().StringVarP(&workspaceManifestFile, "filename", "f", "", "workspace manifest to apply")
+	workspaceApplyCmd.Flags().BoolVar(&workspaceApplyDryRun, "dry-run", false, "only show the changes the manifest would make")
+	_ = workspaceApplyCmd.MarkFlagRequired("filename")
+
+	workspaceCmd.AddCommand(workspaceExportCmd)
+	workspaceCmd.AddCommand(workspaceApplyCmd)
+	rootCmd.AddCommand(workspaceCmd)
+}
+
+func runWorkspaceExport(workspaceID uint64) error {
+	manifest, err := provideWorkspaceManifester().ExportWorkspaceManifest(context.Background(), workspaceTenant(), workspaceID)
+	if err != nil {
+		return fmt.Errorf("unable to export workspace %v: %w", workspaceID, err)
+	}
+
+	out, err := yaml.Marshal(manifest)
+	if err != nil {
+		return fmt.Errorf("unable to marshal workspace manifest: %w", err)
+	}
+
+	fmt.Print(string(out))
+	return nil
+}
+
+func runWorkspaceApply(path string, dryRun bool) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("unable to read %s: %w", path, err)
+	}
+	var manifest model.WorkspaceManifest
+	if err := yaml.UnmarshalStrict(b, &manifest); err != nil {
+		return fmt.Errorf("unable to parse %s: %w", path, err)
+	}
+
+	ctx := context.Background()
+	manifester := provideWorkspaceManifester()
+
+	if dryRun {
+		changes, err := manifester.DiffWorkspaceManifest(ctx, workspaceTenant(), &manifest)
+		if err != nil {
+			return fmt.Errorf("unable to diff %s: %w", path, err)
+		}
+		printWorkspaceManifestChanges(changes)
+		return nil
+	}
+
+	ws, changes, err := manifester.ApplyWorkspaceManifest(ctx, workspaceTenant(), &manifest)
+	if err != nil {
+		return fmt.Errorf("unable to apply %s: %w", path, err)
+	}
+	printWorkspaceManifestChanges(changes)
+	if len(changes) > 0 {
+		fmt.Printf("\nworkspace %d reconciled with %s\n", ws.ID, path)
+	}
+	return nil
+}
+
+func provideWorkspaceManifester() *service.WorkspaceManifester {
+	return service.NewWorkspaceManifester(provider.ProvideWorkspaceService(), provider.ProvideUser(), provider.ProvideAuditWriter(), provider.ProvideConfig())
+}
+
+func workspaceTenant() uint64 {
+	if workspaceTenantID != 0 {
+		return workspaceTenantID
+	}
+	return provider.ProvideConfig().Services.AuthenticationService.SelfService.TenantID
+}
+
+// printWorkspaceManifestChanges prints the changes section by section, in the same
+// layout as diff-config.
+func printWorkspaceManifestChanges(changes []model.WorkspaceManifestChange) {
+	if len(changes) == 0 {
+		fmt.Println("no changes: the workspace matches the manifest")
+		return
+	}
+
+	for i, section := range model.WorkspaceManifestSections {
+		if i > 0 {
+			fmt.Println()
+		}
+		fmt.Printf("=== %s ===\n", section)
+		for _, c := range changes {
+			if c.Section != section {
+				continue
+			}
+			switch c.Action {
+			case model.WorkspaceManifestChangeAdd:
+				fmt.Printf("+ %s = %s\n", c.Key, c.Desired)
+			case model.WorkspaceManifestChangeRemove:
+				fmt.Printf("- %s = %s\n", c.Key, c.Current)
+			default:
+				fmt.Printf("~ %s\n  current:  %s\n  manifest: %s\n", c.Key, c.Current, c.Desired)
+			}
+		}
+	}
+}
@@ -120,6 +120,9 @@ type (
 		K8sClient    K8sClient    `yaml:"kubernetes"`
 		OCIClient    OCIClient    `yaml:"oci"`
 		HarborClient HarborClient `yaml:"harbor"`
+		// OCICatalogs are registries implementing the OCI distribution API the app
+		// catalog is synced from, alongside Harbor, keyed by name.
+		OCICatalogs map[string]OCICatalog `yaml:"oci_catalogs" validate:"dive"`
 	}
 
 	K8sClient struct {
@@ -144,6 +147,10 @@ type (
 
 		PrepullNamespace     string `yaml:"prepull_namespace" validate:"required_if=Enabled true"` // namespace the backend itself runs in, where pre-pull Jobs are created
 		PrepullJobTTLSeconds int    `yaml:"prepull_job_ttl_seconds" validate:"required_if=Enabled true"`
+
+		MetricsEnabled     bool          `yaml:"metrics_enabled"`                                                 // if true, the usage of the workspace pods is polled from the metrics API
+		MetricsInterval    time.Duration `yaml:"metrics_interval" validate:"required_if=MetricsEnabled true"`     // how often the usage of the pods is polled
+		MetricsHistorySize int           `yaml:"metrics_history_size" validate:"required_if=MetricsEnabled true"` // number of samples kept per pod
 	}
 
 	OCIClient struct {
@@ -159,6 +166,21 @@ type (
 		LabelPrefixes      []string `yaml:"label_prefixes"`
 		PageSize           int      `yaml:"page_size" validate:"required_if=Enabled true"`
 		MaxParallelFetches uint64   `yaml:"max_parallel_fetches" validate:"required_if=Enabled true"`
+		// LabelMapping maps chorus labels, e.g. "ch.chorus-tre.app.name", to the labels
+		// the images of the registry carry instead.
+		LabelMapping map[string]string `yaml:"label_mapping"`
+	}
+
+	OCICatalog struct {
+		Host     string    `yaml:"host" validate:"required"` // bare registry hostname, e.g. "registry.example.com"
+		Username string    `yaml:"username" validate:"required_with=Password"`
+		Password Sensitive `yaml:"password" validate:"required_with=Username"`
+		// Projects restricts the sync to the repositories under these path prefixes,
+		// e.g. "apps" for "apps/vscode". All repositories are synced when empty.
+		Projects           []string          `yaml:"projects"`
+		LabelPrefixes      []string          `yaml:"label_prefixes"`
+		LabelMapping       map[string]string `yaml:"label_mapping"`
+		MaxParallelFetches uint64            `yaml:"max_parallel_fetches" validate:"required"`
 	}
 
 	Tenant struct {
@@ -287,7 +309,19 @@ type (
 			ProxyHitSaveBatchInterval  time.Duration `yaml:"proxy_hit_save_batch_interval" validate:"required"`
 			WorkbenchIdleTimeout       time.Duration `yaml:"workbench_idle_timeout"`
 			WorkbenchIdleCheckInterval time.Duration `yaml:"workbench_idle_check_interval" validate:"required"`
-			RoundTripper               struct {
+			// MaxWorkbenchIdleTimeout caps the idle timeouts set on workspaces. 0 means no cap.
+			MaxWorkbenchIdleTimeout time.Duration `yaml:"max_workbench_idle_timeout"`
+			// WorkbenchIdleWarning is how long before an idle workbench is reclaimed its
+			// owner is notified. 0 disables the warning.
+			WorkbenchIdleWarning      time.Duration `yaml:"workbench_idle_warning"`
+			WorkbenchIdleWarningEmail bool          `yaml:"workbench_idle_warning_email"`
+			// WorkbenchIdleCPUMillicores is the CPU usage from which the server or an app
+			// instance of a workbench keeps it from being idle, when pod metrics are
+			// collected. 0 ignores the usage.
+			WorkbenchIdleCPUMillicores int64 `yaml:"workbench_idle_cpu_millicores"`
+			// MaxWorkbenchShareDuration caps how long a workbench share grant may last.
+			MaxWorkbenchShareDuration time.Duration `yaml:"max_workbench_share_duration"`
+			RoundTripper              struct {
 				DialTimeout           time.Duration `yaml:"dial_timeout"`
 				DialKeepAlive         time.Duration `yaml:"dial_keep_alive"`
 				ForceAttemptHTTP2     bool          `yaml:"force_attempt_http2"`
@@ -307,6 +341,11 @@ type (
 			CreatorIsAdmin         bool          `yaml:"creator_is_admin"`
 			CreatorIsDataManager   bool          `yaml:"creator_is_data_manager"`
 			GIDOffset              uint64        `yaml:"gid_offset"`
+			// InvitationTTL is the default lifetime of a workspace invitation.
+			InvitationTTL time.Duration `yaml:"invitation_ttl"`
+			// InvitationURLFormat builds the link sent in invitation emails from the
+			// invitation ID and token, e.g. "https://chorus.example.org/invitations/%d?token=%s".
+			InvitationURLFormat string `yaml:"invitation_url_format"`
 		} `yaml:"workspace_service"`
 
 		WorkspaceFileService struct {
@@ -317,9 +356,21 @@ type (
 			WorkspaceAdminCanAssignDataManager bool `yaml:"workspace_admin_can_assign_data_manager"`
 		} `yaml:"authorization_service"`
 
+		AppService struct {
+			// WorkspaceAppLimits caps the resources of the custom apps of workspaces, as
+			// Kubernetes quantities. An empty limit is not capped.
+			WorkspaceAppLimits struct {
+				MaxCPU              string `yaml:"max_cpu"`
+				MaxMemory           string `yaml:"max_memory"`
+				MaxEphemeralStorage string `yaml:"max_ephemeral_storage"`
+				MaxShmSize          string `yaml:"max_shm_size"`
+			} `yaml:"workspace_app_limits"`
+		} `yaml:"app_service"`
+
 		ApprovalRequestService struct {
-			StagingFileStoreName       string `yaml:"staging_file_store_name" validate:"required"`
-			RequireDataManagerApproval bool   `yaml:"require_data_manager_approval"`
+			StagingFileStoreName       string   `yaml:"staging_file_store_name" validate:"required"`
+			RequireDataManagerApproval bool     `yaml:"require_data_manager_approval"`
+			WorkspaceAccessRoles       []string `yaml:"workspace_access_roles"`
 		} `yaml:"approval_request_service"`
 
 		UserService struct {
@@ -13,6 +13,7 @@ var (
 	ErrNoRowsUpdated = errors.New("database: no rows updated")
 	ErrNoRowsDeleted = errors.New("database: no rows deleted")
 	ErrDuplicateKey  = errors.New("database: duplicate key value violates unique constraint")
+	ErrQuotaReached  = errors.New("database: per-user quota reached")
 
 	// Client errors
 	ErrInvalidRequest = &ChorusError{GRPCCode: codes.InvalidArgument, ChorusCode: errorspb.ChorusErrorCode_INVALID_REQUEST, Title: "Invalid Request"}
@@ -29,6 +30,12 @@ var (
 	ErrTwoFactorRequired  = &ChorusError{GRPCCode: codes.FailedPrecondition, ChorusCode: errorspb.ChorusErrorCode_TWO_FACTOR_REQUIRED, Title: "Two-Factor Authentication Required"}
 	ErrPermissionDenied   = &ChorusError{GRPCCode: codes.PermissionDenied, ChorusCode: errorspb.ChorusErrorCode_PERMISSION_DENIED, Title: "Permission Denied"}
 
+	// Quota errors
+	ErrQuotaExceeded = &ChorusError{GRPCCode: codes.ResourceExhausted, ChorusCode: errorspb.ChorusErrorCode_QUOTA_EXCEEDED, Title: "Quota Exceeded"}
+
+	// State errors
+	ErrReadOnly = &ChorusError{GRPCCode: codes.FailedPrecondition, ChorusCode: errorspb.ChorusErrorCode_READ_ONLY, Title: "Read Only"}
+
 	// Server errors
 	ErrInternal = &ChorusError{GRPCCode: codes.Internal, ChorusCode: errorspb.ChorusErrorCode_INTERNAL_ERROR, Title: "Internal Server Error"}
 )
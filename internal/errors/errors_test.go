@@ -304,6 +304,8 @@ func TestCatalogCoversAllCodes(t *testing.T) {
 		errorspb.ChorusErrorCode_TWO_FACTOR_REQUIRED:  ErrTwoFactorRequired,
 		errorspb.ChorusErrorCode_PERMISSION_DENIED:    ErrPermissionDenied,
 		errorspb.ChorusErrorCode_INTERNAL_ERROR:       ErrInternal,
+		errorspb.ChorusErrorCode_QUOTA_EXCEEDED:       ErrQuotaExceeded,
+		errorspb.ChorusErrorCode_READ_ONLY:            ErrReadOnly,
 	}
 
 	for code, err := range catalog {
@@ -0,0 +1,79 @@
+-- +migrate Up
+
+CREATE SEQUENCE public.workspace_templates_seq MINVALUE 1 MAXVALUE 9007199254740991 INCREMENT 1 START 1;
+-- +migrate StatementBegin
+CREATE TABLE public.workspace_templates (
+    id BIGINT NOT NULL DEFAULT nextval('public.workspace_templates_seq'::REGCLASS),
+
+    tenantid BIGINT NOT NULL,
+    userid BIGINT NOT NULL,
+
+    name TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+
+    networkpolicy TEXT NOT NULL DEFAULT 'Airgapped',
+    allowedfqdns TEXT[] NOT NULL DEFAULT '{}',
+    clipboard TEXT NOT NULL DEFAULT 'disabled',
+
+    members JSONB NOT NULL DEFAULT '[]',
+    appids BIGINT[] NOT NULL DEFAULT '{}',
+
+    createdat TIMESTAMP NOT NULL DEFAULT NOW(),
+    updatedat TIMESTAMP NOT NULL DEFAULT NOW(),
+    deletedat TIMESTAMP NULL,
+
+    CONSTRAINT workspace_templates_pkey PRIMARY KEY (id),
+    CONSTRAINT workspace_templates_tenantcon FOREIGN KEY (tenantid) REFERENCES tenants(id),
+    CONSTRAINT workspace_templates_usercon FOREIGN KEY (userid) REFERENCES users(id)
+);
+-- +migrate StatementEnd
+
+CREATE UNIQUE INDEX workspace_templates_tenant_name_idx ON public.workspace_templates (tenantid, name) WHERE deletedat IS NULL;
+
+CREATE SEQUENCE public.workspace_template_services_seq MINVALUE 1 MAXVALUE 9007199254740991 INCREMENT 1 START 1;
+-- +migrate StatementBegin
+CREATE TABLE public.workspace_template_services (
+    id BIGINT NOT NULL DEFAULT nextval('public.workspace_template_services_seq'::REGCLASS),
+
+    tenantid BIGINT NOT NULL,
+    templateid BIGINT NOT NULL,
+    name TEXT NOT NULL,
+
+    chartregistry TEXT NOT NULL DEFAULT '',
+    chartrepository TEXT NOT NULL DEFAULT '',
+    charttag TEXT NOT NULL DEFAULT '',
+
+    valuesoverride JSONB NOT NULL DEFAULT '{}',
+
+    credentialssecretname TEXT NOT NULL DEFAULT '',
+    credentialspaths TEXT[] NOT NULL DEFAULT '{}',
+
+    connectioninfotemplate TEXT NOT NULL DEFAULT '',
+
+    createdat TIMESTAMP NOT NULL DEFAULT NOW(),
+    updatedat TIMESTAMP NOT NULL DEFAULT NOW(),
+    deletedat TIMESTAMP NULL,
+
+    CONSTRAINT workspace_template_services_pkey PRIMARY KEY (id),
+    CONSTRAINT workspace_template_services_tenantcon FOREIGN KEY (tenantid) REFERENCES tenants(id),
+    CONSTRAINT workspace_template_services_templatecon FOREIGN KEY (templateid) REFERENCES workspace_templates(id)
+);
+-- +migrate StatementEnd
+
+CREATE UNIQUE INDEX workspace_template_services_template_name_idx ON public.workspace_template_services (templateid, name) WHERE deletedat IS NULL;
+
+ALTER TABLE public.workspaces
+    ADD COLUMN templateid BIGINT NULL REFERENCES workspace_templates(id);
+
+-- +migrate Down
+
+ALTER TABLE public.workspaces
+    DROP COLUMN IF EXISTS templateid;
+
+DROP INDEX IF EXISTS public.workspace_template_services_template_name_idx;
+DROP TABLE IF EXISTS public.workspace_template_services;
+DROP SEQUENCE IF EXISTS public.workspace_template_services_seq;
+
+DROP INDEX IF EXISTS public.workspace_templates_tenant_name_idx;
+DROP TABLE IF EXISTS public.workspace_templates;
+DROP SEQUENCE IF EXISTS public.workspace_templates_seq;
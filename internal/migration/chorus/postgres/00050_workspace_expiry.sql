@@ -0,0 +1,17 @@
+-- +migrate Up
+
+ALTER TABLE public.workspaces
+    ADD COLUMN expiresat        TIMESTAMP NULL,
+    ADD COLUMN expirynoticedays INT       NULL;
+
+CREATE INDEX IF NOT EXISTS workspaces_expiresat_idx
+    ON public.workspaces (expiresat)
+    WHERE expiresat IS NOT NULL AND deletedat IS NULL;
+
+-- +migrate Down
+
+DROP INDEX IF EXISTS workspaces_expiresat_idx;
+
+ALTER TABLE public.workspaces
+    DROP COLUMN IF EXISTS expirynoticedays,
+    DROP COLUMN IF EXISTS expiresat;
@@ -0,0 +1,40 @@
+-- +migrate Up
+
+CREATE SEQUENCE public.workspace_invitations_seq MINVALUE 1 MAXVALUE 9007199254740991 INCREMENT 1 START 1;
+-- +migrate StatementBegin
+CREATE TABLE public.workspace_invitations (
+    id BIGINT NOT NULL DEFAULT nextval('public.workspace_invitations_seq'::REGCLASS),
+
+    tenantid BIGINT NOT NULL,
+    workspaceid BIGINT NOT NULL,
+    inviterid BIGINT NOT NULL,
+
+    inviteeuserid BIGINT NULL,
+    inviteeemail TEXT NOT NULL DEFAULT '',
+    rolename TEXT NOT NULL,
+
+    status TEXT NOT NULL DEFAULT 'pending',
+    tokenhash TEXT NOT NULL,
+    expiresat TIMESTAMP NOT NULL,
+    respondedat TIMESTAMP NULL,
+
+    createdat TIMESTAMP NOT NULL DEFAULT NOW(),
+    updatedat TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT workspace_invitations_pkey PRIMARY KEY (id),
+    CONSTRAINT workspace_invitations_tenantcon FOREIGN KEY (tenantid) REFERENCES tenants(id),
+    CONSTRAINT workspace_invitations_workspacecon FOREIGN KEY (workspaceid) REFERENCES workspaces(id),
+    CONSTRAINT workspace_invitations_invitercon FOREIGN KEY (inviterid) REFERENCES users(id),
+    CONSTRAINT workspace_invitations_inviteecon FOREIGN KEY (inviteeuserid) REFERENCES users(id),
+    CONSTRAINT workspace_invitations_invitee_check CHECK (inviteeuserid IS NOT NULL OR inviteeemail <> '')
+);
+-- +migrate StatementEnd
+
+CREATE INDEX workspace_invitations_workspace_idx ON public.workspace_invitations (tenantid, workspaceid);
+CREATE INDEX workspace_invitations_inviteeuserid_idx ON public.workspace_invitations (inviteeuserid) WHERE status = 'pending';
+CREATE INDEX workspace_invitations_inviteeemail_idx ON public.workspace_invitations (lower(inviteeemail)) WHERE status = 'pending' AND inviteeemail <> '';
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS public.workspace_invitations;
+DROP SEQUENCE IF EXISTS public.workspace_invitations_seq;
@@ -0,0 +1,33 @@
+-- +migrate Up
+
+-- Allowed FQDNs become structured rules: {"pattern", "ports", "protocol", "comment"}.
+ALTER TABLE public.workspaces ADD COLUMN allowedfqdnrules JSONB NOT NULL DEFAULT '[]';
+UPDATE public.workspaces SET allowedfqdnrules = COALESCE(
+    (SELECT jsonb_agg(jsonb_build_object('pattern', fqdn)) FROM unnest(allowedfqdns) AS fqdn), '[]');
+ALTER TABLE public.workspaces DROP COLUMN allowedfqdns;
+ALTER TABLE public.workspaces RENAME COLUMN allowedfqdnrules TO allowedfqdns;
+
+-- Reason reported by the network policy controller for each rejected pattern.
+ALTER TABLE public.workspaces ADD COLUMN rejectedfqdns JSONB NOT NULL DEFAULT '{}';
+
+ALTER TABLE public.workspace_templates ADD COLUMN allowedfqdnrules JSONB NOT NULL DEFAULT '[]';
+UPDATE public.workspace_templates SET allowedfqdnrules = COALESCE(
+    (SELECT jsonb_agg(jsonb_build_object('pattern', fqdn)) FROM unnest(allowedfqdns) AS fqdn), '[]');
+ALTER TABLE public.workspace_templates DROP COLUMN allowedfqdns;
+ALTER TABLE public.workspace_templates RENAME COLUMN allowedfqdnrules TO allowedfqdns;
+
+-- +migrate Down
+
+ALTER TABLE public.workspace_templates ADD COLUMN allowedfqdnpatterns TEXT[] NOT NULL DEFAULT '{}';
+UPDATE public.workspace_templates SET allowedfqdnpatterns = COALESCE(
+    (SELECT array_agg(rule->>'pattern') FROM jsonb_array_elements(allowedfqdns) AS rule), '{}');
+ALTER TABLE public.workspace_templates DROP COLUMN allowedfqdns;
+ALTER TABLE public.workspace_templates RENAME COLUMN allowedfqdnpatterns TO allowedfqdns;
+
+ALTER TABLE public.workspaces DROP COLUMN IF EXISTS rejectedfqdns;
+
+ALTER TABLE public.workspaces ADD COLUMN allowedfqdnpatterns TEXT[] NOT NULL DEFAULT '{}';
+UPDATE public.workspaces SET allowedfqdnpatterns = COALESCE(
+    (SELECT array_agg(rule->>'pattern') FROM jsonb_array_elements(allowedfqdns) AS rule), '{}');
+ALTER TABLE public.workspaces DROP COLUMN allowedfqdns;
+ALTER TABLE public.workspaces RENAME COLUMN allowedfqdnpatterns TO allowedfqdns;
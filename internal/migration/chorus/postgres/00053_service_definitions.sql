@@ -0,0 +1,45 @@
+-- +migrate Up
+
+CREATE SEQUENCE public.service_definitions_seq MINVALUE 1 MAXVALUE 9007199254740991 INCREMENT 1 START 1;
+-- +migrate StatementBegin
+CREATE TABLE public.service_definitions (
+    id BIGINT NOT NULL DEFAULT nextval('public.service_definitions_seq'::REGCLASS),
+
+    tenantid BIGINT NOT NULL,
+    userid BIGINT NOT NULL,
+
+    name TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    status TEXT NOT NULL DEFAULT 'active',
+
+    chartregistry TEXT NOT NULL,
+    chartrepository TEXT NOT NULL,
+    charttag TEXT NOT NULL,
+
+    valuesschema JSONB NOT NULL DEFAULT '{}',
+    defaultcredentialspaths TEXT[] NOT NULL DEFAULT '{}',
+    connectioninfotemplate TEXT NOT NULL DEFAULT '',
+    iconurl TEXT NOT NULL DEFAULT '',
+
+    createdat TIMESTAMP NOT NULL DEFAULT NOW(),
+    updatedat TIMESTAMP NOT NULL DEFAULT NOW(),
+    deletedat TIMESTAMP NULL,
+
+    CONSTRAINT service_definitions_pkey PRIMARY KEY (id),
+    CONSTRAINT service_definitions_tenantcon FOREIGN KEY (tenantid) REFERENCES tenants(id),
+    CONSTRAINT service_definitions_usercon FOREIGN KEY (userid) REFERENCES users(id)
+);
+-- +migrate StatementEnd
+
+CREATE UNIQUE INDEX service_definitions_tenant_name_idx ON public.service_definitions (tenantid, name) WHERE deletedat IS NULL;
+
+ALTER TABLE public.workspace_services ADD COLUMN servicedefinitionid BIGINT NULL
+    CONSTRAINT workspace_services_servicedefinitioncon REFERENCES public.service_definitions(id);
+
+-- +migrate Down
+
+ALTER TABLE public.workspace_services DROP COLUMN IF EXISTS servicedefinitionid;
+
+DROP INDEX IF EXISTS public.service_definitions_tenant_name_idx;
+DROP TABLE IF EXISTS public.service_definitions;
+DROP SEQUENCE IF EXISTS public.service_definitions_seq;
@@ -0,0 +1,26 @@
+-- +migrate Up
+
+-- +migrate StatementBegin
+CREATE TABLE public.workbench_schedules (
+    workbenchid BIGINT NOT NULL,
+    tenantid BIGINT NOT NULL,
+
+    timezone TEXT NOT NULL,
+    weekdays TEXT[] NOT NULL,
+    starttime TEXT NOT NULL,
+    stoptime TEXT NOT NULL,
+
+    stoppedat TIMESTAMP NULL,
+
+    createdat TIMESTAMP NOT NULL DEFAULT NOW(),
+    updatedat TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT workbench_schedules_pkey PRIMARY KEY (workbenchid),
+    CONSTRAINT workbench_schedules_workbenchcon FOREIGN KEY (workbenchid) REFERENCES workbenches(id) ON DELETE CASCADE,
+    CONSTRAINT workbench_schedules_tenantcon FOREIGN KEY (tenantid) REFERENCES tenants(id)
+);
+-- +migrate StatementEnd
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS public.workbench_schedules;
@@ -0,0 +1,21 @@
+-- +migrate Up
+
+ALTER TABLE public.workspaces
+    ADD COLUMN workbenchidletimeoutminutes INT NULL;
+
+ALTER TABLE public.workbenches
+    ADD COLUMN idlewarnedat TIMESTAMP NULL;
+
+ALTER TABLE public.app_instances
+    ADD COLUMN activeat TIMESTAMP NULL;
+
+-- +migrate Down
+
+ALTER TABLE public.app_instances
+    DROP COLUMN IF EXISTS activeat;
+
+ALTER TABLE public.workbenches
+    DROP COLUMN IF EXISTS idlewarnedat;
+
+ALTER TABLE public.workspaces
+    DROP COLUMN IF EXISTS workbenchidletimeoutminutes;
@@ -0,0 +1,22 @@
+-- +migrate Up
+
+-- +migrate StatementBegin
+CREATE TABLE public.workbench_hibernations (
+    workbenchid BIGINT NOT NULL,
+    tenantid BIGINT NOT NULL,
+
+    initialresolutionwidth INT NOT NULL DEFAULT 0,
+    initialresolutionheight INT NOT NULL DEFAULT 0,
+    appinstances JSONB NOT NULL DEFAULT '[]',
+
+    createdat TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT workbench_hibernations_pkey PRIMARY KEY (workbenchid),
+    CONSTRAINT workbench_hibernations_workbenchcon FOREIGN KEY (workbenchid) REFERENCES workbenches(id) ON DELETE CASCADE,
+    CONSTRAINT workbench_hibernations_tenantcon FOREIGN KEY (tenantid) REFERENCES tenants(id)
+);
+-- +migrate StatementEnd
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS public.workbench_hibernations;
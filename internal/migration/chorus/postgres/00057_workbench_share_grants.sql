@@ -0,0 +1,55 @@
+-- +migrate Up
+
+CREATE SEQUENCE public.workbench_share_grants_seq MINVALUE 1 MAXVALUE 9007199254740991 INCREMENT 1 START 1;
+-- +migrate StatementBegin
+CREATE TABLE public.workbench_share_grants (
+    id BIGINT NOT NULL DEFAULT nextval('public.workbench_share_grants_seq'::REGCLASS),
+
+    tenantid BIGINT NOT NULL,
+    workbenchid BIGINT NOT NULL,
+    granterid BIGINT NOT NULL,
+
+    granteeuserid BIGINT NULL,
+    granteeworkspaceid BIGINT NULL,
+    mode TEXT NOT NULL,
+
+    status TEXT NOT NULL DEFAULT 'active',
+    expiresat TIMESTAMP NOT NULL,
+    endedat TIMESTAMP NULL,
+
+    createdat TIMESTAMP NOT NULL DEFAULT NOW(),
+    updatedat TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT workbench_share_grants_pkey PRIMARY KEY (id),
+    CONSTRAINT workbench_share_grants_tenantcon FOREIGN KEY (tenantid) REFERENCES tenants(id),
+    CONSTRAINT workbench_share_grants_workbenchcon FOREIGN KEY (workbenchid) REFERENCES workbenches(id) ON DELETE CASCADE,
+    CONSTRAINT workbench_share_grants_grantercon FOREIGN KEY (granterid) REFERENCES users(id),
+    CONSTRAINT workbench_share_grants_granteeusercon FOREIGN KEY (granteeuserid) REFERENCES users(id),
+    CONSTRAINT workbench_share_grants_granteeworkspacecon FOREIGN KEY (granteeworkspaceid) REFERENCES workspaces(id),
+    CONSTRAINT workbench_share_grants_grantee_check CHECK ((granteeuserid IS NULL) <> (granteeworkspaceid IS NULL))
+);
+-- +migrate StatementEnd
+
+CREATE INDEX workbench_share_grants_workbench_idx ON public.workbench_share_grants (tenantid, workbenchid);
+CREATE INDEX workbench_share_grants_active_idx ON public.workbench_share_grants (expiresat) WHERE status = 'active';
+
+-- +migrate StatementBegin
+CREATE TABLE public.workbench_share_grant_members (
+    grantid BIGINT NOT NULL,
+    userid BIGINT NOT NULL,
+
+    removedat TIMESTAMP NULL,
+
+    CONSTRAINT workbench_share_grant_members_pkey PRIMARY KEY (grantid, userid),
+    CONSTRAINT workbench_share_grant_members_grantcon FOREIGN KEY (grantid) REFERENCES workbench_share_grants(id) ON DELETE CASCADE,
+    CONSTRAINT workbench_share_grant_members_usercon FOREIGN KEY (userid) REFERENCES users(id)
+);
+-- +migrate StatementEnd
+
+CREATE INDEX workbench_share_grant_members_userid_idx ON public.workbench_share_grant_members (userid);
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS public.workbench_share_grant_members;
+DROP TABLE IF EXISTS public.workbench_share_grants;
+DROP SEQUENCE IF EXISTS public.workbench_share_grants_seq;
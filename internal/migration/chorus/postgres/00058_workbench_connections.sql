@@ -0,0 +1,38 @@
+-- +migrate Up
+
+CREATE SEQUENCE public.workbench_connections_seq MINVALUE 1 MAXVALUE 9007199254740991 INCREMENT 1 START 1;
+-- +migrate StatementBegin
+CREATE TABLE public.workbench_connections (
+    id BIGINT NOT NULL DEFAULT nextval('public.workbench_connections_seq'::REGCLASS),
+
+    tenantid BIGINT NOT NULL,
+    workbenchid BIGINT NOT NULL,
+    workspaceid BIGINT NOT NULL,
+    userid BIGINT NOT NULL,
+
+    clientip TEXT NOT NULL DEFAULT '',
+    useragent TEXT NOT NULL DEFAULT '',
+
+    bytesin BIGINT NOT NULL DEFAULT 0,
+    bytesout BIGINT NOT NULL DEFAULT 0,
+
+    startedat TIMESTAMP NOT NULL DEFAULT NOW(),
+    lastseenat TIMESTAMP NOT NULL DEFAULT NOW(),
+    endedat TIMESTAMP NULL,
+
+    CONSTRAINT workbench_connections_pkey PRIMARY KEY (id),
+    CONSTRAINT workbench_connections_tenantcon FOREIGN KEY (tenantid) REFERENCES tenants(id),
+    CONSTRAINT workbench_connections_workbenchcon FOREIGN KEY (workbenchid) REFERENCES workbenches(id),
+    CONSTRAINT workbench_connections_workspacecon FOREIGN KEY (workspaceid) REFERENCES workspaces(id),
+    CONSTRAINT workbench_connections_usercon FOREIGN KEY (userid) REFERENCES users(id)
+);
+-- +migrate StatementEnd
+
+CREATE INDEX workbench_connections_workbench_idx ON public.workbench_connections (tenantid, workbenchid, startedat);
+CREATE INDEX workbench_connections_workspace_idx ON public.workbench_connections (tenantid, workspaceid, startedat);
+CREATE INDEX workbench_connections_user_idx ON public.workbench_connections (tenantid, userid, startedat);
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS public.workbench_connections;
+DROP SEQUENCE IF EXISTS public.workbench_connections_seq;
@@ -0,0 +1,49 @@
+-- +migrate Up
+
+CREATE SEQUENCE public.app_versions_seq MINVALUE 1 MAXVALUE 9007199254740991 INCREMENT 1 START 1;
+-- +migrate StatementBegin
+CREATE TABLE public.app_versions (
+    id BIGINT NOT NULL DEFAULT nextval('public.app_versions_seq'::REGCLASS),
+
+    tenantid BIGINT NOT NULL,
+    appid BIGINT NOT NULL,
+
+    tag TEXT NOT NULL,
+    digest TEXT NOT NULL DEFAULT '',
+    releasenotes TEXT NOT NULL DEFAULT '',
+    isdefault BOOLEAN NOT NULL DEFAULT FALSE,
+    status TEXT NOT NULL DEFAULT 'active',
+
+    createdat TIMESTAMP NOT NULL DEFAULT NOW(),
+    updatedat TIMESTAMP NOT NULL DEFAULT NOW(),
+    deprecatedat TIMESTAMP NULL,
+
+    CONSTRAINT app_versions_pkey PRIMARY KEY (id),
+    CONSTRAINT app_versions_tenantcon FOREIGN KEY (tenantid) REFERENCES tenants(id),
+    CONSTRAINT app_versions_appcon FOREIGN KEY (appid) REFERENCES apps(id)
+);
+-- +migrate StatementEnd
+
+CREATE UNIQUE INDEX app_versions_tag_idx ON public.app_versions (tenantid, appid, tag);
+CREATE UNIQUE INDEX app_versions_default_idx ON public.app_versions (appid) WHERE isdefault;
+
+-- Every existing app gets its current tag as its default version.
+INSERT INTO public.app_versions (tenantid, appid, tag, isdefault, createdat, updatedat)
+SELECT tenantid, id, dockerimagetag, TRUE, createdat, updatedat FROM public.apps;
+
+ALTER TABLE public.app_instances
+    ADD COLUMN appversionid BIGINT NULL,
+    ADD CONSTRAINT app_instances_appversioncon FOREIGN KEY (appversionid) REFERENCES app_versions(id);
+
+UPDATE public.app_instances ai
+SET appversionid = v.id
+FROM public.app_versions v
+WHERE v.tenantid = ai.tenantid AND v.appid = ai.appid AND v.isdefault;
+
+-- +migrate Down
+
+ALTER TABLE public.app_instances
+    DROP COLUMN IF EXISTS appversionid;
+
+DROP TABLE IF EXISTS public.app_versions;
+DROP SEQUENCE IF EXISTS public.app_versions_seq;
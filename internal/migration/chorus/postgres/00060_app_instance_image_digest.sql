@@ -0,0 +1,10 @@
+-- +migrate Up
+
+-- The digest the tag of an app instance resolved to when it was created or upgraded.
+ALTER TABLE public.app_instances
+    ADD COLUMN appdockerimagedigest TEXT NOT NULL DEFAULT '';
+
+-- +migrate Down
+
+ALTER TABLE public.app_instances
+    DROP COLUMN IF EXISTS appdockerimagedigest;
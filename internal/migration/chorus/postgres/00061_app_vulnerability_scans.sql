@@ -0,0 +1,29 @@
+-- +migrate Up
+
+ALTER TABLE public.app_versions
+    ADD COLUMN scanstatus TEXT NOT NULL DEFAULT '',
+    ADD COLUMN scancritical INT NOT NULL DEFAULT 0,
+    ADD COLUMN scanhigh INT NOT NULL DEFAULT 0,
+    ADD COLUMN scanmedium INT NOT NULL DEFAULT 0,
+    ADD COLUMN scanlow INT NOT NULL DEFAULT 0,
+    ADD COLUMN scanunknown INT NOT NULL DEFAULT 0,
+    ADD COLUMN scannedat TIMESTAMP NULL;
+
+ALTER TABLE public.platform_settings
+    ADD COLUMN vulnerabilityblockseverity TEXT NOT NULL DEFAULT '',
+    ADD COLUMN vulnerabilitywarnseverity TEXT NOT NULL DEFAULT '';
+
+-- +migrate Down
+
+ALTER TABLE public.platform_settings
+    DROP COLUMN IF EXISTS vulnerabilityblockseverity,
+    DROP COLUMN IF EXISTS vulnerabilitywarnseverity;
+
+ALTER TABLE public.app_versions
+    DROP COLUMN IF EXISTS scanstatus,
+    DROP COLUMN IF EXISTS scancritical,
+    DROP COLUMN IF EXISTS scanhigh,
+    DROP COLUMN IF EXISTS scanmedium,
+    DROP COLUMN IF EXISTS scanlow,
+    DROP COLUMN IF EXISTS scanunknown,
+    DROP COLUMN IF EXISTS scannedat;
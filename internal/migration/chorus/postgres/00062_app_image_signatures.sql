@@ -0,0 +1,21 @@
+-- +migrate Up
+
+ALTER TABLE public.apps
+    ADD COLUMN signaturestatus TEXT NOT NULL DEFAULT '',
+    ADD COLUMN signaturekeyid TEXT NOT NULL DEFAULT '',
+    ADD COLUMN signaturecheckedat TIMESTAMP NULL;
+
+ALTER TABLE public.platform_settings
+    ADD COLUMN imagesigningkeys TEXT NOT NULL DEFAULT '',
+    ADD COLUMN requireimagesignatures BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +migrate Down
+
+ALTER TABLE public.platform_settings
+    DROP COLUMN IF EXISTS imagesigningkeys,
+    DROP COLUMN IF EXISTS requireimagesignatures;
+
+ALTER TABLE public.apps
+    DROP COLUMN IF EXISTS signaturestatus,
+    DROP COLUMN IF EXISTS signaturekeyid,
+    DROP COLUMN IF EXISTS signaturecheckedat;
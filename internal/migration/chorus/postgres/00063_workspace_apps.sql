@@ -0,0 +1,21 @@
+-- +migrate Up
+
+ALTER TABLE public.apps
+    ADD COLUMN workspaceid BIGINT NULL,
+    ADD CONSTRAINT apps_workspacecon FOREIGN KEY (workspaceid) REFERENCES workspaces(id);
+
+CREATE INDEX apps_workspaceid_idx ON public.apps (tenantid, workspaceid);
+
+ALTER TABLE public.platform_settings
+    ADD COLUMN requirecustomappapproval BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +migrate Down
+
+ALTER TABLE public.platform_settings
+    DROP COLUMN IF EXISTS requirecustomappapproval;
+
+DROP INDEX IF EXISTS apps_workspaceid_idx;
+
+ALTER TABLE public.apps
+    DROP CONSTRAINT IF EXISTS apps_workspacecon,
+    DROP COLUMN IF EXISTS workspaceid;
@@ -0,0 +1,79 @@
+-- +migrate Up
+
+-- Existing apps are already in use and are considered approved.
+ALTER TABLE public.apps
+    ADD COLUMN lifecyclestatus TEXT NOT NULL DEFAULT 'approved',
+    ADD COLUMN replacementappid BIGINT NULL,
+    ADD CONSTRAINT apps_replacementappcon FOREIGN KEY (replacementappid) REFERENCES apps(id);
+
+CREATE SEQUENCE public.app_reviewers_seq MINVALUE 1 MAXVALUE 9007199254740991 INCREMENT 1 START 1;
+-- +migrate StatementBegin
+CREATE TABLE public.app_reviewers (
+    id BIGINT NOT NULL DEFAULT nextval('public.app_reviewers_seq'::REGCLASS),
+
+    tenantid BIGINT NOT NULL,
+    appid BIGINT NOT NULL,
+    userid BIGINT NOT NULL,
+
+    decision TEXT NOT NULL DEFAULT 'pending',
+    decidedat TIMESTAMP NULL,
+
+    createdat TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT app_reviewers_pkey PRIMARY KEY (id),
+    CONSTRAINT app_reviewers_tenantcon FOREIGN KEY (tenantid) REFERENCES tenants(id),
+    CONSTRAINT app_reviewers_appcon FOREIGN KEY (appid) REFERENCES apps(id),
+    CONSTRAINT app_reviewers_usercon FOREIGN KEY (userid) REFERENCES users(id)
+);
+-- +migrate StatementEnd
+
+CREATE UNIQUE INDEX app_reviewers_user_idx ON public.app_reviewers (tenantid, appid, userid);
+
+CREATE SEQUENCE public.app_lifecycle_events_seq MINVALUE 1 MAXVALUE 9007199254740991 INCREMENT 1 START 1;
+-- +migrate StatementBegin
+CREATE TABLE public.app_lifecycle_events (
+    id BIGINT NOT NULL DEFAULT nextval('public.app_lifecycle_events_seq'::REGCLASS),
+
+    tenantid BIGINT NOT NULL,
+    appid BIGINT NOT NULL,
+    userid BIGINT NOT NULL,
+
+    action TEXT NOT NULL,
+    fromstatus TEXT NOT NULL DEFAULT '',
+    tostatus TEXT NOT NULL DEFAULT '',
+    comment TEXT NOT NULL DEFAULT '',
+
+    createdat TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT app_lifecycle_events_pkey PRIMARY KEY (id),
+    CONSTRAINT app_lifecycle_events_tenantcon FOREIGN KEY (tenantid) REFERENCES tenants(id),
+    CONSTRAINT app_lifecycle_events_appcon FOREIGN KEY (appid) REFERENCES apps(id)
+);
+-- +migrate StatementEnd
+
+CREATE INDEX app_lifecycle_events_app_idx ON public.app_lifecycle_events (tenantid, appid, createdat);
+
+ALTER TABLE public.platform_settings
+    ADD COLUMN requireappreview BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +migrate StatementBegin
+INSERT INTO role_definitions (name) VALUES
+('AppReviewer')
+ON CONFLICT (name) DO NOTHING;
+-- +migrate StatementEnd
+
+-- +migrate Down
+
+ALTER TABLE public.platform_settings
+    DROP COLUMN IF EXISTS requireappreview;
+
+DROP TABLE IF EXISTS public.app_lifecycle_events;
+DROP SEQUENCE IF EXISTS public.app_lifecycle_events_seq;
+
+DROP TABLE IF EXISTS public.app_reviewers;
+DROP SEQUENCE IF EXISTS public.app_reviewers_seq;
+
+ALTER TABLE public.apps
+    DROP CONSTRAINT IF EXISTS apps_replacementappcon,
+    DROP COLUMN IF EXISTS replacementappid,
+    DROP COLUMN IF EXISTS lifecyclestatus;
@@ -0,0 +1,9 @@
+-- +migrate Up
+
+ALTER TABLE public.workspaces
+    ADD COLUMN appids BIGINT[] NOT NULL DEFAULT '{}';
+
+-- +migrate Down
+
+ALTER TABLE public.workspaces
+    DROP COLUMN IF EXISTS appids;
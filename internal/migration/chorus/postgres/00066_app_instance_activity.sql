@@ -0,0 +1,9 @@
+-- +migrate Up
+
+ALTER TABLE public.app_instances
+    DROP COLUMN IF EXISTS activeat;
+
+-- +migrate Down
+
+ALTER TABLE public.app_instances
+    ADD COLUMN activeat TIMESTAMP NULL;
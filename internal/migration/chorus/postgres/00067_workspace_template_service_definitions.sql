@@ -0,0 +1,8 @@
+-- +migrate Up
+
+ALTER TABLE public.workspace_template_services ADD COLUMN servicedefinitionid BIGINT NULL
+    CONSTRAINT workspace_template_services_servicedefinitioncon REFERENCES public.service_definitions(id);
+
+-- +migrate Down
+
+ALTER TABLE public.workspace_template_services DROP COLUMN IF EXISTS servicedefinitionid;
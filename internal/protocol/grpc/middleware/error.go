@@ -18,32 +18,47 @@ func NewUnaryErrorInterceptor(exposeStackTrace bool) grpc.UnaryServerInterceptor
 			return resp, nil
 		}
 
-		// Check if the error is a ChorusError
-		var cErr *cerr.ChorusError
-		if errors.As(err, &cErr) {
-			logger.TechLog.Error(ctx, "request failed",
-				zap.String("method", info.FullMethod),
-				zap.String("code", cErr.ChorusCode.String()),
-				zap.String("message", cErr.Message),
-				zap.Error(cErr.CausedBy),
-				zap.String("stacktrace", cErr.StackTrace()),
-			)
-			return nil, cErr.ToGRPCStatus(exposeStackTrace).Err()
-		}
+		return nil, toGRPCError(ctx, info.FullMethod, err, exposeStackTrace)
+	}
+}
 
-		// If it's already a gRPC status error, return it as is
-		if _, ok := status.FromError(err); ok {
-			return nil, err // Already a gRPC error
+func NewStreamErrorInterceptor(exposeStackTrace bool) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		err := handler(srv, ss)
+		if err == nil {
+			return nil
 		}
 
-		wrapped := cerr.ErrInternal.Wrap(err, "An unexpected error occurred.")
+		return toGRPCError(ss.Context(), info.FullMethod, err, exposeStackTrace)
+	}
+}
+
+func toGRPCError(ctx context.Context, method string, err error, exposeStackTrace bool) error {
+	// Check if the error is a ChorusError
+	var cErr *cerr.ChorusError
+	if errors.As(err, &cErr) {
 		logger.TechLog.Error(ctx, "request failed",
-			zap.String("method", info.FullMethod),
-			zap.String("code", wrapped.ChorusCode.String()),
-			zap.String("message", wrapped.Message),
-			zap.Error(err),
-			zap.String("stacktrace", wrapped.StackTrace()),
+			zap.String("method", method),
+			zap.String("code", cErr.ChorusCode.String()),
+			zap.String("message", cErr.Message),
+			zap.Error(cErr.CausedBy),
+			zap.String("stacktrace", cErr.StackTrace()),
 		)
-		return nil, wrapped.ToGRPCStatus(exposeStackTrace).Err()
+		return cErr.ToGRPCStatus(exposeStackTrace).Err()
 	}
+
+	// If it's already a gRPC status error, return it as is
+	if _, ok := status.FromError(err); ok {
+		return err // Already a gRPC error
+	}
+
+	wrapped := cerr.ErrInternal.Wrap(err, "An unexpected error occurred.")
+	logger.TechLog.Error(ctx, "request failed",
+		zap.String("method", method),
+		zap.String("code", wrapped.ChorusCode.String()),
+		zap.String("message", wrapped.Message),
+		zap.Error(err),
+		zap.String("stacktrace", wrapped.StackTrace()),
+	)
+	return wrapped.ToGRPCStatus(exposeStackTrace).Err()
 }
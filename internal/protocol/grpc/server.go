@@ -39,6 +39,7 @@ func InitServer(whitelister middleware.ClientWhitelister, keyFunc jwt_go.Keyfunc
 
 	// Add error handling middleware FIRST to catch ChorusErrors before they get converted
 	unary = append(unary, middleware.NewUnaryErrorInterceptor(cfg.Daemon.ExposeErrorStackTrace))
+	stream = append(stream, middleware.NewStreamErrorInterceptor(cfg.Daemon.ExposeErrorStackTrace))
 
 	// Add JWT-authentication middleware.
 	unary = append(unary, middleware.NewAuthUnaryServerInterceptors(keyFunc, claimsFactory)...)
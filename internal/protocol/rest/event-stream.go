@@ -0,0 +1,42 @@
+package rest
+
+import (
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+)
+
+// MIMEEventStream is the content type of server-sent events.
+const MIMEEventStream = "text/event-stream"
+
+// EventStreamMarshaler writes each message of a server-streaming RPC as a server-sent
+// event, so that browsers can follow a stream with an EventSource. It is selected by
+// requests sending 'Accept: text/event-stream'; the other clients keep receiving
+// newline-delimited JSON.
+type EventStreamMarshaler struct {
+	*runtime.JSONPb
+}
+
+// ContentType always returns "text/event-stream".
+func (m *EventStreamMarshaler) ContentType(_ interface{}) string {
+	return MIMEEventStream
+}
+
+// StreamContentType always returns "text/event-stream".
+func (m *EventStreamMarshaler) StreamContentType(_ interface{}) string {
+	return MIMEEventStream
+}
+
+// Marshal marshals v into JSON as the data field of an event. The JSON is written on a
+// single line, so a single data field is enough.
+func (m *EventStreamMarshaler) Marshal(v interface{}) ([]byte, error) {
+	buf, err := m.JSONPb.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte("data: "), buf...), nil
+}
+
+// Delimiter ends an event with a blank line.
+func (m *EventStreamMarshaler) Delimiter() []byte {
+	return []byte("\n\n")
+}
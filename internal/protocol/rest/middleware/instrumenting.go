@@ -49,3 +49,8 @@ func (w *codeResponseWriter) WriteHeader(code int) {
 	w.StatusCode = code
 	w.ResponseWriter.WriteHeader(code)
 }
+
+// Unwrap gives access to the underlying writer, for instance to flush streamed responses.
+func (w *codeResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
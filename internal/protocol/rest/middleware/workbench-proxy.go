@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"context"
+	"fmt"
 	"io/fs"
 	"net/http"
 	"net/url"
@@ -16,14 +17,20 @@ import (
 	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
 	authz "github.com/CHORUS-TRE/chorus-backend/pkg/authorization/model"
 	authorization_service "github.com/CHORUS-TRE/chorus-backend/pkg/authorization/service"
+	user_model "github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
 	jwt_go "github.com/golang-jwt/jwt"
 
 	"go.uber.org/zap"
 )
 
-type ProxyWorkbenchHandler func(ctx context.Context, tenantID, workbenchID uint64, w http.ResponseWriter, r *http.Request) error
+type ProxyWorkbenchHandler func(ctx context.Context, tenantID, workbenchID uint64, readOnly bool, w http.ResponseWriter, r *http.Request) error
 
-func AddProxyWorkbench(h http.Handler, pw ProxyWorkbenchHandler, cfg config.Config, authorizer authorization_service.Authorizer, keyFunc jwt_go.Keyfunc, claimsFactory jwt_model.ClaimsFactory) http.Handler {
+// WorkbenchShareRolesHandler returns the current roles of a user when a share grant that
+// gave them a role in the workbench has ended since their JWT was issued, and false when
+// the roles of the JWT can be trusted.
+type WorkbenchShareRolesHandler func(ctx context.Context, tenantID, workbenchID, userID uint64) ([]user_model.UserRole, bool, error)
+
+func AddProxyWorkbench(h http.Handler, pw ProxyWorkbenchHandler, sr WorkbenchShareRolesHandler, cfg config.Config, authorizer authorization_service.Authorizer, keyFunc jwt_go.Keyfunc, claimsFactory jwt_model.ClaimsFactory) http.Handler {
 	reg := regexp.MustCompile(`^/api/rest/v1/workbenches/([0-9]+)/stream`)
 
 	auth := middleware.NewAuthorization(logger.TechLog, cfg, authorizer, nil)
@@ -60,7 +67,14 @@ func AddProxyWorkbench(h http.Handler, pw ProxyWorkbenchHandler, cfg config.Conf
 			return
 		}
 
-		err = pw(ctx, 1, workbenchID, w, r.WithContext(ctx))
+		readOnly, err := checkWorkbenchShareRoles(ctx, sr, auth, authorizer, workbenchID)
+		if err != nil {
+			logger.TechLog.Error(context.Background(), "workbench share grant ended", zap.Error(err))
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		err = pw(ctx, 1, workbenchID, readOnly, w, r.WithContext(ctx))
 		if err != nil {
 			logger.TechLog.Error(context.Background(), "unable to proxy", zap.Error(err))
 			h.ServeHTTP(w, r)
@@ -69,6 +83,45 @@ func AddProxyWorkbench(h http.Handler, pw ProxyWorkbenchHandler, cfg config.Conf
 	})
 }
 
+// checkWorkbenchShareRoles denies the stream to a user whose JWT still carries a role
+// given by a share grant that has ended. It tells whether the stream is read-only: users
+// who can stream a workbench without being allowed to update it, such as the grantees of
+// a view share grant, only watch it.
+func checkWorkbenchShareRoles(ctx context.Context, sr WorkbenchShareRolesHandler, auth middleware.Authorization, authorizer authorization_service.Authorizer, workbenchID uint64) (bool, error) {
+	userID, err := jwt_model.ExtractUserID(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	userRoles, stale, err := sr(ctx, 1, workbenchID, userID)
+	if err != nil {
+		return false, err
+	}
+	if !stale {
+		return auth.IsAuthorized(ctx, authz.PermUpdateWorkbench.For(authz.WorkbenchID(workbenchID))) != nil, nil
+	}
+
+	roles := make([]authz.Role, 0, len(userRoles))
+	for _, r := range userRoles {
+		roles = append(roles, r.Role)
+	}
+
+	allowed, err := authorizer.IsUserAllowed(roles, authz.PermStreamWorkbench.For(authz.WorkbenchID(workbenchID)))
+	if err != nil {
+		return false, err
+	}
+	if !allowed {
+		return false, fmt.Errorf("user %v is no longer allowed to stream workbench %v", userID, workbenchID)
+	}
+
+	interactive, err := authorizer.IsUserAllowed(roles, authz.PermUpdateWorkbench.For(authz.WorkbenchID(workbenchID)))
+	if err != nil {
+		return false, err
+	}
+
+	return !interactive, nil
+}
+
 func AddAuthUI(h http.Handler) http.Handler {
 	authUIFS, _ := fs.Sub(embed.AuthUIEmbed, "auth-ui")
 	fs := http.FS(authUIFS)
@@ -20,7 +20,7 @@ import (
 
 // InitServer initializes a HTTP-server and returns an empty request multiplexer
 // for a GRPC gateway and a configuration object.
-func InitServer(ctx context.Context, cfg config.Config, version string, started <-chan struct{}, pw middleware.ProxyWorkbenchHandler, authorizer authorization_service.Authorizer, keyFunc jwt_go.Keyfunc, claimsFactory jwt_model.ClaimsFactory, oidcidpService oidcidp_service.OIDCProviderService) (http.Handler, *runtime.ServeMux, []grpc.DialOption) {
+func InitServer(ctx context.Context, cfg config.Config, version string, started <-chan struct{}, pw middleware.ProxyWorkbenchHandler, sr middleware.WorkbenchShareRolesHandler, authorizer authorization_service.Authorizer, keyFunc jwt_go.Keyfunc, claimsFactory jwt_model.ClaimsFactory, oidcidpService oidcidp_service.OIDCProviderService) (http.Handler, *runtime.ServeMux, []grpc.DialOption) {
 
 	mux := runtime.NewServeMux(
 		runtime.WithMetadata(middleware.CorrelationIDMetadata),
@@ -36,6 +36,13 @@ func InitServer(ctx context.Context, cfg config.Config, version string, started
 				},
 			},
 		}),
+		runtime.WithMarshalerOption(MIMEEventStream, &EventStreamMarshaler{
+			JSONPb: &runtime.JSONPb{
+				MarshalOptions: protojson.MarshalOptions{
+					EmitUnpopulated: true,
+				},
+			},
+		}),
 		runtime.WithIncomingHeaderMatcher(newHeaderMatcher(cfg)),
 		runtime.WithOutgoingHeaderMatcher(newOutgoingHeaderMatcher()),
 		runtime.WithForwardResponseOption(responseHeaderMatcher),
@@ -50,7 +57,7 @@ func InitServer(ctx context.Context, cfg config.Config, version string, started
 	handler = oidcidpService.AddOIDCMiddleware(handler)
 	handler = middleware.AddCORS(handler, cfg)
 	if cfg.Services.WorkbenchService.StreamProxyEnabled {
-		handler = middleware.AddProxyWorkbench(handler, pw, cfg, authorizer, keyFunc, claimsFactory)
+		handler = middleware.AddProxyWorkbench(handler, pw, sr, cfg, authorizer, keyFunc, claimsFactory)
 	}
 	if cfg.Services.AuthenticationService.AuthUIEnabled {
 		handler = middleware.AddAuthUI(handler)
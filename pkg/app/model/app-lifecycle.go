@@ -0,0 +1,138 @@
+package model
+
+import (
+	"errors"
+	"slices"
+	"time"
+)
+
+// AppLifecycleStatus is the stage of an app in its review lifecycle. Apps are submitted,
+// reviewed and approved before they can be launched, and are then deprecated and retired.
+type AppLifecycleStatus string
+
+const (
+	AppLifecycleSubmitted   AppLifecycleStatus = "submitted"
+	AppLifecycleUnderReview AppLifecycleStatus = "under_review"
+	AppLifecycleApproved    AppLifecycleStatus = "approved"
+	// AppLifecycleDeprecated apps can still be launched, but their users are warned and
+	// pointed to their replacement.
+	AppLifecycleDeprecated AppLifecycleStatus = "deprecated"
+	// AppLifecycleRetired apps cannot be launched anymore. Running instances are kept.
+	AppLifecycleRetired AppLifecycleStatus = "retired"
+)
+
+func (s AppLifecycleStatus) String() string {
+	return string(s)
+}
+
+func ToAppLifecycleStatus(status string) (AppLifecycleStatus, error) {
+	switch status {
+	case AppLifecycleSubmitted.String():
+		return AppLifecycleSubmitted, nil
+	case AppLifecycleUnderReview.String():
+		return AppLifecycleUnderReview, nil
+	case AppLifecycleApproved.String():
+		return AppLifecycleApproved, nil
+	case AppLifecycleDeprecated.String():
+		return AppLifecycleDeprecated, nil
+	case AppLifecycleRetired.String():
+		return AppLifecycleRetired, nil
+	default:
+		return "", errors.New("unexpected AppLifecycleStatus: " + status)
+	}
+}
+
+// IsLaunchable tells whether new instances of apps in this stage can be started.
+func (s AppLifecycleStatus) IsLaunchable() bool {
+	return s == AppLifecycleApproved || s == AppLifecycleDeprecated
+}
+
+// appLifecycleTransitions lists the stages an approved app can be moved to by an app store
+// admin. The other transitions go through the review of the app.
+var appLifecycleTransitions = map[AppLifecycleStatus][]AppLifecycleStatus{
+	AppLifecycleApproved:   {AppLifecycleDeprecated, AppLifecycleRetired},
+	AppLifecycleDeprecated: {AppLifecycleApproved, AppLifecycleRetired},
+}
+
+// CanMoveTo tells whether an app store admin can move an app from this stage to another.
+func (s AppLifecycleStatus) CanMoveTo(to AppLifecycleStatus) bool {
+	return slices.Contains(appLifecycleTransitions[s], to)
+}
+
+// AppLifecycle is the stage of an app in its lifecycle. ReplacementAppID is the app users
+// are pointed to when the app is deprecated or retired.
+type AppLifecycle struct {
+	LifecycleStatus  AppLifecycleStatus
+	ReplacementAppID *uint64
+}
+
+// AppReviewDecision is the decision of a reviewer of an app.
+type AppReviewDecision string
+
+const (
+	AppReviewPending         AppReviewDecision = "pending"
+	AppReviewApproved        AppReviewDecision = "approved"
+	AppReviewChangesRequired AppReviewDecision = "changes_requested"
+)
+
+func (d AppReviewDecision) String() string {
+	return string(d)
+}
+
+// AppReviewer maps an entry in the 'app_reviewers' database table: a user required to
+// review an app in its current review.
+type AppReviewer struct {
+	ID uint64
+
+	TenantID uint64
+	AppID    uint64
+	UserID   uint64
+
+	Decision  AppReviewDecision
+	DecidedAt *time.Time
+
+	CreatedAt time.Time
+}
+
+// AppLifecycleAction is an event of the lifecycle of an app.
+type AppLifecycleAction string
+
+const (
+	AppLifecycleActionSubmit         AppLifecycleAction = "submit"
+	AppLifecycleActionStartReview    AppLifecycleAction = "start_review"
+	AppLifecycleActionApprove        AppLifecycleAction = "approve"
+	AppLifecycleActionRequestChanges AppLifecycleAction = "request_changes"
+	AppLifecycleActionComment        AppLifecycleAction = "comment"
+	AppLifecycleActionTransition     AppLifecycleAction = "transition"
+)
+
+func (a AppLifecycleAction) String() string {
+	return string(a)
+}
+
+// AppLifecycleEvent maps an entry in the 'app_lifecycle_events' database table. The
+// events of an app are its review comments and the trail of the changes of its stage.
+type AppLifecycleEvent struct {
+	ID uint64
+
+	TenantID uint64
+	AppID    uint64
+	UserID   uint64
+
+	Action     AppLifecycleAction
+	FromStatus AppLifecycleStatus
+	ToStatus   AppLifecycleStatus
+	Comment    string
+
+	CreatedAt time.Time
+}
+
+// AppReview is the review of an app: its stage, the reviewers of its current review and
+// the events of its lifecycle, oldest first.
+type AppReview struct {
+	AppID uint64
+	AppLifecycle
+
+	Reviewers []*AppReviewer
+	Events    []*AppLifecycleEvent
+}
@@ -0,0 +1,185 @@
+package model
+
+import (
+	"cmp"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AppVersion maps an entry in the 'app_versions' database table. A version is an image
+// of an app addressed by its tag and, when known, pinned to its digest.
+type AppVersion struct {
+	ID uint64
+
+	TenantID uint64
+	AppID    uint64
+
+	Tag          string
+	Digest       string
+	ReleaseNotes string
+
+	// IsDefault marks the version used by new app instances that do not pick one. An
+	// app has exactly one default version, whose tag is also the DockerImageTag of the app.
+	IsDefault bool
+	Status    AppVersionStatus
+
+	// VulnerabilityScan is refreshed from the registry by the app_vulnerability_scan job.
+	VulnerabilityScan
+	// VulnerabilityVerdict is the outcome of the vulnerability policy of the tenant for
+	// the version. It is not persisted.
+	VulnerabilityVerdict VulnerabilityVerdict `db:"-"`
+
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	DeprecatedAt *time.Time
+}
+
+// AppVersionStatus represents the status of an app version.
+type AppVersionStatus string
+
+const (
+	AppVersionActive AppVersionStatus = "active"
+	// AppVersionDeprecated versions keep running but no instance can be started or
+	// upgraded to them.
+	AppVersionDeprecated AppVersionStatus = "deprecated"
+)
+
+func (s AppVersionStatus) String() string {
+	return string(s)
+}
+
+func ToAppVersionStatus(status string) (AppVersionStatus, error) {
+	switch status {
+	case AppVersionActive.String():
+		return AppVersionActive, nil
+	case AppVersionDeprecated.String():
+		return AppVersionDeprecated, nil
+	default:
+		return "", errors.New("unexpected AppVersionStatus: " + status)
+	}
+}
+
+func (v *AppVersion) IsDeprecated() bool {
+	return v.Status == AppVersionDeprecated
+}
+
+// Version returns the version of the app with the given ID, or its default version when
+// the ID is 0. It returns nil when the app has no such version.
+func (a *App) Version(versionID uint64) *AppVersion {
+	for _, v := range a.Versions {
+		if (versionID == 0 && v.IsDefault) || (versionID != 0 && v.ID == versionID) {
+			return v
+		}
+	}
+	return nil
+}
+
+// HasVersionTag tells whether the app has a version with the given tag.
+func (a *App) HasVersionTag(tag string) bool {
+	for _, v := range a.Versions {
+		if v.Tag == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// IsNewerThan tells whether the version is a higher version than another, comparing their
+// tags as NewerTag does with the times the versions were added.
+func (v *AppVersion) IsNewerThan(o *AppVersion) bool {
+	return NewerTag(v.Tag, v.CreatedAt, o.Tag, o.CreatedAt)
+}
+
+// NewerTag tells whether a tag is a higher version than another. Tags following semantic
+// versioning, with or without a "v" prefix, are compared by precedence, other tags by the
+// time they were pushed. Tags pushed at the same or an unknown time are compared as
+// strings.
+func NewerTag(tag string, pushedAt time.Time, than string, thanPushedAt time.Time) bool {
+	if v, ok := parseSemver(tag); ok {
+		if thanV, ok := parseSemver(than); ok {
+			return v.compare(thanV) > 0
+		}
+	}
+	if !pushedAt.Equal(thanPushedAt) {
+		return pushedAt.After(thanPushedAt)
+	}
+	return tag > than
+}
+
+// semver is a semantic version, e.g. "1.2.3-rc.1". The minor and patch numbers may be
+// left out, as in "1.2", and the build metadata is ignored.
+type semver struct {
+	core       [3]uint64
+	prerelease []string
+}
+
+func parseSemver(tag string) (semver, bool) {
+	var v semver
+
+	s, _, _ := strings.Cut(strings.TrimPrefix(tag, "v"), "+")
+	s, prerelease, hasPrerelease := strings.Cut(s, "-")
+
+	parts := strings.Split(s, ".")
+	if len(parts) > len(v.core) {
+		return semver{}, false
+	}
+	for i, part := range parts {
+		n, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return semver{}, false
+		}
+		v.core[i] = n
+	}
+
+	if hasPrerelease {
+		v.prerelease = strings.Split(prerelease, ".")
+		for _, id := range v.prerelease {
+			if id == "" {
+				return semver{}, false
+			}
+		}
+	}
+	return v, true
+}
+
+// compare returns -1, 0 or 1 as the precedence of v is lower, equal or higher than the
+// precedence of o. A pre-release has a lower precedence than its release.
+func (v semver) compare(o semver) int {
+	for i := range v.core {
+		if c := cmp.Compare(v.core[i], o.core[i]); c != 0 {
+			return c
+		}
+	}
+
+	switch {
+	case len(v.prerelease) == 0 && len(o.prerelease) == 0:
+		return 0
+	case len(v.prerelease) == 0:
+		return 1
+	case len(o.prerelease) == 0:
+		return -1
+	}
+
+	for i := 0; i < len(v.prerelease) && i < len(o.prerelease); i++ {
+		a, aErr := strconv.ParseUint(v.prerelease[i], 10, 64)
+		b, bErr := strconv.ParseUint(o.prerelease[i], 10, 64)
+		var c int
+		switch {
+		case aErr == nil && bErr == nil:
+			c = cmp.Compare(a, b)
+		case aErr == nil:
+			// Numeric identifiers have a lower precedence than alphanumeric ones.
+			c = -1
+		case bErr == nil:
+			c = 1
+		default:
+			c = strings.Compare(v.prerelease[i], o.prerelease[i])
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return cmp.Compare(uint64(len(v.prerelease)), uint64(len(o.prerelease)))
+}
@@ -11,6 +11,10 @@ type App struct {
 
 	TenantID uint64
 	UserID   uint64
+	// WorkspaceID is set for the custom apps registered by the maintainers of a workspace,
+	// which are only listed and launchable in that workspace, and nil for the apps of the
+	// catalog of the tenant.
+	WorkspaceID *uint64
 
 	Name        string
 	Description string
@@ -35,6 +39,12 @@ type App struct {
 	StabilityStatus              AppStabilityStatus
 	Category                     string
 
+	AppSignature
+	AppLifecycle
+
+	// Versions lists the versions of the app, most recent first.
+	Versions []*AppVersion `db:"-"`
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	DeletedAt *time.Time
@@ -47,6 +57,9 @@ const (
 	AppActive   AppStatus = "active"
 	AppInactive AppStatus = "inactive"
 	AppDeleted  AppStatus = "deleted"
+	// AppPendingApproval custom apps await the approval of an admin before they can be
+	// launched.
+	AppPendingApproval AppStatus = "pending"
 )
 
 func (s AppStatus) String() string {
@@ -61,11 +74,24 @@ func ToAppStatus(status string) (AppStatus, error) {
 		return AppInactive, nil
 	case AppDeleted.String():
 		return AppDeleted, nil
+	case AppPendingApproval.String():
+		return AppPendingApproval, nil
 	default:
 		return "", errors.New("unexpected AppStatus: " + status)
 	}
 }
 
+// IsWorkspaceApp tells whether the app is a custom app of a workspace.
+func (a *App) IsWorkspaceApp() bool {
+	return a.WorkspaceID != nil
+}
+
+// IsLaunchableIn tells whether instances of the app can be started in the workbenches of
+// a workspace: catalog apps can be launched anywhere, custom apps only in their workspace.
+func (a *App) IsLaunchableIn(workspaceID uint64) bool {
+	return a.WorkspaceID == nil || *a.WorkspaceID == workspaceID
+}
+
 // AppStabilityStatus represents the stability status of an app (technical field).
 type AppStabilityStatus string
 
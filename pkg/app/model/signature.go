@@ -0,0 +1,30 @@
+package model
+
+import "time"
+
+// SignatureStatus is the result of the verification of the signature of the image of an
+// app against the trusted keys of its tenant.
+type SignatureStatus string
+
+const (
+	// SignatureStatusUnchecked images were not verified, as the tenant trusts no key and
+	// does not require signatures.
+	SignatureStatusUnchecked SignatureStatus = ""
+	SignatureStatusVerified  SignatureStatus = "verified"
+	SignatureStatusUnsigned  SignatureStatus = "unsigned"
+	// SignatureStatusUntrusted images are signed, but by none of the trusted keys.
+	SignatureStatusUntrusted SignatureStatus = "untrusted"
+)
+
+func (s SignatureStatus) String() string {
+	return string(s)
+}
+
+// AppSignature is the result of the last verification of the signature of the image of an
+// app, at its creation or at the creation of one of its instances.
+type AppSignature struct {
+	SignatureStatus SignatureStatus
+	// SignatureKeyID is the fingerprint of the trusted key the image is signed with.
+	SignatureKeyID     string
+	SignatureCheckedAt *time.Time
+}
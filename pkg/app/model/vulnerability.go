@@ -0,0 +1,111 @@
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+// Severity is the severity of a vulnerability, as reported by the scanner of the registry.
+type Severity string
+
+const (
+	SeverityCritical Severity = "critical"
+	SeverityHigh     Severity = "high"
+	SeverityMedium   Severity = "medium"
+	SeverityLow      Severity = "low"
+)
+
+func (s Severity) String() string {
+	return string(s)
+}
+
+// ToSeverity parses a severity. The empty severity is valid and means no threshold.
+func ToSeverity(severity string) (Severity, error) {
+	switch severity {
+	case "", SeverityCritical.String(), SeverityHigh.String(), SeverityMedium.String(), SeverityLow.String():
+		return Severity(severity), nil
+	default:
+		return "", errors.New("unexpected Severity: " + severity)
+	}
+}
+
+// VulnerabilityScan is the summary of the last vulnerability scan of the image of an app
+// version. ScannedAt is nil until the image has been scanned.
+type VulnerabilityScan struct {
+	ScanStatus   string
+	ScanCritical int
+	ScanHigh     int
+	ScanMedium   int
+	ScanLow      int
+	ScanUnknown  int
+	ScannedAt    *time.Time
+}
+
+func (s VulnerabilityScan) IsScanned() bool {
+	return s.ScannedAt != nil
+}
+
+// CountAtLeast returns the number of vulnerabilities of the given severity or higher.
+func (s VulnerabilityScan) CountAtLeast(severity Severity) int {
+	count := 0
+	switch severity {
+	case SeverityLow:
+		count += s.ScanLow
+		fallthrough
+	case SeverityMedium:
+		count += s.ScanMedium
+		fallthrough
+	case SeverityHigh:
+		count += s.ScanHigh
+		fallthrough
+	case SeverityCritical:
+		count += s.ScanCritical
+	}
+	return count
+}
+
+// VulnerabilityVerdict is the outcome of the vulnerability policy for an app version.
+type VulnerabilityVerdict string
+
+const (
+	// VulnerabilityVerdictNone is returned when the tenant has no vulnerability policy.
+	VulnerabilityVerdictNone VulnerabilityVerdict = ""
+	VulnerabilityVerdictPass VulnerabilityVerdict = "pass"
+	// VulnerabilityVerdictWarn versions can be started, but their users are warned. Versions
+	// that were not scanned yet get this verdict.
+	VulnerabilityVerdictWarn VulnerabilityVerdict = "warn"
+	// VulnerabilityVerdictBlock versions cannot be started.
+	VulnerabilityVerdictBlock VulnerabilityVerdict = "block"
+)
+
+func (v VulnerabilityVerdict) String() string {
+	return string(v)
+}
+
+// VulnerabilityPolicy blocks the versions with vulnerabilities of BlockSeverity or higher,
+// and warns about those with vulnerabilities of WarnSeverity or higher. An empty severity
+// disables the corresponding rule.
+type VulnerabilityPolicy struct {
+	BlockSeverity Severity
+	WarnSeverity  Severity
+}
+
+func (p VulnerabilityPolicy) IsEnabled() bool {
+	return p.BlockSeverity != "" || p.WarnSeverity != ""
+}
+
+// Evaluate applies the policy to the last scan of a version.
+func (p VulnerabilityPolicy) Evaluate(scan VulnerabilityScan) VulnerabilityVerdict {
+	switch {
+	case !p.IsEnabled():
+		return VulnerabilityVerdictNone
+	case !scan.IsScanned():
+		return VulnerabilityVerdictWarn
+	case p.BlockSeverity != "" && scan.CountAtLeast(p.BlockSeverity) > 0:
+		return VulnerabilityVerdictBlock
+	case p.WarnSeverity != "" && scan.CountAtLeast(p.WarnSeverity) > 0:
+		return VulnerabilityVerdictWarn
+	default:
+		return VulnerabilityVerdictPass
+	}
+}
@@ -0,0 +1,309 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/app/model"
+	authz "github.com/CHORUS-TRE/chorus-backend/pkg/authorization/model"
+	notification_model "github.com/CHORUS-TRE/chorus-backend/pkg/notification/model"
+
+	"go.uber.org/zap"
+)
+
+// GetAppReview returns the stage of an app in its lifecycle, the reviewers of its current
+// review and the trail of its lifecycle events.
+func (u *AppService) GetAppReview(ctx context.Context, tenantID, appID uint64) (*model.AppReview, error) {
+	app, err := u.store.GetApp(ctx, tenantID, appID)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to get app %v", appID))
+	}
+
+	return u.appReview(ctx, app)
+}
+
+// StartAppReview assigns the reviewers of a submitted app. The app is approved once all of
+// them approve it. Starting the review of an app under review replaces its reviewers, and
+// their previous decisions are discarded. Reviewers must be allowed to review apps and
+// cannot review the apps they created.
+func (u *AppService) StartAppReview(ctx context.Context, tenantID, appID, userID uint64, reviewerIDs []uint64, comment string) (*model.AppReview, error) {
+	app, err := u.store.GetApp(ctx, tenantID, appID)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to get app %v", appID))
+	}
+	if app.LifecycleStatus != model.AppLifecycleSubmitted && app.LifecycleStatus != model.AppLifecycleUnderReview {
+		return nil, cerr.ErrInvalidRequest.WithMessage(fmt.Sprintf("App %v is %v and cannot be reviewed", appID, app.LifecycleStatus))
+	}
+
+	reviewerIDs = slices.Compact(slices.Sorted(slices.Values(reviewerIDs)))
+	if len(reviewerIDs) == 0 {
+		return nil, cerr.ErrValidation.WithMessage("A review needs at least one reviewer")
+	}
+
+	allowed, err := u.userPermissionFinder.FindUsersWithPermission(ctx, tenantID, authz.FindUsersWithPermissionFilter{
+		PermissionName: authz.PermReviewApp.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, reviewerID := range reviewerIDs {
+		if reviewerID == app.UserID {
+			return nil, cerr.ErrValidation.WithMessage(fmt.Sprintf("User %v created app %v and cannot review it", reviewerID, appID))
+		}
+		if !slices.Contains(allowed, reviewerID) {
+			return nil, cerr.ErrValidation.WithMessage(fmt.Sprintf("User %v is not allowed to review apps", reviewerID))
+		}
+	}
+
+	event := &model.AppLifecycleEvent{
+		TenantID:   tenantID,
+		AppID:      appID,
+		UserID:     userID,
+		Action:     model.AppLifecycleActionStartReview,
+		FromStatus: app.LifecycleStatus,
+		ToStatus:   model.AppLifecycleUnderReview,
+		Comment:    comment,
+	}
+	if err := u.store.StartAppReview(ctx, tenantID, event, reviewerIDs); err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to start review of app %v", appID))
+	}
+
+	u.notifyAppReview(ctx, tenantID, reviewerIDs, fmt.Sprintf("App '%s' has been submitted for your review.", app.Name))
+
+	return u.GetAppReview(ctx, tenantID, appID)
+}
+
+// DecideAppReview records the decision of a reviewer of an app under review. Requesting
+// changes sends the app back to the submitted stage and needs a comment. The app is
+// approved when its last pending reviewer approves it, which the store decides while the
+// app is locked so that concurrent approvals cannot both miss it.
+func (u *AppService) DecideAppReview(ctx context.Context, tenantID, appID, userID uint64, approve bool, comment string) (*model.AppReview, error) {
+	app, err := u.store.GetApp(ctx, tenantID, appID)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to get app %v", appID))
+	}
+	if app.LifecycleStatus != model.AppLifecycleUnderReview {
+		return nil, cerr.ErrInvalidRequest.WithMessage(fmt.Sprintf("App %v is %v and not under review", appID, app.LifecycleStatus))
+	}
+	if !approve && comment == "" {
+		return nil, cerr.ErrValidation.WithMessage("A comment is required to request changes")
+	}
+
+	reviewers, err := u.store.ListAppReviewers(ctx, tenantID, appID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list reviewers of app %v: %w", appID, err)
+	}
+	idx := slices.IndexFunc(reviewers, func(r *model.AppReviewer) bool { return r.UserID == userID })
+	if idx < 0 {
+		return nil, cerr.ErrPermissionDenied.WithMessage(fmt.Sprintf("User %v is not a reviewer of app %v", userID, appID))
+	}
+	if reviewers[idx].Decision != model.AppReviewPending {
+		return nil, cerr.ErrInvalidRequest.WithMessage(fmt.Sprintf("User %v already reviewed app %v", userID, appID))
+	}
+
+	event := &model.AppLifecycleEvent{
+		TenantID:   tenantID,
+		AppID:      appID,
+		UserID:     userID,
+		Action:     model.AppLifecycleActionApprove,
+		FromStatus: app.LifecycleStatus,
+		ToStatus:   app.LifecycleStatus,
+		Comment:    comment,
+	}
+	decision := model.AppReviewApproved
+	if !approve {
+		decision = model.AppReviewChangesRequired
+		event.Action = model.AppLifecycleActionRequestChanges
+		event.ToStatus = model.AppLifecycleSubmitted
+	}
+
+	if err := u.store.DecideAppReview(ctx, tenantID, event, decision); err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to record review of app %v", appID))
+	}
+
+	if event.ToStatus != event.FromStatus {
+		u.notifyAppReview(ctx, tenantID, []uint64{app.UserID}, fmt.Sprintf("App '%s' is now %s.", app.Name, event.ToStatus))
+	}
+
+	return u.GetAppReview(ctx, tenantID, appID)
+}
+
+// CommentAppReview adds a comment to the review of an app.
+func (u *AppService) CommentAppReview(ctx context.Context, tenantID, appID, userID uint64, comment string) (*model.AppReview, error) {
+	if comment == "" {
+		return nil, cerr.ErrValidation.WithMessage("A comment cannot be empty")
+	}
+
+	app, err := u.store.GetApp(ctx, tenantID, appID)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to get app %v", appID))
+	}
+
+	_, err = u.store.CreateAppLifecycleEvent(ctx, tenantID, &model.AppLifecycleEvent{
+		TenantID:   tenantID,
+		AppID:      appID,
+		UserID:     userID,
+		Action:     model.AppLifecycleActionComment,
+		FromStatus: app.LifecycleStatus,
+		ToStatus:   app.LifecycleStatus,
+		Comment:    comment,
+	})
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to comment app %v", appID))
+	}
+
+	return u.appReview(ctx, app)
+}
+
+// UpdateAppLifecycle deprecates, retires or reinstates an approved app. Deprecated and
+// retired apps point their users to the app that replaces them, which must be approved.
+func (u *AppService) UpdateAppLifecycle(ctx context.Context, tenantID, appID, userID uint64, status model.AppLifecycleStatus, replacementAppID *uint64, comment string) (*model.AppReview, error) {
+	app, err := u.store.GetApp(ctx, tenantID, appID)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to get app %v", appID))
+	}
+	if !app.LifecycleStatus.CanMoveTo(status) {
+		return nil, cerr.ErrInvalidRequest.WithMessage(fmt.Sprintf("App %v cannot move from %v to %v", appID, app.LifecycleStatus, status))
+	}
+
+	if status == model.AppLifecycleApproved {
+		replacementAppID = nil
+	}
+	if replacementAppID != nil {
+		if *replacementAppID == appID {
+			return nil, cerr.ErrValidation.WithMessage(fmt.Sprintf("App %v cannot replace itself", appID))
+		}
+		replacement, err := u.store.GetApp(ctx, tenantID, *replacementAppID)
+		if err != nil {
+			return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to get replacement app %v", *replacementAppID))
+		}
+		if replacement.LifecycleStatus != model.AppLifecycleApproved || replacement.Status == model.AppDeleted {
+			return nil, cerr.ErrValidation.WithMessage(fmt.Sprintf("Replacement app %v is not approved", *replacementAppID))
+		}
+	}
+
+	event := &model.AppLifecycleEvent{
+		TenantID:   tenantID,
+		AppID:      appID,
+		UserID:     userID,
+		Action:     model.AppLifecycleActionTransition,
+		FromStatus: app.LifecycleStatus,
+		ToStatus:   status,
+		Comment:    comment,
+	}
+	if err := u.store.UpdateAppLifecycle(ctx, tenantID, event, replacementAppID); err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to update lifecycle of app %v", appID))
+	}
+
+	return u.GetAppReview(ctx, tenantID, appID)
+}
+
+// initAppLifecycles sets the stage new apps start in: submitted for review when the
+// tenant requires it, approved otherwise. Custom apps of workspaces have their own
+// approval and are not reviewed.
+func (u *AppService) initAppLifecycles(ctx context.Context, apps []*model.App) error {
+	if len(apps) == 0 {
+		return nil
+	}
+
+	settings, err := u.platformSettings.GetPlatformSettings(ctx, apps[0].TenantID)
+	if err != nil {
+		return fmt.Errorf("unable to get platform settings: %w", err)
+	}
+
+	for _, app := range apps {
+		app.AppLifecycle = model.AppLifecycle{LifecycleStatus: model.AppLifecycleApproved}
+		if settings.RequireAppReview && !app.IsWorkspaceApp() {
+			app.LifecycleStatus = model.AppLifecycleSubmitted
+		}
+	}
+
+	return nil
+}
+
+// appResubmission returns the event sending an app back to the submitted stage when a
+// user changes its image, so that the new image is reviewed before it can be launched.
+// It returns nil when the app does not need a new review: it is not reviewed, or it is
+// already waiting for one.
+func (u *AppService) appResubmission(ctx context.Context, app *model.App, userID uint64, comment string) (*model.AppLifecycleEvent, error) {
+	if app.IsWorkspaceApp() {
+		return nil, nil
+	}
+	if !app.LifecycleStatus.IsLaunchable() && app.LifecycleStatus != model.AppLifecycleUnderReview {
+		return nil, nil
+	}
+
+	settings, err := u.platformSettings.GetPlatformSettings(ctx, app.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get platform settings: %w", err)
+	}
+	if !settings.RequireAppReview {
+		return nil, nil
+	}
+
+	return &model.AppLifecycleEvent{
+		TenantID:   app.TenantID,
+		AppID:      app.ID,
+		UserID:     userID,
+		Action:     model.AppLifecycleActionSubmit,
+		FromStatus: app.LifecycleStatus,
+		ToStatus:   model.AppLifecycleSubmitted,
+		Comment:    comment,
+	}, nil
+}
+
+// recordAppSubmissions starts the lifecycle trail of the new apps submitted for review.
+func (u *AppService) recordAppSubmissions(ctx context.Context, apps []*model.App) {
+	for _, app := range apps {
+		if app.LifecycleStatus != model.AppLifecycleSubmitted {
+			continue
+		}
+		_, err := u.store.CreateAppLifecycleEvent(ctx, app.TenantID, &model.AppLifecycleEvent{
+			TenantID: app.TenantID,
+			AppID:    app.ID,
+			UserID:   app.UserID,
+			Action:   model.AppLifecycleActionSubmit,
+			ToStatus: model.AppLifecycleSubmitted,
+		})
+		if err != nil {
+			logger.TechLog.Error(ctx, "Unable to record app submission", zap.Uint64("tenant_id", app.TenantID), zap.Uint64("app_id", app.ID), zap.Error(err))
+		}
+	}
+}
+
+func (u *AppService) appReview(ctx context.Context, app *model.App) (*model.AppReview, error) {
+	reviewers, err := u.store.ListAppReviewers(ctx, app.TenantID, app.ID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list reviewers of app %v: %w", app.ID, err)
+	}
+	events, err := u.store.ListAppLifecycleEvents(ctx, app.TenantID, app.ID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list lifecycle events of app %v: %w", app.ID, err)
+	}
+
+	return &model.AppReview{
+		AppID:        app.ID,
+		AppLifecycle: app.AppLifecycle,
+		Reviewers:    reviewers,
+		Events:       events,
+	}, nil
+}
+
+func (u *AppService) notifyAppReview(ctx context.Context, tenantID uint64, userIDs []uint64, message string) {
+	for _, userID := range userIDs {
+		err := u.notificationStore.CreateNotification(ctx, &notification_model.Notification{
+			TenantID: tenantID,
+			UserID:   userID,
+			Message:  message,
+			Content: notification_model.NotificationContent{
+				Type: "SystemNotification",
+			},
+		}, []uint64{userID})
+		if err != nil {
+			logger.TechLog.Error(ctx, "Unable to create notification", zap.Uint64("tenant_id", tenantID), zap.Uint64("user_id", userID), zap.Error(err))
+		}
+	}
+}
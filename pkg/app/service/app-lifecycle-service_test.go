@@ -0,0 +1,325 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/app/model"
+	authz "github.com/CHORUS-TRE/chorus-backend/pkg/authorization/model"
+	platform_settings_model "github.com/CHORUS-TRE/chorus-backend/pkg/platform-settings/model"
+)
+
+type lifecycleAppStore struct {
+	workspaceAppStore
+	reviewers map[uint64][]*model.AppReviewer
+	events    []*model.AppLifecycleEvent
+}
+
+func (s *lifecycleAppStore) ListAppReviewers(_ context.Context, _, appID uint64) ([]*model.AppReviewer, error) {
+	return s.reviewers[appID], nil
+}
+
+func (s *lifecycleAppStore) ListAppLifecycleEvents(_ context.Context, _, appID uint64) ([]*model.AppLifecycleEvent, error) {
+	var events []*model.AppLifecycleEvent
+	for _, e := range s.events {
+		if e.AppID == appID {
+			events = append(events, e)
+		}
+	}
+	return events, nil
+}
+
+func (s *lifecycleAppStore) CreateAppLifecycleEvent(_ context.Context, _ uint64, event *model.AppLifecycleEvent) (*model.AppLifecycleEvent, error) {
+	s.events = append(s.events, event)
+	return event, nil
+}
+
+func (s *lifecycleAppStore) StartAppReview(_ context.Context, _ uint64, event *model.AppLifecycleEvent, reviewerIDs []uint64) error {
+	s.apps[event.AppID].LifecycleStatus = event.ToStatus
+	s.reviewers[event.AppID] = nil
+	for _, id := range reviewerIDs {
+		s.reviewers[event.AppID] = append(s.reviewers[event.AppID], &model.AppReviewer{AppID: event.AppID, UserID: id, Decision: model.AppReviewPending})
+	}
+	s.events = append(s.events, event)
+	return nil
+}
+
+func (s *lifecycleAppStore) DecideAppReview(_ context.Context, _ uint64, event *model.AppLifecycleEvent, decision model.AppReviewDecision) error {
+	approved := decision == model.AppReviewApproved
+	for _, r := range s.reviewers[event.AppID] {
+		if r.UserID == event.UserID {
+			r.Decision = decision
+		}
+		approved = approved && r.Decision == model.AppReviewApproved
+	}
+	if approved {
+		event.ToStatus = model.AppLifecycleApproved
+	}
+	s.apps[event.AppID].LifecycleStatus = event.ToStatus
+	s.events = append(s.events, event)
+	return nil
+}
+
+func (s *lifecycleAppStore) UpdateAppLifecycle(_ context.Context, _ uint64, event *model.AppLifecycleEvent, replacementAppID *uint64) error {
+	s.apps[event.AppID].AppLifecycle = model.AppLifecycle{LifecycleStatus: event.ToStatus, ReplacementAppID: replacementAppID}
+	s.events = append(s.events, event)
+	return nil
+}
+
+func (s *lifecycleAppStore) ListAppVersions(_ context.Context, _ uint64, _ []uint64) ([]*model.AppVersion, error) {
+	return nil, nil
+}
+
+func (s *lifecycleAppStore) UpdateApp(_ context.Context, _ uint64, app *model.App, event *model.AppLifecycleEvent) (*model.App, error) {
+	updated := *app
+	updated.AppLifecycle = s.apps[app.ID].AppLifecycle
+	updated.StabilityStatus = s.apps[app.ID].StabilityStatus
+	if event != nil {
+		updated.LifecycleStatus = event.ToStatus
+		s.events = append(s.events, event)
+	}
+	s.apps[app.ID] = &updated
+	return &updated, nil
+}
+
+func (s *lifecycleAppStore) CreateAppVersion(_ context.Context, _ uint64, version *model.AppVersion, event *model.AppLifecycleEvent) (*model.AppVersion, error) {
+	if event != nil {
+		s.apps[event.AppID].LifecycleStatus = event.ToStatus
+		s.events = append(s.events, event)
+	}
+	return version, nil
+}
+
+type reviewerFinder struct {
+	reviewers []uint64
+}
+
+func (f *reviewerFinder) FindUsersWithPermission(_ context.Context, _ uint64, filter authz.FindUsersWithPermissionFilter) ([]uint64, error) {
+	if filter.PermissionName != authz.PermReviewApp.Name {
+		return nil, nil
+	}
+	return f.reviewers, nil
+}
+
+func newLifecycleSvc(requireReview bool) (*AppService, *lifecycleAppStore, *notificationRecorder) {
+	store := &lifecycleAppStore{
+		workspaceAppStore: workspaceAppStore{apps: map[uint64]*model.App{}},
+		reviewers:         map[uint64][]*model.AppReviewer{},
+	}
+	notifications := &notificationRecorder{}
+	return &AppService{
+		store:                store,
+		k8sClient:            &prePullK8sClient{},
+		ociClient:            &workspaceAppOCIClient{},
+		platformSettings:     &settingsReader{settings: platform_settings_model.PlatformSettings{RequireAppReview: requireReview}},
+		userPermissionFinder: &reviewerFinder{reviewers: []uint64{20, 21, 22}},
+		notificationStore:    notifications,
+	}, store, notifications
+}
+
+func catalogApp(userID uint64) *model.App {
+	return &model.App{
+		TenantID: 1, UserID: userID,
+		Name: "tool", DockerImageRegistry: "harbor.local", DockerImageName: "apps/tool", DockerImageTag: "1.0",
+	}
+}
+
+func TestCreateApp_Lifecycle(t *testing.T) {
+	svc, store, _ := newLifecycleSvc(false)
+	app, err := svc.CreateApp(context.Background(), catalogApp(5))
+	require.NoError(t, err)
+	assert.Equal(t, model.AppLifecycleApproved, app.LifecycleStatus)
+	assert.Empty(t, store.events)
+
+	svc, store, _ = newLifecycleSvc(true)
+	app, err = svc.CreateApp(context.Background(), catalogApp(5))
+	require.NoError(t, err)
+	assert.Equal(t, model.AppLifecycleSubmitted, app.LifecycleStatus)
+	require.Len(t, store.events, 1)
+	assert.Equal(t, model.AppLifecycleActionSubmit, store.events[0].Action)
+
+	app, err = svc.CreateApp(context.Background(), customApp(5))
+	require.NoError(t, err)
+	assert.Equal(t, model.AppLifecycleApproved, app.LifecycleStatus, "custom apps are not reviewed")
+}
+
+func TestAppReview(t *testing.T) {
+	svc, _, notifications := newLifecycleSvc(true)
+	app, err := svc.CreateApp(context.Background(), catalogApp(5))
+	require.NoError(t, err)
+
+	review, err := svc.StartAppReview(context.Background(), 1, app.ID, 30, []uint64{21, 20, 21}, "")
+	require.NoError(t, err)
+	assert.Equal(t, model.AppLifecycleUnderReview, review.LifecycleStatus)
+	require.Len(t, review.Reviewers, 2)
+	assert.ElementsMatch(t, []uint64{20, 21}, notifications.notified)
+
+	_, err = svc.DecideAppReview(context.Background(), 1, app.ID, 22, true, "")
+	assertChorusCode(t, cerr.ErrPermissionDenied, err)
+
+	review, err = svc.DecideAppReview(context.Background(), 1, app.ID, 20, true, "")
+	require.NoError(t, err)
+	assert.Equal(t, model.AppLifecycleUnderReview, review.LifecycleStatus, "all reviewers must approve")
+
+	_, err = svc.DecideAppReview(context.Background(), 1, app.ID, 20, true, "")
+	assertChorusCode(t, cerr.ErrInvalidRequest, err)
+
+	review, err = svc.CommentAppReview(context.Background(), 1, app.ID, 21, "Looks good")
+	require.NoError(t, err)
+	assert.Equal(t, model.AppLifecycleUnderReview, review.LifecycleStatus)
+
+	review, err = svc.DecideAppReview(context.Background(), 1, app.ID, 21, true, "")
+	require.NoError(t, err)
+	assert.Equal(t, model.AppLifecycleApproved, review.LifecycleStatus)
+	assert.Contains(t, notifications.notified, uint64(5), "the creator is notified")
+
+	var actions []model.AppLifecycleAction
+	for _, e := range review.Events {
+		actions = append(actions, e.Action)
+	}
+	assert.Equal(t, []model.AppLifecycleAction{
+		model.AppLifecycleActionSubmit, model.AppLifecycleActionStartReview, model.AppLifecycleActionApprove,
+		model.AppLifecycleActionComment, model.AppLifecycleActionApprove,
+	}, actions)
+}
+
+func TestAppReview_RequestChanges(t *testing.T) {
+	svc, store, _ := newLifecycleSvc(true)
+	app, err := svc.CreateApp(context.Background(), catalogApp(5))
+	require.NoError(t, err)
+
+	_, err = svc.DecideAppReview(context.Background(), 1, app.ID, 20, true, "")
+	assertChorusCode(t, cerr.ErrInvalidRequest, err)
+
+	_, err = svc.StartAppReview(context.Background(), 1, app.ID, 30, []uint64{20}, "")
+	require.NoError(t, err)
+
+	_, err = svc.DecideAppReview(context.Background(), 1, app.ID, 20, false, "")
+	assertChorusCode(t, cerr.ErrValidation, err)
+
+	review, err := svc.DecideAppReview(context.Background(), 1, app.ID, 20, false, "Pin the base image")
+	require.NoError(t, err)
+	assert.Equal(t, model.AppLifecycleSubmitted, review.LifecycleStatus)
+	assert.Equal(t, model.AppReviewChangesRequired, store.reviewers[app.ID][0].Decision)
+
+	review, err = svc.StartAppReview(context.Background(), 1, app.ID, 30, []uint64{20}, "Base image pinned")
+	require.NoError(t, err)
+	assert.Equal(t, model.AppReviewPending, review.Reviewers[0].Decision, "a new review discards the previous decisions")
+}
+
+func TestStartAppReview_Rejected(t *testing.T) {
+	svc, _, _ := newLifecycleSvc(true)
+	app, err := svc.CreateApp(context.Background(), catalogApp(20))
+	require.NoError(t, err)
+
+	_, err = svc.StartAppReview(context.Background(), 1, app.ID, 30, nil, "")
+	assertChorusCode(t, cerr.ErrValidation, err)
+
+	_, err = svc.StartAppReview(context.Background(), 1, app.ID, 30, []uint64{20}, "")
+	assertChorusCode(t, cerr.ErrValidation, err)
+
+	_, err = svc.StartAppReview(context.Background(), 1, app.ID, 30, []uint64{99}, "")
+	assertChorusCode(t, cerr.ErrValidation, err)
+
+	svc, _, _ = newLifecycleSvc(false)
+	app, err = svc.CreateApp(context.Background(), catalogApp(5))
+	require.NoError(t, err)
+	_, err = svc.StartAppReview(context.Background(), 1, app.ID, 30, []uint64{21}, "")
+	assertChorusCode(t, cerr.ErrInvalidRequest, err)
+}
+
+func TestUpdateAppLifecycle(t *testing.T) {
+	svc, store, _ := newLifecycleSvc(false)
+	app, err := svc.CreateApp(context.Background(), catalogApp(5))
+	require.NoError(t, err)
+	replacement, err := svc.CreateApp(context.Background(), catalogApp(5))
+	require.NoError(t, err)
+
+	self := app.ID
+	_, err = svc.UpdateAppLifecycle(context.Background(), 1, app.ID, 30, model.AppLifecycleDeprecated, &self, "")
+	assertChorusCode(t, cerr.ErrValidation, err)
+
+	review, err := svc.UpdateAppLifecycle(context.Background(), 1, app.ID, 30, model.AppLifecycleDeprecated, &replacement.ID, "Use the new tool")
+	require.NoError(t, err)
+	assert.Equal(t, model.AppLifecycleDeprecated, review.LifecycleStatus)
+	assert.Equal(t, replacement.ID, *review.ReplacementAppID)
+
+	_, err = svc.UpdateAppLifecycle(context.Background(), 1, replacement.ID, 30, model.AppLifecycleRetired, &app.ID, "")
+	assertChorusCode(t, cerr.ErrValidation, err)
+	assert.Equal(t, model.AppLifecycleApproved, store.apps[replacement.ID].LifecycleStatus, "deprecated apps cannot replace others")
+
+	review, err = svc.UpdateAppLifecycle(context.Background(), 1, app.ID, 30, model.AppLifecycleRetired, &replacement.ID, "")
+	require.NoError(t, err)
+	assert.Equal(t, model.AppLifecycleRetired, review.LifecycleStatus)
+
+	_, err = svc.UpdateAppLifecycle(context.Background(), 1, app.ID, 30, model.AppLifecycleApproved, nil, "")
+	assertChorusCode(t, cerr.ErrInvalidRequest, err)
+
+	svc, _, _ = newLifecycleSvc(true)
+	app, err = svc.CreateApp(context.Background(), catalogApp(5))
+	require.NoError(t, err)
+	_, err = svc.UpdateAppLifecycle(context.Background(), 1, app.ID, 30, model.AppLifecycleApproved, nil, "")
+	assertChorusCode(t, cerr.ErrInvalidRequest, err)
+}
+
+func TestUpdateApp_ResubmitsChangedImage(t *testing.T) {
+	svc, store, _ := newLifecycleSvc(true)
+	app, err := svc.CreateApp(context.Background(), catalogApp(5))
+	require.NoError(t, err)
+	store.apps[app.ID].LifecycleStatus = model.AppLifecycleApproved
+	store.apps[app.ID].StabilityStatus = model.AppStabilityStatusBeta
+	store.events = nil
+
+	update := *store.apps[app.ID]
+	update.Description = "A tool"
+	updated, err := svc.UpdateApp(context.Background(), &update, 30)
+	require.NoError(t, err)
+	assert.Equal(t, model.AppLifecycleApproved, updated.LifecycleStatus, "the image did not change")
+	assert.Equal(t, model.AppStabilityStatusBeta, updated.StabilityStatus)
+	assert.Empty(t, store.events)
+
+	update.StabilityStatus = model.AppStabilityStatusReady
+	_, err = svc.UpdateApp(context.Background(), &update, 30)
+	assertChorusCode(t, cerr.ErrValidation, err)
+
+	update = *store.apps[app.ID]
+	update.DockerImageTag = "1.1"
+	updated, err = svc.UpdateApp(context.Background(), &update, 30)
+	require.NoError(t, err)
+	assert.Equal(t, model.AppLifecycleSubmitted, updated.LifecycleStatus)
+	require.Len(t, store.events, 1)
+	assert.Equal(t, model.AppLifecycleActionSubmit, store.events[0].Action)
+	assert.Equal(t, model.AppLifecycleApproved, store.events[0].FromStatus)
+	assert.Equal(t, uint64(30), store.events[0].UserID, "the user who changed the image submits it")
+}
+
+func TestCreateAppVersion_ResubmitsApp(t *testing.T) {
+	svc, store, _ := newLifecycleSvc(true)
+	app, err := svc.CreateApp(context.Background(), catalogApp(5))
+	require.NoError(t, err)
+	store.apps[app.ID].LifecycleStatus = model.AppLifecycleApproved
+	store.events = nil
+
+	_, err = svc.CreateAppVersion(context.Background(), &model.AppVersion{TenantID: 1, AppID: app.ID, Tag: "1.1"}, 30)
+	require.NoError(t, err)
+	assert.Equal(t, model.AppLifecycleSubmitted, store.apps[app.ID].LifecycleStatus, "new versions are reviewed before they can be launched")
+	require.Len(t, store.events, 1)
+	assert.Equal(t, uint64(30), store.events[0].UserID)
+
+	_, err = svc.CreateAppVersion(context.Background(), &model.AppVersion{TenantID: 1, AppID: app.ID, Tag: "1.2"}, 30)
+	require.NoError(t, err)
+	assert.Len(t, store.events, 1, "a submitted app is already waiting for its review")
+
+	svc, store, _ = newLifecycleSvc(false)
+	app, err = svc.CreateApp(context.Background(), catalogApp(5))
+	require.NoError(t, err)
+	_, err = svc.CreateAppVersion(context.Background(), &model.AppVersion{TenantID: 1, AppID: app.ID, Tag: "1.1"}, 30)
+	require.NoError(t, err)
+	assert.Equal(t, model.AppLifecycleApproved, store.apps[app.ID].LifecycleStatus, "apps are not reviewed")
+}
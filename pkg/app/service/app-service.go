@@ -7,8 +7,11 @@ import (
 	"github.com/CHORUS-TRE/chorus-backend/internal/client/harbor"
 	"github.com/CHORUS-TRE/chorus-backend/internal/client/k8s"
 	"github.com/CHORUS-TRE/chorus-backend/internal/client/ociregistry"
+	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/app/model"
 	common "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
+	platform_settings_model "github.com/CHORUS-TRE/chorus-backend/pkg/platform-settings/model"
 )
 
 type Apper interface {
@@ -16,8 +19,25 @@ type Apper interface {
 	ListApps(ctx context.Context, tenantID uint64, pagination *common.Pagination) ([]*model.App, *common.PaginationResult, error)
 	CreateApp(ctx context.Context, app *model.App) (*model.App, error)
 	BulkCreateApps(ctx context.Context, apps []*model.App) ([]*model.App, error)
-	UpdateApp(ctx context.Context, app *model.App) (*model.App, error)
+	UpdateApp(ctx context.Context, app *model.App, userID uint64) (*model.App, error)
 	DeleteApp(ctx context.Context, tenantId, appId uint64) error
+
+	ListWorkspaceApps(ctx context.Context, tenantID, workspaceID uint64) ([]*model.App, error)
+	CreateWorkspaceApp(ctx context.Context, app *model.App) (*model.App, error)
+	ApproveWorkspaceApp(ctx context.Context, tenantID, appID uint64) error
+	DeleteWorkspaceApp(ctx context.Context, tenantID, workspaceID, appID uint64) error
+
+	ListAppVersions(ctx context.Context, tenantID, appID uint64) ([]*model.AppVersion, error)
+	CreateAppVersion(ctx context.Context, version *model.AppVersion, userID uint64) (*model.AppVersion, error)
+	UpdateAppVersion(ctx context.Context, version *model.AppVersion) (*model.AppVersion, error)
+
+	VerifyAppSignature(ctx context.Context, tenantID, appID uint64, imageRef string) (*model.AppSignature, error)
+
+	GetAppReview(ctx context.Context, tenantID, appID uint64) (*model.AppReview, error)
+	StartAppReview(ctx context.Context, tenantID, appID, userID uint64, reviewerIDs []uint64, comment string) (*model.AppReview, error)
+	DecideAppReview(ctx context.Context, tenantID, appID, userID uint64, approve bool, comment string) (*model.AppReview, error)
+	CommentAppReview(ctx context.Context, tenantID, appID, userID uint64, comment string) (*model.AppReview, error)
+	UpdateAppLifecycle(ctx context.Context, tenantID, appID, userID uint64, status model.AppLifecycleStatus, replacementAppID *uint64, comment string) (*model.AppReview, error)
 }
 
 type AppStore interface {
@@ -25,23 +45,67 @@ type AppStore interface {
 	ListApps(ctx context.Context, tenantID uint64, pagination *common.Pagination) ([]*model.App, *common.PaginationResult, error)
 	CreateApp(ctx context.Context, tenantID uint64, app *model.App) (*model.App, error)
 	BulkCreateApps(ctx context.Context, tenantID uint64, apps []*model.App) ([]*model.App, error)
-	UpdateApp(ctx context.Context, tenantID uint64, app *model.App) (*model.App, error)
+	UpdateApp(ctx context.Context, tenantID uint64, app *model.App, event *model.AppLifecycleEvent) (*model.App, error)
 	DeleteApp(ctx context.Context, tenantID uint64, appID uint64) error
+
+	ListWorkspaceApps(ctx context.Context, tenantID, workspaceID uint64) ([]*model.App, error)
+	UpdateAppStatus(ctx context.Context, tenantID, appID uint64, status model.AppStatus) error
+
+	ListAppVersions(ctx context.Context, tenantID uint64, appIDs []uint64) ([]*model.AppVersion, error)
+	GetAppVersion(ctx context.Context, tenantID uint64, versionID uint64) (*model.AppVersion, error)
+	CreateAppVersion(ctx context.Context, tenantID uint64, version *model.AppVersion, event *model.AppLifecycleEvent) (*model.AppVersion, error)
+	UpdateAppVersion(ctx context.Context, tenantID uint64, version *model.AppVersion) (*model.AppVersion, error)
+	UpdateAppVersionScan(ctx context.Context, tenantID, versionID uint64, scan model.VulnerabilityScan) error
+
+	UpdateAppSignature(ctx context.Context, tenantID, appID uint64, signature model.AppSignature) error
+
+	ListAppReviewers(ctx context.Context, tenantID, appID uint64) ([]*model.AppReviewer, error)
+	ListAppLifecycleEvents(ctx context.Context, tenantID, appID uint64) ([]*model.AppLifecycleEvent, error)
+	CreateAppLifecycleEvent(ctx context.Context, tenantID uint64, event *model.AppLifecycleEvent) (*model.AppLifecycleEvent, error)
+	StartAppReview(ctx context.Context, tenantID uint64, event *model.AppLifecycleEvent, reviewerIDs []uint64) error
+	DecideAppReview(ctx context.Context, tenantID uint64, event *model.AppLifecycleEvent, decision model.AppReviewDecision) error
+	UpdateAppLifecycle(ctx context.Context, tenantID uint64, event *model.AppLifecycleEvent, replacementAppID *uint64) error
+}
+
+// PlatformSettingsReader reads the vulnerability, signature and custom app policies of a
+// tenant.
+type PlatformSettingsReader interface {
+	GetPlatformSettings(ctx context.Context, tenantID uint64) (*platform_settings_model.PlatformSettings, error)
 }
 
 type AppService struct {
-	store        AppStore
-	k8sClient    k8s.K8sClienter
-	ociClient    ociregistry.OCIClienter
-	harborClient harbor.HarborClient
+	cfg                  config.Config
+	store                AppStore
+	k8sClient            k8s.K8sClienter
+	ociClient            ociregistry.OCIClienter
+	harborClient         harbor.HarborClient
+	platformSettings     PlatformSettingsReader
+	approvalRequestStore ApprovalRequestStore
+	userPermissionFinder UserPermissionFinder
+	notificationStore    NotificationStore
 }
 
-func NewAppService(store AppStore, k8sClient k8s.K8sClienter, ociClient ociregistry.OCIClienter, harborClient harbor.HarborClient) *AppService {
+func NewAppService(
+	cfg config.Config,
+	store AppStore,
+	k8sClient k8s.K8sClienter,
+	ociClient ociregistry.OCIClienter,
+	harborClient harbor.HarborClient,
+	platformSettings PlatformSettingsReader,
+	approvalRequestStore ApprovalRequestStore,
+	userPermissionFinder UserPermissionFinder,
+	notificationStore NotificationStore,
+) *AppService {
 	return &AppService{
-		store:        store,
-		k8sClient:    k8sClient,
-		ociClient:    ociClient,
-		harborClient: harborClient,
+		cfg:                  cfg,
+		store:                store,
+		k8sClient:            k8sClient,
+		ociClient:            ociClient,
+		harborClient:         harborClient,
+		platformSettings:     platformSettings,
+		approvalRequestStore: approvalRequestStore,
+		userPermissionFinder: userPermissionFinder,
+		notificationStore:    notificationStore,
 	}
 }
 
@@ -50,6 +114,9 @@ func (u *AppService) ListApps(ctx context.Context, tenantID uint64, pagination *
 	if err != nil {
 		return nil, nil, fmt.Errorf("unable to query apps: %w", err)
 	}
+	if err := u.loadAppVersions(ctx, tenantID, apps); err != nil {
+		return nil, nil, err
+	}
 	return apps, paginationRes, nil
 }
 
@@ -58,6 +125,9 @@ func (u *AppService) GetApp(ctx context.Context, tenantID, appID uint64) (*model
 	if err != nil {
 		return nil, fmt.Errorf("unable to get app %v: %w", appID, err)
 	}
+	if err := u.loadAppVersions(ctx, tenantID, []*model.App{app}); err != nil {
+		return nil, err
+	}
 
 	return app, nil
 }
@@ -71,11 +141,32 @@ func (u *AppService) DeleteApp(ctx context.Context, tenantID, appID uint64) erro
 	return nil
 }
 
-func (u *AppService) UpdateApp(ctx context.Context, app *model.App) (*model.App, error) {
+// UpdateApp updates an app on behalf of a user. The stability status of an app is the
+// label of its image, set by the catalog sync, and cannot be changed.
+func (u *AppService) UpdateApp(ctx context.Context, app *model.App, userID uint64) (*model.App, error) {
 	if err := u.validateRegistry(app); err != nil {
 		return nil, err
 	}
 
+	current, err := u.store.GetApp(ctx, app.TenantID, app.ID)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to get app %v", app.ID))
+	}
+	if app.StabilityStatus != "" && app.StabilityStatus != current.StabilityStatus {
+		return nil, cerr.ErrValidation.WithMessage(fmt.Sprintf("The stability status of app %v is synced from its image and cannot be changed", app.ID))
+	}
+
+	// The tag of an app is the tag of its default version, which cannot be deprecated.
+	versions, err := u.store.ListAppVersions(ctx, app.TenantID, []uint64{app.ID})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list versions of app %v: %w", app.ID, err)
+	}
+	for _, v := range versions {
+		if v.Tag == app.DockerImageTag && v.IsDeprecated() {
+			return nil, cerr.ErrValidation.WithMessage(fmt.Sprintf("Version %q of app %v is deprecated and cannot be its default version", v.Tag, app.ID))
+		}
+	}
+
 	imageRef := dockerImageToString(app)
 
 	exists, err := u.ociClient.ImageExists(imageRef)
@@ -85,8 +176,18 @@ func (u *AppService) UpdateApp(ctx context.Context, app *model.App) (*model.App,
 	if !exists {
 		return nil, fmt.Errorf("app image %s does not exist", imageRef)
 	}
+	if err := u.verifyAppSignatures(ctx, []*model.App{app}); err != nil {
+		return nil, err
+	}
+	var resubmission *model.AppLifecycleEvent
+	if dockerImageToString(current) != imageRef {
+		resubmission, err = u.appResubmission(ctx, current, userID, fmt.Sprintf("Image changed to %s", imageRef))
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	updatedApp, err := u.store.UpdateApp(ctx, app.TenantID, app)
+	updatedApp, err := u.store.UpdateApp(ctx, app.TenantID, app, resubmission)
 	if err != nil {
 		return nil, fmt.Errorf("unable to update app %s: %w", app.Name, err)
 	}
@@ -102,21 +203,23 @@ func (u *AppService) CreateApp(ctx context.Context, app *model.App) (*model.App,
 	if err := u.validateRegistry(app); err != nil {
 		return nil, err
 	}
-
-	imageRef := dockerImageToString(app)
-
-	exists, err := u.ociClient.ImageExists(imageRef)
-	if err != nil {
-		return nil, fmt.Errorf("unable to verify app image existence %s: %w", imageRef, err)
+	if err := u.checkAppVersionImages(app); err != nil {
+		return nil, err
 	}
-	if !exists {
-		return nil, fmt.Errorf("app image %s does not exist", imageRef)
+	if err := u.verifyAppSignatures(ctx, []*model.App{app}); err != nil {
+		return nil, err
 	}
+	if err := u.initAppLifecycles(ctx, []*model.App{app}); err != nil {
+		return nil, err
+	}
+
+	imageRef := dockerImageToString(app)
 
 	newApp, err := u.store.CreateApp(ctx, app.TenantID, app)
 	if err != nil {
 		return nil, fmt.Errorf("unable to create app %s: %w", app.Name, err)
 	}
+	u.recordAppSubmissions(ctx, []*model.App{newApp})
 
 	go func() {
 		u.k8sClient.PrePullImageOnAllNodes(imageRef)
@@ -130,22 +233,22 @@ func (u *AppService) BulkCreateApps(ctx context.Context, apps []*model.App) ([]*
 		if err := u.validateRegistry(app); err != nil {
 			return nil, err
 		}
-
-		imageRef := dockerImageToString(app)
-
-		exists, err := u.ociClient.ImageExists(imageRef)
-		if err != nil {
-			return nil, fmt.Errorf("unable to verify app image existence %s: %w", imageRef, err)
-		}
-		if !exists {
-			return nil, fmt.Errorf("app image %s does not exist", imageRef)
+		if err := u.checkAppVersionImages(app); err != nil {
+			return nil, err
 		}
 	}
+	if err := u.verifyAppSignatures(ctx, apps); err != nil {
+		return nil, err
+	}
+	if err := u.initAppLifecycles(ctx, apps); err != nil {
+		return nil, err
+	}
 
 	newApps, err := u.store.BulkCreateApps(ctx, apps[0].TenantID, apps)
 	if err != nil {
 		return nil, fmt.Errorf("unable to bulk create apps: %w", err)
 	}
+	u.recordAppSubmissions(ctx, newApps)
 
 	go func() {
 		for _, app := range apps {
@@ -162,10 +265,10 @@ func dockerImageToString(app *model.App) string {
 	return app.DockerImageRegistry + "/" + app.DockerImageName + ":" + app.DockerImageTag
 }
 
-// validateRegistry rejects an app whose registry doesn't match the one the
-// OCI client is configured for
+// validateRegistry rejects an app whose registry is neither the one the OCI
+// client is configured for nor one of the catalogs apps are synced from
 func (u *AppService) validateRegistry(app *model.App) error {
-	if app.DockerImageRegistry != "" && app.DockerImageRegistry != u.ociClient.Host() {
+	if app.DockerImageRegistry != "" && !u.ociClient.HasRegistry(app.DockerImageRegistry) {
 		return fmt.Errorf("app image registry %q does not match configured registry %q", app.DockerImageRegistry, u.ociClient.Host())
 	}
 	return nil
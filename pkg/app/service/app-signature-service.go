@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/ociregistry"
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/app/model"
+	platform_settings_model "github.com/CHORUS-TRE/chorus-backend/pkg/platform-settings/model"
+)
+
+// VerifyAppSignature verifies the signature of an image of an app, before an instance of
+// it is started, and records the result on the app. It refuses the image when the tenant
+// requires signatures and the image is not signed by one of its trusted keys.
+func (u *AppService) VerifyAppSignature(ctx context.Context, tenantID, appID uint64, imageRef string) (*model.AppSignature, error) {
+	settings, err := u.platformSettings.GetPlatformSettings(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get platform settings: %w", err)
+	}
+
+	signature, checkErr := u.checkSignature(settings, imageRef)
+	if signature.SignatureCheckedAt != nil {
+		if err := u.store.UpdateAppSignature(ctx, tenantID, appID, signature); err != nil {
+			return nil, fmt.Errorf("unable to record signature of app %v: %w", appID, err)
+		}
+	}
+	if checkErr != nil {
+		return nil, checkErr
+	}
+
+	return &signature, nil
+}
+
+// verifyAppSignatures verifies the signatures of the images of new apps and records the
+// results on them.
+func (u *AppService) verifyAppSignatures(ctx context.Context, apps []*model.App) error {
+	if len(apps) == 0 {
+		return nil
+	}
+
+	settings, err := u.platformSettings.GetPlatformSettings(ctx, apps[0].TenantID)
+	if err != nil {
+		return fmt.Errorf("unable to get platform settings: %w", err)
+	}
+
+	for _, app := range apps {
+		signature, err := u.checkSignature(settings, dockerImageToString(app))
+		if err != nil {
+			return err
+		}
+		app.AppSignature = signature
+	}
+
+	return nil
+}
+
+// checkSignature verifies the signature of an image against the trusted keys of the
+// tenant. Nothing is checked when the tenant trusts no key and does not require
+// signatures, and the image is unchecked when the registry cannot be reached while
+// signatures are optional.
+func (u *AppService) checkSignature(settings *platform_settings_model.PlatformSettings, imageRef string) (model.AppSignature, error) {
+	if settings.ImageSigningKeys == "" && !settings.RequireImageSignatures {
+		return model.AppSignature{}, nil
+	}
+
+	keys, err := ociregistry.ParseTrustedKeys(settings.ImageSigningKeys)
+	if err != nil {
+		return model.AppSignature{}, cerr.ErrInternal.Wrap(err, "Invalid trusted image signing keys")
+	}
+
+	verification, err := u.ociClient.VerifySignature(imageRef, keys)
+	if err != nil {
+		if settings.RequireImageSignatures {
+			return model.AppSignature{}, fmt.Errorf("unable to verify signature of app image %s: %w", imageRef, err)
+		}
+		return model.AppSignature{}, nil
+	}
+
+	checkedAt := time.Now()
+	signature := model.AppSignature{
+		SignatureStatus:    model.SignatureStatusUnsigned,
+		SignatureKeyID:     verification.KeyID,
+		SignatureCheckedAt: &checkedAt,
+	}
+	switch {
+	case verification.IsVerified():
+		signature.SignatureStatus = model.SignatureStatusVerified
+	case verification.Signed:
+		signature.SignatureStatus = model.SignatureStatusUntrusted
+	}
+
+	if settings.RequireImageSignatures && signature.SignatureStatus != model.SignatureStatusVerified {
+		return signature, cerr.ErrValidation.WithMessage(fmt.Sprintf("App image %s is %s, and images must be signed by a trusted key", imageRef, signature.SignatureStatus))
+	}
+
+	return signature, nil
+}
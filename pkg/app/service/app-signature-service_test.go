@@ -0,0 +1,121 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/ociregistry"
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/app/model"
+	platform_settings_model "github.com/CHORUS-TRE/chorus-backend/pkg/platform-settings/model"
+)
+
+type signatureOCIClient struct {
+	ociregistry.OCIClienter
+	verification ociregistry.SignatureVerification
+	verified     []string
+}
+
+func (c *signatureOCIClient) VerifySignature(imageRef string, keys []ociregistry.TrustedKey) (*ociregistry.SignatureVerification, error) {
+	c.verified = append(c.verified, imageRef)
+	verification := c.verification
+	return &verification, nil
+}
+
+type signatureStore struct {
+	AppStore
+	recorded map[uint64]model.AppSignature
+}
+
+func (s *signatureStore) UpdateAppSignature(_ context.Context, _, appID uint64, signature model.AppSignature) error {
+	s.recorded[appID] = signature
+	return nil
+}
+
+type settingsReader struct {
+	settings platform_settings_model.PlatformSettings
+}
+
+func (r *settingsReader) GetPlatformSettings(_ context.Context, _ uint64) (*platform_settings_model.PlatformSettings, error) {
+	settings := r.settings
+	return &settings, nil
+}
+
+func trustedKeyPEM(t *testing.T) string {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func newSignatureSvc(settings platform_settings_model.PlatformSettings, verification ociregistry.SignatureVerification) (*AppService, *signatureOCIClient, *signatureStore) {
+	client := &signatureOCIClient{verification: verification}
+	store := &signatureStore{recorded: map[uint64]model.AppSignature{}}
+	return &AppService{
+		store:            store,
+		ociClient:        client,
+		platformSettings: &settingsReader{settings: settings},
+	}, client, store
+}
+
+func TestVerifyAppSignatures_NoPolicy(t *testing.T) {
+	svc, client, _ := newSignatureSvc(platform_settings_model.PlatformSettings{}, ociregistry.SignatureVerification{})
+
+	app := &model.App{TenantID: 1, DockerImageRegistry: "harbor.local", DockerImageName: "apps/jupyter", DockerImageTag: "1.0"}
+	require.NoError(t, svc.verifyAppSignatures(context.Background(), []*model.App{app}))
+	assert.Empty(t, client.verified, "nothing is checked without trusted keys")
+	assert.Equal(t, model.SignatureStatusUnchecked, app.SignatureStatus)
+}
+
+func TestVerifyAppSignatures_RecordsResult(t *testing.T) {
+	settings := platform_settings_model.PlatformSettings{ImageSigningKeys: trustedKeyPEM(t)}
+	svc, client, _ := newSignatureSvc(settings, ociregistry.SignatureVerification{Signed: true, KeyID: "sha256:abc"})
+
+	app := &model.App{TenantID: 1, DockerImageRegistry: "harbor.local", DockerImageName: "apps/jupyter", DockerImageTag: "1.0"}
+	require.NoError(t, svc.verifyAppSignatures(context.Background(), []*model.App{app}))
+	assert.Equal(t, []string{"harbor.local/apps/jupyter:1.0"}, client.verified)
+	assert.Equal(t, model.SignatureStatusVerified, app.SignatureStatus)
+	assert.Equal(t, "sha256:abc", app.SignatureKeyID)
+	assert.NotNil(t, app.SignatureCheckedAt)
+
+	// Unsigned images are accepted, and flagged, when signatures are optional.
+	client.verification = ociregistry.SignatureVerification{}
+	require.NoError(t, svc.verifyAppSignatures(context.Background(), []*model.App{app}))
+	assert.Equal(t, model.SignatureStatusUnsigned, app.SignatureStatus)
+}
+
+func TestVerifyAppSignatures_Required(t *testing.T) {
+	settings := platform_settings_model.PlatformSettings{ImageSigningKeys: trustedKeyPEM(t), RequireImageSignatures: true}
+	svc, _, _ := newSignatureSvc(settings, ociregistry.SignatureVerification{Signed: true})
+
+	signed := &model.App{TenantID: 1, DockerImageRegistry: "harbor.local", DockerImageName: "apps/jupyter", DockerImageTag: "1.0"}
+	err := svc.verifyAppSignatures(context.Background(), []*model.App{signed})
+
+	var cErr *cerr.ChorusError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, cerr.ErrValidation.ChorusCode, cErr.ChorusCode)
+	assert.Contains(t, err.Error(), "untrusted")
+}
+
+func TestVerifyAppSignature_RecordsRefusal(t *testing.T) {
+	settings := platform_settings_model.PlatformSettings{RequireImageSignatures: true}
+	svc, client, store := newSignatureSvc(settings, ociregistry.SignatureVerification{})
+
+	_, err := svc.VerifyAppSignature(context.Background(), 1, 9, "harbor.local/apps/jupyter@sha256:abc")
+	assert.Error(t, err)
+	assert.Equal(t, []string{"harbor.local/apps/jupyter@sha256:abc"}, client.verified)
+	require.Contains(t, store.recorded, uint64(9))
+	assert.Equal(t, model.SignatureStatusUnsigned, store.recorded[9].SignatureStatus)
+}
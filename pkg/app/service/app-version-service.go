@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/app/model"
+)
+
+func (u *AppService) ListAppVersions(ctx context.Context, tenantID, appID uint64) ([]*model.AppVersion, error) {
+	app, err := u.GetApp(ctx, tenantID, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	return app.Versions, nil
+}
+
+// CreateAppVersion adds a version to an app on behalf of a user. When the tenant requires
+// apps to be reviewed, the app goes back to the submitted stage until its new image is
+// reviewed.
+func (u *AppService) CreateAppVersion(ctx context.Context, version *model.AppVersion, userID uint64) (*model.AppVersion, error) {
+	app, err := u.store.GetApp(ctx, version.TenantID, version.AppID)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to get app %v", version.AppID))
+	}
+
+	imageRef := appVersionImageToString(app, version)
+
+	exists, err := u.ociClient.ImageExists(imageRef)
+	if err != nil {
+		return nil, cerr.ErrInternal.Wrap(err, fmt.Sprintf("Unable to verify app image existence %s", imageRef))
+	}
+	if !exists {
+		return nil, cerr.ErrValidation.WithMessage(fmt.Sprintf("App image %s does not exist", imageRef))
+	}
+
+	resubmission, err := u.appResubmission(ctx, app, userID, fmt.Sprintf("Version %s added", version.Tag))
+	if err != nil {
+		return nil, err
+	}
+
+	newVersion, err := u.store.CreateAppVersion(ctx, version.TenantID, version, resubmission)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to create version %q of app %v", version.Tag, version.AppID))
+	}
+
+	go func() {
+		u.k8sClient.PrePullImageOnAllNodes(imageRef)
+	}()
+
+	return newVersion, nil
+}
+
+// UpdateAppVersion updates the release notes of a version, deprecates it or makes it the
+// default version of its app. The default version of an app cannot be deprecated.
+func (u *AppService) UpdateAppVersion(ctx context.Context, version *model.AppVersion) (*model.AppVersion, error) {
+	current, err := u.store.GetAppVersion(ctx, version.TenantID, version.ID)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to get app version %v", version.ID))
+	}
+	if current.AppID != version.AppID {
+		return nil, cerr.ErrNotFound.WithMessage(fmt.Sprintf("App %v has no version %v", version.AppID, version.ID))
+	}
+
+	if version.IsDeprecated() && (version.IsDefault || current.IsDefault) {
+		return nil, cerr.ErrValidation.WithMessage("The default version of an app cannot be deprecated, make another version the default first")
+	}
+
+	updatedVersion, err := u.store.UpdateAppVersion(ctx, version.TenantID, version)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to update app version %v", version.ID))
+	}
+
+	return updatedVersion, nil
+}
+
+// loadAppVersions fills the versions of the apps, with the verdict of the vulnerability
+// policy of the tenant for each of them.
+func (u *AppService) loadAppVersions(ctx context.Context, tenantID uint64, apps []*model.App) error {
+	if len(apps) == 0 {
+		return nil
+	}
+
+	appIDs := make([]uint64, 0, len(apps))
+	byID := make(map[uint64]*model.App, len(apps))
+	for _, app := range apps {
+		appIDs = append(appIDs, app.ID)
+		byID[app.ID] = app
+	}
+
+	versions, err := u.store.ListAppVersions(ctx, tenantID, appIDs)
+	if err != nil {
+		return fmt.Errorf("unable to list app versions: %w", err)
+	}
+
+	settings, err := u.platformSettings.GetPlatformSettings(ctx, tenantID)
+	if err != nil {
+		return fmt.Errorf("unable to get platform settings: %w", err)
+	}
+	policy := settings.VulnerabilityPolicy()
+
+	for _, v := range versions {
+		v.VulnerabilityVerdict = policy.Evaluate(v.VulnerabilityScan)
+		if app, ok := byID[v.AppID]; ok {
+			app.Versions = append(app.Versions, v)
+		}
+	}
+
+	return nil
+}
+
+// checkAppVersionImages checks that the images of the versions of a new app exist. An app
+// created with versions gets the tag of its default version, or of its first version.
+func (u *AppService) checkAppVersionImages(app *model.App) error {
+	if len(app.Versions) == 0 {
+		app.Versions = []*model.AppVersion{{Tag: app.DockerImageTag}}
+	}
+
+	defaultVersion := app.Versions[0]
+	for _, v := range app.Versions {
+		if v.Tag == "" {
+			return cerr.ErrValidation.WithMessage(fmt.Sprintf("A version of app %q has no tag", app.Name))
+		}
+		if v.IsDefault || (!defaultVersion.IsDefault && v.Tag == app.DockerImageTag) {
+			defaultVersion = v
+		}
+
+		imageRef := appVersionImageToString(app, v)
+
+		exists, err := u.ociClient.ImageExists(imageRef)
+		if err != nil {
+			return cerr.ErrInternal.Wrap(err, fmt.Sprintf("Unable to verify app image existence %s", imageRef))
+		}
+		if !exists {
+			return cerr.ErrValidation.WithMessage(fmt.Sprintf("App image %s does not exist", imageRef))
+		}
+	}
+	app.DockerImageTag = defaultVersion.Tag
+
+	return nil
+}
+
+// appVersionImageToString constructs the full Docker image name of a version, pinned to
+// its digest when it has one.
+func appVersionImageToString(app *model.App, version *model.AppVersion) string {
+	if version.Digest != "" {
+		return app.DockerImageRegistry + "/" + app.DockerImageName + "@" + version.Digest
+	}
+	return app.DockerImageRegistry + "/" + app.DockerImageName + ":" + version.Tag
+}
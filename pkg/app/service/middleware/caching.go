@@ -66,8 +66,8 @@ func (c *Caching) DeleteApp(ctx context.Context, tenantID, appID uint64) error {
 	return c.next.DeleteApp(ctx, tenantID, appID)
 }
 
-func (c *Caching) UpdateApp(ctx context.Context, app *model.App) (*model.App, error) {
-	return c.next.UpdateApp(ctx, app)
+func (c *Caching) UpdateApp(ctx context.Context, app *model.App, userID uint64) (*model.App, error) {
+	return c.next.UpdateApp(ctx, app, userID)
 }
 
 func (c *Caching) CreateApp(ctx context.Context, app *model.App) (*model.App, error) {
@@ -77,3 +77,55 @@ func (c *Caching) CreateApp(ctx context.Context, app *model.App) (*model.App, er
 func (c *Caching) BulkCreateApps(ctx context.Context, apps []*model.App) ([]*model.App, error) {
 	return c.next.BulkCreateApps(ctx, apps)
 }
+
+func (c *Caching) ListWorkspaceApps(ctx context.Context, tenantID, workspaceID uint64) ([]*model.App, error) {
+	return c.next.ListWorkspaceApps(ctx, tenantID, workspaceID)
+}
+
+func (c *Caching) CreateWorkspaceApp(ctx context.Context, app *model.App) (*model.App, error) {
+	return c.next.CreateWorkspaceApp(ctx, app)
+}
+
+func (c *Caching) ApproveWorkspaceApp(ctx context.Context, tenantID, appID uint64) error {
+	return c.next.ApproveWorkspaceApp(ctx, tenantID, appID)
+}
+
+func (c *Caching) DeleteWorkspaceApp(ctx context.Context, tenantID, workspaceID, appID uint64) error {
+	return c.next.DeleteWorkspaceApp(ctx, tenantID, workspaceID, appID)
+}
+
+func (c *Caching) ListAppVersions(ctx context.Context, tenantID, appID uint64) ([]*model.AppVersion, error) {
+	return c.next.ListAppVersions(ctx, tenantID, appID)
+}
+
+func (c *Caching) CreateAppVersion(ctx context.Context, version *model.AppVersion, userID uint64) (*model.AppVersion, error) {
+	return c.next.CreateAppVersion(ctx, version, userID)
+}
+
+func (c *Caching) UpdateAppVersion(ctx context.Context, version *model.AppVersion) (*model.AppVersion, error) {
+	return c.next.UpdateAppVersion(ctx, version)
+}
+
+func (c *Caching) VerifyAppSignature(ctx context.Context, tenantID, appID uint64, imageRef string) (*model.AppSignature, error) {
+	return c.next.VerifyAppSignature(ctx, tenantID, appID, imageRef)
+}
+
+func (c *Caching) GetAppReview(ctx context.Context, tenantID, appID uint64) (*model.AppReview, error) {
+	return c.next.GetAppReview(ctx, tenantID, appID)
+}
+
+func (c *Caching) StartAppReview(ctx context.Context, tenantID, appID, userID uint64, reviewerIDs []uint64, comment string) (*model.AppReview, error) {
+	return c.next.StartAppReview(ctx, tenantID, appID, userID, reviewerIDs, comment)
+}
+
+func (c *Caching) DecideAppReview(ctx context.Context, tenantID, appID, userID uint64, approve bool, comment string) (*model.AppReview, error) {
+	return c.next.DecideAppReview(ctx, tenantID, appID, userID, approve, comment)
+}
+
+func (c *Caching) CommentAppReview(ctx context.Context, tenantID, appID, userID uint64, comment string) (*model.AppReview, error) {
+	return c.next.CommentAppReview(ctx, tenantID, appID, userID, comment)
+}
+
+func (c *Caching) UpdateAppLifecycle(ctx context.Context, tenantID, appID, userID uint64, status model.AppLifecycleStatus, replacementAppID *uint64, comment string) (*model.AppReview, error) {
+	return c.next.UpdateAppLifecycle(ctx, tenantID, appID, userID, status, replacementAppID, comment)
+}
@@ -86,10 +86,10 @@ func (c appServiceLogging) DeleteApp(ctx context.Context, tenantID, appID uint64
 	return nil
 }
 
-func (c appServiceLogging) UpdateApp(ctx context.Context, app *model.App) (*model.App, error) {
+func (c appServiceLogging) UpdateApp(ctx context.Context, app *model.App, userID uint64) (*model.App, error) {
 	now := time.Now()
 
-	updatedApp, err := c.next.UpdateApp(ctx, app)
+	updatedApp, err := c.next.UpdateApp(ctx, app, userID)
 	if err != nil {
 		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
 			logger.WithAppIDField(app.ID),
@@ -143,3 +143,293 @@ func (c appServiceLogging) BulkCreateApps(ctx context.Context, apps []*model.App
 	)
 	return newApps, nil
 }
+
+func (c appServiceLogging) ListWorkspaceApps(ctx context.Context, tenantID, workspaceID uint64) ([]*model.App, error) {
+	now := time.Now()
+
+	res, err := c.next.ListWorkspaceApps(ctx, tenantID, workspaceID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkspaceIDField(workspaceID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, fmt.Errorf("unable to get workspace apps: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWorkspaceIDField(workspaceID),
+		zap.Int("num_apps", len(res)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c appServiceLogging) CreateWorkspaceApp(ctx context.Context, app *model.App) (*model.App, error) {
+	now := time.Now()
+
+	newApp, err := c.next.CreateWorkspaceApp(ctx, app)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, fmt.Errorf("unable to create workspace app: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithAppIDField(newApp.ID),
+		logger.WithWorkspaceIDField(*newApp.WorkspaceID),
+		zap.String("status", newApp.Status.String()),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return newApp, nil
+}
+
+func (c appServiceLogging) ApproveWorkspaceApp(ctx context.Context, tenantID, appID uint64) error {
+	now := time.Now()
+
+	err := c.next.ApproveWorkspaceApp(ctx, tenantID, appID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithAppIDField(appID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return fmt.Errorf("unable to approve workspace app: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithAppIDField(appID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c appServiceLogging) DeleteWorkspaceApp(ctx context.Context, tenantID, workspaceID, appID uint64) error {
+	now := time.Now()
+
+	err := c.next.DeleteWorkspaceApp(ctx, tenantID, workspaceID, appID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithAppIDField(appID),
+			logger.WithWorkspaceIDField(workspaceID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return fmt.Errorf("unable to delete workspace app: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithAppIDField(appID),
+		logger.WithWorkspaceIDField(workspaceID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c appServiceLogging) ListAppVersions(ctx context.Context, tenantID, appID uint64) ([]*model.AppVersion, error) {
+	now := time.Now()
+
+	res, err := c.next.ListAppVersions(ctx, tenantID, appID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithAppIDField(appID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, fmt.Errorf("unable to get app versions: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithAppIDField(appID),
+		zap.Int("num_versions", len(res)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c appServiceLogging) CreateAppVersion(ctx context.Context, version *model.AppVersion, userID uint64) (*model.AppVersion, error) {
+	now := time.Now()
+
+	newVersion, err := c.next.CreateAppVersion(ctx, version, userID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithAppIDField(version.AppID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, fmt.Errorf("unable to create app version: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithAppIDField(version.AppID),
+		zap.Uint64("app_version_id", newVersion.ID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return newVersion, nil
+}
+
+func (c appServiceLogging) UpdateAppVersion(ctx context.Context, version *model.AppVersion) (*model.AppVersion, error) {
+	now := time.Now()
+
+	updatedVersion, err := c.next.UpdateAppVersion(ctx, version)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithAppIDField(version.AppID),
+			zap.Uint64("app_version_id", version.ID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, fmt.Errorf("unable to update app version: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithAppIDField(version.AppID),
+		zap.Uint64("app_version_id", version.ID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return updatedVersion, nil
+}
+
+func (c appServiceLogging) VerifyAppSignature(ctx context.Context, tenantID, appID uint64, imageRef string) (*model.AppSignature, error) {
+	now := time.Now()
+
+	signature, err := c.next.VerifyAppSignature(ctx, tenantID, appID, imageRef)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithAppIDField(appID),
+			zap.String("image_ref", imageRef),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, fmt.Errorf("unable to verify app signature: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithAppIDField(appID),
+		zap.String("image_ref", imageRef),
+		zap.String("signature_status", signature.SignatureStatus.String()),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return signature, nil
+}
+
+func (c appServiceLogging) GetAppReview(ctx context.Context, tenantID, appID uint64) (*model.AppReview, error) {
+	now := time.Now()
+
+	review, err := c.next.GetAppReview(ctx, tenantID, appID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithAppIDField(appID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, fmt.Errorf("unable to get app review: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithAppIDField(appID),
+		zap.String("lifecycle_status", review.LifecycleStatus.String()),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return review, nil
+}
+
+func (c appServiceLogging) StartAppReview(ctx context.Context, tenantID, appID, userID uint64, reviewerIDs []uint64, comment string) (*model.AppReview, error) {
+	now := time.Now()
+
+	review, err := c.next.StartAppReview(ctx, tenantID, appID, userID, reviewerIDs, comment)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithAppIDField(appID),
+			logger.WithUserIDField(userID),
+			zap.Uint64s("reviewer_ids", reviewerIDs),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, fmt.Errorf("unable to start app review: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithAppIDField(appID),
+		logger.WithUserIDField(userID),
+		zap.Uint64s("reviewer_ids", reviewerIDs),
+		zap.String("lifecycle_status", review.LifecycleStatus.String()),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return review, nil
+}
+
+func (c appServiceLogging) DecideAppReview(ctx context.Context, tenantID, appID, userID uint64, approve bool, comment string) (*model.AppReview, error) {
+	now := time.Now()
+
+	review, err := c.next.DecideAppReview(ctx, tenantID, appID, userID, approve, comment)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithAppIDField(appID),
+			logger.WithUserIDField(userID),
+			zap.Bool("approve", approve),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, fmt.Errorf("unable to decide app review: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithAppIDField(appID),
+		logger.WithUserIDField(userID),
+		zap.Bool("approve", approve),
+		zap.String("lifecycle_status", review.LifecycleStatus.String()),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return review, nil
+}
+
+func (c appServiceLogging) CommentAppReview(ctx context.Context, tenantID, appID, userID uint64, comment string) (*model.AppReview, error) {
+	now := time.Now()
+
+	review, err := c.next.CommentAppReview(ctx, tenantID, appID, userID, comment)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithAppIDField(appID),
+			logger.WithUserIDField(userID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, fmt.Errorf("unable to comment app review: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithAppIDField(appID),
+		logger.WithUserIDField(userID),
+		zap.String("lifecycle_status", review.LifecycleStatus.String()),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return review, nil
+}
+
+func (c appServiceLogging) UpdateAppLifecycle(ctx context.Context, tenantID, appID, userID uint64, status model.AppLifecycleStatus, replacementAppID *uint64, comment string) (*model.AppReview, error) {
+	now := time.Now()
+
+	review, err := c.next.UpdateAppLifecycle(ctx, tenantID, appID, userID, status, replacementAppID, comment)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithAppIDField(appID),
+			logger.WithUserIDField(userID),
+			zap.String("to_status", status.String()),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, fmt.Errorf("unable to update app lifecycle: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithAppIDField(appID),
+		logger.WithUserIDField(userID),
+		zap.String("to_status", status.String()),
+		zap.String("lifecycle_status", review.LifecycleStatus.String()),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return review, nil
+}
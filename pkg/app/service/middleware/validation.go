@@ -2,7 +2,10 @@ package middleware
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/app/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/app/service"
 	common "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
@@ -39,11 +42,11 @@ func (v validation) DeleteApp(ctx context.Context, tenantID, appID uint64) error
 	return v.next.DeleteApp(ctx, tenantID, appID)
 }
 
-func (v validation) UpdateApp(ctx context.Context, app *model.App) (*model.App, error) {
+func (v validation) UpdateApp(ctx context.Context, app *model.App, userID uint64) (*model.App, error) {
 	if err := v.validate.Struct(app); err != nil {
 		return nil, err
 	}
-	return v.next.UpdateApp(ctx, app)
+	return v.next.UpdateApp(ctx, app, userID)
 }
 
 func (v validation) CreateApp(ctx context.Context, app *model.App) (*model.App, error) {
@@ -61,3 +64,76 @@ func (v validation) BulkCreateApps(ctx context.Context, apps []*model.App) ([]*m
 	}
 	return v.next.BulkCreateApps(ctx, apps)
 }
+
+func (v validation) ListWorkspaceApps(ctx context.Context, tenantID, workspaceID uint64) ([]*model.App, error) {
+	return v.next.ListWorkspaceApps(ctx, tenantID, workspaceID)
+}
+
+func (v validation) CreateWorkspaceApp(ctx context.Context, app *model.App) (*model.App, error) {
+	if err := v.validate.Struct(app); err != nil {
+		return nil, err
+	}
+	if app.WorkspaceID == nil || *app.WorkspaceID == 0 {
+		return nil, cerr.ErrValidation.WithMessage("A custom app requires a workspace")
+	}
+	return v.next.CreateWorkspaceApp(ctx, app)
+}
+
+func (v validation) ApproveWorkspaceApp(ctx context.Context, tenantID, appID uint64) error {
+	return v.next.ApproveWorkspaceApp(ctx, tenantID, appID)
+}
+
+func (v validation) DeleteWorkspaceApp(ctx context.Context, tenantID, workspaceID, appID uint64) error {
+	return v.next.DeleteWorkspaceApp(ctx, tenantID, workspaceID, appID)
+}
+
+func (v validation) ListAppVersions(ctx context.Context, tenantID, appID uint64) ([]*model.AppVersion, error) {
+	return v.next.ListAppVersions(ctx, tenantID, appID)
+}
+
+func (v validation) CreateAppVersion(ctx context.Context, version *model.AppVersion, userID uint64) (*model.AppVersion, error) {
+	if version.Tag == "" {
+		return nil, cerr.ErrValidation.WithMessage("A version requires a tag")
+	}
+	if version.Digest != "" && !strings.Contains(version.Digest, ":") {
+		return nil, cerr.ErrValidation.WithMessage(fmt.Sprintf("Invalid digest %q, expected <algorithm>:<hex>", version.Digest))
+	}
+	return v.next.CreateAppVersion(ctx, version, userID)
+}
+
+func (v validation) UpdateAppVersion(ctx context.Context, version *model.AppVersion) (*model.AppVersion, error) {
+	return v.next.UpdateAppVersion(ctx, version)
+}
+
+func (v validation) VerifyAppSignature(ctx context.Context, tenantID, appID uint64, imageRef string) (*model.AppSignature, error) {
+	return v.next.VerifyAppSignature(ctx, tenantID, appID, imageRef)
+}
+
+func (v validation) GetAppReview(ctx context.Context, tenantID, appID uint64) (*model.AppReview, error) {
+	return v.next.GetAppReview(ctx, tenantID, appID)
+}
+
+func (v validation) StartAppReview(ctx context.Context, tenantID, appID, userID uint64, reviewerIDs []uint64, comment string) (*model.AppReview, error) {
+	if len(reviewerIDs) == 0 {
+		return nil, cerr.ErrValidation.WithMessage("A review needs at least one reviewer")
+	}
+	return v.next.StartAppReview(ctx, tenantID, appID, userID, reviewerIDs, comment)
+}
+
+func (v validation) DecideAppReview(ctx context.Context, tenantID, appID, userID uint64, approve bool, comment string) (*model.AppReview, error) {
+	return v.next.DecideAppReview(ctx, tenantID, appID, userID, approve, comment)
+}
+
+func (v validation) CommentAppReview(ctx context.Context, tenantID, appID, userID uint64, comment string) (*model.AppReview, error) {
+	if strings.TrimSpace(comment) == "" {
+		return nil, cerr.ErrValidation.WithMessage("A comment cannot be empty")
+	}
+	return v.next.CommentAppReview(ctx, tenantID, appID, userID, comment)
+}
+
+func (v validation) UpdateAppLifecycle(ctx context.Context, tenantID, appID, userID uint64, status model.AppLifecycleStatus, replacementAppID *uint64, comment string) (*model.AppReview, error) {
+	if _, err := model.ToAppLifecycleStatus(status.String()); err != nil {
+		return nil, cerr.ErrValidation.Wrap(err, fmt.Sprintf("Invalid lifecycle status %q", status))
+	}
+	return v.next.UpdateAppLifecycle(ctx, tenantID, appID, userID, status, replacementAppID, comment)
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/harbor"
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/app/model"
+
+	"go.uber.org/zap"
+)
+
+// scanStatusSuccess is the status of a complete scan report.
+const scanStatusSuccess = "Success"
+
+// AppVulnerabilityScanJob pulls the scan summaries of the images of the app versions from
+// Harbor and stores their severity counts. Only the apps pulled from Harbor are scanned.
+type AppVulnerabilityScanJob struct {
+	appStore     AppStore
+	harborClient harbor.HarborClient
+	registry     string
+	log          *logger.ContextLogger
+}
+
+func NewAppVulnerabilityScanJob(appStore AppStore, harborClient harbor.HarborClient, registry string, log *logger.ContextLogger) *AppVulnerabilityScanJob {
+	return &AppVulnerabilityScanJob{
+		appStore:     appStore,
+		harborClient: harborClient,
+		registry:     registry,
+		log:          log,
+	}
+}
+
+func (j *AppVulnerabilityScanJob) Do(ctx context.Context, options map[string]interface{}) (string, error) {
+	tenantID, err := uint64Option(options, "tenant_id", 1)
+	if err != nil {
+		return "", err
+	}
+
+	apps, _, err := j.appStore.ListApps(ctx, tenantID, nil)
+	if err != nil {
+		return "", fmt.Errorf("listing apps: %w", err)
+	}
+
+	byID := make(map[uint64]*model.App, len(apps))
+	appIDs := make([]uint64, 0, len(apps))
+	for _, a := range apps {
+		if a.DockerImageRegistry != j.registry {
+			continue
+		}
+		byID[a.ID] = a
+		appIDs = append(appIDs, a.ID)
+	}
+	if len(appIDs) == 0 {
+		return "no app to scan", nil
+	}
+
+	versions, err := j.appStore.ListAppVersions(ctx, tenantID, appIDs)
+	if err != nil {
+		return "", fmt.Errorf("listing app versions: %w", err)
+	}
+
+	// A version whose report cannot be pulled does not prevent the others from being
+	// updated.
+	updated := 0
+	var errs []error
+	for _, v := range versions {
+		app := byID[v.AppID]
+		reference := v.Digest
+		if reference == "" {
+			reference = v.Tag
+		}
+
+		overview, err := j.harborClient.GetScanOverview(app.DockerImageName, reference)
+		if err != nil {
+			j.log.Error(ctx, "unable to get scan overview", zap.String("image", app.DockerImageName+":"+v.Tag), zap.Error(err))
+			errs = append(errs, fmt.Errorf("getting scan overview of %s:%s: %w", app.DockerImageName, v.Tag, err))
+			continue
+		}
+		if overview == nil {
+			continue
+		}
+
+		if err := j.appStore.UpdateAppVersionScan(ctx, tenantID, v.ID, scanFromOverview(v.VulnerabilityScan, overview)); err != nil {
+			errs = append(errs, fmt.Errorf("updating scan of version %v: %w", v.ID, err))
+			continue
+		}
+		updated++
+	}
+
+	j.log.Info(ctx, "pulled app vulnerability scans", zap.Int("versions", len(versions)), zap.Int("updated", updated))
+
+	return fmt.Sprintf("updated the scans of %d of %d app versions", updated, len(versions)), errors.Join(errs...)
+}
+
+// scanFromOverview returns the scan of a version from its scan overview. The counts of
+// the previous scan are kept until a new scan completes.
+func scanFromOverview(previous model.VulnerabilityScan, overview *harbor.ScanOverview) model.VulnerabilityScan {
+	scan := previous
+	scan.ScanStatus = overview.Status
+	if overview.Status != scanStatusSuccess {
+		return scan
+	}
+
+	scan = model.VulnerabilityScan{ScanStatus: overview.Status}
+	for severity, count := range overview.Summary {
+		switch strings.ToLower(severity) {
+		case model.SeverityCritical.String():
+			scan.ScanCritical = count
+		case model.SeverityHigh.String():
+			scan.ScanHigh = count
+		case model.SeverityMedium.String():
+			scan.ScanMedium = count
+		case model.SeverityLow.String():
+			scan.ScanLow = count
+		default:
+			scan.ScanUnknown += count
+		}
+	}
+	scannedAt := overview.ScannedAt
+	scan.ScannedAt = &scannedAt
+	return scan
+}
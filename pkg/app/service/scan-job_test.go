@@ -0,0 +1,56 @@
+//go:build unit
+
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/harbor"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/app/model"
+)
+
+func TestScanFromOverview(t *testing.T) {
+	scannedAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	scan := scanFromOverview(model.VulnerabilityScan{}, &harbor.ScanOverview{
+		Status:    "Success",
+		Summary:   map[string]int{"Critical": 1, "High": 2, "Medium": 3, "Low": 4, "Unknown": 5, "None": 1},
+		ScannedAt: scannedAt,
+	})
+
+	assert.Equal(t, "Success", scan.ScanStatus)
+	assert.Equal(t, 1, scan.ScanCritical)
+	assert.Equal(t, 2, scan.ScanHigh)
+	assert.Equal(t, 3, scan.ScanMedium)
+	assert.Equal(t, 4, scan.ScanLow)
+	assert.Equal(t, 6, scan.ScanUnknown)
+	require.NotNil(t, scan.ScannedAt)
+	assert.Equal(t, scannedAt, *scan.ScannedAt)
+
+	// A scan in progress keeps the counts of the previous one.
+	running := scanFromOverview(scan, &harbor.ScanOverview{Status: "Running"})
+	assert.Equal(t, "Running", running.ScanStatus)
+	assert.Equal(t, 1, running.ScanCritical)
+	assert.Equal(t, scan.ScannedAt, running.ScannedAt)
+}
+
+func TestVulnerabilityPolicy_Evaluate(t *testing.T) {
+	scannedAt := time.Now()
+	clean := model.VulnerabilityScan{ScanLow: 3, ScannedAt: &scannedAt}
+	high := model.VulnerabilityScan{ScanHigh: 1, ScanLow: 3, ScannedAt: &scannedAt}
+	critical := model.VulnerabilityScan{ScanCritical: 1, ScannedAt: &scannedAt}
+	policy := model.VulnerabilityPolicy{BlockSeverity: model.SeverityCritical, WarnSeverity: model.SeverityHigh}
+
+	assert.Equal(t, model.VulnerabilityVerdictNone, model.VulnerabilityPolicy{}.Evaluate(critical))
+	assert.Equal(t, model.VulnerabilityVerdictPass, policy.Evaluate(clean))
+	assert.Equal(t, model.VulnerabilityVerdictWarn, policy.Evaluate(high))
+	assert.Equal(t, model.VulnerabilityVerdictBlock, policy.Evaluate(critical))
+	assert.Equal(t, model.VulnerabilityVerdictWarn, policy.Evaluate(model.VulnerabilityScan{}), "versions never scanned are flagged")
+
+	// Thresholds include the higher severities.
+	assert.Equal(t, 4, high.CountAtLeast(model.SeverityLow))
+	assert.Equal(t, model.VulnerabilityVerdictBlock, model.VulnerabilityPolicy{BlockSeverity: model.SeverityHigh}.Evaluate(critical))
+}
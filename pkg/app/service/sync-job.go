@@ -2,12 +2,15 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
-	"github.com/CHORUS-TRE/chorus-backend/internal/client/harbor"
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/catalog"
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
 	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/app/model"
 
@@ -52,23 +55,31 @@ const (
 )
 
 type AppSyncJob struct {
-	appStore     AppStore
-	appService   Apper
-	harborClient harbor.HarborClient
-	registry     string
-	log          *logger.ContextLogger
+	appStore   AppStore
+	appService Apper
+	sources    []catalog.Source
+	// defaultImagePrefix is where the images of apps without a registry are pulled
+	// from, e.g. "harbor.example.com/apps".
+	defaultImagePrefix string
+	log                *logger.ContextLogger
 }
 
-func NewAppSyncJob(appStore AppStore, appService Apper, harborClient harbor.HarborClient, registry string, log *logger.ContextLogger) *AppSyncJob {
+func NewAppSyncJob(appStore AppStore, appService Apper, sources []catalog.Source, defaultImagePrefix string, log *logger.ContextLogger) *AppSyncJob {
 	return &AppSyncJob{
-		appStore:     appStore,
-		appService:   appService,
-		harborClient: harborClient,
-		registry:     registry,
-		log:          log,
+		appStore:           appStore,
+		appService:         appService,
+		sources:            sources,
+		defaultImagePrefix: defaultImagePrefix,
+		log:                log,
 	}
 }
 
+// sourceImage is an image of a catalog source, pulled from the registry of the source.
+type sourceImage struct {
+	catalog.Image
+	registry string
+}
+
 func (j *AppSyncJob) Do(ctx context.Context, options map[string]interface{}) (string, error) {
 	tenantID, err := uint64Option(options, "tenant_id", 1)
 	if err != nil {
@@ -79,66 +90,150 @@ func (j *AppSyncJob) Do(ctx context.Context, options map[string]interface{}) (st
 		return "", err
 	}
 
-	// List existing apps in store
+	// List existing apps in store, with their versions
 	existingApps, _, err := j.appStore.ListApps(ctx, tenantID, nil)
 	if err != nil {
 		return "", fmt.Errorf("listing existing apps: %w", err)
 	}
 
-	existing := make(map[string]struct{}, len(existingApps))
+	appIDs := make([]uint64, 0, len(existingApps))
+	byID := make(map[uint64]*model.App, len(existingApps))
 	for _, a := range existingApps {
-		existing[appIdentity(a)] = struct{}{}
+		appIDs = append(appIDs, a.ID)
+		byID[a.ID] = a
+	}
+	versions, err := j.appStore.ListAppVersions(ctx, tenantID, appIDs)
+	if err != nil {
+		return "", fmt.Errorf("listing existing app versions: %w", err)
+	}
+	for _, v := range versions {
+		if a, ok := byID[v.AppID]; ok {
+			a.Versions = append(a.Versions, v)
+		}
+	}
+
+	// Apps created before versions existed have a row per tag; new versions go to the
+	// most recent one.
+	existing := make(map[string]*model.App, len(existingApps))
+	for _, a := range existingApps {
+		id := appIdentity(a, j.defaultImagePrefix)
+		if e, ok := existing[id]; !ok || a.ID > e.ID {
+			existing[id] = a
+		}
 	}
 	logger.TechLog.Debug(ctx, fmt.Sprintf("app_sync: listed %d existing apps", len(existingApps)))
 
-	// List apps and versions in Harbor
-	harborApps, err := j.harborClient.ListApps()
-	if err != nil {
-		return "", fmt.Errorf("listing apps from harbor: %w", err)
+	// List apps and versions in the catalog sources. A source that cannot be reached
+	// does not prevent the others from being synced.
+	var images []sourceImage
+	var errs []error
+	for _, source := range j.sources {
+		sourceImages, err := source.ListImages()
+		if err != nil {
+			j.log.Error(ctx, "unable to list apps from catalog source", zap.String("source", source.Name()), zap.Error(err))
+			errs = append(errs, fmt.Errorf("listing apps from %s: %w", source.Name(), err))
+			continue
+		}
+		for _, image := range sourceImages {
+			images = append(images, sourceImage{Image: image, registry: source.Registry()})
+		}
+		logger.TechLog.Debug(ctx, fmt.Sprintf("app_sync: listed %d apps from %s", len(sourceImages), source.Name()))
 	}
-	logger.TechLog.Debug(ctx, fmt.Sprintf("app_sync: listed %d apps from harbor", len(harborApps)))
 
-	toCreate := j.appsToCreate(harborApps, existing, tenantID, userID)
-	if len(toCreate) == 0 {
-		return "all apps already exist", nil
+	toCreate, toAdd := appsToSync(images, existing, j.defaultImagePrefix, tenantID, userID)
+	if len(toCreate) == 0 && len(toAdd) == 0 {
+		return "all apps already exist", errors.Join(errs...)
 	}
 
-	created, err := j.appService.BulkCreateApps(ctx, toCreate)
-	if err != nil {
-		return "", fmt.Errorf("bulk creating apps: %w", err)
+	// The apps are created one by one so that an app refused by the catalog, e.g. whose
+	// image is not signed by a trusted key, is left out instead of failing the sync.
+	var created []*model.App
+	skipped := 0
+	for _, app := range toCreate {
+		newApps, err := j.appService.BulkCreateApps(ctx, []*model.App{app})
+		if err != nil {
+			var cErr *cerr.ChorusError
+			if errors.As(err, &cErr) && cErr.ChorusCode == cerr.ErrValidation.ChorusCode {
+				j.log.Warn(ctx, "skipping app refused by the catalog", zap.String("app", app.Name), zap.String("image", dockerImageToString(app)), zap.Error(err))
+				skipped++
+				continue
+			}
+			return "", fmt.Errorf("creating app %q: %w", app.Name, err)
+		}
+		created = append(created, newApps...)
 	}
 
-	j.log.Info(ctx, "synced apps from harbor", zap.Int("created", len(created)))
+	for _, v := range toAdd {
+		if _, err := j.appService.CreateAppVersion(ctx, v, userID); err != nil {
+			return "", fmt.Errorf("creating version %q of app %v: %w", v.Tag, v.AppID, err)
+		}
+	}
 
-	return fmt.Sprintf("created %d new apps", len(created)), nil
+	j.log.Info(ctx, "synced apps from catalog sources", zap.Int("created", len(created)), zap.Int("skipped", skipped), zap.Int("versions", len(toAdd)))
+
+	return fmt.Sprintf("created %d new apps, skipped %d and added %d new versions", len(created), skipped, len(toAdd)), errors.Join(errs...)
 }
 
-func (j *AppSyncJob) appsToCreate(harborApps []harbor.App, existing map[string]struct{}, tenantID, userID uint64) []*model.App {
-	// Sort Harbor apps by tag, lowest version first.
-	sort.SliceStable(harborApps, func(i, k int) bool {
-		return harborApps[i].Tag < harborApps[k].Tag
+// appsToSync returns the apps to create and the versions to add to existing apps. Every
+// tag of an image becomes a version of the apps it holds, and the highest tag becomes
+// their default version.
+func appsToSync(images []sourceImage, existing map[string]*model.App, defaultImagePrefix string, tenantID, userID uint64) ([]*model.App, []*model.AppVersion) {
+	// Sort images by version, lowest first.
+	sort.SliceStable(images, func(i, k int) bool {
+		return model.NewerTag(images[k].Tag, images[k].PushedAt, images[i].Tag, images[i].PushedAt)
 	})
 
+	created := make(map[string]*model.App)
 	var toCreate []*model.App
-	for _, ha := range harborApps {
-		for _, app := range j.harborAppToModels(ha, tenantID, userID) {
+	var toAdd []*model.AppVersion
+	for _, image := range images {
+		for _, app := range imageToModels(image.registry, image.Image, tenantID, userID) {
 			if app.Category == categoryChorus {
 				continue
 			}
 
-			id := appIdentity(app)
-			if _, exists := existing[id]; exists {
+			id := appIdentity(app, defaultImagePrefix)
+			version := &model.AppVersion{
+				TenantID: tenantID,
+				Tag:      image.Tag,
+				Digest:   image.Digest,
+			}
+
+			if e, ok := existing[id]; ok {
+				if e.HasVersionTag(image.Tag) {
+					continue
+				}
+				version.AppID = e.ID
+				version.IsDefault = model.NewerTag(image.Tag, image.PushedAt, e.DockerImageTag, versionSyncedAt(e, e.DockerImageTag))
+				if version.IsDefault {
+					e.DockerImageTag = image.Tag
+				}
+				e.Versions = append(e.Versions, version)
+				toAdd = append(toAdd, version)
 				continue
 			}
-			existing[id] = struct{}{}
+
+			if c, ok := created[id]; ok {
+				if c.HasVersionTag(image.Tag) {
+					continue
+				}
+				// The app takes the metadata of its most recent image.
+				app.Versions = append(c.Versions, version)
+				*c = *app
+				continue
+			}
+
+			app.Versions = []*model.AppVersion{version}
+			created[id] = app
 			toCreate = append(toCreate, app)
 		}
 	}
-	return toCreate
+	return toCreate, toAdd
 }
 
-func (j *AppSyncJob) harborAppToModels(ha harbor.App, tenantID, userID uint64) []*model.App {
-	labels := ha.Labels
+// imageToModels returns the apps an image of a registry holds, described by its labels.
+func imageToModels(registry string, image catalog.Image, tenantID, userID uint64) []*model.App {
+	labels := image.Labels
 	if _, ok := labels[labelAppName]; !ok {
 		return nil
 	}
@@ -149,9 +244,9 @@ func (j *AppSyncJob) harborAppToModels(ha harbor.App, tenantID, userID uint64) [
 		UserID:              userID,
 		Description:         labels[labelOCIDescription],
 		Status:              model.AppActive,
-		DockerImageRegistry: j.registry,
-		DockerImageName:     ha.Repository,
-		DockerImageTag:      ha.Tag,
+		DockerImageRegistry: registry,
+		DockerImageName:     image.Repository,
+		DockerImageTag:      image.Tag,
 		IconURL:             labels[labelAppIcon],
 		StabilityStatus:     model.AppStabilityStatus(labels[labelAppStability]),
 	}
@@ -228,9 +323,25 @@ func parseKioskSubApps(labels map[string]string) map[string]map[string]string {
 	return subApps
 }
 
-// appIdentity uniquely identifies an app
-func appIdentity(a *model.App) string {
-	return a.DockerImageName + "\x00" + a.DockerImageTag + "\x00" + a.Name
+// appIdentity uniquely identifies an app, whatever its version. An app without a registry
+// is identified by the image it is pulled from, under the default image prefix.
+func appIdentity(a *model.App, defaultImagePrefix string) string {
+	image := a.DockerImageRegistry + "/" + a.DockerImageName
+	if a.DockerImageRegistry == "" {
+		image = defaultImagePrefix + "/" + a.DockerImageName
+	}
+	return image + "\x00" + a.Name
+}
+
+// versionSyncedAt returns when the version of an existing app with the given tag was
+// synced, or the zero time for a version added by the current sync.
+func versionSyncedAt(a *model.App, tag string) time.Time {
+	for _, v := range a.Versions {
+		if v.Tag == tag {
+			return v.CreatedAt
+		}
+	}
+	return time.Time{}
 }
 
 func uint64Option(options map[string]interface{}, key string, def uint64) (uint64, error) {
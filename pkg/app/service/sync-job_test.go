@@ -3,19 +3,35 @@
 package service
 
 import (
+	"context"
+	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
-	"github.com/CHORUS-TRE/chorus-backend/internal/client/harbor"
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/catalog"
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/app/model"
+	common "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
 )
 
-const testRegistry = "registry.example.com"
+func TestMain(m *testing.M) {
+	logger.TechLog = logger.NewNop()
+	logger.BizLog = logger.NewNop()
+	logger.SecLog = logger.NewNop()
+	os.Exit(m.Run())
+}
+
+const (
+	testRegistry    = "registry.example.com"
+	testImagePrefix = testRegistry + "/apps"
+)
 
-func TestHarborAppToModels_SingleApp(t *testing.T) {
-	ha := harbor.App{
+func TestImageToModels_SingleApp(t *testing.T) {
+	image := catalog.Image{
 		Repository: "vscode",
 		Tag:        "1.106.1-11",
 		Labels: map[string]string{
@@ -35,8 +51,7 @@ func TestHarborAppToModels_SingleApp(t *testing.T) {
 		},
 	}
 
-	j := &AppSyncJob{registry: testRegistry}
-	apps := j.harborAppToModels(ha, 7, 9)
+	apps := imageToModels(testRegistry, image, 7, 9)
 
 	require.Len(t, apps, 1)
 	app := apps[0]
@@ -60,8 +75,8 @@ func TestHarborAppToModels_SingleApp(t *testing.T) {
 	assert.Empty(t, app.BrowserConfigURL)
 }
 
-func TestHarborAppToModels_KioskMultiApp(t *testing.T) {
-	ha := harbor.App{
+func TestImageToModels_KioskMultiApp(t *testing.T) {
+	image := catalog.Image{
 		Repository: "kiosk",
 		Tag:        "142.0.7444.175-1",
 		Labels: map[string]string{
@@ -85,8 +100,7 @@ func TestHarborAppToModels_KioskMultiApp(t *testing.T) {
 		},
 	}
 
-	j := &AppSyncJob{registry: testRegistry}
-	apps := j.harborAppToModels(ha, 1, 1)
+	apps := imageToModels(testRegistry, image, 1, 1)
 
 	require.Len(t, apps, 2)
 
@@ -115,11 +129,11 @@ func TestHarborAppToModels_KioskMultiApp(t *testing.T) {
 	}
 
 	// Sharing one image, the sub-apps must still be distinct after dedup.
-	assert.NotEqual(t, appIdentity(didata), appIdentity(gitlab))
+	assert.NotEqual(t, appIdentity(didata, testImagePrefix), appIdentity(gitlab, testImagePrefix))
 }
 
-func TestHarborAppToModels_SkipsNonApp(t *testing.T) {
-	ha := harbor.App{
+func TestImageToModels_SkipsNonApp(t *testing.T) {
+	image := catalog.Image{
 		Repository: "ubuntu",
 		Tag:        "24.04",
 		Labels: map[string]string{
@@ -127,8 +141,7 @@ func TestHarborAppToModels_SkipsNonApp(t *testing.T) {
 		},
 	}
 
-	j := &AppSyncJob{registry: testRegistry}
-	assert.Nil(t, j.harborAppToModels(ha, 1, 1))
+	assert.Nil(t, imageToModels(testRegistry, image, 1, 1))
 }
 
 func appLabels(name, category, title string) map[string]string {
@@ -139,28 +152,213 @@ func appLabels(name, category, title string) map[string]string {
 	}
 }
 
-func TestAppsToCreate(t *testing.T) {
-	j := &AppSyncJob{registry: testRegistry}
+func fromRegistry(registry string, images ...catalog.Image) []sourceImage {
+	sourceImages := make([]sourceImage, 0, len(images))
+	for _, image := range images {
+		sourceImages = append(sourceImages, sourceImage{Image: image, registry: registry})
+	}
+	return sourceImages
+}
 
+func TestAppsToSync(t *testing.T) {
 	// Deliberately out of order; Tag should drive creation order.
-	harborApps := []harbor.App{
-		{Repository: "newer", Tag: "2.0.0-1", Labels: appLabels("newer", "Development", "Newer")},
-		{Repository: "internal", Tag: "1.5.0-1", Labels: appLabels("internal", categoryChorus, "Internal")},
-		{Repository: "older", Tag: "1.0.0-1", Labels: appLabels("older", "Science", "Older")},
-		{Repository: "dup", Tag: "3.0.0-1", Labels: appLabels("dup", "Science", "Existing")},
-	}
+	images := fromRegistry(testRegistry,
+		catalog.Image{Repository: "newer", Tag: "2.0.0-1", Digest: "sha256:n2", Labels: appLabels("newer", "Development", "Newer")},
+		catalog.Image{Repository: "internal", Tag: "1.5.0-1", Labels: appLabels("internal", categoryChorus, "Internal")},
+		catalog.Image{Repository: "older", Tag: "1.0.0-1", Labels: appLabels("older", "Science", "Older")},
+		catalog.Image{Repository: "dup", Tag: "3.0.0-1", Labels: appLabels("dup", "Science", "Existing")},
+		catalog.Image{Repository: "newer", Tag: "1.0.0-1", Digest: "sha256:n1", Labels: appLabels("newer", "Science", "Newer")},
+		catalog.Image{Repository: "dup", Tag: "3.1.0-1", Digest: "sha256:d31", Labels: appLabels("dup", "Science", "Existing")},
+		catalog.Image{Repository: "dup", Tag: "2.9.0-1", Labels: appLabels("dup", "Science", "Existing")},
+	)
 
-	existing := map[string]struct{}{
-		appIdentity(&model.App{DockerImageName: "dup", DockerImageTag: "3.0.0-1", Name: "Existing"}): {},
+	existingApp := &model.App{
+		ID:                  4,
+		DockerImageRegistry: testRegistry,
+		DockerImageName:     "dup",
+		DockerImageTag:      "3.0.0-1",
+		Name:                "Existing",
+		Versions:            []*model.AppVersion{{ID: 40, AppID: 4, Tag: "3.0.0-1", IsDefault: true}},
 	}
+	existing := map[string]*model.App{appIdentity(existingApp, testImagePrefix): existingApp}
 
-	toCreate := j.appsToCreate(harborApps, existing, 1, 1)
+	toCreate, toAdd := appsToSync(images, existing, testImagePrefix, 1, 1)
 
-	// "internal" (chorus) and "dup" (already existing) are skipped; the rest are
-	// ordered by tag.
+	// "internal" (chorus) is skipped; the rest are ordered by tag, and the tags of an
+	// image become versions of a single app.
 	require.Len(t, toCreate, 2)
 	assert.Equal(t, "Older", toCreate[0].Name)
-	assert.Equal(t, "Newer", toCreate[1].Name)
+	newer := toCreate[1]
+	assert.Equal(t, "Newer", newer.Name)
+	// The app takes the tag and metadata of its most recent image.
+	assert.Equal(t, "2.0.0-1", newer.DockerImageTag)
+	assert.Equal(t, "Development", newer.Category)
+	require.Len(t, newer.Versions, 2)
+	assert.Equal(t, "1.0.0-1", newer.Versions[0].Tag)
+	assert.Equal(t, "sha256:n1", newer.Versions[0].Digest)
+	assert.Equal(t, "2.0.0-1", newer.Versions[1].Tag)
+
+	// The existing app gets its new tags as versions, and only a higher tag becomes
+	// its default version.
+	require.Len(t, toAdd, 2)
+	assert.Equal(t, "2.9.0-1", toAdd[0].Tag)
+	assert.Equal(t, uint64(4), toAdd[0].AppID)
+	assert.False(t, toAdd[0].IsDefault)
+	assert.Equal(t, "3.1.0-1", toAdd[1].Tag)
+	assert.Equal(t, "sha256:d31", toAdd[1].Digest)
+	assert.True(t, toAdd[1].IsDefault)
+
+	// Once synced, the versions are not added again.
+	_, toAdd = appsToSync(images, existing, testImagePrefix, 1, 1)
+	assert.Empty(t, toAdd)
+}
+
+func TestAppsToSync_ComparesVersions(t *testing.T) {
+	pushed := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	images := fromRegistry(testRegistry,
+		catalog.Image{Repository: "semver", Tag: "1.10.0", Labels: appLabels("semver", "Science", "Semver")},
+		catalog.Image{Repository: "semver", Tag: "1.9.0", Labels: appLabels("semver", "Science", "Semver")},
+		catalog.Image{Repository: "dated", Tag: "alpha", PushedAt: pushed.Add(time.Hour), Labels: appLabels("dated", "Science", "Dated")},
+		catalog.Image{Repository: "dated", Tag: "beta", PushedAt: pushed, Labels: appLabels("dated", "Science", "Dated")},
+	)
+
+	existingApp := &model.App{
+		ID:                  4,
+		DockerImageRegistry: testRegistry,
+		DockerImageName:     "semver",
+		DockerImageTag:      "1.9.0",
+		Name:                "Semver",
+		Versions:            []*model.AppVersion{{ID: 40, AppID: 4, Tag: "1.9.0", IsDefault: true}},
+	}
+	existing := map[string]*model.App{appIdentity(existingApp, testImagePrefix): existingApp}
+
+	toCreate, toAdd := appsToSync(images, existing, testImagePrefix, 1, 1)
+
+	// 1.10.0 is higher than 1.9.0, though lower as a string.
+	require.Len(t, toAdd, 1)
+	assert.Equal(t, "1.10.0", toAdd[0].Tag)
+	assert.True(t, toAdd[0].IsDefault)
+
+	// Tags that are not versions are ordered by push time.
+	require.Len(t, toCreate, 1)
+	assert.Equal(t, "alpha", toCreate[0].DockerImageTag)
+}
+
+func TestAppsToSync_MatchesAppsWithoutRegistry(t *testing.T) {
+	images := fromRegistry(testRegistry,
+		catalog.Image{Repository: "apps/vscode", Tag: "1.1.0", Labels: appLabels("vscode", "Development", "VS Code")},
+	)
+
+	legacyApp := &model.App{
+		ID:              5,
+		DockerImageName: "vscode",
+		DockerImageTag:  "1.0.0",
+		Name:            "VS Code",
+		Versions:        []*model.AppVersion{{ID: 50, AppID: 5, Tag: "1.0.0", IsDefault: true}},
+	}
+	existing := map[string]*model.App{appIdentity(legacyApp, testImagePrefix): legacyApp}
+
+	toCreate, toAdd := appsToSync(images, existing, testImagePrefix, 1, 1)
+
+	// The app is pulled from the default repository of the default registry, the image
+	// is a new version of it rather than a new app.
+	assert.Empty(t, toCreate)
+	require.Len(t, toAdd, 1)
+	assert.Equal(t, uint64(5), toAdd[0].AppID)
+	assert.True(t, toAdd[0].IsDefault)
+}
+
+func TestNewerTag(t *testing.T) {
+	pushed := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		tag, than string
+		newer     bool
+	}{
+		{"1.10.0", "1.9.0", true},
+		{"v2.0", "1.9.9", true},
+		{"2.0.0-2", "2.0.0-10", false},
+		{"2.0.0-rc.1", "2.0.0", false},
+		{"2.0.0-rc.1", "2.0.0-1", true},
+		{"2.0.0-rc.1.1", "2.0.0-rc.1", true},
+		{"1.0.0+build.2", "1.0.0+build.1", false},
+	} {
+		assert.Equal(t, tc.newer, model.NewerTag(tc.tag, time.Time{}, tc.than, time.Time{}), "%s > %s", tc.tag, tc.than)
+	}
+
+	assert.True(t, model.NewerTag("latest", pushed.Add(time.Minute), "1.0.0", pushed))
+	assert.False(t, model.NewerTag("nightly", pushed, "stable", pushed.Add(time.Minute)))
+	assert.True(t, model.NewerTag("b", time.Time{}, "a", time.Time{}))
+}
+
+func TestAppsToSync_SeveralSources(t *testing.T) {
+	images := append(
+		fromRegistry(testRegistry, catalog.Image{Repository: "apps/vscode", Tag: "1.0", Labels: appLabels("vscode", "Development", "VS Code")}),
+		fromRegistry("partner.example.com", catalog.Image{Repository: "apps/vscode", Tag: "1.1", Labels: appLabels("vscode", "Development", "VS Code")})...,
+	)
+
+	toCreate, toAdd := appsToSync(images, map[string]*model.App{}, testImagePrefix, 1, 1)
+
+	// The same image in two registries makes two apps, each pulled from its registry.
+	require.Len(t, toCreate, 2)
+	assert.Empty(t, toAdd)
+	assert.Equal(t, testRegistry, toCreate[0].DockerImageRegistry)
+	assert.Equal(t, "partner.example.com", toCreate[1].DockerImageRegistry)
+	assert.Len(t, toCreate[0].Versions, 1)
+	assert.Len(t, toCreate[1].Versions, 1)
+}
+
+type syncStore struct {
+	AppStore
+}
+
+func (s *syncStore) ListApps(_ context.Context, _ uint64, _ *common.Pagination) ([]*model.App, *common.PaginationResult, error) {
+	return nil, nil, nil
+}
+
+func (s *syncStore) ListAppVersions(_ context.Context, _ uint64, _ []uint64) ([]*model.AppVersion, error) {
+	return nil, nil
+}
+
+// unsignedApper refuses the apps whose image is in unsigned, like a tenant requiring
+// signed images.
+type unsignedApper struct {
+	Apper
+	unsigned map[string]bool
+	created  []string
+}
+
+func (a *unsignedApper) BulkCreateApps(_ context.Context, apps []*model.App) ([]*model.App, error) {
+	for _, app := range apps {
+		if a.unsigned[app.DockerImageName] {
+			return nil, cerr.ErrValidation.WithMessage("App image is unsigned, and images must be signed by a trusted key")
+		}
+	}
+	for _, app := range apps {
+		a.created = append(a.created, app.Name)
+	}
+	return apps, nil
+}
+
+type staticSource struct {
+	images []catalog.Image
+}
+
+func (s *staticSource) Name() string                         { return "static" }
+func (s *staticSource) Registry() string                     { return testRegistry }
+func (s *staticSource) ListImages() ([]catalog.Image, error) { return s.images, nil }
+
+func TestAppSyncJob_SkipsRefusedApps(t *testing.T) {
+	source := &staticSource{images: []catalog.Image{
+		{Repository: "apps/signed", Tag: "1.0.0", Labels: appLabels("signed", "Science", "Signed")},
+		{Repository: "apps/unsigned", Tag: "1.0.0", Labels: appLabels("unsigned", "Science", "Unsigned")},
+	}}
+	apper := &unsignedApper{unsigned: map[string]bool{"apps/unsigned": true}}
+	job := NewAppSyncJob(&syncStore{}, apper, []catalog.Source{source}, testImagePrefix, logger.NewNop())
+
+	res, err := job.Do(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Signed"}, apper.created)
+	assert.Equal(t, "created 1 new apps, skipped 1 and added 0 new versions", res)
 }
 
 func TestUint64Option(t *testing.T) {
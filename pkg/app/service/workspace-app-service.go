@@ -0,0 +1,238 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/app/model"
+	approval_model "github.com/CHORUS-TRE/chorus-backend/pkg/approval-request/model"
+	authz "github.com/CHORUS-TRE/chorus-backend/pkg/authorization/model"
+	notification_model "github.com/CHORUS-TRE/chorus-backend/pkg/notification/model"
+
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// ApprovalRequestStore records the approval requests of the custom apps of workspaces.
+type ApprovalRequestStore interface {
+	CreateApprovalRequest(ctx context.Context, tenantID uint64, request *approval_model.ApprovalRequest) (*approval_model.ApprovalRequest, error)
+}
+
+type UserPermissionFinder interface {
+	FindUsersWithPermission(ctx context.Context, tenantID uint64, filter authz.FindUsersWithPermissionFilter) ([]uint64, error)
+}
+
+type NotificationStore interface {
+	CreateNotification(ctx context.Context, notification *notification_model.Notification, userIDs []uint64) error
+}
+
+// ListWorkspaceApps returns the custom apps of a workspace, including those pending
+// approval.
+func (u *AppService) ListWorkspaceApps(ctx context.Context, tenantID, workspaceID uint64) ([]*model.App, error) {
+	apps, err := u.store.ListWorkspaceApps(ctx, tenantID, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query apps of workspace %v: %w", workspaceID, err)
+	}
+	if err := u.loadAppVersions(ctx, tenantID, apps); err != nil {
+		return nil, err
+	}
+	return apps, nil
+}
+
+// CreateWorkspaceApp registers a custom app of a workspace. Its image goes through the
+// same checks as the apps of the catalog, and its resources are capped by the workspace
+// app limits. When the tenant requires custom apps to be approved, the app is pending
+// until an app store admin approves the approval request raised for it, unless it is
+// registered by one of them.
+func (u *AppService) CreateWorkspaceApp(ctx context.Context, app *model.App) (*model.App, error) {
+	if !app.IsWorkspaceApp() {
+		return nil, cerr.ErrValidation.WithMessage("A custom app must belong to a workspace")
+	}
+	if app.BrowserConfigJWTOIDCClientID != "" {
+		return nil, cerr.ErrValidation.WithMessage("A custom app cannot sign in with an OIDC client of the platform")
+	}
+	if err := u.limitWorkspaceAppResources(app); err != nil {
+		return nil, err
+	}
+
+	settings, err := u.platformSettings.GetPlatformSettings(ctx, app.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get platform settings: %w", err)
+	}
+
+	app.Status = model.AppActive
+	var approvers []uint64
+	if settings.RequireCustomAppApproval {
+		approvers, err = u.userPermissionFinder.FindUsersWithPermission(ctx, app.TenantID, authz.FindUsersWithPermissionFilter{
+			PermissionName: authz.PermApproveCustomApp.Name,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(approvers) == 0 {
+			return nil, cerr.ErrInvalidRequest.WithMessage("No app store admin can approve custom apps")
+		}
+		if !slices.Contains(approvers, app.UserID) {
+			app.Status = model.AppPendingApproval
+		}
+	}
+
+	newApp, err := u.CreateApp(ctx, app)
+	if err != nil {
+		return nil, err
+	}
+
+	if settings.RequireCustomAppApproval {
+		if err := u.requestCustomAppApproval(ctx, newApp, approvers); err != nil {
+			return nil, err
+		}
+	}
+
+	return newApp, nil
+}
+
+// limitWorkspaceAppResources rejects the resources of a custom app above the workspace
+// app limits. A limit the app leaves empty is set to the cap, so that the app does not
+// run unbounded.
+func (u *AppService) limitWorkspaceAppResources(app *model.App) error {
+	limits := u.cfg.Services.AppService.WorkspaceAppLimits
+	resources := []struct {
+		name  string
+		value *string
+		limit string
+		fill  bool
+	}{
+		{"maximum CPU", &app.MaxCPU, limits.MaxCPU, true},
+		{"minimum CPU", &app.MinCPU, limits.MaxCPU, false},
+		{"maximum memory", &app.MaxMemory, limits.MaxMemory, true},
+		{"minimum memory", &app.MinMemory, limits.MaxMemory, false},
+		{"maximum ephemeral storage", &app.MaxEphemeralStorage, limits.MaxEphemeralStorage, true},
+		{"minimum ephemeral storage", &app.MinEphemeralStorage, limits.MaxEphemeralStorage, false},
+		{"shared memory size", &app.ShmSize, limits.MaxShmSize, false},
+	}
+
+	for _, r := range resources {
+		if r.limit == "" {
+			continue
+		}
+		limit, err := resource.ParseQuantity(r.limit)
+		if err != nil {
+			return cerr.ErrInternal.Wrap(err, fmt.Sprintf("Invalid workspace app limit %q", r.limit))
+		}
+
+		if *r.value == "" {
+			if r.fill {
+				*r.value = r.limit
+			}
+			continue
+		}
+		value, err := resource.ParseQuantity(*r.value)
+		if err != nil {
+			return cerr.ErrValidation.WithMessage(fmt.Sprintf("Invalid %s %q", r.name, *r.value))
+		}
+		if value.Cmp(limit) > 0 {
+			return cerr.ErrValidation.WithMessage(fmt.Sprintf("The %s of a custom app cannot exceed %s", r.name, r.limit))
+		}
+	}
+
+	return nil
+}
+
+// requestCustomAppApproval records the approval request of a custom app for the app store
+// admins. The request is auto-approved when the app was registered by one of them;
+// otherwise they are notified.
+func (u *AppService) requestCustomAppApproval(ctx context.Context, app *model.App, approvers []uint64) error {
+	request := &approval_model.ApprovalRequest{
+		TenantID:    app.TenantID,
+		RequesterID: app.UserID,
+		Type:        approval_model.ApprovalRequestTypeCustomApp,
+		Status:      approval_model.ApprovalRequestStatusPending,
+		Title:       fmt.Sprintf("Custom app %s for workspace %v", app.Name, *app.WorkspaceID),
+		Description: fmt.Sprintf("Add the app %s, running the image %s, to workspace %v", app.Name, dockerImageToString(app), *app.WorkspaceID),
+		Details: approval_model.ApprovalRequestDetails{
+			CustomAppDetails: &approval_model.CustomAppDetails{
+				WorkspaceID: *app.WorkspaceID,
+				AppID:       app.ID,
+				AppName:     app.Name,
+				Image:       dockerImageToString(app),
+			},
+		},
+		ApproverIDsByStep: map[approval_model.ApprovalStep][]uint64{
+			approval_model.StepCustomApp: approvers,
+		},
+	}
+
+	autoApprove := app.Status != model.AppPendingApproval
+	if autoApprove {
+		now := time.Now()
+		request.Status = approval_model.ApprovalRequestStatusApproved
+		request.AutoApproved = true
+		request.ApprovedAt = &now
+		request.StepDecisions = map[approval_model.ApprovalStep]approval_model.ApprovalStepDecision{
+			approval_model.StepCustomApp: {ApproverID: app.UserID, ApprovedAt: now, Approve: true},
+		}
+	}
+
+	created, err := u.approvalRequestStore.CreateApprovalRequest(ctx, app.TenantID, request)
+	if err != nil {
+		return cerr.WrapStoreError(err, "Unable to create approval request")
+	}
+
+	if autoApprove {
+		return nil
+	}
+
+	for _, approverID := range approvers {
+		err = u.notificationStore.CreateNotification(ctx, &notification_model.Notification{
+			TenantID: app.TenantID,
+			UserID:   approverID,
+			Message:  fmt.Sprintf("Approval request '%s' has been created and is pending your approval.", request.Title),
+			Content: notification_model.NotificationContent{
+				Type: "ApprovalRequestNotification",
+				ApprovalRequest: &notification_model.ApprovalRequestNotification{
+					ApprovalRequestID: created.ID,
+				},
+			},
+		}, []uint64{approverID})
+		if err != nil {
+			logger.TechLog.Error(ctx, "Unable to create notification", zap.Uint64("tenant_id", app.TenantID), zap.Uint64("request_id", created.ID), zap.Uint64("user_id", approverID))
+		}
+	}
+
+	return nil
+}
+
+// ApproveWorkspaceApp makes a custom app pending approval launchable. It is called once
+// its approval request has been approved.
+func (u *AppService) ApproveWorkspaceApp(ctx context.Context, tenantID, appID uint64) error {
+	app, err := u.store.GetApp(ctx, tenantID, appID)
+	if err != nil {
+		return cerr.WrapStoreError(err, fmt.Sprintf("Unable to get app %v", appID))
+	}
+	if app.Status != model.AppPendingApproval {
+		return cerr.ErrInvalidRequest.WithMessage(fmt.Sprintf("App %v is %v and not pending approval", appID, app.Status))
+	}
+
+	if err := u.store.UpdateAppStatus(ctx, tenantID, appID, model.AppActive); err != nil {
+		return cerr.WrapStoreError(err, fmt.Sprintf("Unable to approve app %v", appID))
+	}
+
+	return nil
+}
+
+// DeleteWorkspaceApp deletes a custom app of a workspace.
+func (u *AppService) DeleteWorkspaceApp(ctx context.Context, tenantID, workspaceID, appID uint64) error {
+	app, err := u.store.GetApp(ctx, tenantID, appID)
+	if err != nil {
+		return cerr.WrapStoreError(err, fmt.Sprintf("Unable to get app %v", appID))
+	}
+	if app.WorkspaceID == nil || *app.WorkspaceID != workspaceID || app.Status == model.AppDeleted {
+		return cerr.ErrNotFound.WithMessage(fmt.Sprintf("Workspace %v has no app %v", workspaceID, appID))
+	}
+
+	return u.DeleteApp(ctx, tenantID, appID)
+}
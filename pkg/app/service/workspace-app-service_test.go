@@ -0,0 +1,238 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/k8s"
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/ociregistry"
+	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/app/model"
+	approval_model "github.com/CHORUS-TRE/chorus-backend/pkg/approval-request/model"
+	authz "github.com/CHORUS-TRE/chorus-backend/pkg/authorization/model"
+	notification_model "github.com/CHORUS-TRE/chorus-backend/pkg/notification/model"
+	platform_settings_model "github.com/CHORUS-TRE/chorus-backend/pkg/platform-settings/model"
+)
+
+type workspaceAppOCIClient struct {
+	ociregistry.OCIClienter
+}
+
+func (c *workspaceAppOCIClient) HasRegistry(registry string) bool { return registry == "harbor.local" }
+func (c *workspaceAppOCIClient) Host() string                     { return "harbor.local" }
+func (c *workspaceAppOCIClient) ImageExists(string) (bool, error) { return true, nil }
+
+type prePullK8sClient struct {
+	k8s.K8sClienter
+}
+
+func (c *prePullK8sClient) PrePullImageOnAllNodes(string) {}
+
+type workspaceAppStore struct {
+	AppStore
+	apps map[uint64]*model.App
+}
+
+func (s *workspaceAppStore) CreateApp(_ context.Context, _ uint64, app *model.App) (*model.App, error) {
+	created := *app
+	created.ID = uint64(len(s.apps) + 1)
+	s.apps[created.ID] = &created
+	return &created, nil
+}
+
+func (s *workspaceAppStore) GetApp(_ context.Context, _, appID uint64) (*model.App, error) {
+	app, ok := s.apps[appID]
+	if !ok {
+		return nil, cerr.ErrNotFound
+	}
+	a := *app
+	return &a, nil
+}
+
+func (s *workspaceAppStore) UpdateAppStatus(_ context.Context, _, appID uint64, status model.AppStatus) error {
+	s.apps[appID].Status = status
+	return nil
+}
+
+func (s *workspaceAppStore) DeleteApp(_ context.Context, _, appID uint64) error {
+	s.apps[appID].Status = model.AppDeleted
+	return nil
+}
+
+type approvalRequestRecorder struct {
+	requests []*approval_model.ApprovalRequest
+}
+
+func (s *approvalRequestRecorder) CreateApprovalRequest(_ context.Context, _ uint64, request *approval_model.ApprovalRequest) (*approval_model.ApprovalRequest, error) {
+	request.ID = uint64(len(s.requests) + 1)
+	s.requests = append(s.requests, request)
+	return request, nil
+}
+
+type approverFinder struct {
+	approvers []uint64
+}
+
+func (f *approverFinder) FindUsersWithPermission(_ context.Context, _ uint64, filter authz.FindUsersWithPermissionFilter) ([]uint64, error) {
+	if filter.PermissionName != authz.PermApproveCustomApp.Name {
+		return nil, nil
+	}
+	return f.approvers, nil
+}
+
+type notificationRecorder struct {
+	notified []uint64
+}
+
+func (s *notificationRecorder) CreateNotification(_ context.Context, _ *notification_model.Notification, userIDs []uint64) error {
+	s.notified = append(s.notified, userIDs...)
+	return nil
+}
+
+func newWorkspaceAppSvc(requireApproval bool) (*AppService, *workspaceAppStore, *approvalRequestRecorder, *notificationRecorder) {
+	store := &workspaceAppStore{apps: map[uint64]*model.App{}}
+	requests := &approvalRequestRecorder{}
+	notifications := &notificationRecorder{}
+	var cfg config.Config
+	cfg.Services.AppService.WorkspaceAppLimits.MaxCPU = "2"
+	cfg.Services.AppService.WorkspaceAppLimits.MaxMemory = "4Gi"
+	return &AppService{
+		cfg:                  cfg,
+		store:                store,
+		k8sClient:            &prePullK8sClient{},
+		ociClient:            &workspaceAppOCIClient{},
+		platformSettings:     &settingsReader{settings: platform_settings_model.PlatformSettings{RequireCustomAppApproval: requireApproval}},
+		approvalRequestStore: requests,
+		userPermissionFinder: &approverFinder{approvers: []uint64{100}},
+		notificationStore:    notifications,
+	}, store, requests, notifications
+}
+
+func customApp(userID uint64) *model.App {
+	workspaceID := uint64(7)
+	return &model.App{
+		TenantID: 1, UserID: userID, WorkspaceID: &workspaceID,
+		Name: "tool", DockerImageRegistry: "harbor.local", DockerImageName: "lab/tool", DockerImageTag: "1.0",
+	}
+}
+
+func assertChorusCode(t *testing.T, expected *cerr.ChorusError, err error) {
+	t.Helper()
+	var cErr *cerr.ChorusError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, expected.ChorusCode, cErr.ChorusCode)
+}
+
+func TestCreateWorkspaceApp(t *testing.T) {
+	svc, _, requests, _ := newWorkspaceAppSvc(false)
+
+	app, err := svc.CreateWorkspaceApp(context.Background(), customApp(5))
+	require.NoError(t, err)
+	assert.Equal(t, model.AppActive, app.Status)
+	assert.Equal(t, uint64(7), *app.WorkspaceID)
+	assert.Empty(t, requests.requests, "no approval is needed")
+
+	app = customApp(5)
+	app.WorkspaceID = nil
+	_, err = svc.CreateWorkspaceApp(context.Background(), app)
+	assertChorusCode(t, cerr.ErrValidation, err)
+
+	app = customApp(5)
+	app.DockerImageRegistry = "docker.io"
+	_, err = svc.CreateWorkspaceApp(context.Background(), app)
+	assert.Error(t, err, "custom apps are pulled from the configured registries only")
+}
+
+func TestCreateWorkspaceApp_Limits(t *testing.T) {
+	svc, _, _, _ := newWorkspaceAppSvc(false)
+
+	app, err := svc.CreateWorkspaceApp(context.Background(), customApp(5))
+	require.NoError(t, err)
+	assert.Equal(t, "2", app.MaxCPU, "an app without limits gets the caps")
+	assert.Equal(t, "4Gi", app.MaxMemory)
+	assert.Empty(t, app.MaxEphemeralStorage, "storage is not capped")
+
+	app = customApp(5)
+	app.MaxCPU = "1500m"
+	app.MinMemory = "1Gi"
+	app, err = svc.CreateWorkspaceApp(context.Background(), app)
+	require.NoError(t, err)
+	assert.Equal(t, "1500m", app.MaxCPU)
+	assert.Equal(t, "1Gi", app.MinMemory)
+
+	for name, set := range map[string]func(*model.App){
+		"max cpu":    func(a *model.App) { a.MaxCPU = "3" },
+		"min memory": func(a *model.App) { a.MinMemory = "8Gi" },
+		"invalid":    func(a *model.App) { a.MaxMemory = "lots" },
+		"oidc":       func(a *model.App) { a.BrowserConfigJWTOIDCClientID = "chorus-frontend" },
+	} {
+		t.Run(name, func(t *testing.T) {
+			app := customApp(5)
+			set(app)
+			_, err := svc.CreateWorkspaceApp(context.Background(), app)
+			assertChorusCode(t, cerr.ErrValidation, err)
+		})
+	}
+}
+
+func TestCreateWorkspaceApp_RequiresApproval(t *testing.T) {
+	svc, store, requests, notifications := newWorkspaceAppSvc(true)
+
+	app, err := svc.CreateWorkspaceApp(context.Background(), customApp(5))
+	require.NoError(t, err)
+	assert.Equal(t, model.AppPendingApproval, app.Status)
+
+	require.Len(t, requests.requests, 1)
+	request := requests.requests[0]
+	assert.Equal(t, approval_model.ApprovalRequestTypeCustomApp, request.Type)
+	assert.Equal(t, approval_model.ApprovalRequestStatusPending, request.Status)
+	assert.Equal(t, app.ID, request.Details.CustomAppDetails.AppID)
+	assert.Equal(t, uint64(7), request.Details.CustomAppDetails.WorkspaceID)
+	assert.Equal(t, []uint64{100}, request.ApproverIDsByStep[approval_model.StepCustomApp])
+	assert.Equal(t, []uint64{100}, notifications.notified)
+
+	require.NoError(t, svc.ApproveWorkspaceApp(context.Background(), 1, app.ID))
+	assert.Equal(t, model.AppActive, store.apps[app.ID].Status)
+
+	err = svc.ApproveWorkspaceApp(context.Background(), 1, app.ID)
+	assertChorusCode(t, cerr.ErrInvalidRequest, err)
+}
+
+func TestCreateWorkspaceApp_AutoApproved(t *testing.T) {
+	svc, _, requests, notifications := newWorkspaceAppSvc(true)
+
+	app, err := svc.CreateWorkspaceApp(context.Background(), customApp(100))
+	require.NoError(t, err)
+	assert.Equal(t, model.AppActive, app.Status)
+
+	require.Len(t, requests.requests, 1)
+	assert.True(t, requests.requests[0].AutoApproved)
+	assert.Equal(t, approval_model.ApprovalRequestStatusApproved, requests.requests[0].Status)
+	assert.Empty(t, notifications.notified)
+
+	svc.userPermissionFinder = &approverFinder{}
+	_, err = svc.CreateWorkspaceApp(context.Background(), customApp(5))
+	assertChorusCode(t, cerr.ErrInvalidRequest, err)
+}
+
+func TestDeleteWorkspaceApp(t *testing.T) {
+	svc, store, _, _ := newWorkspaceAppSvc(false)
+	app, err := svc.CreateWorkspaceApp(context.Background(), customApp(5))
+	require.NoError(t, err)
+
+	err = svc.DeleteWorkspaceApp(context.Background(), 1, 8, app.ID)
+	assertChorusCode(t, cerr.ErrNotFound, err)
+	assert.Equal(t, model.AppActive, store.apps[app.ID].Status)
+
+	require.NoError(t, svc.DeleteWorkspaceApp(context.Background(), 1, 7, app.ID))
+	assert.Equal(t, model.AppDeleted, store.apps[app.ID].Status)
+
+	err = svc.DeleteWorkspaceApp(context.Background(), 1, 7, app.ID)
+	assertChorusCode(t, cerr.ErrNotFound, err)
+}
@@ -47,6 +47,28 @@ func (c appStorageLogging) ListApps(ctx context.Context, tenantID uint64, pagina
 	return res, paginationRes, nil
 }
 
+func (c appStorageLogging) ListWorkspaceApps(ctx context.Context, tenantID, workspaceID uint64) ([]*model.App, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	res, err := c.next.ListWorkspaceApps(ctx, tenantID, workspaceID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkspaceIDField(workspaceID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithWorkspaceIDField(workspaceID),
+		logger.WithCountField(len(res)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
 func (c appStorageLogging) GetApp(ctx context.Context, tenantID uint64, appID uint64) (*model.App, error) {
 	c.logger.Debug(ctx, "request started")
 	now := time.Now()
@@ -88,11 +110,11 @@ func (c appStorageLogging) DeleteApp(ctx context.Context, tenantID, appID uint64
 	return nil
 }
 
-func (c appStorageLogging) UpdateApp(ctx context.Context, tenantID uint64, app *model.App) (*model.App, error) {
+func (c appStorageLogging) UpdateApp(ctx context.Context, tenantID uint64, app *model.App, event *model.AppLifecycleEvent) (*model.App, error) {
 	c.logger.Debug(ctx, "request started")
 	now := time.Now()
 
-	updatedApp, err := c.next.UpdateApp(ctx, tenantID, app)
+	updatedApp, err := c.next.UpdateApp(ctx, tenantID, app, event)
 	if err != nil {
 		c.logger.Error(ctx, "request completed",
 			logger.WithAppIDField(app.ID),
@@ -149,3 +171,288 @@ func (c appStorageLogging) BulkCreateApps(ctx context.Context, tenantID uint64,
 	)
 	return newApps, nil
 }
+
+func (c appStorageLogging) ListAppVersions(ctx context.Context, tenantID uint64, appIDs []uint64) ([]*model.AppVersion, error) {
+	c.logger.Debug(ctx, "request started")
+
+	now := time.Now()
+
+	res, err := c.next.ListAppVersions(ctx, tenantID, appIDs)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithCountField(len(res)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c appStorageLogging) GetAppVersion(ctx context.Context, tenantID uint64, versionID uint64) (*model.AppVersion, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	res, err := c.next.GetAppVersion(ctx, tenantID, versionID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Uint64("app_version_id", versionID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		zap.Uint64("app_version_id", versionID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c appStorageLogging) CreateAppVersion(ctx context.Context, tenantID uint64, version *model.AppVersion, event *model.AppLifecycleEvent) (*model.AppVersion, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	newVersion, err := c.next.CreateAppVersion(ctx, tenantID, version, event)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithAppIDField(version.AppID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithAppIDField(version.AppID),
+		zap.Uint64("app_version_id", newVersion.ID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return newVersion, nil
+}
+
+func (c appStorageLogging) UpdateAppVersion(ctx context.Context, tenantID uint64, version *model.AppVersion) (*model.AppVersion, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	updatedVersion, err := c.next.UpdateAppVersion(ctx, tenantID, version)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Uint64("app_version_id", version.ID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		zap.Uint64("app_version_id", version.ID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return updatedVersion, nil
+}
+
+func (c appStorageLogging) UpdateAppVersionScan(ctx context.Context, tenantID, versionID uint64, scan model.VulnerabilityScan) error {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	err := c.next.UpdateAppVersionScan(ctx, tenantID, versionID, scan)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Uint64("app_version_id", versionID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		zap.Uint64("app_version_id", versionID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c appStorageLogging) UpdateAppSignature(ctx context.Context, tenantID, appID uint64, signature model.AppSignature) error {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	err := c.next.UpdateAppSignature(ctx, tenantID, appID, signature)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Uint64("app_id", appID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		zap.Uint64("app_id", appID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c appStorageLogging) UpdateAppStatus(ctx context.Context, tenantID, appID uint64, status model.AppStatus) error {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	err := c.next.UpdateAppStatus(ctx, tenantID, appID, status)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Uint64("app_id", appID),
+			zap.String("status", status.String()),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		zap.Uint64("app_id", appID),
+		zap.String("status", status.String()),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c appStorageLogging) ListAppReviewers(ctx context.Context, tenantID, appID uint64) ([]*model.AppReviewer, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	res, err := c.next.ListAppReviewers(ctx, tenantID, appID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Uint64("app_id", appID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		zap.Uint64("app_id", appID),
+		logger.WithCountField(len(res)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c appStorageLogging) ListAppLifecycleEvents(ctx context.Context, tenantID, appID uint64) ([]*model.AppLifecycleEvent, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	res, err := c.next.ListAppLifecycleEvents(ctx, tenantID, appID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Uint64("app_id", appID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		zap.Uint64("app_id", appID),
+		logger.WithCountField(len(res)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c appStorageLogging) CreateAppLifecycleEvent(ctx context.Context, tenantID uint64, event *model.AppLifecycleEvent) (*model.AppLifecycleEvent, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	res, err := c.next.CreateAppLifecycleEvent(ctx, tenantID, event)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Uint64("app_id", event.AppID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		zap.Uint64("app_id", event.AppID),
+		zap.String("action", res.Action.String()),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c appStorageLogging) StartAppReview(ctx context.Context, tenantID uint64, event *model.AppLifecycleEvent, reviewerIDs []uint64) error {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	err := c.next.StartAppReview(ctx, tenantID, event, reviewerIDs)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Uint64("app_id", event.AppID),
+			zap.String("lifecycle_status", event.ToStatus.String()),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		zap.Uint64("app_id", event.AppID),
+		zap.String("lifecycle_status", event.ToStatus.String()),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c appStorageLogging) DecideAppReview(ctx context.Context, tenantID uint64, event *model.AppLifecycleEvent, decision model.AppReviewDecision) error {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	err := c.next.DecideAppReview(ctx, tenantID, event, decision)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Uint64("app_id", event.AppID),
+			zap.String("lifecycle_status", event.ToStatus.String()),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		zap.Uint64("app_id", event.AppID),
+		zap.String("lifecycle_status", event.ToStatus.String()),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c appStorageLogging) UpdateAppLifecycle(ctx context.Context, tenantID uint64, event *model.AppLifecycleEvent, replacementAppID *uint64) error {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	err := c.next.UpdateAppLifecycle(ctx, tenantID, event, replacementAppID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Uint64("app_id", event.AppID),
+			zap.String("lifecycle_status", event.ToStatus.String()),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		zap.Uint64("app_id", event.AppID),
+		zap.String("lifecycle_status", event.ToStatus.String()),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
@@ -0,0 +1,234 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/app/model"
+	common_storage "github.com/CHORUS-TRE/chorus-backend/pkg/common/storage"
+)
+
+const appReviewerColumns = `id, tenantid, appid, userid, decision, decidedat, createdat`
+
+const appLifecycleEventColumns = `id, tenantid, appid, userid, action, fromstatus, tostatus, comment, createdat`
+
+// ListAppReviewers lists the reviewers of the current review of an app.
+func (s *AppStorage) ListAppReviewers(ctx context.Context, tenantID, appID uint64) ([]*model.AppReviewer, error) {
+	query := `
+		SELECT ` + appReviewerColumns + `
+		FROM app_reviewers
+		WHERE tenantid = $1 AND appid = $2
+		ORDER BY id;
+	`
+
+	var reviewers []*model.AppReviewer
+	if err := s.db.SelectContext(ctx, &reviewers, query, tenantID, appID); err != nil {
+		return nil, err
+	}
+
+	return reviewers, nil
+}
+
+// ListAppLifecycleEvents lists the lifecycle events of an app, oldest first.
+func (s *AppStorage) ListAppLifecycleEvents(ctx context.Context, tenantID, appID uint64) ([]*model.AppLifecycleEvent, error) {
+	query := `
+		SELECT ` + appLifecycleEventColumns + `
+		FROM app_lifecycle_events
+		WHERE tenantid = $1 AND appid = $2
+		ORDER BY createdat, id;
+	`
+
+	var events []*model.AppLifecycleEvent
+	if err := s.db.SelectContext(ctx, &events, query, tenantID, appID); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// CreateAppLifecycleEvent records an event of the lifecycle of an app that does not
+// change its stage, such as a review comment.
+func (s *AppStorage) CreateAppLifecycleEvent(ctx context.Context, tenantID uint64, event *model.AppLifecycleEvent) (*model.AppLifecycleEvent, error) {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	newEvent, err := s.insertAppLifecycleEvent(ctx, tx, tenantID, event)
+	if err != nil {
+		return nil, common_storage.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return newEvent, nil
+}
+
+// StartAppReview moves an app to the stage of the event, replaces the reviewers of its
+// previous review and records the event.
+func (s *AppStorage) StartAppReview(ctx context.Context, tenantID uint64, event *model.AppLifecycleEvent, reviewerIDs []uint64) error {
+	const deleteQuery = `
+		DELETE FROM app_reviewers
+		WHERE tenantid = $1 AND appid = $2;
+	`
+	const insertQuery = `
+		INSERT INTO app_reviewers (tenantid, appid, userid, decision, createdat)
+		VALUES ($1, $2, $3, $4, NOW());
+	`
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := s.moveAppLifecycle(ctx, tx, tenantID, event, nil); err != nil {
+		return common_storage.Rollback(tx, err)
+	}
+
+	if _, err := tx.ExecContext(ctx, deleteQuery, tenantID, event.AppID); err != nil {
+		return common_storage.Rollback(tx, fmt.Errorf("unable to delete reviewers of app %v: %w", event.AppID, err))
+	}
+	for _, userID := range reviewerIDs {
+		if _, err := tx.ExecContext(ctx, insertQuery, tenantID, event.AppID, userID, model.AppReviewPending); err != nil {
+			return common_storage.Rollback(tx, fmt.Errorf("unable to add reviewer %v to app %v: %w", userID, event.AppID, err))
+		}
+	}
+
+	if _, err := s.insertAppLifecycleEvent(ctx, tx, tenantID, event); err != nil {
+		return common_storage.Rollback(tx, err)
+	}
+
+	return tx.Commit()
+}
+
+// DecideAppReview records the pending decision of a reviewer of an app, moves the app to
+// the stage of the event when it differs from its current one, and records the event.
+// The app is locked while the decision is recorded, so that the approval of the last
+// pending reviewer is counted once: the event then moves the app to the approved stage.
+func (s *AppStorage) DecideAppReview(ctx context.Context, tenantID uint64, event *model.AppLifecycleEvent, decision model.AppReviewDecision) error {
+	const lockQuery = `
+		SELECT lifecyclestatus FROM apps
+		WHERE tenantid = $1 AND id = $2
+		FOR UPDATE;
+	`
+	const query = `
+		UPDATE app_reviewers
+		SET decision = $4, decidedat = NOW()
+		WHERE tenantid = $1 AND appid = $2 AND userid = $3 AND decision = 'pending';
+	`
+	const countQuery = `
+		SELECT COUNT(*) FROM app_reviewers
+		WHERE tenantid = $1 AND appid = $2 AND decision != 'approved';
+	`
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	var status model.AppLifecycleStatus
+	if err := tx.GetContext(ctx, &status, lockQuery, tenantID, event.AppID); err != nil {
+		return common_storage.Rollback(tx, fmt.Errorf("unable to lock app %v: %w", event.AppID, err))
+	}
+	if status != event.FromStatus {
+		return common_storage.Rollback(tx, cerr.ErrNoRowsUpdated)
+	}
+
+	res, err := tx.ExecContext(ctx, query, tenantID, event.AppID, event.UserID, decision)
+	if err != nil {
+		return common_storage.Rollback(tx, fmt.Errorf("unable to update decision of reviewer %v of app %v: %w", event.UserID, event.AppID, err))
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return common_storage.Rollback(tx, err)
+	}
+	if rows != 1 {
+		return common_storage.Rollback(tx, cerr.ErrNoRowsUpdated)
+	}
+
+	if decision == model.AppReviewApproved {
+		var notApproved int
+		if err := tx.GetContext(ctx, &notApproved, countQuery, tenantID, event.AppID); err != nil {
+			return common_storage.Rollback(tx, fmt.Errorf("unable to count reviewers of app %v: %w", event.AppID, err))
+		}
+		if notApproved == 0 {
+			event.ToStatus = model.AppLifecycleApproved
+		}
+	}
+
+	if event.ToStatus != event.FromStatus {
+		if err := s.moveAppLifecycle(ctx, tx, tenantID, event, nil); err != nil {
+			return common_storage.Rollback(tx, err)
+		}
+	}
+
+	if _, err := s.insertAppLifecycleEvent(ctx, tx, tenantID, event); err != nil {
+		return common_storage.Rollback(tx, err)
+	}
+
+	return tx.Commit()
+}
+
+// UpdateAppLifecycle moves an app to the stage of the event, sets the app that replaces
+// it, and records the event.
+func (s *AppStorage) UpdateAppLifecycle(ctx context.Context, tenantID uint64, event *model.AppLifecycleEvent, replacementAppID *uint64) error {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := s.moveAppLifecycle(ctx, tx, tenantID, event, replacementAppID); err != nil {
+		return common_storage.Rollback(tx, err)
+	}
+
+	if _, err := s.insertAppLifecycleEvent(ctx, tx, tenantID, event); err != nil {
+		return common_storage.Rollback(tx, err)
+	}
+
+	return tx.Commit()
+}
+
+// moveAppLifecycle moves an app from the stage the event starts from to the one it ends
+// in. It returns ErrNoRowsUpdated when the app moved meanwhile.
+func (s *AppStorage) moveAppLifecycle(ctx context.Context, tx *sqlx.Tx, tenantID uint64, event *model.AppLifecycleEvent, replacementAppID *uint64) error {
+	const query = `
+		UPDATE apps
+		SET lifecyclestatus = $4, replacementappid = $5, updatedat = NOW()
+		WHERE tenantid = $1 AND id = $2 AND lifecyclestatus = $3;
+	`
+
+	res, err := tx.ExecContext(ctx, query, tenantID, event.AppID, event.FromStatus, event.ToStatus, replacementAppID)
+	if err != nil {
+		return fmt.Errorf("unable to update lifecycle of app %v: %w", event.AppID, err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows != 1 {
+		return cerr.ErrNoRowsUpdated
+	}
+
+	return nil
+}
+
+func (s *AppStorage) insertAppLifecycleEvent(ctx context.Context, tx *sqlx.Tx, tenantID uint64, event *model.AppLifecycleEvent) (*model.AppLifecycleEvent, error) {
+	query := `
+		INSERT INTO app_lifecycle_events (tenantid, appid, userid, action, fromstatus, tostatus, comment, createdat)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NOW())
+		RETURNING ` + appLifecycleEventColumns + `;
+	`
+
+	var newEvent model.AppLifecycleEvent
+	if err := tx.GetContext(ctx, &newEvent, query, tenantID, event.AppID, event.UserID, event.Action, event.FromStatus, event.ToStatus, event.Comment); err != nil {
+		return nil, fmt.Errorf("unable to record lifecycle event of app %v: %w", event.AppID, err)
+	}
+
+	return &newEvent, nil
+}
@@ -25,7 +25,7 @@ func NewAppStorage(db *sqlx.DB) *AppStorage {
 
 func (s *AppStorage) GetApp(ctx context.Context, tenantID uint64, appID uint64) (*model.App, error) {
 	const query = `
-		SELECT id, tenantid, userid, "name", "description", "status", "dockerimagename", "dockerimagetag", "dockerimageregistry", "shmsize", "browserconfigurl", "browserconfigjwturl", "browserconfigjwtoidcclientid", "maxcpu", "mincpu", "maxmemory", "minmemory", "maxephemeralstorage", "minephemeralstorage", "iconurl", "iconbackgroundcolor", "stabilitystatus", "category", createdat, updatedat
+		SELECT id, tenantid, userid, "name", "description", "status", "dockerimagename", "dockerimagetag", "dockerimageregistry", "shmsize", "browserconfigurl", "browserconfigjwturl", "browserconfigjwtoidcclientid", "maxcpu", "mincpu", "maxmemory", "minmemory", "maxephemeralstorage", "minephemeralstorage", "iconurl", "iconbackgroundcolor", "stabilitystatus", "category", signaturestatus, signaturekeyid, signaturecheckedat, workspaceid, lifecyclestatus, replacementappid, createdat, updatedat
 		FROM apps
 		WHERE tenantid = $1 AND id = $2;
 	`
@@ -40,7 +40,7 @@ func (s *AppStorage) GetApp(ctx context.Context, tenantID uint64, appID uint64)
 
 func (s *AppStorage) ListApps(ctx context.Context, tenantID uint64, pagination *common.Pagination) ([]*model.App, *common.PaginationResult, error) {
 	// Get total count query
-	countQuery := `SELECT COUNT(*) FROM apps WHERE tenantid = $1 AND status != 'deleted';`
+	countQuery := `SELECT COUNT(*) FROM apps WHERE tenantid = $1 AND status != 'deleted' AND workspaceid IS NULL;`
 	var totalCount int
 	if err := s.db.GetContext(ctx, &totalCount, countQuery, tenantID); err != nil {
 		return nil, nil, err
@@ -48,9 +48,9 @@ func (s *AppStorage) ListApps(ctx context.Context, tenantID uint64, pagination *
 
 	// Get apps query
 	query := `
-		SELECT id, tenantid, userid, "name", "description", "status", "dockerimagename", "dockerimagetag", "dockerimageregistry", "shmsize", "browserconfigurl", "browserconfigjwturl", "browserconfigjwtoidcclientid", "maxcpu", "mincpu", "maxmemory", "minmemory", "maxephemeralstorage", "minephemeralstorage", "iconurl", "iconbackgroundcolor", "stabilitystatus", "category", createdat, updatedat
+		SELECT id, tenantid, userid, "name", "description", "status", "dockerimagename", "dockerimagetag", "dockerimageregistry", "shmsize", "browserconfigurl", "browserconfigjwturl", "browserconfigjwtoidcclientid", "maxcpu", "mincpu", "maxmemory", "minmemory", "maxephemeralstorage", "minephemeralstorage", "iconurl", "iconbackgroundcolor", "stabilitystatus", "category", signaturestatus, signaturekeyid, signaturecheckedat, workspaceid, lifecyclestatus, replacementappid, createdat, updatedat
 		FROM apps
-		WHERE tenantid = $1 AND status != 'deleted'
+		WHERE tenantid = $1 AND status != 'deleted' AND workspaceid IS NULL
 	`
 
 	// Add pagination
@@ -79,19 +79,49 @@ func (s *AppStorage) ListApps(ctx context.Context, tenantID uint64, pagination *
 	return apps, paginationRes, nil
 }
 
+// ListWorkspaceApps returns the custom apps of a workspace.
+func (s *AppStorage) ListWorkspaceApps(ctx context.Context, tenantID, workspaceID uint64) ([]*model.App, error) {
+	const query = `
+		SELECT id, tenantid, userid, "name", "description", "status", "dockerimagename", "dockerimagetag", "dockerimageregistry", "shmsize", "browserconfigurl", "browserconfigjwturl", "browserconfigjwtoidcclientid", "maxcpu", "mincpu", "maxmemory", "minmemory", "maxephemeralstorage", "minephemeralstorage", "iconurl", "iconbackgroundcolor", "stabilitystatus", "category", signaturestatus, signaturekeyid, signaturecheckedat, workspaceid, lifecyclestatus, replacementappid, createdat, updatedat
+		FROM apps
+		WHERE tenantid = $1 AND workspaceid = $2 AND status != 'deleted'
+		ORDER BY name, id;
+	`
+
+	var apps []*model.App
+	if err := s.db.SelectContext(ctx, &apps, query, tenantID, workspaceID); err != nil {
+		return nil, err
+	}
+
+	return apps, nil
+}
+
 // CreateApp saves the provided app object in the database 'apps' table.
 func (s *AppStorage) CreateApp(ctx context.Context, tenantID uint64, app *model.App) (*model.App, error) {
 	const appQuery = `
-		INSERT INTO apps (tenantid, userid, "name", "description", "status", "dockerimagename", "dockerimagetag", "dockerimageregistry", "shmsize", "browserconfigurl", "browserconfigjwturl", "browserconfigjwtoidcclientid", "maxcpu", "mincpu", "maxmemory", "minmemory", "maxephemeralstorage", "minephemeralstorage", "iconurl", "iconbackgroundcolor", "stabilitystatus", "category", createdat, updatedat)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, NOW(), NOW()) 
-		RETURNING id, tenantid, userid, "name", "description", "status", "dockerimagename", "dockerimagetag", "dockerimageregistry", "shmsize", "browserconfigurl", "browserconfigjwturl", "browserconfigjwtoidcclientid", "maxcpu", "mincpu", "maxmemory", "minmemory", "maxephemeralstorage", "minephemeralstorage", "iconurl", "iconbackgroundcolor", "stabilitystatus", "category", createdat, updatedat;
+		INSERT INTO apps (tenantid, userid, "name", "description", "status", "dockerimagename", "dockerimagetag", "dockerimageregistry", "shmsize", "browserconfigurl", "browserconfigjwturl", "browserconfigjwtoidcclientid", "maxcpu", "mincpu", "maxmemory", "minmemory", "maxephemeralstorage", "minephemeralstorage", "iconurl", "iconbackgroundcolor", "stabilitystatus", "category", signaturestatus, signaturekeyid, signaturecheckedat, workspaceid, lifecyclestatus, replacementappid, createdat, updatedat)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, NOW(), NOW()) 
+		RETURNING id, tenantid, userid, "name", "description", "status", "dockerimagename", "dockerimagetag", "dockerimageregistry", "shmsize", "browserconfigurl", "browserconfigjwturl", "browserconfigjwtoidcclientid", "maxcpu", "mincpu", "maxmemory", "minmemory", "maxephemeralstorage", "minephemeralstorage", "iconurl", "iconbackgroundcolor", "stabilitystatus", "category", signaturestatus, signaturekeyid, signaturecheckedat, workspaceid, lifecyclestatus, replacementappid, createdat, updatedat;
 	`
 
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
 	var newApp model.App
-	err := s.db.GetContext(ctx, &newApp, appQuery,
-		tenantID, app.UserID, app.Name, app.Description, app.Status, app.DockerImageName, app.DockerImageTag, app.DockerImageRegistry, app.ShmSize, app.BrowserConfigURL, app.BrowserConfigJWTURL, app.BrowserConfigJWTOIDCClientID, app.MaxCPU, app.MinCPU, app.MaxMemory, app.MinMemory, app.MaxEphemeralStorage, app.MinEphemeralStorage, app.IconURL, app.IconBackgroundColor, app.StabilityStatus, app.Category,
+	err = tx.GetContext(ctx, &newApp, appQuery,
+		tenantID, app.UserID, app.Name, app.Description, app.Status, app.DockerImageName, app.DockerImageTag, app.DockerImageRegistry, app.ShmSize, app.BrowserConfigURL, app.BrowserConfigJWTURL, app.BrowserConfigJWTOIDCClientID, app.MaxCPU, app.MinCPU, app.MaxMemory, app.MinMemory, app.MaxEphemeralStorage, app.MinEphemeralStorage, app.IconURL, app.IconBackgroundColor, app.StabilityStatus, app.Category, app.SignatureStatus, app.SignatureKeyID, app.SignatureCheckedAt, app.WorkspaceID, app.LifecycleStatus, app.ReplacementAppID,
 	)
 	if err != nil {
+		return nil, common_storage.Rollback(tx, err)
+	}
+
+	if err := s.createInitialAppVersions(ctx, tx, &newApp, app.Versions); err != nil {
+		return nil, common_storage.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
 
@@ -114,9 +144,9 @@ func (s *AppStorage) BulkCreateApps(ctx context.Context, tenantID uint64, apps [
 	}()
 
 	const appQuery = `
-		INSERT INTO apps (tenantid, userid, name, description, status, dockerimagename, dockerimagetag, dockerimageregistry, shmsize, browserconfigurl, browserconfigjwturl, browserconfigjwtoidcclientid, maxcpu, mincpu, maxmemory, minmemory, maxephemeralstorage, minephemeralstorage, iconurl, iconbackgroundcolor, stabilitystatus, category, createdat, updatedat)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, NOW(), NOW()) 
-		RETURNING id, tenantid, userid, name, description, status, dockerimagename, dockerimagetag, dockerimageregistry, shmsize, browserconfigurl, browserconfigjwturl, browserconfigjwtoidcclientid, maxcpu, mincpu, maxmemory, minmemory, maxephemeralstorage, minephemeralstorage, iconurl, iconbackgroundcolor, stabilitystatus, category, createdat, updatedat;
+		INSERT INTO apps (tenantid, userid, name, description, status, dockerimagename, dockerimagetag, dockerimageregistry, shmsize, browserconfigurl, browserconfigjwturl, browserconfigjwtoidcclientid, maxcpu, mincpu, maxmemory, minmemory, maxephemeralstorage, minephemeralstorage, iconurl, iconbackgroundcolor, stabilitystatus, category, signaturestatus, signaturekeyid, signaturecheckedat, workspaceid, lifecyclestatus, replacementappid, createdat, updatedat)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, NOW(), NOW()) 
+		RETURNING id, tenantid, userid, name, description, status, dockerimagename, dockerimagetag, dockerimageregistry, shmsize, browserconfigurl, browserconfigjwturl, browserconfigjwtoidcclientid, maxcpu, mincpu, maxmemory, minmemory, maxephemeralstorage, minephemeralstorage, iconurl, iconbackgroundcolor, stabilitystatus, category, signaturestatus, signaturekeyid, signaturecheckedat, workspaceid, lifecyclestatus, replacementappid, createdat, updatedat;
 	`
 
 	var newApps []*model.App
@@ -138,10 +168,19 @@ func (s *AppStorage) BulkCreateApps(ctx context.Context, tenantID uint64, apps [
 			app.IconBackgroundColor,
 			app.StabilityStatus,
 			app.Category,
+			app.SignatureStatus,
+			app.SignatureKeyID,
+			app.SignatureCheckedAt,
+			app.WorkspaceID,
+			app.LifecycleStatus,
+			app.ReplacementAppID,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("unable to exec bulk insert: %w", err)
 		}
+		if err = s.createInitialAppVersions(ctx, tx, &newApp, app.Versions); err != nil {
+			return nil, err
+		}
 		newApps = append(newApps, &newApp)
 	}
 
@@ -152,24 +191,100 @@ func (s *AppStorage) BulkCreateApps(ctx context.Context, tenantID uint64, apps [
 	return newApps, nil
 }
 
-func (s *AppStorage) UpdateApp(ctx context.Context, tenantID uint64, app *model.App) (*model.App, error) {
+// UpdateApp updates an app. The stability status of the app is set by the catalog sync
+// and is kept. When event is not nil, the app is also moved to the stage of the event,
+// which is recorded.
+func (s *AppStorage) UpdateApp(ctx context.Context, tenantID uint64, app *model.App, event *model.AppLifecycleEvent) (*model.App, error) {
 	const appUpdateQuery = `
 		UPDATE apps
-		SET name = $3, description = $4, status = $5, dockerimagename = $6, dockerimagetag = $7, dockerimageregistry = $8, shmsize = $9, browserconfigurl = $10, browserconfigjwturl = $11, browserconfigjwtoidcclientid = $12, maxcpu = $13, mincpu = $14, maxmemory = $15, minmemory = $16, maxephemeralstorage = $17, minephemeralstorage = $18, iconurl = $19, iconbackgroundcolor = $20, stabilitystatus = $21, category = $22, updatedat = NOW()
+		SET name = $3, description = $4, status = $5, dockerimagename = $6, dockerimagetag = $7, dockerimageregistry = $8, shmsize = $9, browserconfigurl = $10, browserconfigjwturl = $11, browserconfigjwtoidcclientid = $12, maxcpu = $13, mincpu = $14, maxmemory = $15, minmemory = $16, maxephemeralstorage = $17, minephemeralstorage = $18, iconurl = $19, iconbackgroundcolor = $20, category = $21, signaturestatus = $22, signaturekeyid = $23, signaturecheckedat = $24, updatedat = NOW()
 		WHERE tenantid = $1 AND id = $2
-		RETURNING id, tenantid, userid, "name", "description", "status", "dockerimagename", "dockerimagetag", "dockerimageregistry", "shmsize", "browserconfigurl", "browserconfigjwturl", "browserconfigjwtoidcclientid", "maxcpu", "mincpu", "maxmemory", "minmemory", "maxephemeralstorage", "minephemeralstorage", "iconurl", "iconbackgroundcolor", "stabilitystatus", "category", createdat, updatedat;
+		RETURNING id, tenantid, userid, "name", "description", "status", "dockerimagename", "dockerimagetag", "dockerimageregistry", "shmsize", "browserconfigurl", "browserconfigjwturl", "browserconfigjwtoidcclientid", "maxcpu", "mincpu", "maxmemory", "minmemory", "maxephemeralstorage", "minephemeralstorage", "iconurl", "iconbackgroundcolor", "stabilitystatus", "category", signaturestatus, signaturekeyid, signaturecheckedat, workspaceid, lifecyclestatus, replacementappid, createdat, updatedat;
 	`
 
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
 	// Update app
 	var updatedApp model.App
-	err := s.db.GetContext(ctx, &updatedApp, appUpdateQuery, tenantID, app.ID, app.Name, app.Description, app.Status, app.DockerImageName, app.DockerImageTag, app.DockerImageRegistry, app.ShmSize, app.BrowserConfigURL, app.BrowserConfigJWTURL, app.BrowserConfigJWTOIDCClientID, app.MaxCPU, app.MinCPU, app.MaxMemory, app.MinMemory, app.MaxEphemeralStorage, app.MinEphemeralStorage, app.IconURL, app.IconBackgroundColor, app.StabilityStatus, app.Category)
+	err = tx.GetContext(ctx, &updatedApp, appUpdateQuery, tenantID, app.ID, app.Name, app.Description, app.Status, app.DockerImageName, app.DockerImageTag, app.DockerImageRegistry, app.ShmSize, app.BrowserConfigURL, app.BrowserConfigJWTURL, app.BrowserConfigJWTOIDCClientID, app.MaxCPU, app.MinCPU, app.MaxMemory, app.MinMemory, app.MaxEphemeralStorage, app.MinEphemeralStorage, app.IconURL, app.IconBackgroundColor, app.Category, app.SignatureStatus, app.SignatureKeyID, app.SignatureCheckedAt)
 	if err != nil {
+		return nil, common_storage.Rollback(tx, err)
+	}
+
+	if event != nil {
+		if err := s.moveAppLifecycle(ctx, tx, tenantID, event, nil); err != nil {
+			return nil, common_storage.Rollback(tx, err)
+		}
+		if _, err := s.insertAppLifecycleEvent(ctx, tx, tenantID, event); err != nil {
+			return nil, common_storage.Rollback(tx, err)
+		}
+		updatedApp.AppLifecycle = model.AppLifecycle{LifecycleStatus: event.ToStatus}
+	}
+
+	// The tag of the app is the tag of its default version.
+	if err := s.ensureDefaultAppVersion(ctx, tx, &updatedApp); err != nil {
+		return nil, common_storage.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
 
 	return &updatedApp, nil
 }
 
+// UpdateAppSignature saves the result of the last verification of the signature of the
+// image of an app.
+func (s *AppStorage) UpdateAppSignature(ctx context.Context, tenantID, appID uint64, signature model.AppSignature) error {
+	const query = `
+		UPDATE apps
+		SET signaturestatus = $3, signaturekeyid = $4, signaturecheckedat = $5
+		WHERE tenantid = $1 AND id = $2;
+	`
+
+	res, err := s.db.ExecContext(ctx, query, tenantID, appID, signature.SignatureStatus, signature.SignatureKeyID, signature.SignatureCheckedAt)
+	if err != nil {
+		return fmt.Errorf("unable to update signature of app %v: %w", appID, err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows != 1 {
+		return cerr.ErrNoRowsUpdated
+	}
+
+	return nil
+}
+
+// UpdateAppStatus sets the status of an app.
+func (s *AppStorage) UpdateAppStatus(ctx context.Context, tenantID, appID uint64, status model.AppStatus) error {
+	const query = `
+		UPDATE apps
+		SET status = $3, updatedat = NOW()
+		WHERE tenantid = $1 AND id = $2;
+	`
+
+	res, err := s.db.ExecContext(ctx, query, tenantID, appID, status)
+	if err != nil {
+		return fmt.Errorf("unable to update status of app %v: %w", appID, err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows != 1 {
+		return cerr.ErrNoRowsUpdated
+	}
+
+	return nil
+}
+
 func (s *AppStorage) DeleteApp(ctx context.Context, tenantID uint64, appID uint64) error {
 	const query = `
 		UPDATE apps	SET 
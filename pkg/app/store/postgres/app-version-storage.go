@@ -0,0 +1,230 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/app/model"
+	common_storage "github.com/CHORUS-TRE/chorus-backend/pkg/common/storage"
+)
+
+const appVersionColumns = `id, tenantid, appid, tag, digest, releasenotes, isdefault, status,
+	scanstatus, scancritical, scanhigh, scanmedium, scanlow, scanunknown, scannedat,
+	createdat, updatedat, deprecatedat`
+
+// ListAppVersions lists the versions of the given apps, most recent first.
+func (s *AppStorage) ListAppVersions(ctx context.Context, tenantID uint64, appIDs []uint64) ([]*model.AppVersion, error) {
+	query := `
+		SELECT ` + appVersionColumns + `
+		FROM app_versions
+		WHERE tenantid = $1 AND appid = ANY($2)
+		ORDER BY appid, createdat DESC, id DESC;
+	`
+
+	var versions []*model.AppVersion
+	if err := s.db.SelectContext(ctx, &versions, query, tenantID, common_storage.Uint64ToPqInt64(appIDs)); err != nil {
+		return nil, err
+	}
+
+	return versions, nil
+}
+
+func (s *AppStorage) GetAppVersion(ctx context.Context, tenantID uint64, versionID uint64) (*model.AppVersion, error) {
+	query := `
+		SELECT ` + appVersionColumns + `
+		FROM app_versions
+		WHERE tenantid = $1 AND id = $2;
+	`
+
+	var version model.AppVersion
+	if err := s.db.GetContext(ctx, &version, query, tenantID, versionID); err != nil {
+		return nil, err
+	}
+
+	return &version, nil
+}
+
+// CreateAppVersion adds a version to an app, and makes it the default version of the
+// app when it is flagged as such. When event is not nil, the app is also moved to the
+// stage of the event, which is recorded.
+func (s *AppStorage) CreateAppVersion(ctx context.Context, tenantID uint64, version *model.AppVersion, event *model.AppLifecycleEvent) (*model.AppVersion, error) {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	newVersion, err := s.insertAppVersion(ctx, tx, tenantID, version.AppID, version)
+	if err != nil {
+		return nil, common_storage.Rollback(tx, err)
+	}
+
+	if version.IsDefault {
+		if err := s.setDefaultAppVersion(ctx, tx, tenantID, version.AppID, newVersion.ID); err != nil {
+			return nil, common_storage.Rollback(tx, err)
+		}
+		newVersion.IsDefault = true
+	}
+
+	if event != nil {
+		if err := s.moveAppLifecycle(ctx, tx, tenantID, event, nil); err != nil {
+			return nil, common_storage.Rollback(tx, err)
+		}
+		if _, err := s.insertAppLifecycleEvent(ctx, tx, tenantID, event); err != nil {
+			return nil, common_storage.Rollback(tx, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return newVersion, nil
+}
+
+// UpdateAppVersion updates the release notes and the status of a version, and makes it
+// the default version of its app when it is flagged as such.
+func (s *AppStorage) UpdateAppVersion(ctx context.Context, tenantID uint64, version *model.AppVersion) (*model.AppVersion, error) {
+	query := `
+		UPDATE app_versions
+		SET releasenotes = $3, status = $4, updatedat = NOW(),
+		    deprecatedat = CASE WHEN $4 = 'deprecated' THEN COALESCE(deprecatedat, NOW()) ELSE NULL END
+		WHERE tenantid = $1 AND id = $2
+		RETURNING ` + appVersionColumns + `;
+	`
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var updatedVersion model.AppVersion
+	if err := tx.GetContext(ctx, &updatedVersion, query, tenantID, version.ID, version.ReleaseNotes, version.Status); err != nil {
+		return nil, common_storage.Rollback(tx, err)
+	}
+
+	if version.IsDefault && !updatedVersion.IsDefault {
+		if err := s.setDefaultAppVersion(ctx, tx, tenantID, updatedVersion.AppID, updatedVersion.ID); err != nil {
+			return nil, common_storage.Rollback(tx, err)
+		}
+		updatedVersion.IsDefault = true
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &updatedVersion, nil
+}
+
+// UpdateAppVersionScan saves the summary of the last vulnerability scan of a version.
+func (s *AppStorage) UpdateAppVersionScan(ctx context.Context, tenantID, versionID uint64, scan model.VulnerabilityScan) error {
+	const query = `
+		UPDATE app_versions
+		SET scanstatus = $3, scancritical = $4, scanhigh = $5, scanmedium = $6, scanlow = $7,
+		    scanunknown = $8, scannedat = $9
+		WHERE tenantid = $1 AND id = $2;
+	`
+
+	res, err := s.db.ExecContext(ctx, query, tenantID, versionID,
+		scan.ScanStatus, scan.ScanCritical, scan.ScanHigh, scan.ScanMedium, scan.ScanLow,
+		scan.ScanUnknown, scan.ScannedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to update scan of version %v: %w", versionID, err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows != 1 {
+		return cerr.ErrNoRowsUpdated
+	}
+
+	return nil
+}
+
+func (s *AppStorage) insertAppVersion(ctx context.Context, tx *sqlx.Tx, tenantID, appID uint64, version *model.AppVersion) (*model.AppVersion, error) {
+	query := `
+		INSERT INTO app_versions (tenantid, appid, tag, digest, releasenotes, isdefault, status, createdat, updatedat)
+		VALUES ($1, $2, $3, $4, $5, FALSE, $6, NOW(), NOW())
+		RETURNING ` + appVersionColumns + `;
+	`
+
+	var newVersion model.AppVersion
+	if err := tx.GetContext(ctx, &newVersion, query, tenantID, appID, version.Tag, version.Digest, version.ReleaseNotes, model.AppVersionActive); err != nil {
+		return nil, fmt.Errorf("unable to insert version %q of app %v: %w", version.Tag, appID, err)
+	}
+
+	return &newVersion, nil
+}
+
+// setDefaultAppVersion makes a version the default version of its app and copies its tag
+// to the app.
+func (s *AppStorage) setDefaultAppVersion(ctx context.Context, tx *sqlx.Tx, tenantID, appID, versionID uint64) error {
+	const clearQuery = `
+		UPDATE app_versions SET isdefault = FALSE, updatedat = NOW()
+		WHERE tenantid = $1 AND appid = $2 AND isdefault AND id != $3;
+	`
+	const setQuery = `
+		UPDATE app_versions SET isdefault = TRUE, updatedat = NOW()
+		WHERE tenantid = $1 AND appid = $2 AND id = $3;
+	`
+	const appQuery = `
+		UPDATE apps SET dockerimagetag = v.tag, updatedat = NOW()
+		FROM app_versions v
+		WHERE apps.tenantid = $1 AND apps.id = $2 AND v.id = $3;
+	`
+
+	for _, query := range []string{clearQuery, setQuery, appQuery} {
+		if _, err := tx.ExecContext(ctx, query, tenantID, appID, versionID); err != nil {
+			return fmt.Errorf("unable to set default version %v of app %v: %w", versionID, appID, err)
+		}
+	}
+
+	return nil
+}
+
+// createInitialAppVersions inserts the versions of an app that has just been created. An
+// app created without versions gets its tag as its only version. The version matching the
+// tag of the app becomes its default version.
+func (s *AppStorage) createInitialAppVersions(ctx context.Context, tx *sqlx.Tx, app *model.App, versions []*model.AppVersion) error {
+	if len(versions) == 0 {
+		versions = []*model.AppVersion{{Tag: app.DockerImageTag}}
+	}
+
+	for _, version := range versions {
+		newVersion, err := s.insertAppVersion(ctx, tx, app.TenantID, app.ID, version)
+		if err != nil {
+			return err
+		}
+		if newVersion.Tag == app.DockerImageTag {
+			if err := s.setDefaultAppVersion(ctx, tx, app.TenantID, app.ID, newVersion.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// ensureDefaultAppVersion makes the version with the tag of an updated app its default
+// version, adding the version when the app moved to a new tag.
+func (s *AppStorage) ensureDefaultAppVersion(ctx context.Context, tx *sqlx.Tx, app *model.App) error {
+	const query = `
+		INSERT INTO app_versions (tenantid, appid, tag, status, createdat, updatedat)
+		VALUES ($1, $2, $3, $4, NOW(), NOW())
+		ON CONFLICT (tenantid, appid, tag) DO UPDATE SET tag = EXCLUDED.tag
+		RETURNING id;
+	`
+
+	var versionID uint64
+	if err := tx.GetContext(ctx, &versionID, query, app.TenantID, app.ID, app.DockerImageTag, model.AppVersionActive); err != nil {
+		return fmt.Errorf("unable to upsert version %q of app %v: %w", app.DockerImageTag, app.ID, err)
+	}
+
+	return s.setDefaultAppVersion(ctx, tx, app.TenantID, app.ID, versionID)
+}
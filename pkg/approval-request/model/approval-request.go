@@ -3,6 +3,9 @@ package model
 import (
 	"fmt"
 	"time"
+
+	authz "github.com/CHORUS-TRE/chorus-backend/pkg/authorization/model"
+	workspace_model "github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
 )
 
 type ApprovalRequest struct {
@@ -39,12 +42,19 @@ type ApprovalStepDecision struct {
 }
 
 // ApprovalStep is one independently-approved part of a request: "download"
-// (data leaving the source) or "upload" (data entering the destination).
+// (data leaving the source), "upload" (data entering the destination) or
+// "access" (a user joining a workspace) or "network_policy" (a workspace
+// network policy being relaxed) or "custom_app" (a custom app being added to
+// a workspace).
 type ApprovalStep string
 
 const (
 	StepDownload ApprovalStep = "download"
 	StepUpload   ApprovalStep = "upload"
+	StepAccess   ApprovalStep = "access"
+
+	StepNetworkPolicy ApprovalStep = "network_policy"
+	StepCustomApp     ApprovalStep = "custom_app"
 )
 
 // StepsForType returns the ordered list of steps that must be approved
@@ -55,6 +65,12 @@ func StepsForType(t ApprovalRequestType) []ApprovalStep {
 		return []ApprovalStep{StepDownload}
 	case ApprovalRequestTypeDataTransfer:
 		return []ApprovalStep{StepDownload, StepUpload}
+	case ApprovalRequestTypeWorkspaceAccess:
+		return []ApprovalStep{StepAccess}
+	case ApprovalRequestTypeNetworkPolicyChange:
+		return []ApprovalStep{StepNetworkPolicy}
+	case ApprovalRequestTypeCustomApp:
+		return []ApprovalStep{StepCustomApp}
 	default:
 		return nil
 	}
@@ -69,8 +85,12 @@ type ApprovalRequestCounts struct {
 }
 
 type ApprovalRequestDetails struct {
-	DataExtractionDetails *DataExtractionDetails `json:"data_extraction_details,omitempty"`
-	DataTransferDetails   *DataTransferDetails   `json:"data_transfer_details,omitempty"`
+	DataExtractionDetails  *DataExtractionDetails  `json:"data_extraction_details,omitempty"`
+	DataTransferDetails    *DataTransferDetails    `json:"data_transfer_details,omitempty"`
+	WorkspaceAccessDetails *WorkspaceAccessDetails `json:"workspace_access_details,omitempty"`
+
+	NetworkPolicyChangeDetails *NetworkPolicyChangeDetails `json:"network_policy_change_details,omitempty"`
+	CustomAppDetails           *CustomAppDetails           `json:"custom_app_details,omitempty"`
 }
 
 type DataExtractionDetails struct {
@@ -84,6 +104,32 @@ type DataTransferDetails struct {
 	Files                  []ApprovalRequestFile `json:"files"`
 }
 
+// WorkspaceAccessDetails describes a request to join a public workspace with a given role.
+type WorkspaceAccessDetails struct {
+	WorkspaceID   uint64         `json:"workspace_id"`
+	Role          authz.RoleName `json:"role"`
+	Justification string         `json:"justification"`
+}
+
+// NetworkPolicyChangeDetails describes a pending relaxation of the network policy of a
+// workspace, along with the policy in place when it was requested.
+type NetworkPolicyChangeDetails struct {
+	WorkspaceID          uint64                    `json:"workspace_id"`
+	CurrentNetworkPolicy string                    `json:"current_network_policy"`
+	CurrentAllowedFQDNs  workspace_model.FQDNRules `json:"current_allowed_fqdns"`
+	NetworkPolicy        string                    `json:"network_policy"`
+	AllowedFQDNs         workspace_model.FQDNRules `json:"allowed_fqdns"`
+}
+
+// CustomAppDetails describes a custom app registered by the maintainers of a workspace,
+// pending until an app store admin approves it.
+type CustomAppDetails struct {
+	WorkspaceID uint64 `json:"workspace_id"`
+	AppID       uint64 `json:"app_id"`
+	AppName     string `json:"app_name"`
+	Image       string `json:"image"`
+}
+
 func (r *ApprovalRequest) IsFinalState() bool {
 	return r.Status == ApprovalRequestStatusApproved ||
 		r.Status == ApprovalRequestStatusRejected ||
@@ -202,6 +248,24 @@ func (r *ApprovalRequest) GetSourceWorkspaceID() uint64 {
 	return 0
 }
 
+// GetWorkspaceIDs returns the workspaces a request is about.
+func (r *ApprovalRequest) GetWorkspaceIDs() []uint64 {
+	switch {
+	case r.Details.DataExtractionDetails != nil:
+		return []uint64{r.Details.DataExtractionDetails.SourceWorkspaceID}
+	case r.Details.DataTransferDetails != nil:
+		return []uint64{r.Details.DataTransferDetails.SourceWorkspaceID, r.Details.DataTransferDetails.DestinationWorkspaceID}
+	case r.Details.WorkspaceAccessDetails != nil:
+		return []uint64{r.Details.WorkspaceAccessDetails.WorkspaceID}
+	case r.Details.NetworkPolicyChangeDetails != nil:
+		return []uint64{r.Details.NetworkPolicyChangeDetails.WorkspaceID}
+	case r.Details.CustomAppDetails != nil:
+		return []uint64{r.Details.CustomAppDetails.WorkspaceID}
+	default:
+		return nil
+	}
+}
+
 func GetApprovalRequestStoragePath(requestID uint64) string {
 	return fmt.Sprintf("approval-request-%v", requestID)
 }
@@ -226,15 +290,22 @@ type ApprovalRequestFile struct {
 type ApprovalRequestType string
 
 const (
-	ApprovalRequestTypeUnspecified    ApprovalRequestType = ""
-	ApprovalRequestTypeDataExtraction ApprovalRequestType = "data_extraction"
-	ApprovalRequestTypeDataTransfer   ApprovalRequestType = "data_transfer"
+	ApprovalRequestTypeUnspecified     ApprovalRequestType = ""
+	ApprovalRequestTypeDataExtraction  ApprovalRequestType = "data_extraction"
+	ApprovalRequestTypeDataTransfer    ApprovalRequestType = "data_transfer"
+	ApprovalRequestTypeWorkspaceAccess ApprovalRequestType = "workspace_access"
+
+	ApprovalRequestTypeNetworkPolicyChange ApprovalRequestType = "network_policy_change"
+	ApprovalRequestTypeCustomApp           ApprovalRequestType = "custom_app"
 )
 
 func ApprovalRequestTypes() []ApprovalRequestType {
 	return []ApprovalRequestType{
 		ApprovalRequestTypeDataExtraction,
 		ApprovalRequestTypeDataTransfer,
+		ApprovalRequestTypeWorkspaceAccess,
+		ApprovalRequestTypeNetworkPolicyChange,
+		ApprovalRequestTypeCustomApp,
 	}
 }
 
@@ -248,6 +319,12 @@ func ToApprovalRequestType(s string) (ApprovalRequestType, error) {
 		return ApprovalRequestTypeDataExtraction, nil
 	case string(ApprovalRequestTypeDataTransfer), "APPROVAL_REQUEST_TYPE_DATA_TRANSFER":
 		return ApprovalRequestTypeDataTransfer, nil
+	case string(ApprovalRequestTypeWorkspaceAccess), "APPROVAL_REQUEST_TYPE_WORKSPACE_ACCESS":
+		return ApprovalRequestTypeWorkspaceAccess, nil
+	case string(ApprovalRequestTypeNetworkPolicyChange), "APPROVAL_REQUEST_TYPE_NETWORK_POLICY_CHANGE":
+		return ApprovalRequestTypeNetworkPolicyChange, nil
+	case string(ApprovalRequestTypeCustomApp), "APPROVAL_REQUEST_TYPE_CUSTOM_APP":
+		return ApprovalRequestTypeCustomApp, nil
 	case "", "APPROVAL_REQUEST_TYPE_UNSPECIFIED":
 		return ApprovalRequestTypeUnspecified, nil
 	default:
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"path"
+	"slices"
 	"strings"
 	"time"
 
@@ -18,6 +19,7 @@ import (
 	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
 	notification_model "github.com/CHORUS-TRE/chorus-backend/pkg/notification/model"
 	workspace_file_service "github.com/CHORUS-TRE/chorus-backend/pkg/workspace-file/service"
+	workspace_model "github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
 	"go.uber.org/zap"
 )
 
@@ -38,6 +40,7 @@ type ApprovalRequester interface {
 	CountMyApprovalRequests(ctx context.Context, tenantID, userID uint64) (*model.ApprovalRequestCounts, error)
 	CreateDataExtractionRequest(ctx context.Context, request *model.ApprovalRequest, filePaths []string) (*model.ApprovalRequest, error)
 	CreateDataTransferRequest(ctx context.Context, request *model.ApprovalRequest, filePaths []string) (*model.ApprovalRequest, error)
+	CreateWorkspaceAccessRequest(ctx context.Context, request *model.ApprovalRequest) (*model.ApprovalRequest, error)
 	ApproveApprovalRequest(ctx context.Context, tenantID, requestID, userID uint64, approve bool) (*model.ApprovalRequest, error)
 	DeleteApprovalRequest(ctx context.Context, tenantID, requestID, userID uint64) error
 	DownloadApprovalRequestFile(ctx context.Context, tenantID, requestID uint64, filePath string) (*model.ApprovalRequestFile, []byte, error)
@@ -60,12 +63,29 @@ type UserPermissionFinder interface {
 	FindUsersWithPermission(ctx context.Context, tenantID uint64, filter authz.FindUsersWithPermissionFilter) ([]uint64, error)
 }
 
+type WorkspaceReader interface {
+	GetWorkspace(ctx context.Context, tenantID, workspaceID uint64) (*workspace_model.Workspace, error)
+}
+
+type WorkspaceUpdater interface {
+	GrantUserRoleInWorkspace(ctx context.Context, tenantID, userID, workspaceID uint64, roleName authz.RoleName) (bool, error)
+	UpdateWorkspaceNetworkPolicy(ctx context.Context, tenantID, workspaceID uint64, networkPolicy workspace_model.NetworkPolicyMode, allowedFQDNs workspace_model.FQDNRules) (*workspace_model.Workspace, error)
+}
+
+// AppApprover makes the custom apps of workspaces launchable once they are approved.
+type AppApprover interface {
+	ApproveWorkspaceApp(ctx context.Context, tenantID, appID uint64) error
+}
+
 type ApprovalRequestService struct {
 	store                ApprovalRequestStore
 	workspaceFileStore   workspace_file_service.WorkspaceFiler
 	stagingFileStore     filestore.FileStore
 	notificationStore    NotificationStore
 	userPermissionFinder UserPermissionFinder
+	workspaceReader      WorkspaceReader
+	workspaceUpdater     WorkspaceUpdater
+	appApprover          AppApprover
 	cfg                  config.Config
 }
 
@@ -75,6 +95,9 @@ func NewApprovalRequestService(
 	stagingFileStore filestore.FileStore,
 	notificationStore NotificationStore,
 	userPermissionFinder UserPermissionFinder,
+	workspaceReader WorkspaceReader,
+	workspaceUpdater WorkspaceUpdater,
+	appApprover AppApprover,
 	cfg config.Config,
 ) *ApprovalRequestService {
 	return &ApprovalRequestService{
@@ -83,6 +106,9 @@ func NewApprovalRequestService(
 		stagingFileStore:     stagingFileStore,
 		notificationStore:    notificationStore,
 		userPermissionFinder: userPermissionFinder,
+		workspaceReader:      workspaceReader,
+		workspaceUpdater:     workspaceUpdater,
+		appApprover:          appApprover,
 		cfg:                  cfg,
 	}
 }
@@ -117,6 +143,10 @@ func (s *ApprovalRequestService) CreateDataExtractionRequest(ctx context.Context
 		return nil, cerr.ErrInvalidRequest.WithMessage("Invalid details type for data extraction request")
 	}
 
+	if err := s.checkSourceWorkspace(ctx, request.TenantID, details.SourceWorkspaceID); err != nil {
+		return nil, err
+	}
+
 	approversByStep, canAutoApprove, err := s.findApproversForDataExtractionRequest(ctx, request.TenantID, request.RequesterID, details.SourceWorkspaceID)
 	if err != nil {
 		return nil, err
@@ -232,6 +262,17 @@ func (s *ApprovalRequestService) CreateDataTransferRequest(ctx context.Context,
 		return nil, cerr.ErrInvalidRequest.WithMessage("Destination workspace ID is required for data transfer requests")
 	}
 
+	if err := s.checkSourceWorkspace(ctx, request.TenantID, details.SourceWorkspaceID); err != nil {
+		return nil, err
+	}
+	destination, err := s.workspaceReader.GetWorkspace(ctx, request.TenantID, details.DestinationWorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if destination.Status.IsReadOnly() {
+		return nil, cerr.ErrReadOnly.WithMessage(fmt.Sprintf("Destination workspace %v is %v and cannot receive files", destination.ID, destination.Status))
+	}
+
 	approversByStep, canAutoApprove, err := s.findApproversForDataTransferRequest(ctx, request.TenantID, request.RequesterID, details.SourceWorkspaceID, details.DestinationWorkspaceID)
 	if err != nil {
 		return nil, err
@@ -353,6 +394,133 @@ func (s *ApprovalRequestService) findApproversForDataTransferRequest(ctx context
 	}, requesterCanApprove, nil
 }
 
+// CreateWorkspaceAccessRequest creates an approval request to join a public workspace
+// with a workspace role. The contact user of the workspace and its admins are the
+// approvers; once approved, the role is granted to the requester.
+func (s *ApprovalRequestService) CreateWorkspaceAccessRequest(ctx context.Context, request *model.ApprovalRequest) (*model.ApprovalRequest, error) {
+	request.Type = model.ApprovalRequestTypeWorkspaceAccess
+
+	details := request.Details.WorkspaceAccessDetails
+	if details == nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Invalid details type for workspace access request")
+	}
+
+	if !s.isRequestableWorkspaceRole(details.Role) {
+		return nil, cerr.ErrInvalidRequest.WithMessage(fmt.Sprintf("Role %v cannot be requested to join a workspace", details.Role))
+	}
+
+	workspace, err := s.workspaceReader.GetWorkspace(ctx, request.TenantID, details.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if workspace.Visibility != workspace_model.WorkspaceVisibilityPublic {
+		return nil, cerr.ErrPermissionDenied.WithMessage(fmt.Sprintf("Workspace %v does not accept access requests", workspace.ID))
+	}
+	if workspace.Status.IsReadOnly() {
+		return nil, cerr.ErrReadOnly.WithMessage(fmt.Sprintf("Workspace %v is %v and cannot accept new members", workspace.ID, workspace.Status))
+	}
+
+	statuses := []model.ApprovalRequestStatus{model.ApprovalRequestStatusPending}
+	types := []model.ApprovalRequestType{model.ApprovalRequestTypeWorkspaceAccess}
+	pending, _, err := s.store.ListApprovalRequests(ctx, request.TenantID, request.RequesterID, nil, ApprovalRequestFilter{
+		StatusesIn:  &statuses,
+		TypesIn:     &types,
+		WorkspaceID: &workspace.ID,
+		RequesterID: &request.RequesterID,
+	})
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, "Unable to list approval requests")
+	}
+	if len(pending) > 0 {
+		return nil, cerr.ErrAlreadyExists.WithMessage(fmt.Sprintf("An access request to workspace %v is already pending", workspace.ID))
+	}
+
+	approvers, err := s.findApproversForWorkspaceAccessRequest(ctx, workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Title == "" {
+		request.Title = fmt.Sprintf("Access to workspace %s", workspace.Name)
+	}
+	request.Status = model.ApprovalRequestStatusPending
+	request.ApproverIDsByStep = map[model.ApprovalStep][]uint64{
+		model.StepAccess: approvers,
+	}
+
+	createdRequest, err := s.store.CreateApprovalRequest(ctx, request.TenantID, request)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, "Unable to create approval request")
+	}
+
+	for _, approverID := range approvers {
+		err = s.notificationStore.CreateNotification(ctx, &notification_model.Notification{
+			TenantID: request.TenantID,
+			UserID:   approverID,
+			Message:  fmt.Sprintf("Approval request '%s' has been created and is pending your approval.", request.Title),
+			Content: notification_model.NotificationContent{
+				Type: "ApprovalRequestNotification",
+				ApprovalRequest: &notification_model.ApprovalRequestNotification{
+					ApprovalRequestID: createdRequest.ID,
+				},
+			},
+		}, []uint64{approverID})
+		if err != nil {
+			logger.TechLog.Error(ctx, "Unable to create notification", zap.Uint64("tenant_id", request.TenantID), zap.Uint64("request_id", createdRequest.ID), zap.Uint64("user_id", approverID))
+		}
+	}
+
+	return createdRequest, nil
+}
+
+// findApproversForWorkspaceAccessRequest returns the contact user of the workspace
+// followed by its admins.
+func (s *ApprovalRequestService) findApproversForWorkspaceAccessRequest(ctx context.Context, workspace *workspace_model.Workspace) ([]uint64, error) {
+	admins, err := s.userPermissionFinder.FindUsersWithPermission(ctx, workspace.TenantID, authz.FindUsersWithPermissionFilter{
+		PermissionName:          authz.PermManageUsersInWorkspace.Name,
+		Context:                 authz.Context{authz.ContextWorkspace: fmt.Sprintf("%d", workspace.ID)},
+		ViaRoles:                []authz.RoleName{authz.RoleWorkspaceAdmin.Name},
+		PreferExactContextMatch: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var approvers []uint64
+	if contactID := workspace.GetContactUserID(); contactID != 0 {
+		approvers = append(approvers, contactID)
+	}
+	for _, id := range admins {
+		if !containsID(approvers, id) {
+			approvers = append(approvers, id)
+		}
+	}
+
+	if len(approvers) == 0 {
+		return nil, cerr.ErrInvalidRequest.WithMessage("No contact user or workspace admin found for this workspace")
+	}
+
+	return approvers, nil
+}
+
+// isRequestableWorkspaceRole reports whether the role is one of the workspace roles
+// users may ask for, as configured in workspace_access_roles.
+func (s *ApprovalRequestService) isRequestableWorkspaceRole(role authz.RoleName) bool {
+	return slices.Contains(s.cfg.Services.ApprovalRequestService.WorkspaceAccessRoles, string(role))
+}
+
+// checkSourceWorkspace refuses requests that would take files out of a frozen workspace.
+func (s *ApprovalRequestService) checkSourceWorkspace(ctx context.Context, tenantID, workspaceID uint64) error {
+	workspace, err := s.workspaceReader.GetWorkspace(ctx, tenantID, workspaceID)
+	if err != nil {
+		return err
+	}
+	if workspace.Status == workspace_model.WorkspaceStatusFrozen {
+		return cerr.ErrReadOnly.WithMessage(fmt.Sprintf("Workspace %v is frozen, no files can be requested from it until it is unfrozen", workspaceID))
+	}
+	return nil
+}
+
 // containsID reports whether ids contains target.
 func containsID(ids []uint64, target uint64) bool {
 	for _, id := range ids {
@@ -389,6 +557,38 @@ func (s *ApprovalRequestService) ApproveApprovalRequest(ctx context.Context, ten
 		return nil, cerr.ErrInvalidRequest.WithMessage("Request is not pending approval")
 	}
 
+	if sourceID := request.GetSourceWorkspaceID(); approve && sourceID != 0 {
+		if err := s.checkSourceWorkspace(ctx, tenantID, sourceID); err != nil {
+			return nil, err
+		}
+	}
+
+	if details := request.Details.WorkspaceAccessDetails; approve && details != nil {
+		if !s.isRequestableWorkspaceRole(details.Role) {
+			return nil, cerr.ErrPermissionDenied.WithMessage(fmt.Sprintf("Role %v cannot be granted through an access request", details.Role))
+		}
+		workspace, err := s.workspaceReader.GetWorkspace(ctx, tenantID, details.WorkspaceID)
+		if err != nil {
+			return nil, err
+		}
+		if workspace.Status.IsReadOnly() {
+			return nil, cerr.ErrReadOnly.WithMessage(fmt.Sprintf("Workspace %v is %v and cannot accept new members", workspace.ID, workspace.Status))
+		}
+	}
+
+	if details := request.Details.NetworkPolicyChangeDetails; approve && details != nil {
+		workspace, err := s.workspaceReader.GetWorkspace(ctx, tenantID, details.WorkspaceID)
+		if err != nil {
+			return nil, err
+		}
+		if workspace.Status.IsReadOnly() {
+			return nil, cerr.ErrReadOnly.WithMessage(fmt.Sprintf("Workspace %v is %v and its network policy cannot be changed", workspace.ID, workspace.Status))
+		}
+		if err := checkNetworkPolicyUnchanged(workspace, *details); err != nil {
+			return nil, err
+		}
+	}
+
 	// Determine which steps this user is entitled to decide on (and that have
 	// not already been decided). If empty, the user cannot act on this request.
 	stepsToDecide := request.StepsToApprove(userID)
@@ -535,6 +735,32 @@ func (s *ApprovalRequestService) executeApprovedRequest(ctx context.Context, req
 			return cerr.ErrInvalidRequest.WithMessage("Invalid details type for data transfer request")
 		}
 		return s.copyFilesToDestinationWorkspace(ctx, *details)
+	case model.ApprovalRequestTypeWorkspaceAccess:
+		details := request.Details.WorkspaceAccessDetails
+		if details == nil {
+			return cerr.ErrInvalidRequest.WithMessage("Invalid details type for workspace access request")
+		}
+		return s.addRequesterToWorkspace(ctx, request.TenantID, request.RequesterID, *details)
+	case model.ApprovalRequestTypeNetworkPolicyChange:
+		details := request.Details.NetworkPolicyChangeDetails
+		if details == nil {
+			return cerr.ErrInvalidRequest.WithMessage("Invalid details type for network policy change request")
+		}
+		workspace, err := s.workspaceReader.GetWorkspace(ctx, request.TenantID, details.WorkspaceID)
+		if err != nil {
+			return err
+		}
+		if err := checkNetworkPolicyUnchanged(workspace, *details); err != nil {
+			return err
+		}
+		_, err = s.workspaceUpdater.UpdateWorkspaceNetworkPolicy(ctx, request.TenantID, details.WorkspaceID, workspace_model.NetworkPolicyMode(details.NetworkPolicy), details.AllowedFQDNs)
+		return err
+	case model.ApprovalRequestTypeCustomApp:
+		details := request.Details.CustomAppDetails
+		if details == nil {
+			return cerr.ErrInvalidRequest.WithMessage("Invalid details type for custom app request")
+		}
+		return s.appApprover.ApproveWorkspaceApp(ctx, request.TenantID, details.AppID)
 	default:
 		return cerr.ErrInvalidRequest.WithMessage(fmt.Sprintf("Unsupported request type: %s", request.Type))
 	}
@@ -578,6 +804,22 @@ func (s *ApprovalRequestService) copyFilesToDestinationWorkspace(ctx context.Con
 	return nil
 }
 
+// checkNetworkPolicyUnchanged refuses network policy changes requested against a policy
+// the workspace no longer has, so that approving a stale request cannot undo a later change.
+func checkNetworkPolicyUnchanged(workspace *workspace_model.Workspace, details model.NetworkPolicyChangeDetails) error {
+	if workspace.NetworkPolicy.String() != details.CurrentNetworkPolicy || !workspace.AllowedFQDNs.Equal(details.CurrentAllowedFQDNs) {
+		return cerr.ErrInvalidRequest.WithMessage(fmt.Sprintf("The network policy of workspace %v changed since this request was made, a new request is needed", workspace.ID))
+	}
+	return nil
+}
+
+// addRequesterToWorkspace grants the requested role to the requester of an approved
+// workspace access request. Holding the role already is not an error.
+func (s *ApprovalRequestService) addRequesterToWorkspace(ctx context.Context, tenantID, requesterID uint64, details model.WorkspaceAccessDetails) error {
+	_, err := s.workspaceUpdater.GrantUserRoleInWorkspace(ctx, tenantID, requesterID, details.WorkspaceID, details.Role)
+	return err
+}
+
 // appendUUIDToFilename inserts an uuid suffix before the file extension.
 // e.g. "workspace-archive/hello.txt" -> "workspace-archive/hello_<uuid>.txt"
 func appendUUIDToFilename(filePath string) string {
@@ -122,6 +122,25 @@ func (c approvalRequestServiceLogging) CreateDataTransferRequest(ctx context.Con
 	return res, nil
 }
 
+func (c approvalRequestServiceLogging) CreateWorkspaceAccessRequest(ctx context.Context, request *model.ApprovalRequest) (*model.ApprovalRequest, error) {
+	now := time.Now()
+
+	res, err := c.next.CreateWorkspaceAccessRequest(ctx, request)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		zap.Uint64("request_id", res.ID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
 func (c approvalRequestServiceLogging) ApproveApprovalRequest(ctx context.Context, tenantID, requestID, userID uint64, approve bool) (*model.ApprovalRequest, error) {
 	now := time.Now()
 
@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"context"
+	"strings"
 
 	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/approval-request/model"
@@ -80,6 +81,26 @@ func (v validation) CreateDataTransferRequest(ctx context.Context, request *mode
 	return v.next.CreateDataTransferRequest(ctx, request, filePaths)
 }
 
+func (v validation) CreateWorkspaceAccessRequest(ctx context.Context, request *model.ApprovalRequest) (*model.ApprovalRequest, error) {
+	if request == nil {
+		return nil, cerr.ErrValidation.WithMessage("Request is required")
+	}
+	details := request.Details.WorkspaceAccessDetails
+	if details == nil {
+		return nil, cerr.ErrValidation.WithMessage("Workspace access details are required")
+	}
+	if details.WorkspaceID == 0 {
+		return nil, cerr.ErrValidation.WithMessage("Workspace ID is required")
+	}
+	if details.Role == "" {
+		return nil, cerr.ErrValidation.WithMessage("Role is required")
+	}
+	if strings.TrimSpace(details.Justification) == "" {
+		return nil, cerr.ErrValidation.WithMessage("A justification is required")
+	}
+	return v.next.CreateWorkspaceAccessRequest(ctx, request)
+}
+
 func (v validation) ApproveApprovalRequest(ctx context.Context, tenantID, requestID, userID uint64, approve bool) (*model.ApprovalRequest, error) {
 	if requestID == 0 {
 		return nil, cerr.ErrValidation.WithMessage("Request ID is required")
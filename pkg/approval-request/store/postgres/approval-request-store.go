@@ -162,7 +162,7 @@ func (s *ApprovalRequestStorage) ListApprovalRequests(ctx context.Context, tenan
 
 	if filter.WorkspaceID != nil {
 		args = append(args, *filter.WorkspaceID)
-		whereClause += fmt.Sprintf(" AND (details->'data_extraction_details'->>'source_workspace_id' = $%d::TEXT OR details->'data_transfer_details'->>'source_workspace_id' = $%d::TEXT OR details->'data_transfer_details'->>'destination_workspace_id' = $%d::TEXT)", len(args), len(args), len(args))
+		whereClause += fmt.Sprintf(" AND (details->'data_extraction_details'->>'source_workspace_id' = $%d::TEXT OR details->'data_transfer_details'->>'source_workspace_id' = $%d::TEXT OR details->'data_transfer_details'->>'destination_workspace_id' = $%d::TEXT OR details->'workspace_access_details'->>'workspace_id' = $%d::TEXT OR details->'network_policy_change_details'->>'workspace_id' = $%d::TEXT OR details->'custom_app_details'->>'workspace_id' = $%d::TEXT)", len(args), len(args), len(args), len(args), len(args), len(args))
 	}
 
 	if filter.PendingApproval != nil && *filter.PendingApproval {
@@ -34,6 +34,10 @@ const (
 	AuditActionWorkspaceMemberAdd    AuditAction = "AddWorkspaceMember"
 	AuditActionWorkspaceMemberUpdate AuditAction = "UpdateWorkspaceMember"
 	AuditActionWorkspaceMemberRemove AuditAction = "RemoveWorkspaceMember"
+	AuditActionWorkspaceExtend       AuditAction = "ExtendWorkspace"
+	AuditActionWorkspaceArchive      AuditAction = "ArchiveWorkspace"
+	AuditActionWorkspaceFreeze       AuditAction = "FreezeWorkspace"
+	AuditActionWorkspaceUnfreeze     AuditAction = "UnfreezeWorkspace"
 
 	// Workspace Service Instance
 	AuditActionServiceInstanceCreate AuditAction = "CreateServiceInstance"
@@ -43,30 +47,78 @@ const (
 	AuditActionServiceInstanceDelete AuditAction = "DeleteServiceInstance"
 	AuditActionServiceInstanceList   AuditAction = "ListServiceInstance"
 
+	// Workspace Template
+	AuditActionWorkspaceTemplateCreate     AuditAction = "CreateWorkspaceTemplate"
+	AuditActionWorkspaceTemplateRead       AuditAction = "ReadWorkspaceTemplate"
+	AuditActionWorkspaceTemplateUpdate     AuditAction = "UpdateWorkspaceTemplate"
+	AuditActionWorkspaceTemplateDelete     AuditAction = "DeleteWorkspaceTemplate"
+	AuditActionWorkspaceTemplateList       AuditAction = "ListWorkspaceTemplate"
+	AuditActionWorkspaceCreateFromTemplate AuditAction = "CreateWorkspaceFromTemplate"
+
+	// Service Definition
+	AuditActionServiceDefinitionCreate AuditAction = "CreateServiceDefinition"
+	AuditActionServiceDefinitionRead   AuditAction = "ReadServiceDefinition"
+	AuditActionServiceDefinitionUpdate AuditAction = "UpdateServiceDefinition"
+	AuditActionServiceDefinitionDelete AuditAction = "DeleteServiceDefinition"
+	AuditActionServiceDefinitionList   AuditAction = "ListServiceDefinition"
+
+	// Workspace Invitation
+	AuditActionWorkspaceInvitationCreate  AuditAction = "CreateWorkspaceInvitation"
+	AuditActionWorkspaceInvitationList    AuditAction = "ListWorkspaceInvitation"
+	AuditActionWorkspaceInvitationAccept  AuditAction = "AcceptWorkspaceInvitation"
+	AuditActionWorkspaceInvitationDecline AuditAction = "DeclineWorkspaceInvitation"
+	AuditActionWorkspaceInvitationRevoke  AuditAction = "RevokeWorkspaceInvitation"
+
 	// Workbench
-	AuditActionWorkbenchCreate       AuditAction = "CreateWorkbench"
-	AuditActionWorkbenchRead         AuditAction = "ReadWorkbench"
-	AuditActionWorkbenchUpdate       AuditAction = "UpdateWorkbench"
-	AuditActionWorkbenchDelete       AuditAction = "DeleteWorkbench"
-	AuditActionWorkbenchList         AuditAction = "ListWorkbench"
-	AuditActionWorkbenchMemberAdd    AuditAction = "AddWorkbenchMember"
-	AuditActionWorkbenchMemberRemove AuditAction = "RemoveWorkbenchMember"
-	AuditActionWorkbenchStream       AuditAction = "StreamWorkbench"
+	AuditActionWorkbenchCreate           AuditAction = "CreateWorkbench"
+	AuditActionWorkbenchRead             AuditAction = "ReadWorkbench"
+	AuditActionWorkbenchUpdate           AuditAction = "UpdateWorkbench"
+	AuditActionWorkbenchDelete           AuditAction = "DeleteWorkbench"
+	AuditActionWorkbenchList             AuditAction = "ListWorkbench"
+	AuditActionWorkbenchMemberAdd        AuditAction = "AddWorkbenchMember"
+	AuditActionWorkbenchMemberRemove     AuditAction = "RemoveWorkbenchMember"
+	AuditActionWorkbenchStream           AuditAction = "StreamWorkbench"
+	AuditActionWorkbenchStop             AuditAction = "StopWorkbench"
+	AuditActionWorkbenchStart            AuditAction = "StartWorkbench"
+	AuditActionWorkbenchScheduleSet      AuditAction = "SetWorkbenchSchedule"
+	AuditActionWorkbenchScheduleDelete   AuditAction = "DeleteWorkbenchSchedule"
+	AuditActionWorkbenchKeepAlive        AuditAction = "KeepWorkbenchAlive"
+	AuditActionWorkbenchHibernate        AuditAction = "HibernateWorkbench"
+	AuditActionWorkbenchResume           AuditAction = "ResumeWorkbench"
+	AuditActionWorkbenchShareGrantCreate AuditAction = "CreateWorkbenchShareGrant"
+	AuditActionWorkbenchShareGrantRevoke AuditAction = "RevokeWorkbenchShareGrant"
+	AuditActionWorkbenchLogsRead         AuditAction = "ReadWorkbenchLogs"
+	AuditActionWorkbenchConnectionStart  AuditAction = "StartWorkbenchConnection"
+	AuditActionWorkbenchConnectionEnd    AuditAction = "EndWorkbenchConnection"
+	AuditActionWorkbenchConnectionList   AuditAction = "ListWorkbenchConnections"
 
 	// App
-	AuditActionAppCreate     AuditAction = "CreateApp"
-	AuditActionAppRead       AuditAction = "ReadApp"
-	AuditActionAppUpdate     AuditAction = "UpdateApp"
-	AuditActionAppDelete     AuditAction = "DeleteApp"
-	AuditActionAppList       AuditAction = "ListApp"
-	AuditActionAppBulkCreate AuditAction = "BulkCreateApp"
+	AuditActionAppCreate          AuditAction = "CreateApp"
+	AuditActionAppRead            AuditAction = "ReadApp"
+	AuditActionAppUpdate          AuditAction = "UpdateApp"
+	AuditActionAppDelete          AuditAction = "DeleteApp"
+	AuditActionAppList            AuditAction = "ListApp"
+	AuditActionAppBulkCreate      AuditAction = "BulkCreateApp"
+	AuditActionAppVersionCreate   AuditAction = "CreateAppVersion"
+	AuditActionAppVersionUpdate   AuditAction = "UpdateAppVersion"
+	AuditActionAppVersionList     AuditAction = "ListAppVersions"
+	AuditActionWorkspaceAppCreate AuditAction = "CreateWorkspaceApp"
+	AuditActionWorkspaceAppDelete AuditAction = "DeleteWorkspaceApp"
+	AuditActionWorkspaceAppList   AuditAction = "ListWorkspaceApps"
+	AuditActionAppReviewRead      AuditAction = "ReadAppReview"
+	AuditActionAppReviewStart     AuditAction = "StartAppReview"
+	AuditActionAppReviewDecide    AuditAction = "DecideAppReview"
+	AuditActionAppReviewComment   AuditAction = "CommentAppReview"
+	AuditActionAppLifecycleUpdate AuditAction = "UpdateAppLifecycle"
 
 	// App Instance
-	AuditActionAppInstanceCreate AuditAction = "CreateAppInstance"
-	AuditActionAppInstanceRead   AuditAction = "ReadAppInstance"
-	AuditActionAppInstanceUpdate AuditAction = "UpdateAppInstance"
-	AuditActionAppInstanceDelete AuditAction = "DeleteAppInstance"
-	AuditActionAppInstanceList   AuditAction = "ListAppInstance"
+	AuditActionAppInstanceCreate   AuditAction = "CreateAppInstance"
+	AuditActionAppInstanceRead     AuditAction = "ReadAppInstance"
+	AuditActionAppInstanceUpdate   AuditAction = "UpdateAppInstance"
+	AuditActionAppInstanceUpgrade  AuditAction = "UpgradeAppInstance"
+	AuditActionAppInstanceDelete   AuditAction = "DeleteAppInstance"
+	AuditActionAppInstanceList     AuditAction = "ListAppInstance"
+	AuditActionAppInstanceLogsRead AuditAction = "ReadAppInstanceLogs"
 
 	// File
 	AuditActionFileCreate         AuditAction = "CreateFile"
@@ -80,18 +132,20 @@ const (
 	AuditActionFileUploadAbort    AuditAction = "AbortFileUpload"
 
 	// Approval Request
-	AuditActionApprovalRequestCreate       AuditAction = "CreateApprovalRequest"
-	AuditActionApprovalRequestRead         AuditAction = "ReadApprovalRequest"
-	AuditActionApprovalRequestList         AuditAction = "ListApprovalRequest"
-	AuditActionApprovalRequestApprove      AuditAction = "ApproveApprovalRequest"
-	AuditActionApprovalRequestDelete       AuditAction = "DeleteApprovalRequest"
-	AuditActionDataExtractionRequestCreate AuditAction = "CreateDataExtractionRequest"
-	AuditActionDataTransferRequestCreate   AuditAction = "CreateDataTransferRequest"
-	AuditActionApprovalRequestFileDownload AuditAction = "DownloadApprovalRequestFile"
+	AuditActionApprovalRequestCreate        AuditAction = "CreateApprovalRequest"
+	AuditActionApprovalRequestRead          AuditAction = "ReadApprovalRequest"
+	AuditActionApprovalRequestList          AuditAction = "ListApprovalRequest"
+	AuditActionApprovalRequestApprove       AuditAction = "ApproveApprovalRequest"
+	AuditActionApprovalRequestDelete        AuditAction = "DeleteApprovalRequest"
+	AuditActionDataExtractionRequestCreate  AuditAction = "CreateDataExtractionRequest"
+	AuditActionDataTransferRequestCreate    AuditAction = "CreateDataTransferRequest"
+	AuditActionWorkspaceAccessRequestCreate AuditAction = "CreateWorkspaceAccessRequest"
+	AuditActionApprovalRequestFileDownload  AuditAction = "DownloadApprovalRequestFile"
 
 	// Platform Settings
 	AuditActionPlatformSettingsRead   AuditAction = "ReadPlatformSettings"
 	AuditActionPlatformSettingsUpdate AuditAction = "UpdatePlatformSettings"
+	AuditActionUserUsageRead          AuditAction = "ReadUserUsage"
 
 	// Terms of Use
 	AuditActionTermsOfUseVersionCreate  AuditAction = "CreateTermsOfUseVersion"
@@ -20,3 +20,4 @@ const DEFAULT_USERNAME_CLAIM = "sub"
 const DEFAULT_FIRST_NAME_CLAIM = "given_name"
 const DEFAULT_LAST_NAME_CLAIM = "family_name"
 const DEFAULT_EMAIL_CLAIM = "email"
+const EMAIL_VERIFIED_CLAIM = "email_verified"
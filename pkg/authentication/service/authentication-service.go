@@ -57,6 +57,12 @@ type AuthenticationStore interface {
 	GetActiveUser(ctx context.Context, username, source string) (*userModel.User, error)
 }
 
+// InvitationClaimer binds the pending workspace invitations sent to the email address
+// of a user to that user when they first log in.
+type InvitationClaimer interface {
+	ClaimWorkspaceInvitations(ctx context.Context, tenantID, userID uint64, email string) (int64, error)
+}
+
 // AuthenticationService is the authentication service handler.
 type AuthenticationService struct {
 	modes               map[string]config.Mode
@@ -66,6 +72,7 @@ type AuthenticationService struct {
 	maxRefreshTime      time.Duration
 	daemonEncryptionKey *crypto.Secret
 	store               AuthenticationStore // store is the database handler object.
+	invitationClaimer   InvitationClaimer
 	oauthConfigs        map[string]*oauth2.Config
 	oauthHTTPClients    map[string]*http.Client // Custom HTTP clients per OAuth provider for TLS configuration
 	selfServiceTenantID uint64
@@ -88,7 +95,7 @@ type CustomClaims struct {
 }
 
 // NewAuthenticationService returns a fresh authentication service instance.
-func NewAuthenticationService(cfg config.Config, userer Userer, store AuthenticationStore, daemonEncryptionKey *crypto.Secret) (*AuthenticationService, error) {
+func NewAuthenticationService(cfg config.Config, userer Userer, store AuthenticationStore, invitationClaimer InvitationClaimer, daemonEncryptionKey *crypto.Secret) (*AuthenticationService, error) {
 	oauthConfigs := make(map[string]*oauth2.Config)
 	oauthHTTPClients := make(map[string]*http.Client)
 	modes := make(map[string]config.Mode)
@@ -152,6 +159,7 @@ func NewAuthenticationService(cfg config.Config, userer Userer, store Authentica
 		maxRefreshTime:      cfg.Daemon.JWT.MaxRefreshTime,
 		daemonEncryptionKey: daemonEncryptionKey,
 		store:               store,
+		invitationClaimer:   invitationClaimer,
 		oauthConfigs:        oauthConfigs,
 		oauthHTTPClients:    oauthHTTPClients,
 		selfServiceTenantID: cfg.Services.AuthenticationService.SelfService.TenantID,
@@ -409,6 +417,10 @@ func (a *AuthenticationService) OAuthCallback(ctx context.Context, providerID, s
 		}
 	}
 
+	if verified, _ := userInfo[model.EMAIL_VERIFIED_CLAIM].(bool); verified {
+		a.claimWorkspaceInvitations(ctx, user, getUserInfoString(emailClaim))
+	}
+
 	jwtToken, err := createJWTToken(a.signingKey, user, a.jwtExpirationTime, time.Now(), "")
 	if err != nil {
 		logger.TechLog.Error(ctx, "unable to create JWT token", zap.Error(err))
@@ -424,6 +436,25 @@ func (a *AuthenticationService) OAuthCallback(ctx context.Context, providerID, s
 	return jwtToken, a.jwtExpirationTime, url, nil
 }
 
+// claimWorkspaceInvitations hands the workspace invitations sent to the verified email
+// address an OpenID provider returned for a user over to that user. The email of the user
+// profile is never used, as users can change it. Failures are only logged: the invitations
+// are claimed again on the next sign-in, and can be accepted with their token meanwhile.
+func (a *AuthenticationService) claimWorkspaceInvitations(ctx context.Context, user *userModel.User, email string) {
+	if a.invitationClaimer == nil || email == "" {
+		return
+	}
+
+	claimed, err := a.invitationClaimer.ClaimWorkspaceInvitations(ctx, user.TenantID, user.ID, email)
+	if err != nil {
+		logger.TechLog.Error(ctx, "unable to claim workspace invitations", logger.WithUserIDField(user.ID), zap.Error(err))
+		return
+	}
+	if claimed > 0 {
+		logger.TechLog.Info(ctx, "claimed workspace invitations", logger.WithUserIDField(user.ID), logger.WithCountField(int(claimed)))
+	}
+}
+
 func (a *AuthenticationService) RefreshToken(ctx context.Context) (string, time.Duration, error) {
 	tenantID, err := jwt_model.ExtractTenantID(ctx)
 	if err != nil {
@@ -129,7 +129,7 @@ func TestNewAuthenticationService_SetsSelfServiceTenantID(t *testing.T) {
 	cfg := config.Config{}
 	cfg.Services.AuthenticationService.SelfService.TenantID = tenantID
 
-	svc, err := NewAuthenticationService(cfg, &fakeUserer{}, &fakeStore{}, nil)
+	svc, err := NewAuthenticationService(cfg, &fakeUserer{}, &fakeStore{}, nil, nil)
 	require.NoError(t, err)
 	require.Equal(t, tenantID, svc.selfServiceTenantID)
 }
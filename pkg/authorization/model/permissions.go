@@ -108,6 +108,7 @@ var (
 	// Platform
 	PermGetPlatformSettings = newPermissionFactoryNoContext("getPlatformSettings", "Allow the user to get platform settings")
 	PermSetPlatformSettings = newPermissionFactoryNoContext("setPlatformSettings", "Allow the user to set platform settings")
+	PermGetUserUsage        = newPermissionFactoryOneContext[UserID]("getUserUsage", "Allow the user to get a user's usage against the platform limits")
 	PermAuditPlatform       = newPermissionFactoryNoContext("auditPlatform", "Allow the user to audit the platform")
 	PermManageDynamicRoles  = newPermissionFactoryNoContext("manageDynamicRoles", "Allow the user to create dynamic roles")
 
@@ -119,14 +120,15 @@ var (
 	PermDeleteAppInstance = newPermissionFactoryOneContext[WorkbenchID]("deleteAppInstance", "Allow the user to delete an app instance")
 
 	// Workbenches
-	PermListWorkbenches        = newPermissionFactoryOneContext[WorkbenchID]("listWorkbenches", "Allow the user to list workbenchs")
-	PermCreateWorkbench        = newPermissionFactoryOneContext[WorkspaceID]("createWorkbench", "Allow the user to create a workbench")
-	PermUpdateWorkbench        = newPermissionFactoryOneContext[WorkbenchID]("updateWorkbench", "Allow the user to update a workbench")
-	PermGetWorkbench           = newPermissionFactoryOneContext[WorkbenchID]("getWorkbench", "Allow the user to get a workbench")
-	PermStreamWorkbench        = newPermissionFactoryOneContext[WorkbenchID]("streamWorkbench", "Allow the user to stream a workbench")
-	PermDeleteWorkbench        = newPermissionFactoryOneContext[WorkbenchID]("deleteWorkbench", "Allow the user to delete a workbench")
-	PermAuditWorkbench         = newPermissionFactoryOneContext[WorkbenchID]("auditWorkbench", "Allow the user to audit a workbench")
-	PermManageUsersInWorkbench = newPermissionFactoryOneContext[WorkbenchID]("manageUsersInWorkbench", "Allow the user to manage users in a workbench")
+	PermListWorkbenches         = newPermissionFactoryOneContext[WorkbenchID]("listWorkbenches", "Allow the user to list workbenchs")
+	PermCreateWorkbench         = newPermissionFactoryOneContext[WorkspaceID]("createWorkbench", "Allow the user to create a workbench")
+	PermUpdateWorkbench         = newPermissionFactoryOneContext[WorkbenchID]("updateWorkbench", "Allow the user to update a workbench")
+	PermGetWorkbench            = newPermissionFactoryOneContext[WorkbenchID]("getWorkbench", "Allow the user to get a workbench")
+	PermStreamWorkbench         = newPermissionFactoryOneContext[WorkbenchID]("streamWorkbench", "Allow the user to stream a workbench")
+	PermDeleteWorkbench         = newPermissionFactoryOneContext[WorkbenchID]("deleteWorkbench", "Allow the user to delete a workbench")
+	PermAuditWorkbench          = newPermissionFactoryOneContext[WorkbenchID]("auditWorkbench", "Allow the user to audit a workbench")
+	PermManageUsersInWorkbench  = newPermissionFactoryOneContext[WorkbenchID]("manageUsersInWorkbench", "Allow the user to manage users in a workbench")
+	PermManageWorkbenchSchedule = newPermissionFactoryOneContext[WorkbenchID]("manageWorkbenchSchedule", "Allow the user to manage the start/stop schedule of a workbench")
 
 	// Workspaces
 	PermListWorkspaces                 = newPermissionFactoryOneContext[WorkspaceID]("listWorkspaces", "Allow the user to list workspaces")
@@ -135,6 +137,8 @@ var (
 	PermUpdateWorkspace                = newPermissionFactoryOneContext[WorkspaceID]("updateWorkspace", "Allow the user to update a workspace")
 	PermGetWorkspace                   = newPermissionFactoryOneContext[WorkspaceID]("getWorkspace", "Allow the user to get a workspace")
 	PermDeleteWorkspace                = newPermissionFactoryOneContext[WorkspaceID]("deleteWorkspace", "Allow the user to delete a workspace")
+	PermExtendWorkspace                = newPermissionFactoryOneContext[WorkspaceID]("extendWorkspace", "Allow the user to change the end date of a workspace")
+	PermFreezeWorkspace                = newPermissionFactoryOneContext[WorkspaceID]("freezeWorkspace", "Allow the user to freeze and unfreeze a workspace")
 	PermManageUsersInWorkspace         = newPermissionFactoryOneContext[WorkspaceID]("manageUsersInWorkspace", "Allow the user to manage users in a workspace")
 	PermManageUsersDataRoleInWorkspace = newPermissionFactoryOneContext[WorkspaceID]("manageUsersDataRoleInWorkspace", "Allow the user to manage users' data role in a workspace")
 	PermListFilesInWorkspace           = newPermissionFactoryOneContext[WorkspaceID]("listFilesInWorkspace", "Allow the user to list files in a workspace")
@@ -151,6 +155,23 @@ var (
 	PermGetWorkspaceServiceInstanceSecret = newPermissionFactoryOneContext[WorkspaceID]("getWorkspaceServiceInstanceSecret", "Allow the user to get the secrets of a workspace service instance")
 	PermDeleteWorkspaceServiceInstance    = newPermissionFactoryOneContext[WorkspaceID]("deleteWorkspaceServiceInstance", "Allow the user to delete a workspace service instance")
 
+	// Workspace invitations
+	PermRespondToWorkspaceInvitations = newPermissionFactoryNoContext("respondToWorkspaceInvitations", "Allow the user to list, accept and decline the workspace invitations addressed to them")
+
+	// Workspace templates
+	PermListWorkspaceTemplates  = newPermissionFactoryNoContext("listWorkspaceTemplates", "Allow the user to list workspace templates")
+	PermGetWorkspaceTemplate    = newPermissionFactoryNoContext("getWorkspaceTemplate", "Allow the user to get a workspace template")
+	PermCreateWorkspaceTemplate = newPermissionFactoryNoContext("createWorkspaceTemplate", "Allow the user to create a workspace template")
+	PermUpdateWorkspaceTemplate = newPermissionFactoryNoContext("updateWorkspaceTemplate", "Allow the user to update a workspace template")
+	PermDeleteWorkspaceTemplate = newPermissionFactoryNoContext("deleteWorkspaceTemplate", "Allow the user to delete a workspace template")
+
+	// Service definitions
+	PermListServiceDefinitions  = newPermissionFactoryNoContext("listServiceDefinitions", "Allow the user to list service definitions")
+	PermGetServiceDefinition    = newPermissionFactoryNoContext("getServiceDefinition", "Allow the user to get a service definition")
+	PermCreateServiceDefinition = newPermissionFactoryNoContext("createServiceDefinition", "Allow the user to create a service definition")
+	PermUpdateServiceDefinition = newPermissionFactoryNoContext("updateServiceDefinition", "Allow the user to update a service definition")
+	PermDeleteServiceDefinition = newPermissionFactoryNoContext("deleteServiceDefinition", "Allow the user to delete a service definition")
+
 	// Apps
 	PermListApps  = newPermissionFactoryNoContext("listApps", "Allow the user to list apps")
 	PermCreateApp = newPermissionFactoryNoContext("createApp", "Allow the user to create an app")
@@ -158,6 +179,13 @@ var (
 	PermGetApp    = newPermissionFactoryNoContext("getApp", "Allow the user to get an app")
 	PermDeleteApp = newPermissionFactoryNoContext("deleteApp", "Allow the user to delete an app")
 
+	PermListWorkspaceApps   = newPermissionFactoryOneContext[WorkspaceID]("listWorkspaceApps", "Allow the user to list the custom apps of a workspace")
+	PermManageWorkspaceApps = newPermissionFactoryOneContext[WorkspaceID]("manageWorkspaceApps", "Allow the user to register and delete the custom apps of a workspace")
+	PermApproveCustomApp    = newPermissionFactoryNoContext("approveCustomApp", "Allow the user to approve the custom apps of workspaces")
+
+	PermReviewApp          = newPermissionFactoryNoContext("reviewApp", "Allow the user to review and comment the apps submitted to the app store")
+	PermManageAppLifecycle = newPermissionFactoryNoContext("manageAppLifecycle", "Allow the user to start the review of apps, and to deprecate and retire them")
+
 	// Requests
 	PermListRequests   = newPermissionFactoryOneContext[WorkspaceID]("listRequests", "Allow the user to list requests")
 	PermListMyRequests = newPermissionFactoryNoContext("listMyRequests", "Allow the user to list his requests")
@@ -166,6 +194,10 @@ var (
 	PermApproveRequest = newPermissionFactoryOneContext[WorkspaceID]("approveRequest", "Allow the user to approve a request")
 	PermDeleteRequest  = newPermissionFactoryOneContext[WorkspaceID]("deleteRequest", "Allow the user to delete a request")
 
+	PermRequestWorkspaceAccess = newPermissionFactoryNoContext("requestWorkspaceAccess", "Allow the user to request access to a public workspace")
+
+	PermApproveNetworkPolicyChange = newPermissionFactoryOneContext[WorkspaceID]("approveNetworkPolicyChange", "Allow the user to approve the relaxation of a workspace network policy")
+
 	// Terms of use
 	PermCreateTermsOfUseVersion     = newPermissionFactoryNoContext("createTermsOfUseVersion", "Allow the user to create a terms of use version")
 	PermUpdateTermsOfUseVersion     = newPermissionFactoryNoContext("updateTermsOfUseVersion", "Allow the user to update a terms of use version")
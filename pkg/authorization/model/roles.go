@@ -153,12 +153,17 @@ var (
 			PermListPublicWorkspaces,
 			PermListWorkbenches,
 			PermListApps,
+			PermListServiceDefinitions,
+			PermGetServiceDefinition,
 			PermListAppInstances,
 			PermListMyRequests,
+			PermRequestWorkspaceAccess,
+			PermRespondToWorkspaceInvitations,
 			PermAuditUser,
 			PermGetCurrentTermsOfUseVersion,
 			PermGetMyTermsOfUseStatus,
 			PermAcceptTermsOfUse,
+			PermGetUserUsage,
 			PermListOrganizations,
 			PermGetOrganization,
 		)),
@@ -185,11 +190,12 @@ var (
 			PermCreateRequest,
 			PermGetWorkspaceServiceInstance,
 			PermGetWorkspaceServiceInstanceSecret,
+			PermListWorkspaceApps,
 		)),
 	)
 	RoleWorkspaceMaintainer = newRoleFactoryOneContext[WorkspaceID](
 		"WorkspaceMaintainer",
-		"Workspace maintainers can update workspace metadata and manage workspace files",
+		"Workspace maintainers can update workspace metadata and manage workspace files and custom apps",
 		RoleScopeWorkspace,
 		merge(RoleWorkspaceMember.Permissions, grant(
 			PermUpdateWorkspace,
@@ -197,6 +203,7 @@ var (
 			PermModifyFilesInWorkspace,
 			PermSearchUsers,
 			PermCreateRequest,
+			PermManageWorkspaceApps,
 		)),
 	)
 	RoleWorkspaceDataManager = newRoleFactoryOneContext[WorkspaceID](
@@ -210,6 +217,7 @@ var (
 			PermManageUsersDataRoleInWorkspace,
 			PermCreateRequest,
 			PermListRequests,
+			PermApproveNetworkPolicyChange,
 		)),
 	)
 	RoleWorkspaceAdmin = newRoleFactoryOneContext[WorkspaceID](
@@ -225,7 +233,9 @@ var (
 			PermDeleteWorkbench,
 			PermAuditWorkbench,
 			PermManageUsersInWorkbench,
+			PermManageWorkbenchSchedule,
 			PermDeleteWorkspace,
+			PermExtendWorkspace,
 			PermAuditWorkspace,
 			PermManageUsersInWorkspace,
 			PermListRequests,
@@ -268,6 +278,7 @@ var (
 		merge(RoleWorkbenchMember.Permissions, grant(
 			PermDeleteWorkbench,
 			PermManageUsersInWorkbench,
+			PermManageWorkbenchSchedule,
 			PermSearchUsers,
 			PermAuditWorkbench,
 		)),
@@ -309,6 +320,7 @@ var (
 			PermDeleteUser,
 			PermResetPassword,
 			PermListTermsOfUseAcceptances,
+			PermGetUserUsage,
 		)),
 		ContextUser,
 	)
@@ -334,19 +346,26 @@ var (
 	)
 	RolePlatformWorkspaceManager = newRoleFactoryNoContext(
 		"PlatformWorkspaceManager",
-		"Platform workspace managers can create, update, and delete any workspace",
+		"Platform workspace managers can create, update, and delete any workspace and manage workspace templates",
 		RoleScopePlatform,
 		merge(RoleAuthenticated.Permissions, grant(
 			PermCreateWorkspace,
 			PermGetWorkspace,
 			PermUpdateWorkspace,
 			PermDeleteWorkspace,
+			PermExtendWorkspace,
+			PermFreezeWorkspace,
+			PermListWorkspaceTemplates,
+			PermGetWorkspaceTemplate,
+			PermCreateWorkspaceTemplate,
+			PermUpdateWorkspaceTemplate,
+			PermDeleteWorkspaceTemplate,
 		)),
 		ContextWorkspace,
 	)
 	RoleAppStoreAdmin = newRoleFactoryNoContext(
 		"AppStoreAdmin",
-		"App store admins can administer apps",
+		"App store admins can administer apps, their lifecycle and service definitions",
 		RoleScopePlatform,
 		merge(RoleAuthenticated.Permissions, grant(
 			PermListApps,
@@ -354,6 +373,25 @@ var (
 			PermUpdateApp,
 			PermGetApp,
 			PermDeleteApp,
+			PermListServiceDefinitions,
+			PermGetServiceDefinition,
+			PermCreateServiceDefinition,
+			PermUpdateServiceDefinition,
+			PermDeleteServiceDefinition,
+			PermApproveCustomApp,
+			PermReviewApp,
+			PermManageAppLifecycle,
+		)),
+		ContextUser,
+	)
+	RoleAppReviewer = newRoleFactoryNoContext(
+		"AppReviewer",
+		"App reviewers review the apps submitted to the app store before they reach users",
+		RoleScopePlatform,
+		merge(RoleAuthenticated.Permissions, grant(
+			PermListApps,
+			PermGetApp,
+			PermReviewApp,
 		)),
 		ContextUser,
 	)
@@ -368,6 +406,7 @@ var (
 			PermUploadFilesToWorkspace,
 			PermModifyFilesInWorkspace,
 			PermDownloadFilesFromWorkspace,
+			PermApproveNetworkPolicyChange,
 		)),
 		ContextWorkspace,
 	)
@@ -383,6 +422,7 @@ var (
 			RolePlatformAuditor.Permissions,
 			RolePlatformWorkspaceManager.Permissions,
 			RoleAppStoreAdmin.Permissions,
+			RoleAppReviewer.Permissions,
 			RolePlatformDataManager.Permissions,
 			RoleWorkspaceAdmin.Permissions,
 			RoleWorkspaceDataManager.Permissions,
@@ -1,10 +1,12 @@
 package storage
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
 
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
 	audit_model "github.com/CHORUS-TRE/chorus-backend/pkg/audit/model"
 	common "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
 	user_service "github.com/CHORUS-TRE/chorus-backend/pkg/user/service"
@@ -20,6 +22,32 @@ func Rollback(tx *sqlx.Tx, txErr error) error {
 	return txErr
 }
 
+// CheckUserQuota locks the user row for the lifetime of tx so that concurrent
+// creations for the same user are serialized, then counts the resources
+// already owned by the user with countQuery (bound to $1 tenantid and $2 userid).
+// It returns ErrQuotaReached when max is non zero and has already been reached.
+func CheckUserQuota(ctx context.Context, tx *sqlx.Tx, tenantID, userID uint64, max uint32, countQuery string) error {
+	if max == 0 {
+		return nil
+	}
+
+	const lockQuery = `SELECT id FROM users WHERE tenantid = $1 AND id = $2 FOR UPDATE;`
+	if _, err := tx.ExecContext(ctx, lockQuery, tenantID, userID); err != nil {
+		return fmt.Errorf("unable to lock user %v: %w", userID, err)
+	}
+
+	var count uint64
+	if err := tx.GetContext(ctx, &count, countQuery, tenantID, userID); err != nil {
+		return fmt.Errorf("unable to count resources of user %v: %w", userID, err)
+	}
+
+	if count >= uint64(max) {
+		return fmt.Errorf("user %v owns %v of %v allowed: %w", userID, count, max, cerr.ErrQuotaReached)
+	}
+
+	return nil
+}
+
 func PqInt64ToUint64(array pq.Int64Array) []uint64 {
 	output := make([]uint64, len(array))
 	for i, element := range array {
@@ -1,6 +1,10 @@
 package model
 
-import "time"
+import (
+	"time"
+
+	app_model "github.com/CHORUS-TRE/chorus-backend/pkg/app/model"
+)
 
 type PlatformSettings struct {
 	ID uint64
@@ -16,6 +20,47 @@ type PlatformSettings struct {
 	MaxSessionsPerUser     uint32
 	MaxAppInstancesPerUser uint32
 
+	// VulnerabilityBlockSeverity and VulnerabilityWarnSeverity form the vulnerability
+	// policy applied to the images of the app instances. Empty disables the rule.
+	VulnerabilityBlockSeverity app_model.Severity
+	VulnerabilityWarnSeverity  app_model.Severity
+
+	// ImageSigningKeys is the PEM bundle of the public keys app images are signed with.
+	// RequireImageSignatures refuses the images not signed by one of them.
+	ImageSigningKeys       string
+	RequireImageSignatures bool
+
+	// RequireCustomAppApproval holds the custom apps of workspaces until an app store admin
+	// approves them.
+	RequireCustomAppApproval bool
+
+	// RequireAppReview submits the new apps for review. They cannot be launched until their
+	// reviewers approve them.
+	RequireAppReview bool
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
+
+func (s *PlatformSettings) VulnerabilityPolicy() app_model.VulnerabilityPolicy {
+	return app_model.VulnerabilityPolicy{
+		BlockSeverity: s.VulnerabilityBlockSeverity,
+		WarnSeverity:  s.VulnerabilityWarnSeverity,
+	}
+}
+
+// ResourceUsage is the number of resources of one kind owned by a user
+// together with the applicable per-user limit (0 means unlimited).
+type ResourceUsage struct {
+	Used uint32
+	Max  uint32
+}
+
+type UserUsage struct {
+	TenantID uint64
+	UserID   uint64
+
+	Workspaces   ResourceUsage
+	Sessions     ResourceUsage
+	AppInstances ResourceUsage
+}
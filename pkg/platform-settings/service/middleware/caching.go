@@ -53,3 +53,7 @@ func (c *Caching) GetPlatformSettings(ctx context.Context) (reply *model.Platfor
 func (c *Caching) UpdatePlatformSettings(ctx context.Context, settings *model.PlatformSettings) (*model.PlatformSettings, error) {
 	return c.next.UpdatePlatformSettings(ctx, settings)
 }
+
+func (c *Caching) GetUserUsage(ctx context.Context, userID uint64) (*model.UserUsage, error) {
+	return c.next.GetUserUsage(ctx, userID)
+}
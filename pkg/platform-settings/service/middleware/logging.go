@@ -63,3 +63,23 @@ func (c platformSettingsServiceLogging) UpdatePlatformSettings(ctx context.Conte
 	)
 	return res, nil
 }
+
+func (c platformSettingsServiceLogging) GetUserUsage(ctx context.Context, userID uint64) (*model.UserUsage, error) {
+	now := time.Now()
+
+	res, err := c.next.GetUserUsage(ctx, userID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Uint64("user_id", userID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, fmt.Errorf("unable to get user usage: %w", err)
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		zap.Uint64("user_id", userID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
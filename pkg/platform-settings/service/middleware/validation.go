@@ -3,7 +3,9 @@ package middleware
 import (
 	"context"
 
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/ociregistry"
 	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	app_model "github.com/CHORUS-TRE/chorus-backend/pkg/app/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/platform-settings/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/platform-settings/service"
 )
@@ -26,5 +28,20 @@ func (v validation) UpdatePlatformSettings(ctx context.Context, settings *model.
 	if settings == nil {
 		return nil, cerr.ErrValidation.WithMessage("Platform settings are required")
 	}
+	for _, severity := range []app_model.Severity{settings.VulnerabilityBlockSeverity, settings.VulnerabilityWarnSeverity} {
+		if _, err := app_model.ToSeverity(severity.String()); err != nil {
+			return nil, cerr.ErrValidation.Wrap(err, "Invalid vulnerability policy severity")
+		}
+	}
+	if _, err := ociregistry.ParseTrustedKeys(settings.ImageSigningKeys); err != nil {
+		return nil, cerr.ErrValidation.Wrap(err, "Invalid image signing keys")
+	}
 	return v.next.UpdatePlatformSettings(ctx, settings)
 }
+
+func (v validation) GetUserUsage(ctx context.Context, userID uint64) (*model.UserUsage, error) {
+	if userID == 0 {
+		return nil, cerr.ErrValidation.WithMessage("User ID is required")
+	}
+	return v.next.GetUserUsage(ctx, userID)
+}
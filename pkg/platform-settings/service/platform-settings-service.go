@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"fmt"
 
 	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
 	jwt_model "github.com/CHORUS-TRE/chorus-backend/internal/jwt/model"
@@ -13,11 +14,13 @@ var _ PlatformSettingser = (*PlatformSettingsService)(nil)
 type PlatformSettingser interface {
 	GetPlatformSettings(ctx context.Context) (*model.PlatformSettings, error)
 	UpdatePlatformSettings(ctx context.Context, settings *model.PlatformSettings) (*model.PlatformSettings, error)
+	GetUserUsage(ctx context.Context, userID uint64) (*model.UserUsage, error)
 }
 
 type PlatformSettingsStore interface {
 	GetPlatformSettings(ctx context.Context, tenantID uint64) (*model.PlatformSettings, error)
 	UpsertPlatformSettings(ctx context.Context, settings *model.PlatformSettings) (*model.PlatformSettings, error)
+	GetUserUsage(ctx context.Context, tenantID, userID uint64) (*model.UserUsage, error)
 }
 
 type PlatformSettingsService struct {
@@ -52,3 +55,26 @@ func (s *PlatformSettingsService) UpdatePlatformSettings(ctx context.Context, se
 	}
 	return result, nil
 }
+
+func (s *PlatformSettingsService) GetUserUsage(ctx context.Context, userID uint64) (*model.UserUsage, error) {
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return nil, cerr.ErrUnauthenticated.WithMessage("Unable to extract tenant ID")
+	}
+
+	settings, err := s.store.GetPlatformSettings(ctx, tenantID)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, "Unable to get platform settings")
+	}
+
+	usage, err := s.store.GetUserUsage(ctx, tenantID, userID)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to get usage of user %v", userID))
+	}
+
+	usage.Workspaces.Max = settings.MaxWorkspacesPerUser
+	usage.Sessions.Max = settings.MaxSessionsPerUser
+	usage.AppInstances.Max = settings.MaxAppInstancesPerUser
+
+	return usage, nil
+}
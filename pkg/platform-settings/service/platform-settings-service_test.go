@@ -21,6 +21,8 @@ type mockStore struct {
 	upsertErr      error
 	capturedGet    uint64
 	capturedUpsert *model.PlatformSettings
+	usage          *model.UserUsage
+	usageErr       error
 }
 
 func (m *mockStore) GetPlatformSettings(_ context.Context, tenantID uint64) (*model.PlatformSettings, error) {
@@ -33,6 +35,13 @@ func (m *mockStore) UpsertPlatformSettings(_ context.Context, settings *model.Pl
 	return m.upsertSettings, m.upsertErr
 }
 
+func (m *mockStore) GetUserUsage(_ context.Context, tenantID, userID uint64) (*model.UserUsage, error) {
+	if m.usage == nil {
+		return &model.UserUsage{TenantID: tenantID, UserID: userID}, m.usageErr
+	}
+	return m.usage, m.usageErr
+}
+
 func ctxWithTenant(tenantID uint64) context.Context {
 	return context.WithValue(context.Background(), jwt_model.JWTClaimsContextKey, &jwt_model.JWTClaims{
 		TenantID: tenantID,
@@ -102,3 +111,42 @@ func TestUpdatePlatformSettings_PropagatesStoreError(t *testing.T) {
 
 	require.Error(t, err)
 }
+
+func TestGetUserUsage_FillsLimitsFromPlatformSettings(t *testing.T) {
+	store := &mockStore{
+		getSettings: &model.PlatformSettings{TenantID: 3, MaxWorkspacesPerUser: 5, MaxSessionsPerUser: 2, MaxAppInstancesPerUser: 0},
+		usage: &model.UserUsage{
+			TenantID:     3,
+			UserID:       42,
+			Workspaces:   model.ResourceUsage{Used: 4},
+			Sessions:     model.ResourceUsage{Used: 2},
+			AppInstances: model.ResourceUsage{Used: 9},
+		},
+	}
+	svc := NewPlatformSettingsService(store)
+
+	got, err := svc.GetUserUsage(ctxWithTenant(3), 42)
+
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), store.capturedGet)
+	assert.Equal(t, model.ResourceUsage{Used: 4, Max: 5}, got.Workspaces)
+	assert.Equal(t, model.ResourceUsage{Used: 2, Max: 2}, got.Sessions)
+	assert.Equal(t, model.ResourceUsage{Used: 9, Max: 0}, got.AppInstances, "a zero limit means unlimited")
+}
+
+func TestGetUserUsage_ReturnsErrorWhenNoJWT(t *testing.T) {
+	svc := NewPlatformSettingsService(&mockStore{})
+
+	_, err := svc.GetUserUsage(context.Background(), 42)
+
+	require.Error(t, err)
+}
+
+func TestGetUserUsage_PropagatesStoreError(t *testing.T) {
+	store := &mockStore{getSettings: &model.PlatformSettings{TenantID: 1}, usageErr: errors.New("db down")}
+	svc := NewPlatformSettingsService(store)
+
+	_, err := svc.GetUserUsage(ctxWithTenant(1), 42)
+
+	require.Error(t, err)
+}
@@ -64,3 +64,24 @@ func (c platformSettingsStorageLogging) UpsertPlatformSettings(ctx context.Conte
 	)
 	return res, nil
 }
+
+func (c platformSettingsStorageLogging) GetUserUsage(ctx context.Context, tenantID, userID uint64) (*model.UserUsage, error) {
+	c.logger.Debug(ctx, logger.LoggerMessageRequestStarted)
+	now := time.Now()
+
+	res, err := c.next.GetUserUsage(ctx, tenantID, userID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Uint64("tenant_id", tenantID),
+			zap.Uint64("user_id", userID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, logger.LoggerMessageRequestCompleted,
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
@@ -24,7 +24,8 @@ func NewPlatformSettingsStorage(db *sqlx.DB) *PlatformSettingsStorage {
 
 func (s *PlatformSettingsStorage) GetPlatformSettings(ctx context.Context, tenantID uint64) (*model.PlatformSettings, error) {
 	const query = `
-		SELECT id, tenantid, title, headline, tagline, websiteurl, maxworkspacesperuser, maxsessionsperuser, maxappinstancesperuser, createdat, updatedat
+		SELECT id, tenantid, title, headline, tagline, websiteurl, maxworkspacesperuser, maxsessionsperuser, maxappinstancesperuser,
+		       vulnerabilityblockseverity, vulnerabilitywarnseverity, imagesigningkeys, requireimagesignatures, requirecustomappapproval, requireappreview, createdat, updatedat
 		FROM platform_settings
 		WHERE tenantid = $1
 	`
@@ -42,18 +43,26 @@ func (s *PlatformSettingsStorage) GetPlatformSettings(ctx context.Context, tenan
 
 func (s *PlatformSettingsStorage) UpsertPlatformSettings(ctx context.Context, settings *model.PlatformSettings) (*model.PlatformSettings, error) {
 	const query = `
-		INSERT INTO public.platform_settings (tenantid, title, headline, tagline, websiteurl, maxworkspacesperuser, maxsessionsperuser, maxappinstancesperuser, createdat, updatedat)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW(), NOW())
+		INSERT INTO public.platform_settings (tenantid, title, headline, tagline, websiteurl, maxworkspacesperuser, maxsessionsperuser, maxappinstancesperuser,
+		    vulnerabilityblockseverity, vulnerabilitywarnseverity, imagesigningkeys, requireimagesignatures, requirecustomappapproval, requireappreview, createdat, updatedat)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, NOW(), NOW())
 		ON CONFLICT (tenantid) DO UPDATE SET
-		    title                  = EXCLUDED.title,
-		    headline               = EXCLUDED.headline,
-		    tagline                = EXCLUDED.tagline,
-		    websiteurl             = EXCLUDED.websiteurl,
-		    maxworkspacesperuser   = EXCLUDED.maxworkspacesperuser,
-		    maxsessionsperuser     = EXCLUDED.maxsessionsperuser,
-		    maxappinstancesperuser = EXCLUDED.maxappinstancesperuser,
-		    updatedat              = EXCLUDED.updatedat
-		RETURNING id, tenantid, title, headline, tagline, websiteurl, maxworkspacesperuser, maxsessionsperuser, maxappinstancesperuser, createdat, updatedat
+		    title                      = EXCLUDED.title,
+		    headline                   = EXCLUDED.headline,
+		    tagline                    = EXCLUDED.tagline,
+		    websiteurl                 = EXCLUDED.websiteurl,
+		    maxworkspacesperuser       = EXCLUDED.maxworkspacesperuser,
+		    maxsessionsperuser         = EXCLUDED.maxsessionsperuser,
+		    maxappinstancesperuser     = EXCLUDED.maxappinstancesperuser,
+		    vulnerabilityblockseverity = EXCLUDED.vulnerabilityblockseverity,
+		    vulnerabilitywarnseverity  = EXCLUDED.vulnerabilitywarnseverity,
+		    imagesigningkeys           = EXCLUDED.imagesigningkeys,
+		    requireimagesignatures     = EXCLUDED.requireimagesignatures,
+		    requirecustomappapproval   = EXCLUDED.requirecustomappapproval,
+		    requireappreview           = EXCLUDED.requireappreview,
+		    updatedat                  = EXCLUDED.updatedat
+		RETURNING id, tenantid, title, headline, tagline, websiteurl, maxworkspacesperuser, maxsessionsperuser, maxappinstancesperuser,
+		    vulnerabilityblockseverity, vulnerabilitywarnseverity, imagesigningkeys, requireimagesignatures, requirecustomappapproval, requireappreview, createdat, updatedat
 	`
 
 	var result model.PlatformSettings
@@ -66,9 +75,44 @@ func (s *PlatformSettingsStorage) UpsertPlatformSettings(ctx context.Context, se
 		settings.MaxWorkspacesPerUser,
 		settings.MaxSessionsPerUser,
 		settings.MaxAppInstancesPerUser,
+		settings.VulnerabilityBlockSeverity,
+		settings.VulnerabilityWarnSeverity,
+		settings.ImageSigningKeys,
+		settings.RequireImageSignatures,
+		settings.RequireCustomAppApproval,
+		settings.RequireAppReview,
 	); err != nil {
 		return nil, fmt.Errorf("unable to upsert platform settings for tenant %d: %w", settings.TenantID, err)
 	}
 
 	return &result, nil
 }
+
+func (s *PlatformSettingsStorage) GetUserUsage(ctx context.Context, tenantID, userID uint64) (*model.UserUsage, error) {
+	const query = `
+		SELECT
+		    (SELECT COUNT(*) FROM workspaces
+		     WHERE tenantid = $1 AND userid = $2 AND status != 'deleted' AND deletedat IS NULL) AS workspaces,
+		    (SELECT COUNT(*) FROM workbenches
+		     WHERE tenantid = $1 AND userid = $2 AND deletedat IS NULL) AS sessions,
+		    (SELECT COUNT(*) FROM app_instances
+		     WHERE tenantid = $1 AND userid = $2 AND deletedat IS NULL) AS appinstances
+	`
+
+	var counts struct {
+		Workspaces   uint32
+		Sessions     uint32
+		AppInstances uint32
+	}
+	if err := s.db.GetContext(ctx, &counts, query, tenantID, userID); err != nil {
+		return nil, fmt.Errorf("unable to get usage of user %d in tenant %d: %w", userID, tenantID, err)
+	}
+
+	return &model.UserUsage{
+		TenantID:     tenantID,
+		UserID:       userID,
+		Workspaces:   model.ResourceUsage{Used: counts.Workspaces},
+		Sessions:     model.ResourceUsage{Used: counts.Sessions},
+		AppInstances: model.ResourceUsage{Used: counts.AppInstances},
+	}, nil
+}
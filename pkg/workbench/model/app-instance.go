@@ -12,11 +12,14 @@ import (
 type AppInstance struct {
 	ID uint64
 
-	TenantID    uint64
-	UserID      uint64
-	AppID       uint64
-	WorkspaceID uint64
-	WorkbenchID uint64
+	TenantID uint64
+	UserID   uint64
+	AppID    uint64
+	// AppVersionID is the version of the app the instance runs, the default version of
+	// the app when 0 at creation.
+	AppVersionID uint64
+	WorkspaceID  uint64
+	WorkbenchID  uint64
 
 	Status     AppInstanceStatus
 	K8sStatus  K8sAppInstanceStatus
@@ -32,6 +35,16 @@ type AppInstance struct {
 	AppDockerImageRegistry *string
 	AppDockerImageName     *string
 	AppDockerImageTag      *string
+	// AppDockerImageDigest is the digest the tag resolved to when the instance was
+	// created or upgraded, so that it keeps running the exact same image. It is empty
+	// when the registry could not be asked for it.
+	AppDockerImageDigest string
+	// VulnerabilityVerdict is the outcome of the vulnerability policy for the image when
+	// the instance was created or upgraded. It is not persisted.
+	VulnerabilityVerdict string `db:"-"`
+	// LifecycleWarning warns that the app of the instance is deprecated and suggests its
+	// replacement, when the instance is created or upgraded. It is not persisted.
+	LifecycleWarning string `db:"-"`
 
 	AppShmSize             *string
 	AppBrowserConfigURL    *string
@@ -57,6 +70,7 @@ func (a *AppInstance) ToK8sAppInstance() k8s.AppInstance {
 		AppRegistry: utils.ToString(a.AppDockerImageRegistry),
 		AppImage:    utils.ToString(a.AppDockerImageName),
 		AppTag:      utils.ToString(a.AppDockerImageTag),
+		AppDigest:   a.AppDockerImageDigest,
 
 		K8sState: a.K8sState.String(),
 
@@ -0,0 +1,40 @@
+package model
+
+import "time"
+
+const (
+	// DefaultPodLogTailLines is the number of log lines returned when none is requested.
+	DefaultPodLogTailLines = 200
+	// MaxPodLogTailLines caps the number of log lines that can be requested.
+	MaxPodLogTailLines = 5000
+	// MaxPodLogBytes caps the size of the logs returned, whatever the number of lines.
+	MaxPodLogBytes = 1 << 20
+)
+
+// PodLogRequest selects the logs of the pod running a workbench server or an app
+// instance.
+type PodLogRequest struct {
+	TailLines uint32
+	Since     *time.Time
+	// Previous returns the logs of the container that ran before the last restart.
+	Previous bool
+}
+
+// PodLogs are the logs of a pod container, with secrets redacted.
+type PodLogs struct {
+	PodName   string
+	Container string
+	Logs      string
+}
+
+// PodEvent is a Kubernetes event about the pod running a workbench server or an app
+// instance, with secrets redacted.
+type PodEvent struct {
+	Type    string
+	Reason  string
+	Message string
+	Count   int32
+
+	FirstSeenAt time.Time
+	LastSeenAt  time.Time
+}
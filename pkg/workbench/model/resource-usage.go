@@ -0,0 +1,108 @@
+package model
+
+import (
+	"time"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/k8s"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils"
+)
+
+// CPUThrottlingRatio is the share of its declared maximum CPU above which an app
+// instance is considered throttled.
+const CPUThrottlingRatio = 0.9
+
+// ResourceUsage is the CPU and memory used at a point in time.
+type ResourceUsage struct {
+	ObservedAt    time.Time
+	CPUMillicores int64
+	MemoryBytes   int64
+}
+
+// ResourceBounds are the CPU and memory requested and limited by apps, zero when an app
+// does not declare them.
+type ResourceBounds struct {
+	MinCPUMillicores int64
+	MaxCPUMillicores int64
+	MinMemoryBytes   int64
+	MaxMemoryBytes   int64
+}
+
+// ResourceBoundsFromAppInstance returns the bounds declared by the app of an app instance,
+// ignoring the ones that cannot be parsed.
+func ResourceBoundsFromAppInstance(a *AppInstance) ResourceBounds {
+	var b ResourceBounds
+	b.MinCPUMillicores, _ = k8s.ParseCPUMillicores(utils.ToString(a.AppMinCPU))
+	b.MaxCPUMillicores, _ = k8s.ParseCPUMillicores(utils.ToString(a.AppMaxCPU))
+	b.MinMemoryBytes, _ = k8s.ParseMemoryBytes(utils.ToString(a.AppMinMemory))
+	b.MaxMemoryBytes, _ = k8s.ParseMemoryBytes(utils.ToString(a.AppMaxMemory))
+	return b
+}
+
+func (b ResourceBounds) Add(o ResourceBounds) ResourceBounds {
+	return ResourceBounds{
+		MinCPUMillicores: b.MinCPUMillicores + o.MinCPUMillicores,
+		MaxCPUMillicores: b.MaxCPUMillicores + o.MaxCPUMillicores,
+		MinMemoryBytes:   b.MinMemoryBytes + o.MinMemoryBytes,
+		MaxMemoryBytes:   b.MaxMemoryBytes + o.MaxMemoryBytes,
+	}
+}
+
+// AppInstanceUsage is the recent usage of an app instance against the bounds of its app.
+// Current is nil when no usage has been observed, for instance while the app starts.
+type AppInstanceUsage struct {
+	AppInstanceID uint64
+	WorkbenchID   uint64
+	WorkspaceID   uint64
+	AppName       string
+
+	Bounds  ResourceBounds
+	Current *ResourceUsage
+	// History holds the recent samples, oldest first, including the current one.
+	History []ResourceUsage
+}
+
+// CPUThrottled tells whether the app instance currently uses nearly all the CPU its app
+// is limited to.
+func (u *AppInstanceUsage) CPUThrottled() bool {
+	if u.Current == nil || u.Bounds.MaxCPUMillicores == 0 {
+		return false
+	}
+	return float64(u.Current.CPUMillicores) >= CPUThrottlingRatio*float64(u.Bounds.MaxCPUMillicores)
+}
+
+// WorkbenchUsage is the recent usage of a workbench server and of its app instances.
+type WorkbenchUsage struct {
+	WorkbenchID uint64
+	WorkspaceID uint64
+
+	Server        *ResourceUsage
+	ServerHistory []ResourceUsage
+	AppInstances  []*AppInstanceUsage
+
+	// Total sums the current usage of the server and of the app instances, Bounds the
+	// bounds of the apps.
+	Total  ResourceUsage
+	Bounds ResourceBounds
+}
+
+// WorkspaceUsage aggregates the current usage of the workbenches of a workspace.
+type WorkspaceUsage struct {
+	WorkspaceID uint64
+	Workbenches []*WorkbenchUsage
+
+	Total  ResourceUsage
+	Bounds ResourceBounds
+}
+
+// Add sums the usage of two observations, keeping the latest observation time.
+func (u ResourceUsage) Add(o ResourceUsage) ResourceUsage {
+	observedAt := u.ObservedAt
+	if o.ObservedAt.After(observedAt) {
+		observedAt = o.ObservedAt
+	}
+	return ResourceUsage{
+		ObservedAt:    observedAt,
+		CPUMillicores: u.CPUMillicores + o.CPUMillicores,
+		MemoryBytes:   u.MemoryBytes + o.MemoryBytes,
+	}
+}
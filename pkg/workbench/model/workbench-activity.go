@@ -0,0 +1,28 @@
+package model
+
+import "time"
+
+// WorkbenchActivity is what the idle cleaner knows of a workbench to decide whether to
+// warn its owner or reclaim it.
+type WorkbenchActivity struct {
+	WorkbenchID uint64
+	TenantID    uint64
+	UserID      uint64
+	WorkspaceID uint64
+	Name        string
+
+	// LastActivityAt is the latest of the last request proxied to the workbench, the
+	// last keep-alive and the last time the idle cleaner found it busy.
+	LastActivityAt time.Time
+	// IdleWarnedAt is when the owner was last warned that the workbench is about to be
+	// reclaimed.
+	IdleWarnedAt *time.Time
+
+	// WorkspaceIdleTimeoutMinutes is the idle timeout set on the workspace, if any.
+	WorkspaceIdleTimeoutMinutes *int
+}
+
+// IsIdleWarned reports whether the owner was warned since the last activity.
+func (a *WorkbenchActivity) IsIdleWarned() bool {
+	return a.IdleWarnedAt != nil && a.IdleWarnedAt.After(a.LastActivityAt)
+}
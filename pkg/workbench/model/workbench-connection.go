@@ -0,0 +1,50 @@
+package model
+
+import "time"
+
+// WorkbenchConnection maps an entry in the 'workbench_connections' database table. A
+// connection is a stream of a workbench opened by a user, from the upgrade of its
+// websocket through the proxy until it is closed.
+type WorkbenchConnection struct {
+	ID uint64
+
+	TenantID    uint64
+	WorkbenchID uint64
+	WorkspaceID uint64
+	UserID      uint64
+
+	ClientIP  string
+	UserAgent string
+
+	// BytesIn is sent by the user to the workbench, BytesOut by the workbench to the user.
+	BytesIn  uint64
+	BytesOut uint64
+
+	StartedAt time.Time
+	// LastSeenAt is updated while the connection is open, so that connections that were
+	// never closed, for instance because the backend stopped, can be bounded.
+	LastSeenAt time.Time
+	EndedAt    *time.Time
+}
+
+// WorkbenchConnectionFilter selects connections. From and To select the connections that
+// were open at some point in between.
+type WorkbenchConnectionFilter struct {
+	WorkbenchID uint64
+	WorkspaceID uint64
+	UserID      uint64
+	From        time.Time
+	To          time.Time
+}
+
+func (WorkbenchConnection) IsValidSortType(sortType string) bool {
+	validSortTypes := map[string]bool{
+		"id":          true,
+		"workbenchid": true,
+		"userid":      true,
+		"startedat":   true,
+		"endedat":     true,
+	}
+
+	return validSortTypes[sortType]
+}
@@ -0,0 +1,107 @@
+package model
+
+import (
+	"time"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils"
+)
+
+// WorkbenchHibernation maps an entry in the 'workbench_hibernations' database table. It
+// is what a hibernated workbench was running when its K8s resources were torn down, so
+// that resuming it rebuilds the same desktop.
+type WorkbenchHibernation struct {
+	WorkbenchID uint64
+	TenantID    uint64
+
+	InitialResolutionWidth  uint32
+	InitialResolutionHeight uint32
+
+	AppInstances []HibernatedAppInstance
+
+	CreatedAt time.Time
+}
+
+// HibernatedAppInstance is an app instance as it was when its workbench was hibernated,
+// pinned to the image it was running.
+type HibernatedAppInstance struct {
+	AppInstanceID uint64 `json:"appInstanceId"`
+	AppID         uint64 `json:"appId"`
+	AppVersionID  uint64 `json:"appVersionId,omitempty"`
+	AppName       string `json:"appName"`
+
+	DockerImageRegistry string `json:"dockerImageRegistry"`
+	DockerImageName     string `json:"dockerImageName"`
+	DockerImageTag      string `json:"dockerImageTag"`
+	DockerImageDigest   string `json:"dockerImageDigest,omitempty"`
+
+	K8sState K8sAppInstanceState `json:"k8sState"`
+
+	InitialResolutionWidth  uint32 `json:"initialResolutionWidth"`
+	InitialResolutionHeight uint32 `json:"initialResolutionHeight"`
+}
+
+// NewWorkbenchHibernation records the app instances a workbench is running.
+func NewWorkbenchHibernation(workbench *Workbench, apps []*AppInstance) *WorkbenchHibernation {
+	hibernated := make([]HibernatedAppInstance, 0, len(apps))
+	for _, app := range apps {
+		hibernated = append(hibernated, HibernatedAppInstance{
+			AppInstanceID:           app.ID,
+			AppID:                   app.AppID,
+			AppVersionID:            app.AppVersionID,
+			AppName:                 utils.ToString(app.AppName),
+			DockerImageRegistry:     utils.ToString(app.AppDockerImageRegistry),
+			DockerImageName:         utils.ToString(app.AppDockerImageName),
+			DockerImageTag:          utils.ToString(app.AppDockerImageTag),
+			DockerImageDigest:       app.AppDockerImageDigest,
+			K8sState:                app.K8sState,
+			InitialResolutionWidth:  app.InitialResolutionWidth,
+			InitialResolutionHeight: app.InitialResolutionHeight,
+		})
+	}
+
+	return &WorkbenchHibernation{
+		WorkbenchID:             workbench.ID,
+		TenantID:                workbench.TenantID,
+		InitialResolutionWidth:  workbench.InitialResolutionWidth,
+		InitialResolutionHeight: workbench.InitialResolutionHeight,
+		AppInstances:            hibernated,
+	}
+}
+
+// Restore pins the app instances of the workbench back to what they were when it was
+// hibernated. App instances deleted since are left out. The version and digest restored
+// are only kept by the workbench once saved on the app instances.
+func (h *WorkbenchHibernation) Restore(workbench *Workbench, apps []*AppInstance) []*AppInstance {
+	workbench.InitialResolutionWidth = h.InitialResolutionWidth
+	workbench.InitialResolutionHeight = h.InitialResolutionHeight
+
+	byID := make(map[uint64]*AppInstance, len(apps))
+	for _, app := range apps {
+		byID[app.ID] = app
+	}
+
+	restored := make([]*AppInstance, 0, len(h.AppInstances))
+	for _, hibernated := range h.AppInstances {
+		app, ok := byID[hibernated.AppInstanceID]
+		if !ok {
+			continue
+		}
+
+		if hibernated.AppVersionID != 0 {
+			app.AppVersionID = hibernated.AppVersionID
+		}
+		if hibernated.DockerImageDigest != "" {
+			app.AppDockerImageDigest = hibernated.DockerImageDigest
+		}
+		app.AppName = &hibernated.AppName
+		app.AppDockerImageRegistry = &hibernated.DockerImageRegistry
+		app.AppDockerImageName = &hibernated.DockerImageName
+		app.AppDockerImageTag = &hibernated.DockerImageTag
+		app.K8sState = hibernated.K8sState
+		app.InitialResolutionWidth = hibernated.InitialResolutionWidth
+		app.InitialResolutionHeight = hibernated.InitialResolutionHeight
+		restored = append(restored, app)
+	}
+
+	return restored
+}
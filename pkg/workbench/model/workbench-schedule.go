@@ -0,0 +1,91 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// WorkbenchSchedule maps an entry in the 'workbench_schedules' database table. It is the
+// weekly window during which a workbench is available: outside of it, the
+// workbench_schedule job stops the workbench server and its app instances, and it brings
+// them back when the window opens again.
+type WorkbenchSchedule struct {
+	WorkbenchID uint64
+	TenantID    uint64
+
+	// Timezone is the IANA name of the time zone the window is expressed in, e.g. Europe/Zurich.
+	Timezone string
+	// Weekdays are the days on which the window opens.
+	Weekdays []time.Weekday
+	// StartTime and StopTime are times of day formatted as HH:MM. A StopTime earlier than
+	// StartTime makes the window run overnight, until StopTime on the next day.
+	StartTime string
+	StopTime  string
+
+	// StoppedAt is set while the workbench is stopped by its schedule.
+	StoppedAt *time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// IsOpen tells whether the workbench should be running at the given time.
+func (s WorkbenchSchedule) IsOpen(now time.Time) (bool, error) {
+	loc, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return false, fmt.Errorf("invalid timezone %q: %w", s.Timezone, err)
+	}
+	start, err := ParseTimeOfDay(s.StartTime)
+	if err != nil {
+		return false, err
+	}
+	stop, err := ParseTimeOfDay(s.StopTime)
+	if err != nil {
+		return false, err
+	}
+
+	local := now.In(loc)
+	timeOfDay := time.Duration(local.Hour())*time.Hour + time.Duration(local.Minute())*time.Minute + time.Duration(local.Second())*time.Second
+	today := local.Weekday()
+	yesterday := (today + 6) % 7
+
+	if start < stop {
+		return s.opensOn(today) && timeOfDay >= start && timeOfDay < stop, nil
+	}
+	return (s.opensOn(today) && timeOfDay >= start) || (s.opensOn(yesterday) && timeOfDay < stop), nil
+}
+
+func (s WorkbenchSchedule) opensOn(day time.Weekday) bool {
+	for _, d := range s.Weekdays {
+		if d == day {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseTimeOfDay parses a HH:MM time of day into the duration since midnight.
+func ParseTimeOfDay(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %q, expected HH:MM: %w", s, err)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// WeekdayName returns the lowercase english name of a weekday, as stored in the database
+// and exposed by the API.
+func WeekdayName(day time.Weekday) string {
+	return strings.ToLower(day.String())
+}
+
+// ToWeekday parses a weekday name, case insensitively.
+func ToWeekday(name string) (time.Weekday, error) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(name, d.String()) {
+			return d, nil
+		}
+	}
+	return 0, fmt.Errorf("unexpected weekday: %s", name)
+}
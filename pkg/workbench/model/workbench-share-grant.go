@@ -0,0 +1,114 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	authz "github.com/CHORUS-TRE/chorus-backend/pkg/authorization/model"
+)
+
+// WorkbenchShareMode tells what the grantees of a share grant may do in the workbench.
+type WorkbenchShareMode string
+
+const (
+	// WorkbenchShareModeView lets the grantees watch the workbench.
+	WorkbenchShareModeView WorkbenchShareMode = "view"
+	// WorkbenchShareModeInteractive lets the grantees use the workbench and its app
+	// instances, as workbench members.
+	WorkbenchShareModeInteractive WorkbenchShareMode = "interactive"
+)
+
+func (m WorkbenchShareMode) String() string {
+	return string(m)
+}
+
+// RoleName returns the workbench role the grantees are given in this mode.
+func (m WorkbenchShareMode) RoleName() authz.RoleName {
+	if m == WorkbenchShareModeInteractive {
+		return authz.RoleWorkbenchMember.Name
+	}
+	return authz.RoleWorkbenchViewer.Name
+}
+
+func ToWorkbenchShareMode(mode string) (WorkbenchShareMode, error) {
+	switch mode {
+	case WorkbenchShareModeView.String():
+		return WorkbenchShareModeView, nil
+	case WorkbenchShareModeInteractive.String():
+		return WorkbenchShareModeInteractive, nil
+	default:
+		return "", fmt.Errorf("unexpected WorkbenchShareMode: %s", mode)
+	}
+}
+
+type WorkbenchShareGrantStatus string
+
+const (
+	WorkbenchShareGrantStatusActive  WorkbenchShareGrantStatus = "active"
+	WorkbenchShareGrantStatusRevoked WorkbenchShareGrantStatus = "revoked"
+	WorkbenchShareGrantStatusExpired WorkbenchShareGrantStatus = "expired"
+)
+
+func (s WorkbenchShareGrantStatus) String() string {
+	return string(s)
+}
+
+// WorkbenchShareGrant maps an entry in the 'workbench_share_grants' database table. A
+// share grant gives a user, or the members of a workspace, a workbench role until it
+// expires or is revoked, after which the role is taken back.
+type WorkbenchShareGrant struct {
+	ID uint64
+
+	TenantID    uint64
+	WorkbenchID uint64
+	GranterID   uint64
+
+	// Exactly one of GranteeUserID and GranteeWorkspaceID is set. A workspace grant is
+	// given to the members the workspace has when the grant is created.
+	GranteeUserID      *uint64
+	GranteeWorkspaceID *uint64
+	Mode               WorkbenchShareMode
+
+	Status    WorkbenchShareGrantStatus
+	ExpiresAt time.Time
+	EndedAt   *time.Time
+
+	// Members are the users given a role by the grant.
+	Members []WorkbenchShareGrantMember
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// WorkbenchShareGrantMember maps an entry in the 'workbench_share_grant_members'
+// database table.
+type WorkbenchShareGrantMember struct {
+	UserID uint64
+	// RemovedAt is set once the role given by the grant has been taken back.
+	RemovedAt *time.Time
+}
+
+// EffectiveStatus returns the status of the grant, reporting active grants past their
+// expiry date as expired.
+func (g *WorkbenchShareGrant) EffectiveStatus(now time.Time) WorkbenchShareGrantStatus {
+	if g.Status == WorkbenchShareGrantStatusActive && !g.ExpiresAt.After(now) {
+		return WorkbenchShareGrantStatusExpired
+	}
+	return g.Status
+}
+
+// IsActive reports whether the grant still gives its members access to the workbench.
+func (g *WorkbenchShareGrant) IsActive(now time.Time) bool {
+	return g.EffectiveStatus(now) == WorkbenchShareGrantStatusActive
+}
+
+// HasMember reports whether the user was given a role by the grant that has not been
+// taken back yet.
+func (g *WorkbenchShareGrant) HasMember(userID uint64) bool {
+	for _, m := range g.Members {
+		if m.UserID == userID && m.RemovedAt == nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,38 @@
+package model
+
+import "time"
+
+// WorkbenchStatusEvent is the status of a workbench and of its app instances, as pushed
+// to the clients watching it.
+type WorkbenchStatusEvent struct {
+	Workbench    *Workbench
+	AppInstances []*AppInstance
+	ObservedAt   time.Time
+}
+
+// SameStatusAs reports whether two events carry the same statuses, so that watchers are
+// only sent transitions.
+func (e *WorkbenchStatusEvent) SameStatusAs(other *WorkbenchStatusEvent) bool {
+	if other == nil {
+		return false
+	}
+
+	a, b := e.Workbench, other.Workbench
+	if a.Status != b.Status || a.K8sStatus != b.K8sStatus ||
+		a.ServerPodStatus != b.ServerPodStatus || a.ServerPodMessage != b.ServerPodMessage {
+		return false
+	}
+
+	if len(e.AppInstances) != len(other.AppInstances) {
+		return false
+	}
+	for i, app := range e.AppInstances {
+		o := other.AppInstances[i]
+		if app.ID != o.ID || app.Status != o.Status || app.K8sStatus != o.K8sStatus ||
+			app.K8sMessage != o.K8sMessage || app.K8sState != o.K8sState {
+			return false
+		}
+	}
+
+	return true
+}
@@ -51,9 +51,10 @@ func GetIDFromClusterName(clusterName string) (uint64, error) {
 type WorkbenchStatus string
 
 const (
-	WorkbenchActive   WorkbenchStatus = "active"
-	WorkbenchInactive WorkbenchStatus = "inactive"
-	WorkbenchDeleted  WorkbenchStatus = "deleted"
+	WorkbenchActive     WorkbenchStatus = "active"
+	WorkbenchInactive   WorkbenchStatus = "inactive"
+	WorkbenchHibernated WorkbenchStatus = "hibernated"
+	WorkbenchDeleted    WorkbenchStatus = "deleted"
 )
 
 func (s WorkbenchStatus) String() string {
@@ -101,6 +102,8 @@ func ToWorkbenchStatus(status string) (WorkbenchStatus, error) {
 		return WorkbenchActive, nil
 	case WorkbenchInactive.String():
 		return WorkbenchInactive, nil
+	case WorkbenchHibernated.String():
+		return WorkbenchHibernated, nil
 	case WorkbenchDeleted.String():
 		return WorkbenchDeleted, nil
 	default:
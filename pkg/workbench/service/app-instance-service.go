@@ -2,12 +2,14 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"regexp"
 	"strconv"
 
 	"github.com/CHORUS-TRE/chorus-backend/internal/client/k8s"
 	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	app_model "github.com/CHORUS-TRE/chorus-backend/pkg/app/model"
 	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
 )
@@ -69,10 +71,57 @@ func (s *WorkbenchService) UpdateAppInstance(ctx context.Context, appInstance *m
 }
 
 func (s *WorkbenchService) CreateAppInstance(ctx context.Context, appInstance *model.AppInstance) (*model.AppInstance, error) {
+	workbench, err := s.store.GetWorkbench(ctx, appInstance.TenantID, appInstance.WorkbenchID)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to get workbench %v", appInstance.WorkbenchID))
+	}
+	if workbench.Status == model.WorkbenchInactive {
+		return nil, cerr.ErrInvalidRequest.WithMessage(fmt.Sprintf("Unable to create an app instance in workbench %v as it is stopped outside of its schedule", appInstance.WorkbenchID))
+	}
+	if workbench.Status == model.WorkbenchHibernated {
+		return nil, cerr.ErrInvalidRequest.WithMessage(fmt.Sprintf("Unable to create an app instance in workbench %v as it is hibernated", appInstance.WorkbenchID))
+	}
+	ws, err := s.workspaceReader.GetWorkspace(ctx, appInstance.TenantID, workbench.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if ws.Status.IsReadOnly() {
+		return nil, cerr.ErrReadOnly.WithMessage(fmt.Sprintf("Unable to create an app instance in workspace %v as it is %v", workbench.WorkspaceID, ws.Status))
+	}
+
 	app, err := s.apper.GetApp(ctx, appInstance.TenantID, appInstance.AppID)
 	if err != nil {
 		return nil, cerr.ErrInternal.Wrap(err, fmt.Sprintf("Unable to get app %v", appInstance.AppID))
 	}
+	if err := checkAppLaunchable(app, workbench.WorkspaceID); err != nil {
+		return nil, err
+	}
+
+	version, err := appVersionToRun(app, appInstance.AppVersionID)
+	if err != nil {
+		return nil, err
+	}
+	appInstance.AppVersionID = version.ID
+
+	settings, err := s.platformSettings.GetPlatformSettings(ctx, appInstance.TenantID)
+	if err != nil {
+		return nil, cerr.ErrInternal.Wrap(err, "Unable to get platform settings")
+	}
+
+	verdict, err := checkVulnerabilityPolicy(settings.VulnerabilityPolicy(), app, version)
+	if err != nil {
+		return nil, err
+	}
+
+	digest, err := s.resolveImageDigest(app, version)
+	if err != nil {
+		return nil, err
+	}
+	appInstance.AppDockerImageDigest = digest
+
+	if err := s.verifyImageSignature(ctx, appInstance.TenantID, app, version, digest); err != nil {
+		return nil, err
+	}
 
 	if app.BrowserConfigJWTOIDCClientID != "" {
 		token, _, err := s.authenticator.GetShortLivedTokenForClient(ctx, app.BrowserConfigJWTOIDCClientID, appInstance.WorkspaceID)
@@ -82,8 +131,11 @@ func (s *WorkbenchService) CreateAppInstance(ctx context.Context, appInstance *m
 		appInstance.BrowserConfigJWTToken = token
 	}
 
-	newAppInstance, err := s.store.CreateAppInstance(ctx, appInstance.TenantID, appInstance)
+	newAppInstance, err := s.store.CreateAppInstance(ctx, appInstance.TenantID, appInstance, settings.MaxAppInstancesPerUser)
 	if err != nil {
+		if errors.Is(err, cerr.ErrQuotaReached) {
+			return nil, cerr.ErrQuotaExceeded.Wrap(err, fmt.Sprintf("Maximum number of app instances per user reached (maxAppInstancesPerUser: %v)", settings.MaxAppInstancesPerUser))
+		}
 		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to create appInstance %v", appInstance.ID))
 	}
 
@@ -100,22 +152,229 @@ func (s *WorkbenchService) CreateAppInstance(ctx context.Context, appInstance *m
 		return nil, cerr.ErrInternal.Wrap(err, fmt.Sprintf("Unable to create app instance %v", newAppInstance.ID))
 	}
 
+	newAppInstance.VulnerabilityVerdict = verdict.String()
+	newAppInstance.LifecycleWarning = s.appLifecycleWarning(ctx, app)
 	return newAppInstance, nil
 }
 
+// UpgradeAppInstance moves an app instance to a newer version of its app, its default
+// version when appVersionID is 0, and restarts it on the image of that version. Versions
+// older than the one the app instance runs are refused.
+func (s *WorkbenchService) UpgradeAppInstance(ctx context.Context, tenantID, appInstanceID, appVersionID uint64) (*model.AppInstance, error) {
+	appInstance, err := s.store.GetAppInstance(ctx, tenantID, appInstanceID)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to get appInstance %v", appInstanceID))
+	}
+	if appInstance.Status != model.AppInstanceActive {
+		return nil, cerr.ErrInvalidRequest.WithMessage(fmt.Sprintf("Unable to upgrade appInstance %v as it is %v", appInstanceID, appInstance.Status))
+	}
+
+	workbench, err := s.store.GetWorkbench(ctx, tenantID, appInstance.WorkbenchID)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to get workbench %v", appInstance.WorkbenchID))
+	}
+	if workbench.Status == model.WorkbenchInactive || workbench.Status == model.WorkbenchHibernated {
+		return nil, cerr.ErrInvalidRequest.WithMessage(fmt.Sprintf("Unable to upgrade an app instance in workbench %v as it is %v", appInstance.WorkbenchID, workbench.Status))
+	}
+	ws, err := s.workspaceReader.GetWorkspace(ctx, tenantID, workbench.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if ws.Status.IsReadOnly() {
+		return nil, cerr.ErrReadOnly.WithMessage(fmt.Sprintf("Unable to upgrade an app instance in workspace %v as it is %v", workbench.WorkspaceID, ws.Status))
+	}
+
+	app, err := s.apper.GetApp(ctx, tenantID, appInstance.AppID)
+	if err != nil {
+		return nil, cerr.ErrInternal.Wrap(err, fmt.Sprintf("Unable to get app %v", appInstance.AppID))
+	}
+	if err := checkAppLaunchable(app, workbench.WorkspaceID); err != nil {
+		return nil, err
+	}
+
+	version, err := appVersionToRun(app, appVersionID)
+	if err != nil {
+		return nil, err
+	}
+	if version.ID == appInstance.AppVersionID {
+		return nil, cerr.ErrInvalidRequest.WithMessage(fmt.Sprintf("App instance %v already runs version %q", appInstanceID, version.Tag))
+	}
+	if current := app.Version(appInstance.AppVersionID); appInstance.AppVersionID != 0 && current != nil && !version.IsNewerThan(current) {
+		return nil, cerr.ErrInvalidRequest.WithMessage(fmt.Sprintf("Unable to upgrade app instance %v to version %q as it is older than version %q it runs", appInstanceID, version.Tag, current.Tag))
+	}
+
+	settings, err := s.platformSettings.GetPlatformSettings(ctx, tenantID)
+	if err != nil {
+		return nil, cerr.ErrInternal.Wrap(err, "Unable to get platform settings")
+	}
+
+	verdict, err := checkVulnerabilityPolicy(settings.VulnerabilityPolicy(), app, version)
+	if err != nil {
+		return nil, err
+	}
+
+	digest, err := s.resolveImageDigest(app, version)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyImageSignature(ctx, tenantID, app, version, digest); err != nil {
+		return nil, err
+	}
+
+	upgradedAppInstance, err := s.store.UpdateAppInstanceVersion(ctx, tenantID, appInstanceID, version.ID, digest)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to update version of appInstance %v", appInstanceID))
+	}
+
+	clientApp, err := s.getK8sAppInstance(ctx, upgradedAppInstance)
+	if err != nil {
+		return nil, cerr.ErrInternal.Wrap(err, fmt.Sprintf("Unable to get k8s app instance %v", appInstanceID))
+	}
+
+	err = s.client.UpdateAppInstance(s.getWorkspaceName(upgradedAppInstance.WorkspaceID), s.getWorkbenchName(upgradedAppInstance.WorkbenchID), clientApp)
+	if err != nil {
+		return nil, cerr.ErrInternal.Wrap(err, fmt.Sprintf("Unable to upgrade app instance %v", appInstanceID))
+	}
+
+	upgradedAppInstance.VulnerabilityVerdict = verdict.String()
+	upgradedAppInstance.LifecycleWarning = s.appLifecycleWarning(ctx, app)
+	return upgradedAppInstance, nil
+}
+
+// checkAppLaunchable refuses to run the custom apps of other workspaces, those still
+// pending approval, and the apps that are not approved yet or are retired.
+func checkAppLaunchable(app *app_model.App, workspaceID uint64) error {
+	if !app.IsLaunchableIn(workspaceID) {
+		return cerr.ErrPermissionDenied.WithMessage(fmt.Sprintf("App %v is a custom app of another workspace", app.ID))
+	}
+	if app.Status == app_model.AppPendingApproval {
+		return cerr.ErrInvalidRequest.WithMessage(fmt.Sprintf("App %v is awaiting approval", app.ID))
+	}
+	if !app.LifecycleStatus.IsLaunchable() {
+		if app.LifecycleStatus == app_model.AppLifecycleRetired && app.ReplacementAppID != nil {
+			return cerr.ErrInvalidRequest.WithMessage(fmt.Sprintf("App %v is retired and cannot be launched, use app %v instead", app.ID, *app.ReplacementAppID))
+		}
+		return cerr.ErrInvalidRequest.WithMessage(fmt.Sprintf("App %v is %v and cannot be launched", app.ID, app.LifecycleStatus))
+	}
+
+	return nil
+}
+
+// appLifecycleWarning returns the warning shown to the users of a deprecated app, pointing
+// them to its replacement when it has one.
+func (s *WorkbenchService) appLifecycleWarning(ctx context.Context, app *app_model.App) string {
+	if app.LifecycleStatus != app_model.AppLifecycleDeprecated {
+		return ""
+	}
+	if app.ReplacementAppID == nil {
+		return fmt.Sprintf("App %s is deprecated and will be retired", app.Name)
+	}
+
+	replacement, err := s.apper.GetApp(ctx, app.TenantID, *app.ReplacementAppID)
+	if err != nil {
+		return fmt.Sprintf("App %s is deprecated, use app %v instead", app.Name, *app.ReplacementAppID)
+	}
+	return fmt.Sprintf("App %s is deprecated, use %s (app %v) instead", app.Name, replacement.Name, replacement.ID)
+}
+
+// appVersionToRun returns the version of an app a new or upgraded instance should run:
+// the given version, or the default version of the app when versionID is 0. Deprecated
+// versions cannot be started.
+func appVersionToRun(app *app_model.App, versionID uint64) (*app_model.AppVersion, error) {
+	version := app.Version(versionID)
+	if version == nil {
+		if versionID == 0 {
+			return nil, cerr.ErrInternal.WithMessage(fmt.Sprintf("App %v has no default version", app.ID))
+		}
+		return nil, cerr.ErrNotFound.WithMessage(fmt.Sprintf("App %v has no version %v", app.ID, versionID))
+	}
+	if version.IsDeprecated() {
+		return nil, cerr.ErrInvalidRequest.WithMessage(fmt.Sprintf("Version %q of app %v is deprecated", version.Tag, app.ID))
+	}
+
+	return version, nil
+}
+
+// checkVulnerabilityPolicy applies the vulnerability policy of the tenant to the last scan
+// of an app version, and refuses to run the versions it blocks.
+func checkVulnerabilityPolicy(policy app_model.VulnerabilityPolicy, app *app_model.App, version *app_model.AppVersion) (app_model.VulnerabilityVerdict, error) {
+	verdict := policy.Evaluate(version.VulnerabilityScan)
+	if verdict == app_model.VulnerabilityVerdictBlock {
+		return verdict, cerr.ErrInvalidRequest.WithMessage(fmt.Sprintf("Version %q of app %v has %d vulnerabilities of severity %s or higher and is blocked by the vulnerability policy",
+			version.Tag, app.ID, version.CountAtLeast(policy.BlockSeverity), policy.BlockSeverity))
+	}
+
+	return verdict, nil
+}
+
+// resolveImageDigest returns the digest of the image of an app version, so that an app
+// instance keeps running the same image even if its tag is moved. The digest pinned by
+// the version is used when there is one, otherwise the tag is resolved by the registry.
+func (s *WorkbenchService) resolveImageDigest(app *app_model.App, version *app_model.AppVersion) (string, error) {
+	if version.Digest != "" {
+		return version.Digest, nil
+	}
+
+	imageRef := s.appImage(app) + ":" + version.Tag
+
+	digest, err := s.ociClient.GetDigest(imageRef)
+	if err != nil {
+		return "", cerr.ErrInternal.Wrap(err, fmt.Sprintf("Unable to resolve the digest of image %s", imageRef))
+	}
+
+	return digest, nil
+}
+
+// appImage returns the image of an app without its tag, in the default repository of the
+// default registry when the app has no registry.
+func (s *WorkbenchService) appImage(app *app_model.App) string {
+	if app.DockerImageRegistry == "" {
+		k8sCfg := s.cfg.Clients.K8sClient
+		return k8sCfg.DefaultRegistry + "/" + k8sCfg.DefaultRepository + "/" + app.DockerImageName
+	}
+	return app.DockerImageRegistry + "/" + app.DockerImageName
+}
+
+// verifyImageSignature verifies the signature of the image an app instance is about to
+// run, addressed by its digest when it was resolved.
+func (s *WorkbenchService) verifyImageSignature(ctx context.Context, tenantID uint64, app *app_model.App, version *app_model.AppVersion, digest string) error {
+	imageRef := s.appImage(app) + ":" + version.Tag
+	if digest != "" {
+		imageRef = s.appImage(app) + "@" + digest
+	}
+
+	if _, err := s.apper.VerifyAppSignature(ctx, tenantID, app.ID, imageRef); err != nil {
+		var cErr *cerr.ChorusError
+		if errors.As(err, &cErr) {
+			return err
+		}
+		return cerr.ErrInternal.Wrap(err, fmt.Sprintf("Unable to verify the signature of image %s", imageRef))
+	}
+
+	return nil
+}
+
 func (s *WorkbenchService) getK8sAppInstance(ctx context.Context, appInstance *model.AppInstance) (k8s.AppInstance, error) {
 	app, err := s.apper.GetApp(ctx, appInstance.TenantID, appInstance.AppID)
 	if err != nil {
 		return k8s.AppInstance{}, cerr.ErrInternal.Wrap(err, fmt.Sprintf("Unable to get app %v", appInstance.AppID))
 	}
 
+	// The tag of the version pinned by the instance, when it was loaded with it.
+	tag := app.DockerImageTag
+	if appInstance.AppDockerImageTag != nil {
+		tag = *appInstance.AppDockerImageTag
+	}
+
 	clientApp := k8s.AppInstance{
 		ID:      appInstance.ID,
 		AppName: app.Name,
 
 		AppRegistry: app.DockerImageRegistry,
 		AppImage:    app.DockerImageName,
-		AppTag:      app.DockerImageTag,
+		AppTag:      tag,
+		AppDigest:   appInstance.AppDockerImageDigest,
 
 		K8sState: appInstance.K8sState.String(),
 
@@ -3,7 +3,22 @@
 package service
 
 import (
+	"context"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/k8s"
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/ociregistry"
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	app_model "github.com/CHORUS-TRE/chorus-backend/pkg/app/model"
+	app_service "github.com/CHORUS-TRE/chorus-backend/pkg/app/service"
+	platform_settings_model "github.com/CHORUS-TRE/chorus-backend/pkg/platform-settings/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
+	workspace_model "github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
 )
 
 func TestGetWorkspaceID(t *testing.T) {
@@ -40,3 +55,332 @@ func TestGetWorkspaceID(t *testing.T) {
 		})
 	}
 }
+
+func versionedApp() *app_model.App {
+	return &app_model.App{
+		ID: 9, Name: "jupyter", DockerImageRegistry: "harbor.local", DockerImageName: "apps/jupyter", DockerImageTag: "1.1",
+		AppLifecycle: app_model.AppLifecycle{LifecycleStatus: app_model.AppLifecycleApproved},
+		Versions: []*app_model.AppVersion{
+			{ID: 3, AppID: 9, Tag: "1.2", Status: app_model.AppVersionActive},
+			{ID: 2, AppID: 9, Tag: "1.1", IsDefault: true, Status: app_model.AppVersionActive},
+			{ID: 1, AppID: 9, Tag: "1.0", Status: app_model.AppVersionDeprecated},
+		},
+	}
+}
+
+func assertChorusCode(t *testing.T, expected *cerr.ChorusError, err error) {
+	t.Helper()
+	var cErr *cerr.ChorusError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, expected.ChorusCode, cErr.ChorusCode)
+}
+
+func TestAppVersionToRun(t *testing.T) {
+	app := versionedApp()
+
+	version, err := appVersionToRun(app, 0)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), version.ID, "the default version is used when none is picked")
+
+	version, err = appVersionToRun(app, 3)
+	require.NoError(t, err)
+	assert.Equal(t, "1.2", version.Tag)
+
+	_, err = appVersionToRun(app, 1)
+	assertChorusCode(t, cerr.ErrInvalidRequest, err)
+
+	_, err = appVersionToRun(app, 42)
+	assertChorusCode(t, cerr.ErrNotFound, err)
+}
+
+type upgradeStore struct {
+	mockWorkbenchStore
+	appInstance *model.AppInstance
+	workbench   *model.Workbench
+}
+
+func (s *upgradeStore) GetAppInstance(_ context.Context, _, _ uint64) (*model.AppInstance, error) {
+	ai := *s.appInstance
+	return &ai, nil
+}
+
+func (s *upgradeStore) GetWorkbench(_ context.Context, _, _ uint64) (*model.Workbench, error) {
+	wb := *s.workbench
+	return &wb, nil
+}
+
+func (s *upgradeStore) UpdateAppInstanceVersion(_ context.Context, _, _, appVersionID uint64, imageDigest string) (*model.AppInstance, error) {
+	for _, v := range versionedApp().Versions {
+		if v.ID == appVersionID {
+			s.appInstance.AppVersionID = v.ID
+			s.appInstance.AppDockerImageTag = strPtr(v.Tag)
+			s.appInstance.AppDockerImageDigest = imageDigest
+		}
+	}
+	ai := *s.appInstance
+	return &ai, nil
+}
+
+type versionedApper struct {
+	app_service.Apper
+	scans map[uint64]app_model.VulnerabilityScan
+	// verified lists the images whose signature was verified, unsigned refuses them.
+	verified []string
+	unsigned bool
+	// workspaceID makes the app a custom app of that workspace, status overrides its status.
+	workspaceID *uint64
+	status      app_model.AppStatus
+	// lifecycle overrides the lifecycle of the app, replacement is the app replacing it.
+	lifecycle   *app_model.AppLifecycle
+	replacement *app_model.App
+}
+
+func (a *versionedApper) VerifyAppSignature(_ context.Context, _, _ uint64, imageRef string) (*app_model.AppSignature, error) {
+	a.verified = append(a.verified, imageRef)
+	if a.unsigned {
+		return nil, cerr.ErrValidation.WithMessage("unsigned")
+	}
+	return &app_model.AppSignature{SignatureStatus: app_model.SignatureStatusVerified}, nil
+}
+
+func (a *versionedApper) GetApp(_ context.Context, _, appID uint64) (*app_model.App, error) {
+	if a.replacement != nil && a.replacement.ID == appID {
+		return a.replacement, nil
+	}
+	app := versionedApp()
+	for _, v := range app.Versions {
+		v.VulnerabilityScan = a.scans[v.ID]
+	}
+	app.WorkspaceID = a.workspaceID
+	if a.status != "" {
+		app.Status = a.status
+	}
+	if a.lifecycle != nil {
+		app.AppLifecycle = *a.lifecycle
+	}
+	return app, nil
+}
+
+type policySettingsReader struct {
+	policy app_model.VulnerabilityPolicy
+}
+
+func (r *policySettingsReader) GetPlatformSettings(_ context.Context, tenantID uint64) (*platform_settings_model.PlatformSettings, error) {
+	return &platform_settings_model.PlatformSettings{
+		TenantID:                   tenantID,
+		VulnerabilityBlockSeverity: r.policy.BlockSeverity,
+		VulnerabilityWarnSeverity:  r.policy.WarnSeverity,
+	}, nil
+}
+
+type digestOCIClient struct {
+	ociregistry.OCIClienter
+	resolved []string
+}
+
+func (c *digestOCIClient) GetDigest(imageRef string) (string, error) {
+	c.resolved = append(c.resolved, imageRef)
+	return "sha256:" + strings.Repeat("a", 64), nil
+}
+
+type appInstanceK8sClient struct {
+	k8s.K8sClienter
+	updated []k8s.AppInstance
+}
+
+func (c *appInstanceK8sClient) UpdateAppInstance(_, _ string, appInstance k8s.AppInstance) error {
+	c.updated = append(c.updated, appInstance)
+	return nil
+}
+
+func newUpgradeSvc(status model.WorkbenchStatus) (*WorkbenchService, *upgradeStore, *appInstanceK8sClient) {
+	store := &upgradeStore{
+		appInstance: &model.AppInstance{
+			ID: 5, TenantID: 1, AppID: 9, AppVersionID: 2, WorkspaceID: 7, WorkbenchID: 4,
+			Status: model.AppInstanceActive, AppDockerImageTag: strPtr("1.1"),
+		},
+		workbench: &model.Workbench{ID: 4, TenantID: 1, WorkspaceID: 7, Status: status},
+	}
+	client := &appInstanceK8sClient{}
+
+	return &WorkbenchService{
+		store:            store,
+		client:           client,
+		ociClient:        &digestOCIClient{},
+		apper:            &versionedApper{},
+		workspaceReader:  &mockWorkspaceReader{status: workspace_model.WorkspaceStatusActive},
+		platformSettings: &policySettingsReader{},
+	}, store, client
+}
+
+func TestUpgradeAppInstance(t *testing.T) {
+	svc, _, client := newUpgradeSvc(model.WorkbenchActive)
+
+	upgraded, err := svc.UpgradeAppInstance(context.Background(), 1, 5, 3)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), upgraded.AppVersionID)
+
+	assert.Equal(t, "sha256:"+strings.Repeat("a", 64), upgraded.AppDockerImageDigest)
+
+	require.Len(t, client.updated, 1)
+	assert.Equal(t, "1.2", client.updated[0].AppTag, "the instance is restarted on the image of the new version")
+	assert.Equal(t, "apps/jupyter", client.updated[0].AppImage)
+	assert.Equal(t, upgraded.AppDockerImageDigest, client.updated[0].AppDigest, "the instance is pinned to the digest")
+}
+
+func TestResolveImageDigest(t *testing.T) {
+	oci := &digestOCIClient{}
+	svc := &WorkbenchService{ociClient: oci}
+	svc.cfg.Clients.K8sClient.DefaultRegistry = "registry.local"
+	svc.cfg.Clients.K8sClient.DefaultRepository = "chorus"
+	app := versionedApp()
+
+	digest, err := svc.resolveImageDigest(app, app.Version(3))
+	require.NoError(t, err)
+	assert.Equal(t, "sha256:"+strings.Repeat("a", 64), digest)
+	assert.Equal(t, []string{"harbor.local/apps/jupyter:1.2"}, oci.resolved)
+
+	// The digest pinned by a version is used as is.
+	pinned := &app_model.AppVersion{Tag: "1.3", Digest: "sha256:" + strings.Repeat("b", 64)}
+	digest, err = svc.resolveImageDigest(app, pinned)
+	require.NoError(t, err)
+	assert.Equal(t, pinned.Digest, digest)
+	assert.Len(t, oci.resolved, 1)
+
+	// Apps without a registry live in the default repository.
+	app.DockerImageRegistry = ""
+	_, err = svc.resolveImageDigest(app, app.Version(0))
+	require.NoError(t, err)
+	assert.Equal(t, "registry.local/chorus/apps/jupyter:1.1", oci.resolved[1])
+}
+
+func TestUpgradeAppInstance_Rejected(t *testing.T) {
+	svc, _, client := newUpgradeSvc(model.WorkbenchActive)
+
+	_, err := svc.UpgradeAppInstance(context.Background(), 1, 5, 2)
+	assertChorusCode(t, cerr.ErrInvalidRequest, err)
+
+	_, err = svc.UpgradeAppInstance(context.Background(), 1, 5, 1)
+	assertChorusCode(t, cerr.ErrInvalidRequest, err)
+
+	svc, _, _ = newUpgradeSvc(model.WorkbenchHibernated)
+	_, err = svc.UpgradeAppInstance(context.Background(), 1, 5, 3)
+	assertChorusCode(t, cerr.ErrInvalidRequest, err)
+
+	svc, store, _ := newUpgradeSvc(model.WorkbenchActive)
+	store.appInstance.Status = model.AppInstanceInactive
+	_, err = svc.UpgradeAppInstance(context.Background(), 1, 5, 3)
+	assertChorusCode(t, cerr.ErrInvalidRequest, err)
+
+	svc, _, _ = newUpgradeSvc(model.WorkbenchActive)
+	svc.workspaceReader = &mockWorkspaceReader{status: workspace_model.WorkspaceStatusFrozen}
+	_, err = svc.UpgradeAppInstance(context.Background(), 1, 5, 3)
+	assertChorusCode(t, cerr.ErrReadOnly, err)
+
+	assert.Empty(t, client.updated)
+}
+
+func TestUpgradeAppInstance_RejectsOlderVersions(t *testing.T) {
+	svc, store, client := newUpgradeSvc(model.WorkbenchActive)
+	store.appInstance.AppVersionID = 3
+
+	_, err := svc.UpgradeAppInstance(context.Background(), 1, 5, 2)
+	assertChorusCode(t, cerr.ErrInvalidRequest, err)
+
+	// The default version is older too.
+	_, err = svc.UpgradeAppInstance(context.Background(), 1, 5, 0)
+	assertChorusCode(t, cerr.ErrInvalidRequest, err)
+
+	assert.Empty(t, client.updated)
+}
+
+func TestUpgradeAppInstance_VulnerabilityPolicy(t *testing.T) {
+	scannedAt := time.Now()
+	policy := app_model.VulnerabilityPolicy{BlockSeverity: app_model.SeverityCritical, WarnSeverity: app_model.SeverityHigh}
+
+	svc, store, client := newUpgradeSvc(model.WorkbenchActive)
+	svc.platformSettings = &policySettingsReader{policy: policy}
+	svc.apper = &versionedApper{scans: map[uint64]app_model.VulnerabilityScan{
+		3: {ScanStatus: "Success", ScanCritical: 2, ScannedAt: &scannedAt},
+	}}
+
+	_, err := svc.UpgradeAppInstance(context.Background(), 1, 5, 3)
+	assertChorusCode(t, cerr.ErrInvalidRequest, err)
+	assert.Contains(t, err.Error(), "vulnerability policy")
+	assert.Equal(t, uint64(2), store.appInstance.AppVersionID, "a blocked version is not started")
+	assert.Empty(t, client.updated)
+
+	svc.apper = &versionedApper{scans: map[uint64]app_model.VulnerabilityScan{
+		3: {ScanStatus: "Success", ScanHigh: 1, ScannedAt: &scannedAt},
+	}}
+	upgraded, err := svc.UpgradeAppInstance(context.Background(), 1, 5, 3)
+	require.NoError(t, err)
+	assert.Equal(t, app_model.VulnerabilityVerdictWarn.String(), upgraded.VulnerabilityVerdict)
+	assert.Len(t, client.updated, 1)
+}
+
+func TestUpgradeAppInstance_VerifiesSignature(t *testing.T) {
+	svc, store, client := newUpgradeSvc(model.WorkbenchActive)
+	apper := &versionedApper{unsigned: true}
+	svc.apper = apper
+
+	_, err := svc.UpgradeAppInstance(context.Background(), 1, 5, 3)
+	assertChorusCode(t, cerr.ErrValidation, err)
+	require.Len(t, apper.verified, 1)
+	assert.Equal(t, "harbor.local/apps/jupyter@sha256:"+strings.Repeat("a", 64), apper.verified[0], "the image is verified by its digest")
+	assert.Equal(t, uint64(2), store.appInstance.AppVersionID)
+	assert.Empty(t, client.updated)
+
+	apper.unsigned = false
+	_, err = svc.UpgradeAppInstance(context.Background(), 1, 5, 3)
+	require.NoError(t, err)
+	assert.Len(t, client.updated, 1)
+}
+
+func TestUpgradeAppInstance_CustomApps(t *testing.T) {
+	otherWorkspace, ownWorkspace := uint64(8), uint64(7)
+
+	svc, store, client := newUpgradeSvc(model.WorkbenchActive)
+	svc.apper = &versionedApper{workspaceID: &otherWorkspace}
+	_, err := svc.UpgradeAppInstance(context.Background(), 1, 5, 3)
+	assertChorusCode(t, cerr.ErrPermissionDenied, err)
+
+	svc.apper = &versionedApper{workspaceID: &ownWorkspace, status: app_model.AppPendingApproval}
+	_, err = svc.UpgradeAppInstance(context.Background(), 1, 5, 3)
+	assertChorusCode(t, cerr.ErrInvalidRequest, err)
+	assert.Equal(t, uint64(2), store.appInstance.AppVersionID)
+	assert.Empty(t, client.updated)
+
+	svc.apper = &versionedApper{workspaceID: &ownWorkspace}
+	_, err = svc.UpgradeAppInstance(context.Background(), 1, 5, 3)
+	require.NoError(t, err)
+	assert.Len(t, client.updated, 1)
+}
+
+func TestUpgradeAppInstance_Lifecycle(t *testing.T) {
+	replacementID := uint64(12)
+
+	for _, status := range []app_model.AppLifecycleStatus{app_model.AppLifecycleSubmitted, app_model.AppLifecycleUnderReview, app_model.AppLifecycleRetired} {
+		svc, store, client := newUpgradeSvc(model.WorkbenchActive)
+		svc.apper = &versionedApper{lifecycle: &app_model.AppLifecycle{LifecycleStatus: status, ReplacementAppID: &replacementID}}
+		_, err := svc.UpgradeAppInstance(context.Background(), 1, 5, 3)
+		assertChorusCode(t, cerr.ErrInvalidRequest, err)
+		assert.Equal(t, uint64(2), store.appInstance.AppVersionID, "%v apps are not started", status)
+		assert.Empty(t, client.updated)
+	}
+
+	svc, _, client := newUpgradeSvc(model.WorkbenchActive)
+	svc.apper = &versionedApper{
+		lifecycle:   &app_model.AppLifecycle{LifecycleStatus: app_model.AppLifecycleDeprecated, ReplacementAppID: &replacementID},
+		replacement: &app_model.App{ID: replacementID, Name: "jupyterlab"},
+	}
+	upgraded, err := svc.UpgradeAppInstance(context.Background(), 1, 5, 3)
+	require.NoError(t, err)
+	assert.Equal(t, "App jupyter is deprecated, use jupyterlab (app 12) instead", upgraded.LifecycleWarning)
+	assert.Len(t, client.updated, 1)
+
+	svc, _, _ = newUpgradeSvc(model.WorkbenchActive)
+	upgraded, err = svc.UpgradeAppInstance(context.Background(), 1, 5, 3)
+	require.NoError(t, err)
+	assert.Empty(t, upgraded.LifecycleWarning, "approved apps have no warning")
+}
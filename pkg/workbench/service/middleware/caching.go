@@ -3,6 +3,7 @@ package middleware
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
 	"github.com/CHORUS-TRE/chorus-backend/internal/utils/cache"
@@ -18,6 +19,8 @@ const (
 	workbenchCacheSize     = 100 * 1024 * 1024 // Max 100MiB stored in memory
 	defaultCacheExpiration = 1
 	longCacheExpiration    = 60
+
+	shareRolesCacheExpiration = 10
 )
 
 func WorkbenchCaching(log *logger.ContextLogger) func(service.Workbencher) *Caching {
@@ -63,8 +66,8 @@ func (c *Caching) GetWorkbench(ctx context.Context, tenantID, workbenchID uint64
 	return
 }
 
-func (c *Caching) ProxyWorkbench(ctx context.Context, tenantID, workbenchID uint64, w http.ResponseWriter, r *http.Request) error {
-	return c.next.ProxyWorkbench(ctx, tenantID, workbenchID, w, r)
+func (c *Caching) ProxyWorkbench(ctx context.Context, tenantID, workbenchID uint64, readOnly bool, w http.ResponseWriter, r *http.Request) error {
+	return c.next.ProxyWorkbench(ctx, tenantID, workbenchID, readOnly, w, r)
 
 }
 
@@ -121,6 +124,10 @@ func (c *Caching) UpdateAppInstance(ctx context.Context, appInstance *model.AppI
 	return c.next.UpdateAppInstance(ctx, appInstance)
 }
 
+func (c *Caching) UpgradeAppInstance(ctx context.Context, tenantID, appInstanceID, appVersionID uint64) (*model.AppInstance, error) {
+	return c.next.UpgradeAppInstance(ctx, tenantID, appInstanceID, appVersionID)
+}
+
 func (c *Caching) CreateAppInstance(ctx context.Context, appInstance *model.AppInstance) (*model.AppInstance, error) {
 	return c.next.CreateAppInstance(ctx, appInstance)
 }
@@ -132,3 +139,111 @@ func (c *Caching) AddUserRoleInWorkbench(ctx context.Context, tenantID, userID u
 func (c *Caching) RemoveUserFromWorkbench(ctx context.Context, tenantID, userID, workbenchID uint64) error {
 	return c.next.RemoveUserFromWorkbench(ctx, tenantID, userID, workbenchID)
 }
+
+func (c *Caching) GetWorkbenchSchedule(ctx context.Context, tenantID, workbenchID uint64) (*model.WorkbenchSchedule, error) {
+	return c.next.GetWorkbenchSchedule(ctx, tenantID, workbenchID)
+}
+
+func (c *Caching) SetWorkbenchSchedule(ctx context.Context, schedule *model.WorkbenchSchedule) (*model.WorkbenchSchedule, error) {
+	return c.next.SetWorkbenchSchedule(ctx, schedule)
+}
+
+func (c *Caching) DeleteWorkbenchSchedule(ctx context.Context, tenantID, workbenchID uint64) error {
+	return c.next.DeleteWorkbenchSchedule(ctx, tenantID, workbenchID)
+}
+
+func (c *Caching) StopScheduledWorkbench(ctx context.Context, tenantID, workbenchID uint64) error {
+	return c.next.StopScheduledWorkbench(ctx, tenantID, workbenchID)
+}
+
+func (c *Caching) StartScheduledWorkbench(ctx context.Context, tenantID, workbenchID uint64) error {
+	return c.next.StartScheduledWorkbench(ctx, tenantID, workbenchID)
+}
+
+func (c *Caching) KeepWorkbenchAlive(ctx context.Context, tenantID, workbenchID uint64) (*time.Time, error) {
+	return c.next.KeepWorkbenchAlive(ctx, tenantID, workbenchID)
+}
+
+func (c *Caching) HibernateWorkbench(ctx context.Context, tenantID, workbenchID uint64) (*model.Workbench, error) {
+	return c.next.HibernateWorkbench(ctx, tenantID, workbenchID)
+}
+
+func (c *Caching) ResumeWorkbench(ctx context.Context, tenantID, workbenchID uint64) (*model.Workbench, error) {
+	return c.next.ResumeWorkbench(ctx, tenantID, workbenchID)
+}
+
+func (c *Caching) CreateWorkbenchShareGrant(ctx context.Context, grant *model.WorkbenchShareGrant) (*model.WorkbenchShareGrant, error) {
+	return c.next.CreateWorkbenchShareGrant(ctx, grant)
+}
+
+func (c *Caching) ListWorkbenchShareGrants(ctx context.Context, tenantID, workbenchID uint64) ([]*model.WorkbenchShareGrant, error) {
+	return c.next.ListWorkbenchShareGrants(ctx, tenantID, workbenchID)
+}
+
+func (c *Caching) RevokeWorkbenchShareGrant(ctx context.Context, tenantID, workbenchID, grantID uint64) (*model.WorkbenchShareGrant, error) {
+	return c.next.RevokeWorkbenchShareGrant(ctx, tenantID, workbenchID, grantID)
+}
+
+func (c *Caching) ExpireWorkbenchShareGrants(ctx context.Context) (int, error) {
+	return c.next.ExpireWorkbenchShareGrants(ctx)
+}
+
+func (c *Caching) ListWorkbenchShareGrantees(ctx context.Context, grant *model.WorkbenchShareGrant) ([]uint64, error) {
+	return c.next.ListWorkbenchShareGrantees(ctx, grant)
+}
+
+// GetWorkbenchShareRoles is called by the workbench proxy on every streamed request, the
+// answer is kept for a few seconds so that an ended grant is still caught quickly.
+func (c *Caching) GetWorkbenchShareRoles(ctx context.Context, tenantID, workbenchID, userID uint64) (reply []user_model.UserRole, stale bool, err error) {
+	entry := c.cache.NewEntry(cache.WithUint64(tenantID), cache.WithUint64(workbenchID), cache.WithUint64(userID))
+	reply = []user_model.UserRole{}
+
+	if ok := entry.Get(ctx, &reply, &stale); !ok {
+		reply, stale, err = c.next.GetWorkbenchShareRoles(ctx, tenantID, workbenchID, userID)
+		if err == nil {
+			entry.Set(ctx, shareRolesCacheExpiration, reply, stale)
+		}
+	}
+
+	return
+}
+
+func (c *Caching) WatchWorkbench(ctx context.Context, tenantID, workbenchID uint64) (<-chan *model.WorkbenchStatusEvent, error) {
+	return c.next.WatchWorkbench(ctx, tenantID, workbenchID)
+}
+
+func (c *Caching) WatchWorkspace(ctx context.Context, tenantID, workspaceID uint64) (<-chan *model.WorkbenchStatusEvent, error) {
+	return c.next.WatchWorkspace(ctx, tenantID, workspaceID)
+}
+
+func (c *Caching) GetAppInstanceLogs(ctx context.Context, tenantID, appInstanceID uint64, req model.PodLogRequest) (*model.PodLogs, error) {
+	return c.next.GetAppInstanceLogs(ctx, tenantID, appInstanceID, req)
+}
+
+func (c *Caching) ListAppInstanceEvents(ctx context.Context, tenantID, appInstanceID uint64) ([]*model.PodEvent, error) {
+	return c.next.ListAppInstanceEvents(ctx, tenantID, appInstanceID)
+}
+
+func (c *Caching) GetWorkbenchServerLogs(ctx context.Context, tenantID, workbenchID uint64, req model.PodLogRequest) (*model.PodLogs, error) {
+	return c.next.GetWorkbenchServerLogs(ctx, tenantID, workbenchID, req)
+}
+
+func (c *Caching) ListWorkbenchServerEvents(ctx context.Context, tenantID, workbenchID uint64) ([]*model.PodEvent, error) {
+	return c.next.ListWorkbenchServerEvents(ctx, tenantID, workbenchID)
+}
+
+func (c *Caching) GetAppInstanceUsage(ctx context.Context, tenantID, appInstanceID uint64) (*model.AppInstanceUsage, error) {
+	return c.next.GetAppInstanceUsage(ctx, tenantID, appInstanceID)
+}
+
+func (c *Caching) GetWorkbenchUsage(ctx context.Context, tenantID, workbenchID uint64) (*model.WorkbenchUsage, error) {
+	return c.next.GetWorkbenchUsage(ctx, tenantID, workbenchID)
+}
+
+func (c *Caching) GetWorkspaceUsage(ctx context.Context, tenantID, workspaceID uint64) (*model.WorkspaceUsage, error) {
+	return c.next.GetWorkspaceUsage(ctx, tenantID, workspaceID)
+}
+
+func (c *Caching) ListWorkbenchConnections(ctx context.Context, tenantID uint64, pagination *common_model.Pagination, filter model.WorkbenchConnectionFilter) ([]*model.WorkbenchConnection, *common_model.PaginationResult, error) {
+	return c.next.ListWorkbenchConnections(ctx, tenantID, pagination, filter)
+}
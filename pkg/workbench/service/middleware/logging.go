@@ -47,10 +47,10 @@ func (c workbenchServiceLogging) ListWorkbenches(ctx context.Context, tenantID u
 	return res, paginationRes, nil
 }
 
-func (c workbenchServiceLogging) ProxyWorkbench(ctx context.Context, tenantID, workbenchID uint64, w http.ResponseWriter, r *http.Request) error {
+func (c workbenchServiceLogging) ProxyWorkbench(ctx context.Context, tenantID, workbenchID uint64, readOnly bool, w http.ResponseWriter, r *http.Request) error {
 	now := time.Now()
 
-	err := c.next.ProxyWorkbench(ctx, tenantID, workbenchID, w, r)
+	err := c.next.ProxyWorkbench(ctx, tenantID, workbenchID, readOnly, w, r)
 	if err != nil {
 		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
 			zap.Error(err),
@@ -244,6 +244,28 @@ func (c workbenchServiceLogging) UpdateAppInstance(ctx context.Context, appInsta
 	return updatedAppInstance, nil
 }
 
+func (c workbenchServiceLogging) UpgradeAppInstance(ctx context.Context, tenantID, appInstanceID, appVersionID uint64) (*model.AppInstance, error) {
+	now := time.Now()
+
+	upgradedAppInstance, err := c.next.UpgradeAppInstance(ctx, tenantID, appInstanceID, appVersionID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithAppInstanceIDField(appInstanceID),
+			zap.Uint64("app_version_id", appVersionID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithAppInstanceIDField(appInstanceID),
+		zap.Uint64("app_version_id", upgradedAppInstance.AppVersionID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return upgradedAppInstance, nil
+}
+
 func (c workbenchServiceLogging) CreateAppInstance(ctx context.Context, appInstance *model.AppInstance) (*model.AppInstance, error) {
 	now := time.Now()
 
@@ -306,3 +328,489 @@ func (c workbenchServiceLogging) RemoveUserFromWorkbench(ctx context.Context, te
 	)
 	return nil
 }
+
+func (c workbenchServiceLogging) GetWorkbenchSchedule(ctx context.Context, tenantID, workbenchID uint64) (*model.WorkbenchSchedule, error) {
+	now := time.Now()
+
+	schedule, err := c.next.GetWorkbenchSchedule(ctx, tenantID, workbenchID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			logger.WithWorkbenchIDField(workbenchID),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWorkbenchIDField(workbenchID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return schedule, nil
+}
+
+func (c workbenchServiceLogging) SetWorkbenchSchedule(ctx context.Context, schedule *model.WorkbenchSchedule) (*model.WorkbenchSchedule, error) {
+	now := time.Now()
+
+	updated, err := c.next.SetWorkbenchSchedule(ctx, schedule)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			logger.WithWorkbenchIDField(schedule.WorkbenchID),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWorkbenchIDField(updated.WorkbenchID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return updated, nil
+}
+
+func (c workbenchServiceLogging) DeleteWorkbenchSchedule(ctx context.Context, tenantID, workbenchID uint64) error {
+	now := time.Now()
+
+	err := c.next.DeleteWorkbenchSchedule(ctx, tenantID, workbenchID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			logger.WithWorkbenchIDField(workbenchID),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWorkbenchIDField(workbenchID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c workbenchServiceLogging) StopScheduledWorkbench(ctx context.Context, tenantID, workbenchID uint64) error {
+	now := time.Now()
+
+	err := c.next.StopScheduledWorkbench(ctx, tenantID, workbenchID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			logger.WithWorkbenchIDField(workbenchID),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWorkbenchIDField(workbenchID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c workbenchServiceLogging) StartScheduledWorkbench(ctx context.Context, tenantID, workbenchID uint64) error {
+	now := time.Now()
+
+	err := c.next.StartScheduledWorkbench(ctx, tenantID, workbenchID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			logger.WithWorkbenchIDField(workbenchID),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWorkbenchIDField(workbenchID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c workbenchServiceLogging) KeepWorkbenchAlive(ctx context.Context, tenantID, workbenchID uint64) (*time.Time, error) {
+	now := time.Now()
+
+	res, err := c.next.KeepWorkbenchAlive(ctx, tenantID, workbenchID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			logger.WithWorkbenchIDField(workbenchID),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWorkbenchIDField(workbenchID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workbenchServiceLogging) HibernateWorkbench(ctx context.Context, tenantID, workbenchID uint64) (*model.Workbench, error) {
+	now := time.Now()
+
+	res, err := c.next.HibernateWorkbench(ctx, tenantID, workbenchID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			logger.WithWorkbenchIDField(workbenchID),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWorkbenchIDField(workbenchID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workbenchServiceLogging) ResumeWorkbench(ctx context.Context, tenantID, workbenchID uint64) (*model.Workbench, error) {
+	now := time.Now()
+
+	res, err := c.next.ResumeWorkbench(ctx, tenantID, workbenchID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			logger.WithWorkbenchIDField(workbenchID),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWorkbenchIDField(workbenchID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workbenchServiceLogging) CreateWorkbenchShareGrant(ctx context.Context, grant *model.WorkbenchShareGrant) (*model.WorkbenchShareGrant, error) {
+	now := time.Now()
+
+	res, err := c.next.CreateWorkbenchShareGrant(ctx, grant)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			logger.WithWorkbenchIDField(grant.WorkbenchID),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWorkbenchIDField(grant.WorkbenchID),
+		zap.Uint64("grantID", res.ID),
+		logger.WithCountField(len(res.Members)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workbenchServiceLogging) ListWorkbenchShareGrantees(ctx context.Context, grant *model.WorkbenchShareGrant) ([]uint64, error) {
+	now := time.Now()
+
+	res, err := c.next.ListWorkbenchShareGrantees(ctx, grant)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			logger.WithWorkbenchIDField(grant.WorkbenchID),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWorkbenchIDField(grant.WorkbenchID),
+		logger.WithCountField(len(res)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workbenchServiceLogging) ListWorkbenchShareGrants(ctx context.Context, tenantID, workbenchID uint64) ([]*model.WorkbenchShareGrant, error) {
+	now := time.Now()
+
+	res, err := c.next.ListWorkbenchShareGrants(ctx, tenantID, workbenchID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			logger.WithWorkbenchIDField(workbenchID),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWorkbenchIDField(workbenchID),
+		logger.WithCountField(len(res)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workbenchServiceLogging) RevokeWorkbenchShareGrant(ctx context.Context, tenantID, workbenchID, grantID uint64) (*model.WorkbenchShareGrant, error) {
+	now := time.Now()
+
+	res, err := c.next.RevokeWorkbenchShareGrant(ctx, tenantID, workbenchID, grantID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			logger.WithWorkbenchIDField(workbenchID),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWorkbenchIDField(workbenchID),
+		zap.Uint64("grantID", grantID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workbenchServiceLogging) ExpireWorkbenchShareGrants(ctx context.Context) (int, error) {
+	now := time.Now()
+
+	res, err := c.next.ExpireWorkbenchShareGrants(ctx)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			logger.WithCountField(res),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return res, err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithCountField(res),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workbenchServiceLogging) GetWorkbenchShareRoles(ctx context.Context, tenantID, workbenchID, userID uint64) ([]user_model.UserRole, bool, error) {
+	now := time.Now()
+
+	res, stale, err := c.next.GetWorkbenchShareRoles(ctx, tenantID, workbenchID, userID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			logger.WithWorkbenchIDField(workbenchID),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, false, err
+	}
+
+	c.logger.Debug(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWorkbenchIDField(workbenchID),
+		logger.WithUserIDField(userID),
+		zap.Bool("stale", stale),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, stale, nil
+}
+
+func (c workbenchServiceLogging) WatchWorkbench(ctx context.Context, tenantID, workbenchID uint64) (<-chan *model.WorkbenchStatusEvent, error) {
+	now := time.Now()
+
+	res, err := c.next.WatchWorkbench(ctx, tenantID, workbenchID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			logger.WithWorkbenchIDField(workbenchID),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWorkbenchIDField(workbenchID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workbenchServiceLogging) WatchWorkspace(ctx context.Context, tenantID, workspaceID uint64) (<-chan *model.WorkbenchStatusEvent, error) {
+	now := time.Now()
+
+	res, err := c.next.WatchWorkspace(ctx, tenantID, workspaceID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			logger.WithWorkspaceIDField(workspaceID),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWorkspaceIDField(workspaceID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workbenchServiceLogging) GetAppInstanceLogs(ctx context.Context, tenantID, appInstanceID uint64, req model.PodLogRequest) (*model.PodLogs, error) {
+	now := time.Now()
+
+	res, err := c.next.GetAppInstanceLogs(ctx, tenantID, appInstanceID, req)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			logger.WithAppInstanceIDField(appInstanceID),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithAppInstanceIDField(appInstanceID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workbenchServiceLogging) ListAppInstanceEvents(ctx context.Context, tenantID, appInstanceID uint64) ([]*model.PodEvent, error) {
+	now := time.Now()
+
+	res, err := c.next.ListAppInstanceEvents(ctx, tenantID, appInstanceID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			logger.WithAppInstanceIDField(appInstanceID),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithAppInstanceIDField(appInstanceID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workbenchServiceLogging) GetWorkbenchServerLogs(ctx context.Context, tenantID, workbenchID uint64, req model.PodLogRequest) (*model.PodLogs, error) {
+	now := time.Now()
+
+	res, err := c.next.GetWorkbenchServerLogs(ctx, tenantID, workbenchID, req)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			logger.WithWorkbenchIDField(workbenchID),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWorkbenchIDField(workbenchID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workbenchServiceLogging) ListWorkbenchServerEvents(ctx context.Context, tenantID, workbenchID uint64) ([]*model.PodEvent, error) {
+	now := time.Now()
+
+	res, err := c.next.ListWorkbenchServerEvents(ctx, tenantID, workbenchID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			logger.WithWorkbenchIDField(workbenchID),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWorkbenchIDField(workbenchID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workbenchServiceLogging) GetAppInstanceUsage(ctx context.Context, tenantID, appInstanceID uint64) (*model.AppInstanceUsage, error) {
+	now := time.Now()
+
+	res, err := c.next.GetAppInstanceUsage(ctx, tenantID, appInstanceID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			logger.WithAppInstanceIDField(appInstanceID),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithAppInstanceIDField(appInstanceID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workbenchServiceLogging) GetWorkbenchUsage(ctx context.Context, tenantID, workbenchID uint64) (*model.WorkbenchUsage, error) {
+	now := time.Now()
+
+	res, err := c.next.GetWorkbenchUsage(ctx, tenantID, workbenchID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			logger.WithWorkbenchIDField(workbenchID),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWorkbenchIDField(workbenchID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workbenchServiceLogging) GetWorkspaceUsage(ctx context.Context, tenantID, workspaceID uint64) (*model.WorkspaceUsage, error) {
+	now := time.Now()
+
+	res, err := c.next.GetWorkspaceUsage(ctx, tenantID, workspaceID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			logger.WithWorkspaceIDField(workspaceID),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWorkspaceIDField(workspaceID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workbenchServiceLogging) ListWorkbenchConnections(ctx context.Context, tenantID uint64, pagination *common_model.Pagination, filter model.WorkbenchConnectionFilter) ([]*model.WorkbenchConnection, *common_model.PaginationResult, error) {
+	now := time.Now()
+
+	res, paginationRes, err := c.next.ListWorkbenchConnections(ctx, tenantID, pagination, filter)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, nil, err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithCountField(len(res)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, paginationRes, nil
+}
@@ -2,7 +2,9 @@ package middleware
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"time"
 
 	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
 	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
@@ -33,8 +35,8 @@ func (v validation) ListWorkbenches(ctx context.Context, tenantID uint64, pagina
 	}
 	return v.next.ListWorkbenches(ctx, tenantID, pagination, filter)
 }
-func (v validation) ProxyWorkbench(ctx context.Context, tenantID, workbenchID uint64, w http.ResponseWriter, r *http.Request) error {
-	return v.next.ProxyWorkbench(ctx, tenantID, workbenchID, w, r)
+func (v validation) ProxyWorkbench(ctx context.Context, tenantID, workbenchID uint64, readOnly bool, w http.ResponseWriter, r *http.Request) error {
+	return v.next.ProxyWorkbench(ctx, tenantID, workbenchID, readOnly, w, r)
 }
 
 func (v validation) GetWorkbench(ctx context.Context, tenantID, workbenchID uint64) (*model.Workbench, error) {
@@ -85,6 +87,10 @@ func (v validation) UpdateAppInstance(ctx context.Context, appInstance *model.Ap
 	return v.next.UpdateAppInstance(ctx, appInstance)
 }
 
+func (v validation) UpgradeAppInstance(ctx context.Context, tenantID, appInstanceID, appVersionID uint64) (*model.AppInstance, error) {
+	return v.next.UpgradeAppInstance(ctx, tenantID, appInstanceID, appVersionID)
+}
+
 func (v validation) CreateAppInstance(ctx context.Context, appInstance *model.AppInstance) (*model.AppInstance, error) {
 	if err := v.validate.Struct(appInstance); err != nil {
 		return nil, cerr.WrapValidationError(err)
@@ -102,3 +108,170 @@ func (v validation) AddUserRoleInWorkbench(ctx context.Context, tenantID, userID
 func (v validation) RemoveUserFromWorkbench(ctx context.Context, tenantID, userID, workbenchID uint64) error {
 	return v.next.RemoveUserFromWorkbench(ctx, tenantID, userID, workbenchID)
 }
+
+func (v validation) GetWorkbenchSchedule(ctx context.Context, tenantID, workbenchID uint64) (*model.WorkbenchSchedule, error) {
+	return v.next.GetWorkbenchSchedule(ctx, tenantID, workbenchID)
+}
+
+func (v validation) SetWorkbenchSchedule(ctx context.Context, schedule *model.WorkbenchSchedule) (*model.WorkbenchSchedule, error) {
+	if err := validateWorkbenchSchedule(schedule); err != nil {
+		return nil, err
+	}
+	return v.next.SetWorkbenchSchedule(ctx, schedule)
+}
+
+func (v validation) DeleteWorkbenchSchedule(ctx context.Context, tenantID, workbenchID uint64) error {
+	return v.next.DeleteWorkbenchSchedule(ctx, tenantID, workbenchID)
+}
+
+func (v validation) StopScheduledWorkbench(ctx context.Context, tenantID, workbenchID uint64) error {
+	return v.next.StopScheduledWorkbench(ctx, tenantID, workbenchID)
+}
+
+func (v validation) StartScheduledWorkbench(ctx context.Context, tenantID, workbenchID uint64) error {
+	return v.next.StartScheduledWorkbench(ctx, tenantID, workbenchID)
+}
+
+func (v validation) KeepWorkbenchAlive(ctx context.Context, tenantID, workbenchID uint64) (*time.Time, error) {
+	return v.next.KeepWorkbenchAlive(ctx, tenantID, workbenchID)
+}
+
+func (v validation) HibernateWorkbench(ctx context.Context, tenantID, workbenchID uint64) (*model.Workbench, error) {
+	return v.next.HibernateWorkbench(ctx, tenantID, workbenchID)
+}
+
+func (v validation) ResumeWorkbench(ctx context.Context, tenantID, workbenchID uint64) (*model.Workbench, error) {
+	return v.next.ResumeWorkbench(ctx, tenantID, workbenchID)
+}
+
+func (v validation) CreateWorkbenchShareGrant(ctx context.Context, grant *model.WorkbenchShareGrant) (*model.WorkbenchShareGrant, error) {
+	if (grant.GranteeUserID == nil) == (grant.GranteeWorkspaceID == nil) {
+		return nil, cerr.ErrValidation.WithMessage("A share grant requires either a user or a workspace")
+	}
+	if _, err := model.ToWorkbenchShareMode(grant.Mode.String()); err != nil {
+		return nil, cerr.ErrValidation.WithMessage(fmt.Sprintf("Unknown share mode %q", grant.Mode))
+	}
+	return v.next.CreateWorkbenchShareGrant(ctx, grant)
+}
+
+func (v validation) ListWorkbenchShareGrantees(ctx context.Context, grant *model.WorkbenchShareGrant) ([]uint64, error) {
+	if (grant.GranteeUserID == nil) == (grant.GranteeWorkspaceID == nil) {
+		return nil, cerr.ErrValidation.WithMessage("A share grant requires either a user or a workspace")
+	}
+	return v.next.ListWorkbenchShareGrantees(ctx, grant)
+}
+
+func (v validation) ListWorkbenchShareGrants(ctx context.Context, tenantID, workbenchID uint64) ([]*model.WorkbenchShareGrant, error) {
+	return v.next.ListWorkbenchShareGrants(ctx, tenantID, workbenchID)
+}
+
+func (v validation) RevokeWorkbenchShareGrant(ctx context.Context, tenantID, workbenchID, grantID uint64) (*model.WorkbenchShareGrant, error) {
+	return v.next.RevokeWorkbenchShareGrant(ctx, tenantID, workbenchID, grantID)
+}
+
+func (v validation) ExpireWorkbenchShareGrants(ctx context.Context) (int, error) {
+	return v.next.ExpireWorkbenchShareGrants(ctx)
+}
+
+func (v validation) GetWorkbenchShareRoles(ctx context.Context, tenantID, workbenchID, userID uint64) ([]user_model.UserRole, bool, error) {
+	return v.next.GetWorkbenchShareRoles(ctx, tenantID, workbenchID, userID)
+}
+
+func (v validation) WatchWorkbench(ctx context.Context, tenantID, workbenchID uint64) (<-chan *model.WorkbenchStatusEvent, error) {
+	return v.next.WatchWorkbench(ctx, tenantID, workbenchID)
+}
+
+func (v validation) WatchWorkspace(ctx context.Context, tenantID, workspaceID uint64) (<-chan *model.WorkbenchStatusEvent, error) {
+	return v.next.WatchWorkspace(ctx, tenantID, workspaceID)
+}
+
+func (v validation) GetAppInstanceLogs(ctx context.Context, tenantID, appInstanceID uint64, req model.PodLogRequest) (*model.PodLogs, error) {
+	if err := validatePodLogRequest(req); err != nil {
+		return nil, err
+	}
+	return v.next.GetAppInstanceLogs(ctx, tenantID, appInstanceID, req)
+}
+
+func (v validation) ListAppInstanceEvents(ctx context.Context, tenantID, appInstanceID uint64) ([]*model.PodEvent, error) {
+	return v.next.ListAppInstanceEvents(ctx, tenantID, appInstanceID)
+}
+
+func (v validation) GetWorkbenchServerLogs(ctx context.Context, tenantID, workbenchID uint64, req model.PodLogRequest) (*model.PodLogs, error) {
+	if err := validatePodLogRequest(req); err != nil {
+		return nil, err
+	}
+	return v.next.GetWorkbenchServerLogs(ctx, tenantID, workbenchID, req)
+}
+
+func (v validation) ListWorkbenchServerEvents(ctx context.Context, tenantID, workbenchID uint64) ([]*model.PodEvent, error) {
+	return v.next.ListWorkbenchServerEvents(ctx, tenantID, workbenchID)
+}
+
+func (v validation) GetAppInstanceUsage(ctx context.Context, tenantID, appInstanceID uint64) (*model.AppInstanceUsage, error) {
+	return v.next.GetAppInstanceUsage(ctx, tenantID, appInstanceID)
+}
+
+func (v validation) GetWorkbenchUsage(ctx context.Context, tenantID, workbenchID uint64) (*model.WorkbenchUsage, error) {
+	return v.next.GetWorkbenchUsage(ctx, tenantID, workbenchID)
+}
+
+func (v validation) GetWorkspaceUsage(ctx context.Context, tenantID, workspaceID uint64) (*model.WorkspaceUsage, error) {
+	return v.next.GetWorkspaceUsage(ctx, tenantID, workspaceID)
+}
+
+func (v validation) ListWorkbenchConnections(ctx context.Context, tenantID uint64, pagination *common_model.Pagination, filter model.WorkbenchConnectionFilter) ([]*model.WorkbenchConnection, *common_model.PaginationResult, error) {
+	if !filter.From.IsZero() && !filter.To.IsZero() && filter.From.After(filter.To) {
+		return nil, nil, cerr.ErrValidation.WithMessage("The start of the period cannot be after its end")
+	}
+	return v.next.ListWorkbenchConnections(ctx, tenantID, pagination, filter)
+}
+
+// validateWorkbenchSchedule checks that a schedule describes a non-empty weekly window
+// in a known time zone.
+func validateWorkbenchSchedule(schedule *model.WorkbenchSchedule) error {
+	if schedule.Timezone == "" {
+		return cerr.ErrValidation.WithMessage("A schedule requires a timezone")
+	}
+	if _, err := time.LoadLocation(schedule.Timezone); err != nil {
+		return cerr.ErrValidation.WithMessage(fmt.Sprintf("Unknown timezone %q", schedule.Timezone))
+	}
+
+	if len(schedule.Weekdays) == 0 {
+		return cerr.ErrValidation.WithMessage("A schedule requires at least one weekday")
+	}
+	seen := map[time.Weekday]bool{}
+	for _, day := range schedule.Weekdays {
+		if day < time.Sunday || day > time.Saturday {
+			return cerr.ErrValidation.WithMessage(fmt.Sprintf("Unknown weekday %d", day))
+		}
+		if seen[day] {
+			return cerr.ErrValidation.WithMessage(fmt.Sprintf("Weekday %s is listed twice", model.WeekdayName(day)))
+		}
+		seen[day] = true
+	}
+
+	start, err := model.ParseTimeOfDay(schedule.StartTime)
+	if err != nil {
+		return cerr.ErrValidation.WithMessage(fmt.Sprintf("Invalid start time %q, expected HH:MM", schedule.StartTime))
+	}
+	stop, err := model.ParseTimeOfDay(schedule.StopTime)
+	if err != nil {
+		return cerr.ErrValidation.WithMessage(fmt.Sprintf("Invalid stop time %q, expected HH:MM", schedule.StopTime))
+	}
+	if start == stop {
+		return cerr.ErrValidation.WithMessage("The start and stop times of a schedule must differ")
+	}
+
+	return nil
+}
+
+// validatePodLogRequest bounds the amount of logs a request can fetch.
+func validatePodLogRequest(req model.PodLogRequest) error {
+	if req.TailLines > model.MaxPodLogTailLines {
+		return cerr.ErrValidation.WithMessage(fmt.Sprintf("At most %d log lines can be requested", model.MaxPodLogTailLines))
+	}
+	if req.Since != nil && req.Since.After(time.Now()) {
+		return cerr.ErrValidation.WithMessage("The start of the logs cannot be in the future")
+	}
+	return nil
+}
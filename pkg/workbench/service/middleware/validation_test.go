@@ -0,0 +1,73 @@
+//go:build unit
+
+package middleware
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
+)
+
+func validSchedule() *model.WorkbenchSchedule {
+	return &model.WorkbenchSchedule{
+		WorkbenchID: 1,
+		Timezone:    "Europe/Zurich",
+		Weekdays:    []time.Weekday{time.Monday, time.Friday},
+		StartTime:   "08:00",
+		StopTime:    "19:00",
+	}
+}
+
+func TestValidateWorkbenchSchedule_ValidSchedulesPass(t *testing.T) {
+	assert.NoError(t, validateWorkbenchSchedule(validSchedule()))
+
+	overnight := validSchedule()
+	overnight.StartTime, overnight.StopTime = "22:00", "06:00"
+	assert.NoError(t, validateWorkbenchSchedule(overnight))
+}
+
+func TestValidateWorkbenchSchedule_RejectsInvalidSchedules(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(*model.WorkbenchSchedule)
+	}{
+		{"missing timezone", func(s *model.WorkbenchSchedule) { s.Timezone = "" }},
+		{"unknown timezone", func(s *model.WorkbenchSchedule) { s.Timezone = "Europe/Atlantis" }},
+		{"no weekday", func(s *model.WorkbenchSchedule) { s.Weekdays = nil }},
+		{"duplicate weekday", func(s *model.WorkbenchSchedule) { s.Weekdays = []time.Weekday{time.Monday, time.Monday} }},
+		{"out of range weekday", func(s *model.WorkbenchSchedule) { s.Weekdays = []time.Weekday{7} }},
+		{"malformed start time", func(s *model.WorkbenchSchedule) { s.StartTime = "8am" }},
+		{"out of range stop time", func(s *model.WorkbenchSchedule) { s.StopTime = "24:30" }},
+		{"empty window", func(s *model.WorkbenchSchedule) { s.StopTime = s.StartTime }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule := validSchedule()
+			tt.mutate(schedule)
+			err := validateWorkbenchSchedule(schedule)
+			var cErr *cerr.ChorusError
+			require.ErrorAs(t, err, &cErr)
+			assert.Equal(t, cerr.ErrValidation.ChorusCode, cErr.ChorusCode)
+		})
+	}
+}
+
+func TestValidatePodLogRequest(t *testing.T) {
+	assert.NoError(t, validatePodLogRequest(model.PodLogRequest{}))
+	assert.NoError(t, validatePodLogRequest(model.PodLogRequest{TailLines: model.MaxPodLogTailLines, Previous: true}))
+
+	future := time.Now().Add(time.Hour)
+	for _, req := range []model.PodLogRequest{
+		{TailLines: model.MaxPodLogTailLines + 1},
+		{Since: &future},
+	} {
+		var cErr *cerr.ChorusError
+		require.ErrorAs(t, validatePodLogRequest(req), &cErr)
+		assert.Equal(t, cerr.ErrValidation.ChorusCode, cErr.ChorusCode)
+	}
+}
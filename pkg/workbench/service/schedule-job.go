@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+// WorkbenchScheduleJob stops the workbenches whose schedule window is closed and starts
+// them again, with their app instances, once the window opens. Workbenches with a
+// schedule are left alone by the idle cleaner.
+type WorkbenchScheduleJob struct {
+	store       WorkbenchStore
+	workbencher Workbencher
+	log         *logger.ContextLogger
+	now         func() time.Time
+}
+
+func NewWorkbenchScheduleJob(store WorkbenchStore, workbencher Workbencher, log *logger.ContextLogger) *WorkbenchScheduleJob {
+	return &WorkbenchScheduleJob{
+		store:       store,
+		workbencher: workbencher,
+		log:         log,
+		now:         time.Now,
+	}
+}
+
+func (j *WorkbenchScheduleJob) Do(ctx context.Context, options map[string]interface{}) (string, error) {
+	schedules, err := j.store.ListWorkbenchSchedules(ctx)
+	if err != nil {
+		return "", fmt.Errorf("listing workbench schedules: %w", err)
+	}
+
+	now := j.now()
+	started, stopped, failed := 0, 0, 0
+	for _, schedule := range schedules {
+		open, err := schedule.IsOpen(now)
+		if err != nil {
+			j.log.Error(ctx, "invalid workbench schedule", zap.Uint64("workbenchID", schedule.WorkbenchID), zap.Error(err))
+			failed++
+			continue
+		}
+
+		switch {
+		case open && schedule.StoppedAt != nil:
+			if err := j.workbencher.StartScheduledWorkbench(ctx, schedule.TenantID, schedule.WorkbenchID); err != nil {
+				j.log.Error(ctx, "unable to start scheduled workbench", zap.Uint64("workbenchID", schedule.WorkbenchID), zap.Error(err))
+				failed++
+				continue
+			}
+			started++
+		case !open && schedule.StoppedAt == nil:
+			if err := j.workbencher.StopScheduledWorkbench(ctx, schedule.TenantID, schedule.WorkbenchID); err != nil {
+				j.log.Error(ctx, "unable to stop scheduled workbench", zap.Uint64("workbenchID", schedule.WorkbenchID), zap.Error(err))
+				failed++
+				continue
+			}
+			stopped++
+		}
+	}
+
+	msg := fmt.Sprintf("started %d workbenches, stopped %d workbenches", started, stopped)
+	if failed > 0 {
+		return "", fmt.Errorf("%s, %d failures", msg, failed)
+	}
+	return msg, nil
+}
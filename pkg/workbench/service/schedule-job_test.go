@@ -0,0 +1,184 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/k8s"
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
+	workspace_model "github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
+)
+
+type scheduleStore struct {
+	WorkbenchStore
+	schedules []*model.WorkbenchSchedule
+}
+
+func (m *scheduleStore) ListWorkbenchSchedules(_ context.Context) ([]*model.WorkbenchSchedule, error) {
+	return m.schedules, nil
+}
+
+type recordingScheduler struct {
+	Workbencher
+	started []uint64
+	stopped []uint64
+}
+
+func (m *recordingScheduler) StartScheduledWorkbench(_ context.Context, _, workbenchID uint64) error {
+	m.started = append(m.started, workbenchID)
+	return nil
+}
+
+func (m *recordingScheduler) StopScheduledWorkbench(_ context.Context, _, workbenchID uint64) error {
+	m.stopped = append(m.stopped, workbenchID)
+	return nil
+}
+
+func officeHours(workbenchID uint64, stoppedAt *time.Time) *model.WorkbenchSchedule {
+	return &model.WorkbenchSchedule{
+		WorkbenchID: workbenchID,
+		TenantID:    1,
+		Timezone:    "Europe/Zurich",
+		Weekdays:    []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+		StartTime:   "08:00",
+		StopTime:    "19:00",
+		StoppedAt:   stoppedAt,
+	}
+}
+
+func zurichTime(t *testing.T, value string) time.Time {
+	loc, err := time.LoadLocation("Europe/Zurich")
+	require.NoError(t, err)
+	ts, err := time.ParseInLocation("2006-01-02 15:04", value, loc)
+	require.NoError(t, err)
+	return ts
+}
+
+func TestWorkbenchSchedule_IsOpen(t *testing.T) {
+	overnight := &model.WorkbenchSchedule{
+		Timezone:  "Europe/Zurich",
+		Weekdays:  []time.Weekday{time.Friday},
+		StartTime: "22:00",
+		StopTime:  "06:00",
+	}
+
+	tests := []struct {
+		name     string
+		schedule *model.WorkbenchSchedule
+		at       string
+		open     bool
+	}{
+		{"weekday within window", officeHours(1, nil), "2026-10-14 10:30", true},
+		{"weekday at start time", officeHours(1, nil), "2026-10-14 08:00", true},
+		{"weekday at stop time", officeHours(1, nil), "2026-10-14 19:00", false},
+		{"weekday before window", officeHours(1, nil), "2026-10-14 07:59", false},
+		{"weekend", officeHours(1, nil), "2026-10-17 10:30", false},
+		{"overnight on start day", overnight, "2026-10-16 23:00", true},
+		{"overnight on next day", overnight, "2026-10-17 05:00", true},
+		{"overnight after stop", overnight, "2026-10-17 06:30", false},
+		{"overnight from a day without window", overnight, "2026-10-16 05:00", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			open, err := tt.schedule.IsOpen(zurichTime(t, tt.at))
+			require.NoError(t, err)
+			assert.Equal(t, tt.open, open)
+		})
+	}
+}
+
+// The window is evaluated in the time zone of the schedule, not in the one of the server.
+func TestWorkbenchSchedule_IsOpenUsesScheduleTimezone(t *testing.T) {
+	// 07:30 UTC is 09:30 in Zurich during summer time.
+	open, err := officeHours(1, nil).IsOpen(time.Date(2026, 7, 15, 7, 30, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.True(t, open)
+}
+
+func TestWorkbenchScheduleJob_StopsAndStartsWorkbenches(t *testing.T) {
+	stoppedAt := time.Now().Add(-12 * time.Hour)
+	store := &scheduleStore{schedules: []*model.WorkbenchSchedule{
+		officeHours(1, nil),
+		officeHours(2, &stoppedAt),
+	}}
+	scheduler := &recordingScheduler{}
+	job := NewWorkbenchScheduleJob(store, scheduler, logger.TechLog)
+
+	job.now = func() time.Time { return zurichTime(t, "2026-10-14 20:00") }
+	_, err := job.Do(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{1}, scheduler.stopped)
+	assert.Empty(t, scheduler.started)
+
+	scheduler = &recordingScheduler{}
+	job = NewWorkbenchScheduleJob(store, scheduler, logger.TechLog)
+	job.now = func() time.Time { return zurichTime(t, "2026-10-15 08:05") }
+	_, err = job.Do(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{2}, scheduler.started)
+	assert.Empty(t, scheduler.stopped)
+}
+
+func TestWorkbenchScheduleJob_ReportsInvalidSchedules(t *testing.T) {
+	invalid := officeHours(3, nil)
+	invalid.Timezone = "Mars/Olympus_Mons"
+	store := &scheduleStore{schedules: []*model.WorkbenchSchedule{invalid, officeHours(1, nil)}}
+	scheduler := &recordingScheduler{}
+	job := NewWorkbenchScheduleJob(store, scheduler, logger.TechLog)
+	job.now = func() time.Time { return zurichTime(t, "2026-10-17 10:00") }
+
+	_, err := job.Do(context.Background(), nil)
+
+	require.Error(t, err)
+	assert.Equal(t, []uint64{1}, scheduler.stopped)
+}
+
+type scheduledWorkbenchStore struct {
+	hibernationStore
+	schedule *model.WorkbenchSchedule
+}
+
+func (s *scheduledWorkbenchStore) GetWorkbenchSchedule(_ context.Context, _, _ uint64) (*model.WorkbenchSchedule, error) {
+	schedule := *s.schedule
+	return &schedule, nil
+}
+
+func (s *scheduledWorkbenchStore) DeleteWorkbenchSchedule(_ context.Context, _, _ uint64) error {
+	return nil
+}
+
+func (s *scheduledWorkbenchStore) UpdateWorkbenchScheduleStoppedAt(_ context.Context, _, _ uint64, stoppedAt *time.Time) error {
+	s.schedule.StoppedAt = stoppedAt
+	return nil
+}
+
+// Archived and frozen workspaces run no workbench, so their schedules do not start any.
+func TestStartScheduledWorkbench_SkipsReadOnlyWorkspaces(t *testing.T) {
+	for _, status := range []workspace_model.WorkspaceStatus{workspace_model.WorkspaceStatusFrozen, workspace_model.WorkspaceStatusArchived} {
+		t.Run(status.String(), func(t *testing.T) {
+			stoppedAt := time.Now().Add(-12 * time.Hour)
+			store := &scheduledWorkbenchStore{
+				hibernationStore: hibernationStore{workbench: &model.Workbench{ID: 3, TenantID: 1, WorkspaceID: 7, Status: model.WorkbenchInactive}},
+				schedule:         officeHours(3, &stoppedAt),
+			}
+			client := &recordingK8sClient{K8sClienter: k8s.NewTestClient()}
+			svc := newHibernationSvc(store, client)
+			svc.workspaceReader = &mockWorkspaceReader{status: status}
+
+			require.NoError(t, svc.StartScheduledWorkbench(context.Background(), 1, 3))
+			assert.NotNil(t, store.schedule.StoppedAt, "the workbench starts once its workspace is writable again")
+
+			require.NoError(t, svc.DeleteWorkbenchSchedule(context.Background(), 1, 3))
+
+			assert.Equal(t, model.WorkbenchInactive, store.workbench.Status)
+			assert.Empty(t, client.updated)
+		})
+	}
+}
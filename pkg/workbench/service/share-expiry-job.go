@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+)
+
+// WorkbenchShareExpiryJob ends the workbench share grants past their expiry date and
+// takes back the roles they gave.
+type WorkbenchShareExpiryJob struct {
+	workbencher Workbencher
+	log         *logger.ContextLogger
+}
+
+func NewWorkbenchShareExpiryJob(workbencher Workbencher, log *logger.ContextLogger) *WorkbenchShareExpiryJob {
+	return &WorkbenchShareExpiryJob{
+		workbencher: workbencher,
+		log:         log,
+	}
+}
+
+func (j *WorkbenchShareExpiryJob) Do(ctx context.Context, options map[string]interface{}) (string, error) {
+	ended, err := j.workbencher.ExpireWorkbenchShareGrants(ctx)
+	if err != nil {
+		return "", fmt.Errorf("ending %d workbench share grants: %w", ended, err)
+	}
+	return fmt.Sprintf("ended %d workbench share grants", ended), nil
+}
@@ -0,0 +1,208 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/audit"
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	jwt_model "github.com/CHORUS-TRE/chorus-backend/internal/jwt/model"
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	audit_model "github.com/CHORUS-TRE/chorus-backend/pkg/audit/model"
+	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
+)
+
+const headerXForwardedFor = "X-Forwarded-For"
+
+// workbenchConnection is a connection open through the proxy and the bytes it has
+// transferred so far.
+type workbenchConnection struct {
+	connection *model.WorkbenchConnection
+	bytesIn    atomic.Uint64
+	bytesOut   atomic.Uint64
+}
+
+// snapshot returns the connection with the bytes transferred so far.
+func (c *workbenchConnection) snapshot() *model.WorkbenchConnection {
+	connection := *c.connection
+	connection.BytesIn = c.bytesIn.Load()
+	connection.BytesOut = c.bytesOut.Load()
+	return &connection
+}
+
+// countingResponseWriter counts the bytes sent to the user, including those written to
+// the connection once it has been hijacked for the websocket.
+type countingResponseWriter struct {
+	http.ResponseWriter
+	connection *workbenchConnection
+}
+
+func (w *countingResponseWriter) Write(b []byte) (int, error) {
+	n, err := w.ResponseWriter.Write(b)
+	w.connection.bytesOut.Add(uint64(n))
+	return n, err
+}
+
+func (w *countingResponseWriter) Flush() {
+	_ = http.NewResponseController(w.ResponseWriter).Flush()
+}
+
+func (w *countingResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	conn, brw, err := http.NewResponseController(w.ResponseWriter).Hijack()
+	if err != nil {
+		return nil, nil, err
+	}
+	return &countingConn{Conn: conn, connection: w.connection}, brw, nil
+}
+
+func (w *countingResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+type countingConn struct {
+	net.Conn
+	connection *workbenchConnection
+}
+
+func (c *countingConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	c.connection.bytesIn.Add(uint64(n))
+	return n, err
+}
+
+func (c *countingConn) Write(b []byte) (int, error) {
+	n, err := c.Conn.Write(b)
+	c.connection.bytesOut.Add(uint64(n))
+	return n, err
+}
+
+// isWebSocketUpgrade tells whether a request opens the websocket that carries the stream
+// of a workbench, as opposed to the requests loading the client.
+func isWebSocketUpgrade(r *http.Request) bool {
+	return strings.EqualFold(r.Header.Get("Upgrade"), "websocket")
+}
+
+// clientIP returns the IP of the user, as forwarded by the gateway when there is one.
+func (s *WorkbenchService) clientIP(r *http.Request) string {
+	header := s.cfg.Daemon.HTTP.HeaderClientIP
+	if header == "" {
+		header = headerXForwardedFor
+	}
+	if forwarded := r.Header.Get(header); forwarded != "" {
+		ip, _, _ := strings.Cut(forwarded, ",")
+		return strings.TrimSpace(ip)
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// startWorkbenchConnection records a connection to a workbench and returns the response
+// writer counting its bytes.
+func (s *WorkbenchService) startWorkbenchConnection(ctx context.Context, tenantID uint64, workbench *model.Workbench, w http.ResponseWriter, r *http.Request) (*workbenchConnection, http.ResponseWriter, error) {
+	userID, err := jwt_model.ExtractUserID(ctx)
+	if err != nil {
+		return nil, nil, cerr.ErrUnauthenticated.Wrap(err, "Unable to extract user ID from token")
+	}
+
+	created, err := s.store.CreateWorkbenchConnection(ctx, tenantID, &model.WorkbenchConnection{
+		WorkbenchID: workbench.ID,
+		WorkspaceID: workbench.WorkspaceID,
+		UserID:      userID,
+		ClientIP:    s.clientIP(r),
+		UserAgent:   r.UserAgent(),
+		StartedAt:   time.Now(),
+	})
+	if err != nil {
+		return nil, nil, cerr.ErrInternal.Wrap(err, fmt.Sprintf("Unable to record connection to workbench %v", workbench.ID))
+	}
+
+	connection := &workbenchConnection{connection: created}
+	s.connectionsMutex.Lock()
+	s.connections[connection] = struct{}{}
+	s.connectionsMutex.Unlock()
+
+	audit.Record(ctx, s.auditWriter, audit_model.AuditActionWorkbenchConnectionStart,
+		audit.WithWorkbenchID(workbench.ID),
+		audit.WithWorkspaceID(workbench.WorkspaceID),
+		audit.WithDescription(fmt.Sprintf("Connected to session '%s' (ID %d) in workspace %d from %s.", workbench.Name, workbench.ID, workbench.WorkspaceID, created.ClientIP)),
+		audit.WithDetail("connection_id", created.ID),
+		audit.WithDetail("workbench_id", workbench.ID),
+		audit.WithDetail("workspace_id", workbench.WorkspaceID),
+		audit.WithDetail("client_ip", created.ClientIP),
+	)
+
+	return connection, &countingResponseWriter{ResponseWriter: w, connection: connection}, nil
+}
+
+// endWorkbenchConnection records the end of a connection and the bytes it transferred.
+func (s *WorkbenchService) endWorkbenchConnection(ctx context.Context, workbench *model.Workbench, connection *workbenchConnection) {
+	s.connectionsMutex.Lock()
+	delete(s.connections, connection)
+	s.connectionsMutex.Unlock()
+
+	ended := connection.snapshot()
+	endedAt := time.Now()
+	ended.EndedAt = &endedAt
+
+	// The request context may be cancelled once the user is gone.
+	ctx = context.WithoutCancel(ctx)
+	if err := s.store.UpdateWorkbenchConnections(ctx, []*model.WorkbenchConnection{ended}); err != nil {
+		logger.TechLog.Error(ctx, "unable to record end of workbench connection", zap.Uint64("connectionID", ended.ID), zap.Error(err))
+	}
+
+	duration := endedAt.Sub(ended.StartedAt)
+	audit.Record(ctx, s.auditWriter, audit_model.AuditActionWorkbenchConnectionEnd,
+		audit.WithWorkbenchID(workbench.ID),
+		audit.WithWorkspaceID(workbench.WorkspaceID),
+		audit.WithDescription(fmt.Sprintf("Disconnected from session '%s' (ID %d) in workspace %d after %s.", workbench.Name, workbench.ID, workbench.WorkspaceID, duration.Round(time.Second))),
+		audit.WithDetail("connection_id", ended.ID),
+		audit.WithDetail("workbench_id", workbench.ID),
+		audit.WithDetail("workspace_id", workbench.WorkspaceID),
+		audit.WithDetail("client_ip", ended.ClientIP),
+		audit.WithDetail("duration_seconds", int64(duration.Seconds())),
+		audit.WithDetail("bytes_in", ended.BytesIn),
+		audit.WithDetail("bytes_out", ended.BytesOut),
+	)
+}
+
+// saveWorkbenchConnections saves the bytes transferred so far by the open connections, so
+// that a connection interrupted by a restart of the backend is still accounted for.
+func (s *WorkbenchService) saveWorkbenchConnections(ctx context.Context) {
+	s.connectionsMutex.Lock()
+	connections := make([]*model.WorkbenchConnection, 0, len(s.connections))
+	for connection := range s.connections {
+		connections = append(connections, connection.snapshot())
+	}
+	s.connectionsMutex.Unlock()
+
+	if len(connections) == 0 {
+		return
+	}
+
+	if err := s.store.UpdateWorkbenchConnections(ctx, connections); err != nil {
+		logger.TechLog.Error(ctx, fmt.Sprintf("unable to save %v open workbench connections", len(connections)), zap.Error(err))
+	}
+}
+
+// ListWorkbenchConnections lists the connections to workbenches, most recent first.
+func (s *WorkbenchService) ListWorkbenchConnections(ctx context.Context, tenantID uint64, pagination *common_model.Pagination, filter model.WorkbenchConnectionFilter) ([]*model.WorkbenchConnection, *common_model.PaginationResult, error) {
+	connections, paginationRes, err := s.store.ListWorkbenchConnections(ctx, tenantID, pagination, filter)
+	if err != nil {
+		return nil, nil, cerr.WrapStoreError(err, "Unable to list workbench connections")
+	}
+
+	return connections, paginationRes, nil
+}
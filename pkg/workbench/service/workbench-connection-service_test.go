@@ -0,0 +1,132 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+	jwt_model "github.com/CHORUS-TRE/chorus-backend/internal/jwt/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
+)
+
+type connectionStore struct {
+	mockWorkbenchStore
+	created []*model.WorkbenchConnection
+	updated []*model.WorkbenchConnection
+}
+
+func (s *connectionStore) CreateWorkbenchConnection(_ context.Context, tenantID uint64, connection *model.WorkbenchConnection) (*model.WorkbenchConnection, error) {
+	created := *connection
+	created.ID = uint64(len(s.created) + 1)
+	created.TenantID = tenantID
+	created.LastSeenAt = created.StartedAt
+	s.created = append(s.created, &created)
+	return &created, nil
+}
+
+func (s *connectionStore) UpdateWorkbenchConnections(_ context.Context, connections []*model.WorkbenchConnection) error {
+	s.updated = append(s.updated, connections...)
+	return nil
+}
+
+func newConnectionSvc(cfg config.Config, store WorkbenchStore) *WorkbenchService {
+	return &WorkbenchService{
+		cfg:         cfg,
+		store:       store,
+		auditWriter: &mockAuditWriter{},
+		connections: make(map[*workbenchConnection]struct{}),
+	}
+}
+
+func userContext(userID uint64) context.Context {
+	return context.WithValue(context.Background(), jwt_model.JWTClaimsContextKey, &jwt_model.JWTClaims{ID: userID, TenantID: 1})
+}
+
+func TestClientIP(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.RemoteAddr = "10.0.0.7:51234"
+
+	s := newConnectionSvc(config.Config{}, nil)
+	assert.Equal(t, "10.0.0.7", s.clientIP(r))
+
+	r.Header.Set("X-Forwarded-For", "192.0.2.1, 10.0.0.2")
+	assert.Equal(t, "192.0.2.1", s.clientIP(r))
+
+	cfg := config.Config{}
+	cfg.Daemon.HTTP.HeaderClientIP = "X-Real-IP"
+	s = newConnectionSvc(cfg, nil)
+	assert.Equal(t, "10.0.0.7", s.clientIP(r))
+
+	r.Header.Set("X-Real-IP", "198.51.100.4")
+	assert.Equal(t, "198.51.100.4", s.clientIP(r))
+}
+
+func TestWorkbenchConnection_RecordsSessionAndBytes(t *testing.T) {
+	store := &connectionStore{}
+	s := newConnectionSvc(config.Config{}, store)
+	workbench := &model.Workbench{ID: 3, WorkspaceID: 7, Name: "workbench3"}
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.RemoteAddr = "10.0.0.7:51234"
+	r.Header.Set("Upgrade", "websocket")
+	r.Header.Set("User-Agent", "test-agent")
+	require.True(t, isWebSocketUpgrade(r))
+
+	connection, w, err := s.startWorkbenchConnection(userContext(42), 1, workbench, httptest.NewRecorder(), r)
+	require.NoError(t, err)
+	require.Len(t, store.created, 1)
+	assert.Equal(t, uint64(42), store.created[0].UserID)
+	assert.Equal(t, uint64(7), store.created[0].WorkspaceID)
+	assert.Equal(t, "10.0.0.7", store.created[0].ClientIP)
+	assert.Equal(t, "test-agent", store.created[0].UserAgent)
+
+	// The response before the upgrade and the traffic of the websocket are counted.
+	_, err = w.Write([]byte("HTTP/1.1 101"))
+	require.NoError(t, err)
+
+	client, server := net.Pipe()
+	conn := &countingConn{Conn: server, connection: connection}
+	go func() {
+		_, _ = client.Write([]byte("input"))
+		_, _ = io.ReadFull(client, make([]byte, 6))
+	}()
+	_, err = io.ReadFull(conn, make([]byte, 5))
+	require.NoError(t, err)
+	_, err = conn.Write([]byte("output"))
+	require.NoError(t, err)
+
+	s.saveWorkbenchConnections(context.Background())
+	require.Len(t, store.updated, 1)
+	assert.Nil(t, store.updated[0].EndedAt)
+	assert.Equal(t, uint64(5), store.updated[0].BytesIn)
+	assert.Equal(t, uint64(12+6), store.updated[0].BytesOut)
+
+	s.endWorkbenchConnection(userContext(42), workbench, connection)
+	require.Len(t, store.updated, 2)
+	assert.NotNil(t, store.updated[1].EndedAt)
+	assert.Equal(t, uint64(1), store.updated[1].TenantID)
+	assert.Empty(t, s.connections)
+
+	// Closed connections are no longer saved.
+	s.saveWorkbenchConnections(context.Background())
+	assert.Len(t, store.updated, 2)
+}
+
+func TestStartWorkbenchConnection_RequiresUser(t *testing.T) {
+	store := &connectionStore{}
+	s := newConnectionSvc(config.Config{}, store)
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	_, _, err := s.startWorkbenchConnection(context.Background(), 1, &model.Workbench{ID: 3}, httptest.NewRecorder(), r)
+	assert.Error(t, err)
+	assert.Empty(t, store.created)
+}
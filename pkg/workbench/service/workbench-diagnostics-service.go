@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/k8s"
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
+	workspace_model "github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
+)
+
+const redacted = "[REDACTED]"
+
+// secretPatterns match the secrets that apps and the workbench server are known to
+// print, so that logs and events can be shown to workbench members. They are applied
+// in order: the later patterns do not need to handle what the earlier ones replaced.
+var secretPatterns = []struct {
+	re   *regexp.Regexp
+	repl string
+}{
+	{regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----`), redacted},
+	{regexp.MustCompile(`(://[^/\s:@]+:)[^/\s@]+@`), "${1}" + redacted + "@"},
+	{regexp.MustCompile(`(?i)\b(bearer|basic)\s+[A-Za-z0-9._~+/=-]+`), "${1} " + redacted},
+	{regexp.MustCompile(`(?i)([\w.-]*(?:password|passwd|pwd|secret|token|api[_-]?key|access[_-]?key|credential)[\w.-]*["']?\s*[:=]\s*["']?)[^\s"',;&]+`), "${1}" + redacted},
+	{regexp.MustCompile(`eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`), redacted},
+	{regexp.MustCompile(`\b(?:AKIA|ASIA)[A-Z0-9]{16}\b`), redacted},
+}
+
+// redactSecrets replaces the secrets found in logs or event messages.
+func redactSecrets(s string) string {
+	for _, p := range secretPatterns {
+		s = p.re.ReplaceAllString(s, p.repl)
+	}
+	return s
+}
+
+// GetAppInstanceLogs returns the recent logs of the container running an app instance.
+func (s *WorkbenchService) GetAppInstanceLogs(ctx context.Context, tenantID, appInstanceID uint64, req model.PodLogRequest) (*model.PodLogs, error) {
+	appInstance, err := s.store.GetAppInstance(ctx, tenantID, appInstanceID)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to get app instance %v", appInstanceID))
+	}
+
+	logs, err := s.client.GetAppInstanceLogs(workspace_model.GetWorkspaceClusterName(appInstance.WorkspaceID), model.GetWorkbenchClusterName(appInstance.WorkbenchID), k8s.AppInstance{ID: appInstance.ID}, podLogOptions(req))
+	if err != nil {
+		return nil, podDiagnosticsError(err, fmt.Sprintf("Unable to get logs of app instance %v", appInstanceID))
+	}
+
+	return podLogsFromK8s(logs), nil
+}
+
+// ListAppInstanceEvents returns the Kubernetes events of the pod running an app instance.
+func (s *WorkbenchService) ListAppInstanceEvents(ctx context.Context, tenantID, appInstanceID uint64) ([]*model.PodEvent, error) {
+	appInstance, err := s.store.GetAppInstance(ctx, tenantID, appInstanceID)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to get app instance %v", appInstanceID))
+	}
+
+	events, err := s.client.ListAppInstanceEvents(workspace_model.GetWorkspaceClusterName(appInstance.WorkspaceID), model.GetWorkbenchClusterName(appInstance.WorkbenchID), k8s.AppInstance{ID: appInstance.ID})
+	if err != nil {
+		return nil, podDiagnosticsError(err, fmt.Sprintf("Unable to list events of app instance %v", appInstanceID))
+	}
+
+	return podEventsFromK8s(events), nil
+}
+
+// GetWorkbenchServerLogs returns the recent logs of the workbench server container.
+func (s *WorkbenchService) GetWorkbenchServerLogs(ctx context.Context, tenantID, workbenchID uint64, req model.PodLogRequest) (*model.PodLogs, error) {
+	workbench, err := s.store.GetWorkbench(ctx, tenantID, workbenchID)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to get workbench %v", workbenchID))
+	}
+
+	logs, err := s.client.GetWorkbenchServerLogs(workspace_model.GetWorkspaceClusterName(workbench.WorkspaceID), model.GetWorkbenchClusterName(workbenchID), podLogOptions(req))
+	if err != nil {
+		return nil, podDiagnosticsError(err, fmt.Sprintf("Unable to get logs of workbench %v", workbenchID))
+	}
+
+	return podLogsFromK8s(logs), nil
+}
+
+// ListWorkbenchServerEvents returns the Kubernetes events of the workbench server pod.
+func (s *WorkbenchService) ListWorkbenchServerEvents(ctx context.Context, tenantID, workbenchID uint64) ([]*model.PodEvent, error) {
+	workbench, err := s.store.GetWorkbench(ctx, tenantID, workbenchID)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to get workbench %v", workbenchID))
+	}
+
+	events, err := s.client.ListWorkbenchServerEvents(workspace_model.GetWorkspaceClusterName(workbench.WorkspaceID), model.GetWorkbenchClusterName(workbenchID))
+	if err != nil {
+		return nil, podDiagnosticsError(err, fmt.Sprintf("Unable to list events of workbench %v", workbenchID))
+	}
+
+	return podEventsFromK8s(events), nil
+}
+
+func podLogOptions(req model.PodLogRequest) k8s.PodLogOptions {
+	tailLines := req.TailLines
+	if tailLines == 0 {
+		tailLines = model.DefaultPodLogTailLines
+	}
+
+	return k8s.PodLogOptions{
+		TailLines:  int64(tailLines),
+		Since:      req.Since,
+		Previous:   req.Previous,
+		LimitBytes: model.MaxPodLogBytes,
+	}
+}
+
+func podDiagnosticsError(err error, message string) error {
+	switch {
+	case errors.Is(err, k8s.ErrPodNotFound):
+		return cerr.ErrNotFound.Wrap(err, message+": no pod is running")
+	case errors.Is(err, k8s.ErrPodLogsUnavailable):
+		return cerr.ErrInvalidRequest.Wrap(err, message+": no such logs")
+	default:
+		return cerr.ErrInternal.Wrap(err, message)
+	}
+}
+
+func podLogsFromK8s(logs *k8s.PodLogs) *model.PodLogs {
+	return &model.PodLogs{
+		PodName:   logs.PodName,
+		Container: logs.Container,
+		Logs:      redactSecrets(logs.Logs),
+	}
+}
+
+func podEventsFromK8s(events []k8s.PodEvent) []*model.PodEvent {
+	res := make([]*model.PodEvent, 0, len(events))
+	for _, e := range events {
+		res = append(res, &model.PodEvent{
+			Type:        e.Type,
+			Reason:      e.Reason,
+			Message:     redactSecrets(e.Message),
+			Count:       e.Count,
+			FirstSeenAt: e.FirstSeenAt,
+			LastSeenAt:  e.LastSeenAt,
+		})
+	}
+	return res
+}
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils"
+	app_model "github.com/CHORUS-TRE/chorus-backend/pkg/app/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
+	workspace_model "github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
+
+	"go.uber.org/zap"
+)
+
+// HibernateWorkbench tears down the K8s resources of a workbench while keeping it, along
+// with the app instances it is running, pinned to their current image and resolution, so
+// that ResumeWorkbench rebuilds the same desktop.
+func (s *WorkbenchService) HibernateWorkbench(ctx context.Context, tenantID, workbenchID uint64) (*model.Workbench, error) {
+	workbench, err := s.store.GetWorkbench(ctx, tenantID, workbenchID)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to get workbench %v", workbenchID))
+	}
+	if workbench.Status != model.WorkbenchActive {
+		return nil, cerr.ErrInvalidRequest.WithMessage(fmt.Sprintf("Unable to hibernate workbench %v as it is %v", workbenchID, workbench.Status))
+	}
+
+	apps, err := s.store.ListWorkbenchAppInstances(ctx, workbenchID)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to list app instances of workbench %v", workbenchID))
+	}
+
+	s.resolveHibernatedImageDigests(ctx, apps)
+
+	if _, err := s.store.CreateWorkbenchHibernation(ctx, tenantID, model.NewWorkbenchHibernation(workbench, apps)); err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to record app instances of workbench %v", workbenchID))
+	}
+
+	// The workbench is marked hibernated before its resource is deleted so that the k8s
+	// watcher ignores the updates sent while the resource terminates.
+	workbench.Status = model.WorkbenchHibernated
+	workbench.ServerPodStatus = model.WorkbenchServerPodStatusTerminated
+	workbench.ServerPodMessage = ""
+	if _, err := s.store.UpdateWorkbenchStatus(ctx, tenantID, workbench); err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to update status of workbench %v", workbenchID))
+	}
+
+	err = s.client.DeleteWorkbench(workspace_model.GetWorkspaceClusterName(workbench.WorkspaceID), model.GetWorkbenchClusterName(workbenchID))
+	if err != nil {
+		return nil, cerr.ErrInternal.Wrap(err, fmt.Sprintf("Unable to hibernate workbench %v in K8s", workbenchID))
+	}
+
+	hibernatedApps := make([]*model.AppInstance, 0, len(apps))
+	for _, app := range apps {
+		hibernatedApps = append(hibernatedApps, &model.AppInstance{
+			ID:         app.ID,
+			Status:     model.AppInstanceInactive,
+			K8sStatus:  model.K8sAppInstanceStatusStopped,
+			K8sMessage: "Stopped while the workbench is hibernated",
+			K8sState:   app.K8sState,
+		})
+	}
+	if err := s.store.UpdateAppInstances(ctx, tenantID, hibernatedApps); err != nil {
+		logger.TechLog.Error(ctx, "unable to update app instances of hibernated workbench", zap.Error(err), zap.Uint64("workbenchID", workbenchID))
+	}
+
+	s.publishWorkbenchStatus(ctx, workbench)
+
+	return workbench, nil
+}
+
+// ResumeWorkbench recreates a hibernated workbench in K8s with the app instances, images
+// and resolution it had when it was hibernated. Workbenches of read-only workspaces
+// cannot be resumed.
+func (s *WorkbenchService) ResumeWorkbench(ctx context.Context, tenantID, workbenchID uint64) (*model.Workbench, error) {
+	workbench, err := s.store.GetWorkbench(ctx, tenantID, workbenchID)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to get workbench %v", workbenchID))
+	}
+	if workbench.Status != model.WorkbenchHibernated {
+		return nil, cerr.ErrInvalidRequest.WithMessage(fmt.Sprintf("Unable to resume workbench %v as it is not hibernated", workbenchID))
+	}
+	ws, err := s.workspaceReader.GetWorkspace(ctx, tenantID, workbench.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if ws.Status.IsReadOnly() {
+		return nil, cerr.ErrReadOnly.WithMessage(fmt.Sprintf("Unable to resume workbench %v as workspace %v is %v", workbenchID, workbench.WorkspaceID, ws.Status))
+	}
+
+	hibernation, err := s.store.GetWorkbenchHibernation(ctx, tenantID, workbenchID)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to get app instances of hibernated workbench %v", workbenchID))
+	}
+
+	apps, err := s.store.ListWorkbenchAppInstances(ctx, workbenchID)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to list app instances of workbench %v", workbenchID))
+	}
+	apps = hibernation.Restore(workbench, apps)
+
+	// The pins are saved on the app instances, otherwise the next sync of the workbench
+	// would move them to the current image of their version.
+	for _, app := range apps {
+		if app.AppVersionID == 0 && app.AppDockerImageDigest == "" {
+			continue
+		}
+		if _, err := s.store.UpdateAppInstanceVersion(ctx, tenantID, app.ID, app.AppVersionID, app.AppDockerImageDigest); err != nil {
+			return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to pin the image of appInstance %v", app.ID))
+		}
+	}
+
+	workbench.Status = model.WorkbenchActive
+	workbench.ServerPodStatus = model.WorkbenchServerPodStatusWaiting
+	if _, err := s.store.UpdateWorkbenchStatus(ctx, tenantID, workbench); err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to update status of workbench %v", workbenchID))
+	}
+
+	if err := s.updateWorkbenchInK8s(ctx, workbench, apps); err != nil {
+		return nil, cerr.ErrInternal.Wrap(err, fmt.Sprintf("Unable to resume workbench %v in K8s", workbenchID))
+	}
+
+	if err := s.store.DeleteWorkbenchHibernation(ctx, tenantID, workbenchID); err != nil {
+		logger.TechLog.Error(ctx, "unable to delete hibernation of resumed workbench", zap.Error(err), zap.Uint64("workbenchID", workbenchID))
+	}
+
+	s.publishWorkbenchStatus(ctx, workbench)
+
+	return workbench, nil
+}
+
+// resolveHibernatedImageDigests resolves the digest of the images of the app instances
+// not pinned to one yet, so that resuming the workbench runs the exact same images even
+// if their tags are moved meanwhile. App instances whose digest cannot be resolved stay
+// pinned to their tag.
+func (s *WorkbenchService) resolveHibernatedImageDigests(ctx context.Context, apps []*model.AppInstance) {
+	for _, app := range apps {
+		if app.AppDockerImageDigest != "" || utils.ToString(app.AppDockerImageTag) == "" {
+			continue
+		}
+
+		imageRef := s.appImage(&app_model.App{
+			DockerImageRegistry: utils.ToString(app.AppDockerImageRegistry),
+			DockerImageName:     utils.ToString(app.AppDockerImageName),
+		}) + ":" + utils.ToString(app.AppDockerImageTag)
+
+		digest, err := s.ociClient.GetDigest(imageRef)
+		if err != nil {
+			logger.TechLog.Warn(ctx, "unable to resolve the digest of the image of a hibernated app instance", zap.Error(err), zap.Uint64("appInstanceID", app.ID), zap.String("image", imageRef))
+			continue
+		}
+		app.AppDockerImageDigest = digest
+	}
+}
@@ -0,0 +1,261 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/k8s"
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	user_model "github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
+	user_service "github.com/CHORUS-TRE/chorus-backend/pkg/user/service"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
+	workspace_model "github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
+)
+
+type hibernationStore struct {
+	mockWorkbenchStore
+	workbench   *model.Workbench
+	apps        []*model.AppInstance
+	hibernation *model.WorkbenchHibernation
+
+	updatedApps []*model.AppInstance
+	pinnedApps  map[uint64]model.AppInstance
+}
+
+func (s *hibernationStore) GetWorkbench(_ context.Context, _, _ uint64) (*model.Workbench, error) {
+	wb := *s.workbench
+	return &wb, nil
+}
+
+func (s *hibernationStore) UpdateWorkbenchStatus(_ context.Context, _ uint64, workbench *model.Workbench) (*model.Workbench, error) {
+	wb := *workbench
+	s.workbench = &wb
+	return workbench, nil
+}
+
+func (s *hibernationStore) ListWorkbenchAppInstances(_ context.Context, _ uint64) ([]*model.AppInstance, error) {
+	apps := make([]*model.AppInstance, 0, len(s.apps))
+	for _, app := range s.apps {
+		a := *app
+		apps = append(apps, &a)
+	}
+	return apps, nil
+}
+
+func (s *hibernationStore) UpdateAppInstances(_ context.Context, _ uint64, apps []*model.AppInstance) error {
+	s.updatedApps = apps
+	return nil
+}
+
+func (s *hibernationStore) UpdateAppInstanceVersion(_ context.Context, _ uint64, appInstanceID uint64, appVersionID uint64, imageDigest string) (*model.AppInstance, error) {
+	if s.pinnedApps == nil {
+		s.pinnedApps = map[uint64]model.AppInstance{}
+	}
+	pinned := model.AppInstance{ID: appInstanceID, AppVersionID: appVersionID, AppDockerImageDigest: imageDigest}
+	s.pinnedApps[appInstanceID] = pinned
+	return &pinned, nil
+}
+
+func (s *hibernationStore) GetWorkbenchHibernation(_ context.Context, _, _ uint64) (*model.WorkbenchHibernation, error) {
+	if s.hibernation == nil {
+		return nil, sql.ErrNoRows
+	}
+	return s.hibernation, nil
+}
+
+func (s *hibernationStore) CreateWorkbenchHibernation(_ context.Context, _ uint64, hibernation *model.WorkbenchHibernation) (*model.WorkbenchHibernation, error) {
+	s.hibernation = hibernation
+	return hibernation, nil
+}
+
+func (s *hibernationStore) DeleteWorkbenchHibernation(_ context.Context, _, _ uint64) error {
+	s.hibernation = nil
+	return nil
+}
+
+type recordingK8sClient struct {
+	k8s.K8sClienter
+	updated []k8s.Workbench
+	deleted []string
+}
+
+func (c *recordingK8sClient) UpdateWorkbench(workbench k8s.Workbench) error {
+	c.updated = append(c.updated, workbench)
+	return nil
+}
+
+func (c *recordingK8sClient) DeleteWorkbench(_, workbenchName string) error {
+	c.deleted = append(c.deleted, workbenchName)
+	return nil
+}
+
+func newHibernationSvc(store WorkbenchStore, client k8s.K8sClienter) *WorkbenchService {
+	getUser := func(_ context.Context, req user_service.GetUserReq) (*user_model.User, error) {
+		return &user_model.User{ID: req.ID}, nil
+	}
+
+	return &WorkbenchService{
+		store:           store,
+		client:          client,
+		ociClient:       &digestOCIClient{},
+		userer:          &mockUserer{getUser: getUser},
+		workspaceReader: &mockWorkspaceReader{status: workspace_model.WorkspaceStatusActive},
+	}
+}
+
+func strPtr(s string) *string { return &s }
+
+func TestHibernateAndResumeWorkbench(t *testing.T) {
+	store := &hibernationStore{
+		workbench: &model.Workbench{
+			ID: 3, TenantID: 1, UserID: 2, WorkspaceID: 7, Name: "analysis",
+			Status:                 model.WorkbenchActive,
+			InitialResolutionWidth: 1920, InitialResolutionHeight: 1080,
+		},
+		apps: []*model.AppInstance{
+			{ID: 10, AppID: 100, AppVersionID: 20, AppName: strPtr("jupyter"), AppDockerImageRegistry: strPtr("harbor.local"), AppDockerImageName: strPtr("apps/jupyter"), AppDockerImageTag: strPtr("1.0"), K8sState: model.K8sAppInstanceStateRunning, InitialResolutionWidth: 1280, InitialResolutionHeight: 720},
+			{ID: 11, AppID: 101, AppVersionID: 21, AppName: strPtr("rstudio"), AppDockerImageRegistry: strPtr("harbor.local"), AppDockerImageName: strPtr("apps/rstudio"), AppDockerImageTag: strPtr("4.2"), AppDockerImageDigest: "sha256:" + strings.Repeat("b", 64), K8sState: model.K8sAppInstanceStateStopped},
+		},
+	}
+	client := &recordingK8sClient{K8sClienter: k8s.NewTestClient()}
+	svc := newHibernationSvc(store, client)
+
+	hibernated, err := svc.HibernateWorkbench(context.Background(), 1, 3)
+	require.NoError(t, err)
+	assert.Equal(t, model.WorkbenchHibernated, hibernated.Status)
+	assert.Equal(t, model.WorkbenchHibernated, store.workbench.Status)
+	assert.Equal(t, []string{"workbench3"}, client.deleted)
+	require.NotNil(t, store.hibernation)
+	assert.Len(t, store.hibernation.AppInstances, 2)
+	require.Len(t, store.updatedApps, 2)
+	for _, app := range store.updatedApps {
+		assert.Equal(t, model.AppInstanceInactive, app.Status)
+	}
+	assert.Equal(t, model.K8sAppInstanceStateStopped, store.updatedApps[1].K8sState)
+
+	digestA, digestB := "sha256:"+strings.Repeat("a", 64), "sha256:"+strings.Repeat("b", 64)
+	assert.Equal(t, digestA, store.hibernation.AppInstances[0].DockerImageDigest, "the digest of an unpinned image is resolved")
+	assert.Equal(t, digestB, store.hibernation.AppInstances[1].DockerImageDigest)
+
+	// While hibernated, the catalog moves on and the resolution is changed.
+	store.apps[0].AppVersionID = 30
+	store.apps[0].AppDockerImageTag = strPtr("2.0")
+	store.workbench.InitialResolutionWidth = 800
+
+	resumed, err := svc.ResumeWorkbench(context.Background(), 1, 3)
+	require.NoError(t, err)
+	assert.Equal(t, model.WorkbenchActive, resumed.Status)
+	assert.Nil(t, store.hibernation)
+
+	require.Len(t, client.updated, 1)
+	updated := client.updated[0]
+	assert.Equal(t, "workbench3", updated.Name)
+	assert.Equal(t, uint32(1920), updated.InitialResolutionWidth)
+	assert.Equal(t, uint32(1080), updated.InitialResolutionHeight)
+	require.Len(t, updated.Apps, 2)
+	assert.Equal(t, "1.0", updated.Apps[0].AppTag)
+	assert.Equal(t, model.K8sAppInstanceStateRunning.String(), updated.Apps[0].K8sState)
+	assert.Equal(t, "4.2", updated.Apps[1].AppTag)
+	assert.Equal(t, model.K8sAppInstanceStateStopped.String(), updated.Apps[1].K8sState)
+	assert.Equal(t, digestA, updated.Apps[0].AppDigest)
+	assert.Equal(t, digestB, updated.Apps[1].AppDigest)
+
+	// The pins outlive the resume, so that later syncs of the workbench keep them.
+	assert.Equal(t, map[uint64]model.AppInstance{
+		10: {ID: 10, AppVersionID: 20, AppDockerImageDigest: digestA},
+		11: {ID: 11, AppVersionID: 21, AppDockerImageDigest: digestB},
+	}, store.pinnedApps)
+}
+
+func TestResumeWorkbench_SkipsAppInstancesDeletedWhileHibernated(t *testing.T) {
+	store := &hibernationStore{
+		workbench: &model.Workbench{ID: 3, TenantID: 1, WorkspaceID: 7, Status: model.WorkbenchHibernated},
+		apps: []*model.AppInstance{
+			{ID: 11, AppID: 101, AppDockerImageTag: strPtr("4.2")},
+		},
+		hibernation: &model.WorkbenchHibernation{
+			WorkbenchID: 3, TenantID: 1,
+			AppInstances: []model.HibernatedAppInstance{
+				{AppInstanceID: 10, AppID: 100, DockerImageTag: "1.0"},
+				{AppInstanceID: 11, AppID: 101, DockerImageTag: "4.1"},
+			},
+		},
+	}
+	client := &recordingK8sClient{K8sClienter: k8s.NewTestClient()}
+	svc := newHibernationSvc(store, client)
+
+	_, err := svc.ResumeWorkbench(context.Background(), 1, 3)
+	require.NoError(t, err)
+
+	require.Len(t, client.updated, 1)
+	require.Len(t, client.updated[0].Apps, 1)
+	assert.Equal(t, uint64(11), client.updated[0].Apps[0].ID)
+	assert.Equal(t, "4.1", client.updated[0].Apps[0].AppTag)
+}
+
+func TestHibernateWorkbench_RejectsStoppedWorkbench(t *testing.T) {
+	for _, status := range []model.WorkbenchStatus{model.WorkbenchInactive, model.WorkbenchHibernated} {
+		t.Run(status.String(), func(t *testing.T) {
+			store := &hibernationStore{workbench: &model.Workbench{ID: 3, TenantID: 1, Status: status}}
+			client := &recordingK8sClient{K8sClienter: k8s.NewTestClient()}
+			svc := newHibernationSvc(store, client)
+
+			_, err := svc.HibernateWorkbench(context.Background(), 1, 3)
+			var cErr *cerr.ChorusError
+			require.ErrorAs(t, err, &cErr)
+			assert.Equal(t, cerr.ErrInvalidRequest.ChorusCode, cErr.ChorusCode)
+			assert.Empty(t, client.deleted)
+		})
+	}
+}
+
+func TestResumeWorkbench_RejectsWorkbenchNotHibernated(t *testing.T) {
+	store := &hibernationStore{workbench: &model.Workbench{ID: 3, TenantID: 1, Status: model.WorkbenchActive}}
+	client := &recordingK8sClient{K8sClienter: k8s.NewTestClient()}
+	svc := newHibernationSvc(store, client)
+
+	_, err := svc.ResumeWorkbench(context.Background(), 1, 3)
+	var cErr *cerr.ChorusError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, cerr.ErrInvalidRequest.ChorusCode, cErr.ChorusCode)
+	assert.Empty(t, client.updated)
+}
+
+func TestResumeWorkbench_RejectsReadOnlyWorkspace(t *testing.T) {
+	for _, status := range []workspace_model.WorkspaceStatus{workspace_model.WorkspaceStatusFrozen, workspace_model.WorkspaceStatusArchived} {
+		t.Run(status.String(), func(t *testing.T) {
+			store := &hibernationStore{
+				workbench:   &model.Workbench{ID: 3, TenantID: 1, WorkspaceID: 7, Status: model.WorkbenchHibernated},
+				hibernation: &model.WorkbenchHibernation{WorkbenchID: 3, TenantID: 1},
+			}
+			client := &recordingK8sClient{K8sClienter: k8s.NewTestClient()}
+			svc := newHibernationSvc(store, client)
+			svc.workspaceReader = &mockWorkspaceReader{status: status}
+
+			_, err := svc.ResumeWorkbench(context.Background(), 1, 3)
+			var cErr *cerr.ChorusError
+			require.ErrorAs(t, err, &cErr)
+			assert.Equal(t, cerr.ErrReadOnly.ChorusCode, cErr.ChorusCode)
+			assert.Equal(t, model.WorkbenchHibernated, store.workbench.Status)
+			assert.NotNil(t, store.hibernation)
+			assert.Empty(t, client.updated)
+		})
+	}
+}
+
+func TestCreateAppInstance_RejectsHibernatedWorkbench(t *testing.T) {
+	store := &hibernationStore{workbench: &model.Workbench{ID: 3, TenantID: 1, Status: model.WorkbenchHibernated}}
+	svc := newHibernationSvc(store, &recordingK8sClient{K8sClienter: k8s.NewTestClient()})
+
+	_, err := svc.CreateAppInstance(context.Background(), &model.AppInstance{TenantID: 1, WorkbenchID: 3})
+	var cErr *cerr.ChorusError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, cerr.ErrInvalidRequest.ChorusCode, cErr.ChorusCode)
+}
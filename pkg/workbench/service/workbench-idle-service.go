@@ -0,0 +1,254 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/audit"
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/k8s"
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	audit_model "github.com/CHORUS-TRE/chorus-backend/pkg/audit/model"
+	notification_model "github.com/CHORUS-TRE/chorus-backend/pkg/notification/model"
+	user_service "github.com/CHORUS-TRE/chorus-backend/pkg/user/service"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
+	workspace_model "github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
+
+	"go.uber.org/zap"
+)
+
+type idleAction int
+
+const (
+	idleActionNone idleAction = iota
+	idleActionWarn
+	idleActionReclaim
+)
+
+// decideIdleAction tells what to do with a workbench given its idle timeout and the lead
+// time of the warning, along with when the workbench is reclaimed. A warned owner always
+// gets the full lead time, even when the warning went out late.
+func decideIdleAction(activity *model.WorkbenchActivity, timeout, warning time.Duration, now time.Time) (idleAction, time.Time) {
+	if timeout <= 0 {
+		return idleActionNone, time.Time{}
+	}
+
+	reclaimAt := activity.LastActivityAt.Add(timeout)
+	if warning > 0 {
+		if !activity.IsIdleWarned() {
+			if now.Before(reclaimAt.Add(-warning)) {
+				return idleActionNone, time.Time{}
+			}
+			return idleActionWarn, now.Add(warning)
+		}
+		if earliest := activity.IdleWarnedAt.Add(warning); earliest.After(reclaimAt) {
+			reclaimAt = earliest
+		}
+	}
+
+	if now.Before(reclaimAt) {
+		return idleActionNone, time.Time{}
+	}
+	return idleActionReclaim, reclaimAt
+}
+
+// workbenchIdleTimeout returns the idle timeout of the workbenches of a workspace: the
+// one set on the workspace, or else the platform default, capped by the platform maximum.
+// 0 means the workbenches are never reclaimed.
+func (s *WorkbenchService) workbenchIdleTimeout(workspaceTimeoutMinutes *int) time.Duration {
+	timeout := s.cfg.Services.WorkbenchService.WorkbenchIdleTimeout
+	if workspaceTimeoutMinutes != nil && *workspaceTimeoutMinutes > 0 {
+		timeout = time.Duration(*workspaceTimeoutMinutes) * time.Minute
+	}
+
+	maxTimeout := s.cfg.Services.WorkbenchService.MaxWorkbenchIdleTimeout
+	if maxTimeout > 0 && (timeout <= 0 || timeout > maxTimeout) {
+		timeout = maxTimeout
+	}
+
+	return timeout
+}
+
+// KeepWorkbenchAlive restarts the idle clock of a workbench, as if it had just been
+// accessed, and returns when it will be reclaimed if it stays idle.
+func (s *WorkbenchService) KeepWorkbenchAlive(ctx context.Context, tenantID, workbenchID uint64) (*time.Time, error) {
+	workbench, err := s.store.GetWorkbench(ctx, tenantID, workbenchID)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to get workbench %v", workbenchID))
+	}
+
+	workspace, err := s.workspaceReader.GetWorkspace(ctx, tenantID, workbench.WorkspaceID)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to get workspace %v", workbench.WorkspaceID))
+	}
+
+	if err := s.store.KeepWorkbenchAlive(ctx, tenantID, workbenchID); err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to keep workbench %v alive", workbenchID))
+	}
+
+	timeout := s.workbenchIdleTimeout(workspace.WorkbenchIdleTimeoutMinutes)
+	if timeout <= 0 {
+		return nil, nil
+	}
+	reclaimAt := time.Now().Add(timeout)
+	return &reclaimAt, nil
+}
+
+func (s *WorkbenchService) cleanIdleWorkbenches(ctx context.Context) {
+	activities, err := s.store.ListWorkbenchActivities(ctx)
+	if err != nil {
+		logger.TechLog.Error(ctx, "unable to query idle workbenches", zap.Error(err))
+		return
+	}
+
+	warning := s.cfg.Services.WorkbenchService.WorkbenchIdleWarning
+	now := time.Now()
+	for _, activity := range activities {
+		timeout := s.workbenchIdleTimeout(activity.WorkspaceIdleTimeoutMinutes)
+
+		action, reclaimAt := decideIdleAction(activity, timeout, warning, now)
+		if action == idleActionNone {
+			continue
+		}
+
+		// A workbench nobody looks at may still be running an analysis.
+		busy, err := s.isWorkbenchBusy(ctx, activity)
+		if err != nil {
+			logger.TechLog.Error(ctx, "unable to get usage of idle workbench", zap.Error(err), zap.Uint64("workbenchID", activity.WorkbenchID))
+			continue
+		}
+		if busy {
+			if err := s.store.KeepWorkbenchAlive(ctx, activity.TenantID, activity.WorkbenchID); err != nil {
+				logger.TechLog.Error(ctx, "unable to keep busy workbench alive", zap.Error(err), zap.Uint64("workbenchID", activity.WorkbenchID))
+			}
+			continue
+		}
+
+		switch action {
+		case idleActionWarn:
+			s.warnIdleWorkbench(ctx, activity, reclaimAt)
+		case idleActionReclaim:
+			s.reclaimIdleWorkbench(ctx, activity, timeout)
+		}
+	}
+}
+
+// isWorkbenchBusy tells whether the server or one of the app instances of a workbench
+// used at least workbench_idle_cpu_millicores of CPU since the last activity of the
+// workbench. Without pod metrics, a workbench is never busy and only its accesses and
+// keep-alives count.
+func (s *WorkbenchService) isWorkbenchBusy(ctx context.Context, activity *model.WorkbenchActivity) (bool, error) {
+	threshold := s.cfg.Services.WorkbenchService.WorkbenchIdleCPUMillicores
+	if threshold <= 0 {
+		return false, nil
+	}
+
+	usage, err := s.workbenchUsage(ctx, &model.Workbench{ID: activity.WorkbenchID, TenantID: activity.TenantID, WorkspaceID: activity.WorkspaceID})
+	if err != nil {
+		if errors.Is(err, k8s.ErrMetricsDisabled) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	busy := func(history []model.ResourceUsage) bool {
+		for _, sample := range history {
+			if sample.ObservedAt.After(activity.LastActivityAt) && sample.CPUMillicores >= threshold {
+				return true
+			}
+		}
+		return false
+	}
+
+	if busy(usage.ServerHistory) {
+		return true, nil
+	}
+	for _, app := range usage.AppInstances {
+		if busy(app.History) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// warnIdleWorkbench notifies the owner of a workbench, and emails them if enabled, that
+// it will be reclaimed unless it is used or kept alive.
+func (s *WorkbenchService) warnIdleWorkbench(ctx context.Context, activity *model.WorkbenchActivity, reclaimAt time.Time) {
+	if err := s.store.MarkWorkbenchIdleWarned(ctx, activity.TenantID, activity.WorkbenchID); err != nil {
+		logger.TechLog.Error(ctx, "unable to mark idle workbench as warned", zap.Error(err), zap.Uint64("workbenchID", activity.WorkbenchID))
+		return
+	}
+
+	title := fmt.Sprintf("Session %q is about to be closed", activity.Name)
+	message := fmt.Sprintf("Your session %q (ID %d) has been idle since %s and will be closed at %s. Open it or keep it alive to postpone.",
+		activity.Name, activity.WorkbenchID, activity.LastActivityAt.UTC().Format(time.RFC3339), reclaimAt.UTC().Format(time.RFC3339))
+
+	err := s.notificationStore.CreateNotification(ctx, &notification_model.Notification{
+		TenantID: activity.TenantID,
+		Message:  message,
+		Content: notification_model.NotificationContent{
+			Type:               "SystemNotification",
+			SystemNotification: &notification_model.SystemNotification{},
+		},
+	}, []uint64{activity.UserID})
+	if err != nil {
+		logger.TechLog.Error(ctx, "unable to notify owner of idle workbench", zap.Error(err), zap.Uint64("workbenchID", activity.WorkbenchID))
+	}
+
+	if !s.cfg.Services.WorkbenchService.WorkbenchIdleWarningEmail {
+		return
+	}
+
+	owner, err := s.userer.GetUser(ctx, user_service.GetUserReq{TenantID: activity.TenantID, ID: activity.UserID})
+	if err != nil {
+		logger.TechLog.Error(ctx, "unable to get owner of idle workbench", zap.Error(err), zap.Uint64("workbenchID", activity.WorkbenchID))
+		return
+	}
+	if owner.Email == "" {
+		return
+	}
+
+	subject := s.mailer.GetSubject(ctx, activity.TenantID, "workbenchIdle")
+	if subject == "" {
+		subject = title
+	}
+	if err := s.mailer.SendMessage(ctx, activity.TenantID, []string{owner.Email}, subject, title, message); err != nil {
+		logger.TechLog.Error(ctx, "unable to email owner of idle workbench", zap.Error(err), zap.Uint64("workbenchID", activity.WorkbenchID))
+	}
+}
+
+// reclaimIdleWorkbench deletes an idle workbench, unless it was used since its activity
+// was read.
+func (s *WorkbenchService) reclaimIdleWorkbench(ctx context.Context, activity *model.WorkbenchActivity, timeout time.Duration) {
+	workbench, err := s.store.DeleteIdleWorkbench(ctx, activity.TenantID, activity.WorkbenchID, activity.LastActivityAt)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			logger.TechLog.Error(ctx, "unable to delete idle workbench", zap.Error(err), zap.Uint64("workbenchID", activity.WorkbenchID))
+		}
+		return
+	}
+
+	audit.Record(ctx, s.auditWriter, audit_model.AuditActionWorkbenchDelete,
+		audit.WithTenantID(workbench.TenantID),
+		audit.WithActorUsername("system"),
+		audit.WithWorkspaceID(workbench.WorkspaceID),
+		audit.WithWorkbenchID(workbench.ID),
+		audit.WithDescription(fmt.Sprintf("Workbench with ID %d auto-deleted due to idle timeout.", workbench.ID)),
+		audit.WithDetail("trigger", "idle_cleanup"),
+		audit.WithDetail("idle_timeout", timeout.String()),
+		audit.WithDetail("idle_warned", activity.IsIdleWarned()),
+	)
+
+	s.publishWorkbenchStatus(ctx, workbench)
+
+	go func() {
+		logger.TechLog.Debug(ctx, "cleaning idle workbench", zap.Uint64("workbenchID", workbench.ID), zap.String("status", string(workbench.Status)), zap.Any("workbench", workbench))
+		err := s.client.DeleteWorkbench(workspace_model.GetWorkspaceClusterName(workbench.WorkspaceID), model.GetWorkbenchClusterName(workbench.ID))
+		if err != nil {
+			logger.TechLog.Error(ctx, "unable to delete idle workbench", zap.Error(err), zap.Uint64("workbenchID", workbench.ID))
+		}
+	}()
+}
@@ -0,0 +1,361 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"database/sql"
+	"html/template"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/k8s"
+	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+	"github.com/CHORUS-TRE/chorus-backend/internal/mailer"
+	audit_model "github.com/CHORUS-TRE/chorus-backend/pkg/audit/model"
+	notification_model "github.com/CHORUS-TRE/chorus-backend/pkg/notification/model"
+	user_model "github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
+	user_service "github.com/CHORUS-TRE/chorus-backend/pkg/user/service"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
+	workspace_model "github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
+)
+
+type idleStore struct {
+	mockWorkbenchStore
+	activities []*model.WorkbenchActivity
+	// busy lists the workbenches used since their activity was listed.
+	busy map[uint64]bool
+
+	warned  []uint64
+	deleted []uint64
+	kept    []uint64
+}
+
+func (s *idleStore) ListWorkbenchActivities(_ context.Context) ([]*model.WorkbenchActivity, error) {
+	return s.activities, nil
+}
+
+func (s *idleStore) MarkWorkbenchIdleWarned(_ context.Context, _, workbenchID uint64) error {
+	s.warned = append(s.warned, workbenchID)
+	return nil
+}
+
+func (s *idleStore) KeepWorkbenchAlive(_ context.Context, _, workbenchID uint64) error {
+	s.kept = append(s.kept, workbenchID)
+	return nil
+}
+
+func (s *idleStore) ListWorkbenchAppInstances(_ context.Context, workbenchID uint64) ([]*model.AppInstance, error) {
+	return []*model.AppInstance{{ID: workbenchID * 10, WorkbenchID: workbenchID, WorkspaceID: 7}}, nil
+}
+
+func (s *idleStore) DeleteIdleWorkbench(_ context.Context, tenantID, workbenchID uint64, _ time.Time) (*model.Workbench, error) {
+	if s.busy[workbenchID] {
+		return nil, sql.ErrNoRows
+	}
+	s.deleted = append(s.deleted, workbenchID)
+	return &model.Workbench{ID: workbenchID, TenantID: tenantID, WorkspaceID: 7}, nil
+}
+
+type recordingNotificationStore struct {
+	mu         sync.Mutex
+	recipients [][]uint64
+}
+
+func (m *recordingNotificationStore) CreateNotification(_ context.Context, _ *notification_model.Notification, userIDs []uint64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.recipients = append(m.recipients, userIDs)
+	return nil
+}
+
+type mockMailer struct {
+	sentTo [][]string
+}
+
+func (m *mockMailer) SendMessage(_ context.Context, _ uint64, to []string, _, _, _ string) error {
+	m.sentTo = append(m.sentTo, to)
+	return nil
+}
+
+func (m *mockMailer) Send(_ context.Context, _ uint64, _ []string, _ string, _ *template.Template, _ interface{}) error {
+	return nil
+}
+
+func (m *mockMailer) GetSubject(_ context.Context, _ uint64, _ string) string { return "" }
+
+func (m *mockMailer) GetTemplate(_ context.Context, _ uint64, _ mailer.TemplateKey) *template.Template {
+	return nil
+}
+
+type mockAuditWriter struct{}
+
+func (m *mockAuditWriter) Record(_ context.Context, entry *audit_model.AuditEntry) (*audit_model.AuditEntry, error) {
+	return entry, nil
+}
+
+type idleTimeoutWorkspaceReader struct {
+	minutes *int
+}
+
+func (m *idleTimeoutWorkspaceReader) GetWorkspace(_ context.Context, tenantID, workspaceID uint64) (*workspace_model.Workspace, error) {
+	return &workspace_model.Workspace{ID: workspaceID, TenantID: tenantID, WorkbenchIdleTimeoutMinutes: m.minutes}, nil
+}
+
+func idleConfig(timeout, maxTimeout, warning time.Duration, email bool) config.Config {
+	var cfg config.Config
+	cfg.Services.WorkbenchService.WorkbenchIdleTimeout = timeout
+	cfg.Services.WorkbenchService.MaxWorkbenchIdleTimeout = maxTimeout
+	cfg.Services.WorkbenchService.WorkbenchIdleWarning = warning
+	cfg.Services.WorkbenchService.WorkbenchIdleWarningEmail = email
+	return cfg
+}
+
+func newIdleSvc(cfg config.Config, store WorkbenchStore, notifications NotificationStore, mail mailer.Mailer) *WorkbenchService {
+	getOwner := func(_ context.Context, req user_service.GetUserReq) (*user_model.User, error) {
+		return &user_model.User{ID: req.ID, Email: "owner@example.com"}, nil
+	}
+
+	return &WorkbenchService{
+		cfg:               cfg,
+		store:             store,
+		client:            k8s.NewTestClient(),
+		userer:            &mockUserer{getUser: getOwner},
+		notificationStore: notifications,
+		mailer:            mail,
+		workspaceReader:   &idleTimeoutWorkspaceReader{},
+		auditWriter:       &mockAuditWriter{},
+	}
+}
+
+func minutes(m int) *int {
+	return &m
+}
+
+func TestDecideIdleAction(t *testing.T) {
+	now := time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) *time.Time {
+		t := now.Add(d)
+		return &t
+	}
+
+	tests := []struct {
+		name          string
+		activity      model.WorkbenchActivity
+		timeout       time.Duration
+		warning       time.Duration
+		wantAction    idleAction
+		wantReclaimAt time.Time
+	}{
+		{
+			name:       "never reclaimed without timeout",
+			activity:   model.WorkbenchActivity{LastActivityAt: now.Add(-48 * time.Hour)},
+			wantAction: idleActionNone,
+		},
+		{
+			name:       "recently used",
+			activity:   model.WorkbenchActivity{LastActivityAt: now.Add(-time.Hour)},
+			timeout:    2 * time.Hour,
+			warning:    30 * time.Minute,
+			wantAction: idleActionNone,
+		},
+		{
+			name:          "warned ahead of the timeout",
+			activity:      model.WorkbenchActivity{LastActivityAt: now.Add(-100 * time.Minute)},
+			timeout:       2 * time.Hour,
+			warning:       30 * time.Minute,
+			wantAction:    idleActionWarn,
+			wantReclaimAt: now.Add(30 * time.Minute),
+		},
+		{
+			name:          "late warning still gives the full lead time",
+			activity:      model.WorkbenchActivity{LastActivityAt: now.Add(-5 * time.Hour)},
+			timeout:       2 * time.Hour,
+			warning:       30 * time.Minute,
+			wantAction:    idleActionWarn,
+			wantReclaimAt: now.Add(30 * time.Minute),
+		},
+		{
+			name:       "warned but lead time not over",
+			activity:   model.WorkbenchActivity{LastActivityAt: now.Add(-5 * time.Hour), IdleWarnedAt: at(-10 * time.Minute)},
+			timeout:    2 * time.Hour,
+			warning:    30 * time.Minute,
+			wantAction: idleActionNone,
+		},
+		{
+			name:          "reclaimed after warning",
+			activity:      model.WorkbenchActivity{LastActivityAt: now.Add(-5 * time.Hour), IdleWarnedAt: at(-31 * time.Minute)},
+			timeout:       2 * time.Hour,
+			warning:       30 * time.Minute,
+			wantAction:    idleActionReclaim,
+			wantReclaimAt: now.Add(-time.Minute),
+		},
+		{
+			name:          "warning older than the last activity is ignored",
+			activity:      model.WorkbenchActivity{LastActivityAt: now.Add(-110 * time.Minute), IdleWarnedAt: at(-3 * time.Hour)},
+			timeout:       2 * time.Hour,
+			warning:       30 * time.Minute,
+			wantAction:    idleActionWarn,
+			wantReclaimAt: now.Add(30 * time.Minute),
+		},
+		{
+			name:          "reclaimed without warning",
+			activity:      model.WorkbenchActivity{LastActivityAt: now.Add(-3 * time.Hour)},
+			timeout:       2 * time.Hour,
+			wantAction:    idleActionReclaim,
+			wantReclaimAt: now.Add(-time.Hour),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			action, reclaimAt := decideIdleAction(&tt.activity, tt.timeout, tt.warning, now)
+			assert.Equal(t, tt.wantAction, action)
+			if tt.wantAction != idleActionNone {
+				assert.Equal(t, tt.wantReclaimAt, reclaimAt)
+			}
+		})
+	}
+}
+
+func TestWorkbenchIdleTimeout(t *testing.T) {
+	tests := []struct {
+		name       string
+		timeout    time.Duration
+		maxTimeout time.Duration
+		workspace  *int
+		want       time.Duration
+	}{
+		{name: "platform default", timeout: 24 * time.Hour, want: 24 * time.Hour},
+		{name: "workspace timeout", timeout: 24 * time.Hour, maxTimeout: 72 * time.Hour, workspace: minutes(48 * 60), want: 48 * time.Hour},
+		{name: "capped workspace timeout", timeout: 24 * time.Hour, maxTimeout: 72 * time.Hour, workspace: minutes(96 * 60), want: 72 * time.Hour},
+		{name: "capped disabled default", maxTimeout: 72 * time.Hour, want: 72 * time.Hour},
+		{name: "workspace timeout without default", workspace: minutes(30), want: 30 * time.Minute},
+		{name: "disabled", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := newIdleSvc(idleConfig(tt.timeout, tt.maxTimeout, 0, false), &idleStore{}, &mockNotificationStore{}, &mockMailer{})
+			assert.Equal(t, tt.want, svc.workbenchIdleTimeout(tt.workspace))
+		})
+	}
+}
+
+func TestCleanIdleWorkbenches(t *testing.T) {
+	now := time.Now()
+	warnedAt := now.Add(-time.Hour)
+	store := &idleStore{
+		activities: []*model.WorkbenchActivity{
+			// in use
+			{WorkbenchID: 1, TenantID: 1, UserID: 10, LastActivityAt: now.Add(-time.Minute)},
+			// about to be reclaimed
+			{WorkbenchID: 2, TenantID: 1, UserID: 20, LastActivityAt: now.Add(-100 * time.Minute)},
+			// warned, lead time over
+			{WorkbenchID: 3, TenantID: 1, UserID: 30, LastActivityAt: now.Add(-3 * time.Hour), IdleWarnedAt: &warnedAt},
+			// the workspace allows a longer idle time
+			{WorkbenchID: 4, TenantID: 1, UserID: 40, LastActivityAt: now.Add(-3 * time.Hour), IdleWarnedAt: &warnedAt, WorkspaceIdleTimeoutMinutes: minutes(8 * 60)},
+			// warned, but used while the cleaner ran
+			{WorkbenchID: 5, TenantID: 1, UserID: 50, LastActivityAt: now.Add(-3 * time.Hour), IdleWarnedAt: &warnedAt},
+		},
+		busy: map[uint64]bool{5: true},
+	}
+	notifications := &recordingNotificationStore{}
+	mail := &mockMailer{}
+	svc := newIdleSvc(idleConfig(2*time.Hour, 0, 30*time.Minute, true), store, notifications, mail)
+
+	svc.cleanIdleWorkbenches(context.Background())
+
+	assert.Equal(t, []uint64{2}, store.warned)
+	assert.Equal(t, [][]uint64{{20}}, notifications.recipients)
+	assert.Equal(t, [][]string{{"owner@example.com"}}, mail.sentTo)
+	assert.Equal(t, []uint64{3}, store.deleted)
+}
+
+func TestCleanIdleWorkbenches_NoEmailByDefault(t *testing.T) {
+	store := &idleStore{
+		activities: []*model.WorkbenchActivity{
+			{WorkbenchID: 1, TenantID: 1, UserID: 10, LastActivityAt: time.Now().Add(-100 * time.Minute)},
+		},
+	}
+	notifications := &recordingNotificationStore{}
+	mail := &mockMailer{}
+	svc := newIdleSvc(idleConfig(2*time.Hour, 0, 30*time.Minute, false), store, notifications, mail)
+
+	svc.cleanIdleWorkbenches(context.Background())
+
+	assert.Equal(t, []uint64{1}, store.warned)
+	assert.Len(t, notifications.recipients, 1)
+	assert.Empty(t, mail.sentTo)
+}
+
+// A long analysis keeps its workbench from being reclaimed even if nobody watches it.
+func TestCleanIdleWorkbenches_KeepsBusyWorkbenches(t *testing.T) {
+	now := time.Now()
+	warnedAt := now.Add(-time.Hour)
+	lastActivity := now.Add(-3 * time.Hour)
+	store := &idleStore{
+		activities: []*model.WorkbenchActivity{
+			// an app instance computes
+			{WorkbenchID: 1, TenantID: 1, UserID: 10, WorkspaceID: 7, LastActivityAt: lastActivity, IdleWarnedAt: &warnedAt},
+			// the app instance computed, but before the last activity
+			{WorkbenchID: 2, TenantID: 1, UserID: 20, WorkspaceID: 7, LastActivityAt: lastActivity, IdleWarnedAt: &warnedAt},
+			// the app instance barely uses any CPU
+			{WorkbenchID: 3, TenantID: 1, UserID: 30, WorkspaceID: 7, LastActivityAt: lastActivity, IdleWarnedAt: &warnedAt},
+			// about to be reclaimed, while computing
+			{WorkbenchID: 4, TenantID: 1, UserID: 40, WorkspaceID: 7, LastActivityAt: now.Add(-100 * time.Minute)},
+		},
+	}
+	sample := func(at time.Time, cpu int64) *k8s.PodUsage {
+		return &k8s.PodUsage{Samples: []k8s.PodUsageSample{{Timestamp: at, CPUMillicores: cpu}}}
+	}
+	client := &usageK8sClient{apps: map[uint64]*k8s.PodUsage{
+		10: sample(now.Add(-time.Minute), 900),
+		20: sample(lastActivity.Add(-time.Minute), 900),
+		30: sample(now.Add(-time.Minute), 5),
+		40: sample(now.Add(-time.Minute), 900),
+	}}
+	cfg := idleConfig(2*time.Hour, 0, 30*time.Minute, false)
+	cfg.Services.WorkbenchService.WorkbenchIdleCPUMillicores = 100
+	svc := newIdleSvc(cfg, store, &recordingNotificationStore{}, &mockMailer{})
+	svc.client = client
+
+	svc.cleanIdleWorkbenches(context.Background())
+
+	assert.Equal(t, []uint64{1, 4}, store.kept)
+	assert.Empty(t, store.warned)
+	assert.Equal(t, []uint64{2, 3}, store.deleted)
+
+	// Without pod metrics, only the accesses count.
+	store.kept, store.deleted = nil, nil
+	client.err = k8s.ErrMetricsDisabled
+	svc.cleanIdleWorkbenches(context.Background())
+	assert.Empty(t, store.kept)
+	assert.Equal(t, []uint64{1, 2, 3}, store.deleted)
+}
+
+func TestKeepWorkbenchAlive(t *testing.T) {
+	store := &idleStore{}
+	svc := newIdleSvc(idleConfig(2*time.Hour, 0, 30*time.Minute, false), store, &mockNotificationStore{}, &mockMailer{})
+	svc.workspaceReader = &idleTimeoutWorkspaceReader{minutes: minutes(45)}
+
+	before := time.Now()
+	reclaimAt, err := svc.KeepWorkbenchAlive(context.Background(), 1, 3)
+	require.NoError(t, err)
+
+	assert.Equal(t, []uint64{3}, store.kept)
+	require.NotNil(t, reclaimAt)
+	assert.WithinDuration(t, before.Add(45*time.Minute), *reclaimAt, time.Minute)
+}
+
+func TestKeepWorkbenchAlive_NeverReclaimed(t *testing.T) {
+	svc := newIdleSvc(idleConfig(0, 0, 0, false), &idleStore{}, &mockNotificationStore{}, &mockMailer{})
+
+	reclaimAt, err := svc.KeepWorkbenchAlive(context.Background(), 1, 3)
+	require.NoError(t, err)
+	assert.Nil(t, reclaimAt)
+}
@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/audit"
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	audit_model "github.com/CHORUS-TRE/chorus-backend/pkg/audit/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
+	workspace_model "github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
+
+	"go.uber.org/zap"
+)
+
+func (s *WorkbenchService) GetWorkbenchSchedule(ctx context.Context, tenantID, workbenchID uint64) (*model.WorkbenchSchedule, error) {
+	schedule, err := s.store.GetWorkbenchSchedule(ctx, tenantID, workbenchID)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to get schedule of workbench %v", workbenchID))
+	}
+
+	return schedule, nil
+}
+
+// SetWorkbenchSchedule attaches a schedule to a workbench, or replaces its current one.
+// The workbench is stopped or started accordingly on the next run of the
+// workbench_schedule job.
+func (s *WorkbenchService) SetWorkbenchSchedule(ctx context.Context, schedule *model.WorkbenchSchedule) (*model.WorkbenchSchedule, error) {
+	if _, err := s.store.GetWorkbench(ctx, schedule.TenantID, schedule.WorkbenchID); err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to get workbench %v", schedule.WorkbenchID))
+	}
+
+	updated, err := s.store.UpsertWorkbenchSchedule(ctx, schedule.TenantID, schedule)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to set schedule of workbench %v", schedule.WorkbenchID))
+	}
+
+	return updated, nil
+}
+
+// DeleteWorkbenchSchedule removes the schedule of a workbench, starting it again if the
+// schedule had stopped it.
+func (s *WorkbenchService) DeleteWorkbenchSchedule(ctx context.Context, tenantID, workbenchID uint64) error {
+	schedule, err := s.store.GetWorkbenchSchedule(ctx, tenantID, workbenchID)
+	if err != nil {
+		return cerr.WrapStoreError(err, fmt.Sprintf("Unable to get schedule of workbench %v", workbenchID))
+	}
+
+	if err := s.store.DeleteWorkbenchSchedule(ctx, tenantID, workbenchID); err != nil {
+		return cerr.WrapStoreError(err, fmt.Sprintf("Unable to delete schedule of workbench %v", workbenchID))
+	}
+
+	if schedule.StoppedAt == nil {
+		return nil
+	}
+
+	workbench, err := s.store.GetWorkbench(ctx, tenantID, workbenchID)
+	if err != nil {
+		return cerr.WrapStoreError(err, fmt.Sprintf("Unable to get workbench %v", workbenchID))
+	}
+
+	_, err = s.startWorkbench(ctx, workbench)
+	return err
+}
+
+// StopScheduledWorkbench stops the server and the app instances of a workbench whose
+// schedule window is closed. The app instances are kept so that StartScheduledWorkbench
+// brings them back.
+func (s *WorkbenchService) StopScheduledWorkbench(ctx context.Context, tenantID, workbenchID uint64) error {
+	workbench, err := s.store.GetWorkbench(ctx, tenantID, workbenchID)
+	if err != nil {
+		return cerr.WrapStoreError(err, fmt.Sprintf("Unable to get workbench %v", workbenchID))
+	}
+
+	apps, err := s.store.ListWorkbenchAppInstances(ctx, workbenchID)
+	if err != nil {
+		return cerr.WrapStoreError(err, fmt.Sprintf("Unable to list app instances of workbench %v", workbenchID))
+	}
+
+	// The workbench is marked inactive before its resource is deleted so that the k8s
+	// watcher ignores the updates sent while the resource terminates.
+	workbench.Status = model.WorkbenchInactive
+	workbench.ServerPodStatus = model.WorkbenchServerPodStatusTerminated
+	workbench.ServerPodMessage = ""
+	if _, err := s.store.UpdateWorkbenchStatus(ctx, tenantID, workbench); err != nil {
+		return cerr.WrapStoreError(err, fmt.Sprintf("Unable to update status of workbench %v", workbenchID))
+	}
+
+	err = s.client.DeleteWorkbench(workspace_model.GetWorkspaceClusterName(workbench.WorkspaceID), model.GetWorkbenchClusterName(workbenchID))
+	if err != nil {
+		return cerr.ErrInternal.Wrap(err, fmt.Sprintf("Unable to stop workbench %v in K8s", workbenchID))
+	}
+
+	stoppedApps := make([]*model.AppInstance, 0, len(apps))
+	for _, app := range apps {
+		stoppedApps = append(stoppedApps, &model.AppInstance{
+			ID:         app.ID,
+			Status:     model.AppInstanceInactive,
+			K8sStatus:  model.K8sAppInstanceStatusStopped,
+			K8sMessage: "Stopped outside of the workbench schedule",
+			K8sState:   app.K8sState,
+		})
+	}
+	if err := s.store.UpdateAppInstances(ctx, tenantID, stoppedApps); err != nil {
+		logger.TechLog.Error(ctx, "unable to update app instances of stopped workbench", zap.Error(err), zap.Uint64("workbenchID", workbenchID))
+	}
+
+	s.publishWorkbenchStatus(ctx, workbench)
+
+	stoppedAt := time.Now()
+	if err := s.store.UpdateWorkbenchScheduleStoppedAt(ctx, tenantID, workbenchID, &stoppedAt); err != nil {
+		return cerr.WrapStoreError(err, fmt.Sprintf("Unable to update schedule of workbench %v", workbenchID))
+	}
+
+	audit.Record(ctx, s.auditWriter, audit_model.AuditActionWorkbenchStop,
+		audit.WithTenantID(tenantID),
+		audit.WithActorUsername("system"),
+		audit.WithWorkspaceID(workbench.WorkspaceID),
+		audit.WithWorkbenchID(workbenchID),
+		audit.WithDescription(fmt.Sprintf("Session %q (ID %d) stopped outside of its schedule with %d app instance(s).", workbench.Name, workbenchID, len(apps))),
+		audit.WithDetail("trigger", "schedule"),
+		audit.WithDetail("app_instance_count", len(apps)),
+	)
+
+	return nil
+}
+
+// StartScheduledWorkbench brings back a workbench stopped by its schedule, with the app
+// instances it had when it was stopped. Workbenches of read-only workspaces stay stopped
+// until their workspace is writable again.
+func (s *WorkbenchService) StartScheduledWorkbench(ctx context.Context, tenantID, workbenchID uint64) error {
+	workbench, err := s.store.GetWorkbench(ctx, tenantID, workbenchID)
+	if err != nil {
+		return cerr.WrapStoreError(err, fmt.Sprintf("Unable to get workbench %v", workbenchID))
+	}
+
+	started, err := s.startWorkbench(ctx, workbench)
+	if err != nil || !started {
+		return err
+	}
+
+	if err := s.store.UpdateWorkbenchScheduleStoppedAt(ctx, tenantID, workbenchID, nil); err != nil {
+		return cerr.WrapStoreError(err, fmt.Sprintf("Unable to update schedule of workbench %v", workbenchID))
+	}
+
+	audit.Record(ctx, s.auditWriter, audit_model.AuditActionWorkbenchStart,
+		audit.WithTenantID(tenantID),
+		audit.WithActorUsername("system"),
+		audit.WithWorkspaceID(workbench.WorkspaceID),
+		audit.WithWorkbenchID(workbenchID),
+		audit.WithDescription(fmt.Sprintf("Session %q (ID %d) started as its schedule window opened.", workbench.Name, workbenchID)),
+		audit.WithDetail("trigger", "schedule"),
+	)
+
+	return nil
+}
+
+// startWorkbench marks a stopped workbench active again and recreates it in K8s with its
+// app instances. It returns false without starting the workbench when its workspace is
+// read-only, as archived and frozen workspaces run no workbench.
+func (s *WorkbenchService) startWorkbench(ctx context.Context, workbench *model.Workbench) (bool, error) {
+	ws, err := s.workspaceReader.GetWorkspace(ctx, workbench.TenantID, workbench.WorkspaceID)
+	if err != nil {
+		return false, cerr.WrapStoreError(err, fmt.Sprintf("Unable to get workspace %v", workbench.WorkspaceID))
+	}
+	if ws.Status.IsReadOnly() {
+		logger.TechLog.Info(ctx, "not starting workbench of read-only workspace",
+			zap.Uint64("workbenchID", workbench.ID),
+			zap.Uint64("workspaceID", workbench.WorkspaceID),
+			zap.String("workspaceStatus", ws.Status.String()),
+		)
+		return false, nil
+	}
+
+	workbench.Status = model.WorkbenchActive
+	workbench.ServerPodStatus = model.WorkbenchServerPodStatusWaiting
+	if _, err := s.store.UpdateWorkbenchStatus(ctx, workbench.TenantID, workbench); err != nil {
+		return false, cerr.WrapStoreError(err, fmt.Sprintf("Unable to update status of workbench %v", workbench.ID))
+	}
+
+	if err := s.syncWorkbench(ctx, workbench); err != nil {
+		return false, cerr.ErrInternal.Wrap(err, fmt.Sprintf("Unable to start workbench %v in K8s", workbench.ID))
+	}
+
+	s.publishWorkbenchStatus(ctx, workbench)
+
+	return true, nil
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/rand/v2"
 	"net"
@@ -16,9 +17,11 @@ import (
 
 	"github.com/CHORUS-TRE/chorus-backend/internal/audit"
 	"github.com/CHORUS-TRE/chorus-backend/internal/client/k8s"
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/ociregistry"
 	"github.com/CHORUS-TRE/chorus-backend/internal/config"
 	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
 	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/internal/mailer"
 	"github.com/CHORUS-TRE/chorus-backend/internal/protocol/rest/middleware"
 	app_service "github.com/CHORUS-TRE/chorus-backend/pkg/app/service"
 	audit_model "github.com/CHORUS-TRE/chorus-backend/pkg/audit/model"
@@ -28,6 +31,7 @@ import (
 	authz "github.com/CHORUS-TRE/chorus-backend/pkg/authorization/model"
 	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
 	notification_model "github.com/CHORUS-TRE/chorus-backend/pkg/notification/model"
+	platform_settings_model "github.com/CHORUS-TRE/chorus-backend/pkg/platform-settings/model"
 	user_model "github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
 	user_service "github.com/CHORUS-TRE/chorus-backend/pkg/user/service"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
@@ -57,11 +61,15 @@ type WorkspaceReader interface {
 	GetWorkspace(ctx context.Context, tenantID, workspaceID uint64) (*workspace_model.Workspace, error)
 }
 
+type PlatformSettingsReader interface {
+	GetPlatformSettings(ctx context.Context, tenantID uint64) (*platform_settings_model.PlatformSettings, error)
+}
+
 type Workbencher interface {
 	GetWorkbench(ctx context.Context, tenantID, workbenchID uint64) (*model.Workbench, error)
 	ListWorkbenches(ctx context.Context, tenantID uint64, pagination *common_model.Pagination, filter model.WorkbenchFilter) ([]*model.Workbench, *common_model.PaginationResult, error)
 	CreateWorkbench(ctx context.Context, workbench *model.Workbench) (*model.Workbench, error)
-	ProxyWorkbench(ctx context.Context, tenantID, workbenchID uint64, w http.ResponseWriter, r *http.Request) error
+	ProxyWorkbench(ctx context.Context, tenantID, workbenchID uint64, readOnly bool, w http.ResponseWriter, r *http.Request) error
 	UpdateWorkbench(ctx context.Context, workbench *model.Workbench) (*model.Workbench, error)
 	DeleteWorkbench(ctx context.Context, tenantId, workbenchId uint64) (*model.Workbench, error)
 	DeleteWorkbenchesInWorkspace(ctx context.Context, tenantID uint64, workspaceID uint64) error
@@ -73,7 +81,38 @@ type Workbencher interface {
 	ListAppInstances(ctx context.Context, tenantID uint64, pagination *common_model.Pagination, filter model.AppInstanceFilter) ([]*model.AppInstance, *common_model.PaginationResult, error)
 	CreateAppInstance(ctx context.Context, appInstance *model.AppInstance) (*model.AppInstance, error)
 	UpdateAppInstance(ctx context.Context, appInstance *model.AppInstance) (*model.AppInstance, error)
+	UpgradeAppInstance(ctx context.Context, tenantID, appInstanceID, appVersionID uint64) (*model.AppInstance, error)
 	DeleteAppInstance(ctx context.Context, tenantId, appInstanceId uint64) (*model.AppInstance, error)
+
+	GetWorkbenchSchedule(ctx context.Context, tenantID, workbenchID uint64) (*model.WorkbenchSchedule, error)
+	SetWorkbenchSchedule(ctx context.Context, schedule *model.WorkbenchSchedule) (*model.WorkbenchSchedule, error)
+	DeleteWorkbenchSchedule(ctx context.Context, tenantID, workbenchID uint64) error
+	StopScheduledWorkbench(ctx context.Context, tenantID, workbenchID uint64) error
+	StartScheduledWorkbench(ctx context.Context, tenantID, workbenchID uint64) error
+	KeepWorkbenchAlive(ctx context.Context, tenantID, workbenchID uint64) (*time.Time, error)
+	HibernateWorkbench(ctx context.Context, tenantID, workbenchID uint64) (*model.Workbench, error)
+	ResumeWorkbench(ctx context.Context, tenantID, workbenchID uint64) (*model.Workbench, error)
+
+	CreateWorkbenchShareGrant(ctx context.Context, grant *model.WorkbenchShareGrant) (*model.WorkbenchShareGrant, error)
+	ListWorkbenchShareGrantees(ctx context.Context, grant *model.WorkbenchShareGrant) ([]uint64, error)
+	ListWorkbenchShareGrants(ctx context.Context, tenantID, workbenchID uint64) ([]*model.WorkbenchShareGrant, error)
+	RevokeWorkbenchShareGrant(ctx context.Context, tenantID, workbenchID, grantID uint64) (*model.WorkbenchShareGrant, error)
+	ExpireWorkbenchShareGrants(ctx context.Context) (int, error)
+	GetWorkbenchShareRoles(ctx context.Context, tenantID, workbenchID, userID uint64) ([]user_model.UserRole, bool, error)
+
+	WatchWorkbench(ctx context.Context, tenantID, workbenchID uint64) (<-chan *model.WorkbenchStatusEvent, error)
+	WatchWorkspace(ctx context.Context, tenantID, workspaceID uint64) (<-chan *model.WorkbenchStatusEvent, error)
+
+	GetAppInstanceLogs(ctx context.Context, tenantID, appInstanceID uint64, req model.PodLogRequest) (*model.PodLogs, error)
+	ListAppInstanceEvents(ctx context.Context, tenantID, appInstanceID uint64) ([]*model.PodEvent, error)
+	GetWorkbenchServerLogs(ctx context.Context, tenantID, workbenchID uint64, req model.PodLogRequest) (*model.PodLogs, error)
+	ListWorkbenchServerEvents(ctx context.Context, tenantID, workbenchID uint64) ([]*model.PodEvent, error)
+
+	GetAppInstanceUsage(ctx context.Context, tenantID, appInstanceID uint64) (*model.AppInstanceUsage, error)
+	GetWorkbenchUsage(ctx context.Context, tenantID, workbenchID uint64) (*model.WorkbenchUsage, error)
+	GetWorkspaceUsage(ctx context.Context, tenantID, workspaceID uint64) (*model.WorkspaceUsage, error)
+
+	ListWorkbenchConnections(ctx context.Context, tenantID uint64, pagination *common_model.Pagination, filter model.WorkbenchConnectionFilter) ([]*model.WorkbenchConnection, *common_model.PaginationResult, error)
 }
 
 type WorkbenchStore interface {
@@ -82,17 +121,43 @@ type WorkbenchStore interface {
 	ListWorkbenchAppInstances(ctx context.Context, workbenchID uint64) ([]*model.AppInstance, error)
 	ListAllWorkbenches(ctx context.Context) ([]*model.Workbench, error)
 	SaveBatchProxyHit(ctx context.Context, proxyHitCountMap map[uint64]uint64, proxyHitDateMap map[uint64]time.Time) error
-	CreateWorkbench(ctx context.Context, tenantID uint64, workbench *model.Workbench) (*model.Workbench, error)
+	CreateWorkbench(ctx context.Context, tenantID uint64, workbench *model.Workbench, maxPerUser uint32) (*model.Workbench, error)
 	UpdateWorkbench(ctx context.Context, tenantID uint64, workbench *model.Workbench) (*model.Workbench, error)
 	UpdateWorkbenchStatus(ctx context.Context, tenantID uint64, workbench *model.Workbench) (*model.Workbench, error)
 	DeleteWorkbench(ctx context.Context, tenantID uint64, workbenchID uint64) error
 	DeleteWorkbenchesInWorkspace(ctx context.Context, tenantID uint64, workspaceID uint64) error
-	DeleteIdleWorkbenches(ctx context.Context, idleTimeout time.Duration) ([]*model.Workbench, error)
+	ListWorkbenchActivities(ctx context.Context) ([]*model.WorkbenchActivity, error)
+	MarkWorkbenchIdleWarned(ctx context.Context, tenantID, workbenchID uint64) error
+	KeepWorkbenchAlive(ctx context.Context, tenantID, workbenchID uint64) error
+	DeleteIdleWorkbench(ctx context.Context, tenantID, workbenchID uint64, lastActivityAt time.Time) (*model.Workbench, error)
+
+	GetWorkbenchSchedule(ctx context.Context, tenantID, workbenchID uint64) (*model.WorkbenchSchedule, error)
+	ListWorkbenchSchedules(ctx context.Context) ([]*model.WorkbenchSchedule, error)
+	UpsertWorkbenchSchedule(ctx context.Context, tenantID uint64, schedule *model.WorkbenchSchedule) (*model.WorkbenchSchedule, error)
+	UpdateWorkbenchScheduleStoppedAt(ctx context.Context, tenantID, workbenchID uint64, stoppedAt *time.Time) error
+	DeleteWorkbenchSchedule(ctx context.Context, tenantID, workbenchID uint64) error
+
+	GetWorkbenchHibernation(ctx context.Context, tenantID, workbenchID uint64) (*model.WorkbenchHibernation, error)
+	CreateWorkbenchHibernation(ctx context.Context, tenantID uint64, hibernation *model.WorkbenchHibernation) (*model.WorkbenchHibernation, error)
+	DeleteWorkbenchHibernation(ctx context.Context, tenantID, workbenchID uint64) error
+
+	GetWorkbenchShareGrant(ctx context.Context, tenantID, grantID uint64) (*model.WorkbenchShareGrant, error)
+	ListWorkbenchShareGrants(ctx context.Context, tenantID, workbenchID uint64) ([]*model.WorkbenchShareGrant, error)
+	ListUserWorkbenchShareGrants(ctx context.Context, tenantID, workbenchID, userID uint64) ([]*model.WorkbenchShareGrant, error)
+	ListWorkbenchShareGrantsToEnd(ctx context.Context) ([]*model.WorkbenchShareGrant, error)
+	CreateWorkbenchShareGrant(ctx context.Context, tenantID uint64, grant *model.WorkbenchShareGrant) (*model.WorkbenchShareGrant, error)
+	EndWorkbenchShareGrant(ctx context.Context, tenantID, grantID uint64, status model.WorkbenchShareGrantStatus) error
+	RemoveWorkbenchShareGrantMember(ctx context.Context, grantID, userID uint64) error
+
+	CreateWorkbenchConnection(ctx context.Context, tenantID uint64, connection *model.WorkbenchConnection) (*model.WorkbenchConnection, error)
+	UpdateWorkbenchConnections(ctx context.Context, connections []*model.WorkbenchConnection) error
+	ListWorkbenchConnections(ctx context.Context, tenantID uint64, pagination *common_model.Pagination, filter model.WorkbenchConnectionFilter) ([]*model.WorkbenchConnection, *common_model.PaginationResult, error)
 
 	GetAppInstance(ctx context.Context, tenantID uint64, appInstanceID uint64) (*model.AppInstance, error)
 	ListAppInstances(ctx context.Context, tenantID uint64, pagination *common_model.Pagination, workbenchIDsIn *[]uint64) ([]*model.AppInstance, *common_model.PaginationResult, error)
-	CreateAppInstance(ctx context.Context, tenantID uint64, appInstance *model.AppInstance) (*model.AppInstance, error)
+	CreateAppInstance(ctx context.Context, tenantID uint64, appInstance *model.AppInstance, maxPerUser uint32) (*model.AppInstance, error)
 	UpdateAppInstance(ctx context.Context, tenantID uint64, appInstance *model.AppInstance) (*model.AppInstance, error)
+	UpdateAppInstanceVersion(ctx context.Context, tenantID uint64, appInstanceID uint64, appVersionID uint64, imageDigest string) (*model.AppInstance, error)
 	UpdateAppInstances(ctx context.Context, tenantID uint64, appInstances []*model.AppInstance) error
 	DeleteAppInstance(ctx context.Context, tenantID uint64, appInstanceID uint64) error
 	DeleteAppInstances(ctx context.Context, tenantID uint64, appInstanceIDs []uint64) error
@@ -110,15 +175,18 @@ type proxy struct {
 }
 
 type WorkbenchService struct {
-	cfg    config.Config
-	store  WorkbenchStore
-	client k8s.K8sClienter
+	cfg       config.Config
+	store     WorkbenchStore
+	client    k8s.K8sClienter
+	ociClient ociregistry.OCIClienter
 
 	apper             app_service.Apper
 	userer            user_service.Userer
 	authenticator     authentication_service.Authenticator
 	notificationStore NotificationStore
+	mailer            mailer.Mailer
 	workspaceReader   WorkspaceReader
+	platformSettings  PlatformSettingsReader
 	auditWriter       audit_service.AuditWriter
 
 	proxyRWMutex     sync.RWMutex
@@ -126,24 +194,35 @@ type WorkbenchService struct {
 	proxyHitMutex    sync.Mutex
 	proxyHitCountMap map[uint64]uint64
 	proxyHitDateMap  map[uint64]time.Time
+
+	connectionsMutex sync.Mutex
+	connections      map[*workbenchConnection]struct{}
+
+	watchHub *workbenchWatchHub
 }
 
-func NewWorkbenchService(cfg config.Config, store WorkbenchStore, client k8s.K8sClienter, apper app_service.Apper, userer user_service.Userer, authenticator authentication_service.Authenticator, notificationStore NotificationStore, workspaceReader WorkspaceReader, auditWriter audit_service.AuditWriter) *WorkbenchService {
+func NewWorkbenchService(cfg config.Config, store WorkbenchStore, client k8s.K8sClienter, ociClient ociregistry.OCIClienter, apper app_service.Apper, userer user_service.Userer, authenticator authentication_service.Authenticator, notificationStore NotificationStore, mailer mailer.Mailer, workspaceReader WorkspaceReader, platformSettings PlatformSettingsReader, auditWriter audit_service.AuditWriter) *WorkbenchService {
 	s := &WorkbenchService{
-		cfg:    cfg,
-		store:  store,
-		client: client,
+		cfg:       cfg,
+		store:     store,
+		client:    client,
+		ociClient: ociClient,
 
 		apper:             apper,
 		userer:            userer,
 		authenticator:     authenticator,
 		notificationStore: notificationStore,
+		mailer:            mailer,
 		workspaceReader:   workspaceReader,
+		platformSettings:  platformSettings,
 		auditWriter:       auditWriter,
 
 		proxyCache:       make(map[proxyID]*proxy),
 		proxyHitCountMap: make(map[uint64]uint64),
 		proxyHitDateMap:  make(map[uint64]time.Time),
+		connections:      make(map[*workbenchConnection]struct{}),
+
+		watchHub: newWorkbenchWatchHub(),
 	}
 
 	go func() {
@@ -153,26 +232,26 @@ func NewWorkbenchService(cfg config.Config, store WorkbenchStore, client k8s.K8s
 	go func() {
 		for {
 			s.saveBatchProxyHit(context.Background())
+			s.saveWorkbenchConnections(context.Background())
 			randomDelayToAvoidCollision := time.Duration(rand.Int64N(int64(10 * time.Second)))
 			time.Sleep(cfg.Services.WorkbenchService.ProxyHitSaveBatchInterval + randomDelayToAvoidCollision)
 		}
 	}()
 
-	if s.cfg.Services.WorkbenchService.WorkbenchIdleTimeout > 0 {
-		logger.TechLog.Info(context.Background(), "starting workbench idle cleaner", zap.Duration("idleTimeout", s.cfg.Services.WorkbenchService.WorkbenchIdleTimeout), zap.Duration("checkInterval", s.cfg.Services.WorkbenchService.WorkbenchIdleCheckInterval))
+	// The cleaner also runs without a default idle timeout, for the workspaces that set their own.
+	logger.TechLog.Info(context.Background(), "starting workbench idle cleaner", zap.Duration("idleTimeout", s.cfg.Services.WorkbenchService.WorkbenchIdleTimeout), zap.Duration("maxIdleTimeout", s.cfg.Services.WorkbenchService.MaxWorkbenchIdleTimeout), zap.Duration("checkInterval", s.cfg.Services.WorkbenchService.WorkbenchIdleCheckInterval))
 
-		go func() {
-			interval := cfg.Services.WorkbenchService.WorkbenchIdleCheckInterval
-			// sleep a random jitter in initial interval to avoid all instances doing this at the same time
-			jitter := time.Duration(rand.Int64N(int64(interval)))
-			time.Sleep(jitter)
+	go func() {
+		interval := cfg.Services.WorkbenchService.WorkbenchIdleCheckInterval
+		// sleep a random jitter in initial interval to avoid all instances doing this at the same time
+		jitter := time.Duration(rand.Int64N(int64(interval)))
+		time.Sleep(jitter)
 
-			for {
-				s.cleanIdleWorkbenches(context.Background())
-				time.Sleep(interval)
-			}
-		}()
-	}
+		for {
+			s.cleanIdleWorkbenches(context.Background())
+			time.Sleep(interval)
+		}
+	}()
 
 	s.SetClientWatchers()
 
@@ -210,6 +289,12 @@ func (s *WorkbenchService) SetClientWatchers() {
 			return cerr.WrapStoreError(err, fmt.Sprintf("Unable to get workbench %d", workbenchID))
 		}
 
+		// Skip updates of a workbench stopped by its schedule or hibernated, sent while its resource terminates
+		if workbench.Status == model.WorkbenchInactive || workbench.Status == model.WorkbenchHibernated {
+			logger.TechLog.Debug(ctx, "skipping updates - workbench is stopped", zap.String("namespace", k8sWorkbench.Namespace), zap.String("workbenchName", k8sWorkbench.Name))
+			return nil
+		}
+
 		// Overwrite k8s-derived statuses
 		workbench.ServerPodStatus = model.WorkbenchServerPodStatus(k8sWorkbench.ServerPodStatus)
 		workbench.ServerPodMessage = model.WorbenchServerPodMessage(k8sWorkbench.ServerPodMessage)
@@ -321,10 +406,13 @@ func (s *WorkbenchService) SetClientWatchers() {
 				audit.WithDetail("app_image_registry", ai.AppRegistry),
 				audit.WithDetail("app_image_name", ai.AppImage),
 				audit.WithDetail("app_image_tag", ai.AppTag),
+				audit.WithDetail("app_image_digest", ai.AppDigest),
 				audit.WithDetail("trigger", "k8s_watcher"),
 			)
 		}
 
+		s.publishWorkbenchStatus(ctx, workbench)
+
 		return nil
 	}
 
@@ -350,40 +438,6 @@ func (s *WorkbenchService) updateAllWorkbenches(ctx context.Context) {
 	}
 }
 
-func (s *WorkbenchService) cleanIdleWorkbenches(ctx context.Context) {
-	workbenches, err := s.store.DeleteIdleWorkbenches(ctx, s.cfg.Services.WorkbenchService.WorkbenchIdleTimeout)
-	if err != nil {
-		logger.TechLog.Error(ctx, "unable to query idle workbenches", zap.Error(err))
-		return
-	}
-
-	// Record audit logs for deleted workbenches
-	for _, workbench := range workbenches {
-		audit.Record(ctx, s.auditWriter, audit_model.AuditActionWorkbenchDelete,
-			audit.WithTenantID(workbench.TenantID),
-			audit.WithActorUsername("system"),
-			audit.WithWorkspaceID(workbench.WorkspaceID),
-			audit.WithWorkbenchID(workbench.ID),
-			audit.WithDescription(fmt.Sprintf("Workbench with ID %d auto-deleted due to idle timeout.", workbench.ID)),
-			audit.WithDetail("trigger", "idle_cleanup"),
-		)
-	}
-
-	for _, workbench := range workbenches {
-		go func(workbench *model.Workbench) {
-			logger.TechLog.Debug(ctx, "cleaning idle workbench", zap.Uint64("workbenchID", workbench.ID), zap.String("status", string(workbench.Status)), zap.Any("workbench", workbench))
-			// err := s.syncWorkbench(ctx, workbench)
-			// if err != nil {
-			// 	logger.TechLog.Error(ctx, "unable to clean idle workbench", zap.Error(err), zap.Uint64("workbenchID", workbench.ID))
-			// }
-			err = s.client.DeleteWorkbench(workspace_model.GetWorkspaceClusterName(workbench.WorkspaceID), model.GetWorkbenchClusterName(workbench.ID))
-			if err != nil {
-				logger.TechLog.Error(ctx, "unable to delete idle workbench", zap.Error(err), zap.Uint64("workbenchID", workbench.ID))
-			}
-		}(workbench)
-	}
-}
-
 func (s *WorkbenchService) syncWorkbenchWithID(ctx context.Context, tenantID, workbenchID uint64) error {
 	workbench, err := s.GetWorkbench(ctx, tenantID, workbenchID)
 	if err != nil {
@@ -406,49 +460,7 @@ func (s *WorkbenchService) syncWorkbench(ctx context.Context, workbench *model.W
 			return err
 		}
 
-		clientApps := []k8s.AppInstance{}
-		for _, app := range apps {
-			clientApps = append(clientApps, app.ToK8sAppInstance())
-		}
-
-		user, err := s.userer.GetUser(ctx, user_service.GetUserReq{TenantID: workbench.TenantID, ID: workbench.UserID})
-		if err != nil {
-			logger.TechLog.Error(ctx, "unable to get user", zap.Error(err), zap.Uint64("userID", workbench.UserID))
-			return err
-		}
-
-		username := ""
-		if user.Source == auth_helper.GetMainSourceID(s.cfg) {
-			username = user.Username
-		}
-
-		namespace, workbenchName := workspace_model.GetWorkspaceClusterName(workbench.WorkspaceID), model.GetWorkbenchClusterName(workbench.ID)
-
-		clipboard := ""
-		ws, wsErr := s.workspaceReader.GetWorkspace(ctx, workbench.TenantID, workbench.WorkspaceID)
-		if wsErr != nil {
-			logger.TechLog.Warn(ctx, "unable to get workspace for clipboard", zap.Error(wsErr), zap.Uint64("workspaceID", workbench.WorkspaceID))
-		} else {
-			clipboard = string(ws.Clipboard)
-		}
-
-		err = s.client.UpdateWorkbench(k8s.Workbench{
-			TenantID:                workbench.TenantID,
-			Namespace:               namespace,
-			Username:                username,
-			UserID:                  user.ID,
-			Name:                    workbenchName,
-			Apps:                    clientApps,
-			Clipboard:               clipboard,
-			InitialResolutionWidth:  workbench.InitialResolutionWidth,
-			InitialResolutionHeight: workbench.InitialResolutionHeight,
-		})
-		if err != nil {
-			logger.TechLog.Error(ctx, "unable to update workbench", zap.Error(err), zap.Uint64("workbenchID", workbench.ID))
-			return err
-		}
-
-		return nil
+		return s.updateWorkbenchInK8s(ctx, workbench, apps)
 	case model.WorkbenchDeleted:
 		err := s.client.DeleteWorkbench(workspace_model.GetWorkspaceClusterName(workbench.WorkspaceID), model.GetWorkbenchClusterName(workbench.ID))
 		if err != nil {
@@ -464,6 +476,54 @@ func (s *WorkbenchService) syncWorkbench(ctx context.Context, workbench *model.W
 	return nil
 }
 
+// updateWorkbenchInK8s creates or updates the K8s resource of a workbench with the given
+// app instances.
+func (s *WorkbenchService) updateWorkbenchInK8s(ctx context.Context, workbench *model.Workbench, apps []*model.AppInstance) error {
+	clientApps := []k8s.AppInstance{}
+	for _, app := range apps {
+		clientApps = append(clientApps, app.ToK8sAppInstance())
+	}
+
+	user, err := s.userer.GetUser(ctx, user_service.GetUserReq{TenantID: workbench.TenantID, ID: workbench.UserID})
+	if err != nil {
+		logger.TechLog.Error(ctx, "unable to get user", zap.Error(err), zap.Uint64("userID", workbench.UserID))
+		return err
+	}
+
+	username := ""
+	if user.Source == auth_helper.GetMainSourceID(s.cfg) {
+		username = user.Username
+	}
+
+	namespace, workbenchName := workspace_model.GetWorkspaceClusterName(workbench.WorkspaceID), model.GetWorkbenchClusterName(workbench.ID)
+
+	clipboard := ""
+	ws, wsErr := s.workspaceReader.GetWorkspace(ctx, workbench.TenantID, workbench.WorkspaceID)
+	if wsErr != nil {
+		logger.TechLog.Warn(ctx, "unable to get workspace for clipboard", zap.Error(wsErr), zap.Uint64("workspaceID", workbench.WorkspaceID))
+	} else {
+		clipboard = string(ws.Clipboard)
+	}
+
+	err = s.client.UpdateWorkbench(k8s.Workbench{
+		TenantID:                workbench.TenantID,
+		Namespace:               namespace,
+		Username:                username,
+		UserID:                  user.ID,
+		Name:                    workbenchName,
+		Apps:                    clientApps,
+		Clipboard:               clipboard,
+		InitialResolutionWidth:  workbench.InitialResolutionWidth,
+		InitialResolutionHeight: workbench.InitialResolutionHeight,
+	})
+	if err != nil {
+		logger.TechLog.Error(ctx, "unable to update workbench", zap.Error(err), zap.Uint64("workbenchID", workbench.ID))
+		return err
+	}
+
+	return nil
+}
+
 func (s *WorkbenchService) ListWorkbenches(ctx context.Context, tenantID uint64, pagination *common_model.Pagination, filter model.WorkbenchFilter) ([]*model.Workbench, *common_model.PaginationResult, error) {
 	workbenches, paginationRes, err := s.store.ListWorkbenches(ctx, tenantID, pagination, filter.WorkspaceIDsIn)
 	if err != nil {
@@ -502,6 +562,10 @@ func (s *WorkbenchService) DeleteWorkbench(ctx context.Context, tenantID, workbe
 		return nil, cerr.ErrInternal.Wrap(err, fmt.Sprintf("Unable to delete workbench %v in K8s", workbenchID))
 	}
 
+	deleted := *workbench
+	deleted.Status = model.WorkbenchDeleted
+	s.publishWorkbenchStatus(ctx, &deleted)
+
 	return workbench, nil
 }
 
@@ -524,8 +588,24 @@ func (s *WorkbenchService) UpdateWorkbench(ctx context.Context, workbench *model
 }
 
 func (s *WorkbenchService) CreateWorkbench(ctx context.Context, workbench *model.Workbench) (*model.Workbench, error) {
-	newWorkbench, err := s.store.CreateWorkbench(ctx, workbench.TenantID, workbench)
+	ws, err := s.workspaceReader.GetWorkspace(ctx, workbench.TenantID, workbench.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if ws.Status.IsReadOnly() {
+		return nil, cerr.ErrReadOnly.WithMessage(fmt.Sprintf("Unable to create a workbench in workspace %v as it is %v", workbench.WorkspaceID, ws.Status))
+	}
+
+	settings, err := s.platformSettings.GetPlatformSettings(ctx, workbench.TenantID)
+	if err != nil {
+		return nil, cerr.ErrInternal.Wrap(err, "Unable to get platform settings")
+	}
+
+	newWorkbench, err := s.store.CreateWorkbench(ctx, workbench.TenantID, workbench, settings.MaxSessionsPerUser)
 	if err != nil {
+		if errors.Is(err, cerr.ErrQuotaReached) {
+			return nil, cerr.ErrQuotaExceeded.Wrap(err, fmt.Sprintf("Maximum number of sessions per user reached (maxSessionsPerUser: %v)", settings.MaxSessionsPerUser))
+		}
 		return nil, cerr.WrapStoreError(err, "Unable to create workbench")
 	}
 
@@ -547,21 +627,13 @@ func (s *WorkbenchService) CreateWorkbench(ctx context.Context, workbench *model
 
 	namespace, workbenchName := workspace_model.GetWorkspaceClusterName(workbench.WorkspaceID), model.GetWorkbenchClusterName(newWorkbench.ID)
 
-	clipboard := ""
-	ws, wsErr := s.workspaceReader.GetWorkspace(ctx, workbench.TenantID, workbench.WorkspaceID)
-	if wsErr != nil {
-		logger.TechLog.Warn(ctx, "unable to get workspace for clipboard", zap.Error(wsErr), zap.Uint64("workspaceID", workbench.WorkspaceID))
-	} else {
-		clipboard = string(ws.Clipboard)
-	}
-
 	err = s.client.CreateWorkbench(k8s.Workbench{
 		TenantID:                workbench.TenantID,
 		Namespace:               namespace,
 		Username:                username,
 		UserID:                  user.ID,
 		Name:                    workbenchName,
-		Clipboard:               clipboard,
+		Clipboard:               string(ws.Clipboard),
 		InitialResolutionWidth:  workbench.InitialResolutionWidth,
 		InitialResolutionHeight: workbench.InitialResolutionHeight,
 	})
@@ -863,12 +935,19 @@ func (s *WorkbenchService) getProxy(proxyID proxyID) (*proxy, error) {
 	return proxy, nil
 }
 
-func (s *WorkbenchService) ProxyWorkbench(ctx context.Context, tenantID, workbenchID uint64, w http.ResponseWriter, r *http.Request) error {
+// ProxyWorkbench forwards a request of the stream of a workbench. A read-only stream lets
+// the user watch the workbench without acting on it: the input sent through its websocket
+// is dropped.
+func (s *WorkbenchService) ProxyWorkbench(ctx context.Context, tenantID, workbenchID uint64, readOnly bool, w http.ResponseWriter, r *http.Request) error {
 	workbench, err := s.store.GetWorkbench(ctx, tenantID, workbenchID)
 	if err != nil {
 		return cerr.WrapStoreError(err, fmt.Sprintf("Unable to get workbench %v", workbenchID))
 	}
 
+	if readOnly && r.Method != http.MethodGet && r.Method != http.MethodHead {
+		return cerr.ErrPermissionDenied.WithMessage(fmt.Sprintf("Stream of workbench %v is read-only", workbenchID))
+	}
+
 	namespace, workbenchName := workspace_model.GetWorkspaceClusterName(workbench.WorkspaceID), model.GetWorkbenchClusterName(workbenchID)
 
 	proxyID := proxyID{
@@ -897,6 +976,23 @@ func (s *WorkbenchService) ProxyWorkbench(ctx context.Context, tenantID, workben
 		)
 	}
 
+	// The websocket carrying the stream is open as long as the user is connected, so it
+	// delimits a connection.
+	if isWebSocketUpgrade(r) {
+		connection, cw, err := s.startWorkbenchConnection(ctx, tenantID, workbench, w, r)
+		if err != nil {
+			return err
+		}
+		defer s.endWorkbenchConnection(ctx, workbench, connection)
+		w = cw
+
+		if readOnly {
+			// Compressed messages could not be filtered.
+			r.Header.Del("Sec-WebSocket-Extensions")
+			w = &readOnlyResponseWriter{ResponseWriter: w}
+		}
+	}
+
 	proxy.reverseProxy.ServeHTTP(w, r)
 
 	return nil
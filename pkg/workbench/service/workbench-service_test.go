@@ -11,11 +11,13 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
 	authorization_model "github.com/CHORUS-TRE/chorus-backend/pkg/authorization/model"
 	notification_model "github.com/CHORUS-TRE/chorus-backend/pkg/notification/model"
 	user_model "github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
 	user_service "github.com/CHORUS-TRE/chorus-backend/pkg/user/service"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
+	workspace_model "github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
 	"github.com/CHORUS-TRE/chorus-backend/tests/unit"
 )
 
@@ -231,3 +233,56 @@ func TestRemoveUserFromWorkbench_WorkbenchNotFound(t *testing.T) {
 	require.Error(t, err)
 	assert.Empty(t, userer.removedRoleIDs)
 }
+
+// ---------------------------------------------------------------------------
+// Read-only workspaces
+// ---------------------------------------------------------------------------
+
+type mockWorkspaceReader struct {
+	status workspace_model.WorkspaceStatus
+}
+
+func (m *mockWorkspaceReader) GetWorkspace(_ context.Context, tenantID, workspaceID uint64) (*workspace_model.Workspace, error) {
+	return &workspace_model.Workspace{ID: workspaceID, TenantID: tenantID, Status: m.status}, nil
+}
+
+func TestCreateWorkbench_RejectsFrozenWorkspace(t *testing.T) {
+	svc := newSvc(&mockWorkbenchStore{}, &mockUserer{})
+	svc.workspaceReader = &mockWorkspaceReader{status: workspace_model.WorkspaceStatusFrozen}
+
+	_, err := svc.CreateWorkbench(context.Background(), &model.Workbench{TenantID: 1, WorkspaceID: 3, UserID: 42})
+
+	var cErr *cerr.ChorusError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, cerr.ErrReadOnly.ChorusCode, cErr.ChorusCode)
+}
+
+func TestCreateAppInstance_RejectsFrozenWorkspace(t *testing.T) {
+	svc := newSvc(workbenchStoreReturning(3), &mockUserer{})
+	svc.workspaceReader = &mockWorkspaceReader{status: workspace_model.WorkspaceStatusFrozen}
+
+	_, err := svc.CreateAppInstance(context.Background(), &model.AppInstance{TenantID: 1, WorkbenchID: 5, AppID: 9})
+
+	var cErr *cerr.ChorusError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, cerr.ErrReadOnly.ChorusCode, cErr.ChorusCode)
+}
+
+// ---------------------------------------------------------------------------
+// Scheduled workbenches
+// ---------------------------------------------------------------------------
+
+func TestCreateAppInstance_RejectsWorkbenchStoppedBySchedule(t *testing.T) {
+	store := &mockWorkbenchStore{
+		getWorkbench: func(_ context.Context, _, workbenchID uint64) (*model.Workbench, error) {
+			return &model.Workbench{ID: workbenchID, WorkspaceID: 3, Status: model.WorkbenchInactive}, nil
+		},
+	}
+	svc := newSvc(store, &mockUserer{})
+
+	_, err := svc.CreateAppInstance(context.Background(), &model.AppInstance{TenantID: 1, WorkbenchID: 5, AppID: 9})
+
+	var cErr *cerr.ChorusError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, cerr.ErrInvalidRequest.ChorusCode, cErr.ChorusCode)
+}
@@ -0,0 +1,354 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	authz "github.com/CHORUS-TRE/chorus-backend/pkg/authorization/model"
+	notification_model "github.com/CHORUS-TRE/chorus-backend/pkg/notification/model"
+	user_model "github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
+	user_service "github.com/CHORUS-TRE/chorus-backend/pkg/user/service"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
+)
+
+// CreateWorkbenchShareGrant gives a user, or the members of a workspace, the workbench
+// role of the grant mode until the grant expires or is revoked. Users holding a role of
+// their own in the workbench are left out of the grant.
+func (s *WorkbenchService) CreateWorkbenchShareGrant(ctx context.Context, grant *model.WorkbenchShareGrant) (*model.WorkbenchShareGrant, error) {
+	now := time.Now()
+	if !grant.ExpiresAt.After(now) {
+		return nil, cerr.ErrInvalidRequest.WithMessage("A share grant must expire in the future")
+	}
+	if max := s.cfg.Services.WorkbenchService.MaxWorkbenchShareDuration; max > 0 && grant.ExpiresAt.Sub(now) > max {
+		return nil, cerr.ErrInvalidRequest.WithMessage(fmt.Sprintf("A share grant cannot last more than %v", max))
+	}
+
+	workbench, err := s.store.GetWorkbench(ctx, grant.TenantID, grant.WorkbenchID)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to get workbench %v", grant.WorkbenchID))
+	}
+
+	granteeIDs, err := s.workbenchShareGranteeIDs(ctx, workbench, grant)
+	if err != nil {
+		return nil, err
+	}
+
+	grants, err := s.store.ListWorkbenchShareGrants(ctx, grant.TenantID, grant.WorkbenchID)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to list share grants of workbench %v", grant.WorkbenchID))
+	}
+
+	// A user whose role comes from another active grant joins this one, and is given
+	// the role of the latest grant.
+	currentRoles := map[uint64]*user_model.UserRole{}
+	grant.Members = nil
+	for _, userID := range granteeIDs {
+		if userID == grant.GranterID {
+			continue
+		}
+
+		user, err := s.userer.GetUser(ctx, user_service.GetUserReq{TenantID: grant.TenantID, ID: userID, SkipCache: true})
+		if err != nil {
+			return nil, cerr.ErrInternal.Wrap(err, fmt.Sprintf("Unable to get user %v", userID))
+		}
+
+		current := workbenchRoleOf(user, grant.WorkbenchID)
+		if current != nil && latestActiveShareGrant(grants, userID, 0, now) == nil {
+			continue
+		}
+
+		grant.Members = append(grant.Members, model.WorkbenchShareGrantMember{UserID: userID})
+		currentRoles[userID] = current
+	}
+	if len(grant.Members) == 0 {
+		return nil, cerr.ErrInvalidRequest.WithMessage(fmt.Sprintf("The grantees already have a role in workbench %v", grant.WorkbenchID))
+	}
+
+	created, err := s.store.CreateWorkbenchShareGrant(ctx, grant.TenantID, grant)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to create share grant for workbench %v", grant.WorkbenchID))
+	}
+
+	roleName := created.Mode.RoleName()
+	roleContext := authz.Context{
+		authz.ContextWorkbench: strconv.FormatUint(workbench.ID, 10),
+		authz.ContextWorkspace: strconv.FormatUint(workbench.WorkspaceID, 10),
+	}
+	message := fmt.Sprintf("Workbench %v has been shared with you until %v", workbench.Name, created.ExpiresAt.Format(time.RFC1123))
+	for _, m := range created.Members {
+		current := currentRoles[m.UserID]
+		if current != nil && current.Name == roleName {
+			continue
+		}
+		if err := s.setWorkbenchRole(ctx, created.TenantID, m.UserID, current, roleName, roleContext, message); err != nil {
+			return nil, cerr.ErrInternal.Wrap(err, fmt.Sprintf("Unable to give user %v a role in workbench %v", m.UserID, workbench.ID))
+		}
+	}
+
+	return created, nil
+}
+
+func (s *WorkbenchService) ListWorkbenchShareGrants(ctx context.Context, tenantID, workbenchID uint64) ([]*model.WorkbenchShareGrant, error) {
+	grants, err := s.store.ListWorkbenchShareGrants(ctx, tenantID, workbenchID)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to list share grants of workbench %v", workbenchID))
+	}
+
+	// Grants past their expiry date are reported as expired before the jobber ends them.
+	now := time.Now()
+	for _, grant := range grants {
+		grant.Status = grant.EffectiveStatus(now)
+	}
+
+	return grants, nil
+}
+
+// RevokeWorkbenchShareGrant ends an active grant and takes back the roles it gave.
+func (s *WorkbenchService) RevokeWorkbenchShareGrant(ctx context.Context, tenantID, workbenchID, grantID uint64) (*model.WorkbenchShareGrant, error) {
+	grant, err := s.store.GetWorkbenchShareGrant(ctx, tenantID, grantID)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to get share grant %v", grantID))
+	}
+	if grant.WorkbenchID != workbenchID {
+		return nil, cerr.ErrNotFound.WithMessage(fmt.Sprintf("Share grant %v not found in workbench %v", grantID, workbenchID))
+	}
+	if !grant.IsActive(time.Now()) {
+		return nil, cerr.ErrInvalidRequest.WithMessage(fmt.Sprintf("Share grant %v has already ended", grantID))
+	}
+
+	if err := s.endWorkbenchShareGrant(ctx, grant, model.WorkbenchShareGrantStatusRevoked); err != nil {
+		return nil, cerr.ErrInternal.Wrap(err, fmt.Sprintf("Unable to revoke share grant %v", grantID))
+	}
+
+	grant, err = s.store.GetWorkbenchShareGrant(ctx, tenantID, grantID)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to get share grant %v", grantID))
+	}
+
+	return grant, nil
+}
+
+// ExpireWorkbenchShareGrants ends the grants past their expiry date, and takes back the
+// roles still held through ended grants. It returns the number of grants processed.
+func (s *WorkbenchService) ExpireWorkbenchShareGrants(ctx context.Context) (int, error) {
+	grants, err := s.store.ListWorkbenchShareGrantsToEnd(ctx)
+	if err != nil {
+		return 0, cerr.WrapStoreError(err, "Unable to list share grants to end")
+	}
+
+	ended := 0
+	var errs []error
+	for _, grant := range grants {
+		if err := s.endWorkbenchShareGrant(ctx, grant, model.WorkbenchShareGrantStatusExpired); err != nil {
+			errs = append(errs, fmt.Errorf("share grant %v: %w", grant.ID, err))
+			continue
+		}
+		ended++
+	}
+
+	return ended, errors.Join(errs...)
+}
+
+// GetWorkbenchShareRoles tells the workbench proxy whether the roles carried by the JWT of
+// a user may be outdated, because a grant that gave the user a role in the workbench has
+// ended since the JWT was issued. In that case the current roles of the user are returned.
+func (s *WorkbenchService) GetWorkbenchShareRoles(ctx context.Context, tenantID, workbenchID, userID uint64) ([]user_model.UserRole, bool, error) {
+	grants, err := s.store.ListUserWorkbenchShareGrants(ctx, tenantID, workbenchID, userID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	now := time.Now()
+	if latestActiveShareGrant(grants, userID, 0, now) != nil {
+		return nil, false, nil
+	}
+
+	// JWTs issued before a grant ended are valid for at most their expiration time.
+	jwtLifetime := s.cfg.Daemon.JWT.ExpirationTime
+	stale := false
+	for _, grant := range grants {
+		switch {
+		case !grant.IsActive(now) && (grant.Status == model.WorkbenchShareGrantStatusActive || grant.HasMember(userID)):
+			if err := s.endWorkbenchShareGrant(ctx, grant, model.WorkbenchShareGrantStatusExpired); err != nil {
+				return nil, false, err
+			}
+			stale = true
+		case grant.EndedAt != nil && now.Sub(*grant.EndedAt) < jwtLifetime:
+			stale = true
+		}
+	}
+	if !stale {
+		return nil, false, nil
+	}
+
+	user, err := s.userer.GetUser(ctx, user_service.GetUserReq{TenantID: tenantID, ID: userID, SkipCache: true})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return user.Roles, true, nil
+}
+
+// ListWorkbenchShareGrantees returns the users a grant would be given to, so that the
+// caller can be checked against each of them before the grant is created.
+func (s *WorkbenchService) ListWorkbenchShareGrantees(ctx context.Context, grant *model.WorkbenchShareGrant) ([]uint64, error) {
+	workbench, err := s.store.GetWorkbench(ctx, grant.TenantID, grant.WorkbenchID)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to get workbench %v", grant.WorkbenchID))
+	}
+
+	return s.workbenchShareGranteeIDs(ctx, workbench, grant)
+}
+
+// workbenchShareGranteeIDs returns the users a grant is given to. A workbench can only be
+// shared with the members of its own workspace.
+func (s *WorkbenchService) workbenchShareGranteeIDs(ctx context.Context, workbench *model.Workbench, grant *model.WorkbenchShareGrant) ([]uint64, error) {
+	if grant.GranteeUserID != nil {
+		return []uint64{*grant.GranteeUserID}, nil
+	}
+	if *grant.GranteeWorkspaceID != workbench.WorkspaceID {
+		return nil, cerr.ErrInvalidRequest.WithMessage(fmt.Sprintf("Workbench %v can only be shared with the members of workspace %v", workbench.ID, workbench.WorkspaceID))
+	}
+
+	users, _, err := s.userer.ListUsers(ctx, user_service.ListUsersReq{
+		TenantID: grant.TenantID,
+		Filter:   &user_service.UserFilter{WorkspaceIDs: []uint64{*grant.GranteeWorkspaceID}},
+	})
+	if err != nil {
+		return nil, cerr.ErrInternal.Wrap(err, fmt.Sprintf("Unable to list members of workspace %v", *grant.GranteeWorkspaceID))
+	}
+
+	ids := make([]uint64, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.ID)
+	}
+	return ids, nil
+}
+
+// endWorkbenchShareGrant moves a grant to its final status, unless it has already ended,
+// and takes back the roles it gave.
+func (s *WorkbenchService) endWorkbenchShareGrant(ctx context.Context, grant *model.WorkbenchShareGrant, status model.WorkbenchShareGrantStatus) error {
+	if grant.Status == model.WorkbenchShareGrantStatusActive {
+		if err := s.store.EndWorkbenchShareGrant(ctx, grant.TenantID, grant.ID, status); err != nil {
+			return fmt.Errorf("unable to end share grant: %w", err)
+		}
+		grant.Status = status
+	}
+
+	grants, err := s.store.ListWorkbenchShareGrants(ctx, grant.TenantID, grant.WorkbenchID)
+	if err != nil {
+		return fmt.Errorf("unable to list share grants: %w", err)
+	}
+
+	now := time.Now()
+	var errs []error
+	for _, m := range grant.Members {
+		if m.RemovedAt != nil {
+			continue
+		}
+		if err := s.releaseWorkbenchShareGrantMember(ctx, grant, grants, m.UserID, now); err != nil {
+			errs = append(errs, fmt.Errorf("user %v: %w", m.UserID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// releaseWorkbenchShareGrantMember takes back the role an ended grant gave to a user. A
+// user still covered by another active grant is given the role of the latest one instead,
+// and a role changed since the grant gave it is left alone.
+func (s *WorkbenchService) releaseWorkbenchShareGrantMember(ctx context.Context, grant *model.WorkbenchShareGrant, grants []*model.WorkbenchShareGrant, userID uint64, now time.Time) error {
+	user, err := s.userer.GetUser(ctx, user_service.GetUserReq{TenantID: grant.TenantID, ID: userID, SkipCache: true})
+	if err != nil {
+		return fmt.Errorf("unable to get user: %w", err)
+	}
+
+	current := workbenchRoleOf(user, grant.WorkbenchID)
+	if current != nil && current.Name == grant.Mode.RoleName() {
+		next := latestActiveShareGrant(grants, userID, grant.ID, now)
+		switch {
+		case next == nil:
+			message := fmt.Sprintf("Your access to shared workbench %v has ended", grant.WorkbenchID)
+			if err := s.setWorkbenchRole(ctx, grant.TenantID, userID, current, "", nil, message); err != nil {
+				return err
+			}
+		case next.Mode.RoleName() != current.Name:
+			message := fmt.Sprintf("Your access to shared workbench %v has changed to %v", grant.WorkbenchID, next.Mode)
+			if err := s.setWorkbenchRole(ctx, grant.TenantID, userID, current, next.Mode.RoleName(), current.Context, message); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := s.store.RemoveWorkbenchShareGrantMember(ctx, grant.ID, userID); err != nil {
+		return fmt.Errorf("unable to remove share grant member: %w", err)
+	}
+
+	return nil
+}
+
+// setWorkbenchRole replaces the current workbench role of a user, if any, with a role of
+// the given name, or with none if the name is empty, and asks the user to refresh the
+// roles in their JWT.
+func (s *WorkbenchService) setWorkbenchRole(ctx context.Context, tenantID, userID uint64, current *user_model.UserRole, name authz.RoleName, roleContext authz.Context, message string) error {
+	if current != nil {
+		if err := s.userer.RemoveUserRoles(ctx, tenantID, userID, []uint64{current.ID}); err != nil {
+			return fmt.Errorf("unable to remove workbench role: %w", err)
+		}
+	}
+
+	if name != "" {
+		role := user_model.UserRole{Role: authz.Role{Name: name, Context: roleContext}}
+		if err := s.userer.CreateUserRoles(ctx, tenantID, userID, []user_model.UserRole{role}); err != nil {
+			return fmt.Errorf("unable to create workbench role: %w", err)
+		}
+	}
+
+	err := s.notificationStore.CreateNotification(ctx, &notification_model.Notification{
+		TenantID: tenantID,
+		UserID:   userID,
+		Message:  message,
+		Content: notification_model.NotificationContent{
+			Type: "SystemNotification",
+			SystemNotification: &notification_model.SystemNotification{
+				RefreshJWTRequired: true,
+			},
+		},
+	}, []uint64{userID})
+	if err != nil {
+		return fmt.Errorf("unable to create notification: %w", err)
+	}
+
+	return nil
+}
+
+// workbenchRoleOf returns the role a user holds in a workbench, if any.
+func workbenchRoleOf(user *user_model.User, workbenchID uint64) *user_model.UserRole {
+	id := strconv.FormatUint(workbenchID, 10)
+	for _, r := range user.Roles {
+		if r.Context[authz.ContextWorkbench] == id {
+			role := r
+			return &role
+		}
+	}
+	return nil
+}
+
+// latestActiveShareGrant returns the latest active grant, other than the excluded one,
+// still giving a user a role.
+func latestActiveShareGrant(grants []*model.WorkbenchShareGrant, userID, excludedID uint64, now time.Time) *model.WorkbenchShareGrant {
+	var latest *model.WorkbenchShareGrant
+	for _, g := range grants {
+		if g.ID == excludedID || !g.IsActive(now) || !g.HasMember(userID) {
+			continue
+		}
+		if latest == nil || g.ID > latest.ID {
+			latest = g
+		}
+	}
+	return latest
+}
@@ -0,0 +1,400 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	authz "github.com/CHORUS-TRE/chorus-backend/pkg/authorization/model"
+	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
+	user_model "github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
+	user_service "github.com/CHORUS-TRE/chorus-backend/pkg/user/service"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
+)
+
+type shareStore struct {
+	mockWorkbenchStore
+	grants map[uint64]*model.WorkbenchShareGrant
+	nextID uint64
+}
+
+func newShareStore() *shareStore {
+	return &shareStore{
+		mockWorkbenchStore: mockWorkbenchStore{getWorkbench: func(_ context.Context, _, workbenchID uint64) (*model.Workbench, error) {
+			return &model.Workbench{ID: workbenchID, WorkspaceID: 7, Name: "analysis"}, nil
+		}},
+		grants: map[uint64]*model.WorkbenchShareGrant{},
+	}
+}
+
+func cloneGrant(g *model.WorkbenchShareGrant) *model.WorkbenchShareGrant {
+	c := *g
+	c.Members = append([]model.WorkbenchShareGrantMember(nil), g.Members...)
+	return &c
+}
+
+func (s *shareStore) list(keep func(*model.WorkbenchShareGrant) bool) []*model.WorkbenchShareGrant {
+	res := []*model.WorkbenchShareGrant{}
+	for id := s.nextID; id > 0; id-- {
+		if g, ok := s.grants[id]; ok && keep(g) {
+			res = append(res, cloneGrant(g))
+		}
+	}
+	return res
+}
+
+func (s *shareStore) GetWorkbenchShareGrant(_ context.Context, _, grantID uint64) (*model.WorkbenchShareGrant, error) {
+	g, ok := s.grants[grantID]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	return cloneGrant(g), nil
+}
+
+func (s *shareStore) ListWorkbenchShareGrants(_ context.Context, _, workbenchID uint64) ([]*model.WorkbenchShareGrant, error) {
+	return s.list(func(g *model.WorkbenchShareGrant) bool { return g.WorkbenchID == workbenchID }), nil
+}
+
+func (s *shareStore) ListUserWorkbenchShareGrants(_ context.Context, _, workbenchID, userID uint64) ([]*model.WorkbenchShareGrant, error) {
+	return s.list(func(g *model.WorkbenchShareGrant) bool {
+		for _, m := range g.Members {
+			if m.UserID == userID {
+				return g.WorkbenchID == workbenchID
+			}
+		}
+		return false
+	}), nil
+}
+
+func (s *shareStore) ListWorkbenchShareGrantsToEnd(_ context.Context) ([]*model.WorkbenchShareGrant, error) {
+	now := time.Now()
+	return s.list(func(g *model.WorkbenchShareGrant) bool {
+		if g.Status == model.WorkbenchShareGrantStatusActive {
+			return !g.ExpiresAt.After(now)
+		}
+		for _, m := range g.Members {
+			if m.RemovedAt == nil {
+				return true
+			}
+		}
+		return false
+	}), nil
+}
+
+func (s *shareStore) CreateWorkbenchShareGrant(_ context.Context, tenantID uint64, grant *model.WorkbenchShareGrant) (*model.WorkbenchShareGrant, error) {
+	s.nextID++
+	g := cloneGrant(grant)
+	g.ID = s.nextID
+	g.TenantID = tenantID
+	g.Status = model.WorkbenchShareGrantStatusActive
+	s.grants[g.ID] = g
+	return cloneGrant(g), nil
+}
+
+func (s *shareStore) EndWorkbenchShareGrant(_ context.Context, _, grantID uint64, status model.WorkbenchShareGrantStatus) error {
+	g, ok := s.grants[grantID]
+	if !ok || g.Status != model.WorkbenchShareGrantStatusActive {
+		return cerr.ErrNoRowsUpdated
+	}
+	now := time.Now()
+	g.Status = status
+	g.EndedAt = &now
+	return nil
+}
+
+func (s *shareStore) RemoveWorkbenchShareGrantMember(_ context.Context, grantID, userID uint64) error {
+	g := s.grants[grantID]
+	for i, m := range g.Members {
+		if m.UserID == userID && m.RemovedAt == nil {
+			now := time.Now()
+			g.Members[i].RemovedAt = &now
+			return nil
+		}
+	}
+	return cerr.ErrNoRowsUpdated
+}
+
+// roleUserer keeps the roles of users in memory.
+type roleUserer struct {
+	user_service.Userer
+	roles            map[uint64][]user_model.UserRole
+	workspaceMembers map[uint64][]uint64
+	nextRoleID       uint64
+}
+
+func newRoleUserer() *roleUserer {
+	return &roleUserer{roles: map[uint64][]user_model.UserRole{}, workspaceMembers: map[uint64][]uint64{}, nextRoleID: 100}
+}
+
+func (u *roleUserer) GetUser(_ context.Context, req user_service.GetUserReq) (*user_model.User, error) {
+	return &user_model.User{ID: req.ID, Roles: append([]user_model.UserRole(nil), u.roles[req.ID]...)}, nil
+}
+
+func (u *roleUserer) ListUsers(_ context.Context, req user_service.ListUsersReq) ([]*user_model.User, *common_model.PaginationResult, error) {
+	var users []*user_model.User
+	for _, workspaceID := range req.Filter.WorkspaceIDs {
+		for _, id := range u.workspaceMembers[workspaceID] {
+			users = append(users, &user_model.User{ID: id})
+		}
+	}
+	return users, nil, nil
+}
+
+func (u *roleUserer) CreateUserRoles(_ context.Context, _, userID uint64, roles []user_model.UserRole) error {
+	for _, r := range roles {
+		u.nextRoleID++
+		r.ID = u.nextRoleID
+		u.roles[userID] = append(u.roles[userID], r)
+	}
+	return nil
+}
+
+func (u *roleUserer) RemoveUserRoles(_ context.Context, _, userID uint64, roleIDs []uint64) error {
+	var kept []user_model.UserRole
+	for _, r := range u.roles[userID] {
+		removed := false
+		for _, id := range roleIDs {
+			removed = removed || r.ID == id
+		}
+		if !removed {
+			kept = append(kept, r)
+		}
+	}
+	u.roles[userID] = kept
+	return nil
+}
+
+// workbenchRoleName returns the name of the role a user holds in a workbench.
+func (u *roleUserer) workbenchRoleName(userID, workbenchID uint64) authz.RoleName {
+	for _, r := range u.roles[userID] {
+		if r.Context[authz.ContextWorkbench] == strconv.FormatUint(workbenchID, 10) {
+			return r.Name
+		}
+	}
+	return ""
+}
+
+func newShareSvc(store WorkbenchStore, userer user_service.Userer) *WorkbenchService {
+	cfg := config.Config{}
+	cfg.Services.WorkbenchService.MaxWorkbenchShareDuration = 7 * 24 * time.Hour
+	cfg.Daemon.JWT.ExpirationTime = 24 * time.Hour
+
+	return &WorkbenchService{
+		cfg:               cfg,
+		store:             store,
+		userer:            userer,
+		notificationStore: &mockNotificationStore{},
+	}
+}
+
+func userPtr(id uint64) *uint64 { return &id }
+
+func shareWith(userID uint64, mode model.WorkbenchShareMode, ttl time.Duration) *model.WorkbenchShareGrant {
+	return &model.WorkbenchShareGrant{
+		TenantID:      1,
+		WorkbenchID:   3,
+		GranterID:     2,
+		GranteeUserID: userPtr(userID),
+		Mode:          mode,
+		ExpiresAt:     time.Now().Add(ttl),
+	}
+}
+
+func TestCreateWorkbenchShareGrant_GivesRoleOfMode(t *testing.T) {
+	store := newShareStore()
+	userer := newRoleUserer()
+	svc := newShareSvc(store, userer)
+
+	grant, err := svc.CreateWorkbenchShareGrant(context.Background(), shareWith(5, model.WorkbenchShareModeView, time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, model.WorkbenchShareGrantStatusActive, grant.Status)
+	require.Len(t, grant.Members, 1)
+	assert.Equal(t, uint64(5), grant.Members[0].UserID)
+
+	require.Len(t, userer.roles[5], 1)
+	role := userer.roles[5][0]
+	assert.Equal(t, authz.RoleWorkbenchViewer.Name, role.Name)
+	assert.Equal(t, "3", role.Context[authz.ContextWorkbench])
+	assert.Equal(t, "7", role.Context[authz.ContextWorkspace])
+}
+
+func TestCreateWorkbenchShareGrant_LeavesOutUsersWithOwnRole(t *testing.T) {
+	store := newShareStore()
+	userer := newRoleUserer()
+	userer.workspaceMembers[7] = []uint64{2, 5, 6}
+	_ = userer.CreateUserRoles(context.Background(), 1, 6, []user_model.UserRole{
+		{Role: authz.Role{Name: authz.RoleWorkbenchAdmin.Name, Context: authz.Context{authz.ContextWorkbench: "3"}}},
+	})
+	svc := newShareSvc(store, userer)
+
+	grant := shareWith(0, model.WorkbenchShareModeInteractive, time.Hour)
+	grant.GranteeUserID = nil
+	grant.GranteeWorkspaceID = userPtr(7)
+
+	created, err := svc.CreateWorkbenchShareGrant(context.Background(), grant)
+	require.NoError(t, err)
+	require.Len(t, created.Members, 1)
+	assert.Equal(t, uint64(5), created.Members[0].UserID)
+	assert.Equal(t, authz.RoleWorkbenchMember.Name, userer.workbenchRoleName(5, 3))
+	assert.Equal(t, authz.RoleWorkbenchAdmin.Name, userer.workbenchRoleName(6, 3))
+	assert.Empty(t, userer.workbenchRoleName(2, 3))
+
+	_, err = svc.CreateWorkbenchShareGrant(context.Background(), shareWith(6, model.WorkbenchShareModeView, time.Hour))
+	var cErr *cerr.ChorusError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, cerr.ErrInvalidRequest.ChorusCode, cErr.ChorusCode)
+}
+
+func TestCreateWorkbenchShareGrant_RejectsOtherWorkspace(t *testing.T) {
+	store := newShareStore()
+	userer := newRoleUserer()
+	userer.workspaceMembers[9] = []uint64{5}
+	svc := newShareSvc(store, userer)
+
+	grant := shareWith(0, model.WorkbenchShareModeView, time.Hour)
+	grant.GranteeUserID = nil
+	grant.GranteeWorkspaceID = userPtr(9)
+
+	_, err := svc.ListWorkbenchShareGrantees(context.Background(), grant)
+	var cErr *cerr.ChorusError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, cerr.ErrInvalidRequest.ChorusCode, cErr.ChorusCode)
+
+	_, err = svc.CreateWorkbenchShareGrant(context.Background(), grant)
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, cerr.ErrInvalidRequest.ChorusCode, cErr.ChorusCode)
+	assert.Empty(t, userer.workbenchRoleName(5, 3))
+}
+
+func TestCreateWorkbenchShareGrant_RejectsInvalidExpiry(t *testing.T) {
+	svc := newShareSvc(newShareStore(), newRoleUserer())
+
+	for name, ttl := range map[string]time.Duration{"past": -time.Minute, "too long": 8 * 24 * time.Hour} {
+		t.Run(name, func(t *testing.T) {
+			_, err := svc.CreateWorkbenchShareGrant(context.Background(), shareWith(5, model.WorkbenchShareModeView, ttl))
+			var cErr *cerr.ChorusError
+			require.ErrorAs(t, err, &cErr)
+			assert.Equal(t, cerr.ErrInvalidRequest.ChorusCode, cErr.ChorusCode)
+		})
+	}
+}
+
+func TestRevokeWorkbenchShareGrant_TakesRoleBack(t *testing.T) {
+	store := newShareStore()
+	userer := newRoleUserer()
+	svc := newShareSvc(store, userer)
+
+	grant, err := svc.CreateWorkbenchShareGrant(context.Background(), shareWith(5, model.WorkbenchShareModeView, time.Hour))
+	require.NoError(t, err)
+
+	revoked, err := svc.RevokeWorkbenchShareGrant(context.Background(), 1, 3, grant.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.WorkbenchShareGrantStatusRevoked, revoked.Status)
+	assert.NotNil(t, revoked.EndedAt)
+	assert.NotNil(t, revoked.Members[0].RemovedAt)
+	assert.Empty(t, userer.workbenchRoleName(5, 3))
+
+	_, err = svc.RevokeWorkbenchShareGrant(context.Background(), 1, 3, grant.ID)
+	var cErr *cerr.ChorusError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, cerr.ErrInvalidRequest.ChorusCode, cErr.ChorusCode)
+
+	_, err = svc.RevokeWorkbenchShareGrant(context.Background(), 1, 4, grant.ID)
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, cerr.ErrNotFound.ChorusCode, cErr.ChorusCode)
+}
+
+func TestWorkbenchShareGrants_LatestGrantDecidesRole(t *testing.T) {
+	store := newShareStore()
+	userer := newRoleUserer()
+	svc := newShareSvc(store, userer)
+	ctx := context.Background()
+
+	interactive, err := svc.CreateWorkbenchShareGrant(ctx, shareWith(5, model.WorkbenchShareModeInteractive, 2*time.Hour))
+	require.NoError(t, err)
+	view, err := svc.CreateWorkbenchShareGrant(ctx, shareWith(5, model.WorkbenchShareModeView, time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, authz.RoleWorkbenchViewer.Name, userer.workbenchRoleName(5, 3))
+	assert.Len(t, userer.roles[5], 1)
+
+	_, err = svc.RevokeWorkbenchShareGrant(ctx, 1, 3, view.ID)
+	require.NoError(t, err)
+	assert.Equal(t, authz.RoleWorkbenchMember.Name, userer.workbenchRoleName(5, 3))
+
+	_, err = svc.RevokeWorkbenchShareGrant(ctx, 1, 3, interactive.ID)
+	require.NoError(t, err)
+	assert.Empty(t, userer.roles[5])
+}
+
+func TestExpireWorkbenchShareGrants(t *testing.T) {
+	store := newShareStore()
+	userer := newRoleUserer()
+	svc := newShareSvc(store, userer)
+	ctx := context.Background()
+
+	expiring, err := svc.CreateWorkbenchShareGrant(ctx, shareWith(5, model.WorkbenchShareModeView, time.Hour))
+	require.NoError(t, err)
+	_, err = svc.CreateWorkbenchShareGrant(ctx, shareWith(6, model.WorkbenchShareModeView, time.Hour))
+	require.NoError(t, err)
+	store.grants[expiring.ID].ExpiresAt = time.Now().Add(-time.Second)
+
+	grants, err := svc.ListWorkbenchShareGrants(ctx, 1, 3)
+	require.NoError(t, err)
+	require.Len(t, grants, 2)
+	assert.Equal(t, model.WorkbenchShareGrantStatusExpired, grants[1].Status)
+
+	ended, err := svc.ExpireWorkbenchShareGrants(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, ended)
+	assert.Equal(t, model.WorkbenchShareGrantStatusExpired, store.grants[expiring.ID].Status)
+	assert.Empty(t, userer.workbenchRoleName(5, 3))
+	assert.Equal(t, authz.RoleWorkbenchViewer.Name, userer.workbenchRoleName(6, 3))
+
+	ended, err = svc.ExpireWorkbenchShareGrants(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, ended)
+}
+
+func TestGetWorkbenchShareRoles(t *testing.T) {
+	store := newShareStore()
+	userer := newRoleUserer()
+	svc := newShareSvc(store, userer)
+	ctx := context.Background()
+
+	_, stale, err := svc.GetWorkbenchShareRoles(ctx, 1, 3, 5)
+	require.NoError(t, err)
+	assert.False(t, stale, "user never given a role by a grant")
+
+	grant, err := svc.CreateWorkbenchShareGrant(ctx, shareWith(5, model.WorkbenchShareModeView, time.Hour))
+	require.NoError(t, err)
+
+	_, stale, err = svc.GetWorkbenchShareRoles(ctx, 1, 3, 5)
+	require.NoError(t, err)
+	assert.False(t, stale, "grant still active")
+
+	// The grant expired but the jobber has not run yet.
+	store.grants[grant.ID].ExpiresAt = time.Now().Add(-time.Second)
+
+	roles, stale, err := svc.GetWorkbenchShareRoles(ctx, 1, 3, 5)
+	require.NoError(t, err)
+	assert.True(t, stale)
+	assert.Empty(t, roles)
+	assert.Equal(t, model.WorkbenchShareGrantStatusExpired, store.grants[grant.ID].Status)
+
+	// JWTs issued before the grant ended have all expired.
+	endedAt := time.Now().Add(-25 * time.Hour)
+	store.grants[grant.ID].EndedAt = &endedAt
+
+	_, stale, err = svc.GetWorkbenchShareRoles(ctx, 1, 3, 5)
+	require.NoError(t, err)
+	assert.False(t, stale)
+}
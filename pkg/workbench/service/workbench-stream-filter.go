@@ -0,0 +1,254 @@
+package service
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+)
+
+// maxReadOnlyMessageSize bounds the messages a read-only stream buffers before deciding
+// whether to forward them. Viewers only send small control packets.
+const maxReadOnlyMessageSize = 1 << 20
+
+const (
+	webSocketOpClose = 0x8
+	webSocketRSV1    = 0x40
+	webSocketFIN     = 0x80
+	webSocketMask    = 0x80
+)
+
+// readOnlyPackets are the xpra packets a viewer of a workbench may send: those needed to
+// open the session and lay out its windows. Input, clipboard, file transfers, commands
+// and changes of the screen are dropped.
+var readOnlyPackets = map[string]struct{}{
+	"hello":            {},
+	"ping":             {},
+	"ping_echo":        {},
+	"damage-sequence":  {},
+	"buffer-refresh":   {},
+	"connection-data":  {},
+	"bandwidth-limit":  {},
+	"info-request":     {},
+	"set_deflate":      {},
+	"encoding":         {},
+	"quality":          {},
+	"min-quality":      {},
+	"speed":            {},
+	"min-speed":        {},
+	"sound-control":    {},
+	"map-window":       {},
+	"configure-window": {},
+	"disconnect":       {},
+}
+
+// readOnlyResponseWriter hands out a connection that drops the input of the user once it
+// has been hijacked for the websocket of the stream.
+type readOnlyResponseWriter struct {
+	http.ResponseWriter
+}
+
+func (w *readOnlyResponseWriter) Flush() {
+	_ = http.NewResponseController(w.ResponseWriter).Flush()
+}
+
+func (w *readOnlyResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	conn, brw, err := http.NewResponseController(w.ResponseWriter).Hijack()
+	if err != nil {
+		return nil, nil, err
+	}
+	return newReadOnlyConn(conn), brw, nil
+}
+
+func (w *readOnlyResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// readOnlyConn forwards the websocket frames sent by the user to the workbench, except
+// the messages carrying xpra packets a viewer may not send. Control frames are forwarded
+// as is.
+type readOnlyConn struct {
+	net.Conn
+	reader *bufio.Reader
+
+	// frames and message hold the raw frames and the payload of the message being
+	// received, out the frames of the last message to forward. Compressed messages
+	// cannot be read and are dropped.
+	frames     []byte
+	message    []byte
+	compressed bool
+	out        []byte
+}
+
+func newReadOnlyConn(conn net.Conn) *readOnlyConn {
+	return &readOnlyConn{Conn: conn, reader: bufio.NewReader(conn)}
+}
+
+func (c *readOnlyConn) Read(b []byte) (int, error) {
+	for len(c.out) == 0 {
+		if err := c.readFrame(); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(b, c.out)
+	c.out = c.out[n:]
+	return n, nil
+}
+
+func (c *readOnlyConn) readFrame() error {
+	frame, err := readWebSocketFrame(c.reader)
+	if err != nil {
+		return err
+	}
+
+	if frame.opcode >= webSocketOpClose {
+		c.out = frame.raw
+		return nil
+	}
+
+	if len(c.message)+len(frame.payload) > maxReadOnlyMessageSize {
+		return fmt.Errorf("websocket message larger than %v bytes", maxReadOnlyMessageSize)
+	}
+	if len(c.frames) == 0 {
+		c.compressed = frame.compressed
+	}
+	c.frames = append(c.frames, frame.raw...)
+	c.message = append(c.message, frame.payload...)
+	if !frame.fin {
+		return nil
+	}
+
+	if _, ok := readOnlyPackets[xpraPacketType(c.message)]; ok && !c.compressed {
+		c.out = c.frames
+	}
+	c.frames, c.message = nil, nil
+	return nil
+}
+
+type webSocketFrame struct {
+	fin        bool
+	compressed bool
+	opcode     byte
+	// raw is the frame as received, payload its unmasked payload.
+	raw     []byte
+	payload []byte
+}
+
+func readWebSocketFrame(r *bufio.Reader) (*webSocketFrame, error) {
+	raw := make([]byte, 2, 14)
+	if _, err := io.ReadFull(r, raw); err != nil {
+		return nil, err
+	}
+
+	length := uint64(raw[1] &^ webSocketMask)
+	switch length {
+	case 126:
+		raw = raw[:4]
+		if _, err := io.ReadFull(r, raw[2:]); err != nil {
+			return nil, err
+		}
+		length = uint64(binary.BigEndian.Uint16(raw[2:]))
+	case 127:
+		raw = raw[:10]
+		if _, err := io.ReadFull(r, raw[2:]); err != nil {
+			return nil, err
+		}
+		length = binary.BigEndian.Uint64(raw[2:])
+	}
+	if length > maxReadOnlyMessageSize {
+		return nil, fmt.Errorf("websocket frame larger than %v bytes", maxReadOnlyMessageSize)
+	}
+
+	var mask []byte
+	if raw[1]&webSocketMask != 0 {
+		start := len(raw)
+		raw = raw[:start+4]
+		if _, err := io.ReadFull(r, raw[start:]); err != nil {
+			return nil, err
+		}
+		mask = raw[start:]
+	}
+
+	start := len(raw)
+	raw = append(raw, make([]byte, length)...)
+	if _, err := io.ReadFull(r, raw[start:]); err != nil {
+		return nil, err
+	}
+
+	payload := make([]byte, length)
+	copy(payload, raw[start:])
+	if mask != nil {
+		for i := range payload {
+			payload[i] ^= mask[i%4]
+		}
+	}
+
+	return &webSocketFrame{
+		fin:        raw[0]&webSocketFIN != 0,
+		compressed: raw[0]&webSocketRSV1 != 0,
+		opcode:     raw[0] & 0x0f,
+		raw:        raw,
+		payload:    payload,
+	}, nil
+}
+
+// xpraPacketType returns the type of the xpra packet in a message, or an empty string
+// when the packet is compressed, encrypted or cannot be read. A packet starts with an
+// 8 bytes header, followed by its bencoded or rencoded list, whose first item is its
+// type.
+func xpraPacketType(message []byte) string {
+	const (
+		headerSize      = 8
+		flagCipher      = 0x2
+		rencodeList     = 59
+		rencodeFixList  = 192
+		rencodeFixStr   = 128
+		rencodeFixStrTo = 192
+	)
+
+	if len(message) <= headerSize || message[0] != 'P' || message[1]&flagCipher != 0 || message[2] != 0 || message[3] != 0 {
+		return ""
+	}
+	packet := message[headerSize:]
+	if uint64(binary.BigEndian.Uint32(message[4:headerSize])) != uint64(len(packet)) {
+		return ""
+	}
+
+	switch {
+	case packet[0] == 'l', packet[0] == rencodeList, packet[0] >= rencodeFixList:
+		packet = packet[1:]
+	default:
+		return ""
+	}
+	if len(packet) == 0 {
+		return ""
+	}
+
+	if packet[0] >= rencodeFixStr && packet[0] < rencodeFixStrTo {
+		size := int(packet[0] - rencodeFixStr)
+		if len(packet) < 1+size {
+			return ""
+		}
+		return string(packet[1 : 1+size])
+	}
+
+	// Longer strings are written as their size, a colon and their bytes in both formats.
+	size := 0
+	for i, b := range packet {
+		switch {
+		case b >= '0' && b <= '9' && i < 4:
+			size = size*10 + int(b-'0')
+		case b == ':' && i > 0:
+			if len(packet) < i+1+size {
+				return ""
+			}
+			return string(packet[i+1 : i+1+size])
+		default:
+			return ""
+		}
+	}
+	return ""
+}
@@ -0,0 +1,104 @@
+//go:build unit
+
+package service
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// xpraPacket returns an xpra packet of the given type, rencoded or bencoded.
+func xpraPacket(packetType string, rencoded bool) []byte {
+	var payload []byte
+	if rencoded {
+		payload = append([]byte{192 + 2, byte(128 + len(packetType))}, packetType...)
+		payload = append(payload, 0)
+	} else {
+		payload = []byte(fmt.Sprintf("l%d:%si0ee", len(packetType), packetType))
+	}
+
+	header := []byte{'P', 0, 0, 0, 0, 0, 0, 0}
+	binary.BigEndian.PutUint32(header[4:], uint32(len(payload)))
+	return append(header, payload...)
+}
+
+// maskedFrame returns a websocket frame as sent by a browser.
+func maskedFrame(opcode byte, fin bool, payload []byte) []byte {
+	first := opcode
+	if fin {
+		first |= webSocketFIN
+	}
+	mask := []byte{1, 2, 3, 4}
+	frame := []byte{first, webSocketMask | byte(len(payload))}
+	frame = append(frame, mask...)
+	for i, b := range payload {
+		frame = append(frame, b^mask[i%4])
+	}
+	return frame
+}
+
+func TestReadOnlyConn_DropsInput(t *testing.T) {
+	hello := maskedFrame(0x2, true, xpraPacket("hello", true))
+	keyAction := maskedFrame(0x2, true, xpraPacket("key-action", true))
+	ping := xpraPacket("ping", false)
+	pingStart, pingEnd := maskedFrame(0x2, false, ping[:5]), maskedFrame(0x0, true, ping[5:])
+	control := maskedFrame(0x9, true, nil)
+	pointer := maskedFrame(0x2, true, xpraPacket("pointer-position", false))
+	clipboard := maskedFrame(0x2, false, xpraPacket("clipboard-token", true))
+	clipboardEnd := maskedFrame(0x0, true, nil)
+	refresh := maskedFrame(0x2, true, xpraPacket("buffer-refresh", true))
+
+	var sent bytes.Buffer
+	for _, frame := range [][]byte{hello, keyAction, pingStart, control, pingEnd, pointer, clipboard, clipboardEnd, refresh} {
+		sent.Write(frame)
+	}
+
+	client, server := net.Pipe()
+	go func() {
+		_, _ = client.Write(sent.Bytes())
+		_ = client.Close()
+	}()
+
+	received, err := io.ReadAll(newReadOnlyConn(server))
+	require.NoError(t, err)
+
+	var expected bytes.Buffer
+	for _, frame := range [][]byte{hello, control, pingStart, pingEnd, refresh} {
+		expected.Write(frame)
+	}
+	assert.Equal(t, expected.Bytes(), received, "only the packets a viewer needs are forwarded")
+}
+
+func TestReadOnlyConn_DropsCompressedMessages(t *testing.T) {
+	hello := maskedFrame(0x2, true, xpraPacket("hello", true))
+	hello[0] |= webSocketRSV1
+
+	client, server := net.Pipe()
+	go func() {
+		_, _ = client.Write(hello)
+		_ = client.Close()
+	}()
+
+	received, err := io.ReadAll(newReadOnlyConn(server))
+	require.NoError(t, err)
+	assert.Empty(t, received)
+}
+
+func TestXpraPacketType(t *testing.T) {
+	assert.Equal(t, "hello", xpraPacketType(xpraPacket("hello", true)))
+	assert.Equal(t, "key-action", xpraPacketType(xpraPacket("key-action", false)))
+
+	compressed := xpraPacket("hello", true)
+	compressed[2] = 1
+	assert.Empty(t, xpraPacketType(compressed))
+
+	assert.Empty(t, xpraPacketType([]byte("hello")))
+	assert.Empty(t, xpraPacketType(xpraPacket("hello", true)[:10]))
+}
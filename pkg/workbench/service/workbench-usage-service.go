@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/k8s"
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
+	workspace_model "github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
+)
+
+// GetAppInstanceUsage returns the recent CPU and memory usage of an app instance against
+// the bounds declared by its app.
+func (s *WorkbenchService) GetAppInstanceUsage(ctx context.Context, tenantID, appInstanceID uint64) (*model.AppInstanceUsage, error) {
+	appInstance, err := s.store.GetAppInstance(ctx, tenantID, appInstanceID)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to get app instance %v", appInstanceID))
+	}
+
+	// Only the app instances of a workbench are fetched with the resources of their app.
+	apps, err := s.store.ListWorkbenchAppInstances(ctx, appInstance.WorkbenchID)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to list app instances of workbench %v", appInstance.WorkbenchID))
+	}
+	for _, app := range apps {
+		if app.ID == appInstance.ID {
+			appInstance = app
+			break
+		}
+	}
+
+	usage, err := s.appInstanceUsage(appInstance)
+	if err != nil {
+		return nil, usageError(err, fmt.Sprintf("Unable to get usage of app instance %v", appInstanceID))
+	}
+
+	return usage, nil
+}
+
+// GetWorkbenchUsage returns the recent CPU and memory usage of a workbench server and of
+// its app instances.
+func (s *WorkbenchService) GetWorkbenchUsage(ctx context.Context, tenantID, workbenchID uint64) (*model.WorkbenchUsage, error) {
+	workbench, err := s.store.GetWorkbench(ctx, tenantID, workbenchID)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to get workbench %v", workbenchID))
+	}
+
+	return s.workbenchUsage(ctx, workbench)
+}
+
+// GetWorkspaceUsage returns the current CPU and memory usage of the workbenches of a
+// workspace, and their total.
+func (s *WorkbenchService) GetWorkspaceUsage(ctx context.Context, tenantID, workspaceID uint64) (*model.WorkspaceUsage, error) {
+	workbenches, _, err := s.store.ListWorkbenches(ctx, tenantID, nil, &[]uint64{workspaceID})
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to list workbenches of workspace %v", workspaceID))
+	}
+
+	usage := &model.WorkspaceUsage{
+		WorkspaceID: workspaceID,
+		Workbenches: make([]*model.WorkbenchUsage, 0, len(workbenches)),
+	}
+	for _, workbench := range workbenches {
+		workbenchUsage, err := s.workbenchUsage(ctx, workbench)
+		if err != nil {
+			return nil, err
+		}
+
+		usage.Workbenches = append(usage.Workbenches, workbenchUsage)
+		usage.Total = usage.Total.Add(workbenchUsage.Total)
+		usage.Bounds = usage.Bounds.Add(workbenchUsage.Bounds)
+	}
+
+	return usage, nil
+}
+
+func (s *WorkbenchService) workbenchUsage(ctx context.Context, workbench *model.Workbench) (*model.WorkbenchUsage, error) {
+	apps, err := s.store.ListWorkbenchAppInstances(ctx, workbench.ID)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to list app instances of workbench %v", workbench.ID))
+	}
+
+	server, err := s.client.GetWorkbenchServerUsage(workspace_model.GetWorkspaceClusterName(workbench.WorkspaceID), workbench.GetClusterName())
+	if err != nil {
+		return nil, usageError(err, fmt.Sprintf("Unable to get usage of workbench %v", workbench.ID))
+	}
+
+	usage := &model.WorkbenchUsage{
+		WorkbenchID:  workbench.ID,
+		WorkspaceID:  workbench.WorkspaceID,
+		AppInstances: make([]*model.AppInstanceUsage, 0, len(apps)),
+	}
+	usage.Server, usage.ServerHistory = resourceUsageFromK8s(server)
+	if usage.Server != nil {
+		usage.Total = usage.Total.Add(*usage.Server)
+	}
+
+	for _, app := range apps {
+		appUsage, err := s.appInstanceUsage(app)
+		if err != nil {
+			return nil, usageError(err, fmt.Sprintf("Unable to get usage of app instance %v", app.ID))
+		}
+
+		usage.AppInstances = append(usage.AppInstances, appUsage)
+		usage.Bounds = usage.Bounds.Add(appUsage.Bounds)
+		if appUsage.Current != nil {
+			usage.Total = usage.Total.Add(*appUsage.Current)
+		}
+	}
+
+	return usage, nil
+}
+
+func (s *WorkbenchService) appInstanceUsage(appInstance *model.AppInstance) (*model.AppInstanceUsage, error) {
+	podUsage, err := s.client.GetAppInstanceUsage(workspace_model.GetWorkspaceClusterName(appInstance.WorkspaceID), model.GetWorkbenchClusterName(appInstance.WorkbenchID), appInstance.ToK8sAppInstance())
+	if err != nil {
+		return nil, err
+	}
+
+	usage := &model.AppInstanceUsage{
+		AppInstanceID: appInstance.ID,
+		WorkbenchID:   appInstance.WorkbenchID,
+		WorkspaceID:   appInstance.WorkspaceID,
+		AppName:       utils.ToString(appInstance.AppName),
+		Bounds:        model.ResourceBoundsFromAppInstance(appInstance),
+	}
+	usage.Current, usage.History = resourceUsageFromK8s(podUsage)
+
+	return usage, nil
+}
+
+// resourceUsageFromK8s returns the last sample of a pod and its history, nil when the pod
+// has not been observed.
+func resourceUsageFromK8s(podUsage *k8s.PodUsage) (*model.ResourceUsage, []model.ResourceUsage) {
+	if podUsage == nil || len(podUsage.Samples) == 0 {
+		return nil, nil
+	}
+
+	history := make([]model.ResourceUsage, 0, len(podUsage.Samples))
+	for _, sample := range podUsage.Samples {
+		history = append(history, model.ResourceUsage{
+			ObservedAt:    sample.Timestamp,
+			CPUMillicores: sample.CPUMillicores,
+			MemoryBytes:   sample.MemoryBytes,
+		})
+	}
+	current := history[len(history)-1]
+
+	return &current, history
+}
+
+func usageError(err error, message string) error {
+	if errors.Is(err, k8s.ErrMetricsDisabled) {
+		return cerr.ErrInvalidRequest.Wrap(err, message+": resource usage is not collected")
+	}
+	return cerr.ErrInternal.Wrap(err, message)
+}
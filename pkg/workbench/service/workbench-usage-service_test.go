@@ -0,0 +1,105 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/k8s"
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
+)
+
+type usageStore struct {
+	watchStore
+}
+
+func (s *usageStore) GetAppInstance(_ context.Context, _, id uint64) (*model.AppInstance, error) {
+	// Like the real store, the resources of the app are not fetched with the app instance.
+	return &model.AppInstance{ID: id, WorkspaceID: s.workbench.WorkspaceID, WorkbenchID: s.workbench.ID}, nil
+}
+
+type usageK8sClient struct {
+	k8s.K8sClienter
+	server *k8s.PodUsage
+	apps   map[uint64]*k8s.PodUsage
+	err    error
+}
+
+func (c *usageK8sClient) GetWorkbenchServerUsage(namespace, workbenchName string) (*k8s.PodUsage, error) {
+	return c.server, c.err
+}
+
+func (c *usageK8sClient) GetAppInstanceUsage(namespace, workbenchName string, appInstance k8s.AppInstance) (*k8s.PodUsage, error) {
+	return c.apps[appInstance.ID], c.err
+}
+
+func newUsageStore() *usageStore {
+	return &usageStore{watchStore{hibernationStore{
+		workbench: &model.Workbench{ID: 3, TenantID: 1, WorkspaceID: 7, Status: model.WorkbenchActive},
+		apps: []*model.AppInstance{
+			{ID: 10, WorkspaceID: 7, WorkbenchID: 3, AppName: strPtr("jupyter"), AppMinCPU: strPtr("500m"), AppMaxCPU: strPtr("2"), AppMinMemory: strPtr("512Mi"), AppMaxMemory: strPtr("4Gi")},
+			{ID: 11, WorkspaceID: 7, WorkbenchID: 3, AppName: strPtr("rstudio"), AppMaxCPU: strPtr("1")},
+		},
+	}}}
+}
+
+func TestGetAppInstanceUsage_ReportsUsageAgainstAppBounds(t *testing.T) {
+	now := time.Now()
+	client := &usageK8sClient{apps: map[uint64]*k8s.PodUsage{
+		10: {PodName: "workbench3-app-instance-10", Samples: []k8s.PodUsageSample{
+			{Timestamp: now.Add(-time.Minute), CPUMillicores: 300, MemoryBytes: 1 << 29},
+			{Timestamp: now, CPUMillicores: 1900, MemoryBytes: 1 << 30},
+		}},
+	}}
+	svc := &WorkbenchService{store: newUsageStore(), client: client}
+
+	usage, err := svc.GetAppInstanceUsage(context.Background(), 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, "jupyter", usage.AppName)
+	assert.Equal(t, model.ResourceBounds{MinCPUMillicores: 500, MaxCPUMillicores: 2000, MinMemoryBytes: 512 << 20, MaxMemoryBytes: 4 << 30}, usage.Bounds)
+	require.NotNil(t, usage.Current)
+	assert.Equal(t, int64(1900), usage.Current.CPUMillicores)
+	assert.Len(t, usage.History, 2)
+	assert.True(t, usage.CPUThrottled())
+}
+
+func TestGetWorkspaceUsage_AggregatesWorkbenches(t *testing.T) {
+	now := time.Now()
+	client := &usageK8sClient{
+		server: &k8s.PodUsage{Samples: []k8s.PodUsageSample{{Timestamp: now, CPUMillicores: 100, MemoryBytes: 100}}},
+		apps: map[uint64]*k8s.PodUsage{
+			10: {Samples: []k8s.PodUsageSample{{Timestamp: now, CPUMillicores: 200, MemoryBytes: 200}}},
+		},
+	}
+	svc := &WorkbenchService{store: newUsageStore(), client: client}
+
+	usage, err := svc.GetWorkspaceUsage(context.Background(), 1, 7)
+	require.NoError(t, err)
+	require.Len(t, usage.Workbenches, 1)
+
+	workbench := usage.Workbenches[0]
+	require.Len(t, workbench.AppInstances, 2)
+	// The second app instance has not been observed yet.
+	assert.Nil(t, workbench.AppInstances[1].Current)
+	assert.False(t, workbench.AppInstances[1].CPUThrottled())
+
+	assert.Equal(t, int64(300), usage.Total.CPUMillicores)
+	assert.Equal(t, int64(300), usage.Total.MemoryBytes)
+	assert.Equal(t, int64(3000), usage.Bounds.MaxCPUMillicores)
+	assert.Equal(t, int64(500), usage.Bounds.MinCPUMillicores)
+}
+
+func TestGetWorkbenchUsage_MetricsDisabled(t *testing.T) {
+	svc := &WorkbenchService{store: newUsageStore(), client: &usageK8sClient{err: k8s.ErrMetricsDisabled}}
+
+	_, err := svc.GetWorkbenchUsage(context.Background(), 1, 3)
+	var cErr *cerr.ChorusError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, cerr.ErrInvalidRequest.ChorusCode, cErr.ChorusCode)
+}
@@ -0,0 +1,233 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
+
+	"go.uber.org/zap"
+)
+
+// workbenchWatchBufferSize is the number of events kept for a watcher that does not
+// read fast enough; past it the oldest events are dropped, as the next ones carry the
+// full status anyway.
+const workbenchWatchBufferSize = 16
+
+// workbenchWatchHub fans out the status events observed by this instance to the clients
+// watching a workbench or a whole workspace.
+type workbenchWatchHub struct {
+	mu      sync.Mutex
+	watches map[*workbenchWatch]struct{}
+}
+
+// workbenchWatch is a client watching either a single workbench or, when workbenchID is
+// zero, all the workbenches of a workspace.
+type workbenchWatch struct {
+	tenantID    uint64
+	workspaceID uint64
+	workbenchID uint64
+
+	events chan *model.WorkbenchStatusEvent
+	// last holds the last event sent for each workbench, so that only transitions are sent.
+	last map[uint64]*model.WorkbenchStatusEvent
+}
+
+func newWorkbenchWatchHub() *workbenchWatchHub {
+	return &workbenchWatchHub{watches: make(map[*workbenchWatch]struct{})}
+}
+
+func (w *workbenchWatch) matches(tenantID, workspaceID, workbenchID uint64) bool {
+	if w.tenantID != tenantID || w.workspaceID != workspaceID {
+		return false
+	}
+	return w.workbenchID == 0 || w.workbenchID == workbenchID
+}
+
+// send queues an event unless it carries the same statuses as the last one sent for the
+// workbench. The caller must hold the hub lock.
+func (w *workbenchWatch) send(event *model.WorkbenchStatusEvent) {
+	id := event.Workbench.ID
+	if event.SameStatusAs(w.last[id]) {
+		return
+	}
+	w.last[id] = event
+
+	for {
+		select {
+		case w.events <- event:
+			return
+		default:
+		}
+
+		select {
+		case <-w.events:
+		default:
+		}
+	}
+}
+
+func (h *workbenchWatchHub) subscribe(tenantID, workspaceID, workbenchID uint64) *workbenchWatch {
+	w := &workbenchWatch{
+		tenantID:    tenantID,
+		workspaceID: workspaceID,
+		workbenchID: workbenchID,
+		events:      make(chan *model.WorkbenchStatusEvent, workbenchWatchBufferSize),
+		last:        make(map[uint64]*model.WorkbenchStatusEvent),
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.watches[w] = struct{}{}
+
+	return w
+}
+
+// unsubscribe removes a watch and closes its channel.
+func (h *workbenchWatchHub) unsubscribe(w *workbenchWatch) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.watches[w]; !ok {
+		return
+	}
+	delete(h.watches, w)
+	close(w.events)
+}
+
+// watching reports whether a client watches the given workbench, so that events are only
+// built when someone reads them.
+func (h *workbenchWatchHub) watching(tenantID, workspaceID, workbenchID uint64) bool {
+	if h == nil {
+		return false
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for w := range h.watches {
+		if w.matches(tenantID, workspaceID, workbenchID) {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *workbenchWatchHub) publish(event *model.WorkbenchStatusEvent) {
+	if h == nil {
+		return
+	}
+
+	wb := event.Workbench
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for w := range h.watches {
+		if w.matches(wb.TenantID, wb.WorkspaceID, wb.ID) {
+			w.send(event)
+		}
+	}
+}
+
+// sendSnapshot queues the current status of a workbench for a new watch, unless an event
+// more recent than the snapshot has been published to it in the meantime.
+func (h *workbenchWatchHub) sendSnapshot(w *workbenchWatch, event *model.WorkbenchStatusEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.watches[w]; !ok {
+		return
+	}
+	if _, seen := w.last[event.Workbench.ID]; seen {
+		return
+	}
+	w.send(event)
+}
+
+// WatchWorkbench streams the status of a workbench and of its app instances: the current
+// one first, then every transition observed until the context is done, at which point
+// the channel is closed.
+func (s *WorkbenchService) WatchWorkbench(ctx context.Context, tenantID, workbenchID uint64) (<-chan *model.WorkbenchStatusEvent, error) {
+	workbench, err := s.store.GetWorkbench(ctx, tenantID, workbenchID)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to get workbench %v", workbenchID))
+	}
+
+	w := s.watchHub.subscribe(tenantID, workbench.WorkspaceID, workbenchID)
+
+	event, err := s.workbenchStatusEvent(ctx, workbench)
+	if err != nil {
+		s.watchHub.unsubscribe(w)
+		return nil, err
+	}
+	s.watchHub.sendSnapshot(w, event)
+
+	go s.unsubscribeWhenDone(ctx, w)
+
+	return w.events, nil
+}
+
+// WatchWorkspace streams the status of all the workbenches of a workspace, like
+// WatchWorkbench does for a single one.
+func (s *WorkbenchService) WatchWorkspace(ctx context.Context, tenantID, workspaceID uint64) (<-chan *model.WorkbenchStatusEvent, error) {
+	if _, err := s.workspaceReader.GetWorkspace(ctx, tenantID, workspaceID); err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to get workspace %v", workspaceID))
+	}
+
+	w := s.watchHub.subscribe(tenantID, workspaceID, 0)
+
+	workbenches, _, err := s.store.ListWorkbenches(ctx, tenantID, nil, &[]uint64{workspaceID})
+	if err != nil {
+		s.watchHub.unsubscribe(w)
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to list workbenches of workspace %v", workspaceID))
+	}
+	for _, workbench := range workbenches {
+		event, err := s.workbenchStatusEvent(ctx, workbench)
+		if err != nil {
+			s.watchHub.unsubscribe(w)
+			return nil, err
+		}
+		s.watchHub.sendSnapshot(w, event)
+	}
+
+	go s.unsubscribeWhenDone(ctx, w)
+
+	return w.events, nil
+}
+
+func (s *WorkbenchService) unsubscribeWhenDone(ctx context.Context, w *workbenchWatch) {
+	<-ctx.Done()
+	s.watchHub.unsubscribe(w)
+}
+
+func (s *WorkbenchService) workbenchStatusEvent(ctx context.Context, workbench *model.Workbench) (*model.WorkbenchStatusEvent, error) {
+	apps, err := s.store.ListWorkbenchAppInstances(ctx, workbench.ID)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to list app instances of workbench %v", workbench.ID))
+	}
+
+	return &model.WorkbenchStatusEvent{
+		Workbench:    workbench,
+		AppInstances: apps,
+		ObservedAt:   time.Now(),
+	}, nil
+}
+
+// publishWorkbenchStatus pushes the status of a workbench, as just stored, to the clients
+// watching it.
+func (s *WorkbenchService) publishWorkbenchStatus(ctx context.Context, workbench *model.Workbench) {
+	if !s.watchHub.watching(workbench.TenantID, workbench.WorkspaceID, workbench.ID) {
+		return
+	}
+
+	event, err := s.workbenchStatusEvent(ctx, workbench)
+	if err != nil {
+		logger.TechLog.Error(ctx, "unable to build workbench status event", zap.Error(err), zap.Uint64("workbenchID", workbench.ID))
+		return
+	}
+	s.watchHub.publish(event)
+}
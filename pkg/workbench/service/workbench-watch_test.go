@@ -0,0 +1,140 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/client/k8s"
+	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
+)
+
+type watchStore struct {
+	hibernationStore
+}
+
+func (s *watchStore) ListWorkbenches(_ context.Context, _ uint64, _ *common_model.Pagination, _ *[]uint64) ([]*model.Workbench, *common_model.PaginationResult, error) {
+	wb := *s.workbench
+	return []*model.Workbench{&wb}, nil, nil
+}
+
+func (s *watchStore) UpdateWorkbenchScheduleStoppedAt(_ context.Context, _, _ uint64, _ *time.Time) error {
+	return nil
+}
+
+func newWatchSvc(store WorkbenchStore) *WorkbenchService {
+	svc := newHibernationSvc(store, &recordingK8sClient{K8sClienter: k8s.NewTestClient()})
+	svc.watchHub = newWorkbenchWatchHub()
+	return svc
+}
+
+func receiveEvent(t *testing.T, events <-chan *model.WorkbenchStatusEvent) *model.WorkbenchStatusEvent {
+	t.Helper()
+	select {
+	case event, ok := <-events:
+		require.True(t, ok, "channel closed")
+		return event
+	case <-time.After(time.Second):
+		require.FailNow(t, "no event received")
+		return nil
+	}
+}
+
+func TestWatchWorkbench_SendsSnapshotThenTransitions(t *testing.T) {
+	store := &watchStore{hibernationStore{
+		workbench: &model.Workbench{ID: 3, TenantID: 1, WorkspaceID: 7, Status: model.WorkbenchActive, ServerPodStatus: model.WorkbenchServerPodStatusReady},
+		apps:      []*model.AppInstance{{ID: 10, WorkbenchID: 3, K8sStatus: model.K8sAppInstanceStatusRunning}},
+	}}
+	svc := newWatchSvc(store)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events, err := svc.WatchWorkbench(ctx, 1, 3)
+	require.NoError(t, err)
+
+	snapshot := receiveEvent(t, events)
+	assert.Equal(t, model.WorkbenchActive, snapshot.Workbench.Status)
+	require.Len(t, snapshot.AppInstances, 1)
+
+	_, err = svc.HibernateWorkbench(context.Background(), 1, 3)
+	require.NoError(t, err)
+
+	hibernated := receiveEvent(t, events)
+	assert.Equal(t, model.WorkbenchHibernated, hibernated.Workbench.Status)
+	assert.Equal(t, model.WorkbenchServerPodStatusTerminated, hibernated.Workbench.ServerPodStatus)
+
+	// The same status observed again is not a transition.
+	svc.publishWorkbenchStatus(context.Background(), store.workbench)
+	assert.Empty(t, events)
+
+	cancel()
+	select {
+	case _, ok := <-events:
+		assert.False(t, ok)
+	case <-time.After(time.Second):
+		require.FailNow(t, "channel not closed")
+	}
+}
+
+func TestWatchWorkbench_SendsScheduledStop(t *testing.T) {
+	store := &watchStore{hibernationStore{
+		workbench: &model.Workbench{ID: 3, TenantID: 1, WorkspaceID: 7, Status: model.WorkbenchActive, ServerPodStatus: model.WorkbenchServerPodStatusReady},
+	}}
+	svc := newWatchSvc(store)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events, err := svc.WatchWorkbench(ctx, 1, 3)
+	require.NoError(t, err)
+	receiveEvent(t, events)
+
+	require.NoError(t, svc.StopScheduledWorkbench(context.Background(), 1, 3))
+
+	stopped := receiveEvent(t, events)
+	assert.Equal(t, model.WorkbenchInactive, stopped.Workbench.Status)
+	assert.Equal(t, model.WorkbenchServerPodStatusTerminated, stopped.Workbench.ServerPodStatus)
+}
+
+func TestWatchWorkspace_OnlyForwardsItsWorkbenches(t *testing.T) {
+	store := &watchStore{hibernationStore{
+		workbench: &model.Workbench{ID: 3, TenantID: 1, WorkspaceID: 7, Status: model.WorkbenchActive},
+	}}
+	svc := newWatchSvc(store)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events, err := svc.WatchWorkspace(ctx, 1, 7)
+	require.NoError(t, err)
+
+	snapshot := receiveEvent(t, events)
+	assert.Equal(t, uint64(3), snapshot.Workbench.ID)
+
+	svc.publishWorkbenchStatus(context.Background(), &model.Workbench{ID: 4, TenantID: 1, WorkspaceID: 8, Status: model.WorkbenchActive})
+	svc.publishWorkbenchStatus(context.Background(), &model.Workbench{ID: 5, TenantID: 2, WorkspaceID: 7, Status: model.WorkbenchActive})
+	assert.Empty(t, events)
+
+	svc.publishWorkbenchStatus(context.Background(), &model.Workbench{ID: 6, TenantID: 1, WorkspaceID: 7, Status: model.WorkbenchActive})
+	assert.Equal(t, uint64(6), receiveEvent(t, events).Workbench.ID)
+}
+
+func TestWorkbenchWatchHub_DropsOldestEventsOfSlowWatchers(t *testing.T) {
+	hub := newWorkbenchWatchHub()
+	w := hub.subscribe(1, 7, 0)
+
+	for i := 0; i < workbenchWatchBufferSize+2; i++ {
+		hub.publish(&model.WorkbenchStatusEvent{
+			Workbench: &model.Workbench{ID: uint64(i + 1), TenantID: 1, WorkspaceID: 7},
+		})
+	}
+
+	require.Len(t, w.events, workbenchWatchBufferSize)
+	assert.Equal(t, uint64(3), (<-w.events).Workbench.ID)
+
+	hub.unsubscribe(w)
+	assert.False(t, hub.watching(1, 7, 1))
+}
@@ -68,12 +68,11 @@ func (c workbenchStorageLogging) ListWorkbenchAppInstances(ctx context.Context,
 	return res, nil
 }
 
-func (c workbenchStorageLogging) DeleteIdleWorkbenches(ctx context.Context, idleTimeout time.Duration) ([]*model.Workbench, error) {
+func (c workbenchStorageLogging) ListWorkbenchActivities(ctx context.Context) ([]*model.WorkbenchActivity, error) {
 	c.logger.Debug(ctx, "request started")
-
 	now := time.Now()
 
-	res, err := c.next.DeleteIdleWorkbenches(ctx, idleTimeout)
+	res, err := c.next.ListWorkbenchActivities(ctx)
 	if err != nil {
 		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
 			zap.Error(err),
@@ -89,6 +88,69 @@ func (c workbenchStorageLogging) DeleteIdleWorkbenches(ctx context.Context, idle
 	return res, nil
 }
 
+func (c workbenchStorageLogging) MarkWorkbenchIdleWarned(ctx context.Context, tenantID, workbenchID uint64) error {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	err := c.next.MarkWorkbenchIdleWarned(ctx, tenantID, workbenchID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkbenchIDField(workbenchID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithWorkbenchIDField(workbenchID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c workbenchStorageLogging) KeepWorkbenchAlive(ctx context.Context, tenantID, workbenchID uint64) error {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	err := c.next.KeepWorkbenchAlive(ctx, tenantID, workbenchID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkbenchIDField(workbenchID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithWorkbenchIDField(workbenchID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c workbenchStorageLogging) DeleteIdleWorkbench(ctx context.Context, tenantID, workbenchID uint64, lastActivityAt time.Time) (*model.Workbench, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	res, err := c.next.DeleteIdleWorkbench(ctx, tenantID, workbenchID, lastActivityAt)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkbenchIDField(workbenchID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithWorkbenchIDField(workbenchID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
 func (c workbenchStorageLogging) ListAllWorkbenches(ctx context.Context) ([]*model.Workbench, error) {
 	c.logger.Debug(ctx, "request started")
 
@@ -229,12 +291,12 @@ func (c workbenchStorageLogging) UpdateWorkbenchStatus(ctx context.Context, tena
 	return updatedWorkbench, nil
 }
 
-func (c workbenchStorageLogging) CreateWorkbench(ctx context.Context, tenantID uint64, workbench *model.Workbench) (*model.Workbench, error) {
+func (c workbenchStorageLogging) CreateWorkbench(ctx context.Context, tenantID uint64, workbench *model.Workbench, maxPerUser uint32) (*model.Workbench, error) {
 	c.logger.Debug(ctx, "request started")
 
 	now := time.Now()
 
-	newWorkbench, err := c.next.CreateWorkbench(ctx, tenantID, workbench)
+	newWorkbench, err := c.next.CreateWorkbench(ctx, tenantID, workbench, maxPerUser)
 	if err != nil {
 		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
 			zap.Error(err),
@@ -350,6 +412,28 @@ func (c workbenchStorageLogging) UpdateAppInstance(ctx context.Context, tenantID
 	return updatedAppInstance, nil
 }
 
+func (c workbenchStorageLogging) UpdateAppInstanceVersion(ctx context.Context, tenantID uint64, appInstanceID uint64, appVersionID uint64, imageDigest string) (*model.AppInstance, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	updatedAppInstance, err := c.next.UpdateAppInstanceVersion(ctx, tenantID, appInstanceID, appVersionID, imageDigest)
+	if err != nil {
+		c.logger.Error(ctx, "request completed",
+			logger.WithAppInstanceIDField(appInstanceID),
+			zap.Uint64("app_version_id", appVersionID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+	c.logger.Debug(ctx, "request completed",
+		logger.WithAppInstanceIDField(appInstanceID),
+		zap.Uint64("app_version_id", appVersionID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return updatedAppInstance, nil
+}
+
 func (c workbenchStorageLogging) UpdateAppInstances(ctx context.Context, tenantID uint64, appInstances []*model.AppInstance) error {
 	c.logger.Debug(ctx, "request started")
 	now := time.Now()
@@ -368,12 +452,12 @@ func (c workbenchStorageLogging) UpdateAppInstances(ctx context.Context, tenantI
 	return nil
 }
 
-func (c workbenchStorageLogging) CreateAppInstance(ctx context.Context, tenantID uint64, appInstance *model.AppInstance) (*model.AppInstance, error) {
+func (c workbenchStorageLogging) CreateAppInstance(ctx context.Context, tenantID uint64, appInstance *model.AppInstance, maxPerUser uint32) (*model.AppInstance, error) {
 	c.logger.Debug(ctx, "request started")
 
 	now := time.Now()
 
-	newAppInstance, err := c.next.CreateAppInstance(ctx, tenantID, appInstance)
+	newAppInstance, err := c.next.CreateAppInstance(ctx, tenantID, appInstance, maxPerUser)
 	if err != nil {
 		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
 			zap.Error(err),
@@ -388,3 +472,385 @@ func (c workbenchStorageLogging) CreateAppInstance(ctx context.Context, tenantID
 	)
 	return newAppInstance, nil
 }
+
+func (c workbenchStorageLogging) GetWorkbenchSchedule(ctx context.Context, tenantID, workbenchID uint64) (*model.WorkbenchSchedule, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	res, err := c.next.GetWorkbenchSchedule(ctx, tenantID, workbenchID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkbenchIDField(workbenchID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithWorkbenchIDField(workbenchID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workbenchStorageLogging) ListWorkbenchSchedules(ctx context.Context) ([]*model.WorkbenchSchedule, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	res, err := c.next.ListWorkbenchSchedules(ctx)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithCountField(len(res)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workbenchStorageLogging) UpsertWorkbenchSchedule(ctx context.Context, tenantID uint64, schedule *model.WorkbenchSchedule) (*model.WorkbenchSchedule, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	res, err := c.next.UpsertWorkbenchSchedule(ctx, tenantID, schedule)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkbenchIDField(schedule.WorkbenchID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithWorkbenchIDField(schedule.WorkbenchID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workbenchStorageLogging) UpdateWorkbenchScheduleStoppedAt(ctx context.Context, tenantID, workbenchID uint64, stoppedAt *time.Time) error {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	err := c.next.UpdateWorkbenchScheduleStoppedAt(ctx, tenantID, workbenchID, stoppedAt)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkbenchIDField(workbenchID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithWorkbenchIDField(workbenchID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c workbenchStorageLogging) DeleteWorkbenchSchedule(ctx context.Context, tenantID, workbenchID uint64) error {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	err := c.next.DeleteWorkbenchSchedule(ctx, tenantID, workbenchID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkbenchIDField(workbenchID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithWorkbenchIDField(workbenchID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c workbenchStorageLogging) GetWorkbenchHibernation(ctx context.Context, tenantID, workbenchID uint64) (*model.WorkbenchHibernation, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	res, err := c.next.GetWorkbenchHibernation(ctx, tenantID, workbenchID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkbenchIDField(workbenchID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithWorkbenchIDField(workbenchID),
+		logger.WithCountField(len(res.AppInstances)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workbenchStorageLogging) CreateWorkbenchHibernation(ctx context.Context, tenantID uint64, hibernation *model.WorkbenchHibernation) (*model.WorkbenchHibernation, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	res, err := c.next.CreateWorkbenchHibernation(ctx, tenantID, hibernation)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkbenchIDField(hibernation.WorkbenchID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithWorkbenchIDField(hibernation.WorkbenchID),
+		logger.WithCountField(len(res.AppInstances)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workbenchStorageLogging) DeleteWorkbenchHibernation(ctx context.Context, tenantID, workbenchID uint64) error {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	err := c.next.DeleteWorkbenchHibernation(ctx, tenantID, workbenchID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkbenchIDField(workbenchID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithWorkbenchIDField(workbenchID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c workbenchStorageLogging) GetWorkbenchShareGrant(ctx context.Context, tenantID, grantID uint64) (*model.WorkbenchShareGrant, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	res, err := c.next.GetWorkbenchShareGrant(ctx, tenantID, grantID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Uint64("grantID", grantID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		zap.Uint64("grantID", grantID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workbenchStorageLogging) ListWorkbenchShareGrants(ctx context.Context, tenantID, workbenchID uint64) ([]*model.WorkbenchShareGrant, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	res, err := c.next.ListWorkbenchShareGrants(ctx, tenantID, workbenchID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkbenchIDField(workbenchID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithWorkbenchIDField(workbenchID),
+		logger.WithCountField(len(res)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workbenchStorageLogging) ListUserWorkbenchShareGrants(ctx context.Context, tenantID, workbenchID, userID uint64) ([]*model.WorkbenchShareGrant, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	res, err := c.next.ListUserWorkbenchShareGrants(ctx, tenantID, workbenchID, userID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkbenchIDField(workbenchID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithWorkbenchIDField(workbenchID),
+		logger.WithCountField(len(res)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workbenchStorageLogging) ListWorkbenchShareGrantsToEnd(ctx context.Context) ([]*model.WorkbenchShareGrant, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	res, err := c.next.ListWorkbenchShareGrantsToEnd(ctx)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithCountField(len(res)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workbenchStorageLogging) CreateWorkbenchShareGrant(ctx context.Context, tenantID uint64, grant *model.WorkbenchShareGrant) (*model.WorkbenchShareGrant, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	res, err := c.next.CreateWorkbenchShareGrant(ctx, tenantID, grant)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkbenchIDField(grant.WorkbenchID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithWorkbenchIDField(grant.WorkbenchID),
+		logger.WithCountField(len(res.Members)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workbenchStorageLogging) EndWorkbenchShareGrant(ctx context.Context, tenantID, grantID uint64, status model.WorkbenchShareGrantStatus) error {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	err := c.next.EndWorkbenchShareGrant(ctx, tenantID, grantID, status)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Uint64("grantID", grantID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		zap.Uint64("grantID", grantID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c workbenchStorageLogging) RemoveWorkbenchShareGrantMember(ctx context.Context, grantID, userID uint64) error {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	err := c.next.RemoveWorkbenchShareGrantMember(ctx, grantID, userID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Uint64("grantID", grantID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		zap.Uint64("grantID", grantID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c workbenchStorageLogging) CreateWorkbenchConnection(ctx context.Context, tenantID uint64, connection *model.WorkbenchConnection) (*model.WorkbenchConnection, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	res, err := c.next.CreateWorkbenchConnection(ctx, tenantID, connection)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkbenchIDField(connection.WorkbenchID),
+			logger.WithUserIDField(connection.UserID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithWorkbenchIDField(connection.WorkbenchID),
+		logger.WithUserIDField(connection.UserID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workbenchStorageLogging) UpdateWorkbenchConnections(ctx context.Context, connections []*model.WorkbenchConnection) error {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	err := c.next.UpdateWorkbenchConnections(ctx, connections)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithCountField(len(connections)),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithCountField(len(connections)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c workbenchStorageLogging) ListWorkbenchConnections(ctx context.Context, tenantID uint64, pagination *common_model.Pagination, filter model.WorkbenchConnectionFilter) ([]*model.WorkbenchConnection, *common_model.PaginationResult, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	res, paginationRes, err := c.next.ListWorkbenchConnections(ctx, tenantID, pagination, filter)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithCountField(len(res)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, paginationRes, nil
+}
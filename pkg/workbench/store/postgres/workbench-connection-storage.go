@@ -0,0 +1,112 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
+	common_storage "github.com/CHORUS-TRE/chorus-backend/pkg/common/storage"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
+)
+
+// CreateWorkbenchConnection records a connection that has just been opened.
+func (s *WorkbenchStorage) CreateWorkbenchConnection(ctx context.Context, tenantID uint64, connection *model.WorkbenchConnection) (*model.WorkbenchConnection, error) {
+	const query = `
+		INSERT INTO workbench_connections (tenantid, workbenchid, workspaceid, userid,
+		    clientip, useragent, startedat, lastseenat)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $7)
+		RETURNING id, tenantid, workbenchid, workspaceid, userid, clientip, useragent,
+		    bytesin, bytesout, startedat, lastseenat, endedat;
+	`
+
+	var created model.WorkbenchConnection
+	err := s.db.GetContext(ctx, &created, query,
+		tenantID, connection.WorkbenchID, connection.WorkspaceID, connection.UserID,
+		connection.ClientIP, connection.UserAgent, connection.StartedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create workbench connection: %w", err)
+	}
+
+	return &created, nil
+}
+
+// UpdateWorkbenchConnections saves the bytes transferred by connections and their end
+// time once they are closed, and marks them as seen.
+func (s *WorkbenchStorage) UpdateWorkbenchConnections(ctx context.Context, connections []*model.WorkbenchConnection) error {
+	const query = `
+		UPDATE workbench_connections
+		SET bytesin = $3, bytesout = $4, endedat = $5, lastseenat = COALESCE($5, NOW())
+		WHERE tenantid = $1 AND id = $2 AND endedat IS NULL;
+	`
+
+	for _, c := range connections {
+		if _, err := s.db.ExecContext(ctx, query, c.TenantID, c.ID, c.BytesIn, c.BytesOut, c.EndedAt); err != nil {
+			return fmt.Errorf("unable to update workbench connection %v: %w", c.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *WorkbenchStorage) ListWorkbenchConnections(ctx context.Context, tenantID uint64, pagination *common_model.Pagination, filter model.WorkbenchConnectionFilter) ([]*model.WorkbenchConnection, *common_model.PaginationResult, error) {
+	args := []interface{}{tenantID}
+	clauses := []string{"tenantid = $1"}
+
+	if filter.WorkbenchID != 0 {
+		args = append(args, filter.WorkbenchID)
+		clauses = append(clauses, fmt.Sprintf("workbenchid = $%d", len(args)))
+	}
+	if filter.WorkspaceID != 0 {
+		args = append(args, filter.WorkspaceID)
+		clauses = append(clauses, fmt.Sprintf("workspaceid = $%d", len(args)))
+	}
+	if filter.UserID != 0 {
+		args = append(args, filter.UserID)
+		clauses = append(clauses, fmt.Sprintf("userid = $%d", len(args)))
+	}
+	if !filter.From.IsZero() {
+		// Connections never closed are bounded by the last time they were seen.
+		args = append(args, filter.From)
+		clauses = append(clauses, fmt.Sprintf("COALESCE(endedat, lastseenat) >= $%d", len(args)))
+	}
+	if !filter.To.IsZero() {
+		args = append(args, filter.To)
+		clauses = append(clauses, fmt.Sprintf("startedat <= $%d", len(args)))
+	}
+	where := strings.Join(clauses, " AND ")
+
+	var totalCount int64
+	if err := s.db.GetContext(ctx, &totalCount, "SELECT COUNT(*) FROM workbench_connections WHERE "+where, args...); err != nil {
+		return nil, nil, fmt.Errorf("unable to get count: %w", err)
+	}
+
+	query := `
+		SELECT id, tenantid, workbenchid, workspaceid, userid, clientip, useragent,
+		    bytesin, bytesout, startedat, lastseenat, endedat
+		FROM workbench_connections
+		WHERE ` + where
+
+	clause, validatedPagination := common_storage.BuildPaginationClause(pagination, model.WorkbenchConnection{})
+	if !strings.Contains(clause, "ORDER BY") {
+		query += " ORDER BY startedat DESC, id DESC"
+	}
+	query += clause
+
+	paginationRes := &common_model.PaginationResult{
+		Total: uint64(totalCount),
+	}
+	if validatedPagination != nil {
+		paginationRes.Limit = validatedPagination.Limit
+		paginationRes.Offset = validatedPagination.Offset
+		paginationRes.Sort = validatedPagination.Sort
+	}
+
+	var connections []*model.WorkbenchConnection
+	if err := s.db.SelectContext(ctx, &connections, query, args...); err != nil {
+		return nil, nil, fmt.Errorf("unable to list workbench connections: %w", err)
+	}
+
+	return connections, paginationRes, nil
+}
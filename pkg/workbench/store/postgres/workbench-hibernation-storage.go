@@ -0,0 +1,103 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
+)
+
+const workbenchHibernationColumns = `workbenchid, tenantid, initialresolutionwidth, initialresolutionheight, appinstances, createdat`
+
+// workbenchHibernationRow is the database representation of a workbench hibernation,
+// whose app instances are stored as JSON.
+type workbenchHibernationRow struct {
+	WorkbenchID             uint64    `db:"workbenchid"`
+	TenantID                uint64    `db:"tenantid"`
+	InitialResolutionWidth  uint32    `db:"initialresolutionwidth"`
+	InitialResolutionHeight uint32    `db:"initialresolutionheight"`
+	AppInstances            []byte    `db:"appinstances"`
+	CreatedAt               time.Time `db:"createdat"`
+}
+
+func (r workbenchHibernationRow) toModel() (*model.WorkbenchHibernation, error) {
+	var apps []model.HibernatedAppInstance
+	if err := json.Unmarshal(r.AppInstances, &apps); err != nil {
+		return nil, fmt.Errorf("invalid app instances of hibernated workbench %v: %w", r.WorkbenchID, err)
+	}
+
+	return &model.WorkbenchHibernation{
+		WorkbenchID:             r.WorkbenchID,
+		TenantID:                r.TenantID,
+		InitialResolutionWidth:  r.InitialResolutionWidth,
+		InitialResolutionHeight: r.InitialResolutionHeight,
+		AppInstances:            apps,
+		CreatedAt:               r.CreatedAt,
+	}, nil
+}
+
+func (s *WorkbenchStorage) GetWorkbenchHibernation(ctx context.Context, tenantID, workbenchID uint64) (*model.WorkbenchHibernation, error) {
+	const query = `
+		SELECT ` + workbenchHibernationColumns + `
+		FROM workbench_hibernations
+		WHERE tenantid = $1 AND workbenchid = $2;
+	`
+
+	var row workbenchHibernationRow
+	if err := s.db.GetContext(ctx, &row, query, tenantID, workbenchID); err != nil {
+		return nil, err
+	}
+
+	return row.toModel()
+}
+
+// CreateWorkbenchHibernation records what a workbench is running before it is
+// hibernated, replacing any leftover record of a previous hibernation.
+func (s *WorkbenchStorage) CreateWorkbenchHibernation(ctx context.Context, tenantID uint64, hibernation *model.WorkbenchHibernation) (*model.WorkbenchHibernation, error) {
+	apps, err := json.Marshal(hibernation.AppInstances)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal app instances: %w", err)
+	}
+
+	const query = `
+		INSERT INTO workbench_hibernations (workbenchid, tenantid, initialresolutionwidth, initialresolutionheight, appinstances)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (workbenchid) DO UPDATE
+		SET initialresolutionwidth = EXCLUDED.initialresolutionwidth, initialresolutionheight = EXCLUDED.initialresolutionheight,
+		    appinstances = EXCLUDED.appinstances, createdat = NOW()
+		WHERE workbench_hibernations.tenantid = EXCLUDED.tenantid
+		RETURNING ` + workbenchHibernationColumns + `;
+	`
+
+	var row workbenchHibernationRow
+	err = s.db.GetContext(ctx, &row, query, hibernation.WorkbenchID, tenantID, hibernation.InitialResolutionWidth, hibernation.InitialResolutionHeight, apps)
+	if err != nil {
+		return nil, err
+	}
+
+	return row.toModel()
+}
+
+func (s *WorkbenchStorage) DeleteWorkbenchHibernation(ctx context.Context, tenantID, workbenchID uint64) error {
+	const query = `
+		DELETE FROM workbench_hibernations
+		WHERE tenantid = $1 AND workbenchid = $2;
+	`
+
+	rows, err := s.db.ExecContext(ctx, query, tenantID, workbenchID)
+	if err != nil {
+		return fmt.Errorf("unable to exec: %w", err)
+	}
+	affected, err := rows.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("unable to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return cerr.ErrNoRowsDeleted
+	}
+
+	return nil
+}
@@ -0,0 +1,166 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
+)
+
+const workbenchScheduleColumns = `workbenchid, tenantid, timezone, weekdays, starttime, stoptime, stoppedat, createdat, updatedat`
+
+// workbenchScheduleRow is the database representation of a workbench schedule, whose
+// weekdays are stored by name.
+type workbenchScheduleRow struct {
+	WorkbenchID uint64         `db:"workbenchid"`
+	TenantID    uint64         `db:"tenantid"`
+	Timezone    string         `db:"timezone"`
+	Weekdays    pq.StringArray `db:"weekdays"`
+	StartTime   string         `db:"starttime"`
+	StopTime    string         `db:"stoptime"`
+	StoppedAt   *time.Time     `db:"stoppedat"`
+	CreatedAt   time.Time      `db:"createdat"`
+	UpdatedAt   time.Time      `db:"updatedat"`
+}
+
+func (r workbenchScheduleRow) toModel() (*model.WorkbenchSchedule, error) {
+	weekdays := make([]time.Weekday, 0, len(r.Weekdays))
+	for _, name := range r.Weekdays {
+		day, err := model.ToWeekday(name)
+		if err != nil {
+			return nil, err
+		}
+		weekdays = append(weekdays, day)
+	}
+
+	return &model.WorkbenchSchedule{
+		WorkbenchID: r.WorkbenchID,
+		TenantID:    r.TenantID,
+		Timezone:    r.Timezone,
+		Weekdays:    weekdays,
+		StartTime:   r.StartTime,
+		StopTime:    r.StopTime,
+		StoppedAt:   r.StoppedAt,
+		CreatedAt:   r.CreatedAt,
+		UpdatedAt:   r.UpdatedAt,
+	}, nil
+}
+
+func weekdayNames(weekdays []time.Weekday) pq.StringArray {
+	names := make(pq.StringArray, 0, len(weekdays))
+	for _, day := range weekdays {
+		names = append(names, model.WeekdayName(day))
+	}
+	return names
+}
+
+func (s *WorkbenchStorage) GetWorkbenchSchedule(ctx context.Context, tenantID, workbenchID uint64) (*model.WorkbenchSchedule, error) {
+	query := `
+		SELECT ` + workbenchScheduleColumns + `
+		FROM workbench_schedules
+		WHERE tenantid = $1 AND workbenchid = $2;
+	`
+
+	var row workbenchScheduleRow
+	if err := s.db.GetContext(ctx, &row, query, tenantID, workbenchID); err != nil {
+		return nil, err
+	}
+
+	return row.toModel()
+}
+
+// ListWorkbenchSchedules returns the schedules of the workbenches that are neither
+// deleted nor hibernated, across all tenants.
+func (s *WorkbenchStorage) ListWorkbenchSchedules(ctx context.Context) ([]*model.WorkbenchSchedule, error) {
+	const query = `
+		SELECT ws.workbenchid, ws.tenantid, ws.timezone, ws.weekdays, ws.starttime, ws.stoptime, ws.stoppedat, ws.createdat, ws.updatedat
+		FROM workbench_schedules ws
+		JOIN workbenches w ON w.id = ws.workbenchid
+		WHERE w.status NOT IN ('deleted', 'hibernated') AND w.deletedat IS NULL
+		ORDER BY ws.workbenchid;
+	`
+
+	var rows []workbenchScheduleRow
+	if err := s.db.SelectContext(ctx, &rows, query); err != nil {
+		return nil, err
+	}
+
+	schedules := make([]*model.WorkbenchSchedule, 0, len(rows))
+	for _, row := range rows {
+		schedule, err := row.toModel()
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule of workbench %v: %w", row.WorkbenchID, err)
+		}
+		schedules = append(schedules, schedule)
+	}
+
+	return schedules, nil
+}
+
+// UpsertWorkbenchSchedule creates or replaces the schedule of a workbench. Whether the
+// workbench is currently stopped by its schedule is kept.
+func (s *WorkbenchStorage) UpsertWorkbenchSchedule(ctx context.Context, tenantID uint64, schedule *model.WorkbenchSchedule) (*model.WorkbenchSchedule, error) {
+	query := `
+		INSERT INTO workbench_schedules (workbenchid, tenantid, timezone, weekdays, starttime, stoptime)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (workbenchid) DO UPDATE
+		SET timezone = EXCLUDED.timezone, weekdays = EXCLUDED.weekdays, starttime = EXCLUDED.starttime, stoptime = EXCLUDED.stoptime, updatedat = NOW()
+		WHERE workbench_schedules.tenantid = EXCLUDED.tenantid
+		RETURNING ` + workbenchScheduleColumns + `;
+	`
+
+	var row workbenchScheduleRow
+	err := s.db.GetContext(ctx, &row, query, schedule.WorkbenchID, tenantID, schedule.Timezone, weekdayNames(schedule.Weekdays), schedule.StartTime, schedule.StopTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return row.toModel()
+}
+
+func (s *WorkbenchStorage) UpdateWorkbenchScheduleStoppedAt(ctx context.Context, tenantID, workbenchID uint64, stoppedAt *time.Time) error {
+	const query = `
+		UPDATE workbench_schedules
+		SET stoppedat = $3, updatedat = NOW()
+		WHERE tenantid = $1 AND workbenchid = $2;
+	`
+
+	rows, err := s.db.ExecContext(ctx, query, tenantID, workbenchID, stoppedAt)
+	if err != nil {
+		return fmt.Errorf("unable to exec: %w", err)
+	}
+	affected, err := rows.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("unable to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return cerr.ErrNoRowsUpdated
+	}
+
+	return nil
+}
+
+func (s *WorkbenchStorage) DeleteWorkbenchSchedule(ctx context.Context, tenantID, workbenchID uint64) error {
+	const query = `
+		DELETE FROM workbench_schedules
+		WHERE tenantid = $1 AND workbenchid = $2;
+	`
+
+	rows, err := s.db.ExecContext(ctx, query, tenantID, workbenchID)
+	if err != nil {
+		return fmt.Errorf("unable to exec: %w", err)
+	}
+	affected, err := rows.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("unable to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return cerr.ErrNoRowsDeleted
+	}
+
+	return nil
+}
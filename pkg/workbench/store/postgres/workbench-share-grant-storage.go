@@ -0,0 +1,245 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	common_storage "github.com/CHORUS-TRE/chorus-backend/pkg/common/storage"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
+)
+
+const workbenchShareGrantColumns = `
+	g.id, g.tenantid, g.workbenchid, g.granterid, g.granteeuserid, g.granteeworkspaceid,
+	g.mode, g.status, g.expiresat, g.endedat, g.createdat, g.updatedat
+`
+
+// workbenchShareGrantRow is the raw representation of an entry in the
+// 'workbench_share_grants' table.
+type workbenchShareGrantRow struct {
+	ID                 uint64     `db:"id"`
+	TenantID           uint64     `db:"tenantid"`
+	WorkbenchID        uint64     `db:"workbenchid"`
+	GranterID          uint64     `db:"granterid"`
+	GranteeUserID      *uint64    `db:"granteeuserid"`
+	GranteeWorkspaceID *uint64    `db:"granteeworkspaceid"`
+	Mode               string     `db:"mode"`
+	Status             string     `db:"status"`
+	ExpiresAt          time.Time  `db:"expiresat"`
+	EndedAt            *time.Time `db:"endedat"`
+	CreatedAt          time.Time  `db:"createdat"`
+	UpdatedAt          time.Time  `db:"updatedat"`
+}
+
+func (r *workbenchShareGrantRow) toModel() *model.WorkbenchShareGrant {
+	return &model.WorkbenchShareGrant{
+		ID:                 r.ID,
+		TenantID:           r.TenantID,
+		WorkbenchID:        r.WorkbenchID,
+		GranterID:          r.GranterID,
+		GranteeUserID:      r.GranteeUserID,
+		GranteeWorkspaceID: r.GranteeWorkspaceID,
+		Mode:               model.WorkbenchShareMode(r.Mode),
+		Status:             model.WorkbenchShareGrantStatus(r.Status),
+		ExpiresAt:          r.ExpiresAt,
+		EndedAt:            r.EndedAt,
+		CreatedAt:          r.CreatedAt,
+		UpdatedAt:          r.UpdatedAt,
+	}
+}
+
+type workbenchShareGrantMemberRow struct {
+	GrantID   uint64     `db:"grantid"`
+	UserID    uint64     `db:"userid"`
+	RemovedAt *time.Time `db:"removedat"`
+}
+
+func (s *WorkbenchStorage) GetWorkbenchShareGrant(ctx context.Context, tenantID, grantID uint64) (*model.WorkbenchShareGrant, error) {
+	query := `
+		SELECT ` + workbenchShareGrantColumns + `
+		FROM workbench_share_grants g
+		WHERE g.tenantid = $1 AND g.id = $2;
+	`
+
+	grants, err := s.selectWorkbenchShareGrants(ctx, query, tenantID, grantID)
+	if err != nil {
+		return nil, err
+	}
+	if len(grants) == 0 {
+		return nil, sql.ErrNoRows
+	}
+
+	return grants[0], nil
+}
+
+func (s *WorkbenchStorage) ListWorkbenchShareGrants(ctx context.Context, tenantID, workbenchID uint64) ([]*model.WorkbenchShareGrant, error) {
+	query := `
+		SELECT ` + workbenchShareGrantColumns + `
+		FROM workbench_share_grants g
+		WHERE g.tenantid = $1 AND g.workbenchid = $2
+		ORDER BY g.id DESC;
+	`
+
+	return s.selectWorkbenchShareGrants(ctx, query, tenantID, workbenchID)
+}
+
+// ListUserWorkbenchShareGrants lists the grants of a workbench that gave a role to a
+// user, whether or not it has been taken back since.
+func (s *WorkbenchStorage) ListUserWorkbenchShareGrants(ctx context.Context, tenantID, workbenchID, userID uint64) ([]*model.WorkbenchShareGrant, error) {
+	query := `
+		SELECT ` + workbenchShareGrantColumns + `
+		FROM workbench_share_grants g
+		JOIN workbench_share_grant_members m ON m.grantid = g.id
+		WHERE g.tenantid = $1 AND g.workbenchid = $2 AND m.userid = $3
+		ORDER BY g.id DESC;
+	`
+
+	return s.selectWorkbenchShareGrants(ctx, query, tenantID, workbenchID, userID)
+}
+
+// ListWorkbenchShareGrantsToEnd lists, across all tenants, the grants still active past
+// their expiry date and the ended grants whose roles have not all been taken back.
+func (s *WorkbenchStorage) ListWorkbenchShareGrantsToEnd(ctx context.Context) ([]*model.WorkbenchShareGrant, error) {
+	query := `
+		SELECT ` + workbenchShareGrantColumns + `
+		FROM workbench_share_grants g
+		WHERE (g.status = 'active' AND g.expiresat <= NOW())
+		   OR (g.status <> 'active' AND EXISTS (
+		        SELECT 1 FROM workbench_share_grant_members m
+		        WHERE m.grantid = g.id AND m.removedat IS NULL))
+		ORDER BY g.id;
+	`
+
+	return s.selectWorkbenchShareGrants(ctx, query)
+}
+
+// selectWorkbenchShareGrants runs a query selecting grants and loads their members.
+func (s *WorkbenchStorage) selectWorkbenchShareGrants(ctx context.Context, query string, args ...interface{}) ([]*model.WorkbenchShareGrant, error) {
+	var rows []*workbenchShareGrantRow
+	if err := s.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return []*model.WorkbenchShareGrant{}, nil
+	}
+
+	grants := make([]*model.WorkbenchShareGrant, 0, len(rows))
+	byID := make(map[uint64]*model.WorkbenchShareGrant, len(rows))
+	ids := make([]int64, 0, len(rows))
+	for _, row := range rows {
+		grant := row.toModel()
+		grants = append(grants, grant)
+		byID[grant.ID] = grant
+		ids = append(ids, int64(grant.ID))
+	}
+
+	const membersQuery = `
+		SELECT grantid, userid, removedat
+		FROM workbench_share_grant_members
+		WHERE grantid = ANY($1)
+		ORDER BY grantid, userid;
+	`
+
+	var members []workbenchShareGrantMemberRow
+	if err := s.db.SelectContext(ctx, &members, membersQuery, pq.Array(ids)); err != nil {
+		return nil, fmt.Errorf("unable to select share grant members: %w", err)
+	}
+	for _, m := range members {
+		grant := byID[m.GrantID]
+		grant.Members = append(grant.Members, model.WorkbenchShareGrantMember{UserID: m.UserID, RemovedAt: m.RemovedAt})
+	}
+
+	return grants, nil
+}
+
+// CreateWorkbenchShareGrant stores an active grant along with its members.
+func (s *WorkbenchStorage) CreateWorkbenchShareGrant(ctx context.Context, tenantID uint64, grant *model.WorkbenchShareGrant) (*model.WorkbenchShareGrant, error) {
+	const grantQuery = `
+		INSERT INTO workbench_share_grants (tenantid, workbenchid, granterid,
+		    granteeuserid, granteeworkspaceid, mode, status, expiresat,
+		    createdat, updatedat)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW(), NOW())
+		RETURNING id;
+	`
+	const memberQuery = `
+		INSERT INTO workbench_share_grant_members (grantid, userid)
+		VALUES ($1, $2);
+	`
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var id uint64
+	err = tx.GetContext(ctx, &id, grantQuery,
+		tenantID, grant.WorkbenchID, grant.GranterID,
+		grant.GranteeUserID, grant.GranteeWorkspaceID, grant.Mode.String(), model.WorkbenchShareGrantStatusActive.String(), grant.ExpiresAt,
+	)
+	if err != nil {
+		return nil, common_storage.Rollback(tx, fmt.Errorf("unable to create workbench share grant: %w", err))
+	}
+
+	for _, m := range grant.Members {
+		if _, err := tx.ExecContext(ctx, memberQuery, id, m.UserID); err != nil {
+			return nil, common_storage.Rollback(tx, fmt.Errorf("unable to add member %v to workbench share grant: %w", m.UserID, err))
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return s.GetWorkbenchShareGrant(ctx, tenantID, id)
+}
+
+// EndWorkbenchShareGrant moves an active grant to its final status.
+func (s *WorkbenchStorage) EndWorkbenchShareGrant(ctx context.Context, tenantID, grantID uint64, status model.WorkbenchShareGrantStatus) error {
+	const query = `
+		UPDATE workbench_share_grants
+		SET status = $3, endedat = NOW(), updatedat = NOW()
+		WHERE tenantid = $1 AND id = $2 AND status = $4;
+	`
+
+	rows, err := s.db.ExecContext(ctx, query, tenantID, grantID, status.String(), model.WorkbenchShareGrantStatusActive.String())
+	if err != nil {
+		return fmt.Errorf("unable to exec: %w", err)
+	}
+	affected, err := rows.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("unable to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return cerr.ErrNoRowsUpdated
+	}
+
+	return nil
+}
+
+// RemoveWorkbenchShareGrantMember records that the role a grant gave to a user has been
+// taken back.
+func (s *WorkbenchStorage) RemoveWorkbenchShareGrantMember(ctx context.Context, grantID, userID uint64) error {
+	const query = `
+		UPDATE workbench_share_grant_members
+		SET removedat = NOW()
+		WHERE grantid = $1 AND userid = $2 AND removedat IS NULL;
+	`
+
+	rows, err := s.db.ExecContext(ctx, query, grantID, userID)
+	if err != nil {
+		return fmt.Errorf("unable to exec: %w", err)
+	}
+	affected, err := rows.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("unable to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return cerr.ErrNoRowsUpdated
+	}
+
+	return nil
+}
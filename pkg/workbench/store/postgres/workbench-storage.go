@@ -87,24 +87,99 @@ func (s *WorkbenchStorage) ListWorkbenches(ctx context.Context, tenantID uint64,
 	return workbenches, paginationRes, nil
 }
 
-func (s *WorkbenchStorage) DeleteIdleWorkbenches(ctx context.Context, idleTimeout time.Duration) ([]*model.Workbench, error) {
+// ListWorkbenchActivities returns the last access of the workbenches the idle cleaner
+// may reclaim, across all tenants. Workbenches never accessed, hibernated workbenches and
+// scheduled workbenches are left out.
+func (s *WorkbenchStorage) ListWorkbenchActivities(ctx context.Context) ([]*model.WorkbenchActivity, error) {
+	const query = `
+		SELECT w.id AS workbenchid, w.tenantid, w.userid, w.workspaceid, w.name,
+		       w.accessedat AS lastactivityat,
+		       w.idlewarnedat,
+		       ws.workbenchidletimeoutminutes AS workspaceidletimeoutminutes
+		FROM workbenches w
+		JOIN workspaces ws ON ws.id = w.workspaceid
+		WHERE w.accessedat IS NOT NULL
+		  AND w.status NOT IN ('deleted', 'hibernated')
+		  AND w.deletedat IS NULL
+		  AND NOT EXISTS (SELECT 1 FROM workbench_schedules sch WHERE sch.workbenchid = w.id);
+	`
+
+	var activities []*model.WorkbenchActivity
+	if err := s.db.SelectContext(ctx, &activities, query); err != nil {
+		return nil, err
+	}
+
+	return activities, nil
+}
+
+// MarkWorkbenchIdleWarned records that the owner of a workbench was warned that it is
+// about to be reclaimed.
+func (s *WorkbenchStorage) MarkWorkbenchIdleWarned(ctx context.Context, tenantID, workbenchID uint64) error {
+	const query = `
+		UPDATE workbenches
+		SET idlewarnedat = NOW()
+		WHERE tenantid = $1 AND id = $2 AND deletedat IS NULL;
+	`
+
+	rows, err := s.db.ExecContext(ctx, query, tenantID, workbenchID)
+	if err != nil {
+		return fmt.Errorf("unable to exec: %w", err)
+	}
+	affected, err := rows.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("unable to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return cerr.ErrNoRowsUpdated
+	}
+
+	return nil
+}
+
+// KeepWorkbenchAlive counts as an access to the workbench, restarting its idle clock.
+func (s *WorkbenchStorage) KeepWorkbenchAlive(ctx context.Context, tenantID, workbenchID uint64) error {
+	const query = `
+		UPDATE workbenches
+		SET accessedat = NOW(), idlewarnedat = NULL, updatedat = NOW()
+		WHERE tenantid = $1 AND id = $2 AND status != 'deleted' AND deletedat IS NULL;
+	`
+
+	rows, err := s.db.ExecContext(ctx, query, tenantID, workbenchID)
+	if err != nil {
+		return fmt.Errorf("unable to exec: %w", err)
+	}
+	affected, err := rows.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("unable to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return cerr.ErrNoRowsUpdated
+	}
+
+	return nil
+}
+
+// DeleteIdleWorkbench deletes a workbench that had no activity since lastActivityAt. It
+// returns sql.ErrNoRows when the workbench was accessed or kept alive in the meantime.
+func (s *WorkbenchStorage) DeleteIdleWorkbench(ctx context.Context, tenantID, workbenchID uint64, lastActivityAt time.Time) (*model.Workbench, error) {
 	const query = `
 		UPDATE workbenches
-		SET (status, name, updatedat, deletedat) = ($1, concat(name, $2::TEXT), NOW(), NOW())
-		WHERE accessedat IS NOT NULL
-		  AND accessedat < NOW() - $3::INTERVAL
-		  AND status != 'deleted'
+		SET (status, name, updatedat, deletedat) = ($3, concat(name, $4::TEXT), NOW(), NOW())
+		WHERE tenantid = $1 AND id = $2
+		  AND accessedat <= $5
+		  AND status NOT IN ('deleted', 'hibernated')
 		  AND deletedat IS NULL
+		  AND NOT EXISTS (SELECT 1 FROM workbench_schedules ws WHERE ws.workbenchid = workbenches.id)
 		RETURNING id, tenantid, userid, workspaceid, name, shortname, description, status, serverpodstatus, k8sstatus, initialresolutionwidth, initialresolutionheight, createdat, updatedat;
 	`
 
-	var deletedWorkbenches []*model.Workbench
-	err := s.db.SelectContext(ctx, &deletedWorkbenches, query, model.WorkbenchDeleted.String(), "-"+uuid.Next(), fmt.Sprintf("%d seconds", int64(idleTimeout.Seconds())))
+	var workbench model.Workbench
+	err := s.db.GetContext(ctx, &workbench, query, tenantID, workbenchID, model.WorkbenchDeleted.String(), "-"+uuid.Next(), lastActivityAt)
 	if err != nil {
-		return nil, fmt.Errorf("unable to exec: %w", err)
+		return nil, err
 	}
 
-	return deletedWorkbenches, nil
+	return &workbench, nil
 }
 
 func (s *WorkbenchStorage) ListWorkbenchAppInstances(ctx context.Context, workbenchID uint64) ([]*model.AppInstance, error) {
@@ -125,11 +200,13 @@ func (s *WorkbenchStorage) ListWorkbenchAppInstances(ctx context.Context, workbe
 			ai.createdat,
 			ai.updatedat,
 			ai.browserconfigjwttoken,
+			COALESCE(ai.appversionid, 0) as appversionid,
+			ai.appdockerimagedigest,
 
 			a.name as AppName,
 			a.dockerimageregistry as AppDockerImageRegistry,
 			a.dockerimagename as AppDockerImageName,
-			a.dockerimagetag as AppDockerImageTag,
+			COALESCE(v.tag, a.dockerimagetag) as AppDockerImageTag,
 			a.shmsize as AppShmSize,
 			a.browserconfigurl as AppBrowserConfigURL,
 			a.browserconfigjwturl as AppBrowserConfigJWTUrl,
@@ -144,6 +221,8 @@ func (s *WorkbenchStorage) ListWorkbenchAppInstances(ctx context.Context, workbe
 			app_instances ai
 		JOIN 
 			apps a ON ai.appid = a.id
+		LEFT JOIN
+			app_versions v ON ai.appversionid = v.id
 		WHERE 
 			ai.workbenchid = $1 
 			AND ai.status != 'deleted'
@@ -203,18 +282,38 @@ func (s *WorkbenchStorage) SaveBatchProxyHit(ctx context.Context, proxyHitCountM
 }
 
 // CreateWorkbench saves the provided workbench object in the database 'workbenches' table.
-func (s *WorkbenchStorage) CreateWorkbench(ctx context.Context, tenantID uint64, workbench *model.Workbench) (*model.Workbench, error) {
+// When maxPerUser is non zero, the insert is refused with cerr.ErrQuotaReached if the
+// owner already has that many workbenches.
+func (s *WorkbenchStorage) CreateWorkbench(ctx context.Context, tenantID uint64, workbench *model.Workbench, maxPerUser uint32) (*model.Workbench, error) {
+	const countQuery = `
+		SELECT COUNT(*) FROM workbenches
+		WHERE tenantid = $1 AND userid = $2 AND deletedat IS NULL;
+	`
+
 	const workbenchQuery = `
 		INSERT INTO workbenches (tenantid, userid, workspaceid, name, shortname, description, initialresolutionwidth, initialresolutionheight, status, serverpodstatus, serverpodmessage, k8sstatus, createdat, updatedat)
 		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, NOW(), NOW()) 
 		RETURNING id, tenantid, userid, workspaceid, name, shortname, description, status, serverpodstatus, serverpodmessage, k8sstatus, initialresolutionwidth, initialresolutionheight, createdat, updatedat;
 	`
 
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := common_storage.CheckUserQuota(ctx, tx, tenantID, workbench.UserID, maxPerUser, countQuery); err != nil {
+		return nil, common_storage.Rollback(tx, err)
+	}
+
 	var newWorkbench model.Workbench
-	err := s.db.GetContext(ctx, &newWorkbench, workbenchQuery,
+	err = tx.GetContext(ctx, &newWorkbench, workbenchQuery,
 		tenantID, workbench.UserID, workbench.WorkspaceID, workbench.Name, workbench.ShortName, workbench.Description, workbench.InitialResolutionWidth, workbench.InitialResolutionHeight, workbench.Status, workbench.ServerPodStatus, workbench.ServerPodMessage, workbench.K8sStatus,
 	)
 	if err != nil {
+		return nil, common_storage.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
 
@@ -308,10 +407,11 @@ func (s *WorkbenchStorage) DeleteWorkbenchesInWorkspace(ctx context.Context, ten
 
 func (s *WorkbenchStorage) GetAppInstance(ctx context.Context, tenantID uint64, appInstanceID uint64) (*model.AppInstance, error) {
 	const query = `
-		SELECT ai.id, ai.tenantid, ai.userid, ai.appid, ai.workspaceid, ai.workbenchid, ai.status, ai.k8sstate, ai.k8sstatus, ai.k8smessage, ai.initialresolutionwidth, ai.initialresolutionheight, ai.browserconfigjwttoken, ai.createdat, ai.updatedat,
-			a.name as appname, a.dockerimageregistry as appdockerimageregistry, a.dockerimagename as appdockerimagename, a.dockerimagetag as appdockerimagetag
+		SELECT ai.id, ai.tenantid, ai.userid, ai.appid, COALESCE(ai.appversionid, 0) as appversionid, ai.appdockerimagedigest, ai.workspaceid, ai.workbenchid, ai.status, ai.k8sstate, ai.k8sstatus, ai.k8smessage, ai.initialresolutionwidth, ai.initialresolutionheight, ai.browserconfigjwttoken, ai.createdat, ai.updatedat,
+			a.name as appname, a.dockerimageregistry as appdockerimageregistry, a.dockerimagename as appdockerimagename, COALESCE(v.tag, a.dockerimagetag) as appdockerimagetag
 		FROM app_instances ai
 		JOIN apps a ON ai.tenantid = a.tenantid AND ai.appid = a.id
+		LEFT JOIN app_versions v ON ai.appversionid = v.id
 		WHERE ai.tenantid = $1 AND ai.id = $2 AND ai.deletedat IS NULL;
 	`
 
@@ -338,10 +438,11 @@ func (s *WorkbenchStorage) ListAppInstances(ctx context.Context, tenantID uint64
 
 	// Get app instances query
 	query := `
-		SELECT ai.id, ai.tenantid, ai.userid, ai.appid, ai.workspaceid, ai.workbenchid, ai.status, ai.k8sstate, ai.k8sstatus, ai.k8smessage, ai.initialresolutionwidth, ai.initialresolutionheight, ai.browserconfigjwttoken, ai.createdat, ai.updatedat,
-			a.name as appname, a.dockerimageregistry as appdockerimageregistry, a.dockerimagename as appdockerimagename, a.dockerimagetag as appdockerimagetag
+		SELECT ai.id, ai.tenantid, ai.userid, ai.appid, COALESCE(ai.appversionid, 0) as appversionid, ai.appdockerimagedigest, ai.workspaceid, ai.workbenchid, ai.status, ai.k8sstate, ai.k8sstatus, ai.k8smessage, ai.initialresolutionwidth, ai.initialresolutionheight, ai.browserconfigjwttoken, ai.createdat, ai.updatedat,
+			a.name as appname, a.dockerimageregistry as appdockerimageregistry, a.dockerimagename as appdockerimagename, COALESCE(v.tag, a.dockerimagetag) as appdockerimagetag
 		FROM app_instances ai
 		JOIN apps a ON ai.tenantid = a.tenantid AND ai.appid = a.id
+		LEFT JOIN app_versions v ON ai.appversionid = v.id
 		WHERE ai.tenantid = $1 AND ai.deletedat IS NULL
 	`
 	if workbenchIDsIn != nil {
@@ -372,18 +473,38 @@ func (s *WorkbenchStorage) ListAppInstances(ctx context.Context, tenantID uint64
 }
 
 // CreateAppInstance saves the provided appInstance object in the database 'appInstances' table.
-func (s *WorkbenchStorage) CreateAppInstance(ctx context.Context, tenantID uint64, appInstance *model.AppInstance) (*model.AppInstance, error) {
+// When maxPerUser is non zero, the insert is refused with cerr.ErrQuotaReached if the
+// owner already has that many app instances.
+func (s *WorkbenchStorage) CreateAppInstance(ctx context.Context, tenantID uint64, appInstance *model.AppInstance, maxPerUser uint32) (*model.AppInstance, error) {
+	const countQuery = `
+		SELECT COUNT(*) FROM app_instances
+		WHERE tenantid = $1 AND userid = $2 AND deletedat IS NULL;
+	`
+
 	const appInstanceQuery = `
-		INSERT INTO app_instances (tenantid, userid, appid, workspaceid, workbenchid, status, k8sstate, k8sstatus, k8smessage, initialresolutionwidth, initialresolutionheight, browserconfigjwttoken, createdat, updatedat)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, NOW(), NOW())
+		INSERT INTO app_instances (tenantid, userid, appid, workspaceid, workbenchid, status, k8sstate, k8sstatus, k8smessage, initialresolutionwidth, initialresolutionheight, browserconfigjwttoken, appversionid, appdockerimagedigest, createdat, updatedat)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, NULLIF($13, 0), $14, NOW(), NOW())
 		RETURNING id, tenantid, userid, appid, workspaceid, workbenchid, status, k8sstate, k8sstatus, k8smessage, initialresolutionwidth, initialresolutionheight, browserconfigjwttoken, createdat, updatedat;
 	`
 
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := common_storage.CheckUserQuota(ctx, tx, tenantID, appInstance.UserID, maxPerUser, countQuery); err != nil {
+		return nil, common_storage.Rollback(tx, err)
+	}
+
 	var newAppInstance model.AppInstance
-	err := s.db.GetContext(ctx, &newAppInstance, appInstanceQuery,
-		tenantID, appInstance.UserID, appInstance.AppID, appInstance.WorkspaceID, appInstance.WorkbenchID, model.AppInstanceUnknown, model.K8sAppInstanceStateRunning, model.K8sAppInstanceStatusUnknown, appInstance.K8sMessage, appInstance.InitialResolutionWidth, appInstance.InitialResolutionHeight, appInstance.BrowserConfigJWTToken,
+	err = tx.GetContext(ctx, &newAppInstance, appInstanceQuery,
+		tenantID, appInstance.UserID, appInstance.AppID, appInstance.WorkspaceID, appInstance.WorkbenchID, model.AppInstanceUnknown, model.K8sAppInstanceStateRunning, model.K8sAppInstanceStatusUnknown, appInstance.K8sMessage, appInstance.InitialResolutionWidth, appInstance.InitialResolutionHeight, appInstance.BrowserConfigJWTToken, appInstance.AppVersionID, appInstance.AppDockerImageDigest,
 	)
 	if err != nil {
+		return nil, common_storage.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
 
@@ -409,6 +530,31 @@ func (s *WorkbenchStorage) UpdateAppInstance(ctx context.Context, tenantID uint6
 	return s.GetAppInstance(ctx, tenantID, updatedAppInstance.ID)
 }
 
+// UpdateAppInstanceVersion moves an app instance to another version of its app, pinned
+// to the given digest.
+func (s *WorkbenchStorage) UpdateAppInstanceVersion(ctx context.Context, tenantID uint64, appInstanceID uint64, appVersionID uint64, imageDigest string) (*model.AppInstance, error) {
+	const query = `
+		UPDATE app_instances
+		SET appversionid = NULLIF($3, 0), appdockerimagedigest = $4, updatedat = NOW()
+		WHERE tenantid = $1 AND id = $2 AND deletedat IS NULL;
+	`
+
+	rows, err := s.db.ExecContext(ctx, query, tenantID, appInstanceID, appVersionID, imageDigest)
+	if err != nil {
+		return nil, fmt.Errorf("unable to exec: %w", err)
+	}
+	affected, err := rows.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return nil, cerr.ErrNoRowsUpdated
+	}
+
+	// Get the app instance with app details
+	return s.GetAppInstance(ctx, tenantID, appInstanceID)
+}
+
 func (s *WorkbenchStorage) UpdateAppInstances(ctx context.Context, tenantID uint64, appInstances []*model.AppInstance) (err error) {
 	var errAcc []error
 	for _, appInstance := range appInstances {
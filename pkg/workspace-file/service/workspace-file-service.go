@@ -10,6 +10,7 @@ import (
 	"github.com/CHORUS-TRE/chorus-backend/internal/client/filestore"
 	"github.com/CHORUS-TRE/chorus-backend/internal/config"
 	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	jwt_model "github.com/CHORUS-TRE/chorus-backend/internal/jwt/model"
 	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
 	"github.com/CHORUS-TRE/chorus-backend/pkg/workspace-file/model"
 	workspace_model "github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
@@ -29,6 +30,10 @@ type WorkspaceFiler interface {
 	AbortWorkspaceFileUpload(ctx context.Context, workspaceID uint64, filePath string, uploadID string) error
 }
 
+type WorkspaceReader interface {
+	GetWorkspace(ctx context.Context, tenantID, workspaceID uint64) (*workspace_model.Workspace, error)
+}
+
 type workspaceFileStore struct {
 	workspacePrefix string
 	description     string
@@ -39,10 +44,11 @@ type workspaceFileStore struct {
 }
 
 type WorkspaceFileService struct {
-	stores map[string]workspaceFileStore
+	stores          map[string]workspaceFileStore
+	workspaceReader WorkspaceReader
 }
 
-func NewWorkspaceFileService(cfg config.Config, fileStores map[string]filestore.FileStore) (*WorkspaceFileService, error) {
+func NewWorkspaceFileService(cfg config.Config, fileStores map[string]filestore.FileStore, workspaceReader WorkspaceReader) (*WorkspaceFileService, error) {
 	storeConfigs := cfg.Services.WorkspaceFileService.Stores
 
 	stores := make(map[string]workspaceFileStore, len(storeConfigs))
@@ -67,7 +73,7 @@ func NewWorkspaceFileService(cfg config.Config, fileStores map[string]filestore.
 		}
 	}
 
-	return &WorkspaceFileService{stores: stores}, nil
+	return &WorkspaceFileService{stores: stores, workspaceReader: workspaceReader}, nil
 }
 
 func isFileStoreEnabled(cfg config.FileStore) bool {
@@ -99,6 +105,23 @@ func (s *WorkspaceFileService) fromStorePath(storeName string, workspaceID uint6
 	return "/" + storeName + "/" + strings.TrimPrefix(relPath, "/")
 }
 
+// checkWritable rejects changes to the files of a workspace that is read-only, e.g. archived.
+func (s *WorkspaceFileService) checkWritable(ctx context.Context, workspaceID uint64) error {
+	tenantID, err := jwt_model.ExtractTenantID(ctx)
+	if err != nil {
+		return cerr.ErrUnauthenticated.Wrap(err, "Could not extract tenant id from jwt-token")
+	}
+
+	workspace, err := s.workspaceReader.GetWorkspace(ctx, tenantID, workspaceID)
+	if err != nil {
+		return err
+	}
+	if workspace.Status.IsReadOnly() {
+		return cerr.ErrReadOnly.WithMessage(fmt.Sprintf("Files of workspace %v are read-only as the workspace is %v", workspaceID, workspace.Status))
+	}
+	return nil
+}
+
 func (s *WorkspaceFileService) selectFileStore(filePath string) (string, error) {
 	parts := strings.SplitN(strings.TrimPrefix(filePath, "/"), "/", 2)
 	if parts[0] == "" {
@@ -215,6 +238,10 @@ func (s *WorkspaceFileService) ListWorkspaceFiles(ctx context.Context, workspace
 }
 
 func (s *WorkspaceFileService) CreateWorkspaceFile(ctx context.Context, workspaceID uint64, file *filestore.File) (*filestore.File, error) {
+	if err := s.checkWritable(ctx, workspaceID); err != nil {
+		return nil, err
+	}
+
 	storeName, err := s.selectFileStore(file.Path)
 	if err != nil {
 		return nil, err
@@ -261,6 +288,10 @@ func (s *WorkspaceFileService) CreateWorkspaceFile(ctx context.Context, workspac
 }
 
 func (s *WorkspaceFileService) UpdateWorkspaceFile(ctx context.Context, workspaceID uint64, sourcePath string, file *filestore.File, isCopy bool) (*filestore.File, error) {
+	if err := s.checkWritable(ctx, workspaceID); err != nil {
+		return nil, err
+	}
+
 	sourceStoreName, err := s.selectFileStore(sourcePath)
 	if err != nil {
 		return nil, err
@@ -376,6 +407,10 @@ func (s *WorkspaceFileService) UpdateWorkspaceFile(ctx context.Context, workspac
 }
 
 func (s *WorkspaceFileService) DeleteWorkspaceFile(ctx context.Context, workspaceID uint64, filePath string) error {
+	if err := s.checkWritable(ctx, workspaceID); err != nil {
+		return err
+	}
+
 	storeName, err := s.selectFileStore(filePath)
 	if err != nil {
 		return err
@@ -404,6 +439,10 @@ func (s *WorkspaceFileService) DeleteWorkspaceFile(ctx context.Context, workspac
 }
 
 func (s *WorkspaceFileService) InitiateWorkspaceFileUpload(ctx context.Context, workspaceID uint64, filePath string, file *filestore.File) (*filestore.FileUploadInfo, error) {
+	if err := s.checkWritable(ctx, workspaceID); err != nil {
+		return nil, err
+	}
+
 	storeName, err := s.selectFileStore(filePath)
 	if err != nil {
 		return nil, err
@@ -431,6 +470,10 @@ func (s *WorkspaceFileService) InitiateWorkspaceFileUpload(ctx context.Context,
 }
 
 func (s *WorkspaceFileService) UploadWorkspaceFilePart(ctx context.Context, workspaceID uint64, filePath string, uploadID string, part *filestore.FilePart) (*filestore.FilePart, error) {
+	if err := s.checkWritable(ctx, workspaceID); err != nil {
+		return nil, err
+	}
+
 	storeName, err := s.selectFileStore(filePath)
 	if err != nil {
 		return nil, err
@@ -448,6 +491,10 @@ func (s *WorkspaceFileService) UploadWorkspaceFilePart(ctx context.Context, work
 }
 
 func (s *WorkspaceFileService) CompleteWorkspaceFileUpload(ctx context.Context, workspaceID uint64, filePath string, uploadID string, parts []*filestore.FilePart) (*filestore.File, error) {
+	if err := s.checkWritable(ctx, workspaceID); err != nil {
+		return nil, err
+	}
+
 	storeName, err := s.selectFileStore(filePath)
 	if err != nil {
 		return nil, err
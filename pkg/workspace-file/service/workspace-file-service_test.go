@@ -14,6 +14,9 @@ import (
 	"github.com/CHORUS-TRE/chorus-backend/internal/client/miniofilestore"
 	miniorawclient "github.com/CHORUS-TRE/chorus-backend/internal/client/miniofilestore/raw-client"
 	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	jwt_model "github.com/CHORUS-TRE/chorus-backend/internal/jwt/model"
+	workspace_model "github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
 	"github.com/CHORUS-TRE/chorus-backend/tests/unit"
 )
 
@@ -23,6 +26,22 @@ const (
 	testWorkspacePrefix = "workspaces/%s"
 )
 
+type mockWorkspaceReader struct {
+	status workspace_model.WorkspaceStatus
+}
+
+func (m *mockWorkspaceReader) GetWorkspace(_ context.Context, tenantID, workspaceID uint64) (*workspace_model.Workspace, error) {
+	status := m.status
+	if status == "" {
+		status = workspace_model.WorkspaceStatusActive
+	}
+	return &workspace_model.Workspace{ID: workspaceID, TenantID: tenantID, Status: status}, nil
+}
+
+func tenantCtx() context.Context {
+	return context.WithValue(context.Background(), jwt_model.JWTClaimsContextKey, &jwt_model.JWTClaims{TenantID: 1})
+}
+
 func createTestService() *WorkspaceFileService {
 	client := miniorawclient.NewTestClient()
 	fileStore, _ := miniofilestore.NewMinioFileStorage(client)
@@ -46,7 +65,7 @@ func createTestService() *WorkspaceFileService {
 		testStoreName: fileStore,
 	}
 
-	service, _ := NewWorkspaceFileService(cfg, fileStores)
+	service, _ := NewWorkspaceFileService(cfg, fileStores, &mockWorkspaceReader{})
 	return service
 }
 
@@ -80,7 +99,7 @@ func createTestServiceWithTwoStores() *WorkspaceFileService {
 	service, _ := NewWorkspaceFileService(cfg, map[string]filestore.FileStore{
 		testStoreName:  fileStore1,
 		testStoreName2: fileStore2,
-	})
+	}, &mockWorkspaceReader{})
 	return service
 }
 
@@ -522,7 +541,7 @@ func TestUpdateWorkspaceFile_SameStoreMove(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	result, err := s.UpdateWorkspaceFile(context.Background(), workspaceID, sourcePath, &filestore.File{
+	result, err := s.UpdateWorkspaceFile(tenantCtx(), workspaceID, sourcePath, &filestore.File{
 		Path: destPath,
 		Name: "move-dst.txt",
 	}, false)
@@ -552,7 +571,7 @@ func TestUpdateWorkspaceFile_SameStoreCopy(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	result, err := s.UpdateWorkspaceFile(context.Background(), workspaceID, sourcePath, &filestore.File{
+	result, err := s.UpdateWorkspaceFile(tenantCtx(), workspaceID, sourcePath, &filestore.File{
 		Path: destPath,
 		Name: "copy-dst.txt",
 	}, true)
@@ -584,7 +603,7 @@ func TestUpdateWorkspaceFile_CrossStoreMove(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	result, err := s.UpdateWorkspaceFile(context.Background(), workspaceID, sourcePath, &filestore.File{
+	result, err := s.UpdateWorkspaceFile(tenantCtx(), workspaceID, sourcePath, &filestore.File{
 		Path: destPath,
 		Name: "xmove-dst.txt",
 	}, false)
@@ -616,7 +635,7 @@ func TestUpdateWorkspaceFile_CrossStoreCopy(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	result, err := s.UpdateWorkspaceFile(context.Background(), workspaceID, sourcePath, &filestore.File{
+	result, err := s.UpdateWorkspaceFile(tenantCtx(), workspaceID, sourcePath, &filestore.File{
 		Path: destPath,
 		Name: "xcopy-dst.txt",
 	}, true)
@@ -654,7 +673,7 @@ func TestUpdateWorkspaceFile_FailsIfDestinationExists(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	_, err = s.UpdateWorkspaceFile(context.Background(), workspaceID, sourcePath, &filestore.File{
+	_, err = s.UpdateWorkspaceFile(tenantCtx(), workspaceID, sourcePath, &filestore.File{
 		Path: destPath,
 		Name: "overwrite-dst.txt",
 	}, true)
@@ -672,7 +691,7 @@ func TestUpdateWorkspaceFile_FailsOnMissingSource(t *testing.T) {
 	dstStorage := s.stores[testStoreName2].store
 	workspaceID := uint64(1)
 
-	_, err := s.UpdateWorkspaceFile(context.Background(), workspaceID,
+	_, err := s.UpdateWorkspaceFile(tenantCtx(), workspaceID,
 		"/"+testStoreName+"/missing.txt",
 		&filestore.File{Path: "/" + testStoreName2 + "/abort-dst.txt", Name: "abort-dst.txt"},
 		false,
@@ -683,6 +702,31 @@ func TestUpdateWorkspaceFile_FailsOnMissingSource(t *testing.T) {
 	assert.Error(t, err, "destination should not exist when source was not found")
 }
 
+func TestCreateWorkspaceFile_RejectsArchivedWorkspace(t *testing.T) {
+	unit.InitTestLogger()
+
+	s := createTestService()
+	s.workspaceReader = &mockWorkspaceReader{status: workspace_model.WorkspaceStatusArchived}
+	storage := s.stores[testStoreName].store
+	workspaceID := uint64(1)
+	filePath := "/" + testStoreName + "/archived.txt"
+
+	_, err := s.CreateWorkspaceFile(tenantCtx(), workspaceID, &filestore.File{
+		Path:    filePath,
+		Name:    "archived.txt",
+		Content: []byte("content"),
+	})
+	var cErr *cerr.ChorusError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, cerr.ErrReadOnly.ChorusCode, cErr.ChorusCode)
+
+	_, err = storage.StatFile(context.Background(), s.toStorePath(testStoreName, workspaceID, filePath))
+	assert.Error(t, err, "file should not be created in an archived workspace")
+
+	_, err = s.ListWorkspaceFiles(tenantCtx(), workspaceID, "/"+testStoreName+"/")
+	assert.NoError(t, err, "files of an archived workspace should stay readable")
+}
+
 func TestFileUploadPartSizeCalculation(t *testing.T) {
 	unit.InitTestLogger()
 
@@ -0,0 +1,163 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// FQDNProtocol restricts an FQDN rule to a transport protocol.
+type FQDNProtocol string
+
+const (
+	FQDNProtocolAny FQDNProtocol = ""
+	FQDNProtocolTCP FQDNProtocol = "TCP"
+	FQDNProtocolUDP FQDNProtocol = "UDP"
+)
+
+// FQDNRuleStatusRejected flags the rules the network policy controller could not apply.
+const FQDNRuleStatusRejected = "Rejected"
+
+func (p FQDNProtocol) String() string {
+	return string(p)
+}
+
+// FQDNRule allows egress from an FQDNAllowlist workspace to the hosts matching a pattern.
+type FQDNRule struct {
+	// Pattern is a hostname such as "pypi.org", or a wildcard matching its subdomains
+	// such as "*.example.org".
+	Pattern string `json:"pattern"`
+	// Ports restricts the rule to the given destination ports; all ports when empty.
+	Ports []uint32 `json:"ports,omitempty"`
+	// Protocol restricts the rule to TCP or UDP; both when empty.
+	Protocol FQDNProtocol `json:"protocol,omitempty"`
+	Comment  string       `json:"comment,omitempty"`
+}
+
+// IsWildcard reports whether the rule matches the subdomains of a domain.
+func (r FQDNRule) IsWildcard() bool {
+	return strings.HasPrefix(r.Pattern, "*.")
+}
+
+// Covers reports whether all the traffic allowed by other is already allowed by r.
+func (r FQDNRule) Covers(other FQDNRule) bool {
+	pattern, otherPattern := strings.ToLower(r.Pattern), strings.ToLower(other.Pattern)
+	if pattern != otherPattern {
+		if !r.IsWildcard() || !strings.HasSuffix(otherPattern, pattern[1:]) {
+			return false
+		}
+	}
+
+	if r.Protocol != FQDNProtocolAny && r.Protocol != other.Protocol {
+		return false
+	}
+
+	if len(r.Ports) == 0 {
+		return true
+	}
+	if len(other.Ports) == 0 {
+		return false
+	}
+	for _, port := range other.Ports {
+		if !containsPort(r.Ports, port) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r FQDNRule) String() string {
+	s := r.Pattern
+	if len(r.Ports) > 0 {
+		ports := make([]string, 0, len(r.Ports))
+		for _, p := range r.Ports {
+			ports = append(ports, fmt.Sprintf("%d", p))
+		}
+		s += ":" + strings.Join(ports, ",")
+	}
+	if r.Protocol != FQDNProtocolAny {
+		s += "/" + r.Protocol.String()
+	}
+	return s
+}
+
+func containsPort(ports []uint32, port uint32) bool {
+	for _, p := range ports {
+		if p == port {
+			return true
+		}
+	}
+	return false
+}
+
+// FQDNRules handles the JSONB column holding the FQDN allowlist of a workspace.
+type FQDNRules []FQDNRule
+
+// FQDNRulesFromPatterns builds rules allowing all ports and protocols to each pattern.
+func FQDNRulesFromPatterns(patterns []string) FQDNRules {
+	rules := make(FQDNRules, 0, len(patterns))
+	for _, p := range patterns {
+		rules = append(rules, FQDNRule{Pattern: p})
+	}
+	return rules
+}
+
+// Patterns returns the hostname patterns of the rules.
+func (r FQDNRules) Patterns() []string {
+	patterns := make([]string, 0, len(r))
+	for _, rule := range r {
+		patterns = append(patterns, rule.Pattern)
+	}
+	return patterns
+}
+
+// Covers reports whether the traffic allowed by rule is already allowed by one of the rules.
+func (r FQDNRules) Covers(rule FQDNRule) bool {
+	for _, existing := range r {
+		if existing.Covers(rule) {
+			return true
+		}
+	}
+	return false
+}
+
+// Equal reports whether both allowlists hold the same rules in the same order.
+func (r FQDNRules) Equal(other FQDNRules) bool {
+	return slices.EqualFunc(r, other, func(a, b FQDNRule) bool {
+		return strings.EqualFold(a.Pattern, b.Pattern) && slices.Equal(a.Ports, b.Ports) && a.Protocol == b.Protocol
+	})
+}
+
+func (r *FQDNRules) Scan(src interface{}) error {
+	if src == nil {
+		*r = FQDNRules{}
+		return nil
+	}
+	var source []byte
+	switch v := src.(type) {
+	case []byte:
+		source = v
+	case string:
+		source = []byte(v)
+	default:
+		return fmt.Errorf("unsupported type for FQDNRules: %T", src)
+	}
+	rules := FQDNRules{}
+	if err := json.Unmarshal(source, &rules); err != nil {
+		return fmt.Errorf("unable to unmarshal FQDNRules: %w", err)
+	}
+	*r = rules
+	return nil
+}
+
+func (r FQDNRules) Value() (interface{}, error) {
+	if r == nil {
+		return "[]", nil
+	}
+	b, err := json.Marshal(r)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal FQDNRules: %w", err)
+	}
+	return string(b), nil
+}
@@ -0,0 +1,86 @@
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+// ServiceDefinition maps an entry in the 'service_definitions' database table.
+// It is a curated Helm chart, managed by admins, that workspace service instances
+// are created from.
+type ServiceDefinition struct {
+	ID uint64
+
+	TenantID uint64
+	UserID   uint64
+
+	Name        string                  `validate:"required,min=1,max=255,safestring"`
+	Description string                  `validate:"omitempty,generalstring"`
+	Status      ServiceDefinitionStatus `validate:"omitempty,oneof=active inactive"`
+
+	ChartRegistry   string `validate:"required,generalstring"`
+	ChartRepository string `validate:"required,generalstring"`
+	ChartTag        string `validate:"required,safestring"`
+
+	// ValuesSchema is the JSON schema the values override of service instances must match.
+	// An empty schema accepts any values.
+	ValuesSchema JSONMap[any]
+	// DefaultCredentialsPaths are used by service instances that set no credentials paths.
+	DefaultCredentialsPaths StringSlice
+	ConnectionInfoTemplate  string
+	IconURL                 string `validate:"omitempty,generalstring"`
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+}
+
+// ServiceDefinitionStatus tells whether new service instances can be created from a definition.
+type ServiceDefinitionStatus string
+
+const (
+	ServiceDefinitionActive   ServiceDefinitionStatus = "active"
+	ServiceDefinitionInactive ServiceDefinitionStatus = "inactive"
+	ServiceDefinitionDeleted  ServiceDefinitionStatus = "deleted"
+)
+
+func (s ServiceDefinitionStatus) String() string {
+	return string(s)
+}
+
+func ToServiceDefinitionStatus(status string) (ServiceDefinitionStatus, error) {
+	switch status {
+	case ServiceDefinitionActive.String():
+		return ServiceDefinitionActive, nil
+	case ServiceDefinitionInactive.String():
+		return ServiceDefinitionInactive, nil
+	case ServiceDefinitionDeleted.String():
+		return ServiceDefinitionDeleted, nil
+	default:
+		return "", errors.New("unexpected ServiceDefinitionStatus: " + status)
+	}
+}
+
+// ApplyTo sets the chart coordinates and connection info template of a service instance
+// from the definition, along with the credentials paths when the instance sets none.
+func (d ServiceDefinition) ApplyTo(svc *WorkspaceServiceInstance) {
+	id := d.ID
+	svc.ServiceDefinitionID = &id
+	svc.ChartRegistry = d.ChartRegistry
+	svc.ChartRepository = d.ChartRepository
+	svc.ChartTag = d.ChartTag
+	svc.ConnectionInfoTemplate = d.ConnectionInfoTemplate
+	if len(svc.CredentialsPaths) == 0 {
+		svc.CredentialsPaths = append(StringSlice{}, d.DefaultCredentialsPaths...)
+	}
+}
+
+func (ServiceDefinition) IsValidSortType(sortType string) bool {
+	validSortTypes := map[string]bool{
+		"id":        true,
+		"name":      true,
+		"status":    true,
+		"createdat": true,
+	}
+	return validSortTypes[sortType]
+}
@@ -0,0 +1,74 @@
+package model
+
+import (
+	"time"
+
+	authz "github.com/CHORUS-TRE/chorus-backend/pkg/authorization/model"
+)
+
+type WorkspaceInvitationStatus string
+
+const (
+	WorkspaceInvitationStatusPending  WorkspaceInvitationStatus = "pending"
+	WorkspaceInvitationStatusAccepted WorkspaceInvitationStatus = "accepted"
+	WorkspaceInvitationStatusDeclined WorkspaceInvitationStatus = "declined"
+	WorkspaceInvitationStatusRevoked  WorkspaceInvitationStatus = "revoked"
+	// WorkspaceInvitationStatusExpired is never stored: it is reported for pending
+	// invitations whose expiry date has passed.
+	WorkspaceInvitationStatusExpired WorkspaceInvitationStatus = "expired"
+)
+
+func (s WorkspaceInvitationStatus) String() string {
+	return string(s)
+}
+
+// WorkspaceInvitation maps an entry in the 'workspace_invitations' database table.
+// An invitation grants a workspace role to an existing user or to an email address
+// once the invitee accepts it.
+type WorkspaceInvitation struct {
+	ID uint64
+
+	TenantID    uint64
+	WorkspaceID uint64
+	InviterID   uint64
+
+	// WorkspaceName is read from the workspace, for display to the invitee.
+	WorkspaceName string
+
+	// InviteeUserID is nil for an email invitation until a user claims it.
+	InviteeUserID *uint64
+	InviteeEmail  string         `validate:"omitempty,email"`
+	Role          authz.RoleName `validate:"required"`
+
+	Status WorkspaceInvitationStatus
+	// TokenHash is the SHA-256 of the token embedded in the invitation link.
+	TokenHash string
+
+	ExpiresAt   time.Time
+	RespondedAt *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// EffectiveStatus returns the status of the invitation, reporting pending
+// invitations past their expiry date as expired.
+func (i *WorkspaceInvitation) EffectiveStatus(now time.Time) WorkspaceInvitationStatus {
+	if i.Status == WorkspaceInvitationStatusPending && !i.ExpiresAt.After(now) {
+		return WorkspaceInvitationStatusExpired
+	}
+	return i.Status
+}
+
+type WorkspaceInvitationFilter struct {
+	StatusesIn []WorkspaceInvitationStatus
+}
+
+func (WorkspaceInvitation) IsValidSortType(sortType string) bool {
+	validSortTypes := map[string]bool{
+		"id":        true,
+		"status":    true,
+		"expiresat": true,
+		"createdat": true,
+	}
+	return validSortTypes[sortType]
+}
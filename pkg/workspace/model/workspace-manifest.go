@@ -0,0 +1,106 @@
+package model
+
+import (
+	"time"
+
+	authz "github.com/CHORUS-TRE/chorus-backend/pkg/authorization/model"
+)
+
+const (
+	WorkspaceManifestAPIVersion = "chorus-tre.ch/v1"
+	WorkspaceManifestKind       = "Workspace"
+)
+
+// WorkspaceManifest is the declarative description of a workspace, meant to be versioned
+// in git and applied with the `workspace apply` command. Users are referenced by username
+// and service definitions by name so that manifests stay readable.
+type WorkspaceManifest struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+
+	// ID of the workspace to reconcile. The workspace is created when it is not set.
+	ID uint64 `json:"id,omitempty"`
+	// Owner is the username of the user the workspace is created for. It is ignored
+	// once the workspace exists.
+	Owner string `json:"owner,omitempty"`
+
+	Name        string              `json:"name"`
+	ShortName   string              `json:"shortName,omitempty"`
+	Description string              `json:"description,omitempty"`
+	Visibility  WorkspaceVisibility `json:"visibility,omitempty"`
+	// Contact is the username of the contact point of a public workspace.
+	Contact   string        `json:"contact,omitempty"`
+	Clipboard ClipboardMode `json:"clipboard,omitempty"`
+	ExpiresAt *time.Time    `json:"expiresAt,omitempty"`
+
+	NetworkPolicy WorkspaceManifestNetworkPolicy `json:"networkPolicy"`
+
+	Members          []WorkspaceManifestMember          `json:"members,omitempty"`
+	ServiceInstances []WorkspaceManifestServiceInstance `json:"serviceInstances,omitempty"`
+}
+
+type WorkspaceManifestNetworkPolicy struct {
+	Mode      NetworkPolicyMode `json:"mode,omitempty"`
+	FQDNRules FQDNRules         `json:"fqdnRules,omitempty"`
+}
+
+// WorkspaceManifestMember lists the workspace roles of a user. Users left out of the
+// manifest are removed from the workspace.
+type WorkspaceManifestMember struct {
+	Username string           `json:"username"`
+	Roles    []authz.RoleName `json:"roles"`
+}
+
+// WorkspaceManifestServiceInstance describes a service instance, either from a service
+// definition of the catalog or from a free-form chart spec.
+type WorkspaceManifestServiceInstance struct {
+	Name string `json:"name"`
+	// ServiceDefinition is the name of the service definition the chart spec comes from.
+	ServiceDefinition string               `json:"serviceDefinition,omitempty"`
+	State             ServiceInstanceState `json:"state,omitempty"`
+
+	ChartRegistry   string `json:"chartRegistry,omitempty"`
+	ChartRepository string `json:"chartRepository,omitempty"`
+	ChartTag        string `json:"chartTag,omitempty"`
+
+	Values                 map[string]any `json:"values,omitempty"`
+	CredentialsSecretName  string         `json:"credentialsSecretName,omitempty"`
+	CredentialsPaths       []string       `json:"credentialsPaths,omitempty"`
+	ConnectionInfoTemplate string         `json:"connectionInfoTemplate,omitempty"`
+}
+
+// WorkspaceManifestChangeAction tells how applying a manifest changes an item of a workspace.
+type WorkspaceManifestChangeAction string
+
+const (
+	WorkspaceManifestChangeAdd    WorkspaceManifestChangeAction = "add"
+	WorkspaceManifestChangeUpdate WorkspaceManifestChangeAction = "update"
+	WorkspaceManifestChangeRemove WorkspaceManifestChangeAction = "remove"
+)
+
+// WorkspaceManifestSection groups the changes of a manifest diff.
+type WorkspaceManifestSection string
+
+const (
+	WorkspaceManifestSectionSettings         WorkspaceManifestSection = "settings"
+	WorkspaceManifestSectionNetworkPolicy    WorkspaceManifestSection = "network policy"
+	WorkspaceManifestSectionMembers          WorkspaceManifestSection = "members"
+	WorkspaceManifestSectionServiceInstances WorkspaceManifestSection = "service instances"
+)
+
+var WorkspaceManifestSections = []WorkspaceManifestSection{
+	WorkspaceManifestSectionSettings,
+	WorkspaceManifestSectionNetworkPolicy,
+	WorkspaceManifestSectionMembers,
+	WorkspaceManifestSectionServiceInstances,
+}
+
+// WorkspaceManifestChange is a difference between a manifest and the current state of
+// its workspace. Current and Desired are rendered for display.
+type WorkspaceManifestChange struct {
+	Section WorkspaceManifestSection
+	Action  WorkspaceManifestChangeAction
+	Key     string
+	Current string
+	Desired string
+}
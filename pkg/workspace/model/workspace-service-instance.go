@@ -37,6 +37,9 @@ type WorkspaceServiceInstance struct {
 	WorkspaceID uint64 `validate:"required,min=1"`
 	Name        string `validate:"required,min=1,max=63,safestring"`
 
+	// ServiceDefinitionID references the catalog entry the chart spec comes from, if any.
+	ServiceDefinitionID *uint64
+
 	// Spec (desired state)
 	State                  ServiceInstanceState `validate:"omitempty,oneof=Running Stopped Deleted"`
 	ChartRegistry          string               `validate:"omitempty,generalstring"`
@@ -71,6 +74,13 @@ type WorkspaceServiceInstanceStatusUpdate struct {
 	SecretName     string
 }
 
+func (s WorkspaceServiceInstance) GetServiceDefinitionID() uint64 {
+	if s.ServiceDefinitionID != nil {
+		return *s.ServiceDefinitionID
+	}
+	return 0
+}
+
 func (WorkspaceServiceInstance) IsValidSortType(sortType string) bool {
 	validSortTypes := map[string]bool{
 		"id":        true,
@@ -0,0 +1,98 @@
+package model
+
+import (
+	"time"
+
+	authz "github.com/CHORUS-TRE/chorus-backend/pkg/authorization/model"
+)
+
+// WorkspaceTemplate maps an entry in the 'workspace_templates' database table.
+// A template is a blueprint used to provision a fully configured workspace in a single call.
+type WorkspaceTemplate struct {
+	ID uint64
+
+	TenantID uint64
+	UserID   uint64
+
+	Name        string `validate:"required,min=1,max=255,safestring"`
+	Description string `validate:"omitempty,generalstring"`
+
+	NetworkPolicy NetworkPolicyMode `validate:"omitempty,oneof=Open Airgapped FQDNAllowlist"`
+	AllowedFQDNs  FQDNRules
+	Clipboard     ClipboardMode `validate:"omitempty,oneof=disabled to-server to-client both"`
+
+	// Members is the default member-role layout applied to workspaces created from the template.
+	Members []WorkspaceTemplateMember `validate:"dive"`
+	// ServiceInstances are the chart specs deployed in workspaces created from the template.
+	ServiceInstances []*WorkspaceTemplateServiceInstance `validate:"dive"`
+	// AppIDs are the apps pre-selected for workspaces created from the template.
+	AppIDs []uint64 `validate:"dive,min=1"`
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+}
+
+// WorkspaceTemplateMember assigns a workspace role to a user when a workspace is created from a template.
+type WorkspaceTemplateMember struct {
+	UserID uint64         `json:"userId" validate:"required,min=1"`
+	Role   authz.RoleName `json:"role" validate:"required"`
+}
+
+// WorkspaceTemplateServiceInstance maps an entry in the 'workspace_template_services' database table.
+// It holds the spec of a WorkspaceServiceInstance, without any observed status.
+type WorkspaceTemplateServiceInstance struct {
+	ID         uint64
+	TenantID   uint64
+	TemplateID uint64
+	Name       string `validate:"required,min=1,max=63,safestring"`
+
+	// ServiceDefinitionID references the catalog entry the chart spec comes from, if any.
+	ServiceDefinitionID *uint64
+
+	ChartRegistry          string       `validate:"omitempty,generalstring"`
+	ChartRepository        string       `validate:"omitempty,generalstring"`
+	ChartTag               string       `validate:"omitempty,safestring"`
+	Values                 JSONMap[any] `db:"valuesoverride"`
+	CredentialsSecretName  string       `validate:"omitempty,safestring"`
+	CredentialsPaths       StringSlice
+	ConnectionInfoTemplate string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+}
+
+func (t WorkspaceTemplateServiceInstance) GetServiceDefinitionID() uint64 {
+	if t.ServiceDefinitionID != nil {
+		return *t.ServiceDefinitionID
+	}
+	return 0
+}
+
+// ToServiceInstance returns the workspace service instance described by the template spec.
+func (t WorkspaceTemplateServiceInstance) ToServiceInstance(tenantID, workspaceID uint64) *WorkspaceServiceInstance {
+	return &WorkspaceServiceInstance{
+		TenantID:               tenantID,
+		WorkspaceID:            workspaceID,
+		Name:                   t.Name,
+		ServiceDefinitionID:    t.ServiceDefinitionID,
+		State:                  ServiceInstanceStateRunning,
+		ChartRegistry:          t.ChartRegistry,
+		ChartRepository:        t.ChartRepository,
+		ChartTag:               t.ChartTag,
+		Values:                 t.Values,
+		CredentialsSecretName:  t.CredentialsSecretName,
+		CredentialsPaths:       t.CredentialsPaths,
+		ConnectionInfoTemplate: t.ConnectionInfoTemplate,
+	}
+}
+
+func (WorkspaceTemplate) IsValidSortType(sortType string) bool {
+	validSortTypes := map[string]bool{
+		"id":        true,
+		"name":      true,
+		"createdat": true,
+	}
+	return validSortTypes[sortType]
+}
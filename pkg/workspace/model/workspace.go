@@ -3,6 +3,8 @@ package model
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -19,6 +21,38 @@ func (n NetworkPolicyMode) String() string {
 	return string(n)
 }
 
+// openness ranks network policy modes from the most to the least restrictive.
+func (n NetworkPolicyMode) openness() int {
+	switch n {
+	case NetworkPolicyAirgapped:
+		return 0
+	case NetworkPolicyFQDNAllowlist:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// IsNetworkPolicyRelaxation reports whether moving from the current network policy to
+// the requested one opens up the workspace, either through a less restrictive mode or
+// through FQDN rules allowing traffic the current rules do not.
+func IsNetworkPolicyRelaxation(currentMode NetworkPolicyMode, currentRules FQDNRules, requestedMode NetworkPolicyMode, requestedRules FQDNRules) bool {
+	if requestedMode.openness() != currentMode.openness() {
+		return requestedMode.openness() > currentMode.openness()
+	}
+	if requestedMode != NetworkPolicyFQDNAllowlist {
+		return false
+	}
+
+	for _, rule := range requestedRules {
+		if !currentRules.Covers(rule) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // ClipboardMode defines the clipboard direction for a workspace.
 type ClipboardMode string
 
@@ -52,12 +86,23 @@ const (
 	WorkspaceStatusActive   WorkspaceStatus = "active"
 	WorkspaceStatusInactive WorkspaceStatus = "inactive"
 	WorkspaceStatusDeleted  WorkspaceStatus = "deleted"
+	// WorkspaceStatusArchived is set once the workspace end date has passed:
+	// its workbenches are removed and its files can only be read.
+	WorkspaceStatusArchived WorkspaceStatus = "archived"
+	// WorkspaceStatusFrozen is set by a platform manager to preserve a workspace as-is,
+	// e.g. for an audit or a legal hold. It is only lifted manually.
+	WorkspaceStatusFrozen WorkspaceStatus = "frozen"
 )
 
 func (s WorkspaceStatus) String() string {
 	return string(s)
 }
 
+// IsReadOnly reports whether workspace content can no longer be modified in this status.
+func (s WorkspaceStatus) IsReadOnly() bool {
+	return s == WorkspaceStatusArchived || s == WorkspaceStatusFrozen
+}
+
 // Workspace maps an entry in the 'workspaces' database table.
 type Workspace struct {
 	ID uint64
@@ -75,9 +120,15 @@ type Workspace struct {
 
 	// Network policy fields
 	NetworkPolicy        NetworkPolicyMode
-	AllowedFQDNs         StringSlice
+	AllowedFQDNs         FQDNRules
 	NetworkPolicyStatus  string
 	NetworkPolicyMessage string
+	// RejectedFQDNs holds the reason reported by the network policy controller for
+	// each FQDN rule pattern it could not apply.
+	RejectedFQDNs JSONMap[string]
+	// NetworkPolicyApprovalRequestID is set when an update relaxed the network policy and
+	// the change is pending in the referenced approval request. It is not persisted.
+	NetworkPolicyApprovalRequestID uint64 `db:"-"`
 
 	// Clipboard (workspace-wide default for workbenches)
 	Clipboard ClipboardMode
@@ -88,6 +139,21 @@ type Workspace struct {
 	// ContactUserID defines the user to list as contact point when the workspace is public
 	ContactUserID *uint64
 
+	// TemplateID references the workspace template the workspace was provisioned from, if any.
+	TemplateID *uint64
+	// AppIDs are the apps pre-selected for the workspace by its template.
+	AppIDs Uint64Slice
+
+	// ExpiresAt is the end date of the workspace, after which it is archived.
+	ExpiresAt *time.Time
+	// ExpiryNoticeDays is the lead time, in days, of the last expiry notice sent to the
+	// workspace admins. It is reset whenever the end date changes.
+	ExpiryNoticeDays *int
+
+	// WorkbenchIdleTimeoutMinutes is how long the workbenches of the workspace may stay
+	// idle before they are reclaimed. Unset means the platform default applies.
+	WorkbenchIdleTimeoutMinutes *int
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	DeletedAt *time.Time
@@ -213,6 +279,34 @@ func splitPostgresArray(s string) []string {
 	return result
 }
 
+// Uint64Slice handles PostgreSQL BIGINT[] columns.
+type Uint64Slice []uint64
+
+func (s *Uint64Slice) Scan(src interface{}) error {
+	var strs StringSlice
+	if err := strs.Scan(src); err != nil {
+		return err
+	}
+	ids := make(Uint64Slice, 0, len(strs))
+	for _, str := range strs {
+		id, err := strconv.ParseUint(str, 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to parse Uint64Slice item %q: %w", str, err)
+		}
+		ids = append(ids, id)
+	}
+	*s = ids
+	return nil
+}
+
+func (s Uint64Slice) Value() (interface{}, error) {
+	strs := make([]string, 0, len(s))
+	for _, id := range s {
+		strs = append(strs, strconv.FormatUint(id, 10))
+	}
+	return "{" + strings.Join(strs, ",") + "}", nil
+}
+
 type WorkspaceFilter struct {
 	WorkspaceIDsIn *[]uint64
 }
@@ -241,6 +335,20 @@ func (s Workspace) GetContactUserID() uint64 {
 	return 0
 }
 
+func (s Workspace) GetTemplateID() uint64 {
+	if s.TemplateID != nil {
+		return *s.TemplateID
+	}
+	return 0
+}
+
+func (s Workspace) GetWorkbenchIdleTimeoutMinutes() int {
+	if s.WorkbenchIdleTimeoutMinutes != nil {
+		return *s.WorkbenchIdleTimeoutMinutes
+	}
+	return 0
+}
+
 func (Workspace) IsValidSortType(sortType string) bool {
 	validSortTypes := map[string]bool{
 		"id":          true,
@@ -0,0 +1,291 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/audit"
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/internal/mailer"
+	audit_model "github.com/CHORUS-TRE/chorus-backend/pkg/audit/model"
+	audit_service "github.com/CHORUS-TRE/chorus-backend/pkg/audit/service"
+	authz "github.com/CHORUS-TRE/chorus-backend/pkg/authorization/model"
+	notification_model "github.com/CHORUS-TRE/chorus-backend/pkg/notification/model"
+	user_model "github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
+	user_service "github.com/CHORUS-TRE/chorus-backend/pkg/user/service"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
+
+	"go.uber.org/zap"
+)
+
+// defaultExpiryNoticeDays are the lead times, in days, at which workspace admins are
+// warned about the end date of a workspace when the job has no notice_days option.
+var defaultExpiryNoticeDays = []int{30, 7, 1}
+
+// WorkspaceExpiryJob warns workspace admins ahead of the end date of their workspace
+// and archives the workspace once the date is reached.
+type WorkspaceExpiryJob struct {
+	store             WorkspaceStore
+	workbencher       Workbencher
+	userer            Userer
+	notificationStore NotificationStore
+	mailer            mailer.Mailer
+	auditWriter       audit_service.AuditWriter
+	log               *logger.ContextLogger
+}
+
+func NewWorkspaceExpiryJob(store WorkspaceStore, workbencher Workbencher, userer Userer, notificationStore NotificationStore, mailer mailer.Mailer, auditWriter audit_service.AuditWriter, log *logger.ContextLogger) *WorkspaceExpiryJob {
+	return &WorkspaceExpiryJob{
+		store:             store,
+		workbencher:       workbencher,
+		userer:            userer,
+		notificationStore: notificationStore,
+		mailer:            mailer,
+		auditWriter:       auditWriter,
+		log:               log,
+	}
+}
+
+func (j *WorkspaceExpiryJob) Do(ctx context.Context, options map[string]interface{}) (string, error) {
+	noticeDays, err := intListOption(options, "notice_days", defaultExpiryNoticeDays)
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	horizon := now
+	if len(noticeDays) > 0 {
+		horizon = now.Add(time.Duration(slices.Max(noticeDays)) * 24 * time.Hour)
+	}
+
+	workspaces, err := j.store.ListExpiringWorkspaces(ctx, horizon)
+	if err != nil {
+		return "", fmt.Errorf("listing expiring workspaces: %w", err)
+	}
+
+	archived, notified, failed := 0, 0, 0
+	for _, ws := range workspaces {
+		if ws.Status == model.WorkspaceStatusArchived {
+			// Removes the workbenches a previous run failed to delete after archiving.
+			if err := j.workbencher.DeleteWorkbenchesInWorkspace(ctx, ws.TenantID, ws.ID); err != nil {
+				j.log.Error(ctx, "unable to delete workbenches of archived workspace", logger.WithWorkspaceIDField(ws.ID), zap.Error(err))
+				failed++
+			}
+			continue
+		}
+
+		if !ws.ExpiresAt.After(now) {
+			ok, err := j.archive(ctx, ws)
+			if err != nil {
+				j.log.Error(ctx, "unable to archive expired workspace", logger.WithWorkspaceIDField(ws.ID), zap.Error(err))
+				failed++
+				continue
+			}
+			if ok {
+				archived++
+			}
+			continue
+		}
+
+		days, ok := expiryNoticeDue(noticeDays, ws.ExpiresAt.Sub(now))
+		if !ok || (ws.ExpiryNoticeDays != nil && *ws.ExpiryNoticeDays <= days) {
+			continue
+		}
+
+		remaining := expiryDaysLeft(ws.ExpiresAt.Sub(now))
+		message := fmt.Sprintf("Workspace %q will be archived on %s (in %d day(s)). Its workbenches will be deleted and its files will become read-only. Ask a workspace admin to extend its end date if more time is needed.",
+			ws.Name, ws.ExpiresAt.Format(time.DateOnly), remaining)
+		j.notifyAdmins(ctx, ws, "Workspace end date approaching", message)
+
+		if err := j.store.UpdateWorkspaceExpiryNotice(ctx, ws.TenantID, ws.ID, days); err != nil {
+			j.log.Error(ctx, "unable to record workspace expiry notice", logger.WithWorkspaceIDField(ws.ID), zap.Error(err))
+			failed++
+			continue
+		}
+		notified++
+	}
+
+	msg := fmt.Sprintf("archived %d workspaces, sent %d expiry notices", archived, notified)
+	if failed > 0 {
+		return "", fmt.Errorf("%s, %d failures", msg, failed)
+	}
+	return msg, nil
+}
+
+// archive moves an expired workspace to the archived status, which stops new workbenches
+// from being created in it, then removes its workbenches. It reports false when the
+// workspace was extended or changed status since it was listed. Workbenches left over by
+// a failure are removed on the next run.
+func (j *WorkspaceExpiryJob) archive(ctx context.Context, ws *model.Workspace) (bool, error) {
+	if err := j.store.ArchiveWorkspace(ctx, ws.TenantID, ws.ID); err != nil {
+		if errors.Is(err, cerr.ErrNoRowsUpdated) {
+			return false, nil
+		}
+		return false, fmt.Errorf("archiving workspace: %w", err)
+	}
+
+	if err := j.workbencher.DeleteWorkbenchesInWorkspace(ctx, ws.TenantID, ws.ID); err != nil {
+		return true, fmt.Errorf("deleting workbenches: %w", err)
+	}
+
+	audit.Record(ctx, j.auditWriter, audit_model.AuditActionWorkspaceArchive,
+		audit.WithTenantID(ws.TenantID),
+		audit.WithActorUsername("system"),
+		audit.WithWorkspaceID(ws.ID),
+		audit.WithDescription(fmt.Sprintf("Workspace '%s' (ID %d) archived as its end date %s was reached.", ws.Name, ws.ID, ws.ExpiresAt.Format(time.RFC3339))),
+		audit.WithDetail("trigger", "expiry"),
+		audit.WithDetail("expires_at", ws.ExpiresAt.Format(time.RFC3339)),
+	)
+
+	message := fmt.Sprintf("Workspace %q has reached its end date and was archived. Its workbenches were deleted and its files are now read-only.", ws.Name)
+	j.notifyAdmins(ctx, ws, "Workspace archived", message)
+
+	return true, nil
+}
+
+// notifyAdmins sends a notification and an email to the admins of a workspace, or to its
+// owner when it has none. Failures are only logged.
+func (j *WorkspaceExpiryJob) notifyAdmins(ctx context.Context, ws *model.Workspace, title, message string) {
+	admins, err := j.workspaceAdmins(ctx, ws)
+	if err != nil {
+		j.log.Error(ctx, "unable to list workspace admins", logger.WithWorkspaceIDField(ws.ID), zap.Error(err))
+		return
+	}
+	if len(admins) == 0 {
+		return
+	}
+
+	userIDs := make([]uint64, 0, len(admins))
+	emails := make([]string, 0, len(admins))
+	for _, u := range admins {
+		userIDs = append(userIDs, u.ID)
+		if u.Email != "" {
+			emails = append(emails, u.Email)
+		}
+	}
+
+	err = j.notificationStore.CreateNotification(ctx, &notification_model.Notification{
+		TenantID: ws.TenantID,
+		Message:  message,
+		Content: notification_model.NotificationContent{
+			Type:               "SystemNotification",
+			SystemNotification: &notification_model.SystemNotification{},
+		},
+	}, userIDs)
+	if err != nil {
+		j.log.Error(ctx, "unable to notify workspace admins", logger.WithWorkspaceIDField(ws.ID), zap.Error(err))
+	}
+
+	if len(emails) == 0 {
+		return
+	}
+	subject := j.mailer.GetSubject(ctx, ws.TenantID, "workspaceExpiry")
+	if subject == "" {
+		subject = title
+	}
+	if err := j.mailer.SendMessage(ctx, ws.TenantID, emails, subject, title, message); err != nil {
+		j.log.Error(ctx, "unable to email workspace admins", logger.WithWorkspaceIDField(ws.ID), zap.Error(err))
+	}
+}
+
+func (j *WorkspaceExpiryJob) workspaceAdmins(ctx context.Context, ws *model.Workspace) ([]*user_model.User, error) {
+	users, _, err := j.userer.ListUsers(ctx, user_service.ListUsersReq{
+		TenantID: ws.TenantID,
+		Filter:   &user_service.UserFilter{WorkspaceIDs: []uint64{ws.ID}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	workspaceID := strconv.FormatUint(ws.ID, 10)
+	var admins []*user_model.User
+	for _, u := range users {
+		if slices.ContainsFunc(u.Roles, func(r user_model.UserRole) bool {
+			return r.Name == authz.RoleWorkspaceAdmin.Name && r.Context[authz.ContextWorkspace] == workspaceID
+		}) {
+			admins = append(admins, u)
+		}
+	}
+
+	if len(admins) == 0 {
+		owner, err := j.userer.GetUser(ctx, user_service.GetUserReq{TenantID: ws.TenantID, ID: ws.UserID})
+		if err != nil {
+			return nil, err
+		}
+		admins = append(admins, owner)
+	}
+
+	return admins, nil
+}
+
+// expiryNoticeDue returns the smallest lead time, in days, that the remaining duration
+// has already reached, if any.
+func expiryNoticeDue(noticeDays []int, remaining time.Duration) (int, bool) {
+	due, found := 0, false
+	for _, d := range noticeDays {
+		if remaining <= time.Duration(d)*24*time.Hour && (!found || d < due) {
+			due, found = d, true
+		}
+	}
+	return due, found
+}
+
+// expiryDaysLeft returns the number of days left before the end date, counting a started
+// day as a whole one.
+func expiryDaysLeft(remaining time.Duration) int {
+	return int(math.Ceil(remaining.Hours() / 24))
+}
+
+func intListOption(options map[string]interface{}, key string, def []int) ([]int, error) {
+	v, ok := options[key]
+	if !ok {
+		return def, nil
+	}
+
+	var items []interface{}
+	switch l := v.(type) {
+	case []interface{}:
+		items = l
+	case []int:
+		return l, nil
+	case string:
+		for _, part := range strings.Split(l, ",") {
+			items = append(items, strings.TrimSpace(part))
+		}
+	default:
+		return nil, fmt.Errorf("invalid %s option: unsupported type %T", key, v)
+	}
+
+	res := make([]int, 0, len(items))
+	for _, item := range items {
+		var n int
+		switch i := item.(type) {
+		case int:
+			n = i
+		case int64:
+			n = int(i)
+		case float64:
+			n = int(i)
+		case string:
+			parsed, err := strconv.Atoi(i)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s option: %w", key, err)
+			}
+			n = parsed
+		default:
+			return nil, fmt.Errorf("invalid %s option: unsupported item type %T", key, item)
+		}
+		if n <= 0 {
+			return nil, fmt.Errorf("invalid %s option: lead times must be positive, got %d", key, n)
+		}
+		res = append(res, n)
+	}
+	return res, nil
+}
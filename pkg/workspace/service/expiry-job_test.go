@@ -0,0 +1,177 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"errors"
+	"html/template"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/internal/mailer"
+	authorization_model "github.com/CHORUS-TRE/chorus-backend/pkg/authorization/model"
+	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
+	user_model "github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
+	user_service "github.com/CHORUS-TRE/chorus-backend/pkg/user/service"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
+)
+
+type mockMailer struct {
+	sentTo [][]string
+}
+
+func (m *mockMailer) SendMessage(_ context.Context, _ uint64, to []string, _, _, _ string) error {
+	m.sentTo = append(m.sentTo, to)
+	return nil
+}
+
+func (m *mockMailer) Send(_ context.Context, _ uint64, _ []string, _ string, _ *template.Template, _ interface{}) error {
+	return nil
+}
+
+func (m *mockMailer) GetSubject(_ context.Context, _ uint64, _ string) string { return "" }
+
+func (m *mockMailer) GetTemplate(_ context.Context, _ uint64, _ mailer.TemplateKey) *template.Template {
+	return nil
+}
+
+type recordingWorkbencher struct {
+	mockWorkbencher
+	deletedInWorkspaces []uint64
+	// store checks that workspaces are archived before their workbenches are deleted.
+	store *mockWorkspaceStore
+}
+
+func (m *recordingWorkbencher) DeleteWorkbenchesInWorkspace(_ context.Context, _, workspaceID uint64) error {
+	if m.store != nil && !slices.Contains(m.store.archivedWorkspaceIDs, workspaceID) {
+		return errors.New("workspace is not archived")
+	}
+	m.deletedInWorkspaces = append(m.deletedInWorkspaces, workspaceID)
+	return nil
+}
+
+func newExpiryJob(store WorkspaceStore, workbencher Workbencher, mail *mockMailer) *WorkspaceExpiryJob {
+	userer := &mockUserer{
+		listUsers: func(_ context.Context, req user_service.ListUsersReq) ([]*user_model.User, *common_model.PaginationResult, error) {
+			id := req.Filter.WorkspaceIDs[0]
+			return []*user_model.User{
+				{ID: 1, Email: "admin@example.com", Roles: []user_model.UserRole{wsRole(1, id, authorization_model.RoleWorkspaceAdmin.Name)}},
+				{ID: 2, Email: "member@example.com", Roles: []user_model.UserRole{wsRole(2, id, authorization_model.RoleWorkspaceMember.Name)}},
+			}, nil, nil
+		},
+	}
+	return NewWorkspaceExpiryJob(store, workbencher, userer, &mockNotificationStore{}, mail, &mockAuditWriter{}, logger.TechLog)
+}
+
+func TestWorkspaceExpiryJob_ArchivesExpiredWorkspace(t *testing.T) {
+	expired := time.Now().Add(-time.Hour)
+	store := &mockWorkspaceStore{
+		listExpiringWorkspaces: func(_ context.Context, _ time.Time) ([]*model.Workspace, error) {
+			return []*model.Workspace{{ID: 10, TenantID: 1, Name: "ws", Status: model.WorkspaceStatusActive, ExpiresAt: &expired}}, nil
+		},
+	}
+	workbencher := &recordingWorkbencher{store: store}
+	mail := &mockMailer{}
+
+	_, err := newExpiryJob(store, workbencher, mail).Do(context.Background(), nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{10}, workbencher.deletedInWorkspaces)
+	assert.Equal(t, []uint64{10}, store.archivedWorkspaceIDs)
+	assert.Equal(t, [][]string{{"admin@example.com"}}, mail.sentTo)
+}
+
+func TestWorkspaceExpiryJob_SkipsWorkspaceExtendedMeanwhile(t *testing.T) {
+	expired := time.Now().Add(-time.Hour)
+	store := &mockWorkspaceStore{
+		listExpiringWorkspaces: func(_ context.Context, _ time.Time) ([]*model.Workspace, error) {
+			return []*model.Workspace{{ID: 10, TenantID: 1, Name: "ws", Status: model.WorkspaceStatusActive, ExpiresAt: &expired}}, nil
+		},
+		archiveErr: cerr.ErrNoRowsUpdated,
+	}
+	workbencher := &recordingWorkbencher{}
+	mail := &mockMailer{}
+
+	_, err := newExpiryJob(store, workbencher, mail).Do(context.Background(), nil)
+
+	require.NoError(t, err)
+	assert.Empty(t, workbencher.deletedInWorkspaces)
+	assert.Empty(t, mail.sentTo)
+}
+
+func TestWorkspaceExpiryJob_RemovesLeftoverWorkbenchesOfArchivedWorkspace(t *testing.T) {
+	expired := time.Now().Add(-time.Hour)
+	store := &mockWorkspaceStore{
+		listExpiringWorkspaces: func(_ context.Context, _ time.Time) ([]*model.Workspace, error) {
+			return []*model.Workspace{{ID: 10, TenantID: 1, Name: "ws", Status: model.WorkspaceStatusArchived, ExpiresAt: &expired}}, nil
+		},
+	}
+	workbencher := &recordingWorkbencher{}
+	mail := &mockMailer{}
+
+	_, err := newExpiryJob(store, workbencher, mail).Do(context.Background(), nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{10}, workbencher.deletedInWorkspaces)
+	assert.Empty(t, store.archivedWorkspaceIDs)
+	assert.Empty(t, mail.sentTo, "admins are only notified once")
+}
+
+func TestWorkspaceExpiryJob_SendsEachNoticeOnce(t *testing.T) {
+	expiresAt := time.Now().Add(6 * 24 * time.Hour)
+	alreadyNotified := 7
+	store := &mockWorkspaceStore{
+		listExpiringWorkspaces: func(_ context.Context, _ time.Time) ([]*model.Workspace, error) {
+			return []*model.Workspace{
+				{ID: 10, TenantID: 1, Status: model.WorkspaceStatusActive, ExpiresAt: &expiresAt},
+				{ID: 11, TenantID: 1, Status: model.WorkspaceStatusActive, ExpiresAt: &expiresAt, ExpiryNoticeDays: &alreadyNotified},
+			}, nil
+		},
+	}
+	workbencher := &recordingWorkbencher{}
+	mail := &mockMailer{}
+
+	_, err := newExpiryJob(store, workbencher, mail).Do(context.Background(), map[string]interface{}{"notice_days": "30,7,1"})
+
+	require.NoError(t, err)
+	assert.Equal(t, map[uint64]int{10: 7}, store.expiryNotices)
+	assert.Len(t, mail.sentTo, 1)
+	assert.Empty(t, store.archivedWorkspaceIDs)
+	assert.Empty(t, workbencher.deletedInWorkspaces)
+}
+
+func TestExpiryDaysLeft(t *testing.T) {
+	day := 24 * time.Hour
+	assert.Equal(t, 7, expiryDaysLeft(7*day))
+	assert.Equal(t, 7, expiryDaysLeft(6*day+time.Minute))
+	assert.Equal(t, 1, expiryDaysLeft(time.Hour))
+	assert.Equal(t, 1, expiryDaysLeft(day))
+}
+
+func TestExpiryNoticeDue(t *testing.T) {
+	day := 24 * time.Hour
+	tests := []struct {
+		remaining time.Duration
+		want      int
+		wantOK    bool
+	}{
+		{remaining: 40 * day, wantOK: false},
+		{remaining: 30 * day, want: 30, wantOK: true},
+		{remaining: 8 * day, want: 30, wantOK: true},
+		{remaining: 3 * day, want: 7, wantOK: true},
+		{remaining: time.Hour, want: 1, wantOK: true},
+	}
+
+	for _, tt := range tests {
+		got, ok := expiryNoticeDue(defaultExpiryNoticeDays, tt.remaining)
+		assert.Equal(t, tt.wantOK, ok, tt.remaining)
+		assert.Equal(t, tt.want, got, tt.remaining)
+	}
+}
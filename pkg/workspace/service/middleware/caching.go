@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"context"
+	"time"
 
 	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
 	"github.com/CHORUS-TRE/chorus-backend/internal/utils/cache"
@@ -82,6 +83,26 @@ func (c *Caching) DeleteWorkspace(ctx context.Context, tenantID, workspaceID uin
 	return c.next.DeleteWorkspace(ctx, tenantID, workspaceID)
 }
 
+func (c *Caching) ExtendWorkspace(ctx context.Context, tenantID, workspaceID uint64, expiresAt time.Time) (*model.Workspace, error) {
+	return c.next.ExtendWorkspace(ctx, tenantID, workspaceID, expiresAt)
+}
+
+func (c *Caching) UpdateWorkspaceNetworkPolicy(ctx context.Context, tenantID, workspaceID uint64, networkPolicy model.NetworkPolicyMode, allowedFQDNs model.FQDNRules) (*model.Workspace, error) {
+	return c.next.UpdateWorkspaceNetworkPolicy(ctx, tenantID, workspaceID, networkPolicy, allowedFQDNs)
+}
+
+func (c *Caching) UpdateWorkspaceOwner(ctx context.Context, tenantID, workspaceID, userID uint64) (*model.Workspace, error) {
+	return c.next.UpdateWorkspaceOwner(ctx, tenantID, workspaceID, userID)
+}
+
+func (c *Caching) FreezeWorkspace(ctx context.Context, tenantID, workspaceID uint64) (*model.Workspace, error) {
+	return c.next.FreezeWorkspace(ctx, tenantID, workspaceID)
+}
+
+func (c *Caching) UnfreezeWorkspace(ctx context.Context, tenantID, workspaceID uint64) (*model.Workspace, error) {
+	return c.next.UnfreezeWorkspace(ctx, tenantID, workspaceID)
+}
+
 func (c *Caching) UpdateWorkspace(ctx context.Context, workspace *model.Workspace) (*model.Workspace, error) {
 	return c.next.UpdateWorkspace(ctx, workspace)
 }
@@ -94,6 +115,10 @@ func (c *Caching) AddUserRoleInWorkspace(ctx context.Context, tenantID, userID u
 	return c.next.AddUserRoleInWorkspace(ctx, tenantID, userID, role)
 }
 
+func (c *Caching) GrantUserRoleInWorkspace(ctx context.Context, tenantID, userID, workspaceID uint64, roleName authorization_model.RoleName) (bool, error) {
+	return c.next.GrantUserRoleInWorkspace(ctx, tenantID, userID, workspaceID, roleName)
+}
+
 func (c *Caching) RemoveUserRoleInWorkspace(ctx context.Context, tenantID, userID, workspaceID uint64, roleName authorization_model.RoleName) error {
 	return c.next.RemoveUserRoleInWorkspace(ctx, tenantID, userID, workspaceID, roleName)
 }
@@ -125,3 +150,75 @@ func (c *Caching) UpdateWorkspaceServiceInstance(ctx context.Context, svc *model
 func (c *Caching) DeleteWorkspaceServiceInstance(ctx context.Context, tenantID, workspaceServiceInstanceID uint64) error {
 	return c.next.DeleteWorkspaceServiceInstance(ctx, tenantID, workspaceServiceInstanceID)
 }
+
+func (c *Caching) GetWorkspaceTemplate(ctx context.Context, tenantID, templateID uint64) (*model.WorkspaceTemplate, error) {
+	return c.next.GetWorkspaceTemplate(ctx, tenantID, templateID)
+}
+
+func (c *Caching) ListWorkspaceTemplates(ctx context.Context, tenantID uint64, pagination *common_model.Pagination) ([]*model.WorkspaceTemplate, *common_model.PaginationResult, error) {
+	return c.next.ListWorkspaceTemplates(ctx, tenantID, pagination)
+}
+
+func (c *Caching) CreateWorkspaceTemplate(ctx context.Context, template *model.WorkspaceTemplate) (*model.WorkspaceTemplate, error) {
+	return c.next.CreateWorkspaceTemplate(ctx, template)
+}
+
+func (c *Caching) UpdateWorkspaceTemplate(ctx context.Context, template *model.WorkspaceTemplate) (*model.WorkspaceTemplate, error) {
+	return c.next.UpdateWorkspaceTemplate(ctx, template)
+}
+
+func (c *Caching) DeleteWorkspaceTemplate(ctx context.Context, tenantID, templateID uint64) error {
+	return c.next.DeleteWorkspaceTemplate(ctx, tenantID, templateID)
+}
+
+func (c *Caching) CreateWorkspaceFromTemplate(ctx context.Context, templateID uint64, workspace *model.Workspace) (*model.Workspace, []*model.WorkspaceServiceInstance, error) {
+	return c.next.CreateWorkspaceFromTemplate(ctx, templateID, workspace)
+}
+
+func (c *Caching) GetServiceDefinition(ctx context.Context, tenantID, serviceDefinitionID uint64) (*model.ServiceDefinition, error) {
+	return c.next.GetServiceDefinition(ctx, tenantID, serviceDefinitionID)
+}
+
+func (c *Caching) ListServiceDefinitions(ctx context.Context, tenantID uint64, pagination *common_model.Pagination) ([]*model.ServiceDefinition, *common_model.PaginationResult, error) {
+	return c.next.ListServiceDefinitions(ctx, tenantID, pagination)
+}
+
+func (c *Caching) CreateServiceDefinition(ctx context.Context, def *model.ServiceDefinition) (*model.ServiceDefinition, error) {
+	return c.next.CreateServiceDefinition(ctx, def)
+}
+
+func (c *Caching) UpdateServiceDefinition(ctx context.Context, def *model.ServiceDefinition) (*model.ServiceDefinition, error) {
+	return c.next.UpdateServiceDefinition(ctx, def)
+}
+
+func (c *Caching) DeleteServiceDefinition(ctx context.Context, tenantID, serviceDefinitionID uint64) error {
+	return c.next.DeleteServiceDefinition(ctx, tenantID, serviceDefinitionID)
+}
+
+func (c *Caching) GetWorkspaceInvitation(ctx context.Context, tenantID, invitationID uint64) (*model.WorkspaceInvitation, error) {
+	return c.next.GetWorkspaceInvitation(ctx, tenantID, invitationID)
+}
+
+func (c *Caching) ListWorkspaceInvitations(ctx context.Context, tenantID, workspaceID uint64, pagination *common_model.Pagination, filter model.WorkspaceInvitationFilter) ([]*model.WorkspaceInvitation, *common_model.PaginationResult, error) {
+	return c.next.ListWorkspaceInvitations(ctx, tenantID, workspaceID, pagination, filter)
+}
+
+func (c *Caching) ListInviteeWorkspaceInvitations(ctx context.Context, tenantID, userID uint64, pagination *common_model.Pagination) ([]*model.WorkspaceInvitation, *common_model.PaginationResult, error) {
+	return c.next.ListInviteeWorkspaceInvitations(ctx, tenantID, userID, pagination)
+}
+
+func (c *Caching) CreateWorkspaceInvitation(ctx context.Context, invitation *model.WorkspaceInvitation) (*model.WorkspaceInvitation, error) {
+	return c.next.CreateWorkspaceInvitation(ctx, invitation)
+}
+
+func (c *Caching) AcceptWorkspaceInvitation(ctx context.Context, tenantID, userID, invitationID uint64, token string) (*model.WorkspaceInvitation, error) {
+	return c.next.AcceptWorkspaceInvitation(ctx, tenantID, userID, invitationID, token)
+}
+
+func (c *Caching) DeclineWorkspaceInvitation(ctx context.Context, tenantID, userID, invitationID uint64, token string) (*model.WorkspaceInvitation, error) {
+	return c.next.DeclineWorkspaceInvitation(ctx, tenantID, userID, invitationID, token)
+}
+
+func (c *Caching) RevokeWorkspaceInvitation(ctx context.Context, tenantID, invitationID uint64) (*model.WorkspaceInvitation, error) {
+	return c.next.RevokeWorkspaceInvitation(ctx, tenantID, invitationID)
+}
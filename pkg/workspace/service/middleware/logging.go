@@ -108,6 +108,110 @@ func (c workspaceServiceLogging) DeleteWorkspace(ctx context.Context, tenantID,
 	return nil
 }
 
+func (c workspaceServiceLogging) ExtendWorkspace(ctx context.Context, tenantID, workspaceID uint64, expiresAt time.Time) (*model.Workspace, error) {
+	now := time.Now()
+
+	workspace, err := c.next.ExtendWorkspace(ctx, tenantID, workspaceID, expiresAt)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkspaceIDField(workspaceID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWorkspaceIDField(workspaceID),
+		zap.Time("expires_at", expiresAt),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return workspace, nil
+}
+
+func (c workspaceServiceLogging) UpdateWorkspaceNetworkPolicy(ctx context.Context, tenantID, workspaceID uint64, networkPolicy model.NetworkPolicyMode, allowedFQDNs model.FQDNRules) (*model.Workspace, error) {
+	now := time.Now()
+
+	workspace, err := c.next.UpdateWorkspaceNetworkPolicy(ctx, tenantID, workspaceID, networkPolicy, allowedFQDNs)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkspaceIDField(workspaceID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWorkspaceIDField(workspaceID),
+		zap.String("network_policy", networkPolicy.String()),
+		zap.Strings("allowed_fqdns", allowedFQDNs.Patterns()),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return workspace, nil
+}
+
+func (c workspaceServiceLogging) UpdateWorkspaceOwner(ctx context.Context, tenantID, workspaceID, userID uint64) (*model.Workspace, error) {
+	now := time.Now()
+
+	workspace, err := c.next.UpdateWorkspaceOwner(ctx, tenantID, workspaceID, userID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkspaceIDField(workspaceID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWorkspaceIDField(workspaceID),
+		logger.WithUserIDField(userID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return workspace, nil
+}
+
+func (c workspaceServiceLogging) FreezeWorkspace(ctx context.Context, tenantID, workspaceID uint64) (*model.Workspace, error) {
+	now := time.Now()
+
+	workspace, err := c.next.FreezeWorkspace(ctx, tenantID, workspaceID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkspaceIDField(workspaceID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWorkspaceIDField(workspaceID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return workspace, nil
+}
+
+func (c workspaceServiceLogging) UnfreezeWorkspace(ctx context.Context, tenantID, workspaceID uint64) (*model.Workspace, error) {
+	now := time.Now()
+
+	workspace, err := c.next.UnfreezeWorkspace(ctx, tenantID, workspaceID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkspaceIDField(workspaceID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWorkspaceIDField(workspaceID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return workspace, nil
+}
+
 func (c workspaceServiceLogging) UpdateWorkspace(ctx context.Context, workspace *model.Workspace) (*model.Workspace, error) {
 	now := time.Now()
 
@@ -168,6 +272,28 @@ func (c workspaceServiceLogging) AddUserRoleInWorkspace(ctx context.Context, ten
 	return nil
 }
 
+func (c workspaceServiceLogging) GrantUserRoleInWorkspace(ctx context.Context, tenantID, userID, workspaceID uint64, roleName authorization_model.RoleName) (bool, error) {
+	now := time.Now()
+
+	granted, err := c.next.GrantUserRoleInWorkspace(ctx, tenantID, userID, workspaceID, roleName)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkspaceIDField(workspaceID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return false, err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWorkspaceIDField(workspaceID),
+		zap.Bool("granted", granted),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+
+	return granted, nil
+}
+
 func (c workspaceServiceLogging) RemoveUserRoleInWorkspace(ctx context.Context, tenantID, userID, workspaceID uint64, roleName authorization_model.RoleName) error {
 	now := time.Now()
 
@@ -324,3 +450,368 @@ func (c workspaceServiceLogging) DeleteWorkspaceServiceInstance(ctx context.Cont
 	)
 	return nil
 }
+
+func (c workspaceServiceLogging) GetWorkspaceTemplate(ctx context.Context, tenantID, templateID uint64) (*model.WorkspaceTemplate, error) {
+	now := time.Now()
+
+	res, err := c.next.GetWorkspaceTemplate(ctx, tenantID, templateID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Uint64("workspace_template_id", templateID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		zap.Uint64("workspace_template_id", templateID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workspaceServiceLogging) ListWorkspaceTemplates(ctx context.Context, tenantID uint64, pagination *common_model.Pagination) ([]*model.WorkspaceTemplate, *common_model.PaginationResult, error) {
+	now := time.Now()
+
+	res, paginationRes, err := c.next.ListWorkspaceTemplates(ctx, tenantID, pagination)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, nil, err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		zap.Int("num_workspace_templates", len(res)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, paginationRes, nil
+}
+
+func (c workspaceServiceLogging) CreateWorkspaceTemplate(ctx context.Context, template *model.WorkspaceTemplate) (*model.WorkspaceTemplate, error) {
+	now := time.Now()
+
+	created, err := c.next.CreateWorkspaceTemplate(ctx, template)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		zap.Uint64("workspace_template_id", created.ID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return created, nil
+}
+
+func (c workspaceServiceLogging) UpdateWorkspaceTemplate(ctx context.Context, template *model.WorkspaceTemplate) (*model.WorkspaceTemplate, error) {
+	now := time.Now()
+
+	updated, err := c.next.UpdateWorkspaceTemplate(ctx, template)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Uint64("workspace_template_id", template.ID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		zap.Uint64("workspace_template_id", updated.ID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return updated, nil
+}
+
+func (c workspaceServiceLogging) DeleteWorkspaceTemplate(ctx context.Context, tenantID, templateID uint64) error {
+	now := time.Now()
+
+	err := c.next.DeleteWorkspaceTemplate(ctx, tenantID, templateID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Uint64("workspace_template_id", templateID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		zap.Uint64("workspace_template_id", templateID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c workspaceServiceLogging) CreateWorkspaceFromTemplate(ctx context.Context, templateID uint64, workspace *model.Workspace) (*model.Workspace, []*model.WorkspaceServiceInstance, error) {
+	now := time.Now()
+
+	created, svcs, err := c.next.CreateWorkspaceFromTemplate(ctx, templateID, workspace)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Uint64("workspace_template_id", templateID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, nil, err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		zap.Uint64("workspace_template_id", templateID),
+		zap.Uint64("workspace_id", created.ID),
+		zap.Int("num_workspace_service_instances", len(svcs)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return created, svcs, nil
+}
+
+func (c workspaceServiceLogging) GetServiceDefinition(ctx context.Context, tenantID, serviceDefinitionID uint64) (*model.ServiceDefinition, error) {
+	now := time.Now()
+
+	res, err := c.next.GetServiceDefinition(ctx, tenantID, serviceDefinitionID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Uint64("service_definition_id", serviceDefinitionID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		zap.Uint64("service_definition_id", serviceDefinitionID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workspaceServiceLogging) ListServiceDefinitions(ctx context.Context, tenantID uint64, pagination *common_model.Pagination) ([]*model.ServiceDefinition, *common_model.PaginationResult, error) {
+	now := time.Now()
+
+	res, paginationRes, err := c.next.ListServiceDefinitions(ctx, tenantID, pagination)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, nil, err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		zap.Int("num_service_definitions", len(res)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, paginationRes, nil
+}
+
+func (c workspaceServiceLogging) CreateServiceDefinition(ctx context.Context, def *model.ServiceDefinition) (*model.ServiceDefinition, error) {
+	now := time.Now()
+
+	created, err := c.next.CreateServiceDefinition(ctx, def)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		zap.Uint64("service_definition_id", created.ID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return created, nil
+}
+
+func (c workspaceServiceLogging) UpdateServiceDefinition(ctx context.Context, def *model.ServiceDefinition) (*model.ServiceDefinition, error) {
+	now := time.Now()
+
+	updated, err := c.next.UpdateServiceDefinition(ctx, def)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Uint64("service_definition_id", def.ID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		zap.Uint64("service_definition_id", updated.ID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return updated, nil
+}
+
+func (c workspaceServiceLogging) DeleteServiceDefinition(ctx context.Context, tenantID, serviceDefinitionID uint64) error {
+	now := time.Now()
+
+	err := c.next.DeleteServiceDefinition(ctx, tenantID, serviceDefinitionID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Uint64("service_definition_id", serviceDefinitionID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		zap.Uint64("service_definition_id", serviceDefinitionID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c workspaceServiceLogging) GetWorkspaceInvitation(ctx context.Context, tenantID, invitationID uint64) (*model.WorkspaceInvitation, error) {
+	now := time.Now()
+
+	res, err := c.next.GetWorkspaceInvitation(ctx, tenantID, invitationID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Uint64("workspace_invitation_id", invitationID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		zap.Uint64("workspace_invitation_id", invitationID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workspaceServiceLogging) ListWorkspaceInvitations(ctx context.Context, tenantID, workspaceID uint64, pagination *common_model.Pagination, filter model.WorkspaceInvitationFilter) ([]*model.WorkspaceInvitation, *common_model.PaginationResult, error) {
+	now := time.Now()
+
+	res, paginationRes, err := c.next.ListWorkspaceInvitations(ctx, tenantID, workspaceID, pagination, filter)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkspaceIDField(workspaceID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, nil, err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWorkspaceIDField(workspaceID),
+		zap.Int("num_workspace_invitations", len(res)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, paginationRes, nil
+}
+
+func (c workspaceServiceLogging) ListInviteeWorkspaceInvitations(ctx context.Context, tenantID, userID uint64, pagination *common_model.Pagination) ([]*model.WorkspaceInvitation, *common_model.PaginationResult, error) {
+	now := time.Now()
+
+	res, paginationRes, err := c.next.ListInviteeWorkspaceInvitations(ctx, tenantID, userID, pagination)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithUserIDField(userID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, nil, err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithUserIDField(userID),
+		zap.Int("num_workspace_invitations", len(res)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, paginationRes, nil
+}
+
+func (c workspaceServiceLogging) CreateWorkspaceInvitation(ctx context.Context, invitation *model.WorkspaceInvitation) (*model.WorkspaceInvitation, error) {
+	now := time.Now()
+
+	created, err := c.next.CreateWorkspaceInvitation(ctx, invitation)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkspaceIDField(invitation.WorkspaceID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		logger.WithWorkspaceIDField(created.WorkspaceID),
+		zap.Uint64("workspace_invitation_id", created.ID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return created, nil
+}
+
+func (c workspaceServiceLogging) AcceptWorkspaceInvitation(ctx context.Context, tenantID, userID, invitationID uint64, token string) (*model.WorkspaceInvitation, error) {
+	now := time.Now()
+
+	res, err := c.next.AcceptWorkspaceInvitation(ctx, tenantID, userID, invitationID, token)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Uint64("workspace_invitation_id", invitationID),
+			logger.WithUserIDField(userID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		zap.Uint64("workspace_invitation_id", invitationID),
+		logger.WithUserIDField(userID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workspaceServiceLogging) DeclineWorkspaceInvitation(ctx context.Context, tenantID, userID, invitationID uint64, token string) (*model.WorkspaceInvitation, error) {
+	now := time.Now()
+
+	res, err := c.next.DeclineWorkspaceInvitation(ctx, tenantID, userID, invitationID, token)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Uint64("workspace_invitation_id", invitationID),
+			logger.WithUserIDField(userID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		zap.Uint64("workspace_invitation_id", invitationID),
+		logger.WithUserIDField(userID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workspaceServiceLogging) RevokeWorkspaceInvitation(ctx context.Context, tenantID, invitationID uint64) (*model.WorkspaceInvitation, error) {
+	now := time.Now()
+
+	res, err := c.next.RevokeWorkspaceInvitation(ctx, tenantID, invitationID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Uint64("workspace_invitation_id", invitationID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Info(ctx, logger.LoggerMessageRequestCompleted,
+		zap.Uint64("workspace_invitation_id", invitationID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
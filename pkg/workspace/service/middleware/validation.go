@@ -2,6 +2,9 @@ package middleware
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
 	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
 	authorization_model "github.com/CHORUS-TRE/chorus-backend/pkg/authorization/model"
@@ -49,10 +52,41 @@ func (v validation) DeleteWorkspace(ctx context.Context, tenantID, workspaceID u
 	return v.next.DeleteWorkspace(ctx, tenantID, workspaceID)
 }
 
+func (v validation) ExtendWorkspace(ctx context.Context, tenantID, workspaceID uint64, expiresAt time.Time) (*model.Workspace, error) {
+	return v.next.ExtendWorkspace(ctx, tenantID, workspaceID, expiresAt)
+}
+
+func (v validation) UpdateWorkspaceNetworkPolicy(ctx context.Context, tenantID, workspaceID uint64, networkPolicy model.NetworkPolicyMode, allowedFQDNs model.FQDNRules) (*model.Workspace, error) {
+	switch networkPolicy {
+	case model.NetworkPolicyOpen, model.NetworkPolicyAirgapped, model.NetworkPolicyFQDNAllowlist:
+	default:
+		return nil, cerr.ErrValidation.WithMessage(fmt.Sprintf("Unknown network policy %q", networkPolicy))
+	}
+	if err := validateFQDNRules(allowedFQDNs); err != nil {
+		return nil, err
+	}
+	return v.next.UpdateWorkspaceNetworkPolicy(ctx, tenantID, workspaceID, networkPolicy, allowedFQDNs)
+}
+
+func (v validation) UpdateWorkspaceOwner(ctx context.Context, tenantID, workspaceID, userID uint64) (*model.Workspace, error) {
+	return v.next.UpdateWorkspaceOwner(ctx, tenantID, workspaceID, userID)
+}
+
+func (v validation) FreezeWorkspace(ctx context.Context, tenantID, workspaceID uint64) (*model.Workspace, error) {
+	return v.next.FreezeWorkspace(ctx, tenantID, workspaceID)
+}
+
+func (v validation) UnfreezeWorkspace(ctx context.Context, tenantID, workspaceID uint64) (*model.Workspace, error) {
+	return v.next.UnfreezeWorkspace(ctx, tenantID, workspaceID)
+}
+
 func (v validation) UpdateWorkspace(ctx context.Context, workspace *model.Workspace) (*model.Workspace, error) {
 	if err := v.validate.Struct(workspace); err != nil {
 		return nil, cerr.WrapValidationError(err)
 	}
+	if err := validateFQDNRules(workspace.AllowedFQDNs); err != nil {
+		return nil, err
+	}
 	return v.next.UpdateWorkspace(ctx, workspace)
 }
 
@@ -60,6 +94,9 @@ func (v validation) CreateWorkspace(ctx context.Context, workspace *model.Worksp
 	if err := v.validate.Struct(workspace); err != nil {
 		return nil, cerr.WrapValidationError(err)
 	}
+	if err := validateFQDNRules(workspace.AllowedFQDNs); err != nil {
+		return nil, err
+	}
 	return v.next.CreateWorkspace(ctx, workspace)
 }
 
@@ -67,6 +104,10 @@ func (v validation) AddUserRoleInWorkspace(ctx context.Context, tenantID, userID
 	return v.next.AddUserRoleInWorkspace(ctx, tenantID, userID, role)
 }
 
+func (v validation) GrantUserRoleInWorkspace(ctx context.Context, tenantID, userID, workspaceID uint64, roleName authorization_model.RoleName) (bool, error) {
+	return v.next.GrantUserRoleInWorkspace(ctx, tenantID, userID, workspaceID, roleName)
+}
+
 func (v validation) RemoveUserRoleInWorkspace(ctx context.Context, tenantID, userID, workspaceID uint64, roleName authorization_model.RoleName) error {
 	return v.next.RemoveUserRoleInWorkspace(ctx, tenantID, userID, workspaceID, roleName)
 }
@@ -107,3 +148,246 @@ func (v validation) UpdateWorkspaceServiceInstance(ctx context.Context, svc *mod
 func (v validation) DeleteWorkspaceServiceInstance(ctx context.Context, tenantID, workspaceServiceInstanceID uint64) error {
 	return v.next.DeleteWorkspaceServiceInstance(ctx, tenantID, workspaceServiceInstanceID)
 }
+
+func (v validation) GetWorkspaceTemplate(ctx context.Context, tenantID, templateID uint64) (*model.WorkspaceTemplate, error) {
+	return v.next.GetWorkspaceTemplate(ctx, tenantID, templateID)
+}
+
+func (v validation) ListWorkspaceTemplates(ctx context.Context, tenantID uint64, pagination *common_model.Pagination) ([]*model.WorkspaceTemplate, *common_model.PaginationResult, error) {
+	if err := v.validate.Struct(pagination); err != nil {
+		return nil, nil, cerr.WrapValidationError(err)
+	}
+	return v.next.ListWorkspaceTemplates(ctx, tenantID, pagination)
+}
+
+func (v validation) CreateWorkspaceTemplate(ctx context.Context, template *model.WorkspaceTemplate) (*model.WorkspaceTemplate, error) {
+	if err := v.validate.Struct(template); err != nil {
+		return nil, cerr.WrapValidationError(err)
+	}
+	if err := validateFQDNRules(template.AllowedFQDNs); err != nil {
+		return nil, err
+	}
+	return v.next.CreateWorkspaceTemplate(ctx, template)
+}
+
+func (v validation) UpdateWorkspaceTemplate(ctx context.Context, template *model.WorkspaceTemplate) (*model.WorkspaceTemplate, error) {
+	if err := v.validate.Struct(template); err != nil {
+		return nil, cerr.WrapValidationError(err)
+	}
+	if err := validateFQDNRules(template.AllowedFQDNs); err != nil {
+		return nil, err
+	}
+	return v.next.UpdateWorkspaceTemplate(ctx, template)
+}
+
+func (v validation) DeleteWorkspaceTemplate(ctx context.Context, tenantID, templateID uint64) error {
+	return v.next.DeleteWorkspaceTemplate(ctx, tenantID, templateID)
+}
+
+func (v validation) CreateWorkspaceFromTemplate(ctx context.Context, templateID uint64, workspace *model.Workspace) (*model.Workspace, []*model.WorkspaceServiceInstance, error) {
+	if err := v.validate.Struct(workspace); err != nil {
+		return nil, nil, cerr.WrapValidationError(err)
+	}
+	if err := validateFQDNRules(workspace.AllowedFQDNs); err != nil {
+		return nil, nil, err
+	}
+	return v.next.CreateWorkspaceFromTemplate(ctx, templateID, workspace)
+}
+
+func (v validation) GetServiceDefinition(ctx context.Context, tenantID, serviceDefinitionID uint64) (*model.ServiceDefinition, error) {
+	return v.next.GetServiceDefinition(ctx, tenantID, serviceDefinitionID)
+}
+
+func (v validation) ListServiceDefinitions(ctx context.Context, tenantID uint64, pagination *common_model.Pagination) ([]*model.ServiceDefinition, *common_model.PaginationResult, error) {
+	if err := v.validate.Struct(pagination); err != nil {
+		return nil, nil, cerr.WrapValidationError(err)
+	}
+	return v.next.ListServiceDefinitions(ctx, tenantID, pagination)
+}
+
+func (v validation) CreateServiceDefinition(ctx context.Context, def *model.ServiceDefinition) (*model.ServiceDefinition, error) {
+	if err := v.validate.Struct(def); err != nil {
+		return nil, cerr.WrapValidationError(err)
+	}
+	return v.next.CreateServiceDefinition(ctx, def)
+}
+
+func (v validation) UpdateServiceDefinition(ctx context.Context, def *model.ServiceDefinition) (*model.ServiceDefinition, error) {
+	if err := v.validate.Struct(def); err != nil {
+		return nil, cerr.WrapValidationError(err)
+	}
+	return v.next.UpdateServiceDefinition(ctx, def)
+}
+
+func (v validation) DeleteServiceDefinition(ctx context.Context, tenantID, serviceDefinitionID uint64) error {
+	return v.next.DeleteServiceDefinition(ctx, tenantID, serviceDefinitionID)
+}
+
+func (v validation) GetWorkspaceInvitation(ctx context.Context, tenantID, invitationID uint64) (*model.WorkspaceInvitation, error) {
+	return v.next.GetWorkspaceInvitation(ctx, tenantID, invitationID)
+}
+
+func (v validation) ListWorkspaceInvitations(ctx context.Context, tenantID, workspaceID uint64, pagination *common_model.Pagination, filter model.WorkspaceInvitationFilter) ([]*model.WorkspaceInvitation, *common_model.PaginationResult, error) {
+	if err := v.validate.Struct(pagination); err != nil {
+		return nil, nil, cerr.WrapValidationError(err)
+	}
+	return v.next.ListWorkspaceInvitations(ctx, tenantID, workspaceID, pagination, filter)
+}
+
+func (v validation) ListInviteeWorkspaceInvitations(ctx context.Context, tenantID, userID uint64, pagination *common_model.Pagination) ([]*model.WorkspaceInvitation, *common_model.PaginationResult, error) {
+	if err := v.validate.Struct(pagination); err != nil {
+		return nil, nil, cerr.WrapValidationError(err)
+	}
+	return v.next.ListInviteeWorkspaceInvitations(ctx, tenantID, userID, pagination)
+}
+
+func (v validation) CreateWorkspaceInvitation(ctx context.Context, invitation *model.WorkspaceInvitation) (*model.WorkspaceInvitation, error) {
+	if err := v.validate.Struct(invitation); err != nil {
+		return nil, cerr.WrapValidationError(err)
+	}
+	return v.next.CreateWorkspaceInvitation(ctx, invitation)
+}
+
+func (v validation) AcceptWorkspaceInvitation(ctx context.Context, tenantID, userID, invitationID uint64, token string) (*model.WorkspaceInvitation, error) {
+	return v.next.AcceptWorkspaceInvitation(ctx, tenantID, userID, invitationID, token)
+}
+
+func (v validation) DeclineWorkspaceInvitation(ctx context.Context, tenantID, userID, invitationID uint64, token string) (*model.WorkspaceInvitation, error) {
+	return v.next.DeclineWorkspaceInvitation(ctx, tenantID, userID, invitationID, token)
+}
+
+func (v validation) RevokeWorkspaceInvitation(ctx context.Context, tenantID, invitationID uint64) (*model.WorkspaceInvitation, error) {
+	return v.next.RevokeWorkspaceInvitation(ctx, tenantID, invitationID)
+}
+
+const (
+	maxFQDNLength        = 253
+	maxFQDNLabelLength   = 63
+	maxFQDNCommentLength = 256
+)
+
+// validateFQDNRules checks the FQDN allowlist rules of a workspace, reporting every
+// invalid rule at once so that clients can flag them all.
+func validateFQDNRules(rules model.FQDNRules) error {
+	var problems []string
+	seen := make(map[string]int, len(rules))
+
+	for i, rule := range rules {
+		for _, problem := range fqdnRuleProblems(rule) {
+			problems = append(problems, fmt.Sprintf("rule %d (%q): %s", i, rule.Pattern, problem))
+		}
+
+		key := strings.ToLower(rule.Pattern) + "/" + rule.Protocol.String()
+		if first, ok := seen[key]; ok {
+			problems = append(problems, fmt.Sprintf("rule %d (%q): duplicates rule %d", i, rule.Pattern, first))
+		} else {
+			seen[key] = i
+		}
+	}
+
+	if len(problems) > 0 {
+		return cerr.ErrValidation.WithMessage(fmt.Sprintf("Invalid FQDN allowlist: %s", strings.Join(problems, "; ")))
+	}
+	return nil
+}
+
+func fqdnRuleProblems(rule model.FQDNRule) []string {
+	var problems []string
+
+	if problem := fqdnPatternProblem(rule.Pattern); problem != "" {
+		problems = append(problems, problem)
+	}
+
+	seenPorts := make(map[uint32]bool, len(rule.Ports))
+	for _, port := range rule.Ports {
+		if port == 0 || port > 65535 {
+			problems = append(problems, fmt.Sprintf("port %d is out of range 1-65535", port))
+		} else if seenPorts[port] {
+			problems = append(problems, fmt.Sprintf("port %d is listed twice", port))
+		}
+		seenPorts[port] = true
+	}
+
+	switch rule.Protocol {
+	case model.FQDNProtocolAny, model.FQDNProtocolTCP, model.FQDNProtocolUDP:
+	default:
+		problems = append(problems, fmt.Sprintf("unknown protocol %q, expected TCP or UDP", rule.Protocol))
+	}
+
+	if len(rule.Comment) > maxFQDNCommentLength {
+		problems = append(problems, fmt.Sprintf("comment is longer than %d characters", maxFQDNCommentLength))
+	}
+
+	return problems
+}
+
+// fqdnPatternProblem describes why a pattern is not a hostname or a leading wildcard
+// on a domain, or returns an empty string when the pattern is valid.
+func fqdnPatternProblem(pattern string) string {
+	if pattern == "" {
+		return "pattern is empty"
+	}
+	if strings.TrimSpace(pattern) != pattern {
+		return "pattern has surrounding spaces"
+	}
+	if strings.Contains(pattern, "://") {
+		return "pattern must be a hostname, not a URL"
+	}
+	if strings.ContainsAny(pattern, "/:@") {
+		return "pattern must be a hostname without path, port or credentials"
+	}
+	if len(pattern) > maxFQDNLength {
+		return fmt.Sprintf("pattern is longer than %d characters", maxFQDNLength)
+	}
+
+	host := pattern
+	if strings.HasPrefix(pattern, "*.") {
+		host = pattern[2:]
+	}
+	if strings.Contains(host, "*") {
+		return "wildcards are only allowed as a leading \"*.\""
+	}
+
+	labels := strings.Split(strings.TrimSuffix(host, "."), ".")
+	if len(labels) < 2 {
+		return "pattern must have at least two labels, e.g. example.org"
+	}
+	for _, label := range labels {
+		if problem := fqdnLabelProblem(label); problem != "" {
+			return problem
+		}
+	}
+
+	if isNumeric(labels[len(labels)-1]) {
+		return "IP addresses are not allowed, use a hostname"
+	}
+
+	return ""
+}
+
+func fqdnLabelProblem(label string) string {
+	if label == "" {
+		return "pattern has an empty label"
+	}
+	if len(label) > maxFQDNLabelLength {
+		return fmt.Sprintf("label %q is longer than %d characters", label, maxFQDNLabelLength)
+	}
+	if strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+		return fmt.Sprintf("label %q starts or ends with a hyphen", label)
+	}
+	for _, c := range strings.ToLower(label) {
+		if (c < 'a' || c > 'z') && (c < '0' || c > '9') && c != '-' {
+			return fmt.Sprintf("label %q has invalid character %q", label, c)
+		}
+	}
+	return ""
+}
+
+func isNumeric(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
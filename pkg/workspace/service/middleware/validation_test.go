@@ -0,0 +1,67 @@
+//go:build unit
+
+package middleware
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
+)
+
+func TestValidateFQDNRules_ValidRulesPass(t *testing.T) {
+	err := validateFQDNRules(model.FQDNRules{
+		{Pattern: "pypi.org"},
+		{Pattern: "*.example.org", Ports: []uint32{443, 8443}, Protocol: model.FQDNProtocolTCP, Comment: "Project data"},
+		{Pattern: "ntp.example.org", Ports: []uint32{123}, Protocol: model.FQDNProtocolUDP},
+		{Pattern: "cran.r-project.org."},
+	})
+	assert.NoError(t, err)
+}
+
+func TestValidateFQDNRules_RejectsInvalidRules(t *testing.T) {
+	tests := []struct {
+		name string
+		rule model.FQDNRule
+	}{
+		{"empty", model.FQDNRule{Pattern: ""}},
+		{"spaces", model.FQDNRule{Pattern: " pypi.org"}},
+		{"url", model.FQDNRule{Pattern: "https://pypi.org"}},
+		{"path", model.FQDNRule{Pattern: "pypi.org/simple"}},
+		{"port in pattern", model.FQDNRule{Pattern: "pypi.org:443"}},
+		{"single label", model.FQDNRule{Pattern: "localhost"}},
+		{"wildcard on tld", model.FQDNRule{Pattern: "*.org"}},
+		{"inner wildcard", model.FQDNRule{Pattern: "data.*.example.org"}},
+		{"bare wildcard", model.FQDNRule{Pattern: "*"}},
+		{"ip address", model.FQDNRule{Pattern: "10.0.0.1"}},
+		{"underscore", model.FQDNRule{Pattern: "my_host.example.org"}},
+		{"hyphen edge", model.FQDNRule{Pattern: "-host.example.org"}},
+		{"empty label", model.FQDNRule{Pattern: "host..example.org"}},
+		{"port zero", model.FQDNRule{Pattern: "pypi.org", Ports: []uint32{0}}},
+		{"port too high", model.FQDNRule{Pattern: "pypi.org", Ports: []uint32{70000}}},
+		{"duplicate port", model.FQDNRule{Pattern: "pypi.org", Ports: []uint32{443, 443}}},
+		{"unknown protocol", model.FQDNRule{Pattern: "pypi.org", Protocol: "ICMP"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateFQDNRules(model.FQDNRules{tt.rule})
+			var cErr *cerr.ChorusError
+			require.ErrorAs(t, err, &cErr)
+			assert.Equal(t, cerr.ErrValidation.ChorusCode, cErr.ChorusCode)
+		})
+	}
+}
+
+func TestValidateFQDNRules_ReportsEveryInvalidRule(t *testing.T) {
+	err := validateFQDNRules(model.FQDNRules{
+		{Pattern: "pypi.org"},
+		{Pattern: "https://bad.example.org"},
+		{Pattern: "PyPI.org"},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `rule 1 ("https://bad.example.org")`)
+	assert.Contains(t, err.Error(), `rule 2 ("PyPI.org"): duplicates rule 0`)
+}
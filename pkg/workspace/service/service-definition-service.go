@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	openapi_errors "github.com/go-openapi/errors"
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
+)
+
+func (s *WorkspaceService) GetServiceDefinition(ctx context.Context, tenantID, serviceDefinitionID uint64) (*model.ServiceDefinition, error) {
+	def, err := s.store.GetServiceDefinition(ctx, tenantID, serviceDefinitionID)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to get service definition %v", serviceDefinitionID))
+	}
+	return def, nil
+}
+
+func (s *WorkspaceService) ListServiceDefinitions(ctx context.Context, tenantID uint64, pagination *common_model.Pagination) ([]*model.ServiceDefinition, *common_model.PaginationResult, error) {
+	defs, paginationRes, err := s.store.ListServiceDefinitions(ctx, tenantID, pagination)
+	if err != nil {
+		return nil, nil, cerr.WrapStoreError(err, "Unable to list service definitions")
+	}
+	return defs, paginationRes, nil
+}
+
+func (s *WorkspaceService) CreateServiceDefinition(ctx context.Context, def *model.ServiceDefinition) (*model.ServiceDefinition, error) {
+	if err := prepareServiceDefinition(def); err != nil {
+		return nil, err
+	}
+
+	created, err := s.store.CreateServiceDefinition(ctx, def.TenantID, def)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, "Unable to create service definition")
+	}
+	return created, nil
+}
+
+func (s *WorkspaceService) UpdateServiceDefinition(ctx context.Context, def *model.ServiceDefinition) (*model.ServiceDefinition, error) {
+	if err := prepareServiceDefinition(def); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.store.UpdateServiceDefinition(ctx, def.TenantID, def)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to update service definition %v", def.ID))
+	}
+	return updated, nil
+}
+
+func (s *WorkspaceService) DeleteServiceDefinition(ctx context.Context, tenantID, serviceDefinitionID uint64) error {
+	err := s.store.DeleteServiceDefinition(ctx, tenantID, serviceDefinitionID)
+	if err != nil {
+		return cerr.WrapStoreError(err, fmt.Sprintf("Unable to delete service definition %v", serviceDefinitionID))
+	}
+	return nil
+}
+
+// applyServiceDefinition fills the chart spec of a service instance from the service
+// definition it references, after checking its values against the definition schema.
+// Instances that reference no definition keep their free-form chart spec. New instances
+// can only be created from active definitions.
+func (s *WorkspaceService) applyServiceDefinition(ctx context.Context, svc *model.WorkspaceServiceInstance, allowInactive bool) error {
+	if svc.ServiceDefinitionID == nil {
+		return nil
+	}
+
+	def, err := s.store.GetServiceDefinition(ctx, svc.TenantID, *svc.ServiceDefinitionID)
+	if err != nil {
+		return cerr.WrapStoreError(err, fmt.Sprintf("Unable to get service definition %v", *svc.ServiceDefinitionID))
+	}
+
+	if def.Status != model.ServiceDefinitionActive && !allowInactive {
+		return cerr.ErrInvalidRequest.WithMessage(fmt.Sprintf("Service definition %q is not active", def.Name))
+	}
+
+	if err := validateServiceValues(def, svc.Values); err != nil {
+		return err
+	}
+
+	def.ApplyTo(svc)
+	return nil
+}
+
+// prepareServiceDefinition applies the defaults to a service definition and checks that
+// its values schema is a valid JSON schema.
+func prepareServiceDefinition(def *model.ServiceDefinition) error {
+	if def.Status == "" {
+		def.Status = model.ServiceDefinitionActive
+	}
+	if def.ValuesSchema == nil {
+		def.ValuesSchema = model.JSONMap[any]{}
+	}
+	if def.DefaultCredentialsPaths == nil {
+		def.DefaultCredentialsPaths = model.StringSlice{}
+	}
+
+	if _, err := parseValuesSchema(def.ValuesSchema); err != nil {
+		return cerr.ErrValidation.WithMessage(fmt.Sprintf("Invalid values schema: %v", err))
+	}
+	return nil
+}
+
+func parseValuesSchema(valuesSchema model.JSONMap[any]) (*spec.Schema, error) {
+	raw, err := json.Marshal(valuesSchema)
+	if err != nil {
+		return nil, err
+	}
+
+	schema := &spec.Schema{}
+	if err := json.Unmarshal(raw, schema); err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+// validateServiceValues checks the values override of a service instance against the
+// schema of its service definition, reporting every mismatch at once.
+func validateServiceValues(def *model.ServiceDefinition, values model.JSONMap[any]) error {
+	if len(def.ValuesSchema) == 0 {
+		return nil
+	}
+
+	schema, err := parseValuesSchema(def.ValuesSchema)
+	if err != nil {
+		return cerr.ErrInternal.Wrap(err, fmt.Sprintf("Invalid values schema in service definition %v", def.ID))
+	}
+
+	data := map[string]any(values)
+	if data == nil {
+		data = map[string]any{}
+	}
+
+	err = validate.AgainstSchema(schema, data, strfmt.Default)
+	if err == nil {
+		return nil
+	}
+
+	problems := []string{err.Error()}
+	var composite *openapi_errors.CompositeError
+	if errors.As(err, &composite) && len(composite.Errors) > 0 {
+		problems = problems[:0]
+		for _, e := range composite.Errors {
+			problems = append(problems, e.Error())
+		}
+	}
+
+	return cerr.ErrValidation.WithMessage(fmt.Sprintf("Values do not match the schema of service definition %q: %s", def.Name, strings.Join(problems, "; ")))
+}
@@ -0,0 +1,272 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	authz "github.com/CHORUS-TRE/chorus-backend/pkg/authorization/model"
+	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
+	notification_model "github.com/CHORUS-TRE/chorus-backend/pkg/notification/model"
+	user_model "github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
+	user_service "github.com/CHORUS-TRE/chorus-backend/pkg/user/service"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
+	"go.uber.org/zap"
+)
+
+func (s *WorkspaceService) GetWorkspaceInvitation(ctx context.Context, tenantID, invitationID uint64) (*model.WorkspaceInvitation, error) {
+	invitation, err := s.store.GetWorkspaceInvitation(ctx, tenantID, invitationID)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to get workspace invitation %v", invitationID))
+	}
+	return withEffectiveStatus(invitation), nil
+}
+
+func (s *WorkspaceService) ListWorkspaceInvitations(ctx context.Context, tenantID, workspaceID uint64, pagination *common_model.Pagination, filter model.WorkspaceInvitationFilter) ([]*model.WorkspaceInvitation, *common_model.PaginationResult, error) {
+	invitations, paginationRes, err := s.store.ListWorkspaceInvitations(ctx, tenantID, workspaceID, pagination, filter)
+	if err != nil {
+		return nil, nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to list invitations of workspace %v", workspaceID))
+	}
+	for _, invitation := range invitations {
+		withEffectiveStatus(invitation)
+	}
+	return invitations, paginationRes, nil
+}
+
+// ListInviteeWorkspaceInvitations lists the pending invitations addressed to a user, either
+// directly or through an email address claimed on sign-in with the verified email of the user.
+func (s *WorkspaceService) ListInviteeWorkspaceInvitations(ctx context.Context, tenantID, userID uint64, pagination *common_model.Pagination) ([]*model.WorkspaceInvitation, *common_model.PaginationResult, error) {
+	invitations, paginationRes, err := s.store.ListInviteeWorkspaceInvitations(ctx, tenantID, userID, pagination)
+	if err != nil {
+		return nil, nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to list invitations of user %v", userID))
+	}
+	return invitations, paginationRes, nil
+}
+
+// CreateWorkspaceInvitation invites an existing user or an email address to join a workspace
+// with a workspace role. The invitee is notified, and emailed a link carrying a single-use
+// token when an address is known. Only the hash of the token is stored.
+func (s *WorkspaceService) CreateWorkspaceInvitation(ctx context.Context, invitation *model.WorkspaceInvitation) (*model.WorkspaceInvitation, error) {
+	if !isWorkspaceRole(invitation.Role) {
+		return nil, cerr.ErrInvalidRequest.WithMessage(fmt.Sprintf("Role %v is not a workspace role", invitation.Role))
+	}
+
+	invitation.InviteeEmail = strings.ToLower(strings.TrimSpace(invitation.InviteeEmail))
+	if invitation.InviteeUserID == nil && invitation.InviteeEmail == "" {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Either a user or an email address must be invited")
+	}
+
+	now := time.Now()
+	if invitation.ExpiresAt.IsZero() {
+		invitation.ExpiresAt = now.Add(s.cfg.Services.WorkspaceService.InvitationTTL)
+	}
+	if !invitation.ExpiresAt.After(now) {
+		return nil, cerr.ErrInvalidRequest.WithMessage("The invitation expiry date must be in the future")
+	}
+
+	if err := s.checkWorkspaceWritable(ctx, invitation.TenantID, invitation.WorkspaceID); err != nil {
+		return nil, err
+	}
+
+	mailTo := invitation.InviteeEmail
+	if invitation.InviteeUserID != nil {
+		invitee, err := s.userer.GetUser(ctx, user_service.GetUserReq{TenantID: invitation.TenantID, ID: *invitation.InviteeUserID})
+		if err != nil {
+			return nil, cerr.ErrInvalidRequest.Wrap(err, fmt.Sprintf("Unable to get invited user %v", *invitation.InviteeUserID))
+		}
+		if hasWorkspaceRole(invitee, invitation.WorkspaceID, invitation.Role) {
+			return nil, cerr.ErrAlreadyExists.WithMessage(fmt.Sprintf("User %v already has role %v in workspace %v", invitee.ID, invitation.Role, invitation.WorkspaceID))
+		}
+		if mailTo == "" {
+			mailTo = invitee.Email
+		}
+	}
+
+	token, tokenHash, err := newInvitationToken()
+	if err != nil {
+		return nil, cerr.ErrInternal.Wrap(err, "Unable to generate invitation token")
+	}
+	invitation.TokenHash = tokenHash
+
+	created, err := s.store.CreateWorkspaceInvitation(ctx, invitation.TenantID, invitation)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to create invitation to workspace %v", invitation.WorkspaceID))
+	}
+
+	s.sendWorkspaceInvitation(ctx, created, mailTo, token)
+
+	return withEffectiveStatus(created), nil
+}
+
+// AcceptWorkspaceInvitation grants the user the role of the invitation in the workspace,
+// then binds the invitation to the user. The invitation stays pending when the role cannot
+// be granted, and the role is taken back when the invitation was answered meanwhile.
+func (s *WorkspaceService) AcceptWorkspaceInvitation(ctx context.Context, tenantID, userID, invitationID uint64, token string) (*model.WorkspaceInvitation, error) {
+	invitation, err := s.inviteeInvitation(ctx, tenantID, userID, invitationID, token)
+	if err != nil {
+		return nil, err
+	}
+
+	granted, err := s.GrantUserRoleInWorkspace(ctx, tenantID, userID, invitation.WorkspaceID, invitation.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.respondWorkspaceInvitation(ctx, tenantID, invitationID, model.WorkspaceInvitationStatusAccepted, &userID); err != nil {
+		if granted {
+			if rErr := s.RemoveUserRoleInWorkspace(ctx, tenantID, userID, invitation.WorkspaceID, invitation.Role); rErr != nil {
+				logger.TechLog.Error(ctx, "unable to remove role granted by an invitation no longer pending",
+					zap.Uint64("workspaceInvitationID", invitationID), logger.WithUserIDField(userID), zap.Error(rErr))
+			}
+		}
+		return nil, err
+	}
+
+	return s.GetWorkspaceInvitation(ctx, tenantID, invitationID)
+}
+
+func (s *WorkspaceService) DeclineWorkspaceInvitation(ctx context.Context, tenantID, userID, invitationID uint64, token string) (*model.WorkspaceInvitation, error) {
+	if _, err := s.inviteeInvitation(ctx, tenantID, userID, invitationID, token); err != nil {
+		return nil, err
+	}
+
+	if err := s.respondWorkspaceInvitation(ctx, tenantID, invitationID, model.WorkspaceInvitationStatusDeclined, &userID); err != nil {
+		return nil, err
+	}
+
+	return s.GetWorkspaceInvitation(ctx, tenantID, invitationID)
+}
+
+func (s *WorkspaceService) RevokeWorkspaceInvitation(ctx context.Context, tenantID, invitationID uint64) (*model.WorkspaceInvitation, error) {
+	if err := s.respondWorkspaceInvitation(ctx, tenantID, invitationID, model.WorkspaceInvitationStatusRevoked, nil); err != nil {
+		return nil, err
+	}
+
+	return s.GetWorkspaceInvitation(ctx, tenantID, invitationID)
+}
+
+func (s *WorkspaceService) respondWorkspaceInvitation(ctx context.Context, tenantID, invitationID uint64, status model.WorkspaceInvitationStatus, inviteeUserID *uint64) error {
+	err := s.store.RespondWorkspaceInvitation(ctx, tenantID, invitationID, status, inviteeUserID)
+	if errors.Is(err, cerr.ErrNoRowsUpdated) {
+		return cerr.ErrInvalidRequest.WithMessage(fmt.Sprintf("Invitation %v is no longer pending", invitationID))
+	}
+	if err != nil {
+		return cerr.WrapStoreError(err, fmt.Sprintf("Unable to update invitation %v", invitationID))
+	}
+	return nil
+}
+
+// inviteeInvitation returns a pending invitation if it is addressed to the user: either
+// directly, including email invitations claimed with their verified email, or through the
+// token of the invitation link. The email of the user profile is never matched, as users
+// can change it.
+func (s *WorkspaceService) inviteeInvitation(ctx context.Context, tenantID, userID, invitationID uint64, token string) (*model.WorkspaceInvitation, error) {
+	invitation, err := s.GetWorkspaceInvitation(ctx, tenantID, invitationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if invitation.Status != model.WorkspaceInvitationStatusPending {
+		return nil, cerr.ErrInvalidRequest.WithMessage(fmt.Sprintf("Invitation %v is %v", invitationID, invitation.Status))
+	}
+
+	if invitation.InviteeUserID != nil {
+		if *invitation.InviteeUserID != userID {
+			return nil, cerr.ErrPermissionDenied.WithMessage(fmt.Sprintf("Invitation %v is not addressed to you", invitationID))
+		}
+		return invitation, nil
+	}
+
+	if token == "" || !invitationTokenMatches(invitation.TokenHash, token) {
+		return nil, cerr.ErrPermissionDenied.WithMessage(fmt.Sprintf("Invitation %v is not addressed to you", invitationID))
+	}
+
+	return invitation, nil
+}
+
+// sendWorkspaceInvitation notifies the invitee when they are a known user and emails them the
+// invitation link when an address is known. Failures are only logged.
+func (s *WorkspaceService) sendWorkspaceInvitation(ctx context.Context, invitation *model.WorkspaceInvitation, mailTo, token string) {
+	message := fmt.Sprintf("You have been invited to join workspace %q as %v. The invitation expires on %s.",
+		invitation.WorkspaceName, invitation.Role, invitation.ExpiresAt.Format(time.DateOnly))
+
+	if invitation.InviteeUserID != nil {
+		err := s.notificationStore.CreateNotification(ctx, &notification_model.Notification{
+			TenantID: invitation.TenantID,
+			Message:  message,
+			Content: notification_model.NotificationContent{
+				Type:               "SystemNotification",
+				SystemNotification: &notification_model.SystemNotification{},
+			},
+		}, []uint64{*invitation.InviteeUserID})
+		if err != nil {
+			logger.TechLog.Error(ctx, "unable to notify invited user",
+				zap.Uint64("workspaceInvitationID", invitation.ID), zap.Error(err))
+		}
+	}
+
+	if mailTo == "" {
+		return
+	}
+
+	if format := s.cfg.Services.WorkspaceService.InvitationURLFormat; format != "" {
+		message += fmt.Sprintf(" Open %s to accept or decline it.", fmt.Sprintf(format, invitation.ID, token))
+	} else {
+		message += " Sign in to accept or decline it."
+	}
+
+	title := "Workspace invitation"
+	subject := s.mailer.GetSubject(ctx, invitation.TenantID, "workspaceInvitation")
+	if subject == "" {
+		subject = title
+	}
+	if err := s.mailer.SendMessage(ctx, invitation.TenantID, []string{mailTo}, subject, title, message); err != nil {
+		logger.TechLog.Error(ctx, "unable to email workspace invitation",
+			zap.Uint64("workspaceInvitationID", invitation.ID), zap.Error(err))
+	}
+}
+
+func withEffectiveStatus(invitation *model.WorkspaceInvitation) *model.WorkspaceInvitation {
+	invitation.Status = invitation.EffectiveStatus(time.Now())
+	return invitation
+}
+
+func isWorkspaceRole(role authz.RoleName) bool {
+	return slices.ContainsFunc(authz.GetRoleDefinitions(), func(def *authz.RoleDefinition) bool {
+		return def.Name == role && def.Scope == authz.RoleScopeWorkspace
+	})
+}
+
+func hasWorkspaceRole(user *user_model.User, workspaceID uint64, role authz.RoleName) bool {
+	return slices.ContainsFunc(user.Roles, func(r user_model.UserRole) bool {
+		return r.Name == role && r.Context[authz.ContextWorkspace] == fmt.Sprintf("%d", workspaceID)
+	})
+}
+
+func newInvitationToken() (string, string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+	return token, hashInvitationToken(token), nil
+}
+
+func hashInvitationToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func invitationTokenMatches(tokenHash, token string) bool {
+	return subtle.ConstantTimeCompare([]byte(tokenHash), []byte(hashInvitationToken(token))) == 1
+}
@@ -0,0 +1,848 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/audit"
+	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	audit_model "github.com/CHORUS-TRE/chorus-backend/pkg/audit/model"
+	audit_service "github.com/CHORUS-TRE/chorus-backend/pkg/audit/service"
+	authz "github.com/CHORUS-TRE/chorus-backend/pkg/authorization/model"
+	user_model "github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
+	user_service "github.com/CHORUS-TRE/chorus-backend/pkg/user/service"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
+)
+
+// WorkspaceManifester exports workspaces as declarative manifests, and reconciles
+// workspaces with their manifest through the workspace and user services.
+type WorkspaceManifester struct {
+	workspaceer Workspaceer
+	userer      Userer
+	auditWriter audit_service.AuditWriter
+	cfg         config.Config
+}
+
+func NewWorkspaceManifester(workspaceer Workspaceer, userer Userer, auditWriter audit_service.AuditWriter, cfg config.Config) *WorkspaceManifester {
+	return &WorkspaceManifester{workspaceer: workspaceer, userer: userer, auditWriter: auditWriter, cfg: cfg}
+}
+
+// workspaceManifestState is the part of a workspace described by a manifest, either as
+// it currently is or as the manifest wants it.
+type workspaceManifestState struct {
+	workspace *model.Workspace
+	owner     string
+	contact   string
+
+	users    map[string]*user_model.User
+	roles    map[string][]authz.RoleName
+	services map[string]*model.WorkspaceServiceInstance
+}
+
+func (m *WorkspaceManifester) ExportWorkspaceManifest(ctx context.Context, tenantID, workspaceID uint64) (*model.WorkspaceManifest, error) {
+	state, err := m.currentState(ctx, tenantID, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	defs, err := m.serviceDefinitions(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	ws := state.workspace
+	manifest := &model.WorkspaceManifest{
+		APIVersion: model.WorkspaceManifestAPIVersion,
+		Kind:       model.WorkspaceManifestKind,
+
+		ID:    ws.ID,
+		Owner: state.owner,
+
+		Name:        ws.Name,
+		ShortName:   ws.ShortName,
+		Description: ws.Description,
+		Visibility:  ws.Visibility,
+		Contact:     state.contact,
+		Clipboard:   ws.Clipboard,
+		ExpiresAt:   ws.ExpiresAt,
+
+		NetworkPolicy: model.WorkspaceManifestNetworkPolicy{
+			Mode:      ws.NetworkPolicy,
+			FQDNRules: ws.AllowedFQDNs,
+		},
+	}
+
+	for _, username := range sortedKeys(state.roles) {
+		manifest.Members = append(manifest.Members, model.WorkspaceManifestMember{
+			Username: username,
+			Roles:    state.roles[username],
+		})
+	}
+
+	for _, name := range sortedKeys(state.services) {
+		svc := state.services[name]
+		entry := model.WorkspaceManifestServiceInstance{
+			Name:                  svc.Name,
+			State:                 svc.State,
+			Values:                svc.Values,
+			CredentialsSecretName: svc.CredentialsSecretName,
+			CredentialsPaths:      svc.CredentialsPaths,
+		}
+		if def, ok := defs[svc.GetServiceDefinitionID()]; ok {
+			entry.ServiceDefinition = def.Name
+		} else {
+			entry.ChartRegistry = svc.ChartRegistry
+			entry.ChartRepository = svc.ChartRepository
+			entry.ChartTag = svc.ChartTag
+			entry.ConnectionInfoTemplate = svc.ConnectionInfoTemplate
+		}
+		manifest.ServiceInstances = append(manifest.ServiceInstances, entry)
+	}
+
+	return manifest, nil
+}
+
+// DiffWorkspaceManifest returns the changes applying the manifest would make, without
+// changing anything.
+func (m *WorkspaceManifester) DiffWorkspaceManifest(ctx context.Context, tenantID uint64, manifest *model.WorkspaceManifest) ([]model.WorkspaceManifestChange, error) {
+	current, desired, err := m.states(ctx, tenantID, manifest)
+	if err != nil {
+		return nil, err
+	}
+	return diffWorkspaceManifestStates(current, desired), nil
+}
+
+// ApplyWorkspaceManifest reconciles a workspace with its manifest, creating the workspace
+// when the manifest has no ID. It returns the reconciled workspace and the changes made,
+// which are recorded in the audit log.
+func (m *WorkspaceManifester) ApplyWorkspaceManifest(ctx context.Context, tenantID uint64, manifest *model.WorkspaceManifest) (*model.Workspace, []model.WorkspaceManifestChange, error) {
+	current, desired, err := m.states(ctx, tenantID, manifest)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := checkManifestNetworkPolicy(current, desired); err != nil {
+		return nil, nil, err
+	}
+	changes := diffWorkspaceManifestStates(current, desired)
+
+	ws, err := m.applyWorkspaceManifest(ctx, tenantID, current, desired)
+	if len(changes) > 0 {
+		m.recordWorkspaceManifest(ctx, tenantID, manifest, ws, changes, err)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return ws, changes, nil
+}
+
+func (m *WorkspaceManifester) applyWorkspaceManifest(ctx context.Context, tenantID uint64, current, desired *workspaceManifestState) (*model.Workspace, error) {
+	if current.workspace == nil {
+		owner, ok := desired.users[desired.owner]
+		if !ok {
+			return nil, cerr.ErrInvalidRequest.WithMessage("The manifest of a new workspace must set its owner")
+		}
+		ws := *desired.workspace
+		ws.TenantID = tenantID
+		ws.UserID = owner.ID
+		ws.Status = model.WorkspaceStatusActive
+
+		created, err := m.workspaceer.CreateWorkspace(ctx, &ws)
+		if err != nil {
+			return nil, err
+		}
+
+		// The creator roles granted along with the workspace are reconciled with the
+		// manifest members below.
+		current, err = m.currentState(ctx, tenantID, created.ID)
+		if err != nil {
+			return nil, err
+		}
+	} else if err := m.applyWorkspaceSettings(ctx, current, desired); err != nil {
+		return nil, err
+	}
+
+	workspaceID := current.workspace.ID
+	if err := m.applyMembers(ctx, tenantID, workspaceID, current, desired); err != nil {
+		return nil, err
+	}
+	if err := m.applyServiceInstances(ctx, tenantID, workspaceID, current, desired); err != nil {
+		return nil, err
+	}
+
+	return m.workspaceer.GetWorkspace(ctx, tenantID, workspaceID)
+}
+
+func (m *WorkspaceManifester) applyWorkspaceSettings(ctx context.Context, current, desired *workspaceManifestState) error {
+	cur, want := current.workspace, desired.workspace
+
+	if workspaceSettingsChanged(current, desired) {
+		// The network policy is kept here and updated below: updates of workspaces relax
+		// it through approval requests, which are meant for the API users.
+		ws := *cur
+		ws.Name = want.Name
+		ws.ShortName = want.ShortName
+		ws.Description = want.Description
+		ws.Visibility = want.Visibility
+		ws.Clipboard = want.Clipboard
+		ws.ContactUserID = want.ContactUserID
+		if _, err := m.workspaceer.UpdateWorkspace(ctx, &ws); err != nil {
+			return err
+		}
+	}
+
+	if ownerChanged(current, desired) {
+		if _, err := m.workspaceer.UpdateWorkspaceOwner(ctx, cur.TenantID, cur.ID, desired.users[desired.owner].ID); err != nil {
+			return err
+		}
+	}
+
+	if expiresAtChanged(cur, want) {
+		if _, err := m.workspaceer.ExtendWorkspace(ctx, cur.TenantID, cur.ID, *want.ExpiresAt); err != nil {
+			return err
+		}
+	}
+
+	// Relaxations were refused by checkManifestNetworkPolicy: only tighter policies are
+	// applied here.
+	if networkPolicyChanged(cur, want) {
+		if _, err := m.workspaceer.UpdateWorkspaceNetworkPolicy(ctx, cur.TenantID, cur.ID, want.NetworkPolicy, want.AllowedFQDNs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkManifestNetworkPolicy refuses a manifest opening up the network policy of an
+// existing workspace. Relaxing the policy needs the approval of a data manager, which is
+// requested by the user updating the workspace through the API: manifests applied from
+// the command line have no such user.
+func checkManifestNetworkPolicy(current, desired *workspaceManifestState) error {
+	cur, want := current.workspace, desired.workspace
+	if cur == nil || !model.IsNetworkPolicyRelaxation(cur.NetworkPolicy, cur.AllowedFQDNs, want.NetworkPolicy, want.AllowedFQDNs) {
+		return nil
+	}
+	return cerr.ErrPermissionDenied.WithMessage(fmt.Sprintf("The manifest relaxes the network policy of workspace %v from %s to %s, which needs an approval: update the workspace through the API instead", cur.ID, describeNetworkPolicy(cur.NetworkPolicy, cur.AllowedFQDNs), describeNetworkPolicy(want.NetworkPolicy, want.AllowedFQDNs)))
+}
+
+// recordWorkspaceManifest records the changes made by applying a manifest, including
+// those made before it failed.
+func (m *WorkspaceManifester) recordWorkspaceManifest(ctx context.Context, tenantID uint64, manifest *model.WorkspaceManifest, ws *model.Workspace, changes []model.WorkspaceManifestChange, err error) {
+	workspaceID := manifest.ID
+	if ws != nil {
+		workspaceID = ws.ID
+	}
+
+	described := make([]string, 0, len(changes))
+	for _, c := range changes {
+		described = append(described, fmt.Sprintf("%s: %s %s", c.Section, c.Action, c.Key))
+	}
+
+	action := audit_model.AuditActionWorkspaceUpdate
+	if manifest.ID == 0 {
+		action = audit_model.AuditActionWorkspaceCreate
+	}
+	opts := []audit.Option{
+		audit.WithTenantID(tenantID),
+		audit.WithActorUsername("system"),
+		audit.WithWorkspaceID(workspaceID),
+		audit.WithDetail("workspace_id", workspaceID),
+		audit.WithDetail("workspace_name", manifest.Name),
+		audit.WithDetail("trigger", "workspace_manifest"),
+		audit.WithDetail("changes", described),
+		audit.WithDetail("network_policy", manifest.NetworkPolicy.Mode),
+		audit.WithDetail("allowed_fqdns", manifest.NetworkPolicy.FQDNRules),
+	}
+	if err != nil {
+		opts = append(opts,
+			audit.WithDescription(fmt.Sprintf("Failed to apply manifest to workspace %q (ID %d).", manifest.Name, workspaceID)),
+			audit.WithError(err),
+		)
+	} else {
+		opts = append(opts, audit.WithDescription(fmt.Sprintf("Applied manifest to workspace %q (ID %d): %d changes.", manifest.Name, workspaceID, len(changes))))
+	}
+
+	audit.Record(ctx, m.auditWriter, action, opts...)
+}
+
+// applyMembers grants the missing roles before removing the extra ones, so that a
+// workspace never goes through a state without its admins.
+func (m *WorkspaceManifester) applyMembers(ctx context.Context, tenantID, workspaceID uint64, current, desired *workspaceManifestState) error {
+	for _, username := range sortedKeys(desired.roles) {
+		user := desired.users[username]
+		for _, role := range desired.roles[username] {
+			if slices.Contains(current.roles[username], role) {
+				continue
+			}
+			err := m.workspaceer.AddUserRoleInWorkspace(ctx, tenantID, user.ID, user_model.UserRole{Role: authz.Role{
+				Name:    role,
+				Context: authz.Context{authz.ContextWorkspace: fmt.Sprintf("%d", workspaceID)},
+			}})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, username := range sortedKeys(current.roles) {
+		user := current.users[username]
+		for _, role := range current.roles[username] {
+			if slices.Contains(desired.roles[username], role) {
+				continue
+			}
+			if err := m.workspaceer.RemoveUserRoleInWorkspace(ctx, tenantID, user.ID, workspaceID, role); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *WorkspaceManifester) applyServiceInstances(ctx context.Context, tenantID, workspaceID uint64, current, desired *workspaceManifestState) error {
+	for _, name := range sortedKeys(desired.services) {
+		want := *desired.services[name]
+		want.TenantID = tenantID
+		want.WorkspaceID = workspaceID
+
+		cur, ok := current.services[name]
+		if !ok {
+			if _, err := m.workspaceer.CreateWorkspaceServiceInstance(ctx, &want); err != nil {
+				return err
+			}
+			continue
+		}
+		if len(serviceInstanceChanges(cur, &want)) == 0 {
+			continue
+		}
+		want.ID = cur.ID
+		if _, err := m.workspaceer.UpdateWorkspaceServiceInstance(ctx, &want); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range sortedKeys(current.services) {
+		if _, ok := desired.services[name]; ok {
+			continue
+		}
+		if err := m.workspaceer.DeleteWorkspaceServiceInstance(ctx, tenantID, current.services[name].ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// states loads the current state of the workspace of a manifest and resolves the state
+// the manifest describes. The current state of a new workspace only holds the roles its
+// owner is granted on creation, which the members of the manifest then reconcile.
+func (m *WorkspaceManifester) states(ctx context.Context, tenantID uint64, manifest *model.WorkspaceManifest) (*workspaceManifestState, *workspaceManifestState, error) {
+	if err := validateWorkspaceManifest(manifest); err != nil {
+		return nil, nil, err
+	}
+
+	current := &workspaceManifestState{
+		users:    map[string]*user_model.User{},
+		roles:    map[string][]authz.RoleName{},
+		services: map[string]*model.WorkspaceServiceInstance{},
+	}
+	if manifest.ID != 0 {
+		var err error
+		current, err = m.currentState(ctx, tenantID, manifest.ID)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	desired, err := m.desiredState(ctx, tenantID, manifest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if owner, ok := desired.users[desired.owner]; ok && manifest.ID == 0 {
+		if roles := creatorRoleNames(m.cfg); len(roles) > 0 {
+			current.users[desired.owner] = owner
+			current.roles[desired.owner] = roles
+		}
+	}
+	return current, desired, nil
+}
+
+func (m *WorkspaceManifester) currentState(ctx context.Context, tenantID, workspaceID uint64) (*workspaceManifestState, error) {
+	ws, err := m.workspaceer.GetWorkspace(ctx, tenantID, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &workspaceManifestState{
+		workspace: ws,
+		users:     map[string]*user_model.User{},
+		roles:     map[string][]authz.RoleName{},
+		services:  map[string]*model.WorkspaceServiceInstance{},
+	}
+
+	members, _, err := m.userer.ListUsers(ctx, user_service.ListUsersReq{
+		TenantID: tenantID,
+		Filter:   &user_service.UserFilter{WorkspaceIDs: []uint64{workspaceID}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range members {
+		var roles []authz.RoleName
+		for _, r := range user.Roles {
+			if r.Context[authz.ContextWorkspace] == fmt.Sprintf("%d", workspaceID) && isWorkspaceRole(r.Name) && !slices.Contains(roles, r.Name) {
+				roles = append(roles, r.Name)
+			}
+		}
+		if len(roles) == 0 {
+			continue
+		}
+		slices.Sort(roles)
+		state.users[user.Username] = user
+		state.roles[user.Username] = roles
+	}
+
+	userIDs := []uint64{ws.UserID}
+	if ws.ContactUserID != nil {
+		userIDs = append(userIDs, *ws.ContactUserID)
+	}
+	users, err := m.userer.GetUsers(ctx, tenantID, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		if user.ID == ws.UserID {
+			state.owner = user.Username
+		}
+		if ws.ContactUserID != nil && user.ID == *ws.ContactUserID {
+			state.contact = user.Username
+		}
+	}
+
+	svcs, _, err := m.workspaceer.ListWorkspaceServiceInstances(ctx, tenantID, nil, WorkspaceServiceInstanceFilter{WorkspaceIDsIn: &[]uint64{workspaceID}})
+	if err != nil {
+		return nil, err
+	}
+	for _, svc := range svcs {
+		state.services[svc.Name] = svc
+	}
+
+	return state, nil
+}
+
+func (m *WorkspaceManifester) desiredState(ctx context.Context, tenantID uint64, manifest *model.WorkspaceManifest) (*workspaceManifestState, error) {
+	usernames := []string{}
+	for _, username := range []string{manifest.Owner, manifest.Contact} {
+		if username != "" {
+			usernames = append(usernames, username)
+		}
+	}
+	for _, member := range manifest.Members {
+		usernames = append(usernames, member.Username)
+	}
+	users, err := m.usersByUsername(ctx, tenantID, usernames)
+	if err != nil {
+		return nil, err
+	}
+
+	ws := &model.Workspace{
+		ID:            manifest.ID,
+		TenantID:      tenantID,
+		Name:          manifest.Name,
+		ShortName:     manifest.ShortName,
+		Description:   manifest.Description,
+		Visibility:    manifest.Visibility,
+		Clipboard:     manifest.Clipboard,
+		ExpiresAt:     manifest.ExpiresAt,
+		NetworkPolicy: manifest.NetworkPolicy.Mode,
+		AllowedFQDNs:  manifest.NetworkPolicy.FQDNRules,
+	}
+	if ws.Visibility == "" {
+		ws.Visibility = model.WorkspaceVisibilityPrivate
+	}
+	if ws.Clipboard == "" {
+		ws.Clipboard = model.ClipboardDisabled
+	}
+	if ws.NetworkPolicy == "" {
+		ws.NetworkPolicy = model.NetworkPolicyAirgapped
+	}
+	if ws.AllowedFQDNs == nil {
+		ws.AllowedFQDNs = model.FQDNRules{}
+	}
+	if manifest.Contact != "" {
+		contactID := users[manifest.Contact].ID
+		ws.ContactUserID = &contactID
+	}
+
+	state := &workspaceManifestState{
+		workspace: ws,
+		owner:     manifest.Owner,
+		contact:   manifest.Contact,
+		users:     users,
+		roles:     map[string][]authz.RoleName{},
+		services:  map[string]*model.WorkspaceServiceInstance{},
+	}
+
+	for _, member := range manifest.Members {
+		roles := slices.Clone(member.Roles)
+		slices.Sort(roles)
+		state.roles[member.Username] = slices.Compact(roles)
+	}
+
+	var defs map[string]*model.ServiceDefinition
+	for _, entry := range manifest.ServiceInstances {
+		svc := &model.WorkspaceServiceInstance{
+			TenantID:               tenantID,
+			WorkspaceID:            manifest.ID,
+			Name:                   entry.Name,
+			State:                  entry.State,
+			ChartRegistry:          entry.ChartRegistry,
+			ChartRepository:        entry.ChartRepository,
+			ChartTag:               entry.ChartTag,
+			Values:                 model.JSONMap[any](entry.Values),
+			CredentialsSecretName:  entry.CredentialsSecretName,
+			CredentialsPaths:       model.StringSlice(entry.CredentialsPaths),
+			ConnectionInfoTemplate: entry.ConnectionInfoTemplate,
+		}
+		if svc.State == "" {
+			svc.State = model.ServiceInstanceStateRunning
+		}
+
+		if entry.ServiceDefinition != "" {
+			if defs == nil {
+				byID, err := m.serviceDefinitions(ctx, tenantID)
+				if err != nil {
+					return nil, err
+				}
+				defs = map[string]*model.ServiceDefinition{}
+				for _, def := range byID {
+					defs[def.Name] = def
+				}
+			}
+			def, ok := defs[entry.ServiceDefinition]
+			if !ok {
+				return nil, cerr.ErrInvalidRequest.WithMessage(fmt.Sprintf("Unknown service definition %q for service instance %q", entry.ServiceDefinition, entry.Name))
+			}
+			def.ApplyTo(svc)
+		}
+
+		state.services[entry.Name] = svc
+	}
+
+	return state, nil
+}
+
+func (m *WorkspaceManifester) usersByUsername(ctx context.Context, tenantID uint64, usernames []string) (map[string]*user_model.User, error) {
+	users := map[string]*user_model.User{}
+	for _, username := range usernames {
+		if _, ok := users[username]; ok {
+			continue
+		}
+		search := username
+		candidates, _, err := m.userer.ListUsers(ctx, user_service.ListUsersReq{
+			TenantID: tenantID,
+			Filter:   &user_service.UserFilter{Search: &search},
+		})
+		if err != nil {
+			return nil, err
+		}
+		idx := slices.IndexFunc(candidates, func(u *user_model.User) bool { return u.Username == username })
+		if idx < 0 {
+			return nil, cerr.ErrInvalidRequest.WithMessage(fmt.Sprintf("Unknown user %q", username))
+		}
+		users[username] = candidates[idx]
+	}
+	return users, nil
+}
+
+func (m *WorkspaceManifester) serviceDefinitions(ctx context.Context, tenantID uint64) (map[uint64]*model.ServiceDefinition, error) {
+	defs, _, err := m.workspaceer.ListServiceDefinitions(ctx, tenantID, nil)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uint64]*model.ServiceDefinition, len(defs))
+	for _, def := range defs {
+		byID[def.ID] = def
+	}
+	return byID, nil
+}
+
+func validateWorkspaceManifest(manifest *model.WorkspaceManifest) error {
+	if manifest.APIVersion != model.WorkspaceManifestAPIVersion || manifest.Kind != model.WorkspaceManifestKind {
+		return cerr.ErrInvalidRequest.WithMessage(fmt.Sprintf("Unsupported manifest %s/%s, expected %s/%s", manifest.APIVersion, manifest.Kind, model.WorkspaceManifestAPIVersion, model.WorkspaceManifestKind))
+	}
+	if manifest.Name == "" {
+		return cerr.ErrInvalidRequest.WithMessage("The manifest must set the workspace name")
+	}
+
+	seen := map[string]bool{}
+	for _, member := range manifest.Members {
+		if member.Username == "" {
+			return cerr.ErrInvalidRequest.WithMessage("Every member must set a username")
+		}
+		if seen[member.Username] {
+			return cerr.ErrInvalidRequest.WithMessage(fmt.Sprintf("Member %q is listed more than once", member.Username))
+		}
+		seen[member.Username] = true
+		for _, role := range member.Roles {
+			if !isWorkspaceRole(role) {
+				return cerr.ErrInvalidRequest.WithMessage(fmt.Sprintf("Role %v of member %q is not a workspace role", role, member.Username))
+			}
+		}
+	}
+
+	seen = map[string]bool{}
+	for _, svc := range manifest.ServiceInstances {
+		if seen[svc.Name] {
+			return cerr.ErrInvalidRequest.WithMessage(fmt.Sprintf("Service instance %q is listed more than once", svc.Name))
+		}
+		seen[svc.Name] = true
+	}
+
+	return nil
+}
+
+// diffWorkspaceManifestStates lists the changes turning the current state into the
+// desired one, ordered by section and key.
+func diffWorkspaceManifestStates(current, desired *workspaceManifestState) []model.WorkspaceManifestChange {
+	var changes []model.WorkspaceManifestChange
+
+	cur, want := current.workspace, desired.workspace
+	if cur == nil {
+		changes = append(changes, model.WorkspaceManifestChange{
+			Section: model.WorkspaceManifestSectionSettings,
+			Action:  model.WorkspaceManifestChangeAdd,
+			Key:     "workspace",
+			Desired: fmt.Sprintf("%s (owner %s)", want.Name, desired.owner),
+		})
+		cur = &model.Workspace{}
+	} else {
+		settings := []struct {
+			key              string
+			current, desired string
+		}{
+			{"name", cur.Name, want.Name},
+			{"shortName", cur.ShortName, want.ShortName},
+			{"description", cur.Description, want.Description},
+			{"visibility", cur.Visibility.String(), want.Visibility.String()},
+			{"contact", current.contact, desired.contact},
+			{"clipboard", cur.Clipboard.String(), want.Clipboard.String()},
+		}
+		for _, s := range settings {
+			if s.current != s.desired {
+				changes = append(changes, model.WorkspaceManifestChange{
+					Section: model.WorkspaceManifestSectionSettings,
+					Action:  model.WorkspaceManifestChangeUpdate,
+					Key:     s.key,
+					Current: s.current,
+					Desired: s.desired,
+				})
+			}
+		}
+		if ownerChanged(current, desired) {
+			changes = append(changes, model.WorkspaceManifestChange{
+				Section: model.WorkspaceManifestSectionSettings,
+				Action:  model.WorkspaceManifestChangeUpdate,
+				Key:     "owner",
+				Current: current.owner,
+				Desired: desired.owner,
+			})
+		}
+		if expiresAtChanged(cur, want) {
+			changes = append(changes, model.WorkspaceManifestChange{
+				Section: model.WorkspaceManifestSectionSettings,
+				Action:  model.WorkspaceManifestChangeUpdate,
+				Key:     "expiresAt",
+				Current: formatManifestTime(cur.ExpiresAt),
+				Desired: formatManifestTime(want.ExpiresAt),
+			})
+		}
+	}
+
+	if current.workspace == nil || networkPolicyChanged(cur, want) {
+		change := model.WorkspaceManifestChange{
+			Section: model.WorkspaceManifestSectionNetworkPolicy,
+			Action:  model.WorkspaceManifestChangeUpdate,
+			Key:     "networkPolicy",
+			Desired: describeNetworkPolicy(want.NetworkPolicy, want.AllowedFQDNs),
+		}
+		if current.workspace == nil {
+			change.Action = model.WorkspaceManifestChangeAdd
+		} else {
+			change.Current = describeNetworkPolicy(cur.NetworkPolicy, cur.AllowedFQDNs)
+		}
+		changes = append(changes, change)
+	}
+
+	for _, username := range sortedUnion(current.roles, desired.roles) {
+		for _, role := range desired.roles[username] {
+			if !slices.Contains(current.roles[username], role) {
+				changes = append(changes, model.WorkspaceManifestChange{
+					Section: model.WorkspaceManifestSectionMembers,
+					Action:  model.WorkspaceManifestChangeAdd,
+					Key:     username,
+					Desired: role.String(),
+				})
+			}
+		}
+		for _, role := range current.roles[username] {
+			if !slices.Contains(desired.roles[username], role) {
+				changes = append(changes, model.WorkspaceManifestChange{
+					Section: model.WorkspaceManifestSectionMembers,
+					Action:  model.WorkspaceManifestChangeRemove,
+					Key:     username,
+					Current: role.String(),
+				})
+			}
+		}
+	}
+
+	for _, name := range sortedUnion(current.services, desired.services) {
+		cur, inCurrent := current.services[name]
+		want, inDesired := desired.services[name]
+		switch {
+		case !inCurrent:
+			changes = append(changes, model.WorkspaceManifestChange{
+				Section: model.WorkspaceManifestSectionServiceInstances,
+				Action:  model.WorkspaceManifestChangeAdd,
+				Key:     name,
+				Desired: describeChart(want),
+			})
+		case !inDesired:
+			changes = append(changes, model.WorkspaceManifestChange{
+				Section: model.WorkspaceManifestSectionServiceInstances,
+				Action:  model.WorkspaceManifestChangeRemove,
+				Key:     name,
+				Current: describeChart(cur),
+			})
+		default:
+			changes = append(changes, serviceInstanceChanges(cur, want)...)
+		}
+	}
+
+	return changes
+}
+
+// serviceInstanceChanges lists the spec fields of a service instance that differ, one
+// change per field.
+func serviceInstanceChanges(current, desired *model.WorkspaceServiceInstance) []model.WorkspaceManifestChange {
+	fields := []struct {
+		key              string
+		current, desired string
+	}{
+		{"serviceDefinitionId", formatManifestID(current.GetServiceDefinitionID()), formatManifestID(desired.GetServiceDefinitionID())},
+		{"state", current.State.String(), desired.State.String()},
+		{"chart", describeChart(current), describeChart(desired)},
+		{"values", formatManifestValues(current.Values), formatManifestValues(desired.Values)},
+		{"credentialsSecretName", current.CredentialsSecretName, desired.CredentialsSecretName},
+		{"credentialsPaths", strings.Join(current.CredentialsPaths, ", "), strings.Join(desired.CredentialsPaths, ", ")},
+		{"connectionInfoTemplate", current.ConnectionInfoTemplate, desired.ConnectionInfoTemplate},
+	}
+
+	var changes []model.WorkspaceManifestChange
+	for _, f := range fields {
+		if f.current != f.desired {
+			changes = append(changes, model.WorkspaceManifestChange{
+				Section: model.WorkspaceManifestSectionServiceInstances,
+				Action:  model.WorkspaceManifestChangeUpdate,
+				Key:     current.Name + "." + f.key,
+				Current: f.current,
+				Desired: f.desired,
+			})
+		}
+	}
+	return changes
+}
+
+func workspaceSettingsChanged(current, desired *workspaceManifestState) bool {
+	cur, want := current.workspace, desired.workspace
+	return cur.Name != want.Name ||
+		cur.ShortName != want.ShortName ||
+		cur.Description != want.Description ||
+		cur.Visibility != want.Visibility ||
+		cur.Clipboard != want.Clipboard ||
+		current.contact != desired.contact
+}
+
+// ownerChanged reports whether the manifest hands the workspace over to another user.
+// Manifests without an owner leave the current one untouched.
+func ownerChanged(current, desired *workspaceManifestState) bool {
+	return desired.owner != "" && desired.owner != current.owner
+}
+
+// expiresAtChanged reports whether the manifest sets another end date. Manifests without
+// an end date leave the current one untouched.
+func expiresAtChanged(current, desired *model.Workspace) bool {
+	if desired.ExpiresAt == nil {
+		return false
+	}
+	return current.ExpiresAt == nil || !current.ExpiresAt.Equal(*desired.ExpiresAt)
+}
+
+func networkPolicyChanged(current, desired *model.Workspace) bool {
+	if current.NetworkPolicy != desired.NetworkPolicy {
+		return true
+	}
+	if len(current.AllowedFQDNs) == 0 && len(desired.AllowedFQDNs) == 0 {
+		return false
+	}
+	return !reflect.DeepEqual(current.AllowedFQDNs, desired.AllowedFQDNs)
+}
+
+func describeChart(svc *model.WorkspaceServiceInstance) string {
+	return fmt.Sprintf("%s/%s:%s", strings.TrimSuffix(svc.ChartRegistry, "/"), svc.ChartRepository, svc.ChartTag)
+}
+
+// formatManifestValues renders values as compact JSON, with sorted keys so that equal
+// values always render the same.
+func formatManifestValues(values model.JSONMap[any]) string {
+	if len(values) == 0 {
+		return ""
+	}
+	b, err := json.Marshal(values)
+	if err != nil {
+		return fmt.Sprintf("%v", map[string]any(values))
+	}
+	return string(b)
+}
+
+func formatManifestID(id uint64) string {
+	if id == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d", id)
+}
+
+func formatManifestTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedUnion[V any](a, b map[string]V) []string {
+	keys := sortedKeys(a)
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,219 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	authz "github.com/CHORUS-TRE/chorus-backend/pkg/authorization/model"
+	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
+	user_model "github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
+	user_service "github.com/CHORUS-TRE/chorus-backend/pkg/user/service"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
+)
+
+func manifestState() *workspaceManifestState {
+	return &workspaceManifestState{
+		workspace: &model.Workspace{
+			ID:            7,
+			Name:          "Genomics",
+			ShortName:     "gen",
+			Visibility:    model.WorkspaceVisibilityPrivate,
+			Clipboard:     model.ClipboardDisabled,
+			NetworkPolicy: model.NetworkPolicyAirgapped,
+			AllowedFQDNs:  model.FQDNRules{},
+		},
+		owner: "alice",
+		users: map[string]*user_model.User{
+			"alice": {ID: 1, Username: "alice"},
+		},
+		roles: map[string][]authz.RoleName{
+			"alice": {authz.RoleWorkspaceAdmin.Name},
+		},
+		services: map[string]*model.WorkspaceServiceInstance{
+			"db": {
+				ID:              3,
+				Name:            "db",
+				State:           model.ServiceInstanceStateRunning,
+				ChartRegistry:   "registry.example.org",
+				ChartRepository: "charts/postgres",
+				ChartTag:        "1.0.0",
+				Values:          model.JSONMap[any]{"storage": "10Gi"},
+			},
+		},
+	}
+}
+
+func TestDiffWorkspaceManifestStates_NoChanges(t *testing.T) {
+	assert.Empty(t, diffWorkspaceManifestStates(manifestState(), manifestState()))
+}
+
+func TestDiffWorkspaceManifestStates_ReportsEverySection(t *testing.T) {
+	current := manifestState()
+	desired := manifestState()
+
+	desired.workspace.Description = "Genome sequencing"
+	desired.workspace.NetworkPolicy = model.NetworkPolicyFQDNAllowlist
+	desired.workspace.AllowedFQDNs = model.FQDNRules{{Pattern: "pypi.org"}}
+	desired.users["bob"] = &user_model.User{ID: 2, Username: "bob"}
+	desired.roles["bob"] = []authz.RoleName{authz.RoleWorkspaceMember.Name}
+	desired.roles["alice"] = []authz.RoleName{authz.RoleWorkspaceMember.Name}
+	desired.services["db"].ChartTag = "1.1.0"
+	desired.services["cache"] = &model.WorkspaceServiceInstance{Name: "cache", State: model.ServiceInstanceStateRunning, ChartRegistry: "registry.example.org", ChartRepository: "charts/redis", ChartTag: "7.0.0"}
+
+	changes := diffWorkspaceManifestStates(current, desired)
+
+	assert.Equal(t, []model.WorkspaceManifestChange{
+		{Section: model.WorkspaceManifestSectionSettings, Action: model.WorkspaceManifestChangeUpdate, Key: "description", Current: "", Desired: "Genome sequencing"},
+		{Section: model.WorkspaceManifestSectionNetworkPolicy, Action: model.WorkspaceManifestChangeUpdate, Key: "networkPolicy", Current: "Airgapped", Desired: "FQDNAllowlist [pypi.org]"},
+		{Section: model.WorkspaceManifestSectionMembers, Action: model.WorkspaceManifestChangeAdd, Key: "alice", Desired: authz.RoleWorkspaceMember.Name.String()},
+		{Section: model.WorkspaceManifestSectionMembers, Action: model.WorkspaceManifestChangeRemove, Key: "alice", Current: authz.RoleWorkspaceAdmin.Name.String()},
+		{Section: model.WorkspaceManifestSectionMembers, Action: model.WorkspaceManifestChangeAdd, Key: "bob", Desired: authz.RoleWorkspaceMember.Name.String()},
+		{Section: model.WorkspaceManifestSectionServiceInstances, Action: model.WorkspaceManifestChangeAdd, Key: "cache", Desired: "registry.example.org/charts/redis:7.0.0"},
+		{Section: model.WorkspaceManifestSectionServiceInstances, Action: model.WorkspaceManifestChangeUpdate, Key: "db.chart", Current: "registry.example.org/charts/postgres:1.0.0", Desired: "registry.example.org/charts/postgres:1.1.0"},
+	}, changes)
+}
+
+func TestDiffWorkspaceManifestStates_RemovedServiceInstance(t *testing.T) {
+	desired := manifestState()
+	delete(desired.services, "db")
+
+	changes := diffWorkspaceManifestStates(manifestState(), desired)
+	require.Len(t, changes, 1)
+	assert.Equal(t, model.WorkspaceManifestChangeRemove, changes[0].Action)
+	assert.Equal(t, "db", changes[0].Key)
+}
+
+func TestDiffWorkspaceManifestStates_NewWorkspace(t *testing.T) {
+	current := &workspaceManifestState{
+		users:    map[string]*user_model.User{},
+		roles:    map[string][]authz.RoleName{},
+		services: map[string]*model.WorkspaceServiceInstance{},
+	}
+	desired := manifestState()
+	desired.workspace.ID = 0
+
+	changes := diffWorkspaceManifestStates(current, desired)
+	require.Len(t, changes, 4)
+	assert.Equal(t, model.WorkspaceManifestChange{Section: model.WorkspaceManifestSectionSettings, Action: model.WorkspaceManifestChangeAdd, Key: "workspace", Desired: "Genomics (owner alice)"}, changes[0])
+	assert.Equal(t, model.WorkspaceManifestChangeAdd, changes[1].Action)
+	assert.Equal(t, "alice", changes[2].Key)
+	assert.Equal(t, "db", changes[3].Key)
+}
+
+func TestDiffWorkspaceManifestStates_OwnerChange(t *testing.T) {
+	desired := manifestState()
+	desired.owner = "bob"
+	desired.users["bob"] = &user_model.User{ID: 2, Username: "bob"}
+
+	changes := diffWorkspaceManifestStates(manifestState(), desired)
+	assert.Equal(t, []model.WorkspaceManifestChange{
+		{Section: model.WorkspaceManifestSectionSettings, Action: model.WorkspaceManifestChangeUpdate, Key: "owner", Current: "alice", Desired: "bob"},
+	}, changes)
+
+	desired.owner = ""
+	assert.Empty(t, diffWorkspaceManifestStates(manifestState(), desired))
+}
+
+func TestDiffWorkspaceManifest_NewWorkspaceReconcilesCreatorRoles(t *testing.T) {
+	var cfg config.Config
+	cfg.Services.WorkspaceService.CreatorIsAdmin = true
+	cfg.Services.WorkspaceService.CreatorIsDataManager = true
+	userer := &mockUserer{
+		listUsers: func(_ context.Context, req user_service.ListUsersReq) ([]*user_model.User, *common_model.PaginationResult, error) {
+			return []*user_model.User{{ID: 1, Username: *req.Filter.Search}}, nil, nil
+		},
+	}
+	manifester := NewWorkspaceManifester(nil, userer, &mockAuditWriter{}, cfg)
+
+	changes, err := manifester.DiffWorkspaceManifest(context.Background(), 1, &model.WorkspaceManifest{
+		APIVersion: model.WorkspaceManifestAPIVersion,
+		Kind:       model.WorkspaceManifestKind,
+		Name:       "Genomics",
+		Owner:      "alice",
+		Members:    []model.WorkspaceManifestMember{{Username: "alice", Roles: []authz.RoleName{authz.RoleWorkspaceAdmin.Name}}},
+	})
+
+	require.NoError(t, err)
+	var members []model.WorkspaceManifestChange
+	for _, c := range changes {
+		if c.Section == model.WorkspaceManifestSectionMembers {
+			members = append(members, c)
+		}
+	}
+	assert.Equal(t, []model.WorkspaceManifestChange{
+		{Section: model.WorkspaceManifestSectionMembers, Action: model.WorkspaceManifestChangeRemove, Key: "alice", Current: authz.RoleWorkspaceDataManager.Name.String()},
+	}, members)
+}
+
+func TestDiffWorkspaceManifestStates_ValuesCompareBySemantics(t *testing.T) {
+	current := manifestState()
+	current.services["db"].Values = model.JSONMap[any]{"storage": "10Gi", "replicas": float64(2)}
+	desired := manifestState()
+	desired.services["db"].Values = model.JSONMap[any]{"replicas": float64(2), "storage": "10Gi"}
+
+	assert.Empty(t, diffWorkspaceManifestStates(current, desired))
+}
+
+func TestValidateWorkspaceManifest(t *testing.T) {
+	valid := func() *model.WorkspaceManifest {
+		return &model.WorkspaceManifest{
+			APIVersion: model.WorkspaceManifestAPIVersion,
+			Kind:       model.WorkspaceManifestKind,
+			Name:       "Genomics",
+			Members:    []model.WorkspaceManifestMember{{Username: "alice", Roles: []authz.RoleName{authz.RoleWorkspaceAdmin.Name}}},
+		}
+	}
+	require.NoError(t, validateWorkspaceManifest(valid()))
+
+	tests := []struct {
+		name   string
+		mutate func(*model.WorkspaceManifest)
+	}{
+		{"wrong kind", func(m *model.WorkspaceManifest) { m.Kind = "Workbench" }},
+		{"missing name", func(m *model.WorkspaceManifest) { m.Name = "" }},
+		{"duplicate member", func(m *model.WorkspaceManifest) { m.Members = append(m.Members, m.Members[0]) }},
+		{"platform role", func(m *model.WorkspaceManifest) {
+			m.Members[0].Roles = []authz.RoleName{authz.RolePlatformUserManager.Name}
+		}},
+		{"duplicate service instance", func(m *model.WorkspaceManifest) {
+			m.ServiceInstances = []model.WorkspaceManifestServiceInstance{{Name: "db"}, {Name: "db"}}
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manifest := valid()
+			tt.mutate(manifest)
+			err := validateWorkspaceManifest(manifest)
+			var cErr *cerr.ChorusError
+			require.ErrorAs(t, err, &cErr)
+			assert.Equal(t, cerr.ErrInvalidRequest.ChorusCode, cErr.ChorusCode)
+		})
+	}
+}
+
+func TestCheckManifestNetworkPolicy(t *testing.T) {
+	current, desired := manifestState(), manifestState()
+	desired.workspace.NetworkPolicy = model.NetworkPolicyFQDNAllowlist
+	desired.workspace.AllowedFQDNs = model.FQDNRules{{Pattern: "pypi.org"}}
+
+	err := checkManifestNetworkPolicy(current, desired)
+	var cErr *cerr.ChorusError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, cerr.ErrPermissionDenied.ChorusCode, cErr.ChorusCode, "relaxations need an approval")
+
+	assert.NoError(t, checkManifestNetworkPolicy(desired, current), "tighter policies are applied")
+
+	desired.workspace.AllowedFQDNs = model.FQDNRules{}
+	current.workspace.NetworkPolicy = model.NetworkPolicyFQDNAllowlist
+	current.workspace.AllowedFQDNs = model.FQDNRules{{Pattern: "pypi.org"}}
+	assert.NoError(t, checkManifestNetworkPolicy(current, desired), "removing FQDN rules tightens the policy")
+
+	assert.NoError(t, checkManifestNetworkPolicy(&workspaceManifestState{}, desired), "new workspaces are created with their policy")
+}
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	jwt_model "github.com/CHORUS-TRE/chorus-backend/internal/jwt/model"
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	approval_model "github.com/CHORUS-TRE/chorus-backend/pkg/approval-request/model"
+	authz "github.com/CHORUS-TRE/chorus-backend/pkg/authorization/model"
+	notification_model "github.com/CHORUS-TRE/chorus-backend/pkg/notification/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
+	"go.uber.org/zap"
+)
+
+// ApprovalRequestStore records the approval requests raised by workspace updates.
+type ApprovalRequestStore interface {
+	CreateApprovalRequest(ctx context.Context, tenantID uint64, request *approval_model.ApprovalRequest) (*approval_model.ApprovalRequest, error)
+}
+
+type UserPermissionFinder interface {
+	FindUsersWithPermission(ctx context.Context, tenantID uint64, filter authz.FindUsersWithPermissionFilter) ([]uint64, error)
+}
+
+// UpdateWorkspaceNetworkPolicy applies a network policy to a workspace and syncs it to K8s.
+// It is called once a network policy relaxation has been approved.
+func (s *WorkspaceService) UpdateWorkspaceNetworkPolicy(ctx context.Context, tenantID, workspaceID uint64, networkPolicy model.NetworkPolicyMode, allowedFQDNs model.FQDNRules) (*model.Workspace, error) {
+	if err := s.checkWorkspaceWritable(ctx, tenantID, workspaceID); err != nil {
+		return nil, err
+	}
+
+	if allowedFQDNs == nil {
+		allowedFQDNs = model.FQDNRules{}
+	}
+
+	updated, err := s.store.UpdateWorkspaceNetworkPolicy(ctx, tenantID, workspaceID, networkPolicy, allowedFQDNs)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to update network policy of workspace %v", workspaceID))
+	}
+
+	if err := s.syncWorkspaceToK8s(ctx, workspaceID, tenantID); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// requestNetworkPolicyRelaxation records the relaxation of the network policy of a
+// workspace as an approval request for its data managers and the platform data
+// managers. The request is auto-approved, and nil is returned, when the requester is
+// one of these approvers; otherwise the pending request is returned and the approvers
+// are notified.
+func (s *WorkspaceService) requestNetworkPolicyRelaxation(ctx context.Context, current, requested *model.Workspace) (*approval_model.ApprovalRequest, error) {
+	requesterID, err := jwt_model.ExtractUserID(ctx)
+	if err != nil {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Could not extract user ID from token")
+	}
+
+	approvers, err := s.userPermissionFinder.FindUsersWithPermission(ctx, current.TenantID, authz.FindUsersWithPermissionFilter{
+		PermissionName: authz.PermApproveNetworkPolicyChange.Name,
+		Context:        authz.Context{authz.ContextWorkspace: fmt.Sprintf("%d", current.ID)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(approvers) == 0 {
+		return nil, cerr.ErrInvalidRequest.WithMessage("No data manager or platform admin can approve network policy changes for this workspace")
+	}
+
+	request := &approval_model.ApprovalRequest{
+		TenantID:    current.TenantID,
+		RequesterID: requesterID,
+		Type:        approval_model.ApprovalRequestTypeNetworkPolicyChange,
+		Status:      approval_model.ApprovalRequestStatusPending,
+		Title:       fmt.Sprintf("Network policy change for workspace %s", current.Name),
+		Description: fmt.Sprintf("Change the network policy from %s to %s", describeNetworkPolicy(current.NetworkPolicy, current.AllowedFQDNs), describeNetworkPolicy(requested.NetworkPolicy, requested.AllowedFQDNs)),
+		Details: approval_model.ApprovalRequestDetails{
+			NetworkPolicyChangeDetails: &approval_model.NetworkPolicyChangeDetails{
+				WorkspaceID:          current.ID,
+				CurrentNetworkPolicy: current.NetworkPolicy.String(),
+				CurrentAllowedFQDNs:  current.AllowedFQDNs,
+				NetworkPolicy:        requested.NetworkPolicy.String(),
+				AllowedFQDNs:         requested.AllowedFQDNs,
+			},
+		},
+		ApproverIDsByStep: map[approval_model.ApprovalStep][]uint64{
+			approval_model.StepNetworkPolicy: approvers,
+		},
+	}
+
+	autoApprove := false
+	for _, id := range approvers {
+		if id == requesterID {
+			autoApprove = true
+			break
+		}
+	}
+	if autoApprove {
+		now := time.Now()
+		request.Status = approval_model.ApprovalRequestStatusApproved
+		request.AutoApproved = true
+		request.ApprovedAt = &now
+		request.StepDecisions = map[approval_model.ApprovalStep]approval_model.ApprovalStepDecision{
+			approval_model.StepNetworkPolicy: {ApproverID: requesterID, ApprovedAt: now, Approve: true},
+		}
+	}
+
+	created, err := s.approvalRequestStore.CreateApprovalRequest(ctx, current.TenantID, request)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, "Unable to create approval request")
+	}
+
+	if autoApprove {
+		return nil, nil
+	}
+
+	for _, approverID := range approvers {
+		err = s.notificationStore.CreateNotification(ctx, &notification_model.Notification{
+			TenantID: current.TenantID,
+			UserID:   approverID,
+			Message:  fmt.Sprintf("Approval request '%s' has been created and is pending your approval.", request.Title),
+			Content: notification_model.NotificationContent{
+				Type: "ApprovalRequestNotification",
+				ApprovalRequest: &notification_model.ApprovalRequestNotification{
+					ApprovalRequestID: created.ID,
+				},
+			},
+		}, []uint64{approverID})
+		if err != nil {
+			logger.TechLog.Error(ctx, "Unable to create notification", zap.Uint64("tenant_id", current.TenantID), zap.Uint64("request_id", created.ID), zap.Uint64("user_id", approverID))
+		}
+	}
+
+	return created, nil
+}
+
+func describeNetworkPolicy(mode model.NetworkPolicyMode, rules model.FQDNRules) string {
+	if mode != model.NetworkPolicyFQDNAllowlist {
+		return mode.String()
+	}
+	described := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		described = append(described, rule.String())
+	}
+	return fmt.Sprintf("%s [%s]", mode, strings.Join(described, ", "))
+}
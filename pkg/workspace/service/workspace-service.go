@@ -2,8 +2,10 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/rand/v2"
+	"slices"
 	"time"
 
 	"github.com/CHORUS-TRE/chorus-backend/internal/audit"
@@ -11,11 +13,14 @@ import (
 	"github.com/CHORUS-TRE/chorus-backend/internal/config"
 	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
 	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	"github.com/CHORUS-TRE/chorus-backend/internal/mailer"
+	approval_model "github.com/CHORUS-TRE/chorus-backend/pkg/approval-request/model"
 	audit_model "github.com/CHORUS-TRE/chorus-backend/pkg/audit/model"
 	audit_service "github.com/CHORUS-TRE/chorus-backend/pkg/audit/service"
 	authz "github.com/CHORUS-TRE/chorus-backend/pkg/authorization/model"
 	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
 	notification_model "github.com/CHORUS-TRE/chorus-backend/pkg/notification/model"
+	platform_settings_model "github.com/CHORUS-TRE/chorus-backend/pkg/platform-settings/model"
 	user_model "github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
 	user_service "github.com/CHORUS-TRE/chorus-backend/pkg/user/service"
 	workbench_model "github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
@@ -27,6 +32,10 @@ type NotificationStore interface {
 	CreateNotification(ctx context.Context, notification *notification_model.Notification, userIDs []uint64) error
 }
 
+type PlatformSettingsReader interface {
+	GetPlatformSettings(ctx context.Context, tenantID uint64) (*platform_settings_model.PlatformSettings, error)
+}
+
 type Workspaceer interface {
 	GetWorkspace(ctx context.Context, tenantID, workspaceID uint64) (*model.Workspace, error)
 	ListWorkspaces(ctx context.Context, tenantID uint64, pagination *common_model.Pagination, filter model.WorkspaceFilter) ([]*model.Workspace, *common_model.PaginationResult, error)
@@ -34,8 +43,14 @@ type Workspaceer interface {
 	CreateWorkspace(ctx context.Context, workspace *model.Workspace) (*model.Workspace, error)
 	UpdateWorkspace(ctx context.Context, workspace *model.Workspace) (*model.Workspace, error)
 	DeleteWorkspace(ctx context.Context, tenantId, workspaceId uint64) error
+	ExtendWorkspace(ctx context.Context, tenantID, workspaceID uint64, expiresAt time.Time) (*model.Workspace, error)
+	FreezeWorkspace(ctx context.Context, tenantID, workspaceID uint64) (*model.Workspace, error)
+	UnfreezeWorkspace(ctx context.Context, tenantID, workspaceID uint64) (*model.Workspace, error)
+	UpdateWorkspaceNetworkPolicy(ctx context.Context, tenantID, workspaceID uint64, networkPolicy model.NetworkPolicyMode, allowedFQDNs model.FQDNRules) (*model.Workspace, error)
+	UpdateWorkspaceOwner(ctx context.Context, tenantID, workspaceID, userID uint64) (*model.Workspace, error)
 
 	AddUserRoleInWorkspace(ctx context.Context, tenantID, userID uint64, role user_model.UserRole) error
+	GrantUserRoleInWorkspace(ctx context.Context, tenantID, userID, workspaceID uint64, roleName authz.RoleName) (bool, error)
 	RemoveUserRoleInWorkspace(ctx context.Context, tenantID, userID, workspaceID uint64, roleName authz.RoleName) error
 	RemoveUserFromWorkspace(ctx context.Context, tenantID, userID uint64, workspaceID uint64) error
 
@@ -45,6 +60,27 @@ type Workspaceer interface {
 	CreateWorkspaceServiceInstance(ctx context.Context, svc *model.WorkspaceServiceInstance) (*model.WorkspaceServiceInstance, error)
 	UpdateWorkspaceServiceInstance(ctx context.Context, svc *model.WorkspaceServiceInstance) (*model.WorkspaceServiceInstance, error)
 	DeleteWorkspaceServiceInstance(ctx context.Context, tenantID, workspaceServiceInstanceID uint64) error
+
+	GetWorkspaceTemplate(ctx context.Context, tenantID, templateID uint64) (*model.WorkspaceTemplate, error)
+	ListWorkspaceTemplates(ctx context.Context, tenantID uint64, pagination *common_model.Pagination) ([]*model.WorkspaceTemplate, *common_model.PaginationResult, error)
+	CreateWorkspaceTemplate(ctx context.Context, template *model.WorkspaceTemplate) (*model.WorkspaceTemplate, error)
+	UpdateWorkspaceTemplate(ctx context.Context, template *model.WorkspaceTemplate) (*model.WorkspaceTemplate, error)
+	DeleteWorkspaceTemplate(ctx context.Context, tenantID, templateID uint64) error
+	CreateWorkspaceFromTemplate(ctx context.Context, templateID uint64, workspace *model.Workspace) (*model.Workspace, []*model.WorkspaceServiceInstance, error)
+
+	GetServiceDefinition(ctx context.Context, tenantID, serviceDefinitionID uint64) (*model.ServiceDefinition, error)
+	ListServiceDefinitions(ctx context.Context, tenantID uint64, pagination *common_model.Pagination) ([]*model.ServiceDefinition, *common_model.PaginationResult, error)
+	CreateServiceDefinition(ctx context.Context, def *model.ServiceDefinition) (*model.ServiceDefinition, error)
+	UpdateServiceDefinition(ctx context.Context, def *model.ServiceDefinition) (*model.ServiceDefinition, error)
+	DeleteServiceDefinition(ctx context.Context, tenantID, serviceDefinitionID uint64) error
+
+	GetWorkspaceInvitation(ctx context.Context, tenantID, invitationID uint64) (*model.WorkspaceInvitation, error)
+	ListWorkspaceInvitations(ctx context.Context, tenantID, workspaceID uint64, pagination *common_model.Pagination, filter model.WorkspaceInvitationFilter) ([]*model.WorkspaceInvitation, *common_model.PaginationResult, error)
+	ListInviteeWorkspaceInvitations(ctx context.Context, tenantID, userID uint64, pagination *common_model.Pagination) ([]*model.WorkspaceInvitation, *common_model.PaginationResult, error)
+	CreateWorkspaceInvitation(ctx context.Context, invitation *model.WorkspaceInvitation) (*model.WorkspaceInvitation, error)
+	AcceptWorkspaceInvitation(ctx context.Context, tenantID, userID, invitationID uint64, token string) (*model.WorkspaceInvitation, error)
+	DeclineWorkspaceInvitation(ctx context.Context, tenantID, userID, invitationID uint64, token string) (*model.WorkspaceInvitation, error)
+	RevokeWorkspaceInvitation(ctx context.Context, tenantID, invitationID uint64) (*model.WorkspaceInvitation, error)
 }
 
 type WorkspaceServiceInstanceFilter struct {
@@ -61,10 +97,19 @@ type WorkspaceStore interface {
 	ListWorkspaces(ctx context.Context, tenantID uint64, pagination *common_model.Pagination, IDIn *[]uint64, allowDeleted bool) ([]*model.Workspace, *common_model.PaginationResult, error)
 	ListPublicWorkspaces(ctx context.Context, tenantID uint64, pagination *common_model.Pagination) ([]*model.Workspace, *common_model.PaginationResult, error)
 	DeleteOldWorkspaces(ctx context.Context, duration time.Duration) ([]*model.Workspace, error)
-	CreateWorkspace(ctx context.Context, tenantID uint64, workspace *model.Workspace) (*model.Workspace, error)
+	CreateWorkspace(ctx context.Context, tenantID uint64, workspace *model.Workspace, maxPerUser uint32) (*model.Workspace, error)
+	CreateWorkspaceWithServiceInstances(ctx context.Context, tenantID uint64, workspace *model.Workspace, svcs []*model.WorkspaceServiceInstance, maxPerUser uint32) (*model.Workspace, []*model.WorkspaceServiceInstance, error)
 	UpdateWorkspace(ctx context.Context, tenantID uint64, workspace *model.Workspace) (*model.Workspace, error)
 	DeleteWorkspace(ctx context.Context, tenantID uint64, workspaceID uint64) error
-	UpdateWorkspaceStatus(ctx context.Context, tenantID uint64, workspaceID uint64, networkPolicyStatus, networkPolicyMessage string) error
+	UpdateWorkspaceStatus(ctx context.Context, tenantID uint64, workspaceID uint64, networkPolicyStatus, networkPolicyMessage string, rejectedFQDNs model.JSONMap[string]) error
+	UpdateWorkspaceNetworkPolicy(ctx context.Context, tenantID uint64, workspaceID uint64, networkPolicy model.NetworkPolicyMode, allowedFQDNs model.FQDNRules) (*model.Workspace, error)
+	UpdateWorkspaceOwner(ctx context.Context, tenantID uint64, workspaceID uint64, userID uint64) (*model.Workspace, error)
+	ExtendWorkspace(ctx context.Context, tenantID uint64, workspaceID uint64, expiresAt time.Time) (*model.Workspace, error)
+	ListExpiringWorkspaces(ctx context.Context, before time.Time) ([]*model.Workspace, error)
+	UpdateWorkspaceExpiryNotice(ctx context.Context, tenantID uint64, workspaceID uint64, noticeDays int) error
+	ArchiveWorkspace(ctx context.Context, tenantID uint64, workspaceID uint64) error
+	FreezeWorkspace(ctx context.Context, tenantID uint64, workspaceID uint64) error
+	UnfreezeWorkspace(ctx context.Context, tenantID uint64, workspaceID uint64) error
 
 	GetWorkspaceServiceInstance(ctx context.Context, tenantID, workspaceServiceInstanceID uint64) (*model.WorkspaceServiceInstance, error)
 	ListWorkspaceServiceInstances(ctx context.Context, tenantID uint64, pagination *common_model.Pagination, workspaceIDsIn *[]uint64) ([]*model.WorkspaceServiceInstance, *common_model.PaginationResult, error)
@@ -73,6 +118,25 @@ type WorkspaceStore interface {
 	UpdateWorkspaceServiceInstance(ctx context.Context, tenantID uint64, svc *model.WorkspaceServiceInstance) (*model.WorkspaceServiceInstance, error)
 	DeleteWorkspaceServiceInstance(ctx context.Context, tenantID, workspaceServiceInstanceID uint64) error
 	UpdateWorkspaceServiceInstanceStatuses(ctx context.Context, workspaceID uint64, statuses map[uint64]model.WorkspaceServiceInstanceStatusUpdate) error
+
+	GetWorkspaceTemplate(ctx context.Context, tenantID, templateID uint64) (*model.WorkspaceTemplate, error)
+	ListWorkspaceTemplates(ctx context.Context, tenantID uint64, pagination *common_model.Pagination) ([]*model.WorkspaceTemplate, *common_model.PaginationResult, error)
+	CreateWorkspaceTemplate(ctx context.Context, tenantID uint64, template *model.WorkspaceTemplate) (*model.WorkspaceTemplate, error)
+	UpdateWorkspaceTemplate(ctx context.Context, tenantID uint64, template *model.WorkspaceTemplate) (*model.WorkspaceTemplate, error)
+	DeleteWorkspaceTemplate(ctx context.Context, tenantID, templateID uint64) error
+
+	GetServiceDefinition(ctx context.Context, tenantID, serviceDefinitionID uint64) (*model.ServiceDefinition, error)
+	ListServiceDefinitions(ctx context.Context, tenantID uint64, pagination *common_model.Pagination) ([]*model.ServiceDefinition, *common_model.PaginationResult, error)
+	CreateServiceDefinition(ctx context.Context, tenantID uint64, def *model.ServiceDefinition) (*model.ServiceDefinition, error)
+	UpdateServiceDefinition(ctx context.Context, tenantID uint64, def *model.ServiceDefinition) (*model.ServiceDefinition, error)
+	DeleteServiceDefinition(ctx context.Context, tenantID, serviceDefinitionID uint64) error
+
+	GetWorkspaceInvitation(ctx context.Context, tenantID, invitationID uint64) (*model.WorkspaceInvitation, error)
+	ListWorkspaceInvitations(ctx context.Context, tenantID, workspaceID uint64, pagination *common_model.Pagination, filter model.WorkspaceInvitationFilter) ([]*model.WorkspaceInvitation, *common_model.PaginationResult, error)
+	ListInviteeWorkspaceInvitations(ctx context.Context, tenantID, userID uint64, pagination *common_model.Pagination) ([]*model.WorkspaceInvitation, *common_model.PaginationResult, error)
+	CreateWorkspaceInvitation(ctx context.Context, tenantID uint64, invitation *model.WorkspaceInvitation) (*model.WorkspaceInvitation, error)
+	RespondWorkspaceInvitation(ctx context.Context, tenantID, invitationID uint64, status model.WorkspaceInvitationStatus, inviteeUserID *uint64) error
+	ClaimWorkspaceInvitations(ctx context.Context, tenantID, userID uint64, email string) (int64, error)
 }
 
 type Userer interface {
@@ -81,6 +145,7 @@ type Userer interface {
 	RemoveRolesByContext(ctx context.Context, contextDimension, contextValue string) ([]uint64, error)
 	GetUser(ctx context.Context, req user_service.GetUserReq) (*user_model.User, error)
 	GetUsers(ctx context.Context, tenantID uint64, userIDs []uint64) ([]*user_model.User, error)
+	ListUsers(ctx context.Context, req user_service.ListUsersReq) ([]*user_model.User, *common_model.PaginationResult, error)
 }
 
 type WorkspaceService struct {
@@ -90,10 +155,15 @@ type WorkspaceService struct {
 	workbencher       Workbencher
 	userer            Userer
 	notificationStore NotificationStore
+	mailer            mailer.Mailer
+	platformSettings  PlatformSettingsReader
 	auditWriter       audit_service.AuditWriter
+
+	approvalRequestStore ApprovalRequestStore
+	userPermissionFinder UserPermissionFinder
 }
 
-func NewWorkspaceService(cfg config.Config, store WorkspaceStore, client k8s.K8sClienter, workbencher Workbencher, userer Userer, notificationStore NotificationStore, auditWriter audit_service.AuditWriter) *WorkspaceService {
+func NewWorkspaceService(cfg config.Config, store WorkspaceStore, client k8s.K8sClienter, workbencher Workbencher, userer Userer, notificationStore NotificationStore, mailer mailer.Mailer, platformSettings PlatformSettingsReader, auditWriter audit_service.AuditWriter, approvalRequestStore ApprovalRequestStore, userPermissionFinder UserPermissionFinder) *WorkspaceService {
 	ws := &WorkspaceService{
 		cfg:               cfg,
 		store:             store,
@@ -101,7 +171,12 @@ func NewWorkspaceService(cfg config.Config, store WorkspaceStore, client k8s.K8s
 		workbencher:       workbencher,
 		userer:            userer,
 		notificationStore: notificationStore,
+		mailer:            mailer,
+		platformSettings:  platformSettings,
 		auditWriter:       auditWriter,
+
+		approvalRequestStore: approvalRequestStore,
+		userPermissionFinder: userPermissionFinder,
 	}
 
 	ws.SetClientWatchers()
@@ -262,7 +337,15 @@ func (s *WorkspaceService) GetWorkspace(ctx context.Context, tenantID, workspace
 }
 
 func (s *WorkspaceService) DeleteWorkspace(ctx context.Context, tenantID, workspaceID uint64) error {
-	err := s.workbencher.DeleteWorkbenchesInWorkspace(ctx, tenantID, workspaceID)
+	workspace, err := s.store.GetWorkspace(ctx, tenantID, workspaceID)
+	if err != nil {
+		return cerr.WrapStoreError(err, fmt.Sprintf("Unable to get workspace %v", workspaceID))
+	}
+	if workspace.Status == model.WorkspaceStatusFrozen {
+		return readOnlyError(workspace)
+	}
+
+	err = s.workbencher.DeleteWorkbenchesInWorkspace(ctx, tenantID, workspaceID)
 	if err != nil {
 		return cerr.ErrInternal.Wrap(err, fmt.Sprintf("Unable to delete workbenches in workspace %v", workspaceID))
 	}
@@ -293,7 +376,7 @@ func (s *WorkspaceService) UpdateWorkspace(ctx context.Context, workspace *model
 		workspace.Clipboard = "disabled"
 	}
 	if workspace.AllowedFQDNs == nil {
-		workspace.AllowedFQDNs = model.StringSlice{}
+		workspace.AllowedFQDNs = model.FQDNRules{}
 	}
 	if workspace.Visibility == "" {
 		workspace.Visibility = model.WorkspaceVisibilityPrivate
@@ -301,10 +384,45 @@ func (s *WorkspaceService) UpdateWorkspace(ctx context.Context, workspace *model
 	if workspace.ContactUserID != nil && *workspace.ContactUserID == 0 {
 		workspace.ContactUserID = nil
 	}
+	if err := s.checkWorkbenchIdleTimeout(workspace); err != nil {
+		return nil, err
+	}
+
+	current, err := s.store.GetWorkspace(ctx, workspace.TenantID, workspace.ID)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to get workspace %v", workspace.ID))
+	}
+	if current.Status.IsReadOnly() {
+		return nil, readOnlyError(current)
+	}
+	if workspace.Status == model.WorkspaceStatusArchived {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Workspaces are archived automatically when their end date is reached")
+	}
+	if workspace.Status == model.WorkspaceStatusFrozen {
+		return nil, cerr.ErrInvalidRequest.WithMessage("Use FreezeWorkspace to freeze a workspace")
+	}
+
+	// Relaxing the network policy needs the approval of a data manager: until then the
+	// current policy is kept and the other changes are applied.
+	var networkPolicyRequest *approval_model.ApprovalRequest
+	if model.IsNetworkPolicyRelaxation(current.NetworkPolicy, current.AllowedFQDNs, workspace.NetworkPolicy, workspace.AllowedFQDNs) {
+		networkPolicyRequest, err = s.requestNetworkPolicyRelaxation(ctx, current, workspace)
+		if err != nil {
+			return nil, err
+		}
+		if networkPolicyRequest != nil {
+			workspace.NetworkPolicy = current.NetworkPolicy
+			workspace.AllowedFQDNs = current.AllowedFQDNs
+		}
+	}
+
 	updatedWorkspace, err := s.store.UpdateWorkspace(ctx, workspace.TenantID, workspace)
 	if err != nil {
 		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to update workspace %v", workspace.ID))
 	}
+	if networkPolicyRequest != nil {
+		updatedWorkspace.NetworkPolicyApprovalRequestID = networkPolicyRequest.ID
+	}
 
 	svcs, err := s.store.ListWorkspaceServiceInstancesByWorkspace(ctx, updatedWorkspace.ID)
 	if err != nil {
@@ -319,7 +437,113 @@ func (s *WorkspaceService) UpdateWorkspace(ctx context.Context, workspace *model
 	return updatedWorkspace, nil
 }
 
+// ExtendWorkspace moves the end date of a workspace further in the future. Archived
+// workspaces become active again; their workbenches are not restored.
+func (s *WorkspaceService) ExtendWorkspace(ctx context.Context, tenantID, workspaceID uint64, expiresAt time.Time) (*model.Workspace, error) {
+	if !expiresAt.After(time.Now()) {
+		return nil, cerr.ErrInvalidRequest.WithMessage("The new end date must be in the future")
+	}
+
+	current, err := s.store.GetWorkspace(ctx, tenantID, workspaceID)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to get workspace %v", workspaceID))
+	}
+	if current.ExpiresAt != nil && expiresAt.Before(*current.ExpiresAt) {
+		return nil, cerr.ErrInvalidRequest.WithMessage(fmt.Sprintf("The new end date must be later than the current one (%v)", current.ExpiresAt.Format(time.RFC3339)))
+	}
+
+	workspace, err := s.store.ExtendWorkspace(ctx, tenantID, workspaceID, expiresAt)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to extend workspace %v", workspaceID))
+	}
+
+	return workspace, nil
+}
+
+// UpdateWorkspaceOwner hands a workspace over to another user. The roles of the previous
+// and the new owner in the workspace are left as they are.
+func (s *WorkspaceService) UpdateWorkspaceOwner(ctx context.Context, tenantID, workspaceID, userID uint64) (*model.Workspace, error) {
+	if err := s.checkWorkspaceWritable(ctx, tenantID, workspaceID); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.userer.GetUser(ctx, user_service.GetUserReq{TenantID: tenantID, ID: userID}); err != nil {
+		return nil, cerr.ErrInvalidRequest.Wrap(err, fmt.Sprintf("Unable to get user %v", userID))
+	}
+
+	workspace, err := s.store.UpdateWorkspaceOwner(ctx, tenantID, workspaceID, userID)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to update owner of workspace %v", workspaceID))
+	}
+
+	return workspace, nil
+}
+
+// FreezeWorkspace puts a workspace in the frozen status, in which its content can only be read.
+func (s *WorkspaceService) FreezeWorkspace(ctx context.Context, tenantID, workspaceID uint64) (*model.Workspace, error) {
+	err := s.store.FreezeWorkspace(ctx, tenantID, workspaceID)
+	if err != nil {
+		if errors.Is(err, cerr.ErrNoRowsUpdated) {
+			return nil, cerr.ErrInvalidRequest.Wrap(err, fmt.Sprintf("Workspace %v does not exist or is already frozen", workspaceID))
+		}
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to freeze workspace %v", workspaceID))
+	}
+
+	return s.GetWorkspace(ctx, tenantID, workspaceID)
+}
+
+// UnfreezeWorkspace makes a frozen workspace active again. If its end date passed in the
+// meantime, it is archived on the next run of the expiry job.
+func (s *WorkspaceService) UnfreezeWorkspace(ctx context.Context, tenantID, workspaceID uint64) (*model.Workspace, error) {
+	err := s.store.UnfreezeWorkspace(ctx, tenantID, workspaceID)
+	if err != nil {
+		if errors.Is(err, cerr.ErrNoRowsUpdated) {
+			return nil, cerr.ErrInvalidRequest.Wrap(err, fmt.Sprintf("Workspace %v does not exist or is not frozen", workspaceID))
+		}
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to unfreeze workspace %v", workspaceID))
+	}
+
+	return s.GetWorkspace(ctx, tenantID, workspaceID)
+}
+
+// checkWorkspaceWritable returns an error if the content of the workspace can no longer be modified.
+func (s *WorkspaceService) checkWorkspaceWritable(ctx context.Context, tenantID, workspaceID uint64) error {
+	workspace, err := s.store.GetWorkspace(ctx, tenantID, workspaceID)
+	if err != nil {
+		return cerr.WrapStoreError(err, fmt.Sprintf("Unable to get workspace %v", workspaceID))
+	}
+	if workspace.Status.IsReadOnly() {
+		return readOnlyError(workspace)
+	}
+	return nil
+}
+
+func readOnlyError(workspace *model.Workspace) *cerr.ChorusError {
+	if workspace.Status == model.WorkspaceStatusFrozen {
+		return cerr.ErrReadOnly.WithMessage(fmt.Sprintf("Workspace %v is frozen, no changes are allowed until it is unfrozen", workspace.ID))
+	}
+	return cerr.ErrReadOnly.WithMessage(fmt.Sprintf("Workspace %v is %v, extend its end date to reactivate it", workspace.ID, workspace.Status))
+}
+
+// checkWorkbenchIdleTimeout refuses workbench idle timeouts above the platform maximum.
+func (s *WorkspaceService) checkWorkbenchIdleTimeout(workspace *model.Workspace) error {
+	maxTimeout := s.cfg.Services.WorkbenchService.MaxWorkbenchIdleTimeout
+	if workspace.WorkbenchIdleTimeoutMinutes == nil || maxTimeout <= 0 {
+		return nil
+	}
+	if time.Duration(*workspace.WorkbenchIdleTimeoutMinutes)*time.Minute > maxTimeout {
+		return cerr.ErrValidation.WithMessage(fmt.Sprintf("The workbench idle timeout cannot exceed %v minutes", int64(maxTimeout.Minutes())))
+	}
+	return nil
+}
+
 func (s *WorkspaceService) CreateWorkspace(ctx context.Context, workspace *model.Workspace) (*model.Workspace, error) {
+	if workspace.ExpiresAt != nil && !workspace.ExpiresAt.After(time.Now()) {
+		return nil, cerr.ErrInvalidRequest.WithMessage("The workspace end date must be in the future")
+	}
+	if err := s.checkWorkbenchIdleTimeout(workspace); err != nil {
+		return nil, err
+	}
 	if workspace.NetworkPolicy == "" {
 		workspace.NetworkPolicy = "Airgapped"
 	}
@@ -327,26 +551,26 @@ func (s *WorkspaceService) CreateWorkspace(ctx context.Context, workspace *model
 		workspace.Clipboard = "disabled"
 	}
 	if workspace.AllowedFQDNs == nil {
-		workspace.AllowedFQDNs = model.StringSlice{}
+		workspace.AllowedFQDNs = model.FQDNRules{}
 	}
 	if workspace.Visibility == "" {
 		workspace.Visibility = model.WorkspaceVisibilityPrivate
 	}
-	newWorkspace, err := s.store.CreateWorkspace(ctx, workspace.TenantID, workspace)
+
+	settings, err := s.platformSettings.GetPlatformSettings(ctx, workspace.TenantID)
 	if err != nil {
-		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to create workspace %v", workspace.ID))
+		return nil, cerr.ErrInternal.Wrap(err, "Unable to get platform settings")
 	}
 
-	var rolesToAssign []user_model.UserRole
-	if s.cfg.Services.WorkspaceService.CreatorIsAdmin {
-		r := authz.RoleWorkspaceAdmin.For(authz.WorkspaceID(newWorkspace.ID))
-		rolesToAssign = append(rolesToAssign, user_model.UserRole{Role: r})
-	}
-	if s.cfg.Services.WorkspaceService.CreatorIsDataManager {
-		r := authz.RoleWorkspaceDataManager.For(authz.WorkspaceID(newWorkspace.ID))
-		rolesToAssign = append(rolesToAssign, user_model.UserRole{Role: r})
+	newWorkspace, err := s.store.CreateWorkspace(ctx, workspace.TenantID, workspace, settings.MaxWorkspacesPerUser)
+	if err != nil {
+		if errors.Is(err, cerr.ErrQuotaReached) {
+			return nil, cerr.ErrQuotaExceeded.Wrap(err, fmt.Sprintf("Maximum number of workspaces per user reached (maxWorkspacesPerUser: %v)", settings.MaxWorkspacesPerUser))
+		}
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to create workspace %v", workspace.ID))
 	}
 
+	rolesToAssign := s.creatorRoles(newWorkspace.ID)
 	if len(rolesToAssign) > 0 {
 		err = s.userer.CreateUserRoles(ctx, workspace.TenantID, workspace.UserID, rolesToAssign)
 		if err != nil {
@@ -363,6 +587,32 @@ func (s *WorkspaceService) CreateWorkspace(ctx context.Context, workspace *model
 	return newWorkspace, nil
 }
 
+// creatorRoles returns the workspace roles granted to the creator of a workspace.
+func (s *WorkspaceService) creatorRoles(workspaceID uint64) []user_model.UserRole {
+	var roles []user_model.UserRole
+	for _, name := range creatorRoleNames(s.cfg) {
+		roles = append(roles, user_model.UserRole{Role: authz.Role{
+			Name:    name,
+			Context: authz.Context{authz.ContextWorkspace: fmt.Sprintf("%d", workspaceID)},
+		}})
+	}
+	return roles
+}
+
+// creatorRoleNames returns the names of the workspace roles granted to the creator of a
+// workspace, sorted.
+func creatorRoleNames(cfg config.Config) []authz.RoleName {
+	var names []authz.RoleName
+	if cfg.Services.WorkspaceService.CreatorIsAdmin {
+		names = append(names, authz.RoleWorkspaceAdmin.Name)
+	}
+	if cfg.Services.WorkspaceService.CreatorIsDataManager {
+		names = append(names, authz.RoleWorkspaceDataManager.Name)
+	}
+	slices.Sort(names)
+	return names
+}
+
 func (s *WorkspaceService) AddUserRoleInWorkspace(ctx context.Context, tenantID, userID uint64, role user_model.UserRole) error {
 	// Verify that the user exists and get its roles
 	user, err := s.userer.GetUser(ctx, user_service.GetUserReq{TenantID: tenantID, ID: userID})
@@ -408,6 +658,31 @@ func (s *WorkspaceService) AddUserRoleInWorkspace(ctx context.Context, tenantID,
 	return nil
 }
 
+// GrantUserRoleInWorkspace grants a workspace role to the user on behalf of an invitation
+// or an approved access request. Holding the role already is not an error; granted reports
+// whether the role was assigned by this call.
+func (s *WorkspaceService) GrantUserRoleInWorkspace(ctx context.Context, tenantID, userID, workspaceID uint64, roleName authz.RoleName) (bool, error) {
+	if !isWorkspaceRole(roleName) {
+		return false, cerr.ErrInvalidRequest.WithMessage(fmt.Sprintf("Role %v is not a workspace role", roleName))
+	}
+
+	if err := s.checkWorkspaceWritable(ctx, tenantID, workspaceID); err != nil {
+		return false, err
+	}
+
+	role := user_model.UserRole{Role: authz.Role{
+		Name:    roleName,
+		Context: authz.Context{authz.ContextWorkspace: fmt.Sprintf("%d", workspaceID)},
+	}}
+	err := s.AddUserRoleInWorkspace(ctx, tenantID, userID, role)
+	var cErr *cerr.ChorusError
+	if err != nil && !(errors.As(err, &cErr) && cErr.ChorusCode == cerr.ErrAlreadyExists.ChorusCode) {
+		return false, err
+	}
+
+	return err == nil, nil
+}
+
 func (s *WorkspaceService) RemoveUserRoleInWorkspace(ctx context.Context, tenantID, userID, workspaceID uint64, roleName authz.RoleName) error {
 	// Verify that the user exists and get its roles
 	user, err := s.userer.GetUser(ctx, user_service.GetUserReq{TenantID: tenantID, ID: userID})
@@ -578,6 +853,14 @@ func (s *WorkspaceService) ListWorkspaceServiceInstances(ctx context.Context, te
 }
 
 func (s *WorkspaceService) CreateWorkspaceServiceInstance(ctx context.Context, svc *model.WorkspaceServiceInstance) (*model.WorkspaceServiceInstance, error) {
+	if err := s.checkWorkspaceWritable(ctx, svc.TenantID, svc.WorkspaceID); err != nil {
+		return nil, err
+	}
+
+	if err := s.applyServiceDefinition(ctx, svc, false); err != nil {
+		return nil, err
+	}
+
 	created, err := s.store.CreateWorkspaceServiceInstance(ctx, svc.TenantID, svc)
 	if err != nil {
 		return nil, cerr.WrapStoreError(err, "Unable to create workspace service instance")
@@ -591,6 +874,14 @@ func (s *WorkspaceService) CreateWorkspaceServiceInstance(ctx context.Context, s
 }
 
 func (s *WorkspaceService) UpdateWorkspaceServiceInstance(ctx context.Context, svc *model.WorkspaceServiceInstance) (*model.WorkspaceServiceInstance, error) {
+	if err := s.checkWorkspaceWritable(ctx, svc.TenantID, svc.WorkspaceID); err != nil {
+		return nil, err
+	}
+
+	if err := s.applyServiceDefinition(ctx, svc, true); err != nil {
+		return nil, err
+	}
+
 	updated, err := s.store.UpdateWorkspaceServiceInstance(ctx, svc.TenantID, svc)
 	if err != nil {
 		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to update workspace service instance %v", svc.ID))
@@ -609,6 +900,10 @@ func (s *WorkspaceService) DeleteWorkspaceServiceInstance(ctx context.Context, t
 		return cerr.WrapStoreError(err, fmt.Sprintf("Unable to get workspace service instance %v", workspaceServiceInstanceID))
 	}
 
+	if err := s.checkWorkspaceWritable(ctx, tenantID, svc.WorkspaceID); err != nil {
+		return err
+	}
+
 	// Set the desired state to Deleted
 	svc.State = model.ServiceInstanceStateDeleted
 
@@ -687,7 +982,7 @@ func (s *WorkspaceService) SetClientWatchers() {
 			}
 		}
 
-		err = s.store.UpdateWorkspaceStatus(ctx, wsOutput.TenantID, workspaceID, wsOutput.NetworkPolicyStatus, wsOutput.NetworkPolicyMessage)
+		err = s.store.UpdateWorkspaceStatus(ctx, wsOutput.TenantID, workspaceID, wsOutput.NetworkPolicyStatus, wsOutput.NetworkPolicyMessage, model.JSONMap[string](wsOutput.RejectedFQDNs))
 		if err != nil {
 			logger.TechLog.Error(ctx, "unable to update workspace status from watcher",
 				zap.Uint64("workspaceID", workspaceID), zap.Error(err))
@@ -794,8 +1089,20 @@ func workspaceToK8sInput(ws *model.Workspace, svcs []*model.WorkspaceServiceInst
 		TenantID:      ws.TenantID,
 		Namespace:     model.GetWorkspaceClusterName(ws.ID),
 		NetworkPolicy: string(ws.NetworkPolicy),
-		AllowedFQDNs:  []string(ws.AllowedFQDNs),
+		AllowedFQDNs:  fqdnRulesToK8s(ws.AllowedFQDNs),
 		Clipboard:     string(ws.Clipboard),
 		Services:      services,
 	}
 }
+
+func fqdnRulesToK8s(rules model.FQDNRules) []k8s.WorkspaceFQDNRule {
+	k8sRules := make([]k8s.WorkspaceFQDNRule, 0, len(rules))
+	for _, rule := range rules {
+		k8sRules = append(k8sRules, k8s.WorkspaceFQDNRule{
+			Pattern:  rule.Pattern,
+			Ports:    rule.Ports,
+			Protocol: rule.Protocol.String(),
+		})
+	}
+	return k8sRules
+}
@@ -15,12 +15,16 @@ import (
 
 	k8s "github.com/CHORUS-TRE/chorus-backend/internal/client/k8s"
 	"github.com/CHORUS-TRE/chorus-backend/internal/config"
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	jwt_model "github.com/CHORUS-TRE/chorus-backend/internal/jwt/model"
 	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	approval_model "github.com/CHORUS-TRE/chorus-backend/pkg/approval-request/model"
 	audit_model "github.com/CHORUS-TRE/chorus-backend/pkg/audit/model"
 	audit_service "github.com/CHORUS-TRE/chorus-backend/pkg/audit/service"
 	authorization_model "github.com/CHORUS-TRE/chorus-backend/pkg/authorization/model"
 	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
 	notification_model "github.com/CHORUS-TRE/chorus-backend/pkg/notification/model"
+	platform_settings_model "github.com/CHORUS-TRE/chorus-backend/pkg/platform-settings/model"
 	user_model "github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
 	user_service "github.com/CHORUS-TRE/chorus-backend/pkg/user/service"
 	workbench_model "github.com/CHORUS-TRE/chorus-backend/pkg/workbench/model"
@@ -39,7 +43,7 @@ func TestMain(m *testing.M) {
 // ---------------------------------------------------------------------------
 
 type mockWorkspaceStore struct {
-	createWorkspace                          func(ctx context.Context, tenantID uint64, workspace *model.Workspace) (*model.Workspace, error)
+	createWorkspace                          func(ctx context.Context, tenantID uint64, workspace *model.Workspace, maxPerUser uint32) (*model.Workspace, error)
 	listPublicWorkspaces                     func(ctx context.Context, tenantID uint64, pagination *common_model.Pagination) ([]*model.Workspace, *common_model.PaginationResult, error)
 	getWorkspace                             func(ctx context.Context, tenantID, id uint64) (*model.Workspace, error)
 	updateWorkspaceStatus                    func(ctx context.Context, tenantID, workspaceID uint64, status, message string) error
@@ -48,10 +52,27 @@ type mockWorkspaceStore struct {
 	updateWorkspaceServiceInstance           func(ctx context.Context, tenantID uint64, svc *model.WorkspaceServiceInstance) (*model.WorkspaceServiceInstance, error)
 	deleteWorkspaceServiceInstance           func(ctx context.Context, tenantID, id uint64) error
 	updateWorkspaceServiceInstanceStatuses   func(ctx context.Context, workspaceID uint64, statuses map[uint64]model.WorkspaceServiceInstanceStatusUpdate) error
-}
-
-func (m *mockWorkspaceStore) CreateWorkspace(ctx context.Context, tenantID uint64, workspace *model.Workspace) (*model.Workspace, error) {
-	return m.createWorkspace(ctx, tenantID, workspace)
+	createWorkspaceWithServiceInstances      func(ctx context.Context, tenantID uint64, workspace *model.Workspace, svcs []*model.WorkspaceServiceInstance, maxPerUser uint32) (*model.Workspace, []*model.WorkspaceServiceInstance, error)
+	getWorkspaceTemplate                     func(ctx context.Context, tenantID, templateID uint64) (*model.WorkspaceTemplate, error)
+	getServiceDefinition                     func(ctx context.Context, tenantID, serviceDefinitionID uint64) (*model.ServiceDefinition, error)
+	listExpiringWorkspaces                   func(ctx context.Context, before time.Time) ([]*model.Workspace, error)
+	deletedWorkspaceIDs                      []uint64
+	archivedWorkspaceIDs                     []uint64
+	archiveErr                               error
+	extendedWorkspaces                       map[uint64]time.Time
+	expiryNotices                            map[uint64]int
+	freezeErr                                error
+	getWorkspaceInvitation                   func(ctx context.Context, tenantID, id uint64) (*model.WorkspaceInvitation, error)
+	createdInvitations                       []*model.WorkspaceInvitation
+	respondedInvitations                     map[uint64]model.WorkspaceInvitationStatus
+	respondInvitationErr                     error
+	updatedWorkspaces                        []*model.Workspace
+	networkPolicyUpdates                     map[uint64]model.NetworkPolicyMode
+	ownerUpdates                             map[uint64]uint64
+}
+
+func (m *mockWorkspaceStore) CreateWorkspace(ctx context.Context, tenantID uint64, workspace *model.Workspace, maxPerUser uint32) (*model.Workspace, error) {
+	return m.createWorkspace(ctx, tenantID, workspace, maxPerUser)
 }
 
 func (m *mockWorkspaceStore) ListPublicWorkspaces(ctx context.Context, tenantID uint64, pagination *common_model.Pagination) ([]*model.Workspace, *common_model.PaginationResult, error) {
@@ -62,7 +83,7 @@ func (m *mockWorkspaceStore) GetWorkspace(ctx context.Context, tenantID uint64,
 	if m.getWorkspace != nil {
 		return m.getWorkspace(ctx, tenantID, id)
 	}
-	return nil, nil
+	return &model.Workspace{ID: id, TenantID: tenantID, Status: model.WorkspaceStatusActive}, nil
 }
 
 func (m *mockWorkspaceStore) ListWorkspaces(_ context.Context, _ uint64, _ *common_model.Pagination, _ *[]uint64, _ bool) ([]*model.Workspace, *common_model.PaginationResult, error) {
@@ -73,15 +94,34 @@ func (m *mockWorkspaceStore) DeleteOldWorkspaces(_ context.Context, _ time.Durat
 	return nil, nil
 }
 
-func (m *mockWorkspaceStore) UpdateWorkspace(_ context.Context, _ uint64, _ *model.Workspace) (*model.Workspace, error) {
-	return nil, nil
+func (m *mockWorkspaceStore) UpdateWorkspace(_ context.Context, _ uint64, workspace *model.Workspace) (*model.Workspace, error) {
+	updated := *workspace
+	m.updatedWorkspaces = append(m.updatedWorkspaces, &updated)
+	return &updated, nil
 }
 
-func (m *mockWorkspaceStore) DeleteWorkspace(_ context.Context, _ uint64, _ uint64) error {
+func (m *mockWorkspaceStore) UpdateWorkspaceNetworkPolicy(_ context.Context, tenantID uint64, workspaceID uint64, networkPolicy model.NetworkPolicyMode, allowedFQDNs model.FQDNRules) (*model.Workspace, error) {
+	if m.networkPolicyUpdates == nil {
+		m.networkPolicyUpdates = map[uint64]model.NetworkPolicyMode{}
+	}
+	m.networkPolicyUpdates[workspaceID] = networkPolicy
+	return &model.Workspace{ID: workspaceID, TenantID: tenantID, NetworkPolicy: networkPolicy, AllowedFQDNs: allowedFQDNs}, nil
+}
+
+func (m *mockWorkspaceStore) UpdateWorkspaceOwner(_ context.Context, tenantID uint64, workspaceID uint64, userID uint64) (*model.Workspace, error) {
+	if m.ownerUpdates == nil {
+		m.ownerUpdates = map[uint64]uint64{}
+	}
+	m.ownerUpdates[workspaceID] = userID
+	return &model.Workspace{ID: workspaceID, TenantID: tenantID, UserID: userID}, nil
+}
+
+func (m *mockWorkspaceStore) DeleteWorkspace(_ context.Context, _ uint64, workspaceID uint64) error {
+	m.deletedWorkspaceIDs = append(m.deletedWorkspaceIDs, workspaceID)
 	return nil
 }
 
-func (m *mockWorkspaceStore) UpdateWorkspaceStatus(ctx context.Context, tenantID uint64, workspaceID uint64, status, message string) error {
+func (m *mockWorkspaceStore) UpdateWorkspaceStatus(ctx context.Context, tenantID uint64, workspaceID uint64, status, message string, rejectedFQDNs model.JSONMap[string]) error {
 	if m.updateWorkspaceStatus != nil {
 		return m.updateWorkspaceStatus(ctx, tenantID, workspaceID, status, message)
 	}
@@ -131,12 +171,134 @@ func (m *mockWorkspaceStore) UpdateWorkspaceServiceInstanceStatuses(ctx context.
 	return nil
 }
 
+func (m *mockWorkspaceStore) CreateWorkspaceWithServiceInstances(ctx context.Context, tenantID uint64, workspace *model.Workspace, svcs []*model.WorkspaceServiceInstance, maxPerUser uint32) (*model.Workspace, []*model.WorkspaceServiceInstance, error) {
+	return m.createWorkspaceWithServiceInstances(ctx, tenantID, workspace, svcs, maxPerUser)
+}
+
+func (m *mockWorkspaceStore) GetWorkspaceTemplate(ctx context.Context, tenantID, templateID uint64) (*model.WorkspaceTemplate, error) {
+	return m.getWorkspaceTemplate(ctx, tenantID, templateID)
+}
+
+func (m *mockWorkspaceStore) ListWorkspaceTemplates(_ context.Context, _ uint64, _ *common_model.Pagination) ([]*model.WorkspaceTemplate, *common_model.PaginationResult, error) {
+	return nil, nil, nil
+}
+
+func (m *mockWorkspaceStore) CreateWorkspaceTemplate(_ context.Context, _ uint64, template *model.WorkspaceTemplate) (*model.WorkspaceTemplate, error) {
+	return template, nil
+}
+
+func (m *mockWorkspaceStore) UpdateWorkspaceTemplate(_ context.Context, _ uint64, template *model.WorkspaceTemplate) (*model.WorkspaceTemplate, error) {
+	return template, nil
+}
+
+func (m *mockWorkspaceStore) DeleteWorkspaceTemplate(_ context.Context, _, _ uint64) error {
+	return nil
+}
+
+func (m *mockWorkspaceStore) GetServiceDefinition(ctx context.Context, tenantID, serviceDefinitionID uint64) (*model.ServiceDefinition, error) {
+	return m.getServiceDefinition(ctx, tenantID, serviceDefinitionID)
+}
+
+func (m *mockWorkspaceStore) ListServiceDefinitions(_ context.Context, _ uint64, _ *common_model.Pagination) ([]*model.ServiceDefinition, *common_model.PaginationResult, error) {
+	return nil, nil, nil
+}
+
+func (m *mockWorkspaceStore) CreateServiceDefinition(_ context.Context, _ uint64, def *model.ServiceDefinition) (*model.ServiceDefinition, error) {
+	return def, nil
+}
+
+func (m *mockWorkspaceStore) UpdateServiceDefinition(_ context.Context, _ uint64, def *model.ServiceDefinition) (*model.ServiceDefinition, error) {
+	return def, nil
+}
+
+func (m *mockWorkspaceStore) DeleteServiceDefinition(_ context.Context, _, _ uint64) error {
+	return nil
+}
+
+func (m *mockWorkspaceStore) ExtendWorkspace(_ context.Context, _ uint64, workspaceID uint64, expiresAt time.Time) (*model.Workspace, error) {
+	if m.extendedWorkspaces == nil {
+		m.extendedWorkspaces = map[uint64]time.Time{}
+	}
+	m.extendedWorkspaces[workspaceID] = expiresAt
+	return &model.Workspace{ID: workspaceID, Status: model.WorkspaceStatusActive, ExpiresAt: &expiresAt}, nil
+}
+
+func (m *mockWorkspaceStore) ListExpiringWorkspaces(ctx context.Context, before time.Time) ([]*model.Workspace, error) {
+	if m.listExpiringWorkspaces != nil {
+		return m.listExpiringWorkspaces(ctx, before)
+	}
+	return nil, nil
+}
+
+func (m *mockWorkspaceStore) UpdateWorkspaceExpiryNotice(_ context.Context, _ uint64, workspaceID uint64, noticeDays int) error {
+	if m.expiryNotices == nil {
+		m.expiryNotices = map[uint64]int{}
+	}
+	m.expiryNotices[workspaceID] = noticeDays
+	return nil
+}
+
+func (m *mockWorkspaceStore) ArchiveWorkspace(_ context.Context, _ uint64, workspaceID uint64) error {
+	if m.archiveErr != nil {
+		return m.archiveErr
+	}
+	m.archivedWorkspaceIDs = append(m.archivedWorkspaceIDs, workspaceID)
+	return nil
+}
+
+func (m *mockWorkspaceStore) FreezeWorkspace(_ context.Context, _ uint64, _ uint64) error {
+	return m.freezeErr
+}
+
+func (m *mockWorkspaceStore) UnfreezeWorkspace(_ context.Context, _ uint64, _ uint64) error {
+	return nil
+}
+
+func (m *mockWorkspaceStore) GetWorkspaceInvitation(ctx context.Context, tenantID, id uint64) (*model.WorkspaceInvitation, error) {
+	if m.getWorkspaceInvitation != nil {
+		return m.getWorkspaceInvitation(ctx, tenantID, id)
+	}
+	return nil, errors.New("not found")
+}
+
+func (m *mockWorkspaceStore) ListWorkspaceInvitations(_ context.Context, _, _ uint64, _ *common_model.Pagination, _ model.WorkspaceInvitationFilter) ([]*model.WorkspaceInvitation, *common_model.PaginationResult, error) {
+	return nil, nil, nil
+}
+
+func (m *mockWorkspaceStore) ListInviteeWorkspaceInvitations(_ context.Context, _, _ uint64, _ *common_model.Pagination) ([]*model.WorkspaceInvitation, *common_model.PaginationResult, error) {
+	return nil, nil, nil
+}
+
+func (m *mockWorkspaceStore) CreateWorkspaceInvitation(_ context.Context, _ uint64, invitation *model.WorkspaceInvitation) (*model.WorkspaceInvitation, error) {
+	m.createdInvitations = append(m.createdInvitations, invitation)
+	created := *invitation
+	created.ID = uint64(len(m.createdInvitations))
+	created.Status = model.WorkspaceInvitationStatusPending
+	return &created, nil
+}
+
+func (m *mockWorkspaceStore) RespondWorkspaceInvitation(_ context.Context, _, invitationID uint64, status model.WorkspaceInvitationStatus, _ *uint64) error {
+	if m.respondInvitationErr != nil {
+		return m.respondInvitationErr
+	}
+	if m.respondedInvitations == nil {
+		m.respondedInvitations = map[uint64]model.WorkspaceInvitationStatus{}
+	}
+	m.respondedInvitations[invitationID] = status
+	return nil
+}
+
+func (m *mockWorkspaceStore) ClaimWorkspaceInvitations(_ context.Context, _, _ uint64, _ string) (int64, error) {
+	return 0, nil
+}
+
 type mockUserer struct {
 	createUserRolesErr error
 	capturedRoles      []user_model.UserRole
 	removedRoleIDs     []uint64
 	getUser            func(ctx context.Context, req user_service.GetUserReq) (*user_model.User, error)
 	getUsers           func(ctx context.Context, tenantID uint64, userIDs []uint64) ([]*user_model.User, error)
+	listUsers          func(ctx context.Context, req user_service.ListUsersReq) ([]*user_model.User, *common_model.PaginationResult, error)
 }
 
 func (m *mockUserer) CreateUserRoles(_ context.Context, _, _ uint64, roles []user_model.UserRole) error {
@@ -167,15 +329,26 @@ func (m *mockUserer) GetUsers(ctx context.Context, tenantID uint64, userIDs []ui
 	return nil, nil
 }
 
+func (m *mockUserer) ListUsers(ctx context.Context, req user_service.ListUsersReq) ([]*user_model.User, *common_model.PaginationResult, error) {
+	if m.listUsers != nil {
+		return m.listUsers(ctx, req)
+	}
+	return nil, nil, nil
+}
+
 type mockK8s struct {
 	createWorkspaceErr error
+	createdWorkspaces  []k8s.WorkspaceInput
 	getSecretData      map[string][]byte
 	getSecretErr       error
 	updatedWorkspaces  []k8s.WorkspaceInput
 	onUpdateWorkspace  func(k8s.WorkspaceOutput) error
 }
 
-func (m *mockK8s) CreateWorkspace(_ k8s.WorkspaceInput) error { return m.createWorkspaceErr }
+func (m *mockK8s) CreateWorkspace(input k8s.WorkspaceInput) error {
+	m.createdWorkspaces = append(m.createdWorkspaces, input)
+	return m.createWorkspaceErr
+}
 func (m *mockK8s) UpdateWorkspace(input k8s.WorkspaceInput) error {
 	m.updatedWorkspaces = append(m.updatedWorkspaces, input)
 	return nil
@@ -192,6 +365,20 @@ func (m *mockK8s) PrePullImageOnAllNodes(_ string)
 func (m *mockK8s) GetSecret(_, _ string) (map[string][]byte, error) {
 	return m.getSecretData, m.getSecretErr
 }
+func (m *mockK8s) GetWorkbenchServerLogs(_, _ string, _ k8s.PodLogOptions) (*k8s.PodLogs, error) {
+	return nil, nil
+}
+func (m *mockK8s) GetAppInstanceLogs(_, _ string, _ k8s.AppInstance, _ k8s.PodLogOptions) (*k8s.PodLogs, error) {
+	return nil, nil
+}
+func (m *mockK8s) ListWorkbenchServerEvents(_, _ string) ([]k8s.PodEvent, error) { return nil, nil }
+func (m *mockK8s) ListAppInstanceEvents(_, _ string, _ k8s.AppInstance) ([]k8s.PodEvent, error) {
+	return nil, nil
+}
+func (m *mockK8s) GetWorkbenchServerUsage(_, _ string) (*k8s.PodUsage, error) { return nil, nil }
+func (m *mockK8s) GetAppInstanceUsage(_, _ string, _ k8s.AppInstance) (*k8s.PodUsage, error) {
+	return nil, nil
+}
 func (m *mockK8s) RegisterOnNewWorkbenchHandler(_ func(k8s.Workbench) error) error    { return nil }
 func (m *mockK8s) RegisterOnUpdateWorkbenchHandler(_ func(k8s.Workbench) error) error { return nil }
 func (m *mockK8s) RegisterOnDeleteWorkbenchHandler(_ func(k8s.Workbench) error) error { return nil }
@@ -218,6 +405,37 @@ func (m *mockNotificationStore) CreateNotification(_ context.Context, _ *notific
 	return nil
 }
 
+type mockPlatformSettings struct {
+	settings platform_settings_model.PlatformSettings
+}
+
+func (m *mockPlatformSettings) GetPlatformSettings(_ context.Context, tenantID uint64) (*platform_settings_model.PlatformSettings, error) {
+	settings := m.settings
+	settings.TenantID = tenantID
+	return &settings, nil
+}
+
+type mockApprovalRequestStore struct {
+	created []*approval_model.ApprovalRequest
+}
+
+func (m *mockApprovalRequestStore) CreateApprovalRequest(_ context.Context, _ uint64, request *approval_model.ApprovalRequest) (*approval_model.ApprovalRequest, error) {
+	created := *request
+	created.ID = uint64(len(m.created) + 1)
+	m.created = append(m.created, &created)
+	return &created, nil
+}
+
+type mockUserPermissionFinder struct {
+	userIDs []uint64
+	filters []authorization_model.FindUsersWithPermissionFilter
+}
+
+func (m *mockUserPermissionFinder) FindUsersWithPermission(_ context.Context, _ uint64, filter authorization_model.FindUsersWithPermissionFilter) ([]uint64, error) {
+	m.filters = append(m.filters, filter)
+	return m.userIDs, nil
+}
+
 type mockAuditWriter struct{}
 
 func (m *mockAuditWriter) Record(_ context.Context, _ *audit_model.AuditEntry) (*audit_model.AuditEntry, error) {
@@ -236,13 +454,18 @@ func newSvc(cfg config.Config, store WorkspaceStore, k8sClient k8s.K8sClienter,
 		workbencher:       &mockWorkbencher{},
 		userer:            userer,
 		notificationStore: &mockNotificationStore{},
+		mailer:            &mockMailer{},
+		platformSettings:  &mockPlatformSettings{},
 		auditWriter:       audit_service.AuditWriter(&mockAuditWriter{}),
+
+		approvalRequestStore: &mockApprovalRequestStore{},
+		userPermissionFinder: &mockUserPermissionFinder{},
 	}
 }
 
 func storeReturning(ws *model.Workspace) *mockWorkspaceStore {
 	return &mockWorkspaceStore{
-		createWorkspace: func(_ context.Context, _ uint64, _ *model.Workspace) (*model.Workspace, error) {
+		createWorkspace: func(_ context.Context, _ uint64, _ *model.Workspace, _ uint32) (*model.Workspace, error) {
 			return ws, nil
 		},
 	}
@@ -344,7 +567,7 @@ func TestCreateWorkspace_DoesNotAssignRolesWhenNotConfigured(t *testing.T) {
 func TestCreateWorkspace_PropagatesStoreError(t *testing.T) {
 	cfg := config.Config{}
 	store := &mockWorkspaceStore{
-		createWorkspace: func(_ context.Context, _ uint64, _ *model.Workspace) (*model.Workspace, error) {
+		createWorkspace: func(_ context.Context, _ uint64, _ *model.Workspace, _ uint32) (*model.Workspace, error) {
 			return nil, errors.New("db down")
 		},
 	}
@@ -356,6 +579,43 @@ func TestCreateWorkspace_PropagatesStoreError(t *testing.T) {
 	assert.Contains(t, err.Error(), "db down")
 }
 
+func TestCreateWorkspace_PassesMaxWorkspacesPerUserToStore(t *testing.T) {
+	cfg := config.Config{}
+	var capturedMax uint32
+	store := &mockWorkspaceStore{
+		createWorkspace: func(_ context.Context, _ uint64, ws *model.Workspace, maxPerUser uint32) (*model.Workspace, error) {
+			capturedMax = maxPerUser
+			return ws, nil
+		},
+	}
+
+	svc := newSvc(cfg, store, &mockK8s{}, &mockUserer{})
+	svc.platformSettings = &mockPlatformSettings{settings: platform_settings_model.PlatformSettings{MaxWorkspacesPerUser: 3}}
+	_, err := svc.CreateWorkspace(context.Background(), &model.Workspace{TenantID: 1, UserID: 42})
+
+	require.NoError(t, err)
+	assert.Equal(t, uint32(3), capturedMax)
+}
+
+func TestCreateWorkspace_QuotaReachedReturnsQuotaExceeded(t *testing.T) {
+	cfg := config.Config{}
+	store := &mockWorkspaceStore{
+		createWorkspace: func(_ context.Context, _ uint64, _ *model.Workspace, _ uint32) (*model.Workspace, error) {
+			return nil, fmt.Errorf("user 42 owns 3 of 3 allowed: %w", cerr.ErrQuotaReached)
+		},
+	}
+
+	svc := newSvc(cfg, store, &mockK8s{}, &mockUserer{})
+	svc.platformSettings = &mockPlatformSettings{settings: platform_settings_model.PlatformSettings{MaxWorkspacesPerUser: 3}}
+	_, err := svc.CreateWorkspace(context.Background(), &model.Workspace{TenantID: 1, UserID: 42})
+
+	require.Error(t, err)
+	var cErr *cerr.ChorusError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, cerr.ErrQuotaExceeded.ChorusCode, cErr.ChorusCode)
+	assert.Contains(t, cErr.Message, "maxWorkspacesPerUser: 3")
+}
+
 func TestCreateWorkspace_PropagatesCreateUserRolesError(t *testing.T) {
 	cfg := config.Config{}
 	cfg.Services.WorkspaceService.CreatorIsAdmin = true
@@ -383,6 +643,363 @@ func TestCreateWorkspace_PropagatesK8sError(t *testing.T) {
 	assert.Contains(t, err.Error(), "k8s unreachable")
 }
 
+func TestCreateWorkspace_RejectsWorkbenchIdleTimeoutAboveMaximum(t *testing.T) {
+	cfg := config.Config{}
+	cfg.Services.WorkbenchService.MaxWorkbenchIdleTimeout = 72 * time.Hour
+
+	created := &model.Workspace{ID: 16, TenantID: 1, UserID: 42}
+	svc := newSvc(cfg, storeReturning(created), &mockK8s{}, &mockUserer{})
+
+	tooLong := 73 * 60
+	_, err := svc.CreateWorkspace(context.Background(), &model.Workspace{TenantID: 1, UserID: 42, WorkbenchIdleTimeoutMinutes: &tooLong})
+
+	var cErr *cerr.ChorusError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, cerr.ErrValidation.ChorusCode, cErr.ChorusCode)
+
+	allowed := 72 * 60
+	_, err = svc.CreateWorkspace(context.Background(), &model.Workspace{TenantID: 1, UserID: 42, WorkbenchIdleTimeoutMinutes: &allowed})
+	require.NoError(t, err)
+}
+
+// ---------------------------------------------------------------------------
+// Workspace templates
+// ---------------------------------------------------------------------------
+
+func templateStore(template *model.WorkspaceTemplate) *mockWorkspaceStore {
+	return &mockWorkspaceStore{
+		getWorkspaceTemplate: func(_ context.Context, _, _ uint64) (*model.WorkspaceTemplate, error) {
+			return template, nil
+		},
+		createWorkspaceWithServiceInstances: func(_ context.Context, _ uint64, ws *model.Workspace, svcs []*model.WorkspaceServiceInstance, _ uint32) (*model.Workspace, []*model.WorkspaceServiceInstance, error) {
+			created := *ws
+			created.ID = 7
+			for i, svc := range svcs {
+				svc.ID = uint64(100 + i)
+				svc.WorkspaceID = created.ID
+			}
+			return &created, svcs, nil
+		},
+	}
+}
+
+func TestCreateWorkspaceTemplate_RejectsNonWorkspaceRole(t *testing.T) {
+	svc := newSvc(config.Config{}, &mockWorkspaceStore{}, &mockK8s{}, &mockUserer{})
+
+	_, err := svc.CreateWorkspaceTemplate(context.Background(), &model.WorkspaceTemplate{
+		Name:    "tpl",
+		Members: []model.WorkspaceTemplateMember{{UserID: 2, Role: authorization_model.RolePlatformUserManager.Name}},
+	})
+	require.Error(t, err)
+	var cErr *cerr.ChorusError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, cerr.ErrInvalidRequest.ChorusCode, cErr.ChorusCode)
+}
+
+func TestCreateWorkspaceTemplate_RejectsDuplicateServiceNames(t *testing.T) {
+	svc := newSvc(config.Config{}, &mockWorkspaceStore{}, &mockK8s{}, &mockUserer{})
+
+	_, err := svc.CreateWorkspaceTemplate(context.Background(), &model.WorkspaceTemplate{
+		Name: "tpl",
+		ServiceInstances: []*model.WorkspaceTemplateServiceInstance{
+			{Name: "db"},
+			{Name: "db"},
+		},
+	})
+	require.Error(t, err)
+	var cErr *cerr.ChorusError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, cerr.ErrInvalidRequest.ChorusCode, cErr.ChorusCode)
+}
+
+func TestCreateWorkspaceTemplate_AppliesDefaults(t *testing.T) {
+	svc := newSvc(config.Config{}, &mockWorkspaceStore{}, &mockK8s{}, &mockUserer{})
+
+	created, err := svc.CreateWorkspaceTemplate(context.Background(), &model.WorkspaceTemplate{Name: "tpl"})
+	require.NoError(t, err)
+	assert.Equal(t, model.NetworkPolicyAirgapped, created.NetworkPolicy)
+	assert.Equal(t, model.ClipboardDisabled, created.Clipboard)
+	assert.NotNil(t, created.AllowedFQDNs)
+}
+
+func TestCreateWorkspaceFromTemplate_AppliesTemplateSettingsAndServices(t *testing.T) {
+	template := &model.WorkspaceTemplate{
+		ID:            3,
+		NetworkPolicy: model.NetworkPolicyFQDNAllowlist,
+		AllowedFQDNs:  model.FQDNRules{{Pattern: "example.org"}},
+		Clipboard:     model.ClipboardBoth,
+		AppIDs:        []uint64{9, 12},
+		ServiceInstances: []*model.WorkspaceTemplateServiceInstance{
+			{Name: "db", ChartRegistry: "registry.example.org", ChartRepository: "charts/postgres", ChartTag: "1.0.0"},
+		},
+	}
+	k8sMock := &mockK8s{}
+	svc := newSvc(config.Config{}, templateStore(template), k8sMock, &mockUserer{})
+
+	ws, svcs, err := svc.CreateWorkspaceFromTemplate(context.Background(), template.ID, &model.Workspace{TenantID: 1, UserID: 42, Name: "ws"})
+	require.NoError(t, err)
+
+	assert.Equal(t, model.NetworkPolicyFQDNAllowlist, ws.NetworkPolicy)
+	assert.Equal(t, model.FQDNRules{{Pattern: "example.org"}}, ws.AllowedFQDNs)
+	assert.Equal(t, model.ClipboardBoth, ws.Clipboard)
+	assert.Equal(t, uint64(3), ws.GetTemplateID())
+	assert.Equal(t, model.Uint64Slice{9, 12}, ws.AppIDs)
+	assert.Equal(t, model.WorkspaceVisibilityPrivate, ws.Visibility)
+
+	require.Len(t, svcs, 1)
+	assert.Equal(t, "db", svcs[0].Name)
+	assert.Equal(t, ws.ID, svcs[0].WorkspaceID)
+
+	require.Len(t, k8sMock.createdWorkspaces, 1)
+	assert.Len(t, k8sMock.createdWorkspaces[0].Services, 1)
+}
+
+func TestCreateWorkspaceFromTemplate_AssignsCreatorAndMemberRoles(t *testing.T) {
+	cfg := config.Config{}
+	cfg.Services.WorkspaceService.CreatorIsAdmin = true
+
+	template := &model.WorkspaceTemplate{
+		ID: 3,
+		Members: []model.WorkspaceTemplateMember{
+			{UserID: 43, Role: authorization_model.RoleWorkspaceMember.Name},
+			{UserID: 42, Role: authorization_model.RoleWorkspaceAdmin.Name},
+		},
+	}
+	userer := &mockUserer{}
+	svc := newSvc(cfg, templateStore(template), &mockK8s{}, userer)
+
+	_, _, err := svc.CreateWorkspaceFromTemplate(context.Background(), template.ID, &model.Workspace{TenantID: 1, UserID: 42, Name: "ws"})
+	require.NoError(t, err)
+
+	// The creator's admin role is not granted twice.
+	require.Len(t, userer.capturedRoles, 2)
+	names := []authorization_model.RoleName{userer.capturedRoles[0].Name, userer.capturedRoles[1].Name}
+	assert.ElementsMatch(t, []authorization_model.RoleName{authorization_model.RoleWorkspaceAdmin.Name, authorization_model.RoleWorkspaceMember.Name}, names)
+}
+
+func TestCreateWorkspaceFromTemplate_QuotaReachedReturnsQuotaExceeded(t *testing.T) {
+	store := templateStore(&model.WorkspaceTemplate{ID: 3})
+	store.createWorkspaceWithServiceInstances = func(_ context.Context, _ uint64, _ *model.Workspace, _ []*model.WorkspaceServiceInstance, _ uint32) (*model.Workspace, []*model.WorkspaceServiceInstance, error) {
+		return nil, nil, cerr.ErrQuotaReached
+	}
+	svc := newSvc(config.Config{}, store, &mockK8s{}, &mockUserer{})
+
+	_, _, err := svc.CreateWorkspaceFromTemplate(context.Background(), 3, &model.Workspace{TenantID: 1, UserID: 42, Name: "ws"})
+	require.Error(t, err)
+	var cErr *cerr.ChorusError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, cerr.ErrQuotaExceeded.ChorusCode, cErr.ChorusCode)
+}
+
+func TestCreateWorkspaceFromTemplate_RollsBackOnK8sError(t *testing.T) {
+	store := templateStore(&model.WorkspaceTemplate{ID: 3})
+	svc := newSvc(config.Config{}, store, &mockK8s{createWorkspaceErr: errors.New("k8s unavailable")}, &mockUserer{})
+
+	_, _, err := svc.CreateWorkspaceFromTemplate(context.Background(), 3, &model.Workspace{TenantID: 1, UserID: 42, Name: "ws"})
+	require.Error(t, err)
+	assert.Equal(t, []uint64{7}, store.deletedWorkspaceIDs)
+}
+
+// ---------------------------------------------------------------------------
+// Service definitions
+// ---------------------------------------------------------------------------
+
+func serviceDefinitionStore(def *model.ServiceDefinition) *mockWorkspaceStore {
+	return &mockWorkspaceStore{
+		getServiceDefinition: func(_ context.Context, _, _ uint64) (*model.ServiceDefinition, error) {
+			return def, nil
+		},
+	}
+}
+
+func postgresServiceDefinition() *model.ServiceDefinition {
+	return &model.ServiceDefinition{
+		ID:                      5,
+		Name:                    "postgres",
+		Status:                  model.ServiceDefinitionActive,
+		ChartRegistry:           "registry.example.org",
+		ChartRepository:         "charts/postgres",
+		ChartTag:                "1.0.0",
+		DefaultCredentialsPaths: model.StringSlice{"auth.password"},
+		ConnectionInfoTemplate:  "postgres://{{ .Host }}:5432",
+		ValuesSchema: model.JSONMap[any]{
+			"type":     "object",
+			"required": []any{"storage"},
+			"properties": map[string]any{
+				"storage":  map[string]any{"type": "string"},
+				"replicas": map[string]any{"type": "integer", "minimum": 1},
+			},
+		},
+	}
+}
+
+func TestCreateServiceDefinition_AppliesDefaults(t *testing.T) {
+	svc := newSvc(config.Config{}, &mockWorkspaceStore{}, &mockK8s{}, &mockUserer{})
+
+	created, err := svc.CreateServiceDefinition(context.Background(), &model.ServiceDefinition{Name: "postgres"})
+	require.NoError(t, err)
+	assert.Equal(t, model.ServiceDefinitionActive, created.Status)
+	assert.NotNil(t, created.ValuesSchema)
+	assert.NotNil(t, created.DefaultCredentialsPaths)
+}
+
+func TestCreateServiceDefinition_RejectsInvalidSchema(t *testing.T) {
+	svc := newSvc(config.Config{}, &mockWorkspaceStore{}, &mockK8s{}, &mockUserer{})
+
+	_, err := svc.CreateServiceDefinition(context.Background(), &model.ServiceDefinition{
+		Name:         "postgres",
+		ValuesSchema: model.JSONMap[any]{"type": 42},
+	})
+	require.Error(t, err)
+	var cErr *cerr.ChorusError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, cerr.ErrValidation.ChorusCode, cErr.ChorusCode)
+}
+
+func TestApplyServiceDefinition_FillsChartSpec(t *testing.T) {
+	def := postgresServiceDefinition()
+	svc := newSvc(config.Config{}, serviceDefinitionStore(def), &mockK8s{}, &mockUserer{})
+
+	defID := def.ID
+	instance := &model.WorkspaceServiceInstance{
+		TenantID:            1,
+		Name:                "db",
+		ServiceDefinitionID: &defID,
+		ChartTag:            "ignored",
+		Values:              model.JSONMap[any]{"storage": "10Gi", "replicas": 2},
+	}
+	require.NoError(t, svc.applyServiceDefinition(context.Background(), instance, false))
+
+	assert.Equal(t, "registry.example.org", instance.ChartRegistry)
+	assert.Equal(t, "charts/postgres", instance.ChartRepository)
+	assert.Equal(t, "1.0.0", instance.ChartTag)
+	assert.Equal(t, def.ConnectionInfoTemplate, instance.ConnectionInfoTemplate)
+	assert.Equal(t, model.StringSlice{"auth.password"}, instance.CredentialsPaths)
+	assert.Equal(t, uint64(5), instance.GetServiceDefinitionID())
+}
+
+func TestApplyServiceDefinition_KeepsInstanceCredentialsPaths(t *testing.T) {
+	def := postgresServiceDefinition()
+	svc := newSvc(config.Config{}, serviceDefinitionStore(def), &mockK8s{}, &mockUserer{})
+
+	defID := def.ID
+	instance := &model.WorkspaceServiceInstance{
+		ServiceDefinitionID: &defID,
+		CredentialsPaths:    model.StringSlice{"custom.password"},
+		Values:              model.JSONMap[any]{"storage": "10Gi"},
+	}
+	require.NoError(t, svc.applyServiceDefinition(context.Background(), instance, false))
+	assert.Equal(t, model.StringSlice{"custom.password"}, instance.CredentialsPaths)
+}
+
+func TestApplyServiceDefinition_RejectsValuesNotMatchingSchema(t *testing.T) {
+	def := postgresServiceDefinition()
+	svc := newSvc(config.Config{}, serviceDefinitionStore(def), &mockK8s{}, &mockUserer{})
+
+	defID := def.ID
+	instance := &model.WorkspaceServiceInstance{
+		ServiceDefinitionID: &defID,
+		Values:              model.JSONMap[any]{"replicas": 0},
+	}
+	err := svc.applyServiceDefinition(context.Background(), instance, false)
+	require.Error(t, err)
+	var cErr *cerr.ChorusError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, cerr.ErrValidation.ChorusCode, cErr.ChorusCode)
+	assert.Contains(t, err.Error(), "storage")
+	assert.Contains(t, err.Error(), "replicas")
+	assert.Empty(t, instance.ChartRepository)
+}
+
+func TestApplyServiceDefinition_InactiveDefinition(t *testing.T) {
+	def := postgresServiceDefinition()
+	def.Status = model.ServiceDefinitionInactive
+	svc := newSvc(config.Config{}, serviceDefinitionStore(def), &mockK8s{}, &mockUserer{})
+
+	defID := def.ID
+	instance := &model.WorkspaceServiceInstance{
+		ServiceDefinitionID: &defID,
+		Values:              model.JSONMap[any]{"storage": "10Gi"},
+	}
+
+	err := svc.applyServiceDefinition(context.Background(), instance, false)
+	var cErr *cerr.ChorusError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, cerr.ErrInvalidRequest.ChorusCode, cErr.ChorusCode)
+
+	require.NoError(t, svc.applyServiceDefinition(context.Background(), instance, true))
+	assert.Equal(t, "charts/postgres", instance.ChartRepository)
+}
+
+func TestCreateWorkspaceTemplate_AppliesServiceDefinitions(t *testing.T) {
+	def := postgresServiceDefinition()
+	svc := newSvc(config.Config{}, serviceDefinitionStore(def), &mockK8s{}, &mockUserer{})
+
+	defID := def.ID
+	created, err := svc.CreateWorkspaceTemplate(context.Background(), &model.WorkspaceTemplate{
+		TenantID: 1,
+		Name:     "tpl",
+		ServiceInstances: []*model.WorkspaceTemplateServiceInstance{
+			{Name: "db", ServiceDefinitionID: &defID, ChartTag: "ignored", Values: model.JSONMap[any]{"storage": "10Gi"}},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, created.ServiceInstances, 1)
+	assert.Equal(t, "charts/postgres", created.ServiceInstances[0].ChartRepository)
+	assert.Equal(t, "1.0.0", created.ServiceInstances[0].ChartTag)
+	assert.Equal(t, model.StringSlice{"auth.password"}, created.ServiceInstances[0].CredentialsPaths)
+
+	_, err = svc.CreateWorkspaceTemplate(context.Background(), &model.WorkspaceTemplate{
+		TenantID: 1,
+		Name:     "tpl",
+		ServiceInstances: []*model.WorkspaceTemplateServiceInstance{
+			{Name: "db", ServiceDefinitionID: &defID, Values: model.JSONMap[any]{"replicas": 0}},
+		},
+	})
+	var cErr *cerr.ChorusError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, cerr.ErrValidation.ChorusCode, cErr.ChorusCode)
+}
+
+// The service definition may be retired after the template was saved.
+func TestCreateWorkspaceFromTemplate_AppliesServiceDefinitions(t *testing.T) {
+	def := postgresServiceDefinition()
+	defID := def.ID
+	template := &model.WorkspaceTemplate{
+		ID: 3,
+		ServiceInstances: []*model.WorkspaceTemplateServiceInstance{
+			{Name: "db", ServiceDefinitionID: &defID, ChartTag: "0.9.0", Values: model.JSONMap[any]{"storage": "10Gi"}},
+		},
+	}
+	store := templateStore(template)
+	store.getServiceDefinition = serviceDefinitionStore(def).getServiceDefinition
+	k8sMock := &mockK8s{}
+	svc := newSvc(config.Config{}, store, k8sMock, &mockUserer{})
+
+	_, svcs, err := svc.CreateWorkspaceFromTemplate(context.Background(), template.ID, &model.Workspace{TenantID: 1, UserID: 42, Name: "ws"})
+	require.NoError(t, err)
+	require.Len(t, svcs, 1)
+	assert.Equal(t, "1.0.0", svcs[0].ChartTag, "the chart of the definition is deployed")
+	assert.Equal(t, uint64(5), svcs[0].GetServiceDefinitionID())
+
+	def.Status = model.ServiceDefinitionInactive
+	k8sMock.createdWorkspaces = nil
+	_, _, err = svc.CreateWorkspaceFromTemplate(context.Background(), template.ID, &model.Workspace{TenantID: 1, UserID: 42, Name: "ws"})
+	var cErr *cerr.ChorusError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, cerr.ErrInvalidRequest.ChorusCode, cErr.ChorusCode)
+	assert.Empty(t, k8sMock.createdWorkspaces)
+}
+
+func TestApplyServiceDefinition_FreeFormInstanceUnchanged(t *testing.T) {
+	svc := newSvc(config.Config{}, &mockWorkspaceStore{}, &mockK8s{}, &mockUserer{})
+
+	instance := &model.WorkspaceServiceInstance{ChartRepository: "charts/custom", Values: model.JSONMap[any]{"any": true}}
+	require.NoError(t, svc.applyServiceDefinition(context.Background(), instance, false))
+	assert.Equal(t, "charts/custom", instance.ChartRepository)
+	assert.Nil(t, instance.ServiceDefinitionID)
+}
+
 // ---------------------------------------------------------------------------
 // ListPublicWorkspaces
 // ---------------------------------------------------------------------------
@@ -674,6 +1291,69 @@ func TestAddUserRoleInWorkspace_SameRoleDifferentWorkspaceAllowed(t *testing.T)
 	assert.Equal(t, "9", userer.capturedRoles[0].Context["workspace"])
 }
 
+// ---------------------------------------------------------------------------
+// GrantUserRoleInWorkspace
+// ---------------------------------------------------------------------------
+
+func TestGrantUserRoleInWorkspace_AssignsRole(t *testing.T) {
+	userer := &mockUserer{getUser: userWithRoles()}
+
+	svc := newSvc(config.Config{}, &mockWorkspaceStore{}, &mockK8s{}, userer)
+	granted, err := svc.GrantUserRoleInWorkspace(context.Background(), 1, 42, 5, authorization_model.RoleWorkspaceMember.Name)
+
+	require.NoError(t, err)
+	assert.True(t, granted)
+	require.Len(t, userer.capturedRoles, 1)
+	assert.Equal(t, authorization_model.RoleWorkspaceMember.Name, userer.capturedRoles[0].Role.Name)
+	assert.Equal(t, "5", userer.capturedRoles[0].Context["workspace"])
+}
+
+func TestGrantUserRoleInWorkspace_RoleAlreadyHeld(t *testing.T) {
+	userer := &mockUserer{
+		getUser: userWithRoles(
+			wsRole(1, 5, authorization_model.RoleWorkspaceMember.Name),
+		),
+	}
+
+	svc := newSvc(config.Config{}, &mockWorkspaceStore{}, &mockK8s{}, userer)
+	granted, err := svc.GrantUserRoleInWorkspace(context.Background(), 1, 42, 5, authorization_model.RoleWorkspaceMember.Name)
+
+	require.NoError(t, err)
+	assert.False(t, granted)
+	assert.Empty(t, userer.capturedRoles)
+}
+
+func TestGrantUserRoleInWorkspace_RejectsNonWorkspaceRole(t *testing.T) {
+	userer := &mockUserer{getUser: userWithRoles()}
+
+	svc := newSvc(config.Config{}, &mockWorkspaceStore{}, &mockK8s{}, userer)
+	_, err := svc.GrantUserRoleInWorkspace(context.Background(), 1, 42, 5, authorization_model.RoleSuperAdmin.Name)
+
+	require.Error(t, err)
+	var cErr *cerr.ChorusError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, cerr.ErrInvalidRequest.ChorusCode, cErr.ChorusCode)
+	assert.Empty(t, userer.capturedRoles)
+}
+
+func TestGrantUserRoleInWorkspace_RefusesFrozenWorkspace(t *testing.T) {
+	userer := &mockUserer{getUser: userWithRoles()}
+	store := &mockWorkspaceStore{
+		getWorkspace: func(_ context.Context, tenantID, id uint64) (*model.Workspace, error) {
+			return &model.Workspace{ID: id, TenantID: tenantID, Status: model.WorkspaceStatusFrozen}, nil
+		},
+	}
+
+	svc := newSvc(config.Config{}, store, &mockK8s{}, userer)
+	_, err := svc.GrantUserRoleInWorkspace(context.Background(), 1, 42, 5, authorization_model.RoleWorkspaceMember.Name)
+
+	require.Error(t, err)
+	var cErr *cerr.ChorusError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, cerr.ErrReadOnly.ChorusCode, cErr.ChorusCode)
+	assert.Empty(t, userer.capturedRoles)
+}
+
 // ---------------------------------------------------------------------------
 // RemoveUserFromWorkspace
 // ---------------------------------------------------------------------------
@@ -1009,3 +1689,505 @@ func TestWorkspaceWatcher_PropagatesFinalizeDeleteError(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "db down")
 }
+
+// ---------------------------------------------------------------------------
+// ExtendWorkspace / UpdateWorkspace on archived workspaces
+// ---------------------------------------------------------------------------
+
+func TestExtendWorkspace_RejectsPastDate(t *testing.T) {
+	store := &mockWorkspaceStore{}
+	svc := newSvc(config.Config{}, store, &mockK8s{}, &mockUserer{})
+
+	_, err := svc.ExtendWorkspace(context.Background(), 1, 10, time.Now().Add(-time.Hour))
+
+	var cErr *cerr.ChorusError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, cerr.ErrInvalidRequest.ChorusCode, cErr.ChorusCode)
+	assert.Empty(t, store.extendedWorkspaces)
+}
+
+func TestExtendWorkspace_RejectsEarlierDate(t *testing.T) {
+	current := time.Now().Add(30 * 24 * time.Hour)
+	store := &mockWorkspaceStore{
+		getWorkspace: func(_ context.Context, _, id uint64) (*model.Workspace, error) {
+			return &model.Workspace{ID: id, Status: model.WorkspaceStatusActive, ExpiresAt: &current}, nil
+		},
+	}
+	svc := newSvc(config.Config{}, store, &mockK8s{}, &mockUserer{})
+
+	_, err := svc.ExtendWorkspace(context.Background(), 1, 10, current.Add(-24*time.Hour))
+
+	var cErr *cerr.ChorusError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, cerr.ErrInvalidRequest.ChorusCode, cErr.ChorusCode)
+	assert.Empty(t, store.extendedWorkspaces)
+}
+
+func TestExtendWorkspace_ReactivatesArchivedWorkspace(t *testing.T) {
+	past := time.Now().Add(-24 * time.Hour)
+	store := &mockWorkspaceStore{
+		getWorkspace: func(_ context.Context, _, id uint64) (*model.Workspace, error) {
+			return &model.Workspace{ID: id, Status: model.WorkspaceStatusArchived, ExpiresAt: &past}, nil
+		},
+	}
+	svc := newSvc(config.Config{}, store, &mockK8s{}, &mockUserer{})
+
+	expiresAt := time.Now().Add(90 * 24 * time.Hour)
+	ws, err := svc.ExtendWorkspace(context.Background(), 1, 10, expiresAt)
+
+	require.NoError(t, err)
+	assert.Equal(t, model.WorkspaceStatusActive, ws.Status)
+	assert.Equal(t, expiresAt, store.extendedWorkspaces[10])
+}
+
+func TestUpdateWorkspace_RejectsArchivedWorkspace(t *testing.T) {
+	store := &mockWorkspaceStore{
+		getWorkspace: func(_ context.Context, _, id uint64) (*model.Workspace, error) {
+			return &model.Workspace{ID: id, Status: model.WorkspaceStatusArchived}, nil
+		},
+	}
+	k8sClient := &mockK8s{}
+	svc := newSvc(config.Config{}, store, k8sClient, &mockUserer{})
+
+	_, err := svc.UpdateWorkspace(context.Background(), &model.Workspace{ID: 10, TenantID: 1, Name: "renamed"})
+
+	var cErr *cerr.ChorusError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, cerr.ErrReadOnly.ChorusCode, cErr.ChorusCode)
+	assert.Empty(t, k8sClient.updatedWorkspaces)
+}
+
+// ---------------------------------------------------------------------------
+// Frozen workspaces
+// ---------------------------------------------------------------------------
+
+func frozenWorkspaceStore() *mockWorkspaceStore {
+	return &mockWorkspaceStore{
+		getWorkspace: func(_ context.Context, tenantID, id uint64) (*model.Workspace, error) {
+			return &model.Workspace{ID: id, TenantID: tenantID, Status: model.WorkspaceStatusFrozen}, nil
+		},
+	}
+}
+
+func TestFreezeWorkspace_RejectsAlreadyFrozenWorkspace(t *testing.T) {
+	store := &mockWorkspaceStore{freezeErr: cerr.ErrNoRowsUpdated}
+	svc := newSvc(config.Config{}, store, &mockK8s{}, &mockUserer{})
+
+	_, err := svc.FreezeWorkspace(context.Background(), 1, 10)
+
+	var cErr *cerr.ChorusError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, cerr.ErrInvalidRequest.ChorusCode, cErr.ChorusCode)
+}
+
+func TestUpdateWorkspace_RejectsFrozenWorkspace(t *testing.T) {
+	k8sClient := &mockK8s{}
+	svc := newSvc(config.Config{}, frozenWorkspaceStore(), k8sClient, &mockUserer{})
+
+	_, err := svc.UpdateWorkspace(context.Background(), &model.Workspace{ID: 10, TenantID: 1, Name: "renamed"})
+
+	var cErr *cerr.ChorusError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, cerr.ErrReadOnly.ChorusCode, cErr.ChorusCode)
+	assert.Empty(t, k8sClient.updatedWorkspaces)
+}
+
+func TestDeleteWorkspace_RejectsFrozenWorkspace(t *testing.T) {
+	store := frozenWorkspaceStore()
+	svc := newSvc(config.Config{}, store, &mockK8s{}, &mockUserer{})
+
+	err := svc.DeleteWorkspace(context.Background(), 1, 10)
+
+	var cErr *cerr.ChorusError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, cerr.ErrReadOnly.ChorusCode, cErr.ChorusCode)
+	assert.Empty(t, store.deletedWorkspaceIDs)
+}
+
+func TestCreateWorkspaceServiceInstance_RejectsFrozenWorkspace(t *testing.T) {
+	k8sClient := &mockK8s{}
+	svc := newSvc(config.Config{}, frozenWorkspaceStore(), k8sClient, &mockUserer{})
+
+	_, err := svc.CreateWorkspaceServiceInstance(context.Background(), &model.WorkspaceServiceInstance{TenantID: 1, WorkspaceID: 10, Name: "postgres"})
+
+	var cErr *cerr.ChorusError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, cerr.ErrReadOnly.ChorusCode, cErr.ChorusCode)
+	assert.Empty(t, k8sClient.updatedWorkspaces)
+}
+
+// ---------------------------------------------------------------------------
+// Workspace invitations
+// ---------------------------------------------------------------------------
+
+func invitationStore(invitation *model.WorkspaceInvitation) *mockWorkspaceStore {
+	return &mockWorkspaceStore{
+		getWorkspaceInvitation: func(_ context.Context, _, _ uint64) (*model.WorkspaceInvitation, error) {
+			inv := *invitation
+			return &inv, nil
+		},
+	}
+}
+
+func pendingInvitation() *model.WorkspaceInvitation {
+	return &model.WorkspaceInvitation{
+		ID:           5,
+		TenantID:     1,
+		WorkspaceID:  10,
+		InviteeEmail: "alice@example.com",
+		Role:         authorization_model.RoleWorkspaceMember.Name,
+		Status:       model.WorkspaceInvitationStatusPending,
+		TokenHash:    hashInvitationToken("secret-token"),
+		ExpiresAt:    time.Now().Add(24 * time.Hour),
+	}
+}
+
+func TestCreateWorkspaceInvitation_RejectsNonWorkspaceRole(t *testing.T) {
+	store := &mockWorkspaceStore{}
+	svc := newSvc(config.Config{}, store, &mockK8s{}, &mockUserer{})
+
+	_, err := svc.CreateWorkspaceInvitation(context.Background(), &model.WorkspaceInvitation{
+		TenantID: 1, WorkspaceID: 10, InviteeEmail: "alice@example.com", Role: authorization_model.RoleWorkbenchViewer.Name,
+	})
+
+	var cErr *cerr.ChorusError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, cerr.ErrInvalidRequest.ChorusCode, cErr.ChorusCode)
+	assert.Empty(t, store.createdInvitations)
+}
+
+func TestCreateWorkspaceInvitation_StoresTokenHashAndEmailsLink(t *testing.T) {
+	cfg := config.Config{}
+	cfg.Services.WorkspaceService.InvitationTTL = 7 * 24 * time.Hour
+	store := &mockWorkspaceStore{}
+	mail := &mockMailer{}
+	svc := newSvc(cfg, store, &mockK8s{}, &mockUserer{})
+	svc.mailer = mail
+
+	created, err := svc.CreateWorkspaceInvitation(context.Background(), &model.WorkspaceInvitation{
+		TenantID: 1, WorkspaceID: 10, InviteeEmail: " Alice@Example.com ", Role: authorization_model.RoleWorkspaceMember.Name,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, store.createdInvitations, 1)
+	assert.Equal(t, "alice@example.com", created.InviteeEmail)
+	assert.Len(t, created.TokenHash, 64)
+	assert.WithinDuration(t, time.Now().Add(7*24*time.Hour), created.ExpiresAt, time.Minute)
+	assert.Equal(t, [][]string{{"alice@example.com"}}, mail.sentTo)
+}
+
+func TestCreateWorkspaceInvitation_RejectsExistingMember(t *testing.T) {
+	store := &mockWorkspaceStore{}
+	userer := &mockUserer{getUser: userWithRoles(wsRole(1, 10, authorization_model.RoleWorkspaceMember.Name))}
+	svc := newSvc(config.Config{}, store, &mockK8s{}, userer)
+
+	inviteeID := uint64(42)
+	_, err := svc.CreateWorkspaceInvitation(context.Background(), &model.WorkspaceInvitation{
+		TenantID: 1, WorkspaceID: 10, InviteeUserID: &inviteeID, Role: authorization_model.RoleWorkspaceMember.Name,
+		ExpiresAt: time.Now().Add(time.Hour),
+	})
+
+	var cErr *cerr.ChorusError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, cerr.ErrAlreadyExists.ChorusCode, cErr.ChorusCode)
+	assert.Empty(t, store.createdInvitations)
+}
+
+func TestAcceptWorkspaceInvitation_WithTokenGrantsRole(t *testing.T) {
+	store := invitationStore(pendingInvitation())
+	userer := &mockUserer{getUser: userWithRoles()}
+	svc := newSvc(config.Config{}, store, &mockK8s{}, userer)
+
+	_, err := svc.AcceptWorkspaceInvitation(context.Background(), 1, 42, 5, "secret-token")
+
+	require.NoError(t, err)
+	assert.Equal(t, model.WorkspaceInvitationStatusAccepted, store.respondedInvitations[5])
+	require.Len(t, userer.capturedRoles, 1)
+	assert.Equal(t, authorization_model.RoleWorkspaceMember.Name, userer.capturedRoles[0].Name)
+	assert.Equal(t, "10", userer.capturedRoles[0].Context[authorization_model.ContextWorkspace])
+}
+
+func TestAcceptWorkspaceInvitation_IgnoresProfileEmail(t *testing.T) {
+	store := invitationStore(pendingInvitation())
+	userer := &mockUserer{getUser: func(_ context.Context, _ user_service.GetUserReq) (*user_model.User, error) {
+		return &user_model.User{ID: 42, Email: "ALICE@example.com"}, nil
+	}}
+	svc := newSvc(config.Config{}, store, &mockK8s{}, userer)
+
+	_, err := svc.AcceptWorkspaceInvitation(context.Background(), 1, 42, 5, "")
+
+	var cErr *cerr.ChorusError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, cerr.ErrPermissionDenied.ChorusCode, cErr.ChorusCode)
+	assert.Empty(t, userer.capturedRoles)
+}
+
+func TestAcceptWorkspaceInvitation_ClaimedInvitationGrantsRole(t *testing.T) {
+	invitation := pendingInvitation()
+	inviteeID := uint64(42)
+	invitation.InviteeUserID = &inviteeID
+	store := invitationStore(invitation)
+	userer := &mockUserer{getUser: userWithRoles()}
+	svc := newSvc(config.Config{}, store, &mockK8s{}, userer)
+
+	_, err := svc.AcceptWorkspaceInvitation(context.Background(), 1, 42, 5, "")
+
+	require.NoError(t, err)
+	assert.Len(t, userer.capturedRoles, 1)
+}
+
+func TestAcceptWorkspaceInvitation_KeepsInvitationPendingWhenRoleFails(t *testing.T) {
+	store := invitationStore(pendingInvitation())
+	userer := &mockUserer{getUser: userWithRoles(), createUserRolesErr: errors.New("db down")}
+	svc := newSvc(config.Config{}, store, &mockK8s{}, userer)
+
+	_, err := svc.AcceptWorkspaceInvitation(context.Background(), 1, 42, 5, "secret-token")
+
+	require.Error(t, err)
+	assert.Empty(t, store.respondedInvitations)
+}
+
+func TestAcceptWorkspaceInvitation_TakesRoleBackWhenNoLongerPending(t *testing.T) {
+	store := invitationStore(pendingInvitation())
+	store.respondInvitationErr = cerr.ErrNoRowsUpdated
+	userer := &mockUserer{}
+	userer.getUser = func(_ context.Context, _ user_service.GetUserReq) (*user_model.User, error) {
+		roles := []user_model.UserRole{}
+		for i, r := range userer.capturedRoles {
+			r.ID = uint64(i + 1)
+			roles = append(roles, r)
+		}
+		return &user_model.User{ID: 42, Roles: roles}, nil
+	}
+	svc := newSvc(config.Config{}, store, &mockK8s{}, userer)
+
+	_, err := svc.AcceptWorkspaceInvitation(context.Background(), 1, 42, 5, "secret-token")
+
+	var cErr *cerr.ChorusError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, cerr.ErrInvalidRequest.ChorusCode, cErr.ChorusCode)
+	assert.Len(t, userer.capturedRoles, 1)
+	assert.Equal(t, []uint64{1}, userer.removedRoleIDs, "the role granted is taken back")
+}
+
+func TestAcceptWorkspaceInvitation_RejectsOtherUser(t *testing.T) {
+	store := invitationStore(pendingInvitation())
+	userer := &mockUserer{getUser: func(_ context.Context, _ user_service.GetUserReq) (*user_model.User, error) {
+		return &user_model.User{ID: 43, Email: "bob@example.com"}, nil
+	}}
+	svc := newSvc(config.Config{}, store, &mockK8s{}, userer)
+
+	_, err := svc.AcceptWorkspaceInvitation(context.Background(), 1, 43, 5, "wrong-token")
+
+	var cErr *cerr.ChorusError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, cerr.ErrPermissionDenied.ChorusCode, cErr.ChorusCode)
+	assert.Empty(t, store.respondedInvitations)
+	assert.Empty(t, userer.capturedRoles)
+}
+
+func TestAcceptWorkspaceInvitation_RejectsExpiredInvitation(t *testing.T) {
+	invitation := pendingInvitation()
+	invitation.ExpiresAt = time.Now().Add(-time.Hour)
+	store := invitationStore(invitation)
+	userer := &mockUserer{getUser: userWithRoles()}
+	svc := newSvc(config.Config{}, store, &mockK8s{}, userer)
+
+	_, err := svc.AcceptWorkspaceInvitation(context.Background(), 1, 42, 5, "secret-token")
+
+	var cErr *cerr.ChorusError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, cerr.ErrInvalidRequest.ChorusCode, cErr.ChorusCode)
+	assert.Empty(t, userer.capturedRoles)
+}
+
+func TestRevokeWorkspaceInvitation_RejectsInvitationNoLongerPending(t *testing.T) {
+	store := invitationStore(pendingInvitation())
+	store.respondInvitationErr = cerr.ErrNoRowsUpdated
+	svc := newSvc(config.Config{}, store, &mockK8s{}, &mockUserer{})
+
+	_, err := svc.RevokeWorkspaceInvitation(context.Background(), 1, 5)
+
+	var cErr *cerr.ChorusError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, cerr.ErrInvalidRequest.ChorusCode, cErr.ChorusCode)
+}
+
+// ---------------------------------------------------------------------------
+// Network policy relaxations
+// ---------------------------------------------------------------------------
+
+func airgappedWorkspaceStore() *mockWorkspaceStore {
+	return &mockWorkspaceStore{
+		getWorkspace: func(_ context.Context, tenantID, id uint64) (*model.Workspace, error) {
+			return &model.Workspace{
+				ID: id, TenantID: tenantID, Name: "genomics", Status: model.WorkspaceStatusActive,
+				NetworkPolicy: model.NetworkPolicyAirgapped, AllowedFQDNs: model.FQDNRules{},
+			}, nil
+		},
+	}
+}
+
+func userContext(userID uint64) context.Context {
+	return context.WithValue(context.Background(), jwt_model.JWTClaimsContextKey, &jwt_model.JWTClaims{ID: userID, TenantID: 1})
+}
+
+func TestIsNetworkPolicyRelaxation(t *testing.T) {
+	pypi := model.FQDNRules{{Pattern: "pypi.org"}}
+	tests := []struct {
+		name           string
+		currentMode    model.NetworkPolicyMode
+		currentFQDNs   model.FQDNRules
+		requestedMode  model.NetworkPolicyMode
+		requestedFQDNs model.FQDNRules
+		want           bool
+	}{
+		{"airgapped to open", model.NetworkPolicyAirgapped, nil, model.NetworkPolicyOpen, nil, true},
+		{"airgapped to allowlist", model.NetworkPolicyAirgapped, nil, model.NetworkPolicyFQDNAllowlist, pypi, true},
+		{"open to airgapped", model.NetworkPolicyOpen, nil, model.NetworkPolicyAirgapped, nil, false},
+		{"allowlist to airgapped", model.NetworkPolicyFQDNAllowlist, pypi, model.NetworkPolicyAirgapped, nil, false},
+		{"new fqdn", model.NetworkPolicyFQDNAllowlist, pypi, model.NetworkPolicyFQDNAllowlist, model.FQDNRules{{Pattern: "pypi.org"}, {Pattern: "cran.r-project.org"}}, true},
+		{"removed fqdn", model.NetworkPolicyFQDNAllowlist, model.FQDNRules{{Pattern: "pypi.org"}, {Pattern: "cran.r-project.org"}}, model.NetworkPolicyFQDNAllowlist, pypi, false},
+		{"same fqdn in another case", model.NetworkPolicyFQDNAllowlist, pypi, model.NetworkPolicyFQDNAllowlist, model.FQDNRules{{Pattern: "PyPI.org"}}, false},
+		{"subdomain covered by wildcard", model.NetworkPolicyFQDNAllowlist, model.FQDNRules{{Pattern: "*.example.org"}}, model.NetworkPolicyFQDNAllowlist, model.FQDNRules{{Pattern: "data.example.org"}}, false},
+		{"wildcard over host", model.NetworkPolicyFQDNAllowlist, model.FQDNRules{{Pattern: "data.example.org"}}, model.NetworkPolicyFQDNAllowlist, model.FQDNRules{{Pattern: "*.example.org"}}, true},
+		{"port restricted", model.NetworkPolicyFQDNAllowlist, pypi, model.NetworkPolicyFQDNAllowlist, model.FQDNRules{{Pattern: "pypi.org", Ports: []uint32{443}}}, false},
+		{"port added", model.NetworkPolicyFQDNAllowlist, model.FQDNRules{{Pattern: "pypi.org", Ports: []uint32{443}}}, model.NetworkPolicyFQDNAllowlist, model.FQDNRules{{Pattern: "pypi.org", Ports: []uint32{443, 80}}}, true},
+		{"ports lifted", model.NetworkPolicyFQDNAllowlist, model.FQDNRules{{Pattern: "pypi.org", Ports: []uint32{443}}}, model.NetworkPolicyFQDNAllowlist, pypi, true},
+		{"protocol lifted", model.NetworkPolicyFQDNAllowlist, model.FQDNRules{{Pattern: "pypi.org", Protocol: model.FQDNProtocolTCP}}, model.NetworkPolicyFQDNAllowlist, pypi, true},
+		{"unchanged", model.NetworkPolicyAirgapped, nil, model.NetworkPolicyAirgapped, nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, model.IsNetworkPolicyRelaxation(tt.currentMode, tt.currentFQDNs, tt.requestedMode, tt.requestedFQDNs))
+		})
+	}
+}
+
+func TestUpdateWorkspace_RelaxationCreatesPendingApprovalRequest(t *testing.T) {
+	store := airgappedWorkspaceStore()
+	k8sClient := &mockK8s{}
+	svc := newSvc(config.Config{}, store, k8sClient, &mockUserer{})
+	approvals := &mockApprovalRequestStore{}
+	finder := &mockUserPermissionFinder{userIDs: []uint64{7, 8}}
+	svc.approvalRequestStore = approvals
+	svc.userPermissionFinder = finder
+
+	updated, err := svc.UpdateWorkspace(userContext(42), &model.Workspace{
+		ID: 10, TenantID: 1, Name: "renamed",
+		NetworkPolicy: model.NetworkPolicyFQDNAllowlist, AllowedFQDNs: model.FQDNRules{{Pattern: "pypi.org"}},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, approvals.created, 1)
+	request := approvals.created[0]
+	assert.Equal(t, approval_model.ApprovalRequestTypeNetworkPolicyChange, request.Type)
+	assert.Equal(t, approval_model.ApprovalRequestStatusPending, request.Status)
+	assert.Equal(t, uint64(42), request.RequesterID)
+	assert.Equal(t, []uint64{7, 8}, request.ApproverIDsByStep[approval_model.StepNetworkPolicy])
+	require.NotNil(t, request.Details.NetworkPolicyChangeDetails)
+	assert.Equal(t, model.NetworkPolicyFQDNAllowlist.String(), request.Details.NetworkPolicyChangeDetails.NetworkPolicy)
+	assert.Equal(t, model.FQDNRules{{Pattern: "pypi.org"}}, request.Details.NetworkPolicyChangeDetails.AllowedFQDNs)
+	assert.Equal(t, authorization_model.PermApproveNetworkPolicyChange.Name, finder.filters[0].PermissionName)
+
+	assert.Equal(t, "renamed", updated.Name)
+	assert.Equal(t, model.NetworkPolicyAirgapped, updated.NetworkPolicy)
+	assert.Empty(t, updated.AllowedFQDNs)
+	assert.Equal(t, request.ID, updated.NetworkPolicyApprovalRequestID)
+	require.Len(t, k8sClient.updatedWorkspaces, 1)
+	assert.Equal(t, model.NetworkPolicyAirgapped.String(), k8sClient.updatedWorkspaces[0].NetworkPolicy)
+}
+
+func TestUpdateWorkspace_RelaxationByApproverIsAutoApproved(t *testing.T) {
+	store := airgappedWorkspaceStore()
+	svc := newSvc(config.Config{}, store, &mockK8s{}, &mockUserer{})
+	approvals := &mockApprovalRequestStore{}
+	svc.approvalRequestStore = approvals
+	svc.userPermissionFinder = &mockUserPermissionFinder{userIDs: []uint64{7, 42}}
+
+	updated, err := svc.UpdateWorkspace(userContext(42), &model.Workspace{
+		ID: 10, TenantID: 1, Name: "genomics", NetworkPolicy: model.NetworkPolicyOpen,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, approvals.created, 1)
+	assert.Equal(t, approval_model.ApprovalRequestStatusApproved, approvals.created[0].Status)
+	assert.True(t, approvals.created[0].AutoApproved)
+	assert.Equal(t, model.NetworkPolicyOpen, updated.NetworkPolicy)
+	assert.Zero(t, updated.NetworkPolicyApprovalRequestID)
+}
+
+func TestUpdateWorkspace_RelaxationWithoutApproversIsRejected(t *testing.T) {
+	store := airgappedWorkspaceStore()
+	svc := newSvc(config.Config{}, store, &mockK8s{}, &mockUserer{})
+
+	_, err := svc.UpdateWorkspace(userContext(42), &model.Workspace{
+		ID: 10, TenantID: 1, Name: "genomics", NetworkPolicy: model.NetworkPolicyOpen,
+	})
+
+	var cErr *cerr.ChorusError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, cerr.ErrInvalidRequest.ChorusCode, cErr.ChorusCode)
+	assert.Empty(t, store.updatedWorkspaces)
+}
+
+func TestUpdateWorkspace_TighteningAppliesImmediately(t *testing.T) {
+	store := &mockWorkspaceStore{
+		getWorkspace: func(_ context.Context, tenantID, id uint64) (*model.Workspace, error) {
+			return &model.Workspace{ID: id, TenantID: tenantID, Status: model.WorkspaceStatusActive, NetworkPolicy: model.NetworkPolicyOpen}, nil
+		},
+	}
+	svc := newSvc(config.Config{}, store, &mockK8s{}, &mockUserer{})
+	approvals := &mockApprovalRequestStore{}
+	svc.approvalRequestStore = approvals
+
+	updated, err := svc.UpdateWorkspace(userContext(42), &model.Workspace{
+		ID: 10, TenantID: 1, Name: "genomics",
+		NetworkPolicy: model.NetworkPolicyFQDNAllowlist, AllowedFQDNs: model.FQDNRules{{Pattern: "pypi.org"}},
+	})
+	require.NoError(t, err)
+
+	assert.Empty(t, approvals.created)
+	assert.Equal(t, model.NetworkPolicyFQDNAllowlist, updated.NetworkPolicy)
+	assert.Zero(t, updated.NetworkPolicyApprovalRequestID)
+}
+
+func TestUpdateWorkspaceOwner_HandsWorkspaceOver(t *testing.T) {
+	store := &mockWorkspaceStore{}
+	userer := &mockUserer{getUser: userWithRoles()}
+
+	svc := newSvc(config.Config{}, store, &mockK8s{}, userer)
+	ws, err := svc.UpdateWorkspaceOwner(context.Background(), 1, 10, 42)
+
+	require.NoError(t, err)
+	assert.Equal(t, uint64(42), ws.UserID)
+	assert.Equal(t, map[uint64]uint64{10: 42}, store.ownerUpdates)
+}
+
+func TestUpdateWorkspaceOwner_RejectsFrozenWorkspace(t *testing.T) {
+	store := &mockWorkspaceStore{
+		getWorkspace: func(_ context.Context, tenantID, id uint64) (*model.Workspace, error) {
+			return &model.Workspace{ID: id, TenantID: tenantID, Status: model.WorkspaceStatusFrozen}, nil
+		},
+	}
+
+	svc := newSvc(config.Config{}, store, &mockK8s{}, &mockUserer{getUser: userWithRoles()})
+	_, err := svc.UpdateWorkspaceOwner(context.Background(), 1, 10, 42)
+
+	var cErr *cerr.ChorusError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, cerr.ErrReadOnly.ChorusCode, cErr.ChorusCode)
+	assert.Empty(t, store.ownerUpdates)
+}
+
+func TestUpdateWorkspaceNetworkPolicy_RejectsFrozenWorkspace(t *testing.T) {
+	store := frozenWorkspaceStore()
+	svc := newSvc(config.Config{}, store, &mockK8s{}, &mockUserer{})
+
+	_, err := svc.UpdateWorkspaceNetworkPolicy(context.Background(), 1, 10, model.NetworkPolicyOpen, nil)
+
+	var cErr *cerr.ChorusError
+	require.ErrorAs(t, err, &cErr)
+	assert.Equal(t, cerr.ErrReadOnly.ChorusCode, cErr.ChorusCode)
+	assert.Empty(t, store.networkPolicyUpdates)
+}
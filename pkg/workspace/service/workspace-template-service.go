@@ -0,0 +1,229 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	"github.com/CHORUS-TRE/chorus-backend/internal/logger"
+	authz "github.com/CHORUS-TRE/chorus-backend/pkg/authorization/model"
+	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
+	notification_model "github.com/CHORUS-TRE/chorus-backend/pkg/notification/model"
+	user_model "github.com/CHORUS-TRE/chorus-backend/pkg/user/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
+	"go.uber.org/zap"
+)
+
+func (s *WorkspaceService) GetWorkspaceTemplate(ctx context.Context, tenantID, templateID uint64) (*model.WorkspaceTemplate, error) {
+	template, err := s.store.GetWorkspaceTemplate(ctx, tenantID, templateID)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to get workspace template %v", templateID))
+	}
+	return template, nil
+}
+
+func (s *WorkspaceService) ListWorkspaceTemplates(ctx context.Context, tenantID uint64, pagination *common_model.Pagination) ([]*model.WorkspaceTemplate, *common_model.PaginationResult, error) {
+	templates, paginationRes, err := s.store.ListWorkspaceTemplates(ctx, tenantID, pagination)
+	if err != nil {
+		return nil, nil, cerr.WrapStoreError(err, "Unable to list workspace templates")
+	}
+	return templates, paginationRes, nil
+}
+
+func (s *WorkspaceService) CreateWorkspaceTemplate(ctx context.Context, template *model.WorkspaceTemplate) (*model.WorkspaceTemplate, error) {
+	if err := s.prepareWorkspaceTemplate(ctx, template); err != nil {
+		return nil, err
+	}
+
+	created, err := s.store.CreateWorkspaceTemplate(ctx, template.TenantID, template)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, "Unable to create workspace template")
+	}
+	return created, nil
+}
+
+func (s *WorkspaceService) UpdateWorkspaceTemplate(ctx context.Context, template *model.WorkspaceTemplate) (*model.WorkspaceTemplate, error) {
+	if err := s.prepareWorkspaceTemplate(ctx, template); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.store.UpdateWorkspaceTemplate(ctx, template.TenantID, template)
+	if err != nil {
+		return nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to update workspace template %v", template.ID))
+	}
+	return updated, nil
+}
+
+func (s *WorkspaceService) DeleteWorkspaceTemplate(ctx context.Context, tenantID, templateID uint64) error {
+	err := s.store.DeleteWorkspaceTemplate(ctx, tenantID, templateID)
+	if err != nil {
+		return cerr.WrapStoreError(err, fmt.Sprintf("Unable to delete workspace template %v", templateID))
+	}
+	return nil
+}
+
+// CreateWorkspaceFromTemplate provisions a workspace from a template in one call: the
+// workspace and its service instances are created in a single transaction, then the
+// creator and template member roles are assigned and the workspace is deployed in K8s.
+// If any step after the transaction fails, the workspace and its roles are removed again.
+func (s *WorkspaceService) CreateWorkspaceFromTemplate(ctx context.Context, templateID uint64, workspace *model.Workspace) (*model.Workspace, []*model.WorkspaceServiceInstance, error) {
+	if workspace.ExpiresAt != nil && !workspace.ExpiresAt.After(time.Now()) {
+		return nil, nil, cerr.ErrInvalidRequest.WithMessage("The workspace end date must be in the future")
+	}
+
+	template, err := s.store.GetWorkspaceTemplate(ctx, workspace.TenantID, templateID)
+	if err != nil {
+		return nil, nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to get workspace template %v", templateID))
+	}
+
+	if err := validateTemplateMembers(template.Members); err != nil {
+		return nil, nil, err
+	}
+
+	workspace.NetworkPolicy = template.NetworkPolicy
+	workspace.AllowedFQDNs = append(model.FQDNRules{}, template.AllowedFQDNs...)
+	workspace.Clipboard = template.Clipboard
+	workspace.TemplateID = &template.ID
+	workspace.AppIDs = append(model.Uint64Slice{}, template.AppIDs...)
+	if workspace.Visibility == "" {
+		workspace.Visibility = model.WorkspaceVisibilityPrivate
+	}
+
+	settings, err := s.platformSettings.GetPlatformSettings(ctx, workspace.TenantID)
+	if err != nil {
+		return nil, nil, cerr.ErrInternal.Wrap(err, "Unable to get platform settings")
+	}
+
+	// The service definitions may have changed, or been retired, since the template was
+	// saved.
+	svcs := make([]*model.WorkspaceServiceInstance, 0, len(template.ServiceInstances))
+	for _, ts := range template.ServiceInstances {
+		svc := ts.ToServiceInstance(workspace.TenantID, 0)
+		if err := s.applyServiceDefinition(ctx, svc, false); err != nil {
+			return nil, nil, err
+		}
+		svcs = append(svcs, svc)
+	}
+
+	newWorkspace, newSvcs, err := s.store.CreateWorkspaceWithServiceInstances(ctx, workspace.TenantID, workspace, svcs, settings.MaxWorkspacesPerUser)
+	if err != nil {
+		if errors.Is(err, cerr.ErrQuotaReached) {
+			return nil, nil, cerr.ErrQuotaExceeded.Wrap(err, fmt.Sprintf("Maximum number of workspaces per user reached (maxWorkspacesPerUser: %v)", settings.MaxWorkspacesPerUser))
+		}
+		return nil, nil, cerr.WrapStoreError(err, fmt.Sprintf("Unable to create workspace from template %v", templateID))
+	}
+
+	rolesByUser := map[uint64][]user_model.UserRole{}
+	rolesByUser[workspace.UserID] = s.creatorRoles(newWorkspace.ID)
+	for _, m := range template.Members {
+		r := user_model.UserRole{Role: authz.Role{
+			Name:    m.Role,
+			Context: authz.Context{authz.ContextWorkspace: fmt.Sprintf("%d", newWorkspace.ID)},
+		}}
+		if slices.ContainsFunc(rolesByUser[m.UserID], func(ur user_model.UserRole) bool { return ur.Name == r.Name }) {
+			continue
+		}
+		rolesByUser[m.UserID] = append(rolesByUser[m.UserID], r)
+	}
+
+	for userID, roles := range rolesByUser {
+		if len(roles) == 0 {
+			continue
+		}
+		if err := s.userer.CreateUserRoles(ctx, workspace.TenantID, userID, roles); err != nil {
+			s.rollbackWorkspaceFromTemplate(ctx, workspace.TenantID, newWorkspace.ID)
+			return nil, nil, cerr.ErrInternal.Wrap(err, fmt.Sprintf("Unable to assign workspace roles to user %v for workspace %v", userID, newWorkspace.ID))
+		}
+	}
+
+	input := workspaceToK8sInput(newWorkspace, newSvcs)
+	if err := s.k8sClient.CreateWorkspace(input); err != nil {
+		s.rollbackWorkspaceFromTemplate(ctx, workspace.TenantID, newWorkspace.ID)
+		return nil, nil, cerr.ErrInternal.Wrap(err, fmt.Sprintf("Unable to create workspace %v in K8s", newWorkspace.ID))
+	}
+
+	memberIDs := []uint64{}
+	for userID := range rolesByUser {
+		if userID != workspace.UserID {
+			memberIDs = append(memberIDs, userID)
+		}
+	}
+	if len(memberIDs) > 0 {
+		err = s.notificationStore.CreateNotification(ctx, &notification_model.Notification{
+			TenantID: workspace.TenantID,
+			Message:  fmt.Sprintf("You have been added to workspace %v", newWorkspace.Name),
+			Content: notification_model.NotificationContent{
+				Type: "SystemNotification",
+				SystemNotification: &notification_model.SystemNotification{
+					RefreshJWTRequired: true,
+				},
+			},
+		}, memberIDs)
+		if err != nil {
+			logger.TechLog.Error(ctx, "unable to notify workspace template members",
+				zap.Uint64("workspaceID", newWorkspace.ID), zap.Error(err))
+		}
+	}
+
+	return newWorkspace, newSvcs, nil
+}
+
+// rollbackWorkspaceFromTemplate removes a partially provisioned workspace and the roles
+// already granted on it. Failures are only logged as the original error is returned.
+func (s *WorkspaceService) rollbackWorkspaceFromTemplate(ctx context.Context, tenantID, workspaceID uint64) {
+	if _, err := s.userer.RemoveRolesByContext(ctx, "workspace", fmt.Sprintf("%d", workspaceID)); err != nil {
+		logger.TechLog.Error(ctx, "unable to remove roles of partially provisioned workspace",
+			zap.Uint64("workspaceID", workspaceID), zap.Error(err))
+	}
+	if err := s.store.DeleteWorkspace(ctx, tenantID, workspaceID); err != nil {
+		logger.TechLog.Error(ctx, "unable to delete partially provisioned workspace",
+			zap.Uint64("workspaceID", workspaceID), zap.Error(err))
+	}
+}
+
+// prepareWorkspaceTemplate applies the workspace defaults to a template, fills the chart
+// spec of its service instances from their service definition and checks its member roles.
+func (s *WorkspaceService) prepareWorkspaceTemplate(ctx context.Context, template *model.WorkspaceTemplate) error {
+	if template.NetworkPolicy == "" {
+		template.NetworkPolicy = model.NetworkPolicyAirgapped
+	}
+	if template.Clipboard == "" {
+		template.Clipboard = model.ClipboardDisabled
+	}
+	if template.AllowedFQDNs == nil {
+		template.AllowedFQDNs = model.FQDNRules{}
+	}
+
+	names := map[string]struct{}{}
+	for _, svc := range template.ServiceInstances {
+		if _, ok := names[svc.Name]; ok {
+			return cerr.ErrInvalidRequest.WithMessage(fmt.Sprintf("Duplicate service instance name %q in workspace template", svc.Name))
+		}
+		names[svc.Name] = struct{}{}
+
+		spec := svc.ToServiceInstance(template.TenantID, 0)
+		if err := s.applyServiceDefinition(ctx, spec, false); err != nil {
+			return err
+		}
+		svc.ChartRegistry = spec.ChartRegistry
+		svc.ChartRepository = spec.ChartRepository
+		svc.ChartTag = spec.ChartTag
+		svc.CredentialsPaths = spec.CredentialsPaths
+		svc.ConnectionInfoTemplate = spec.ConnectionInfoTemplate
+	}
+
+	return validateTemplateMembers(template.Members)
+}
+
+// validateTemplateMembers checks that every member role is a workspace-scoped role.
+func validateTemplateMembers(members []model.WorkspaceTemplateMember) error {
+	for _, m := range members {
+		if !isWorkspaceRole(m.Role) {
+			return cerr.ErrInvalidRequest.WithMessage(fmt.Sprintf("Role %v of user %v is not a workspace role", m.Role, m.UserID))
+		}
+	}
+	return nil
+}
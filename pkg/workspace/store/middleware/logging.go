@@ -130,12 +130,12 @@ func (c workspaceStorageLogging) UpdateWorkspace(ctx context.Context, tenantID u
 	return updatedWorkspace, nil
 }
 
-func (c workspaceStorageLogging) CreateWorkspace(ctx context.Context, tenantID uint64, workspace *model.Workspace) (*model.Workspace, error) {
+func (c workspaceStorageLogging) CreateWorkspace(ctx context.Context, tenantID uint64, workspace *model.Workspace, maxPerUser uint32) (*model.Workspace, error) {
 	c.logger.Debug(ctx, "request started")
 
 	now := time.Now()
 
-	newWorkspace, err := c.next.CreateWorkspace(ctx, tenantID, workspace)
+	newWorkspace, err := c.next.CreateWorkspace(ctx, tenantID, workspace, maxPerUser)
 	if err != nil {
 		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
 			zap.Error(err),
@@ -173,11 +173,178 @@ func (c workspaceStorageLogging) DeleteOldWorkspaces(ctx context.Context, timeou
 	return deletedWorkspaces, nil
 }
 
-func (c workspaceStorageLogging) UpdateWorkspaceStatus(ctx context.Context, tenantID uint64, workspaceID uint64, networkPolicyStatus, networkPolicyMessage string) error {
+func (c workspaceStorageLogging) ExtendWorkspace(ctx context.Context, tenantID uint64, workspaceID uint64, expiresAt time.Time) (*model.Workspace, error) {
 	c.logger.Debug(ctx, "request started")
 	now := time.Now()
 
-	err := c.next.UpdateWorkspaceStatus(ctx, tenantID, workspaceID, networkPolicyStatus, networkPolicyMessage)
+	workspace, err := c.next.ExtendWorkspace(ctx, tenantID, workspaceID, expiresAt)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkspaceIDField(workspaceID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithWorkspaceIDField(workspaceID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return workspace, nil
+}
+
+func (c workspaceStorageLogging) UpdateWorkspaceNetworkPolicy(ctx context.Context, tenantID uint64, workspaceID uint64, networkPolicy model.NetworkPolicyMode, allowedFQDNs model.FQDNRules) (*model.Workspace, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	workspace, err := c.next.UpdateWorkspaceNetworkPolicy(ctx, tenantID, workspaceID, networkPolicy, allowedFQDNs)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkspaceIDField(workspaceID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithWorkspaceIDField(workspaceID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return workspace, nil
+}
+
+func (c workspaceStorageLogging) UpdateWorkspaceOwner(ctx context.Context, tenantID uint64, workspaceID uint64, userID uint64) (*model.Workspace, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	workspace, err := c.next.UpdateWorkspaceOwner(ctx, tenantID, workspaceID, userID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkspaceIDField(workspaceID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithWorkspaceIDField(workspaceID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return workspace, nil
+}
+
+func (c workspaceStorageLogging) ListExpiringWorkspaces(ctx context.Context, before time.Time) ([]*model.Workspace, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	workspaces, err := c.next.ListExpiringWorkspaces(ctx, before)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		zap.Int("num_workspaces", len(workspaces)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return workspaces, nil
+}
+
+func (c workspaceStorageLogging) UpdateWorkspaceExpiryNotice(ctx context.Context, tenantID uint64, workspaceID uint64, noticeDays int) error {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	err := c.next.UpdateWorkspaceExpiryNotice(ctx, tenantID, workspaceID, noticeDays)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkspaceIDField(workspaceID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithWorkspaceIDField(workspaceID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c workspaceStorageLogging) ArchiveWorkspace(ctx context.Context, tenantID uint64, workspaceID uint64) error {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	err := c.next.ArchiveWorkspace(ctx, tenantID, workspaceID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkspaceIDField(workspaceID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithWorkspaceIDField(workspaceID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c workspaceStorageLogging) FreezeWorkspace(ctx context.Context, tenantID uint64, workspaceID uint64) error {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	err := c.next.FreezeWorkspace(ctx, tenantID, workspaceID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkspaceIDField(workspaceID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithWorkspaceIDField(workspaceID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c workspaceStorageLogging) UnfreezeWorkspace(ctx context.Context, tenantID uint64, workspaceID uint64) error {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	err := c.next.UnfreezeWorkspace(ctx, tenantID, workspaceID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkspaceIDField(workspaceID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithWorkspaceIDField(workspaceID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c workspaceStorageLogging) UpdateWorkspaceStatus(ctx context.Context, tenantID uint64, workspaceID uint64, networkPolicyStatus, networkPolicyMessage string, rejectedFQDNs model.JSONMap[string]) error {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	err := c.next.UpdateWorkspaceStatus(ctx, tenantID, workspaceID, networkPolicyStatus, networkPolicyMessage, rejectedFQDNs)
 	if err != nil {
 		c.logger.Error(ctx, "request completed",
 			logger.WithWorkspaceIDField(workspaceID),
@@ -339,3 +506,361 @@ func (c workspaceStorageLogging) UpdateWorkspaceServiceInstanceStatuses(ctx cont
 	)
 	return nil
 }
+
+func (c workspaceStorageLogging) CreateWorkspaceWithServiceInstances(ctx context.Context, tenantID uint64, workspace *model.Workspace, svcs []*model.WorkspaceServiceInstance, maxPerUser uint32) (*model.Workspace, []*model.WorkspaceServiceInstance, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	created, createdSvcs, err := c.next.CreateWorkspaceWithServiceInstances(ctx, tenantID, workspace, svcs, maxPerUser)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		zap.Uint64("workspace_id", created.ID),
+		logger.WithCountField(len(createdSvcs)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return created, createdSvcs, nil
+}
+
+func (c workspaceStorageLogging) GetWorkspaceTemplate(ctx context.Context, tenantID, templateID uint64) (*model.WorkspaceTemplate, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	res, err := c.next.GetWorkspaceTemplate(ctx, tenantID, templateID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Uint64("workspace_template_id", templateID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		zap.Uint64("workspace_template_id", templateID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workspaceStorageLogging) ListWorkspaceTemplates(ctx context.Context, tenantID uint64, pagination *common_model.Pagination) ([]*model.WorkspaceTemplate, *common_model.PaginationResult, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	res, paginationRes, err := c.next.ListWorkspaceTemplates(ctx, tenantID, pagination)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithCountField(len(res)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, paginationRes, nil
+}
+
+func (c workspaceStorageLogging) CreateWorkspaceTemplate(ctx context.Context, tenantID uint64, template *model.WorkspaceTemplate) (*model.WorkspaceTemplate, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	created, err := c.next.CreateWorkspaceTemplate(ctx, tenantID, template)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		zap.Uint64("workspace_template_id", created.ID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return created, nil
+}
+
+func (c workspaceStorageLogging) UpdateWorkspaceTemplate(ctx context.Context, tenantID uint64, template *model.WorkspaceTemplate) (*model.WorkspaceTemplate, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	updated, err := c.next.UpdateWorkspaceTemplate(ctx, tenantID, template)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Uint64("workspace_template_id", template.ID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		zap.Uint64("workspace_template_id", updated.ID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return updated, nil
+}
+
+func (c workspaceStorageLogging) DeleteWorkspaceTemplate(ctx context.Context, tenantID, templateID uint64) error {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	err := c.next.DeleteWorkspaceTemplate(ctx, tenantID, templateID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Uint64("workspace_template_id", templateID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		zap.Uint64("workspace_template_id", templateID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c workspaceStorageLogging) GetServiceDefinition(ctx context.Context, tenantID, serviceDefinitionID uint64) (*model.ServiceDefinition, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	res, err := c.next.GetServiceDefinition(ctx, tenantID, serviceDefinitionID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Uint64("service_definition_id", serviceDefinitionID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		zap.Uint64("service_definition_id", serviceDefinitionID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workspaceStorageLogging) ListServiceDefinitions(ctx context.Context, tenantID uint64, pagination *common_model.Pagination) ([]*model.ServiceDefinition, *common_model.PaginationResult, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	res, paginationRes, err := c.next.ListServiceDefinitions(ctx, tenantID, pagination)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithCountField(len(res)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, paginationRes, nil
+}
+
+func (c workspaceStorageLogging) CreateServiceDefinition(ctx context.Context, tenantID uint64, def *model.ServiceDefinition) (*model.ServiceDefinition, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	created, err := c.next.CreateServiceDefinition(ctx, tenantID, def)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		zap.Uint64("service_definition_id", created.ID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return created, nil
+}
+
+func (c workspaceStorageLogging) UpdateServiceDefinition(ctx context.Context, tenantID uint64, def *model.ServiceDefinition) (*model.ServiceDefinition, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	updated, err := c.next.UpdateServiceDefinition(ctx, tenantID, def)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Uint64("service_definition_id", def.ID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		zap.Uint64("service_definition_id", updated.ID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return updated, nil
+}
+
+func (c workspaceStorageLogging) DeleteServiceDefinition(ctx context.Context, tenantID, serviceDefinitionID uint64) error {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	err := c.next.DeleteServiceDefinition(ctx, tenantID, serviceDefinitionID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Uint64("service_definition_id", serviceDefinitionID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		zap.Uint64("service_definition_id", serviceDefinitionID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c workspaceStorageLogging) GetWorkspaceInvitation(ctx context.Context, tenantID, invitationID uint64) (*model.WorkspaceInvitation, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	res, err := c.next.GetWorkspaceInvitation(ctx, tenantID, invitationID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Uint64("workspace_invitation_id", invitationID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		zap.Uint64("workspace_invitation_id", invitationID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, nil
+}
+
+func (c workspaceStorageLogging) ListWorkspaceInvitations(ctx context.Context, tenantID, workspaceID uint64, pagination *common_model.Pagination, filter model.WorkspaceInvitationFilter) ([]*model.WorkspaceInvitation, *common_model.PaginationResult, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	res, paginationRes, err := c.next.ListWorkspaceInvitations(ctx, tenantID, workspaceID, pagination, filter)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkspaceIDField(workspaceID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithWorkspaceIDField(workspaceID),
+		logger.WithCountField(len(res)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, paginationRes, nil
+}
+
+func (c workspaceStorageLogging) ListInviteeWorkspaceInvitations(ctx context.Context, tenantID, userID uint64, pagination *common_model.Pagination) ([]*model.WorkspaceInvitation, *common_model.PaginationResult, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	res, paginationRes, err := c.next.ListInviteeWorkspaceInvitations(ctx, tenantID, userID, pagination)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithUserIDField(userID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithUserIDField(userID),
+		logger.WithCountField(len(res)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return res, paginationRes, nil
+}
+
+func (c workspaceStorageLogging) CreateWorkspaceInvitation(ctx context.Context, tenantID uint64, invitation *model.WorkspaceInvitation) (*model.WorkspaceInvitation, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	created, err := c.next.CreateWorkspaceInvitation(ctx, tenantID, invitation)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithWorkspaceIDField(invitation.WorkspaceID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return nil, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		zap.Uint64("workspace_invitation_id", created.ID),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return created, nil
+}
+
+func (c workspaceStorageLogging) RespondWorkspaceInvitation(ctx context.Context, tenantID, invitationID uint64, status model.WorkspaceInvitationStatus, inviteeUserID *uint64) error {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	err := c.next.RespondWorkspaceInvitation(ctx, tenantID, invitationID, status, inviteeUserID)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			zap.Uint64("workspace_invitation_id", invitationID),
+			zap.String("status", status.String()),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		zap.Uint64("workspace_invitation_id", invitationID),
+		zap.String("status", status.String()),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return nil
+}
+
+func (c workspaceStorageLogging) ClaimWorkspaceInvitations(ctx context.Context, tenantID, userID uint64, email string) (int64, error) {
+	c.logger.Debug(ctx, "request started")
+	now := time.Now()
+
+	claimed, err := c.next.ClaimWorkspaceInvitations(ctx, tenantID, userID, email)
+	if err != nil {
+		c.logger.Error(ctx, logger.LoggerMessageRequestFailed,
+			logger.WithUserIDField(userID),
+			zap.Error(err),
+			zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+		)
+		return 0, err
+	}
+
+	c.logger.Debug(ctx, "request completed",
+		logger.WithUserIDField(userID),
+		logger.WithCountField(int(claimed)),
+		zap.Float64(logger.LoggerKeyElapsedMs, float64(time.Since(now).Nanoseconds())/1000000.0),
+	)
+	return claimed, nil
+}
@@ -0,0 +1,142 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/uuid"
+	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/common/storage"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
+)
+
+func (s *WorkspaceStorage) GetServiceDefinition(ctx context.Context, tenantID, serviceDefinitionID uint64) (*model.ServiceDefinition, error) {
+	const query = `
+		SELECT id, tenantid, userid, name, description, status,
+		       chartregistry, chartrepository, charttag,
+		       valuesschema, defaultcredentialspaths, connectioninfotemplate, iconurl,
+		       createdat, updatedat
+		FROM service_definitions
+		WHERE tenantid = $1 AND id = $2 AND deletedat IS NULL;
+	`
+
+	var def model.ServiceDefinition
+	if err := s.db.GetContext(ctx, &def, query, tenantID, serviceDefinitionID); err != nil {
+		return nil, err
+	}
+
+	return &def, nil
+}
+
+func (s *WorkspaceStorage) ListServiceDefinitions(ctx context.Context, tenantID uint64, pagination *common_model.Pagination) ([]*model.ServiceDefinition, *common_model.PaginationResult, error) {
+	const countQuery = `SELECT COUNT(*) FROM service_definitions WHERE tenantid = $1 AND deletedat IS NULL`
+
+	var totalCount int
+	if err := s.db.GetContext(ctx, &totalCount, countQuery, tenantID); err != nil {
+		return nil, nil, err
+	}
+
+	query := `
+		SELECT id, tenantid, userid, name, description, status,
+		       chartregistry, chartrepository, charttag,
+		       valuesschema, defaultcredentialspaths, connectioninfotemplate, iconurl,
+		       createdat, updatedat
+		FROM service_definitions
+		WHERE tenantid = $1 AND deletedat IS NULL
+	`
+
+	clause, validatedPagination := storage.BuildPaginationClause(pagination, model.ServiceDefinition{})
+	query += clause
+
+	paginationRes := &common_model.PaginationResult{Total: uint64(totalCount)}
+	if validatedPagination != nil {
+		paginationRes.Limit = validatedPagination.Limit
+		paginationRes.Offset = validatedPagination.Offset
+		paginationRes.Sort = validatedPagination.Sort
+	}
+
+	var defs []*model.ServiceDefinition
+	if err := s.db.SelectContext(ctx, &defs, query, tenantID); err != nil {
+		return nil, nil, err
+	}
+
+	return defs, paginationRes, nil
+}
+
+func (s *WorkspaceStorage) CreateServiceDefinition(ctx context.Context, tenantID uint64, def *model.ServiceDefinition) (*model.ServiceDefinition, error) {
+	const query = `
+		INSERT INTO service_definitions (tenantid, userid, name, description, status,
+		    chartregistry, chartrepository, charttag,
+		    valuesschema, defaultcredentialspaths, connectioninfotemplate, iconurl,
+		    createdat, updatedat)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, NOW(), NOW())
+		RETURNING id, tenantid, userid, name, description, status,
+		    chartregistry, chartrepository, charttag,
+		    valuesschema, defaultcredentialspaths, connectioninfotemplate, iconurl,
+		    createdat, updatedat;
+	`
+
+	var created model.ServiceDefinition
+	err := s.db.GetContext(ctx, &created, query,
+		tenantID, def.UserID, def.Name, def.Description, def.Status,
+		def.ChartRegistry, def.ChartRepository, def.ChartTag,
+		def.ValuesSchema, pqStringArray(def.DefaultCredentialsPaths), def.ConnectionInfoTemplate, def.IconURL,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+func (s *WorkspaceStorage) UpdateServiceDefinition(ctx context.Context, tenantID uint64, def *model.ServiceDefinition) (*model.ServiceDefinition, error) {
+	const query = `
+		UPDATE service_definitions
+		SET name = $3, description = $4, status = $5,
+		    chartregistry = $6, chartrepository = $7, charttag = $8,
+		    valuesschema = $9, defaultcredentialspaths = $10, connectioninfotemplate = $11, iconurl = $12,
+		    updatedat = NOW()
+		WHERE tenantid = $1 AND id = $2 AND deletedat IS NULL
+		RETURNING id, tenantid, userid, name, description, status,
+		    chartregistry, chartrepository, charttag,
+		    valuesschema, defaultcredentialspaths, connectioninfotemplate, iconurl,
+		    createdat, updatedat;
+	`
+
+	var updated model.ServiceDefinition
+	err := s.db.GetContext(ctx, &updated, query,
+		tenantID, def.ID, def.Name, def.Description, def.Status,
+		def.ChartRegistry, def.ChartRepository, def.ChartTag,
+		def.ValuesSchema, pqStringArray(def.DefaultCredentialsPaths), def.ConnectionInfoTemplate, def.IconURL,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to update service definition: %w", err)
+	}
+
+	return &updated, nil
+}
+
+// DeleteServiceDefinition soft-deletes a definition. The service instances created from
+// it keep their chart spec.
+func (s *WorkspaceStorage) DeleteServiceDefinition(ctx context.Context, tenantID, serviceDefinitionID uint64) error {
+	const query = `
+		UPDATE service_definitions
+		SET status = $3, name = concat(name, $4::TEXT), deletedat = NOW(), updatedat = NOW()
+		WHERE tenantid = $1 AND id = $2 AND deletedat IS NULL;
+	`
+
+	rows, err := s.db.ExecContext(ctx, query, tenantID, serviceDefinitionID, model.ServiceDefinitionDeleted.String(), "-"+uuid.Next())
+	if err != nil {
+		return fmt.Errorf("unable to delete service definition: %w", err)
+	}
+	affected, err := rows.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("unable to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return cerr.ErrNoRowsDeleted
+	}
+
+	return nil
+}
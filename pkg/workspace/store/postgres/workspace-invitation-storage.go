@@ -0,0 +1,223 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	authz "github.com/CHORUS-TRE/chorus-backend/pkg/authorization/model"
+	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/common/storage"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
+)
+
+// workspaceInvitationRow is the raw representation of an entry in the
+// 'workspace_invitations' table joined with the name of its workspace.
+type workspaceInvitationRow struct {
+	ID            uint64     `db:"id"`
+	TenantID      uint64     `db:"tenantid"`
+	WorkspaceID   uint64     `db:"workspaceid"`
+	WorkspaceName string     `db:"workspacename"`
+	InviterID     uint64     `db:"inviterid"`
+	InviteeUserID *uint64    `db:"inviteeuserid"`
+	InviteeEmail  string     `db:"inviteeemail"`
+	RoleName      string     `db:"rolename"`
+	Status        string     `db:"status"`
+	TokenHash     string     `db:"tokenhash"`
+	ExpiresAt     time.Time  `db:"expiresat"`
+	RespondedAt   *time.Time `db:"respondedat"`
+	CreatedAt     time.Time  `db:"createdat"`
+	UpdatedAt     time.Time  `db:"updatedat"`
+}
+
+func (r *workspaceInvitationRow) toModel() *model.WorkspaceInvitation {
+	return &model.WorkspaceInvitation{
+		ID:            r.ID,
+		TenantID:      r.TenantID,
+		WorkspaceID:   r.WorkspaceID,
+		WorkspaceName: r.WorkspaceName,
+		InviterID:     r.InviterID,
+		InviteeUserID: r.InviteeUserID,
+		InviteeEmail:  r.InviteeEmail,
+		Role:          authz.RoleName(r.RoleName),
+		Status:        model.WorkspaceInvitationStatus(r.Status),
+		TokenHash:     r.TokenHash,
+		ExpiresAt:     r.ExpiresAt,
+		RespondedAt:   r.RespondedAt,
+		CreatedAt:     r.CreatedAt,
+		UpdatedAt:     r.UpdatedAt,
+	}
+}
+
+const workspaceInvitationColumns = `
+	i.id, i.tenantid, i.workspaceid, w.name AS workspacename, i.inviterid,
+	i.inviteeuserid, i.inviteeemail, i.rolename, i.status, i.tokenhash,
+	i.expiresat, i.respondedat, i.createdat, i.updatedat
+`
+
+func (s *WorkspaceStorage) GetWorkspaceInvitation(ctx context.Context, tenantID, invitationID uint64) (*model.WorkspaceInvitation, error) {
+	query := `
+		SELECT ` + workspaceInvitationColumns + `
+		FROM workspace_invitations i
+		JOIN workspaces w ON w.id = i.workspaceid
+		WHERE i.tenantid = $1 AND i.id = $2;
+	`
+
+	var row workspaceInvitationRow
+	if err := s.db.GetContext(ctx, &row, query, tenantID, invitationID); err != nil {
+		return nil, err
+	}
+
+	return row.toModel(), nil
+}
+
+func (s *WorkspaceStorage) ListWorkspaceInvitations(ctx context.Context, tenantID, workspaceID uint64, pagination *common_model.Pagination, filter model.WorkspaceInvitationFilter) ([]*model.WorkspaceInvitation, *common_model.PaginationResult, error) {
+	args := []interface{}{tenantID, workspaceID}
+	where := "i.tenantid = $1 AND i.workspaceid = $2" + invitationStatusCondition(filter.StatusesIn)
+
+	return s.listWorkspaceInvitations(ctx, where, args, pagination)
+}
+
+// ListInviteeWorkspaceInvitations lists the pending invitations bound to a user.
+func (s *WorkspaceStorage) ListInviteeWorkspaceInvitations(ctx context.Context, tenantID, userID uint64, pagination *common_model.Pagination) ([]*model.WorkspaceInvitation, *common_model.PaginationResult, error) {
+	args := []interface{}{tenantID, userID}
+	where := `i.tenantid = $1 AND i.inviteeuserid = $2` +
+		invitationStatusCondition([]model.WorkspaceInvitationStatus{model.WorkspaceInvitationStatusPending})
+
+	return s.listWorkspaceInvitations(ctx, where, args, pagination)
+}
+
+func (s *WorkspaceStorage) listWorkspaceInvitations(ctx context.Context, where string, args []interface{}, pagination *common_model.Pagination) ([]*model.WorkspaceInvitation, *common_model.PaginationResult, error) {
+	countQuery := `SELECT COUNT(*) FROM workspace_invitations i WHERE ` + where
+
+	var totalCount int
+	if err := s.db.GetContext(ctx, &totalCount, countQuery, args...); err != nil {
+		return nil, nil, err
+	}
+
+	query := `
+		SELECT ` + workspaceInvitationColumns + `
+		FROM workspace_invitations i
+		JOIN workspaces w ON w.id = i.workspaceid
+		WHERE ` + where
+
+	clause, validatedPagination := storage.BuildPaginationClause(pagination, model.WorkspaceInvitation{})
+	if clause == "" {
+		clause = " ORDER BY i.id DESC"
+	}
+	query += clause
+
+	paginationRes := &common_model.PaginationResult{Total: uint64(totalCount)}
+	if validatedPagination != nil {
+		paginationRes.Limit = validatedPagination.Limit
+		paginationRes.Offset = validatedPagination.Offset
+		paginationRes.Sort = validatedPagination.Sort
+	}
+
+	var rows []*workspaceInvitationRow
+	if err := s.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, nil, err
+	}
+
+	invitations := make([]*model.WorkspaceInvitation, 0, len(rows))
+	for _, row := range rows {
+		invitations = append(invitations, row.toModel())
+	}
+
+	return invitations, paginationRes, nil
+}
+
+// invitationStatusCondition builds the SQL condition matching the given statuses.
+// Expired is not stored, it is matched as a pending invitation past its expiry date.
+func invitationStatusCondition(statuses []model.WorkspaceInvitationStatus) string {
+	if len(statuses) == 0 {
+		return ""
+	}
+
+	conds := make([]string, 0, len(statuses))
+	for _, st := range statuses {
+		switch st {
+		case model.WorkspaceInvitationStatusPending:
+			conds = append(conds, "(i.status = 'pending' AND i.expiresat > NOW())")
+		case model.WorkspaceInvitationStatusExpired:
+			conds = append(conds, "(i.status = 'pending' AND i.expiresat <= NOW())")
+		case model.WorkspaceInvitationStatusAccepted, model.WorkspaceInvitationStatusDeclined, model.WorkspaceInvitationStatusRevoked:
+			conds = append(conds, fmt.Sprintf("i.status = '%s'", st))
+		}
+	}
+	if len(conds) == 0 {
+		return " AND FALSE"
+	}
+
+	return " AND (" + strings.Join(conds, " OR ") + ")"
+}
+
+func (s *WorkspaceStorage) CreateWorkspaceInvitation(ctx context.Context, tenantID uint64, invitation *model.WorkspaceInvitation) (*model.WorkspaceInvitation, error) {
+	const query = `
+		INSERT INTO workspace_invitations (tenantid, workspaceid, inviterid,
+		    inviteeuserid, inviteeemail, rolename, status, tokenhash, expiresat,
+		    createdat, updatedat)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW(), NOW())
+		RETURNING id;
+	`
+
+	var id uint64
+	err := s.db.GetContext(ctx, &id, query,
+		tenantID, invitation.WorkspaceID, invitation.InviterID,
+		invitation.InviteeUserID, invitation.InviteeEmail, invitation.Role, model.WorkspaceInvitationStatusPending.String(),
+		invitation.TokenHash, invitation.ExpiresAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create workspace invitation: %w", err)
+	}
+
+	return s.GetWorkspaceInvitation(ctx, tenantID, id)
+}
+
+// RespondWorkspaceInvitation moves a pending, unexpired invitation to its final status.
+// When inviteeUserID is set, the invitation is bound to that user.
+func (s *WorkspaceStorage) RespondWorkspaceInvitation(ctx context.Context, tenantID, invitationID uint64, status model.WorkspaceInvitationStatus, inviteeUserID *uint64) error {
+	const query = `
+		UPDATE workspace_invitations
+		SET status = $3, inviteeuserid = COALESCE($4, inviteeuserid), respondedat = NOW(), updatedat = NOW()
+		WHERE tenantid = $1 AND id = $2 AND status = $5 AND expiresat > NOW();
+	`
+
+	rows, err := s.db.ExecContext(ctx, query, tenantID, invitationID, status.String(), inviteeUserID, model.WorkspaceInvitationStatusPending.String())
+	if err != nil {
+		return fmt.Errorf("unable to update workspace invitation: %w", err)
+	}
+	affected, err := rows.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("unable to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return cerr.ErrNoRowsUpdated
+	}
+
+	return nil
+}
+
+// ClaimWorkspaceInvitations binds the pending email invitations sent to the address
+// of a user to that user, and returns the number of invitations claimed.
+func (s *WorkspaceStorage) ClaimWorkspaceInvitations(ctx context.Context, tenantID, userID uint64, email string) (int64, error) {
+	const query = `
+		UPDATE workspace_invitations
+		SET inviteeuserid = $2, updatedat = NOW()
+		WHERE tenantid = $1 AND inviteeuserid IS NULL AND lower(inviteeemail) = lower($3)
+		  AND status = $4 AND expiresat > NOW();
+	`
+
+	if email == "" {
+		return 0, nil
+	}
+
+	rows, err := s.db.ExecContext(ctx, query, tenantID, userID, email, model.WorkspaceInvitationStatusPending.String())
+	if err != nil {
+		return 0, fmt.Errorf("unable to claim workspace invitations: %w", err)
+	}
+
+	return rows.RowsAffected()
+}
@@ -36,8 +36,8 @@ func NewWorkspaceStorage(db *sqlx.DB, daemonKey *crypto.Secret) *WorkspaceStorag
 func (s *WorkspaceStorage) GetWorkspace(ctx context.Context, tenantID uint64, workspaceID uint64) (*model.Workspace, error) {
 	const query = `
 		SELECT id, tenantid, userid, name, shortname, description, status, ismain,
-		       networkpolicy, allowedfqdns, networkpolicystatus, networkpolicymessage,
-		       clipboard, visibility, contactuserid,
+		       networkpolicy, allowedfqdns, networkpolicystatus, networkpolicymessage, rejectedfqdns,
+		       clipboard, visibility, contactuserid, templateid, appids, expiresat, expirynoticedays, workbenchidletimeoutminutes,
 		       createdat, updatedat
 		FROM workspaces
 		WHERE tenantid = $1 AND id = $2 AND deletedat IS NULL;
@@ -72,8 +72,8 @@ func (s *WorkspaceStorage) ListWorkspaces(ctx context.Context, tenantID uint64,
 	// Get workspaces query
 	query := `
 		SELECT id, tenantid, userid, name, shortname, description, status, ismain,
-		       networkpolicy, allowedfqdns, networkpolicystatus, networkpolicymessage,
-		       clipboard, visibility, contactuserid,
+		       networkpolicy, allowedfqdns, networkpolicystatus, networkpolicymessage, rejectedfqdns,
+		       clipboard, visibility, contactuserid, templateid, appids, expiresat, expirynoticedays, workbenchidletimeoutminutes,
 		       createdat, updatedat
 		FROM workspaces
 		WHERE tenantid = $1
@@ -125,7 +125,7 @@ func (s *WorkspaceStorage) ListPublicWorkspaces(ctx context.Context, tenantID ui
 	// except for the filters on visibility and deletedat
 	query := `
 		SELECT id, tenantid, userid, name, shortname, description, status, ismain,
-		       networkpolicy, allowedfqdns, networkpolicystatus, networkpolicymessage,
+		       networkpolicy, allowedfqdns, networkpolicystatus, networkpolicymessage, rejectedfqdns,
 		       clipboard, contactuserid,
 		       createdat, updatedat
 		FROM workspaces
@@ -156,23 +156,83 @@ func (s *WorkspaceStorage) ListPublicWorkspaces(ctx context.Context, tenantID ui
 }
 
 // CreateWorkspace saves the provided workspace object in the database 'workspaces' table.
-func (s *WorkspaceStorage) CreateWorkspace(ctx context.Context, tenantID uint64, workspace *model.Workspace) (*model.Workspace, error) {
+// When maxPerUser is non zero, the insert is refused with cerr.ErrQuotaReached
+// if the owner already has that many workspaces.
+func (s *WorkspaceStorage) CreateWorkspace(ctx context.Context, tenantID uint64, workspace *model.Workspace, maxPerUser uint32) (*model.Workspace, error) {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	createdWorkspace, err := s.createWorkspace(ctx, tx, tenantID, workspace, maxPerUser)
+	if err != nil {
+		return nil, storage.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return createdWorkspace, nil
+}
+
+// CreateWorkspaceWithServiceInstances saves the provided workspace and its service
+// instances in a single transaction, so that either all of them or none are created.
+func (s *WorkspaceStorage) CreateWorkspaceWithServiceInstances(ctx context.Context, tenantID uint64, workspace *model.Workspace, svcs []*model.WorkspaceServiceInstance, maxPerUser uint32) (*model.Workspace, []*model.WorkspaceServiceInstance, error) {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	createdWorkspace, err := s.createWorkspace(ctx, tx, tenantID, workspace, maxPerUser)
+	if err != nil {
+		return nil, nil, storage.Rollback(tx, err)
+	}
+
+	createdSvcs := make([]*model.WorkspaceServiceInstance, 0, len(svcs))
+	for _, svc := range svcs {
+		svc.WorkspaceID = createdWorkspace.ID
+		created, err := s.createWorkspaceServiceInstance(ctx, tx, tenantID, svc)
+		if err != nil {
+			return nil, nil, storage.Rollback(tx, err)
+		}
+		createdSvcs = append(createdSvcs, created)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, err
+	}
+
+	return createdWorkspace, createdSvcs, nil
+}
+
+func (s *WorkspaceStorage) createWorkspace(ctx context.Context, tx *sqlx.Tx, tenantID uint64, workspace *model.Workspace, maxPerUser uint32) (*model.Workspace, error) {
+	const countQuery = `
+		SELECT COUNT(*) FROM workspaces
+		WHERE tenantid = $1 AND userid = $2 AND status != 'deleted' AND deletedat IS NULL;
+	`
+
 	const workspaceQuery = `
 		INSERT INTO workspaces (tenantid, userid, name, shortname, description, status, ismain,
 		                        networkpolicy, allowedfqdns, clipboard,
-								visibility, contactuserid,
+								visibility, contactuserid, templateid, appids, expiresat, workbenchidletimeoutminutes,
 		                        createdat, updatedat)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, NOW(), NOW())
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, NOW(), NOW())
 		RETURNING id, tenantid, userid, name, shortname, description, status, ismain,
-		          networkpolicy, allowedfqdns, networkpolicystatus, networkpolicymessage,
-		          clipboard, visibility, contactuserid,
+		          networkpolicy, allowedfqdns, networkpolicystatus, networkpolicymessage, rejectedfqdns,
+		          clipboard, visibility, contactuserid, templateid, appids, expiresat, expirynoticedays, workbenchidletimeoutminutes,
 		          createdat, updatedat;
 	`
 
+	if err := storage.CheckUserQuota(ctx, tx, tenantID, workspace.UserID, maxPerUser, countQuery); err != nil {
+		return nil, err
+	}
+
 	var createdWorkspace model.Workspace
-	err := s.db.GetContext(ctx, &createdWorkspace, workspaceQuery,
+	err := tx.GetContext(ctx, &createdWorkspace, workspaceQuery,
 		tenantID, workspace.UserID, workspace.Name, workspace.ShortName, workspace.Description, workspace.Status, workspace.IsMain,
-		workspace.NetworkPolicy, pqStringArray(workspace.AllowedFQDNs), workspace.Clipboard, workspace.Visibility, workspace.ContactUserID,
+		workspace.NetworkPolicy, workspace.AllowedFQDNs, workspace.Clipboard, workspace.Visibility, workspace.ContactUserID,
+		workspace.TemplateID, workspace.AppIDs, workspace.ExpiresAt, workspace.WorkbenchIdleTimeoutMinutes,
 	)
 	if err != nil {
 		return nil, err
@@ -186,18 +246,19 @@ func (s *WorkspaceStorage) UpdateWorkspace(ctx context.Context, tenantID uint64,
 		UPDATE workspaces
 		SET name = $3, shortname = $4, description = $5, status = $6, isMain = $7,
 		    networkpolicy = $8, allowedfqdns = $9, clipboard = $10, visibility = $11, contactuserid = $12,
-		    updatedat = NOW()
+		    workbenchidletimeoutminutes = $13, updatedat = NOW()
 		WHERE tenantid = $1 AND id = $2 AND deletedat IS NULL
 		RETURNING id, tenantid, userid, name, shortname, description, status, ismain,
-		          networkpolicy, allowedfqdns, networkpolicystatus, networkpolicymessage,
-		          clipboard, visibility, contactuserid,
+		          networkpolicy, allowedfqdns, networkpolicystatus, networkpolicymessage, rejectedfqdns,
+		          clipboard, visibility, contactuserid, templateid, appids, expiresat, expirynoticedays, workbenchidletimeoutminutes,
 		          createdat, updatedat;
 	`
 
 	var updatedWorkspace model.Workspace
 	err := s.db.GetContext(ctx, &updatedWorkspace, workspaceUpdateQuery,
 		tenantID, workspace.ID, workspace.Name, workspace.ShortName, workspace.Description, workspace.Status, workspace.IsMain,
-		workspace.NetworkPolicy, pqStringArray(workspace.AllowedFQDNs), workspace.Clipboard, workspace.Visibility, workspace.ContactUserID,
+		workspace.NetworkPolicy, workspace.AllowedFQDNs, workspace.Clipboard, workspace.Visibility, workspace.ContactUserID,
+		workspace.WorkbenchIdleTimeoutMinutes,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("unable to update workspace: %w", err)
@@ -206,15 +267,194 @@ func (s *WorkspaceStorage) UpdateWorkspace(ctx context.Context, tenantID uint64,
 	return &updatedWorkspace, nil
 }
 
+// UpdateWorkspaceNetworkPolicy updates the network policy mode and FQDN allowlist of a workspace.
+func (s *WorkspaceStorage) UpdateWorkspaceNetworkPolicy(ctx context.Context, tenantID uint64, workspaceID uint64, networkPolicy model.NetworkPolicyMode, allowedFQDNs model.FQDNRules) (*model.Workspace, error) {
+	const query = `
+		UPDATE workspaces
+		SET networkpolicy = $3, allowedfqdns = $4, updatedat = NOW()
+		WHERE tenantid = $1 AND id = $2 AND deletedat IS NULL
+		RETURNING id, tenantid, userid, name, shortname, description, status, ismain,
+		          networkpolicy, allowedfqdns, networkpolicystatus, networkpolicymessage, rejectedfqdns,
+		          clipboard, visibility, contactuserid, templateid, appids, expiresat, expirynoticedays, workbenchidletimeoutminutes,
+		          createdat, updatedat;
+	`
+
+	var workspace model.Workspace
+	err := s.db.GetContext(ctx, &workspace, query, tenantID, workspaceID, networkPolicy, allowedFQDNs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &workspace, nil
+}
+
+// UpdateWorkspaceOwner makes the user the owner of a workspace.
+func (s *WorkspaceStorage) UpdateWorkspaceOwner(ctx context.Context, tenantID uint64, workspaceID uint64, userID uint64) (*model.Workspace, error) {
+	const query = `
+		UPDATE workspaces
+		SET userid = $3, updatedat = NOW()
+		WHERE tenantid = $1 AND id = $2 AND deletedat IS NULL
+		RETURNING id, tenantid, userid, name, shortname, description, status, ismain,
+		          networkpolicy, allowedfqdns, networkpolicystatus, networkpolicymessage, rejectedfqdns,
+		          clipboard, visibility, contactuserid, templateid, appids, expiresat, expirynoticedays, workbenchidletimeoutminutes,
+		          createdat, updatedat;
+	`
+
+	var workspace model.Workspace
+	err := s.db.GetContext(ctx, &workspace, query, tenantID, workspaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &workspace, nil
+}
+
+// ExtendWorkspace moves the end date of a workspace and resets its expiry notices.
+// An archived workspace is made active again.
+func (s *WorkspaceStorage) ExtendWorkspace(ctx context.Context, tenantID uint64, workspaceID uint64, expiresAt time.Time) (*model.Workspace, error) {
+	const query = `
+		UPDATE workspaces
+		SET expiresat = $3, expirynoticedays = NULL,
+		    status = CASE WHEN status = $4 THEN $5 ELSE status END,
+		    updatedat = NOW()
+		WHERE tenantid = $1 AND id = $2 AND deletedat IS NULL
+		RETURNING id, tenantid, userid, name, shortname, description, status, ismain,
+		          networkpolicy, allowedfqdns, networkpolicystatus, networkpolicymessage, rejectedfqdns,
+		          clipboard, visibility, contactuserid, templateid, appids, expiresat, expirynoticedays, workbenchidletimeoutminutes,
+		          createdat, updatedat;
+	`
+
+	var workspace model.Workspace
+	err := s.db.GetContext(ctx, &workspace, query, tenantID, workspaceID, expiresAt,
+		model.WorkspaceStatusArchived.String(), model.WorkspaceStatusActive.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return &workspace, nil
+}
+
+// ListExpiringWorkspaces lists the active workspaces of all tenants whose end date is
+// before the given time, and the archived ones that still have workbenches.
+func (s *WorkspaceStorage) ListExpiringWorkspaces(ctx context.Context, before time.Time) ([]*model.Workspace, error) {
+	const query = `
+		SELECT id, tenantid, userid, name, shortname, description, status, ismain,
+		       networkpolicy, allowedfqdns, networkpolicystatus, networkpolicymessage, rejectedfqdns,
+		       clipboard, visibility, contactuserid, templateid, appids, expiresat, expirynoticedays, workbenchidletimeoutminutes,
+		       createdat, updatedat
+		FROM workspaces
+		WHERE expiresat IS NOT NULL AND expiresat <= $1 AND deletedat IS NULL
+		  AND (status = $2 OR (status = $3 AND EXISTS (
+		      SELECT 1 FROM workbenches w
+		      WHERE w.workspaceid = workspaces.id AND w.status != 'deleted' AND w.deletedat IS NULL
+		  )))
+		ORDER BY expiresat;
+	`
+
+	var workspaces []*model.Workspace
+	if err := s.db.SelectContext(ctx, &workspaces, query, before, model.WorkspaceStatusActive.String(), model.WorkspaceStatusArchived.String()); err != nil {
+		return nil, err
+	}
+
+	return workspaces, nil
+}
+
+// UpdateWorkspaceExpiryNotice records the lead time, in days, of the last expiry notice sent for a workspace.
+func (s *WorkspaceStorage) UpdateWorkspaceExpiryNotice(ctx context.Context, tenantID uint64, workspaceID uint64, noticeDays int) error {
+	const query = `
+		UPDATE workspaces
+		SET expirynoticedays = $3
+		WHERE tenantid = $1 AND id = $2 AND deletedat IS NULL;
+	`
+
+	_, err := s.db.ExecContext(ctx, query, tenantID, workspaceID, noticeDays)
+	if err != nil {
+		return fmt.Errorf("unable to update workspace expiry notice: %w", err)
+	}
+
+	return nil
+}
+
+// ArchiveWorkspace moves an active workspace whose end date has passed to the archived
+// status. It returns ErrNoRowsUpdated when the workspace was extended meanwhile.
+func (s *WorkspaceStorage) ArchiveWorkspace(ctx context.Context, tenantID uint64, workspaceID uint64) error {
+	const query = `
+		UPDATE workspaces
+		SET status = $3, updatedat = NOW()
+		WHERE tenantid = $1 AND id = $2 AND status = $4 AND deletedat IS NULL
+		  AND expiresat IS NOT NULL AND expiresat <= NOW();
+	`
+
+	rows, err := s.db.ExecContext(ctx, query, tenantID, workspaceID, model.WorkspaceStatusArchived.String(), model.WorkspaceStatusActive.String())
+	if err != nil {
+		return fmt.Errorf("unable to archive workspace: %w", err)
+	}
+	affected, err := rows.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("unable to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return cerr.ErrNoRowsUpdated
+	}
+
+	return nil
+}
+
+// FreezeWorkspace moves a workspace that is not deleted or already frozen to the frozen status.
+func (s *WorkspaceStorage) FreezeWorkspace(ctx context.Context, tenantID uint64, workspaceID uint64) error {
+	const query = `
+		UPDATE workspaces
+		SET status = $3, updatedat = NOW()
+		WHERE tenantid = $1 AND id = $2 AND status NOT IN ($3, $4) AND deletedat IS NULL;
+	`
+
+	rows, err := s.db.ExecContext(ctx, query, tenantID, workspaceID, model.WorkspaceStatusFrozen.String(), model.WorkspaceStatusDeleted.String())
+	if err != nil {
+		return fmt.Errorf("unable to freeze workspace: %w", err)
+	}
+	affected, err := rows.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("unable to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return cerr.ErrNoRowsUpdated
+	}
+
+	return nil
+}
+
+// UnfreezeWorkspace moves a frozen workspace back to the active status.
+func (s *WorkspaceStorage) UnfreezeWorkspace(ctx context.Context, tenantID uint64, workspaceID uint64) error {
+	const query = `
+		UPDATE workspaces
+		SET status = $3, updatedat = NOW()
+		WHERE tenantid = $1 AND id = $2 AND status = $4 AND deletedat IS NULL;
+	`
+
+	rows, err := s.db.ExecContext(ctx, query, tenantID, workspaceID, model.WorkspaceStatusActive.String(), model.WorkspaceStatusFrozen.String())
+	if err != nil {
+		return fmt.Errorf("unable to unfreeze workspace: %w", err)
+	}
+	affected, err := rows.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("unable to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return cerr.ErrNoRowsUpdated
+	}
+
+	return nil
+}
+
 // UpdateWorkspaceStatus updates only the workspace-level status fields (from K8s watcher).
-func (s *WorkspaceStorage) UpdateWorkspaceStatus(ctx context.Context, tenantID uint64, workspaceID uint64, networkPolicyStatus, networkPolicyMessage string) error {
+func (s *WorkspaceStorage) UpdateWorkspaceStatus(ctx context.Context, tenantID uint64, workspaceID uint64, networkPolicyStatus, networkPolicyMessage string, rejectedFQDNs model.JSONMap[string]) error {
 	const query = `
 		UPDATE workspaces
-		SET networkpolicystatus = $3, networkpolicymessage = $4, updatedat = NOW()
+		SET networkpolicystatus = $3, networkpolicymessage = $4, rejectedfqdns = $5, updatedat = NOW()
 		WHERE tenantid = $1 AND id = $2 AND deletedat IS NULL;
 	`
 
-	_, err := s.db.ExecContext(ctx, query, tenantID, workspaceID, networkPolicyStatus, networkPolicyMessage)
+	_, err := s.db.ExecContext(ctx, query, tenantID, workspaceID, networkPolicyStatus, networkPolicyMessage, rejectedFQDNs)
 	if err != nil {
 		return fmt.Errorf("unable to update workspace status: %w", err)
 	}
@@ -242,7 +482,7 @@ func (s *WorkspaceStorage) UpdateWorkspaceServiceInstanceStatuses(ctx context.Co
 
 func (s *WorkspaceStorage) GetWorkspaceServiceInstance(ctx context.Context, tenantID, workspaceServiceInstanceID uint64) (*model.WorkspaceServiceInstance, error) {
 	const query = `
-		SELECT id, tenantid, workspaceid, name,
+		SELECT id, tenantid, workspaceid, name, servicedefinitionid,
 		       state, chartregistry, chartrepository, charttag,
 		       valuesoverride, credentialssecretname, credentialspaths,
 		       connectioninfotemplate, computedvalues,
@@ -279,7 +519,7 @@ func (s *WorkspaceStorage) ListWorkspaceServiceInstances(ctx context.Context, te
 	}
 
 	query := `
-		SELECT id, tenantid, workspaceid, name,
+		SELECT id, tenantid, workspaceid, name, servicedefinitionid,
 		       state, chartregistry, chartrepository, charttag,
 		       valuesoverride, credentialssecretname, credentialspaths,
 		       connectioninfotemplate, computedvalues,
@@ -318,7 +558,7 @@ func (s *WorkspaceStorage) ListWorkspaceServiceInstances(ctx context.Context, te
 
 func (s *WorkspaceStorage) ListWorkspaceServiceInstancesByWorkspace(ctx context.Context, workspaceID uint64) ([]*model.WorkspaceServiceInstance, error) {
 	const query = `
-		SELECT id, tenantid, workspaceid, name,
+		SELECT id, tenantid, workspaceid, name, servicedefinitionid,
 		       state, chartregistry, chartrepository, charttag,
 		       valuesoverride, credentialssecretname, credentialspaths,
 		       connectioninfotemplate, computedvalues,
@@ -343,14 +583,18 @@ func (s *WorkspaceStorage) ListWorkspaceServiceInstancesByWorkspace(ctx context.
 }
 
 func (s *WorkspaceStorage) CreateWorkspaceServiceInstance(ctx context.Context, tenantID uint64, svc *model.WorkspaceServiceInstance) (*model.WorkspaceServiceInstance, error) {
+	return s.createWorkspaceServiceInstance(ctx, s.db, tenantID, svc)
+}
+
+func (s *WorkspaceStorage) createWorkspaceServiceInstance(ctx context.Context, q sqlx.QueryerContext, tenantID uint64, svc *model.WorkspaceServiceInstance) (*model.WorkspaceServiceInstance, error) {
 	const query = `
-		INSERT INTO workspace_services (tenantid, workspaceid, name,
+		INSERT INTO workspace_services (tenantid, workspaceid, name, servicedefinitionid,
 		    state, chartregistry, chartrepository, charttag,
 		    valuesoverride, credentialssecretname, credentialspaths,
 		    connectioninfotemplate, computedvalues,
 		    createdat, updatedat)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, NOW(), NOW())
-		RETURNING id, tenantid, workspaceid, name,
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, NOW(), NOW())
+		RETURNING id, tenantid, workspaceid, name, servicedefinitionid,
 		    state, chartregistry, chartrepository, charttag,
 		    valuesoverride, credentialssecretname, credentialspaths,
 		    connectioninfotemplate, computedvalues,
@@ -374,8 +618,8 @@ func (s *WorkspaceStorage) CreateWorkspaceServiceInstance(ctx context.Context, t
 	}
 
 	var created model.WorkspaceServiceInstance
-	err = s.db.GetContext(ctx, &created, query,
-		tenantID, svc.WorkspaceID, svc.Name,
+	err = sqlx.GetContext(ctx, q, &created, query,
+		tenantID, svc.WorkspaceID, svc.Name, svc.ServiceDefinitionID,
 		string(state), svc.ChartRegistry, svc.ChartRepository, svc.ChartTag,
 		encValues, encCredName, pqStringArray(encCredPaths),
 		svc.ConnectionInfoTemplate, computedVals,
@@ -396,10 +640,10 @@ func (s *WorkspaceStorage) UpdateWorkspaceServiceInstance(ctx context.Context, t
 		UPDATE workspace_services
 		SET state = $3, chartregistry = $4, chartrepository = $5, charttag = $6,
 		    valuesoverride = $7, credentialssecretname = $8, credentialspaths = $9,
-		    connectioninfotemplate = $10, computedvalues = $11,
+		    connectioninfotemplate = $10, computedvalues = $11, servicedefinitionid = $12,
 		    updatedat = NOW()
 		WHERE tenantid = $1 AND id = $2 AND deletedat IS NULL
-		RETURNING id, tenantid, workspaceid, name,
+		RETURNING id, tenantid, workspaceid, name, servicedefinitionid,
 		    state, chartregistry, chartrepository, charttag,
 		    valuesoverride, credentialssecretname, credentialspaths,
 		    connectioninfotemplate, computedvalues,
@@ -422,7 +666,7 @@ func (s *WorkspaceStorage) UpdateWorkspaceServiceInstance(ctx context.Context, t
 		tenantID, svc.ID,
 		string(svc.State), svc.ChartRegistry, svc.ChartRepository, svc.ChartTag,
 		encValues, encCredName, pqStringArray(encCredPaths),
-		svc.ConnectionInfoTemplate, computedVals,
+		svc.ConnectionInfoTemplate, computedVals, svc.ServiceDefinitionID,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("unable to update workspace service instance: %w", err)
@@ -489,7 +733,7 @@ func (s *WorkspaceStorage) DeleteOldWorkspaces(ctx context.Context, timeout time
 		  AND status != 'deleted'
 		  AND deletedat IS NULL
 		RETURNING id, tenantid, userid, name, shortname, description, status, ismain,
-		          networkpolicy, allowedfqdns, networkpolicystatus, networkpolicymessage,
+		          networkpolicy, allowedfqdns, networkpolicystatus, networkpolicymessage, rejectedfqdns,
 		          clipboard,
 		          createdat, updatedat;
 	`
@@ -0,0 +1,361 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	cerr "github.com/CHORUS-TRE/chorus-backend/internal/errors"
+	"github.com/CHORUS-TRE/chorus-backend/internal/utils/uuid"
+	common_model "github.com/CHORUS-TRE/chorus-backend/pkg/common/model"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/common/storage"
+	"github.com/CHORUS-TRE/chorus-backend/pkg/workspace/model"
+)
+
+// workspaceTemplateRow is the raw representation of an entry in the
+// 'workspace_templates' table; members are stored as JSONB and app IDs as BIGINT[].
+type workspaceTemplateRow struct {
+	ID            uint64                  `db:"id"`
+	TenantID      uint64                  `db:"tenantid"`
+	UserID        uint64                  `db:"userid"`
+	Name          string                  `db:"name"`
+	Description   string                  `db:"description"`
+	NetworkPolicy model.NetworkPolicyMode `db:"networkpolicy"`
+	AllowedFQDNs  model.FQDNRules         `db:"allowedfqdns"`
+	Clipboard     model.ClipboardMode     `db:"clipboard"`
+	Members       []byte                  `db:"members"`
+	AppIDs        pq.Int64Array           `db:"appids"`
+	CreatedAt     time.Time               `db:"createdat"`
+	UpdatedAt     time.Time               `db:"updatedat"`
+}
+
+func (r *workspaceTemplateRow) toModel() (*model.WorkspaceTemplate, error) {
+	members := []model.WorkspaceTemplateMember{}
+	if len(r.Members) > 0 {
+		if err := json.Unmarshal(r.Members, &members); err != nil {
+			return nil, fmt.Errorf("unable to unmarshal members: %w", err)
+		}
+	}
+
+	return &model.WorkspaceTemplate{
+		ID:            r.ID,
+		TenantID:      r.TenantID,
+		UserID:        r.UserID,
+		Name:          r.Name,
+		Description:   r.Description,
+		NetworkPolicy: r.NetworkPolicy,
+		AllowedFQDNs:  r.AllowedFQDNs,
+		Clipboard:     r.Clipboard,
+		Members:       members,
+		AppIDs:        storage.PqInt64ToUint64(r.AppIDs),
+		CreatedAt:     r.CreatedAt,
+		UpdatedAt:     r.UpdatedAt,
+	}, nil
+}
+
+func marshalTemplateMembers(members []model.WorkspaceTemplateMember) (string, error) {
+	if members == nil {
+		members = []model.WorkspaceTemplateMember{}
+	}
+	b, err := json.Marshal(members)
+	if err != nil {
+		return "", fmt.Errorf("unable to marshal members: %w", err)
+	}
+	return string(b), nil
+}
+
+func (s *WorkspaceStorage) GetWorkspaceTemplate(ctx context.Context, tenantID, templateID uint64) (*model.WorkspaceTemplate, error) {
+	const query = `
+		SELECT id, tenantid, userid, name, description,
+		       networkpolicy, allowedfqdns, clipboard, members, appids,
+		       createdat, updatedat
+		FROM workspace_templates
+		WHERE tenantid = $1 AND id = $2 AND deletedat IS NULL;
+	`
+
+	var row workspaceTemplateRow
+	if err := s.db.GetContext(ctx, &row, query, tenantID, templateID); err != nil {
+		return nil, err
+	}
+
+	template, err := row.toModel()
+	if err != nil {
+		return nil, err
+	}
+
+	template.ServiceInstances, err = s.listWorkspaceTemplateServiceInstances(ctx, s.db, template.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+func (s *WorkspaceStorage) ListWorkspaceTemplates(ctx context.Context, tenantID uint64, pagination *common_model.Pagination) ([]*model.WorkspaceTemplate, *common_model.PaginationResult, error) {
+	const countQuery = `SELECT COUNT(*) FROM workspace_templates WHERE tenantid = $1 AND deletedat IS NULL`
+
+	var totalCount int
+	if err := s.db.GetContext(ctx, &totalCount, countQuery, tenantID); err != nil {
+		return nil, nil, err
+	}
+
+	query := `
+		SELECT id, tenantid, userid, name, description,
+		       networkpolicy, allowedfqdns, clipboard, members, appids,
+		       createdat, updatedat
+		FROM workspace_templates
+		WHERE tenantid = $1 AND deletedat IS NULL
+	`
+
+	clause, validatedPagination := storage.BuildPaginationClause(pagination, model.WorkspaceTemplate{})
+	query += clause
+
+	paginationRes := &common_model.PaginationResult{Total: uint64(totalCount)}
+	if validatedPagination != nil {
+		paginationRes.Limit = validatedPagination.Limit
+		paginationRes.Offset = validatedPagination.Offset
+		paginationRes.Sort = validatedPagination.Sort
+	}
+
+	var rows []*workspaceTemplateRow
+	if err := s.db.SelectContext(ctx, &rows, query, tenantID); err != nil {
+		return nil, nil, err
+	}
+
+	templates := make([]*model.WorkspaceTemplate, 0, len(rows))
+	for _, row := range rows {
+		template, err := row.toModel()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		template.ServiceInstances, err = s.listWorkspaceTemplateServiceInstances(ctx, s.db, template.ID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		templates = append(templates, template)
+	}
+
+	return templates, paginationRes, nil
+}
+
+func (s *WorkspaceStorage) CreateWorkspaceTemplate(ctx context.Context, tenantID uint64, template *model.WorkspaceTemplate) (*model.WorkspaceTemplate, error) {
+	const query = `
+		INSERT INTO workspace_templates (tenantid, userid, name, description,
+		    networkpolicy, allowedfqdns, clipboard, members, appids,
+		    createdat, updatedat)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW(), NOW())
+		RETURNING id, tenantid, userid, name, description,
+		    networkpolicy, allowedfqdns, clipboard, members, appids,
+		    createdat, updatedat;
+	`
+
+	members, err := marshalTemplateMembers(template.Members)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var row workspaceTemplateRow
+	err = tx.GetContext(ctx, &row, query,
+		tenantID, template.UserID, template.Name, template.Description,
+		template.NetworkPolicy, template.AllowedFQDNs, template.Clipboard, members, storage.Uint64ToPqInt64(template.AppIDs),
+	)
+	if err != nil {
+		return nil, storage.Rollback(tx, err)
+	}
+
+	created, err := row.toModel()
+	if err != nil {
+		return nil, storage.Rollback(tx, err)
+	}
+
+	created.ServiceInstances, err = s.createWorkspaceTemplateServiceInstances(ctx, tx, tenantID, created.ID, template.ServiceInstances)
+	if err != nil {
+		return nil, storage.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// UpdateWorkspaceTemplate updates the template and replaces its service instance specs.
+func (s *WorkspaceStorage) UpdateWorkspaceTemplate(ctx context.Context, tenantID uint64, template *model.WorkspaceTemplate) (*model.WorkspaceTemplate, error) {
+	const query = `
+		UPDATE workspace_templates
+		SET name = $3, description = $4,
+		    networkpolicy = $5, allowedfqdns = $6, clipboard = $7, members = $8, appids = $9,
+		    updatedat = NOW()
+		WHERE tenantid = $1 AND id = $2 AND deletedat IS NULL
+		RETURNING id, tenantid, userid, name, description,
+		    networkpolicy, allowedfqdns, clipboard, members, appids,
+		    createdat, updatedat;
+	`
+
+	const deleteServicesQuery = `
+		UPDATE workspace_template_services
+		SET name = concat(name, $2::TEXT), deletedat = NOW(), updatedat = NOW()
+		WHERE templateid = $1 AND deletedat IS NULL;
+	`
+
+	members, err := marshalTemplateMembers(template.Members)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var row workspaceTemplateRow
+	err = tx.GetContext(ctx, &row, query,
+		tenantID, template.ID, template.Name, template.Description,
+		template.NetworkPolicy, template.AllowedFQDNs, template.Clipboard, members, storage.Uint64ToPqInt64(template.AppIDs),
+	)
+	if err != nil {
+		return nil, storage.Rollback(tx, fmt.Errorf("unable to update workspace template: %w", err))
+	}
+
+	updated, err := row.toModel()
+	if err != nil {
+		return nil, storage.Rollback(tx, err)
+	}
+
+	if _, err := tx.ExecContext(ctx, deleteServicesQuery, updated.ID, "-"+uuid.Next()); err != nil {
+		return nil, storage.Rollback(tx, fmt.Errorf("unable to delete workspace template services: %w", err))
+	}
+
+	updated.ServiceInstances, err = s.createWorkspaceTemplateServiceInstances(ctx, tx, tenantID, updated.ID, template.ServiceInstances)
+	if err != nil {
+		return nil, storage.Rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (s *WorkspaceStorage) DeleteWorkspaceTemplate(ctx context.Context, tenantID, templateID uint64) error {
+	const query = `
+		UPDATE workspace_templates
+		SET name = concat(name, $3::TEXT), deletedat = NOW(), updatedat = NOW()
+		WHERE tenantid = $1 AND id = $2 AND deletedat IS NULL;
+	`
+
+	rows, err := s.db.ExecContext(ctx, query, tenantID, templateID, "-"+uuid.Next())
+	if err != nil {
+		return fmt.Errorf("unable to delete workspace template: %w", err)
+	}
+	affected, err := rows.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("unable to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return cerr.ErrNoRowsDeleted
+	}
+
+	return nil
+}
+
+func (s *WorkspaceStorage) listWorkspaceTemplateServiceInstances(ctx context.Context, q sqlx.QueryerContext, templateID uint64) ([]*model.WorkspaceTemplateServiceInstance, error) {
+	const query = `
+		SELECT id, tenantid, templateid, name, servicedefinitionid,
+		       chartregistry, chartrepository, charttag,
+		       valuesoverride, credentialssecretname, credentialspaths,
+		       connectioninfotemplate,
+		       createdat, updatedat
+		FROM workspace_template_services
+		WHERE templateid = $1 AND deletedat IS NULL
+		ORDER BY id;
+	`
+
+	svcs := []*model.WorkspaceTemplateServiceInstance{}
+	if err := sqlx.SelectContext(ctx, q, &svcs, query, templateID); err != nil {
+		return nil, err
+	}
+
+	for _, svc := range svcs {
+		if err := s.decryptTemplateServiceInstance(svc); err != nil {
+			return nil, fmt.Errorf("unable to decrypt workspace template service instance: %w", err)
+		}
+	}
+
+	return svcs, nil
+}
+
+func (s *WorkspaceStorage) createWorkspaceTemplateServiceInstances(ctx context.Context, q sqlx.QueryerContext, tenantID, templateID uint64, svcs []*model.WorkspaceTemplateServiceInstance) ([]*model.WorkspaceTemplateServiceInstance, error) {
+	const query = `
+		INSERT INTO workspace_template_services (tenantid, templateid, name, servicedefinitionid,
+		    chartregistry, chartrepository, charttag,
+		    valuesoverride, credentialssecretname, credentialspaths,
+		    connectioninfotemplate,
+		    createdat, updatedat)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, NOW(), NOW())
+		RETURNING id, tenantid, templateid, name, servicedefinitionid,
+		    chartregistry, chartrepository, charttag,
+		    valuesoverride, credentialssecretname, credentialspaths,
+		    connectioninfotemplate,
+		    createdat, updatedat;
+	`
+
+	created := make([]*model.WorkspaceTemplateServiceInstance, 0, len(svcs))
+	for _, svc := range svcs {
+		encValues, encCredName, encCredPaths, err := s.encryptSensitiveFields(svc.ToServiceInstance(tenantID, 0))
+		if err != nil {
+			return nil, err
+		}
+
+		var c model.WorkspaceTemplateServiceInstance
+		err = sqlx.GetContext(ctx, q, &c, query,
+			tenantID, templateID, svc.Name, svc.ServiceDefinitionID,
+			svc.ChartRegistry, svc.ChartRepository, svc.ChartTag,
+			encValues, encCredName, pqStringArray(encCredPaths),
+			svc.ConnectionInfoTemplate,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.decryptTemplateServiceInstance(&c); err != nil {
+			return nil, fmt.Errorf("unable to decrypt workspace template service instance: %w", err)
+		}
+
+		created = append(created, &c)
+	}
+
+	return created, nil
+}
+
+// decryptTemplateServiceInstance decrypts the sensitive fields of a template
+// service instance, which are encrypted the same way as for service instances.
+func (s *WorkspaceStorage) decryptTemplateServiceInstance(t *model.WorkspaceTemplateServiceInstance) error {
+	svc := model.WorkspaceServiceInstance{
+		Values:                t.Values,
+		CredentialsSecretName: t.CredentialsSecretName,
+		CredentialsPaths:      t.CredentialsPaths,
+	}
+	if err := s.decryptServiceInstance(&svc); err != nil {
+		return err
+	}
+
+	t.Values = svc.Values
+	t.CredentialsSecretName = svc.CredentialsSecretName
+	t.CredentialsPaths = svc.CredentialsPaths
+
+	return nil
+}